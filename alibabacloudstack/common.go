@@ -2,7 +2,10 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	// Registers SHA-256 for the PGP encryption of encryptWithPgpKey.
+	_ "crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/fc-go-sdk"
@@ -14,13 +17,19 @@ import (
 	"os/user"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/packet"
 )
 
 type InstanceNetWork string
@@ -333,12 +342,16 @@ func addDebug(action, content interface{}, requestInfo ...interface{}) {
 				request.Version = client.Config.APIVersion
 				request.Product = "FC"
 				request.ActionName = fmt.Sprintf("%s", action)
-
+			case map[string]interface{}:
+				request.ActionName = fmt.Sprintf("%s", action)
 			}
 
 			requestContent := ""
 			if len(requestInfo) > 1 {
-				requestContent = fmt.Sprintf("%#v", requestInfo[1])
+				requestContent = fmt.Sprintf("%#v", redactDebugValue(requestInfo[1]))
+			} else if payload, ok := requestInfo[0].(map[string]interface{}); ok {
+				// tea rpc callers pass the request payload as the only request info
+				requestContent = fmt.Sprintf("%#v", redactDebugValue(payload))
 			}
 
 			content = fmt.Sprintf("%vDomain:%v, Version:%v, ActionName:%v, Method:%v, Product:%v, Region:%v\n\n"+
@@ -348,10 +361,143 @@ func addDebug(action, content interface{}, requestInfo ...interface{}) {
 		}
 
 		//fmt.Printf(DefaultDebugMsg, action, content, trace)
-		log.Printf(DefaultDebugMsg, action, content, trace)
+		log.Printf(DefaultDebugMsg, action, redactDebugString(fmt.Sprintf("%v", content)), trace)
 	}
 }
 
+// debugRedactedValue replaces every sensitive value written to the debug log.
+const debugRedactedValue = "******"
+
+// sensitiveDebugKeyWords are the fragments of a parameter name which mark its value as sensitive,
+// whatever the product, e.g. AccessKeySecret, KmsEncryptedPassword, SecurityToken or private_key.
+var sensitiveDebugKeyWords = []string{"password", "secret", "securitytoken", "ststoken", "privatekey", "authorization", "signature"}
+
+// genericDebugKeys are the parameter names too common to be redacted for every product, even if an
+// attribute with that name is Sensitive, e.g. the type of alibabacloudstack_alikafka_sasl_user.
+var genericDebugKeys = map[string]bool{"type": true, "name": true, "value": true, "key": true, "id": true, "status": true, "description": true}
+
+var sensitiveSchemaAttributes map[string]bool
+var sensitiveSchemaAttributesOnce sync.Once
+
+// debugKeyPattern matches `key: value`, `"key":"value"`, `Key:"value"` and `key=value` pairs as they
+// appear in %#v dumps, JSON bodies and query strings.
+var debugKeyPattern = regexp.MustCompile(`("?)([A-Za-z_][\w.-]*)("?\s*[:=]\s*)("(?:[^"\\]|\\.)*"|[^,\s&}\])]+)`)
+
+func normalizeDebugKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "", ".", "").Replace(key))
+}
+
+// loadSensitiveSchemaAttributes collects the names of all the resource and data source attributes
+// which are marked as Sensitive, so they are redacted with the same name in the API parameters. The
+// generic names are left out, as they would redact the parameters of every other product.
+func loadSensitiveSchemaAttributes() map[string]bool {
+	sensitiveSchemaAttributesOnce.Do(func() {
+		sensitiveSchemaAttributes = make(map[string]bool)
+		var walk func(map[string]*schema.Schema)
+		walk = func(schemas map[string]*schema.Schema) {
+			for name, s := range schemas {
+				if s.Sensitive && !genericDebugKeys[normalizeDebugKey(name)] {
+					sensitiveSchemaAttributes[normalizeDebugKey(name)] = true
+				}
+				if elem, ok := s.Elem.(*schema.Resource); ok {
+					walk(elem.Schema)
+				}
+			}
+		}
+		provider := Provider()
+		for _, r := range provider.ResourcesMap {
+			walk(r.Schema)
+		}
+		for _, r := range provider.DataSourcesMap {
+			walk(r.Schema)
+		}
+	})
+	return sensitiveSchemaAttributes
+}
+
+func isSensitiveDebugKey(key string) bool {
+	key = normalizeDebugKey(key)
+	if key == "" {
+		return false
+	}
+	for _, word := range sensitiveDebugKeyWords {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return loadSensitiveSchemaAttributes()[key]
+}
+
+// redactDebugString scrubs the values of all sensitive keys in a formatted debug message.
+func redactDebugString(content string) string {
+	return debugKeyPattern.ReplaceAllStringFunc(content, func(pair string) string {
+		parts := debugKeyPattern.FindStringSubmatch(pair)
+		if !isSensitiveDebugKey(parts[2]) {
+			return pair
+		}
+		value := debugRedactedValue
+		if strings.HasPrefix(parts[4], "\"") {
+			value = fmt.Sprintf("%q", debugRedactedValue)
+		}
+		return parts[1] + parts[2] + parts[3] + value
+	})
+}
+
+// redactDebugValue returns a copy of the RPC, ROA, CommonRequest or tea rpc request payload in which
+// the values of all sensitive keys are replaced. Any other value is returned as it is.
+func redactDebugValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if isSensitiveDebugKey(key) {
+				result[key] = debugRedactedValue
+				continue
+			}
+			result[key] = redactDebugValue(item)
+		}
+		return result
+	case map[string]string:
+		result := make(map[string]string, len(v))
+		for key, item := range v {
+			if isSensitiveDebugKey(key) {
+				item = debugRedactedValue
+			}
+			result[key] = item
+		}
+		return result
+	case map[string]*string:
+		result := make(map[string]string, len(v))
+		for key, item := range v {
+			if isSensitiveDebugKey(key) {
+				result[key] = debugRedactedValue
+			} else if item != nil {
+				result[key] = *item
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = redactDebugValue(item)
+		}
+		return result
+	case *requests.CommonRequest:
+		return map[string]interface{}{
+			"QueryParams": redactDebugValue(v.QueryParams),
+			"FormParams":  redactDebugValue(v.FormParams),
+			"Headers":     redactDebugValue(v.Headers),
+		}
+	case requests.AcsRequest:
+		return map[string]interface{}{
+			"QueryParams": redactDebugValue(v.GetQueryParams()),
+			"FormParams":  redactDebugValue(v.GetFormParams()),
+			"Headers":     redactDebugValue(v.GetHeaders()),
+		}
+	}
+	return value
+}
+
 func debugOn() bool {
 	for _, part := range strings.Split(os.Getenv("DEBUG"), ",") {
 		if strings.TrimSpace(part) == "terraform" {
@@ -385,14 +531,16 @@ func getNextpageNumber(number requests.Integer) (requests.Integer, error) {
 	return requests.NewInteger(page + 1), nil
 }
 
+// incrementalWait returns a func sleeping firstDuration the first time it is called and growing the
+// wait exponentially by increaseDuration afterwards, with jitter. Only the growth is capped at the
+// provider max_backoff, so a firstDuration longer than it is still honoured.
 func incrementalWait(firstDuration time.Duration, increaseDuration time.Duration) func() {
-	retryCount := 1
+	retryCount := 0
 	return func() {
-		var waitTime time.Duration
-		if retryCount == 1 {
-			waitTime = firstDuration
-		} else if retryCount > 1 {
-			waitTime += increaseDuration
+		policy := connectivity.CurrentRetryPolicy()
+		waitTime := firstDuration
+		if retryCount > 0 && increaseDuration > 0 {
+			waitTime += policy.BackoffFrom(increaseDuration, retryCount-1)
 		}
 		time.Sleep(waitTime)
 		retryCount++
@@ -417,24 +565,37 @@ func ParseResourceId(id string, length int) (parts []string, err error) {
 	return parts, err
 }
 
+// stateConfReplay is set by the offline tests, which replay the resource statuses from cassettes, so
+// the state change waits refresh the statuses without waiting.
+var stateConfReplay = false
+
+func stateConfDelays(delay time.Duration) (time.Duration, time.Duration) {
+	if stateConfReplay {
+		return 0, 0
+	}
+	return delay, 3 * time.Second
+}
+
 func BuildStateConf(pending, target []string, timeout, delay time.Duration, f resource.StateRefreshFunc) *resource.StateChangeConf {
+	delay, minTimeout := stateConfDelays(delay)
 	return &resource.StateChangeConf{
 		Pending:    pending,
 		Target:     target,
 		Refresh:    f,
 		Timeout:    timeout,
 		Delay:      delay,
-		MinTimeout: 3 * time.Second,
+		MinTimeout: minTimeout,
 	}
 }
 func BuildStateConfByTimes(pending, target []string, timeout, delay time.Duration, f resource.StateRefreshFunc, notFoundChecks int) *resource.StateChangeConf {
+	delay, minTimeout := stateConfDelays(delay)
 	return &resource.StateChangeConf{
 		Pending:        pending,
 		Target:         target,
 		Refresh:        f,
 		Timeout:        timeout,
 		Delay:          delay,
-		MinTimeout:     3 * time.Second,
+		MinTimeout:     minTimeout,
 		NotFoundChecks: notFoundChecks,
 	}
 }
@@ -480,11 +641,11 @@ var ClientErrorCatcher = Catcher{AlibabacloudStackGoClientFailure, 10, 5}
 var ServiceBusyCatcher = Catcher{"ServiceUnavailable", 10, 5}
 var ThrottlingCatcher = Catcher{Throttling, 50, 2}
 
+// NewInvoker only retries the client failures, as the throttling and service unavailable errors are
+// already retried by the retry policy of the connectivity clients.
 func NewInvoker() Invoker {
 	i := Invoker{}
 	i.AddCatcher(ClientErrorCatcher)
-	i.AddCatcher(ServiceBusyCatcher)
-	i.AddCatcher(ThrottlingCatcher)
 	return i
 }
 
@@ -549,6 +710,35 @@ func GetUserHomeDir() (string, error) {
 	return usr.HomeDir, nil
 }
 
+// encryptWithPgpKey encrypts value with the base64 encoded PGP public key and returns the key
+// fingerprint and the base64 encoded encrypted value, which can be decrypted with
+// "base64 --decode | gpg --decrypt".
+func encryptWithPgpKey(pgpKey, value string) (string, string, error) {
+	if strings.HasPrefix(pgpKey, "keybase:") {
+		return "", "", Error("the keybase pgp keys are not supported, please set the base64 encoded public key instead of %s", pgpKey)
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(pgpKey))
+	if err != nil {
+		return "", "", Error("the pgp_key must be a base64 encoded public key: %s", err)
+	}
+	entity, err := openpgp.ReadEntity(packet.NewReader(bytes.NewReader(data)))
+	if err != nil {
+		return "", "", Error("unable to read the pgp_key: %s", err)
+	}
+	buf := &bytes.Buffer{}
+	writer, err := openpgp.Encrypt(buf, []*openpgp.Entity{entity}, nil, nil, nil)
+	if err != nil {
+		return "", "", Error("unable to encrypt with the pgp_key: %s", err)
+	}
+	if _, err := writer.Write([]byte(value)); err != nil {
+		return "", "", Error("unable to encrypt with the pgp_key: %s", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", "", Error("unable to encrypt with the pgp_key: %s", err)
+	}
+	return hex.EncodeToString(entity.PrimaryKey.Fingerprint[:]), base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
 func writeToFile(filePath string, data interface{}) error {
 	var out string
 	switch data.(type) {
@@ -592,27 +782,36 @@ func (a *Invoker) AddCatcher(catcher Catcher) {
 	a.catchers = append(a.catchers, &catcher)
 }
 
+// Run calls f until it succeeds or fails with an error no catcher retries. Every catcher retries up to
+// its RetryCount times, waiting an exponential backoff from its RetryWaitSeconds between two attempts.
 func (a *Invoker) Run(f func() error) error {
-	err := f()
-
-	if err == nil {
-		return nil
-	}
-
-	for _, catcher := range a.catchers {
-		if IsExpectedErrors(err, []string{catcher.Reason}) {
-			catcher.RetryCount--
+	attempts := make([]int, len(a.catchers))
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
 
-			if catcher.RetryCount <= 0 {
+		retried := false
+		for i, catcher := range a.catchers {
+			if !IsExpectedErrors(err, []string{catcher.Reason}) {
+				continue
+			}
+			attempts[i]++
+			if attempts[i] >= catcher.RetryCount {
 				return fmt.Errorf("Retry timeout and got an error: %#v.", err)
-			} else {
-				time.Sleep(time.Duration(catcher.RetryWaitSeconds) * time.Second)
-				return a.Run(f)
 			}
+			wait := time.Duration(catcher.RetryWaitSeconds) * time.Second
+			time.Sleep(connectivity.CurrentRetryPolicy().BackoffFrom(wait, attempts[i]-1))
+			retried = true
+			break
+		}
+		if !retried {
+			return err
 		}
 	}
-	return err
 }
+
 func Trim(v string) string {
 	if len(v) < 1 {
 		return v
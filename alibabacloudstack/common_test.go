@@ -0,0 +1,57 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+)
+
+func TestRedactDebugString(t *testing.T) {
+	cases := map[string]string{
+		`map[string]string{"AccessKeySecret":"abc123", "Product":"ecs"}`: `map[string]string{"AccessKeySecret":"******", "Product":"ecs"}`,
+		`{"Password": "P@ssw0rd", "InstanceId": "i-123"}`:                `{"Password": "******", "InstanceId": "i-123"}`,
+		`&ecs.CreateInstanceRequest{Password:"P@ssw0rd", ImageId:"m-1"}`: `&ecs.CreateInstanceRequest{Password:"******", ImageId:"m-1"}`,
+		`Action=CreateUser&SecurityToken=sts-token&RegionId=cn-qingdao`:  `Action=CreateUser&SecurityToken=******&RegionId=cn-qingdao`,
+		`KmsEncryptedPassword: cipher, NextToken: 10`:                    `KmsEncryptedPassword: ******, NextToken: 10`,
+		`{"Type": "scram", "Plaintext": "data"}`:                         `{"Type": "scram", "Plaintext": "******"}`,
+	}
+	for content, expected := range cases {
+		if actual := redactDebugString(content); actual != expected {
+			t.Errorf("redactDebugString(%s): expected %s, got %s", content, expected, actual)
+		}
+	}
+}
+
+func TestRedactDebugValue(t *testing.T) {
+	token := "sts-token"
+	payload := map[string]interface{}{
+		"RegionId":          "cn-qingdao",
+		"account_password":  "P@ssw0rd",
+		"DBInstanceNetType": "Intranet",
+		"Tags": []interface{}{
+			map[string]interface{}{"Key": "k", "PrivateKey": "-----BEGIN"},
+		},
+	}
+	dump := fmt.Sprintf("%#v", redactDebugValue(payload))
+	if strings.Contains(dump, "P@ssw0rd") || strings.Contains(dump, "BEGIN") {
+		t.Errorf("the sensitive values are not redacted: %s", dump)
+	}
+	if !strings.Contains(dump, "Intranet") {
+		t.Errorf("the non sensitive values should be kept: %s", dump)
+	}
+
+	dump = fmt.Sprintf("%#v", redactDebugValue(map[string]*string{"SecurityToken": &token}))
+	if strings.Contains(dump, token) {
+		t.Errorf("the tea rpc payload is not redacted: %s", dump)
+	}
+
+	request := requests.NewCommonRequest()
+	request.QueryParams = map[string]string{"AccessKeySecret": "abc123", "Product": "ascm"}
+	request.TransToAcsRequest()
+	dump = fmt.Sprintf("%#v", redactDebugValue(request.Ontology))
+	if strings.Contains(dump, "abc123") || !strings.Contains(dump, "ascm") {
+		t.Errorf("the common request is not redacted: %s", dump)
+	}
+}
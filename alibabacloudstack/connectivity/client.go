@@ -1,6 +1,8 @@
 package connectivity
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"log"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
 	cdn_new "github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
@@ -53,6 +56,7 @@ import (
 
 	"github.com/denverdino/aliyungo/cs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/resty.v1"
 
 	"fmt"
 	"net/http"
@@ -65,17 +69,29 @@ import (
 )
 
 type AlibabacloudStackClient struct {
-	SourceIp                     string
-	SecureTransport              string
-	Region                       Region
-	RegionId                     string
-	Domain                       string
-	AccessKey                    string
-	SecretKey                    string
-	Department                   string
-	ResourceGroup                string
-	Config                       *Config
-	teaSdkConfig                 rpc.Config
+	SourceIp        string
+	SecureTransport string
+	Region          Region
+	RegionId        string
+	Domain          string
+	AccessKey       string
+	SecretKey       string
+	Department      string
+	ResourceGroup   string
+	Config          *Config
+	teaSdkConfig    rpc.Config
+	OtsInstanceName string
+	telemetry       *apiTelemetry
+	retryPolicy     *RetryPolicy
+	rateLimiter     *rateLimiter
+	recorder        *Recorder
+	*clientConnections
+	ctx context.Context
+}
+
+// clientConnections holds the product clients, initialized on first use and shared by the
+// AlibabacloudStackClient copies returned by WithContext.
+type clientConnections struct {
 	accountId                    string
 	roleId                       int
 	ecsconn                      *ecs.Client
@@ -111,11 +127,12 @@ type AlibabacloudStackClient struct {
 	cmsconn                      *cms.Client
 	maxcomputeconn               *maxcompute.Client
 	alikafkaconn                 *alikafka.Client
+	cenconn                      *cbn.Client
 	otsconn                      *ots.Client
-	OtsInstanceName              string
 	tablestoreconnByInstanceName map[string]*tablestore.TableStoreClient
 	dhconn                       datahub.DataHubApi
 	cloudapiconn                 *cloudapi.Client
+	stopCtx                      context.Context
 }
 
 const (
@@ -161,18 +178,31 @@ func (c *Config) Client() (*AlibabacloudStackClient, error) {
 		return nil, err
 	}
 
+	telemetry, err := newApiTelemetry(c.TelemetryLogLevel, c.TelemetryFile)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy := NewRetryPolicy(c.MaxRetries, time.Duration(c.MaxBackoff)*time.Second, c.RetryableErrorCodes)
+	setCurrentRetryPolicy(retryPolicy)
+
 	return &AlibabacloudStackClient{
-		Config:                       c,
-		teaSdkConfig:                 teaSdkConfig,
-		Region:                       c.Region,
-		RegionId:                     c.RegionId,
-		AccessKey:                    c.AccessKey,
-		SecretKey:                    c.SecretKey,
-		Department:                   c.Department,
-		ResourceGroup:                c.ResourceGroup,
-		Domain:                       c.Domain,
-		OtsInstanceName:              c.OtsInstanceName,
-		tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
+		Config:          c,
+		teaSdkConfig:    teaSdkConfig,
+		Region:          c.Region,
+		RegionId:        c.RegionId,
+		AccessKey:       c.AccessKey,
+		SecretKey:       c.SecretKey,
+		Department:      c.Department,
+		ResourceGroup:   c.ResourceGroup,
+		Domain:          c.Domain,
+		OtsInstanceName: c.OtsInstanceName,
+		clientConnections: &clientConnections{
+			tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
+		},
+		telemetry:   telemetry,
+		retryPolicy: retryPolicy,
+		rateLimiter: newRateLimiter(c.RateLimits),
 	}, nil
 }
 
@@ -206,7 +236,9 @@ func (client *AlibabacloudStackClient) WithEcsClient(do func(*ecs.Client) (inter
 		client.ecsconn = ecsconn
 	}
 
-	return do(client.ecsconn)
+	return client.invoke(string(ECSCode), func() (interface{}, error) {
+		return do(client.ecsconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithPolarDBClient(do func(*polardb.Client) (interface{}, error)) (interface{}, error) {
@@ -234,7 +266,9 @@ func (client *AlibabacloudStackClient) WithPolarDBClient(do func(*polardb.Client
 		client.polarDBconn = polarDBconn
 	}
 
-	return do(client.polarDBconn)
+	return client.invoke(string(POLARDBCode), func() (interface{}, error) {
+		return do(client.polarDBconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithElasticsearchClient(do func(*elasticsearch.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the Elasticsearch client if necessary
@@ -261,7 +295,9 @@ func (client *AlibabacloudStackClient) WithElasticsearchClient(do func(*elastics
 		client.elasticsearchconn = elasticsearchconn
 	}
 
-	return do(client.elasticsearchconn)
+	return client.invoke(string(ELASTICSEARCHCode), func() (interface{}, error) {
+		return do(client.elasticsearchconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCloudApiClient(do func(*cloudapi.Client) (interface{}, error)) (interface{}, error) {
@@ -288,7 +324,9 @@ func (client *AlibabacloudStackClient) WithCloudApiClient(do func(*cloudapi.Clie
 		client.cloudapiconn = cloudapiconn
 	}
 
-	return do(client.cloudapiconn)
+	return client.invoke(string(CLOUDAPICode), func() (interface{}, error) {
+		return do(client.cloudapiconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithEssClient(do func(*ess.Client) (interface{}, error)) (interface{}, error) {
@@ -320,7 +358,9 @@ func (client *AlibabacloudStackClient) WithEssClient(do func(*ess.Client) (inter
 		client.essconn = essconn
 	}
 
-	return do(client.essconn)
+	return client.invoke(string(ESSCode), func() (interface{}, error) {
+		return do(client.essconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithRkvClient(do func(*r_kvstore.Client) (interface{}, error)) (interface{}, error) {
@@ -351,7 +391,9 @@ func (client *AlibabacloudStackClient) WithRkvClient(do func(*r_kvstore.Client)
 		client.rkvconn = rkvconn
 	}
 
-	return do(client.rkvconn)
+	return client.invoke(string(KVSTORECode), func() (interface{}, error) {
+		return do(client.rkvconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, error)) (interface{}, error) {
@@ -377,7 +419,9 @@ func (client *AlibabacloudStackClient) WithGpdbClient(do func(*gpdb.Client) (int
 		client.gpdbconn = gpdbconn
 	}
 
-	return do(client.gpdbconn)
+	return client.invoke(string(GPDBCode), func() (interface{}, error) {
+		return do(client.gpdbconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithAdbClient(do func(*adb.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the adb client if necessary
@@ -402,7 +446,9 @@ func (client *AlibabacloudStackClient) WithAdbClient(do func(*adb.Client) (inter
 		client.adbconn = adbconn
 	}
 
-	return do(client.adbconn)
+	return client.invoke(string(ADBCode), func() (interface{}, error) {
+		return do(client.adbconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithHbaseClient(do func(*hbase.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the HBase client if necessary
@@ -429,7 +475,9 @@ func (client *AlibabacloudStackClient) WithHbaseClient(do func(*hbase.Client) (i
 		client.hbaseconn = hbaseconn
 	}
 
-	return do(client.hbaseconn)
+	return client.invoke(string(HBASECode), func() (interface{}, error) {
+		return do(client.hbaseconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithFcClient(do func(*fc.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
@@ -451,18 +499,23 @@ func (client *AlibabacloudStackClient) WithFcClient(do func(*fc.Client) (interfa
 
 		config := client.getSdkConfig()
 		clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.Config.SecurityToken), fc.WithTransport(config.HttpTransport),
-			fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
+			fc.WithTimeout(30), fc.WithRetryCount(0)}
 		fcconn, err := fc.NewClient(fmt.Sprintf("https://%s.%s", accountId, endpoint), string(ApiVersion20160815), client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the FC client: %#v", err)
 		}
 
+		// fc.WithTransport only takes a *http.Transport, so the telemetry transport is set on the resty
+		// client the FC SDK sends its requests with.
+		resty.SetTransport(client.getTelemetryTransport(string(FCCode)))
 		fcconn.Config.UserAgent = client.getUserAgent()
 		fcconn.Config.SecurityToken = client.Config.SecurityToken
 		client.fcconn = fcconn
 	}
 
-	return do(client.fcconn)
+	return client.invoke(string(FCCode), func() (interface{}, error) {
+		return do(client.fcconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the VPC client if necessary
@@ -493,7 +546,9 @@ func (client *AlibabacloudStackClient) WithVpcClient(do func(*vpc.Client) (inter
 		client.vpcconn = vpcconn
 	}
 
-	return do(client.vpcconn)
+	return client.invoke(string(VPCCode), func() (interface{}, error) {
+		return do(client.vpcconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithSlbClient(do func(*slb.Client) (interface{}, error)) (interface{}, error) {
@@ -523,7 +578,9 @@ func (client *AlibabacloudStackClient) WithSlbClient(do func(*slb.Client) (inter
 		client.slbconn = slbconn
 	}
 
-	return do(client.slbconn)
+	return client.invoke(string(SLBCode), func() (interface{}, error) {
+		return do(client.slbconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithDdsClient(do func(*dds.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the DDS client if necessary
@@ -550,7 +607,9 @@ func (client *AlibabacloudStackClient) WithDdsClient(do func(*dds.Client) (inter
 		client.ddsconn = ddsconn
 	}
 
-	return do(client.ddsconn)
+	return client.invoke(string(DDSCode), func() (interface{}, error) {
+		return do(client.ddsconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithOssNewClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
@@ -583,7 +642,9 @@ func (client *AlibabacloudStackClient) WithOssNewClient(do func(*ecs.Client) (in
 		client.ecsconn = ecsconn
 	}
 
-	return do(client.ecsconn)
+	return client.invoke(string(ECSCode), func() (interface{}, error) {
+		return do(client.ecsconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) describeEndpointForService(serviceCode string) (*location.Endpoint, error) {
@@ -623,69 +684,77 @@ func (client *AlibabacloudStackClient) describeEndpointForService(serviceCode st
 	return nil, fmt.Errorf("There is no any available endpoint for %s in region %s.", serviceCode, client.RegionId)
 }
 
-func (client *AlibabacloudStackClient) NewCommonRequest(product, serviceCode, schema string, apiVersion ApiVersion) (*requests.CommonRequest, error) {
+// NewCommonRequest builds a CommonRequest for the given product API with the scheme, insecure mode,
+// region, department and resource group taken from the provider configuration. The request is signed
+// by the SDK client with the configured credential, so the AccessKeySecret never appears in the query
+// parameters. An empty pathPattern builds an RPC style request, otherwise a ROA style one.
+func (client *AlibabacloudStackClient) NewCommonRequest(method, product, version, apiName, pathPattern string) *requests.CommonRequest {
 	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	var endpoint string
-	if strings.ToUpper(product) == "SLB" {
-		endpoint = client.Config.SlbEndpoint
-	}
-	if strings.ToUpper(product) == "ECS" {
-		endpoint = client.Config.EcsEndpoint
-	}
-	if strings.ToUpper(product) == "ASCM" {
-		endpoint = client.Config.AscmEndpoint
-	}
-
-	if endpoint == "" {
-		endpointItem, err := client.describeEndpointForService(serviceCode)
-		if err != nil {
-			return nil, fmt.Errorf("describeEndpointForService got an error: %#v.", err)
-		}
-		if endpointItem != nil {
-			endpoint = endpointItem.Endpoint
-		}
-	}
-	// Use product code to find product domain
-	if endpoint != "" {
-		request.Domain = endpoint
+	request.Method = method
+	request.Product = product
+	request.Version = version
+	request.ServiceCode = strings.ToLower(product)
+	request.RegionId = client.RegionId
+	request.Domain = client.Config.productEndpoint(product)
+	if pathPattern != "" {
+		request.PathPattern = pathPattern
 	} else {
-		// When getting endpoint failed by location, using custom endpoint instead
-		request.Domain = fmt.Sprintf("%s.%s.aliyuncs.com", strings.ToLower(serviceCode), client.RegionId)
+		request.ApiName = apiName
 	}
-	request.Version = string(apiVersion)
-	request.RegionId = client.RegionId
-	request.Product = product
-
-	if strings.ToUpper(product) == "SLB" {
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
+	request.Scheme = client.getRequestScheme()
+	request.SetHTTPSInsecure(client.Config.Insecure)
+	request.Headers = map[string]string{
+		"RegionId":              client.RegionId,
+		"x-acs-regionid":        client.RegionId,
+		"x-acs-organizationid":  client.Department,
+		"x-acs-resourcegroupid": client.ResourceGroup,
+		"x-ascm-product-name":   product,
 	}
-	if strings.ToUpper(product) == "ECS" {
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
+	request.QueryParams = map[string]string{
+		"RegionId":      client.RegionId,
+		"Product":       strings.ToLower(product),
+		"Version":       version,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
 	}
-	if strings.ToUpper(product) == "ASCM" {
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ascm", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
+	if pathPattern == "" {
+		request.QueryParams["Action"] = apiName
 	}
-
 	request.AppendUserAgent(Terraform, TerraformVersion)
 	request.AppendUserAgent(Provider, ProviderVersion)
 	request.AppendUserAgent(Module, client.Config.ConfigurationSource)
+	return request
+}
+
+// InitRpcRequest fills the common scheme, region, department and resource group settings into an
+// alibaba-cloud-sdk-go RPC request created by one of the service packages, e.g. vpc.CreateDescribeVpcsRequest().
+func (client *AlibabacloudStackClient) InitRpcRequest(request *requests.RpcRequest) {
+	request.Scheme = client.getRequestScheme()
+	request.RegionId = client.RegionId
 	request.SetHTTPSInsecure(client.Config.Insecure)
-	return request, nil
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{
+		"Product":       strings.ToLower(request.GetProduct()),
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+	}
 }
 
+func (client *AlibabacloudStackClient) getRequestScheme() string {
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		return "https"
+	}
+	return "http"
+}
+
+// getSdkConfig returns the alibaba-cloud-sdk-go client config. The sdk retries are disabled: it would
+// resend any request which timed out or got a 5xx, creating resources twice, and its retries would
+// multiply the ones of the provider retry policy, which only retries the errors the service did not act on.
 func (client *AlibabacloudStackClient) getSdkConfig() *sdk.Config {
 	log.Printf("Protocol is set to %s", client.Config.Protocol)
-	return sdk.NewConfig().
-		WithMaxRetryTime(DefaultClientRetryCountSmall).
+	config := sdk.NewConfig().
+		WithAutoRetry(false).
+		WithMaxRetryTime(0).
 		WithTimeout(time.Duration(30) * time.Second).
 		WithEnableAsync(true).
 		WithGoRoutinePoolSize(100).
@@ -693,6 +762,10 @@ func (client *AlibabacloudStackClient) getSdkConfig() *sdk.Config {
 		WithDebug(false).
 		WithHttpTransport(client.getTransport()).
 		WithScheme(strings.ToLower(client.Config.Protocol))
+	// The sdk only applies the insecure and proxy settings to a plain *http.Transport,
+	// so the wrapped transport carries them itself.
+	config.Transport = client.getTelemetryTransport("")
+	return config
 }
 
 func (client *AlibabacloudStackClient) getTransport() *http.Transport {
@@ -705,6 +778,30 @@ func (client *AlibabacloudStackClient) getTransport() *http.Transport {
 
 	return transport
 }
+
+// getTelemetryTransport returns the transport recording and rate limiting the requests. The requests
+// without a Product query parameter or x-ascm-product-name header are counted against product.
+func (client *AlibabacloudStackClient) getTelemetryTransport(product string) http.RoundTripper {
+	transport := client.getTransport()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: client.Config.Insecure}
+	transport.Proxy = http.ProxyFromEnvironment
+	if client.Config.Proxy != "" {
+		if proxy, err := url.Parse(client.Config.Proxy); err == nil {
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+	}
+	var roundTripper http.RoundTripper = transport
+	if client.recorder != nil {
+		roundTripper = client.recorder.Transport(transport)
+	}
+	return &telemetryTransport{
+		telemetry:   client.telemetry,
+		rateLimiter: client.rateLimiter,
+		connections: client.clientConnections,
+		product:     product,
+		transport:   roundTripper,
+	}
+}
 func (client *AlibabacloudStackClient) AccountId() (string, error) {
 	client.accountIdMutex.Lock()
 	defer client.accountIdMutex.Unlock()
@@ -804,7 +901,9 @@ func (client *AlibabacloudStackClient) WithKmsClient(do func(*kms.Client) (inter
 		}
 		client.kmsconn = kmsconn
 	}
-	return do(client.kmsconn)
+	return client.invoke(string(KMSCode), func() (interface{}, error) {
+		return do(client.kmsconn)
+	})
 }
 func (client *AlibabacloudStackClient) GetCallerInfo() (*responses.BaseResponse, error) {
 
@@ -846,7 +945,6 @@ func (client *AlibabacloudStackClient) GetCallerInfo() (*responses.BaseResponse,
 	request.Version = "2019-05-10" // Specify product version
 	request.ApiName = "GetUserInfo"
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":  client.Config.SecretKey,
 		"SecurityToken":    client.Config.SecurityToken,
 		"Product":          "ascm",
 		"Department":       client.Config.Department,
@@ -932,10 +1030,12 @@ func (client *AlibabacloudStackClient) WithBssopenapiClient(do func(*bssopenapi.
 		client.bssopenapiconn = bssopenapiconn
 	}
 
-	return do(client.bssopenapiconn)
+	return client.invoke(string(BSSOPENAPICode), func() (interface{}, error) {
+		return do(client.bssopenapiconn)
+	})
 }
 
-func (client *AlibabacloudStackClient) NewNasClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewNasClient() (*TeaRpcClient, error) {
 	productCode := "nas"
 	endpoint := client.Config.NasEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -955,7 +1055,7 @@ func (client *AlibabacloudStackClient) NewNasClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
 func (client *AlibabacloudStackClient) WithOssClientPutObject(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
@@ -995,17 +1095,21 @@ func (client *AlibabacloudStackClient) WithOssClientPutObject(do func(*oss.Clien
 			clientOptions = append(clientOptions, oss.Proxy(client.Config.Proxy))
 		}
 
-		clientOptions = append(clientOptions, oss.UseCname(false))
+		clientOptions = append(clientOptions, oss.UseCname(false), oss.HTTPClient(&http.Client{Transport: client.getTelemetryTransport(string(OSSCode))}))
 
 		ossconn, err := oss.New(endpoint, client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
 		}
 
+		// The requests are retried by the provider retry policy only, see getSdkConfig.
+		ossconn.Config.RetryTimes = 0
 		client.ossconn = ossconn
 	}
 
-	return do(client.ossconn)
+	return client.invoke(string(OSSCode), func() (interface{}, error) {
+		return do(client.ossconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithOssClient(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
@@ -1045,17 +1149,21 @@ func (client *AlibabacloudStackClient) WithOssClient(do func(*oss.Client) (inter
 			clientOptions = append(clientOptions, oss.Proxy(client.Config.Proxy))
 		}
 
-		clientOptions = append(clientOptions, oss.UseCname(false))
+		clientOptions = append(clientOptions, oss.UseCname(false), oss.HTTPClient(&http.Client{Transport: client.getTelemetryTransport(string(OSSCode))}))
 
 		ossconn, err := oss.New(endpoint, client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
 		}
 
+		// The requests are retried by the provider retry policy only, see getSdkConfig.
+		ossconn.Config.RetryTimes = 0
 		client.ossconn = ossconn
 	}
 
-	return do(client.ossconn)
+	return client.invoke(string(OSSCode), func() (interface{}, error) {
+		return do(client.ossconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithRamClient(do func(*ram.Client) (interface{}, error)) (interface{}, error) {
@@ -1086,7 +1194,9 @@ func (client *AlibabacloudStackClient) WithRamClient(do func(*ram.Client) (inter
 		client.ramconn = ramconn
 	}
 
-	return do(client.ramconn)
+	return client.invoke(string(RAMCode), func() (interface{}, error) {
+		return do(client.ramconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithRdsClient(do func(*rds.Client) (interface{}, error)) (interface{}, error) {
@@ -1116,7 +1226,9 @@ func (client *AlibabacloudStackClient) WithRdsClient(do func(*rds.Client) (inter
 		client.rdsconn = rdsconn
 	}
 
-	return do(client.rdsconn)
+	return client.invoke(string(RDSCode), func() (interface{}, error) {
+		return do(client.rdsconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCdnClient_new(do func(*cdn_new.Client) (interface{}, error)) (interface{}, error) {
@@ -1144,7 +1256,9 @@ func (client *AlibabacloudStackClient) WithCdnClient_new(do func(*cdn_new.Client
 		client.cdnconn_new = cdnconn
 	}
 
-	return do(client.cdnconn_new)
+	return client.invoke(string(CDNCode), func() (interface{}, error) {
+		return do(client.cdnconn_new)
+	})
 }
 func (client *AlibabacloudStackClient) getUserAgent() string {
 	return fmt.Sprintf("%s/%s %s/%s %s/%s", Terraform, TerraformVersion, Provider, ProviderVersion, Module, client.Config.ConfigurationSource)
@@ -1178,7 +1292,9 @@ func (client *AlibabacloudStackClient) WithCsClient(do func(*cs.Client) (interfa
 		client.csconn = csconn
 	}
 
-	return do(client.csconn)
+	return client.invoke(string(CONTAINCode), func() (interface{}, error) {
+		return do(client.csconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) getHttpProxyUrl() *url.URL {
@@ -1239,7 +1355,9 @@ func (client *AlibabacloudStackClient) WithOnsClient(do func(*ons.Client) (inter
 		client.onsconn = onsconn
 	}
 
-	return do(client.onsconn)
+	return client.invoke(string(ONSCode), func() (interface{}, error) {
+		return do(client.onsconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithLogClient(do func(*sls.Client) (interface{}, error)) (interface{}, error) {
@@ -1269,7 +1387,12 @@ func (client *AlibabacloudStackClient) WithLogClient(do func(*sls.Client) (inter
 		}
 	}
 
-	return do(client.logconn)
+	return client.invoke(string(LOGCode), client.recordCalls(string(LOGCode), client.logconn.Endpoint, telemetryCallAction(do), func() (interface{}, error) {
+		if err := client.rateLimiter.Wait(client.Context(), string(LOGCode)); err != nil {
+			return nil, err
+		}
+		return do(client.logconn)
+	}))
 }
 func (client *AlibabacloudStackClient) WithLogPopClient(do func(*slsPop.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the HBase client if necessary
@@ -1293,7 +1416,9 @@ func (client *AlibabacloudStackClient) WithLogPopClient(do func(*slsPop.Client)
 		client.logpopconn = logpopconn
 	}
 
-	return do(client.logpopconn)
+	return client.invoke(string(LOGCode), func() (interface{}, error) {
+		return do(client.logpopconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithAlikafkaClient(do func(*alikafka.Client) (interface{}, error)) (interface{}, error) {
@@ -1320,7 +1445,38 @@ func (client *AlibabacloudStackClient) WithAlikafkaClient(do func(*alikafka.Clie
 		client.alikafkaconn = alikafkaconn
 	}
 
-	return do(client.alikafkaconn)
+	return client.invoke(string(ALIKAFKACode), func() (interface{}, error) {
+		return do(client.alikafkaconn)
+	})
+}
+
+func (client *AlibabacloudStackClient) WithCenClient(do func(*cbn.Client) (interface{}, error)) (interface{}, error) {
+	// Initialize the cen client if necessary
+	if client.cenconn == nil {
+		endpoint := client.Config.CenEndpoint
+		if endpoint == "" {
+			endpoint = loadEndpoint(client.Config.RegionId, CENCode)
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.Config.RegionId, string(CENCode), endpoint)
+		}
+		cenconn, err := cbn.NewClientWithOptions(client.Config.RegionId, client.getSdkConfig(), client.Config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the CEN client: %#v", err)
+		}
+		cenconn.SetReadTimeout(time.Duration(client.Config.ClientReadTimeout) * time.Millisecond)
+		cenconn.SetConnectTimeout(time.Duration(client.Config.ClientConnectTimeout) * time.Millisecond)
+		cenconn.SourceIp = client.Config.SourceIp
+		cenconn.SecureTransport = client.Config.SecureTransport
+		cenconn.AppendUserAgent(Terraform, terraformVersion)
+		cenconn.AppendUserAgent(Provider, providerVersion)
+		cenconn.AppendUserAgent(Module, client.Config.ConfigurationSource)
+		client.cenconn = cenconn
+	}
+
+	return client.invoke(string(CENCode), func() (interface{}, error) {
+		return do(client.cenconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithEdasClient(do func(*edas.Client) (interface{}, error)) (interface{}, error) {
@@ -1358,7 +1514,9 @@ func (client *AlibabacloudStackClient) WithEdasClient(do func(*edas.Client) (int
 		client.edasconn = edasconn
 	}
 
-	return do(client.edasconn)
+	return client.invoke(string(EDASCode), func() (interface{}, error) {
+		return do(client.edasconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCrEEClient(do func(*cr_ee.Client) (interface{}, error)) (interface{}, error) {
@@ -1384,7 +1542,9 @@ func (client *AlibabacloudStackClient) WithCrEEClient(do func(*cr_ee.Client) (in
 		client.creeconn = creeconn
 	}
 
-	return do(client.creeconn)
+	return client.invoke(string(CRCode), func() (interface{}, error) {
+		return do(client.creeconn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCrClient(do func(*cr.Client) (interface{}, error)) (interface{}, error) {
@@ -1413,7 +1573,9 @@ func (client *AlibabacloudStackClient) WithCrClient(do func(*cr.Client) (interfa
 		client.crconn = crconn
 	}
 
-	return do(client.crconn)
+	return client.invoke(string(CRCode), func() (interface{}, error) {
+		return do(client.crconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithDnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the DNS client if necessary
@@ -1437,7 +1599,9 @@ func (client *AlibabacloudStackClient) WithDnsClient(do func(*alidns.Client) (in
 		client.dnsconn = dnsconn
 	}
 
-	return do(client.dnsconn)
+	return client.invoke(string(DNSCode), func() (interface{}, error) {
+		return do(client.dnsconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithCmsClient(do func(*cms.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the CMS client if necessary
@@ -1463,7 +1627,9 @@ func (client *AlibabacloudStackClient) WithCmsClient(do func(*cms.Client) (inter
 		}
 	}
 
-	return do(client.cmsconn)
+	return client.invoke(string(CMSCode), func() (interface{}, error) {
+		return do(client.cmsconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithMaxComputeClient(do func(*maxcompute.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
@@ -1496,10 +1662,12 @@ func (client *AlibabacloudStackClient) WithMaxComputeClient(do func(*maxcompute.
 		client.maxcomputeconn = maxcomputeconn
 	}
 
-	return do(client.maxcomputeconn)
+	return client.invoke(string(MAXCOMPUTECode), func() (interface{}, error) {
+		return do(client.maxcomputeconn)
+	})
 }
 
-func (client *AlibabacloudStackClient) NewHitsdbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewHitsdbClient() (*TeaRpcClient, error) {
 	productCode := "hitsdb"
 	endpoint := client.Config.HitsdbEndpoint
 	if endpoint == "" {
@@ -1521,10 +1689,10 @@ func (client *AlibabacloudStackClient) NewHitsdbClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewOdpsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewOdpsClient() (*TeaRpcClient, error) {
 	productCode := "odps"
 	endpoint := client.Config.MaxComputeEndpoint
 	if endpoint == "" {
@@ -1546,9 +1714,9 @@ func (client *AlibabacloudStackClient) NewOdpsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewKmsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewKmsClient() (*TeaRpcClient, error) {
 	productCode := "kms"
 	endpoint := client.Config.KmsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1570,10 +1738,10 @@ func (client *AlibabacloudStackClient) NewKmsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewAscmClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewAscmClient() (*TeaRpcClient, error) {
 	productCode := "ascm"
 	endpoint := client.Config.AscmEndpoint
 	if endpoint == "" {
@@ -1597,9 +1765,9 @@ func (client *AlibabacloudStackClient) NewAscmClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewCloudApiClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewCloudApiClient() (*TeaRpcClient, error) {
 	productCode := "apigateway"
 	endpoint := client.Config.ApigatewayEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1622,9 +1790,9 @@ func (client *AlibabacloudStackClient) NewCloudApiClient() (*rpc.Client, error)
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewAdsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewAdsClient() (*TeaRpcClient, error) {
 	productCode := "ads"
 	endpoint := client.Config.AdbEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1644,10 +1812,10 @@ func (client *AlibabacloudStackClient) NewAdsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewCmsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewCmsClient() (*TeaRpcClient, error) {
 	productCode := "cms"
 	endpoint := client.Config.CmsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1667,10 +1835,10 @@ func (client *AlibabacloudStackClient) NewCmsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewTeaCommonClient(endpoint string) (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewTeaCommonClient(endpoint string) (*TeaRpcClient, error) {
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
 
@@ -1679,7 +1847,7 @@ func (client *AlibabacloudStackClient) NewTeaCommonClient(endpoint string) (*rpc
 		return nil, fmt.Errorf("unable to initialize the tea client: %#v", err)
 	}
 
-	return conn, nil
+	return client.newTeaRpcClient("tea", conn), nil
 }
 
 func (client *AlibabacloudStackClient) WithTableStoreClient(instanceName string, do func(*tablestore.TableStoreClient) (interface{}, error)) (interface{}, error) {
@@ -1704,12 +1872,25 @@ func (client *AlibabacloudStackClient) WithTableStoreClient(instanceName string,
 		// 	endpoint = fmt.Sprintf("https://%s", endpoint)
 		// }
 		// endpoint := "http://test1111.cn-wulan-env212-d01.ots-internal.inter.env212.shuguang.com"
-		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken, tablestore.NewDefaultTableStoreConfig())
+		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken, client.getTableStoreConfig())
 		client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
 	}
 
-	return do(tableStoreClient)
+	endpoint := fmt.Sprintf("%s.%s.ots-internal.aliyuncs.com", instanceName, client.RegionId)
+	return client.invoke(string(OTSCode), client.recordCalls(string(OTSCode), endpoint, telemetryCallAction(do), func() (interface{}, error) {
+		if err := client.rateLimiter.Wait(client.Context(), string(OTSCode)); err != nil {
+			return nil, err
+		}
+		return do(tableStoreClient)
+	}))
 }
+func (client *AlibabacloudStackClient) getTableStoreConfig() *tablestore.TableStoreConfig {
+	config := tablestore.NewDefaultTableStoreConfig()
+	// The TableStore SDK retries would bypass the rate limit, which is waited for once per invoke attempt.
+	config.RetryTimes = 0
+	return config
+}
+
 func (client *AlibabacloudStackClient) WithOtsClient(do func(*ots.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the OTS client if necessary
 	if client.otsconn == nil {
@@ -1720,7 +1901,10 @@ func (client *AlibabacloudStackClient) WithOtsClient(do func(*ots.Client) (inter
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.Config.RegionId, string(OTSCode), endpoint)
 		}
-		otsconn, err := ots.NewClientWithOptions(client.Config.RegionId, client.getSdkConfig(), client.Config.getAuthCredential(true))
+		// The requests are counted against the OTS rate limit even when they carry no Product parameter.
+		config := client.getSdkConfig()
+		config.Transport = client.getTelemetryTransport(string(OTSCode))
+		otsconn, err := ots.NewClientWithOptions(client.Config.RegionId, config, client.Config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OTS client: %#v", err)
 		}
@@ -1735,7 +1919,9 @@ func (client *AlibabacloudStackClient) WithOtsClient(do func(*ots.Client) (inter
 		client.otsconn = otsconn
 	}
 
-	return do(client.otsconn)
+	return client.invoke(string(OTSCode), func() (interface{}, error) {
+		return do(client.otsconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithDataHubClient(do func(api datahub.DataHubApi) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
@@ -1761,14 +1947,18 @@ func (client *AlibabacloudStackClient) WithDataHubClient(do func(api datahub.Dat
 		account := datahub.NewStsCredential(client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
 		config := &datahub.Config{
 			UserAgent: client.getUserAgent(),
+			// The telemetry transport records the requests and waits for the DataHub rate limit.
+			HttpClient: &http.Client{Transport: client.getTelemetryTransport(string(DATAHUBCode))},
 		}
 
 		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
 	}
 
-	return do(client.dhconn)
+	return client.invoke(string(DATAHUBCode), func() (interface{}, error) {
+		return do(client.dhconn)
+	})
 }
-func (client *AlibabacloudStackClient) NewVpcClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewVpcClient() (*TeaRpcClient, error) {
 	productCode := "vpc"
 	endpoint := client.Config.VpcEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1791,9 +1981,9 @@ func (client *AlibabacloudStackClient) NewVpcClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewEcsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewEcsClient() (*TeaRpcClient, error) {
 	productCode := "ecs"
 	endpoint := client.Config.EcsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1816,9 +2006,9 @@ func (client *AlibabacloudStackClient) NewEcsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewElasticsearchClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewElasticsearchClient() (*TeaRpcClient, error) {
 	productCode := "elasticsearch"
 	endpoint := client.Config.ElasticsearchEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1839,10 +2029,10 @@ func (client *AlibabacloudStackClient) NewElasticsearchClient() (*rpc.Client, er
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, err
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewRosClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewRosClient() (*TeaRpcClient, error) {
 	productCode := "ros"
 	endpoint := client.Config.RosEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1862,10 +2052,10 @@ func (client *AlibabacloudStackClient) NewRosClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewRdsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewRdsClient() (*TeaRpcClient, error) {
 	productCode := "rds"
 	endpoint := ""
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1885,7 +2075,7 @@ func (client *AlibabacloudStackClient) NewRdsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
 func (client *AlibabacloudStackClient) NewRoaCsClient() (*roaCS.Client, error) {
@@ -1912,7 +2102,7 @@ func (client *AlibabacloudStackClient) NewRoaCsClient() (*roaCS.Client, error) {
 	return roaCSConn, nil
 }
 
-func (client *AlibabacloudStackClient) NewDtsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDtsClient() (*TeaRpcClient, error) {
 	productCode := "dts"
 	endpoint := client.Config.DtsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1934,10 +2124,10 @@ func (client *AlibabacloudStackClient) NewDtsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*TeaRpcClient, error) {
 	productCode := "dmsenterprise"
 	endpoint := client.Config.DmsEnterpriseEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1959,10 +2149,10 @@ func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*rpc.Client, er
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewHbaseClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewHbaseClient() (*TeaRpcClient, error) {
 	productCode := "hbase"
 	endpoint := ""
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1982,7 +2172,7 @@ func (client *AlibabacloudStackClient) NewHbaseClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
 func (client *AlibabacloudStackClient) WithDrdsClient(do func(*drds.Client) (interface{}, error)) (interface{}, error) {
@@ -2017,9 +2207,11 @@ func (client *AlibabacloudStackClient) WithDrdsClient(do func(*drds.Client) (int
 		client.drdsconn = drdsconn
 	}
 
-	return do(client.drdsconn)
+	return client.invoke(string(DRDSCode), func() (interface{}, error) {
+		return do(client.drdsconn)
+	})
 }
-func (client *AlibabacloudStackClient) NewGpdbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewGpdbClient() (*TeaRpcClient, error) {
 	productCode := "gpdb"
 	endpoint := client.Config.GpdbEndpoint
 
@@ -2043,10 +2235,10 @@ func (client *AlibabacloudStackClient) NewGpdbClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewQuickbiClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewQuickbiClient() (*TeaRpcClient, error) {
 	productCode := "quickbi"
 	endpoint := client.Config.QuickbiEndpoint
 	//endpoint := "quickbi-public.inter.env202.shuguang.com"
@@ -2069,9 +2261,9 @@ func (client *AlibabacloudStackClient) NewQuickbiClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewCsbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewCsbClient() (*TeaRpcClient, error) {
 	productCode := "csb"
 	endpoint := client.Config.CsbEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2093,9 +2285,9 @@ func (client *AlibabacloudStackClient) NewCsbClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewGdbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewGdbClient() (*TeaRpcClient, error) {
 	productCode := "gdb"
 	endpoint := client.Config.GdbEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2117,9 +2309,9 @@ func (client *AlibabacloudStackClient) NewGdbClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*TeaRpcClient, error) {
 	productCode := "dataworkspublic"
 	endpoint := client.Config.DataworkspublicEndpoint
 	//endpoint := "dataworks-public.cloud.ste3.com"
@@ -2142,10 +2334,10 @@ func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*rpc.Client,
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*TeaRpcClient, error) {
 	productCode := "dataworks-private-cloud"
 	endpoint := client.Config.DataworkspublicEndpoint
 	//endpoint := "dataworks.inter.env66.shuguang.com"
@@ -2170,10 +2362,10 @@ func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*rpc.Client,
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewDbsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDbsClient() (*TeaRpcClient, error) {
 	productCode := "dbs"
 	endpoint := client.Config.DbsEndpoint
 	//endpoint := "dbs.inter.env66.shuguang.com"
@@ -2196,9 +2388,9 @@ func (client *AlibabacloudStackClient) NewDbsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewArmsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewArmsClient() (*TeaRpcClient, error) {
 	productCode := "arms"
 	endpoint := client.Config.ArmsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2220,10 +2412,10 @@ func (client *AlibabacloudStackClient) NewArmsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewOosClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewOosClient() (*TeaRpcClient, error) {
 	productCode := "oos"
 	endpoint := client.Config.OosEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2243,9 +2435,9 @@ func (client *AlibabacloudStackClient) NewOosClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewCloudfwClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewCloudfwClient() (*TeaRpcClient, error) {
 	productCode := "cloudfw"
 	endpoint := client.Config.CloudfwEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2265,5 +2457,5 @@ func (client *AlibabacloudStackClient) NewCloudfwClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newTeaRpcClient(productCode, conn), nil
 }
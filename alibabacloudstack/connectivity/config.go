@@ -41,6 +41,14 @@ type Config struct {
 	RamRoleSessionName       string
 	RamRolePolicy            string
 	RamRoleSessionExpiration int
+	TelemetryLogLevel        string
+	TelemetryFile            string
+	MaxRetries               int
+	MaxBackoff               int
+	RetryableErrorCodes      map[string][]string
+	RateLimits               map[string]RateLimit
+	EndpointDiscovery        *EndpointDiscovery
+	discoveredEndpoints      map[string]string
 
 	Endpoints               map[string]interface{}
 	EcsEndpoint             string
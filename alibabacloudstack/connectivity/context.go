@@ -0,0 +1,55 @@
+package connectivity
+
+import (
+	"context"
+	"time"
+)
+
+// WithContext returns a copy of the client bound to ctx. The copy shares the product clients of the
+// client, and its API calls, retries and rate limit waits stop as soon as ctx is done.
+func (client *AlibabacloudStackClient) WithContext(ctx context.Context) *AlibabacloudStackClient {
+	if ctx == nil || ctx == client.ctx {
+		return client
+	}
+	c := *client
+	c.ctx = ctx
+	return &c
+}
+
+// Context returns the context the client is bound to, or the provider stop context if the client is
+// not bound to any.
+func (client *AlibabacloudStackClient) Context() context.Context {
+	if client.ctx != nil {
+		return client.ctx
+	}
+	if client.clientConnections != nil && client.stopCtx != nil {
+		return client.stopCtx
+	}
+	return context.Background()
+}
+
+// SetStopContext sets the context which is done when Terraform stops the provider, e.g. on Ctrl-C.
+// The in-flight alibaba-cloud-sdk-go and OSS requests are cancelled when it is done.
+func (client *AlibabacloudStackClient) SetStopContext(ctx context.Context) {
+	client.stopCtx = ctx
+}
+
+// invoke calls do following the retry policy of product, until the client context is done.
+func (client *AlibabacloudStackClient) invoke(product string, do func() (interface{}, error)) (interface{}, error) {
+	return client.getRetryPolicy().Retry(client.Context(), product, do)
+}
+
+// sleepWithContext waits d, or returns the context error as soon as ctx is done.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
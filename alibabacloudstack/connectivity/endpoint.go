@@ -1,11 +1,19 @@
 package connectivity
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/location"
+	"github.com/mitchellh/go-homedir"
 )
 
 // ServiceCode Load endpoints from endpoints.xml or environment variables to meet specified application scenario, like private cloud.
@@ -166,13 +174,19 @@ func (client *AlibabacloudStackClient) loadEndpoint(productCode string) error {
 		return nil
 	}
 
-	// Secondly, load endpoint from known rules
+	// Load endpoint from known rules
 	// Currently, this way is not pass.
 	// if _, ok := irregularProductCode[productCode]; !ok {
 	// 	client.config.Endpoints[productCode] = regularEndpoint
 	// 	return nil
 	// }
 
+	// Secondly, load endpoint from the discovered ones
+	if endpoint := client.Config.discoveredEndpoint(productCode); endpoint != "" {
+		client.Config.Endpoints[productCode] = endpoint
+		return nil
+	}
+
 	// Thirdly, load endpoint from location
 	serviceCode := serviceCodeMapping[productCode]
 	if serviceCode == "" {
@@ -194,3 +208,319 @@ const (
 	OpenDatahubService    = "datahub.aliyuncs.com"
 	OpenApiGatewayService = "apigateway.cn-hangzhou.aliyuncs.com"
 )
+
+// DefaultEndpointCacheTTL is the time the discovered endpoints are cached when endpoint_discovery sets
+// no cache_ttl.
+const DefaultEndpointCacheTTL = 24 * time.Hour
+
+// EndpointDiscovery enables the discovery of the product endpoints from the location service of the
+// stack. The endpoints of a region are listed once and cached to CacheDir for CacheTTL, so the next
+// runs do not query the location service again.
+type EndpointDiscovery struct {
+	CacheDir string
+	CacheTTL time.Duration
+}
+
+type endpointCache struct {
+	LocationEndpoint string            `json:"location_endpoint"`
+	RegionId         string            `json:"region_id"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	Endpoints        map[string]string `json:"endpoints"`
+}
+
+// The location service product codes which differ from the endpoints block keys.
+var discoveredProductMapping = map[string]string{
+	"r_kvstore":        "kvstore",
+	"sls":              "log",
+	"odps":             "maxcompute",
+	"cloudapi":         "apigateway",
+	"dataworks_public": "dataworkspublic",
+	"dms":              "dms_enterprise",
+	"dmsenterprise":    "dms_enterprise",
+	"hbase_pop":        "hbase",
+}
+
+// DiscoverEndpoints sets the endpoint of every product returned by the location service for the
+// region, replacing the domain ones. The caller applies the explicit endpoints again afterwards, so
+// they still win. A stale cache is used when the location service cannot be reached.
+func (client *AlibabacloudStackClient) DiscoverEndpoints() error {
+	c := client.Config
+	if c.EndpointDiscovery == nil {
+		return nil
+	}
+	if c.LocationEndpoint == "" {
+		return fmt.Errorf("endpoint discovery requires the location service endpoint, please set the domain or the location endpoint in the endpoints block")
+	}
+	path, err := c.endpointCachePath()
+	if err != nil {
+		return err
+	}
+	cache, err := readEndpointCache(path)
+	if err != nil {
+		log.Printf("[WARN] unable to read the endpoint cache %s: %s", path, err)
+	}
+	if cache == nil || time.Since(cache.UpdatedAt) >= c.EndpointDiscovery.CacheTTL {
+		endpoints, err := client.listEndpoints()
+		if err != nil {
+			if cache == nil {
+				return err
+			}
+			log.Printf("[WARN] using the endpoints of region %s cached at %s: %s", c.RegionId, cache.UpdatedAt.Format(time.RFC3339), err)
+		} else {
+			cache = &endpointCache{
+				LocationEndpoint: c.LocationEndpoint,
+				RegionId:         c.RegionId,
+				UpdatedAt:        time.Now(),
+				Endpoints:        endpoints,
+			}
+			if err := writeEndpointCache(path, cache); err != nil {
+				log.Printf("[WARN] unable to write the endpoint cache %s: %s", path, err)
+			}
+		}
+	}
+
+	c.discoveredEndpoints = make(map[string]string)
+	for product, endpoint := range cache.Endpoints {
+		product = normalizeDiscoveredProduct(product)
+		c.discoveredEndpoints[product] = endpoint
+		if field := c.endpointField(product); field != nil && endpoint != "" {
+			*field = endpoint
+		}
+		// The log client sends its requests to the SLS OpenAPI endpoint, not to the log one.
+		if product == "log" && endpoint != "" {
+			c.SLSOpenAPIEndpoint = endpoint
+		}
+	}
+	log.Printf("[DEBUG] discovered the endpoints of %d products in region %s", len(c.discoveredEndpoints), c.RegionId)
+	return nil
+}
+
+// listEndpoints lists the endpoints of the region with the sdk config of the other clients, so the
+// request is rate limited, recorded and retried by the retry policy like theirs.
+func (client *AlibabacloudStackClient) listEndpoints() (map[string]string, error) {
+	c := client.Config
+	conn, err := location.NewClientWithOptions(c.RegionId, client.getSdkConfig(), c.getAuthCredential(true))
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the location client: %#v", err)
+	}
+	conn.AppendUserAgent(Terraform, TerraformVersion)
+	conn.AppendUserAgent(Provider, ProviderVersion)
+	conn.AppendUserAgent(Module, c.ConfigurationSource)
+	conn.SetHTTPSInsecure(c.Insecure)
+	if c.Proxy != "" {
+		conn.SetHttpProxy(c.Proxy)
+		conn.SetHttpsProxy(c.Proxy)
+	}
+
+	request := location.CreateListEndpointsRequest()
+	request.Domain = c.LocationEndpoint
+	request.Scheme = strings.ToLower(c.Protocol)
+	request.Id = c.RegionId
+	request.Headers["x-ascm-product-name"] = "Location"
+	request.QueryParams["Product"] = "location"
+	if c.Department != "" {
+		request.QueryParams["Department"] = c.Department
+		request.QueryParams["ResourceGroup"] = c.ResourceGroup
+	}
+	raw, err := client.invoke("location", func() (interface{}, error) {
+		return conn.ListEndpoints(request)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the endpoints of region %s from %s: %#v", c.RegionId, c.LocationEndpoint, err)
+	}
+	response, _ := raw.(*location.ListEndpointsResponse)
+	endpoints := make(map[string]string)
+	for _, e := range response.EndpointList.ItemEndpoint {
+		if e.Endpoint == "" || e.Type != "" && e.Type != "openAPI" {
+			continue
+		}
+		endpoints[strings.ToLower(e.Product)] = e.Endpoint
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("there is no any available endpoint in region %s from %s", c.RegionId, c.LocationEndpoint)
+	}
+	return endpoints, nil
+}
+
+var endpointCacheNameRegexp = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// The stacks may use the same region ids, so the cache of a region is named after the location
+// service endpoint too.
+func (c *Config) endpointCachePath() (string, error) {
+	dir := c.EndpointDiscovery.CacheDir
+	if dir == "" {
+		home, err := homedir.Dir()
+		if err != nil {
+			return "", fmt.Errorf("unable to find the home directory to cache the endpoints: %#v", err)
+		}
+		dir = filepath.Join(home, ".terraform.d", "alibabacloudstack")
+	}
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("endpoints_%s_%s.json", c.LocationEndpoint, c.RegionId)
+	return filepath.Join(dir, endpointCacheNameRegexp.ReplaceAllString(name, "_")), nil
+}
+
+func readEndpointCache(path string) (*endpointCache, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache := &endpointCache{}
+	if err := json.Unmarshal(data, cache); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// writeEndpointCache replaces the cache file at once, so the concurrent runs never read a partial one.
+func writeEndpointCache(path string, cache *endpointCache) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func normalizeDiscoveredProduct(product string) string {
+	product = strings.Replace(strings.ToLower(strings.TrimSpace(product)), "-", "_", -1)
+	if v, ok := discoveredProductMapping[product]; ok {
+		return v
+	}
+	return product
+}
+
+func (c *Config) discoveredEndpoint(product string) string {
+	return c.discoveredEndpoints[normalizeDiscoveredProduct(product)]
+}
+
+// productEndpoint returns the endpoint of the product, e.g. "Ecs", or the domain if it has none.
+func (c *Config) productEndpoint(product string) string {
+	if field := c.endpointField(normalizeDiscoveredProduct(product)); field != nil && *field != "" {
+		return *field
+	}
+	if endpoint := c.discoveredEndpoint(product); endpoint != "" {
+		return endpoint
+	}
+	return c.Domain
+}
+
+// endpointField returns the Config field holding the endpoint of the product, named as in the
+// endpoints block.
+func (c *Config) endpointField(product string) *string {
+	switch product {
+	case "ecs":
+		return &c.EcsEndpoint
+	case "vpc":
+		return &c.VpcEndpoint
+	case "slb":
+		return &c.SlbEndpoint
+	case "oss":
+		return &c.OssEndpoint
+	case "ascm":
+		return &c.AscmEndpoint
+	case "rds":
+		return &c.RdsEndpoint
+	case "ons":
+		return &c.OnsEndpoint
+	case "kms":
+		return &c.KmsEndpoint
+	case "log":
+		return &c.LogEndpoint
+	case "cr":
+		return &c.CrEndpoint
+	case "ess":
+		return &c.EssEndpoint
+	case "dns":
+		return &c.DnsEndpoint
+	case "kvstore":
+		return &c.KVStoreEndpoint
+	case "gpdb":
+		return &c.GpdbEndpoint
+	case "dds":
+		return &c.DdsEndpoint
+	case "cs":
+		return &c.CsEndpoint
+	case "cms":
+		return &c.CmsEndpoint
+	case "hitsdb":
+		return &c.HitsdbEndpoint
+	case "maxcompute":
+		return &c.MaxComputeEndpoint
+	case "ots":
+		return &c.OtsEndpoint
+	case "datahub":
+		return &c.DatahubEndpoint
+	case "edas":
+		return &c.EdasEndpoint
+	case "adb":
+		return &c.AdbEndpoint
+	case "ros":
+		return &c.RosEndpoint
+	case "dts":
+		return &c.DtsEndpoint
+	case "alikafka":
+		return &c.AlikafkaEndpoint
+	case "nas":
+		return &c.NasEndpoint
+	case "apigateway":
+		return &c.ApigatewayEndpoint
+	case "dms_enterprise":
+		return &c.DmsEnterpriseEndpoint
+	case "hbase":
+		return &c.HBaseEndpoint
+	case "drds":
+		return &c.DrdsEndpoint
+	case "quickbi":
+		return &c.QuickbiEndpoint
+	case "elasticsearch":
+		return &c.ElasticsearchEndpoint
+	case "dataworkspublic":
+		return &c.DataworkspublicEndpoint
+	case "dbs":
+		return &c.DbsEndpoint
+	case "oos":
+		return &c.OosEndpoint
+	case "arms":
+		return &c.ArmsEndpoint
+	case "cloudfw":
+		return &c.CloudfwEndpoint
+	case "csb":
+		return &c.CsbEndpoint
+	case "gdb":
+		return &c.GdbEndpoint
+	case "sts":
+		return &c.StsEndpoint
+	case "cen":
+		return &c.CenEndpoint
+	case "ram":
+		return &c.RamEndpoint
+	case "polardb":
+		return &c.PolarDBEndpoint
+	case "fc":
+		return &c.FcEndpoint
+	case "location":
+		return &c.LocationEndpoint
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package connectivity
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiscoverEndpointsFromCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "endpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cache := fmt.Sprintf(`{"location_endpoint": "location.example.com", "region_id": "cn-test", "updated_at": %q,
+		"endpoints": {"ecs": "ecs.example.com", "r-kvstore": "kvstore.example.com", "Sls": "log.example.com"}}`, time.Now().Format(time.RFC3339))
+	if err := ioutil.WriteFile(filepath.Join(dir, "endpoints_location.example.com_cn-test.json"), []byte(cache), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{
+		RegionId:          "cn-test",
+		LocationEndpoint:  "location.example.com",
+		EcsEndpoint:       "domain.example.com",
+		VpcEndpoint:       "domain.example.com",
+		EndpointDiscovery: &EndpointDiscovery{CacheDir: dir, CacheTTL: time.Hour},
+	}
+	client := &AlibabacloudStackClient{Config: config}
+	if err := client.DiscoverEndpoints(); err != nil {
+		t.Fatalf("DiscoverEndpoints: %s", err)
+	}
+	cases := []struct{ actual, expected string }{
+		{config.EcsEndpoint, "ecs.example.com"},
+		{config.KVStoreEndpoint, "kvstore.example.com"},
+		{config.LogEndpoint, "log.example.com"},
+		{config.SLSOpenAPIEndpoint, "log.example.com"},
+		{config.VpcEndpoint, "domain.example.com"},
+	}
+	for _, c := range cases {
+		if c.actual != c.expected {
+			t.Errorf("expected endpoint %s, got %s", c.expected, c.actual)
+		}
+	}
+}
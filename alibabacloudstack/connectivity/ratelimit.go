@@ -0,0 +1,121 @@
+package connectivity
+
+import (
+	"context"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit is the number of requests per second the provider sends to a product, with bursts of up
+// to Burst requests. A Burst of 0 allows bursts of one second of requests.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiter holds one token bucket per lower case product code. It is shared by every resource of a
+// run, so the requests to a product never exceed its limit whatever the Terraform parallelism. The
+// limit set for AllProducts applies to every product without a limit of its own.
+type rateLimiter struct {
+	limits  map[string]RateLimit
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func newRateLimiter(limits map[string]RateLimit) *rateLimiter {
+	limiter := &rateLimiter{
+		limits:  make(map[string]RateLimit),
+		buckets: make(map[string]*tokenBucket),
+	}
+	for product, limit := range limits {
+		if limit.RequestsPerSecond > 0 {
+			limiter.limits[strings.ToLower(product)] = limit
+		}
+	}
+	return limiter
+}
+
+// Wait blocks until the product bucket has a token for the next request, or returns the context
+// error as soon as ctx is done. The token of a cancelled wait is given back to the bucket, so the
+// requests queued behind it do not wait for a request which is never sent.
+func (l *rateLimiter) Wait(ctx context.Context, product string) error {
+	if l == nil || len(l.limits) == 0 {
+		return nil
+	}
+	bucket := l.bucket(strings.ToLower(product))
+	if bucket == nil {
+		return nil
+	}
+	wait := bucket.reserve(time.Now())
+	if wait > 0 {
+		log.Printf("[TRACE] the %s requests are rate limited, waiting %s", product, wait)
+	}
+	if err := sleepWithContext(ctx, wait); err != nil {
+		bucket.cancel()
+		return err
+	}
+	return nil
+}
+
+func (l *rateLimiter) bucket(product string) *tokenBucket {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if bucket, ok := l.buckets[product]; ok {
+		return bucket
+	}
+	limit, ok := l.limits[product]
+	if !ok {
+		if limit, ok = l.limits[AllProducts]; !ok {
+			return nil
+		}
+	}
+	bucket := newTokenBucket(limit)
+	l.buckets[product] = bucket
+	return bucket
+}
+
+type tokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(limit.RequestsPerSecond))
+	}
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before the token is available.
+// The tokens may go negative, which queues the concurrent callers one after the other.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a token taken by reserve.
+func (b *tokenBucket) cancel() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
@@ -0,0 +1,82 @@
+package connectivity
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 2, Burst: 2})
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if wait := bucket.reserve(now); wait != 0 {
+			t.Errorf("reserve %d: expected no wait within the burst, got %s", i, wait)
+		}
+	}
+	if wait := bucket.reserve(now); wait != 500*time.Millisecond {
+		t.Errorf("expected to wait 500ms once the burst is spent, got %s", wait)
+	}
+	if wait := bucket.reserve(now); wait != time.Second {
+		t.Errorf("expected the next caller to be queued for 1s, got %s", wait)
+	}
+	if wait := bucket.reserve(now.Add(2 * time.Second)); wait != 0 {
+		t.Errorf("expected the bucket to be refilled after 2s, got %s", wait)
+	}
+
+	bucket = newTokenBucket(RateLimit{RequestsPerSecond: 2.5})
+	if bucket.burst != 3 {
+		t.Errorf("expected a default burst of one second of requests, got %v", bucket.burst)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := newRateLimiter(map[string]RateLimit{"ECS": {RequestsPerSecond: 1, Burst: 1}})
+	ctx := context.Background()
+	if err := limiter.Wait(ctx, "ecs"); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+	// The products without a limit are never blocked.
+	for i := 0; i < 10; i++ {
+		if err := limiter.Wait(ctx, "vpc"); err != nil {
+			t.Fatalf("Wait: %s", err)
+		}
+	}
+	if len(limiter.buckets) != 1 || limiter.buckets["ecs"] == nil {
+		t.Errorf("expected a single ecs bucket, got %v", limiter.buckets)
+	}
+
+	start := time.Now()
+	limiter = newRateLimiter(map[string]RateLimit{AllProducts: {RequestsPerSecond: 20, Burst: 1}})
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx, "ECS"); err != nil {
+			t.Fatalf("Wait: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected to be blocked once the burst is spent, waited %s", elapsed)
+	}
+	if err := limiter.Wait(ctx, "VPC"); err != nil || time.Since(start) > time.Second {
+		t.Errorf("expected every product to get its own bucket, got %v", err)
+	}
+}
+
+func TestRateLimiterWaitCancel(t *testing.T) {
+	limiter := newRateLimiter(map[string]RateLimit{"ecs": {RequestsPerSecond: 0.1, Burst: 1}})
+	if err := limiter.Wait(context.Background(), "ecs"); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := limiter.Wait(ctx, "ecs"); err != context.DeadlineExceeded {
+		t.Errorf("expected the context error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected Wait to return once the context is done, waited %s", elapsed)
+	}
+	// The cancelled wait gave its token back, so the next caller only waits for the first one.
+	if wait := limiter.buckets["ecs"].reserve(time.Now()); wait > 10*time.Second {
+		t.Errorf("expected the cancelled token to be given back, got a wait of %s", wait)
+	}
+}
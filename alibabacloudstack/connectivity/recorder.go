@@ -0,0 +1,459 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// RecorderMode is the mode of a Recorder.
+type RecorderMode string
+
+const (
+	// RecorderModeRecord sends the requests to the stack and appends them to the cassette.
+	RecorderModeRecord = RecorderMode("record")
+	// RecorderModeReplay answers the requests from the cassette without any network access.
+	RecorderModeReplay = RecorderMode("replay")
+)
+
+// The request parameters which change from one call to another or from one stack to another. They
+// are neither recorded nor compared when matching a request.
+var recorderIgnoredParams = map[string]bool{
+	"accesskeyid":      true,
+	"securitytoken":    true,
+	"signature":        true,
+	"signaturemethod":  true,
+	"signaturenonce":   true,
+	"signaturetype":    true,
+	"signatureversion": true,
+	"timestamp":        true,
+	"clienttoken":      true,
+	"regionid":         true,
+	"department":       true,
+	"resourcegroup":    true,
+	"organizationid":   true,
+	"resourcegroupid":  true,
+	"expires":          true,
+}
+
+// The response headers kept in the cassettes.
+var recorderKeptHeaders = []string{"Content-Type", "Etag", "Last-Modified", "X-Acs-Request-Id", "X-Oss-Request-Id", "X-Log-Requestid", "X-Oss-Version-Id"}
+
+// Cassette holds the API calls recorded for one test, in the order they were sent.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded API call.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request used to match it on replay. The signing, credential and
+// organization parameters are dropped and the secrets are masked.
+type RecordedRequest struct {
+	Method string            `json:"method"`
+	Path   string            `json:"path"`
+	Query  map[string]string `json:"query,omitempty"`
+	Form   map[string]string `json:"form,omitempty"`
+	Body   string            `json:"body,omitempty"`
+}
+
+// RecordedResponse is the response answered on replay. A Body which is not valid UTF-8, e.g. a
+// compressed log group, is base64 encoded.
+type RecordedResponse struct {
+	Status       int               `json:"status"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Body         string            `json:"body,omitempty"`
+	BodyIsBase64 bool              `json:"body_is_base64,omitempty"`
+}
+
+// Recorder records the API calls of the provider to a cassette file, or replays them from it. It
+// plugs into the alibaba-cloud-sdk-go and OSS transports and into the tea rpc clients, so the
+// resources can be tested without a stack. The SLS client is only reached by swapping the process
+// http.DefaultTransport, see SetRecorder, and no cassette covers it yet. On replay a request is answered by the first recorded
+// interaction matching it which was not answered yet, or by the last matching one once they are
+// all answered, so the status polling loops do not need to be recorded up to the same attempt.
+type Recorder struct {
+	mode     RecorderMode
+	path     string
+	mutex    sync.Mutex
+	cassette *Cassette
+	answered map[*Interaction]bool
+}
+
+// NewRecorder returns a recorder of the cassette at path. On replay the cassette must exist, on
+// record it is created or truncated.
+func NewRecorder(mode RecorderMode, path string) (*Recorder, error) {
+	recorder := &Recorder{
+		mode:     mode,
+		path:     path,
+		cassette: &Cassette{},
+		answered: make(map[*Interaction]bool),
+	}
+	switch mode {
+	case RecorderModeReplay:
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the cassette %s: %#v", path, err)
+		}
+		if err := json.Unmarshal(data, recorder.cassette); err != nil {
+			return nil, fmt.Errorf("unable to parse the cassette %s: %#v", path, err)
+		}
+	case RecorderModeRecord:
+		if err := recorder.save(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid recorder mode %q, expected %q or %q", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+	return recorder, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Transport returns the transport recording the requests sent through transport, or replaying them
+// without sending them.
+func (r *Recorder) Transport(transport http.RoundTripper) http.RoundTripper {
+	return &recorderTransport{recorder: r, transport: transport}
+}
+
+type recorderTransport struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+func (t *recorderTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded := newRecordedRequest(request.Method, request.URL.Path, request.URL.Query(), request.Header.Get("Content-Type"), body)
+
+	if t.recorder.mode == RecorderModeReplay {
+		interaction, err := t.recorder.match(recorded)
+		if err != nil {
+			return nil, err
+		}
+		return interaction.Response.httpResponse(request)
+	}
+
+	response, err := t.transport.RoundTrip(request)
+	if err != nil {
+		return response, err
+	}
+	content, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = ioutil.NopCloser(bytes.NewReader(content))
+	if err != nil {
+		return response, err
+	}
+	interaction := &Interaction{Request: recorded, Response: RecordedResponse{Status: response.StatusCode, Headers: make(map[string]string)}}
+	for _, header := range recorderKeptHeaders {
+		if v := response.Header.Get(header); v != "" {
+			interaction.Response.Headers[header] = v
+		}
+	}
+	interaction.Response.setBody(content)
+	return response, t.recorder.append(interaction)
+}
+
+// wrapTeaRequest returns doRequest recording the tea rpc calls, or replaying them. The tea clients
+// build their own transport, so they are recorded at the request level.
+func (r *Recorder) wrapTeaRequest(doRequest teaDoRequestFunc) teaDoRequestFunc {
+	return func(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+		params := url.Values{"Action": {tea.StringValue(action)}, "Version": {tea.StringValue(version)}}
+		flattenRecordedParams(params, "", query)
+		form := url.Values{}
+		flattenRecordedParams(form, "", body)
+		recorded := newRecordedRequest(strings.ToUpper(tea.StringValue(method)), "/", params, "", nil)
+		recorded.Form = recordedParams(form)
+
+		if r.mode == RecorderModeReplay {
+			interaction, err := r.match(recorded)
+			if err != nil {
+				return nil, err
+			}
+			return interaction.Response.teaResponse()
+		}
+
+		response, err := doRequest(action, protocol, method, version, authType, query, body, runtime)
+		interaction := &Interaction{Request: recorded, Response: RecordedResponse{Status: http.StatusOK}}
+		var content []byte
+		if e, ok := err.(*tea.SDKError); ok {
+			interaction.Response.Status = tea.IntValue(e.StatusCode)
+			var data interface{}
+			if json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) != nil {
+				data = tea.StringValue(e.Data)
+			}
+			content, _ = json.Marshal(map[string]interface{}{"Code": tea.StringValue(e.Code), "Message": tea.StringValue(e.Message), "Data": data})
+		} else if err != nil {
+			return response, err
+		} else {
+			content, _ = json.Marshal(response)
+		}
+		interaction.Response.setBody(content)
+		if e := r.append(interaction); e != nil {
+			return response, e
+		}
+		return response, err
+	}
+}
+
+// Unanswered returns the recorded requests which were not replayed yet.
+func (r *Recorder) Unanswered() []RecordedRequest {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var requests []RecordedRequest
+	for _, interaction := range r.cassette.Interactions {
+		if !r.answered[interaction] {
+			requests = append(requests, interaction.Request)
+		}
+	}
+	return requests
+}
+
+func (r *Recorder) match(request RecordedRequest) (*Interaction, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := request.key()
+	var last *Interaction
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.Request.key() != key {
+			continue
+		}
+		if !r.answered[interaction] {
+			r.answered[interaction] = true
+			return interaction, nil
+		}
+		last = interaction
+	}
+	if last == nil {
+		return nil, fmt.Errorf("the cassette %s has no interaction for the request %s", r.path, key)
+	}
+	return last, nil
+}
+
+func (r *Recorder) append(interaction *Interaction) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return r.save()
+}
+
+// save rewrites the cassette after every recorded call, so it is complete whenever the test stops.
+func (r *Recorder) save() error {
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+func newRecordedRequest(method, path string, query url.Values, contentType string, body []byte) RecordedRequest {
+	request := RecordedRequest{
+		Method: method,
+		Path:   path,
+		Query:  recordedParams(query),
+	}
+	if len(body) == 0 {
+		return request
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			request.Form = recordedParams(form)
+			return request
+		}
+	}
+	if utf8.Valid(body) {
+		request.Body = string(body)
+	} else {
+		request.Body = base64.StdEncoding.EncodeToString(body)
+	}
+	return request
+}
+
+func recordedParams(values url.Values) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	params := make(map[string]string)
+	for k, v := range values {
+		if recorderIgnoredParams[strings.ToLower(k)] {
+			continue
+		}
+		params[k] = strings.Join(v, ",")
+		if isRecorderSecret(k) {
+			params[k] = "******"
+		}
+	}
+	return params
+}
+
+func isRecorderSecret(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range []string{"password", "secret", "privatekey", "token"} {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenRecordedParams flattens the tea request parameters the way they are sent, e.g. Tag.1.Key.
+func flattenRecordedParams(values url.Values, prefix string, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		for k, item := range v {
+			flattenRecordedParams(values, joinRecordedKey(prefix, k), item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flattenRecordedParams(values, joinRecordedKey(prefix, fmt.Sprint(i+1)), item)
+		}
+	case map[string]string:
+		for k, item := range v {
+			values.Set(joinRecordedKey(prefix, k), item)
+		}
+	case []string:
+		for i, item := range v {
+			values.Set(joinRecordedKey(prefix, fmt.Sprint(i+1)), item)
+		}
+	case *string:
+		if v != nil {
+			values.Set(prefix, *v)
+		}
+	case string:
+		values.Set(prefix, v)
+	default:
+		values.Set(prefix, fmt.Sprint(v))
+	}
+}
+
+func joinRecordedKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func (request RecordedRequest) key() string {
+	key := request.Method + " " + request.Path
+	for _, params := range []map[string]string{request.Query, request.Form} {
+		keys := make([]string, 0, len(params))
+		for k := range params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for i, k := range keys {
+			keys[i] = k + "=" + params[k]
+		}
+		key += " " + strings.Join(keys, "&")
+	}
+	return strings.TrimSpace(key + " " + request.Body)
+}
+
+func (response *RecordedResponse) setBody(content []byte) {
+	if utf8.Valid(content) {
+		response.Body = string(content)
+		return
+	}
+	response.Body = base64.StdEncoding.EncodeToString(content)
+	response.BodyIsBase64 = true
+}
+
+func (response RecordedResponse) content() ([]byte, error) {
+	if response.BodyIsBase64 {
+		return base64.StdEncoding.DecodeString(response.Body)
+	}
+	return []byte(response.Body), nil
+}
+
+func (response RecordedResponse) httpResponse(request *http.Request) (*http.Response, error) {
+	content, err := response.content()
+	if err != nil {
+		return nil, err
+	}
+	header := make(http.Header)
+	for k, v := range response.Headers {
+		header.Set(k, v)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.Status, http.StatusText(response.Status)),
+		StatusCode:    response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       request,
+	}, nil
+}
+
+func (response RecordedResponse) teaResponse() (map[string]interface{}, error) {
+	content, err := response.content()
+	if err != nil {
+		return nil, err
+	}
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(content, &body); err != nil {
+		return nil, err
+	}
+	if response.Status < http.StatusBadRequest {
+		return body, nil
+	}
+	return nil, tea.NewSDKError(map[string]interface{}{
+		"code":       body["Code"],
+		"message":    body["Message"],
+		"data":       body["Data"],
+		"statusCode": response.Status,
+	})
+}
+
+// defaultTransport is the http.DefaultTransport of the process. The SLS client has no transport of
+// its own, so it sends its requests through http.DefaultTransport.
+var defaultTransport = http.DefaultTransport
+
+// Close restores the http.DefaultTransport replaced by SetRecorder.
+func (r *Recorder) Close() {
+	if t, ok := http.DefaultTransport.(*recorderTransport); ok && t.recorder == r {
+		http.DefaultTransport = defaultTransport
+	}
+}
+
+// SetRecorder records or replays the API calls of the client with recorder. It must be set before
+// the product clients are used. The SLS client has no transport option, so the recorder replaces the
+// http.DefaultTransport of the process until it is closed.
+func (client *AlibabacloudStackClient) SetRecorder(recorder *Recorder) {
+	client.recorder = recorder
+	if recorder != nil {
+		http.DefaultTransport = recorder.Transport(defaultTransport)
+	}
+}
@@ -0,0 +1,210 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	DefaultMaxRetries  = 5
+	DefaultMaxBackoff  = 30 * time.Second
+	DefaultBaseBackoff = time.Second
+)
+
+// AllProducts is the RetryableErrorCodes key whose error codes are retried for every product.
+const AllProducts = "*"
+
+// DefaultRetryableErrorCodes are always retried, in addition to the codes set by retryable_error_codes.
+// A code ending with ".*" matches every code with that prefix.
+var DefaultRetryableErrorCodes = map[string][]string{
+	AllProducts: {"Throttling", "Throttling.*", "Rejected.Throttling", "ServiceUnavailable", "ServiceBusy", "SystemBusy"},
+	"log":       {"ServerBusy", "WriteQuotaExceed", "ReadQuotaExceed"},
+	"ots":       {"OTSServerBusy", "OTSQuotaExhausted", "OTSServerUnavailable", "OTSTimeout"},
+	"datahub":   {"LimitExceeded", "ServiceInProcess"},
+}
+
+// RetryPolicy is the retry behaviour shared by every client of the provider. A call failing with a
+// retryable error is retried up to MaxRetries times, waiting an exponential backoff with jitter capped
+// at MaxBackoff between two attempts.
+type RetryPolicy struct {
+	MaxRetries  int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// RetryableErrorCodes maps a lower case product code, e.g. "ecs", to its retryable error codes.
+	RetryableErrorCodes map[string][]string
+}
+
+// NewRetryPolicy returns the policy built from the provider settings. A negative maxRetries or a zero
+// maxBackoff falls back to the defaults, and retryableErrorCodes extends DefaultRetryableErrorCodes.
+func NewRetryPolicy(maxRetries int, maxBackoff time.Duration, retryableErrorCodes map[string][]string) *RetryPolicy {
+	policy := &RetryPolicy{
+		MaxRetries:          maxRetries,
+		BaseBackoff:         DefaultBaseBackoff,
+		MaxBackoff:          maxBackoff,
+		RetryableErrorCodes: make(map[string][]string),
+	}
+	if policy.MaxRetries < 0 {
+		policy.MaxRetries = DefaultMaxRetries
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DefaultMaxBackoff
+	}
+	if policy.BaseBackoff > policy.MaxBackoff {
+		policy.BaseBackoff = policy.MaxBackoff
+	}
+	for _, codes := range []map[string][]string{DefaultRetryableErrorCodes, retryableErrorCodes} {
+		for product, v := range codes {
+			product = strings.ToLower(strings.TrimSpace(product))
+			if product == "" {
+				product = AllProducts
+			}
+			policy.RetryableErrorCodes[product] = append(policy.RetryableErrorCodes[product], v...)
+		}
+	}
+	return policy
+}
+
+var retryPolicy = NewRetryPolicy(DefaultMaxRetries, DefaultMaxBackoff, nil)
+var retryPolicyMutex sync.RWMutex
+
+// CurrentRetryPolicy returns the policy of the last configured provider. It is used by the retry
+// helpers which have no access to the client, like the Invoker and the resource.Retry loops.
+func CurrentRetryPolicy() *RetryPolicy {
+	retryPolicyMutex.RLock()
+	defer retryPolicyMutex.RUnlock()
+	return retryPolicy
+}
+
+func setCurrentRetryPolicy(policy *RetryPolicy) {
+	retryPolicyMutex.Lock()
+	defer retryPolicyMutex.Unlock()
+	retryPolicy = policy
+}
+
+// Backoff returns the time to wait before the retry following the given attempt, counted from 0.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	return p.BackoffFrom(p.BaseBackoff, attempt)
+}
+
+// BackoffFrom is Backoff with its own base duration. The wait doubles with every attempt, is capped
+// at MaxBackoff and is jittered to a random value between its half and its whole.
+func (p *RetryPolicy) BackoffFrom(base time.Duration, attempt int) time.Duration {
+	wait := base
+	for i := 0; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 1 {
+		return wait
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// IsRetryable reports whether err is retried for product: its error code is one of the product or
+// common retryable codes, or the service answered 429 or 503.
+func (p *RetryPolicy) IsRetryable(product string, err error) bool {
+	if err == nil {
+		return false
+	}
+	code, status := retryErrorCode(err)
+	if status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+		return true
+	}
+	if code == "" {
+		return false
+	}
+	for _, key := range []string{AllProducts, strings.ToLower(product)} {
+		for _, c := range p.RetryableErrorCodes[key] {
+			if c == code || strings.HasSuffix(c, ".*") && strings.HasPrefix(code, strings.TrimSuffix(c, "*")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Retry calls do until it succeeds, fails with an error which is not retryable for product, the
+// retries are exhausted or ctx is done.
+func (p *RetryPolicy) Retry(ctx context.Context, product string, do func() (interface{}, error)) (interface{}, error) {
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		raw, err := do()
+		if err == nil || attempt >= p.MaxRetries || !p.IsRetryable(product, err) {
+			return raw, err
+		}
+		wait := p.Backoff(attempt)
+		log.Printf("[DEBUG] %s request got a retryable error, retrying in %s (%d/%d): %s", product, wait, attempt+1, p.MaxRetries, err)
+		if e := sleepWithContext(ctx, wait); e != nil {
+			return raw, err
+		}
+	}
+}
+
+func (client *AlibabacloudStackClient) getRetryPolicy() *RetryPolicy {
+	if client.retryPolicy == nil {
+		return CurrentRetryPolicy()
+	}
+	return client.retryPolicy
+}
+
+// retryErrorCode returns the error code and the HTTP status of the errors returned by the SDKs.
+func retryErrorCode(err error) (string, int) {
+	switch e := err.(type) {
+	case *tea.SDKError:
+		return tea.StringValue(e.Code), tea.IntValue(e.StatusCode)
+	case *errors.ServerError:
+		return e.ErrorCode(), e.HttpStatus()
+	case *common.Error:
+		return e.Code, e.StatusCode
+	case oss.ServiceError:
+		return e.Code, e.StatusCode
+	case *sls.Error:
+		return e.Code, int(e.HTTPCode)
+	case *tablestore.OtsError:
+		return e.Code, 0
+	case *datahub.DatahubClientError:
+		return e.Code, e.StatusCode
+	case *datahub.LimitExceededError:
+		return e.Code, e.StatusCode
+	case *datahub.ServiceInProcessError:
+		return e.Code, e.StatusCode
+	case *datahub.ServiceTemporaryUnavailableError:
+		return e.Code, e.StatusCode
+	}
+	return "", 0
+}
+
+// ParseRetryableErrorCodes parses the retryable_error_codes entries, formatted as "<product>:<code>"
+// or "<code>" for every product.
+func ParseRetryableErrorCodes(entries []string) (map[string][]string, error) {
+	codes := make(map[string][]string)
+	for _, entry := range entries {
+		product, code := AllProducts, strings.TrimSpace(entry)
+		if i := strings.Index(code, ":"); i >= 0 {
+			product, code = strings.ToLower(strings.TrimSpace(code[:i])), strings.TrimSpace(code[i+1:])
+		}
+		if product == "" || code == "" {
+			return nil, fmt.Errorf("invalid retryable error code %q, expected \"<product>:<code>\" or \"<code>\"", entry)
+		}
+		codes[product] = append(codes[product], code)
+	}
+	return codes, nil
+}
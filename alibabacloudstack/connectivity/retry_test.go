@@ -0,0 +1,69 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	codes, err := ParseRetryableErrorCodes([]string{"ecs:IncorrectInstanceStatus", "Quota.*"})
+	if err != nil {
+		t.Fatalf("ParseRetryableErrorCodes got an error: %#v", err)
+	}
+	if _, err := ParseRetryableErrorCodes([]string{"ecs:"}); err == nil {
+		t.Errorf("ParseRetryableErrorCodes should reject an entry without a code")
+	}
+	policy := NewRetryPolicy(3, 4*time.Second, codes)
+
+	cases := []struct {
+		product string
+		code    string
+		retry   bool
+	}{
+		{"ECS", "IncorrectInstanceStatus", true},
+		{"VPC", "IncorrectInstanceStatus", false},
+		{"VPC", "Quota.Exceeded", true},
+		{"SLB", "Throttling.User", true},
+		{"RDS", "InvalidParameter", false},
+	}
+	for _, c := range cases {
+		err := errors.NewServerError(400, fmt.Sprintf(`{"Code":"%s"}`, c.code), "")
+		if actual := policy.IsRetryable(c.product, err); actual != c.retry {
+			t.Errorf("IsRetryable(%s, %s): expected %t, got %t", c.product, c.code, c.retry, actual)
+		}
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		if wait := policy.Backoff(attempt); wait > 4*time.Second || wait < time.Second/2 {
+			t.Errorf("Backoff(%d) is out of range: %s", attempt, wait)
+		}
+	}
+
+	calls := 0
+	policy = NewRetryPolicy(3, 10*time.Millisecond, nil)
+	_, err = policy.Retry(context.Background(), "ECS", func() (interface{}, error) {
+		calls++
+		return nil, errors.NewServerError(503, `{"Code":"ServiceUnavailable"}`, "")
+	})
+	if err == nil || calls != 4 {
+		t.Errorf("Retry should give up after 3 retries, got %d calls and error %v", calls, err)
+	}
+}
+
+func TestRetryPolicyCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	policy := NewRetryPolicy(3, time.Minute, nil)
+	_, err := policy.Retry(ctx, "ECS", func() (interface{}, error) {
+		calls++
+		cancel()
+		return nil, errors.NewServerError(503, `{"Code":"ServiceUnavailable"}`, "")
+	})
+	if err == nil || calls != 1 {
+		t.Errorf("Retry should stop once the context is cancelled, got %d calls and error %v", calls, err)
+	}
+}
@@ -0,0 +1,416 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	rpc "github.com/alibabacloud-go/tea-rpc/client"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+)
+
+// DefaultTelemetryLogLevel is the Terraform log level used to write the API call records
+// when the provider does not set telemetry_log_level.
+const DefaultTelemetryLogLevel = "DEBUG"
+
+// ApiCallRecord is the telemetry of one API call made by the provider. One record is emitted for
+// every attempt, and Attempt counts the attempts of the same request, starting at 1.
+type ApiCallRecord struct {
+	Time       string `json:"time"`
+	Product    string `json:"product"`
+	Action     string `json:"action"`
+	Endpoint   string `json:"endpoint"`
+	HttpStatus int    `json:"http_status"`
+	RequestId  string `json:"request_id"`
+	DurationMs int64  `json:"duration_ms"`
+	Attempt    int    `json:"attempt"`
+	Resource   string `json:"resource"`
+	Error      string `json:"error,omitempty"`
+}
+
+type apiTelemetry struct {
+	logLevel string
+	file     *os.File
+	mutex    sync.Mutex
+	// attempts counts the failed attempts of the requests sent through the SDK transports, which do
+	// not carry a context. An entry is dropped once its request succeeds or stops being retried.
+	attempts  map[string]*telemetryAttempt
+	lastSweep time.Time
+}
+
+type telemetryAttempt struct {
+	count int
+	last  time.Time
+}
+
+// telemetryAttemptTTL is how long the failed attempts of a request are kept when it is not retried.
+const telemetryAttemptTTL = 10 * time.Minute
+
+// Terraform does not pass the resource address to the provider, so the record resource is resolved
+// from the CRUD function on the call stack, e.g. "alibabacloudstack_vpc.Read".
+var telemetryResources = make(map[string]string)
+var telemetryResourcesMutex sync.RWMutex
+
+// RegisterTelemetryResource records the resource address reported for the API calls made by function.
+func RegisterTelemetryResource(function interface{}, address string) {
+	value := reflect.ValueOf(function)
+	if function == nil || value.Kind() != reflect.Func || value.IsNil() {
+		return
+	}
+	if fn := runtime.FuncForPC(value.Pointer()); fn != nil {
+		telemetryResourcesMutex.Lock()
+		telemetryResources[fn.Name()] = address
+		telemetryResourcesMutex.Unlock()
+	}
+}
+
+func telemetryResource() string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	telemetryResourcesMutex.RLock()
+	defer telemetryResourcesMutex.RUnlock()
+	for {
+		frame, more := frames.Next()
+		if address, ok := telemetryResources[frame.Function]; ok {
+			return address
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func newApiTelemetry(logLevel, file string) (*apiTelemetry, error) {
+	telemetry := &apiTelemetry{
+		logLevel: strings.ToUpper(strings.TrimSpace(logLevel)),
+		attempts: make(map[string]*telemetryAttempt),
+	}
+	if telemetry.logLevel == "" {
+		telemetry.logLevel = DefaultTelemetryLogLevel
+	}
+	if file != "" {
+		f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open the telemetry file %s: %#v", file, err)
+		}
+		telemetry.file = f
+	}
+	return telemetry, nil
+}
+
+func (t *apiTelemetry) record(record ApiCallRecord, start time.Time, err error) {
+	if t == nil {
+		return
+	}
+	record.Time = start.Format(time.RFC3339Nano)
+	record.DurationMs = time.Since(start).Milliseconds()
+	if record.Resource == "" {
+		record.Resource = telemetryResource()
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if record.Attempt < 1 {
+		record.Attempt = 1
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	content, e := json.Marshal(record)
+	if e != nil {
+		log.Printf("[WARN] unable to marshal the api call record %#v: %#v", record, e)
+		return
+	}
+	if t.file != nil {
+		if _, e := t.file.Write(append(content, '\n')); e != nil {
+			log.Printf("[WARN] unable to write the api call record to %s: %#v", t.file.Name(), e)
+		}
+		return
+	}
+	log.Printf("[%s] [API CALL] %s", t.logLevel, content)
+}
+
+// attempt returns the attempt number of the request identified by key and remembers it when the
+// attempt failed, so that the next attempt of the same request is numbered after it.
+func (t *apiTelemetry) attempt(key string, failed bool) int {
+	if t == nil {
+		return 1
+	}
+	now := time.Now()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if now.Sub(t.lastSweep) > telemetryAttemptTTL {
+		for k, v := range t.attempts {
+			if now.Sub(v.last) > telemetryAttemptTTL {
+				delete(t.attempts, k)
+			}
+		}
+		t.lastSweep = now
+	}
+	previous, ok := t.attempts[key]
+	attempt := 1
+	if ok && now.Sub(previous.last) <= telemetryAttemptTTL {
+		attempt = previous.count + 1
+	}
+	if failed {
+		t.attempts[key] = &telemetryAttempt{count: attempt, last: now}
+	} else {
+		delete(t.attempts, key)
+	}
+	return attempt
+}
+
+// telemetryRequestKey identifies a request across its retries. The signature parameters change on
+// every attempt, so they are left out.
+func telemetryRequestKey(request *http.Request) string {
+	query := request.URL.Query()
+	for _, name := range []string{"Signature", "SignatureNonce", "Timestamp"} {
+		query.Del(name)
+	}
+	return strings.Join([]string{request.Method, request.URL.Host, request.URL.Path, query.Encode()}, "|")
+}
+
+func failedAttempt(status int, err error) bool {
+	return err != nil || status >= http.StatusBadRequest
+}
+
+// telemetryTransport records every request sent by the alibaba-cloud-sdk-go, OSS, FC and DataHub
+// clients, after waiting for the rate limit of its product.
+type telemetryTransport struct {
+	telemetry   *apiTelemetry
+	rateLimiter *rateLimiter
+	connections *clientConnections
+	product     string
+	transport   http.RoundTripper
+}
+
+func (t *telemetryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// The SDKs do not take a context, so their requests are bound to the provider stop context.
+	if t.connections != nil && t.connections.stopCtx != nil {
+		request = request.WithContext(t.connections.stopCtx)
+	}
+	query := request.URL.Query()
+	product := firstNonEmpty(query.Get("Product"), request.Header.Get("x-ascm-product-name"), t.product)
+	if err := t.rateLimiter.Wait(request.Context(), firstNonEmpty(product, strings.Split(request.URL.Host, ".")[0])); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	response, err := t.transport.RoundTrip(request)
+	if t.telemetry == nil {
+		return response, err
+	}
+
+	record := ApiCallRecord{
+		Endpoint: request.URL.Host,
+		Product:  firstNonEmpty(product, strings.Split(request.URL.Host, ".")[0]),
+		Action:   firstNonEmpty(query.Get("Action"), request.Header.Get("x-acs-action"), request.Method+" "+request.URL.Path),
+	}
+	if response != nil {
+		record.HttpStatus = response.StatusCode
+		record.RequestId = firstNonEmpty(response.Header.Get("x-acs-request-id"), response.Header.Get("x-oss-request-id"))
+		if record.RequestId == "" && response.Body != nil && response.ContentLength >= 0 && response.ContentLength < 1<<20 {
+			body, e := ioutil.ReadAll(response.Body)
+			response.Body.Close()
+			response.Body = ioutil.NopCloser(bytes.NewReader(body))
+			if e == nil {
+				var content map[string]interface{}
+				if json.Unmarshal(body, &content) == nil {
+					record.RequestId = fmt.Sprint(firstNonNil(content["RequestId"], content["requestId"], ""))
+				}
+			}
+		}
+	}
+	record.Attempt = t.telemetry.attempt(telemetryRequestKey(request), failedAttempt(record.HttpStatus, err))
+	t.telemetry.record(record, start, err)
+	return response, err
+}
+
+// recordCalls wraps do so that each of its attempts is recorded. It is used for the Log and TableStore
+// SDKs, which do not let the provider set their HTTP transport: one record is emitted per call instead
+// of per HTTP request, and the action is the provider function making the call, see telemetryCallAction.
+func (client *AlibabacloudStackClient) recordCalls(product, endpoint, action string, do func() (interface{}, error)) func() (interface{}, error) {
+	if client.telemetry == nil {
+		return do
+	}
+	attempt := 0
+	return func() (interface{}, error) {
+		attempt++
+		start := time.Now()
+		raw, err := do()
+		record := ApiCallRecord{
+			Product:  product,
+			Action:   action,
+			Endpoint: endpoint,
+			Attempt:  attempt,
+		}
+		switch e := err.(type) {
+		case nil:
+			record.HttpStatus = http.StatusOK
+		case *sls.Error:
+			record.HttpStatus = int(e.HTTPCode)
+			record.RequestId = e.RequestID
+		case *tablestore.OtsError:
+			record.RequestId = e.RequestId
+		}
+		client.telemetry.record(record, start, err)
+		return raw, err
+	}
+}
+
+// telemetryCallAction returns the provider function which declared the closure do, e.g.
+// "LogService.DescribeLogProject" for alibabacloudstack.(*LogService).DescribeLogProject.func1.
+func telemetryCallAction(do interface{}) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(do).Pointer())
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	parts := strings.Split(name, ".")
+	// Closures are named func1, and the closures nested in them func1.1.
+	for len(parts) > 1 && strings.TrimLeft(strings.TrimPrefix(parts[len(parts)-1], "func"), "0123456789") == "" {
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) > 1 {
+		parts = parts[1:]
+	}
+	return strings.NewReplacer("(*", "", ")", "").Replace(strings.Join(parts, "."))
+}
+
+// TeaRpcClient is the tea rpc client returned by the New*Client helpers. Every DoRequest* call waits
+// for the product rate limit, is retried following the provider retry policy and is recorded.
+type TeaRpcClient struct {
+	*rpc.Client
+	telemetry   *apiTelemetry
+	retryPolicy *RetryPolicy
+	rateLimiter *rateLimiter
+	recorder    *Recorder
+	product     string
+	ctx         context.Context
+}
+
+type teaDoRequestFunc func(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error)
+
+func (conn *TeaRpcClient) DoRequest(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	return conn.doRequest(conn.Client.DoRequest, action, protocol, method, version, authType, query, body, runtime)
+}
+
+func (conn *TeaRpcClient) DoRequestWithOrg(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	return conn.doRequest(conn.Client.DoRequestWithOrg, action, protocol, method, version, authType, query, body, runtime)
+}
+
+func (conn *TeaRpcClient) DoRequesttowpoint1(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	return conn.doRequest(conn.Client.DoRequesttowpoint1, action, protocol, method, version, authType, query, body, runtime)
+}
+
+func (conn *TeaRpcClient) doRequest(doRequest teaDoRequestFunc, action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	product := conn.product
+	for _, params := range []map[string]interface{}{query, body} {
+		if v, ok := params["Product"].(string); ok && v != "" {
+			product = v
+			break
+		}
+	}
+	if conn.recorder != nil {
+		doRequest = conn.recorder.wrapTeaRequest(doRequest)
+	}
+	// The retry policy below is the only one retrying the call, whatever the runtime options of the caller.
+	if runtime != nil {
+		runtime.Autoretry = tea.Bool(false)
+	}
+	ctx := conn.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// The attempts are counted per call, as the retries below all belong to it.
+	attempt := 0
+	do := func() (interface{}, error) {
+		if err := conn.rateLimiter.Wait(ctx, product); err != nil {
+			return nil, err
+		}
+		attempt++
+		return conn.record(doRequest, product, attempt, action, protocol, method, version, authType, query, body, runtime)
+	}
+	var raw interface{}
+	var err error
+	if conn.retryPolicy == nil {
+		raw, err = do()
+	} else {
+		raw, err = conn.retryPolicy.Retry(ctx, product, do)
+	}
+	response, _ := raw.(map[string]interface{})
+	return response, err
+}
+
+func (conn *TeaRpcClient) record(doRequest teaDoRequestFunc, product string, attempt int, action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	start := time.Now()
+	response, err := doRequest(action, protocol, method, version, authType, query, body, runtime)
+	record := ApiCallRecord{
+		Product:  product,
+		Action:   tea.StringValue(action),
+		Endpoint: tea.StringValue(conn.Endpoint),
+		Attempt:  attempt,
+	}
+	if err == nil {
+		record.HttpStatus = http.StatusOK
+		if response != nil {
+			record.RequestId = fmt.Sprint(firstNonNil(response["RequestId"], response["requestId"], ""))
+		}
+	} else if e, ok := err.(*tea.SDKError); ok {
+		record.HttpStatus = tea.IntValue(e.StatusCode)
+		var data map[string]interface{}
+		if json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) == nil {
+			record.RequestId = fmt.Sprint(firstNonNil(data["RequestId"], data["requestId"], ""))
+		}
+	}
+	conn.telemetry.record(record, start, err)
+	return response, err
+}
+
+func (client *AlibabacloudStackClient) newTeaRpcClient(productCode string, conn *rpc.Client) *TeaRpcClient {
+	return &TeaRpcClient{
+		Client:      conn,
+		telemetry:   client.telemetry,
+		retryPolicy: client.getRetryPolicy(),
+		rateLimiter: client.rateLimiter,
+		recorder:    client.recorder,
+		product:     productCode,
+		ctx:         client.Context(),
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func firstNonNil(values ...interface{}) interface{} {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+	return nil
+}
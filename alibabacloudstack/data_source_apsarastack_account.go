@@ -1,15 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAccountRead,
+		ReadContext: dataSourceAlibabacloudStackAccountRead,
 
 		Schema: map[string]*schema.Schema{
 			// Computed values
@@ -21,11 +23,11 @@ func dataSourceAlibabacloudStackAccount() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAccountRead(d *schema.ResourceData, meta interface{}) error {
-	accountId, err := meta.(*connectivity.AlibabacloudStackClient).AccountId()
+func dataSourceAlibabacloudStackAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accountId, err := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx).AccountId()
 
 	if err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	log.Printf("[DEBUG] alibabacloudstack_account - account ID found: %#v", accountId)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackAdbDbClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAdbDbClustersRead,
+		ReadContext: dataSourceAlibabacloudStackAdbDbClustersRead,
 		Schema: map[string]*schema.Schema{
 			"description_regex": {
 				Type:         schema.TypeString,
@@ -243,8 +245,8 @@ func dataSourceAlibabacloudStackAdbDbClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackAdbDbClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeDBClusters"
 	request := make(map[string]interface{})
@@ -277,7 +279,7 @@ func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta i
 	if v, ok := d.GetOk("description_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		descriptionRegex = r
 	}
@@ -294,20 +296,20 @@ func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta i
 	var response map[string]interface{}
 	conn, err := client.NewAdsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_adb_db_clusters", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_adb_db_clusters", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.Items.DBCluster", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Items.DBCluster", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Items.DBCluster", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -403,20 +405,20 @@ func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta i
 		//}
 		getResp1, err := adbService.DescribeDBClusterAccessWhiteList(id)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		mapping["security_ips"] = strings.Split(getResp1["SecurityIPList"].(string), ",")
 
 		getResp2, err := adbService.DescribeAdbDbCluster(id)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		//mapping["engine_version"] = getResp2["EngineVersion"]
 		mapping["maintain_time"] = getResp2["MaintainTime"]
 
 		getResp3, err := adbService.DescribeDBClusters(id)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		mapping["db_cluster_version"] = getResp3["DBVersion"]
 
@@ -426,15 +428,15 @@ func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta i
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("descriptions", descriptions); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("clusters", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
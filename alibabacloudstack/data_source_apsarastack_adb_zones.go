@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -8,12 +9,13 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	//"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackAdbZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAdbZonesRead,
+		ReadContext: dataSourceAlibabacloudStackAdbZonesRead,
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -51,8 +53,8 @@ func dataSourceAlibabacloudStackAdbZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAdbZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackAdbZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
 
@@ -64,12 +66,12 @@ func dataSourceAlibabacloudStackAdbZonesRead(d *schema.ResourceData, meta interf
 		return adbClient.DescribeRegions(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_adb_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_adb_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	regions, _ := raw.(*adb.DescribeRegionsResponse)
 	if len(regions.Regions.Region) <= 0 {
-		return WrapError(fmt.Errorf("[ERROR] There is no available region for adb."))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for adb.")))
 	}
 	for _, r := range regions.Regions.Region {
 		for _, zone := range r.Zones.Zone {
@@ -105,10 +107,10 @@ func dataSourceAlibabacloudStackAdbZonesRead(d *schema.ResourceData, meta interf
 	}
 	d.SetId(dataResourceIdHash(zoneIds))
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", zoneIds); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -0,0 +1,130 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAlikafkaConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackAlikafkaConsumerGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_id_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consumer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remark": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAlikafkaConsumerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	instanceId := d.Get("instance_id").(string)
+	objects, err := alikafkaService.ListAlikafkaConsumerGroups(instanceId)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	var consumerIdRegex *regexp.Regexp
+	if v, ok := d.GetOk("consumer_id_regex"); ok {
+		consumerIdRegex = regexp.MustCompile(v.(string))
+	}
+
+	ids := make([]string, 0)
+	names := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		if consumerIdRegex != nil && !consumerIdRegex.MatchString(object.ConsumerId) {
+			continue
+		}
+		tags := make(map[string]string)
+		for _, tag := range object.Tags.TagVO {
+			tags[tag.Key] = tag.Value
+		}
+		id := instanceId + COLON_SEPARATED + object.ConsumerId
+		mapping := map[string]interface{}{
+			"id":          id,
+			"instance_id": instanceId,
+			"consumer_id": object.ConsumerId,
+			"remark":      object.Remark,
+			"tags":        tags,
+		}
+		ids = append(ids, id)
+		names = append(names, object.ConsumerId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("names", names); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("groups", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
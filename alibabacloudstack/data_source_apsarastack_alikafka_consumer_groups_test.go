@@ -0,0 +1,70 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAlikafkaConsumerGroupsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_alikafka_consumer_groups.default"
+	name := fmt.Sprintf("tf-testacc-alikafkaconsumers%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAlikafkaConsumerGroupsConfigDependence)
+
+	consumerIdRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id":       "${alibabacloudstack_alikafka_consumer_group.default.instance_id}",
+			"consumer_id_regex": "${alibabacloudstack_alikafka_consumer_group.default.consumer_id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id":       "${alibabacloudstack_alikafka_consumer_group.default.instance_id}",
+			"consumer_id_regex": "${alibabacloudstack_alikafka_consumer_group.default.consumer_id}_fake",
+		}),
+	}
+
+	var existAlikafkaConsumerGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                "1",
+			"names.#":              "1",
+			"names.0":              name,
+			"groups.#":             "1",
+			"groups.0.id":          CHECKSET,
+			"groups.0.instance_id": "cluster-private-paas-default",
+			"groups.0.consumer_id": name,
+			"groups.0.remark":      "alibabacloudstack_alikafka_consumer_group_remark",
+		}
+	}
+
+	var fakeAlikafkaConsumerGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"groups.#": "0",
+		}
+	}
+
+	var alikafkaConsumerGroupsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAlikafkaConsumerGroupsMapFunc,
+		fakeMapFunc:  fakeAlikafkaConsumerGroupsMapFunc,
+	}
+
+	alikafkaConsumerGroupsCheckInfo.dataSourceTestCheck(t, rand, consumerIdRegexConf)
+}
+
+func dataSourceAlikafkaConsumerGroupsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+resource "alibabacloudstack_alikafka_consumer_group" "default" {
+  instance_id = "cluster-private-paas-default"
+  consumer_id = var.name
+  description = "alibabacloudstack_alikafka_consumer_group_remark"
+}
+`, name)
+}
@@ -0,0 +1,204 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAlikafkaInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackAlikafkaInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"service_status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"deploy_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_point": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"io_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"eip_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"topic_quota": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"spec_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paid_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAlikafkaInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	objects, err := alikafkaService.ListAlikafkaInstances()
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	ids := make([]string, 0)
+	names := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		if nameRegex != nil && !nameRegex.MatchString(object.Name) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[object.InstanceId]; !ok {
+				continue
+			}
+		}
+		tags := make(map[string]string)
+		for _, tag := range object.Tags.TagVO {
+			tags[tag.Key] = tag.Value
+		}
+		mapping := map[string]interface{}{
+			"id":             object.InstanceId,
+			"name":           object.Name,
+			"create_time":    object.CreateTime,
+			"service_status": object.ServiceStatus,
+			"deploy_type":    object.DeployType,
+			"vpc_id":         object.VpcId,
+			"vswitch_id":     object.VSwitchId,
+			"zone_id":        object.ZoneId,
+			"end_point":      object.EndPoint,
+			"security_group": object.SecurityGroup,
+			"io_max":         object.IoMax,
+			"eip_max":        object.EipMax,
+			"disk_type":      object.DiskType,
+			"disk_size":      object.DiskSize,
+			"topic_quota":    object.TopicNumLimit,
+			"spec_type":      object.SpecType,
+			"paid_type":      object.PaidType,
+			"tags":           tags,
+		}
+		ids = append(ids, object.InstanceId)
+		names = append(names, object.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("names", names); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("instances", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
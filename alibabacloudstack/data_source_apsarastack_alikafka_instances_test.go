@@ -0,0 +1,62 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAlikafkaInstancesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_alikafka_instances.default"
+	name := fmt.Sprintf("tf-testacc-alikafkainstances%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAlikafkaInstancesConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"cluster-private-paas-default"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"cluster-private-paas-default_fake"},
+		}),
+	}
+
+	var existAlikafkaInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":               "1",
+			"ids.0":               "cluster-private-paas-default",
+			"names.#":             "1",
+			"instances.#":         "1",
+			"instances.0.id":      "cluster-private-paas-default",
+			"instances.0.name":    CHECKSET,
+			"instances.0.vpc_id":  CHECKSET,
+			"instances.0.zone_id": CHECKSET,
+		}
+	}
+
+	var fakeAlikafkaInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":       "0",
+			"names.#":     "0",
+			"instances.#": "0",
+		}
+	}
+
+	var alikafkaInstancesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAlikafkaInstancesMapFunc,
+		fakeMapFunc:  fakeAlikafkaInstancesMapFunc,
+	}
+
+	alikafkaInstancesCheckInfo.dataSourceTestCheck(t, rand, idsConf)
+}
+
+func dataSourceAlikafkaInstancesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+`, name)
+}
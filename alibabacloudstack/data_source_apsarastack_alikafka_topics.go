@@ -0,0 +1,155 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAlikafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackAlikafkaTopicsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_topic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"compact_topic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"partition_num": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remark": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAlikafkaTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	instanceId := d.Get("instance_id").(string)
+	objects, err := alikafkaService.ListAlikafkaTopics(instanceId)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	ids := make([]string, 0)
+	names := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		if nameRegex != nil && !nameRegex.MatchString(object.Topic) {
+			continue
+		}
+		tags := make(map[string]string)
+		for _, tag := range object.InstanceDo.Tags.TagVO {
+			tags[tag.Key] = tag.Value
+		}
+		id := instanceId + COLON_SEPARATED + object.Topic
+		mapping := map[string]interface{}{
+			"id":            id,
+			"topic":         object.Topic,
+			"local_topic":   object.LocalTopic,
+			"compact_topic": object.InstanceDo.CompactTopic,
+			"partition_num": object.InstanceDo.PartitionNum,
+			"remark":        object.InstanceDo.Remark,
+			"status":        object.Status,
+			"status_name":   object.StatusName,
+			"create_time":   object.InstanceDo.CreateTime,
+			"tags":          tags,
+		}
+		ids = append(ids, id)
+		names = append(names, object.Topic)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("names", names); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("topics", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
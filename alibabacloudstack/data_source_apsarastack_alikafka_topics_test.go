@@ -0,0 +1,76 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAlikafkaTopicsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_alikafka_topics.default"
+	name := fmt.Sprintf("tf-testacc-alikafkatopics%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAlikafkaTopicsConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_topic.default.instance_id}",
+			"name_regex":  "${alibabacloudstack_alikafka_topic.default.topic}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_topic.default.instance_id}",
+			"name_regex":  "${alibabacloudstack_alikafka_topic.default.topic}_fake",
+		}),
+	}
+
+	var existAlikafkaTopicsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                  "1",
+			"names.#":                "1",
+			"names.0":                name,
+			"topics.#":               "1",
+			"topics.0.id":            CHECKSET,
+			"topics.0.topic":         name,
+			"topics.0.local_topic":   "false",
+			"topics.0.compact_topic": "false",
+			"topics.0.partition_num": "12",
+			"topics.0.remark":        "alibabacloudstack_alikafka_topic_remark",
+			"topics.0.create_time":   CHECKSET,
+		}
+	}
+
+	var fakeAlikafkaTopicsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"topics.#": "0",
+		}
+	}
+
+	var alikafkaTopicsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAlikafkaTopicsMapFunc,
+		fakeMapFunc:  fakeAlikafkaTopicsMapFunc,
+	}
+
+	alikafkaTopicsCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf)
+}
+
+func dataSourceAlikafkaTopicsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+resource "alibabacloudstack_alikafka_topic" "default" {
+  instance_id   = "cluster-private-paas-default"
+  topic         = var.name
+  local_topic   = "false"
+  compact_topic = "false"
+  partition_num = "12"
+  remark        = "alibabacloudstack_alikafka_topic_remark"
+}
+`, name)
+}
@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackApiGatewayApis() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayApisRead,
+		ReadContext: dataSourceAlibabacloudStackApigatewayApisRead,
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -83,23 +85,11 @@ func dataSourceAlibabacloudStackApiGatewayApis() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayApisRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackApigatewayApisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := cloudapi.CreateDescribeApisRequest()
 	request.RegionId = client.RegionId
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeApis",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	if groupId, ok := d.GetOk("group_id"); ok {
 		request.GroupId = groupId.(string)
 	}
@@ -117,7 +107,7 @@ func dataSourceAlibabacloudStackApigatewayApisRead(d *schema.ResourceData, meta
 			return cloudApiClient.DescribeApis(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_apis", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_apis", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*cloudapi.DescribeApisResponse)
@@ -130,7 +120,7 @@ func dataSourceAlibabacloudStackApigatewayApisRead(d *schema.ResourceData, meta
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -162,7 +152,7 @@ func dataSourceAlibabacloudStackApigatewayApisRead(d *schema.ResourceData, meta
 		filteredApisTemp = append(filteredApisTemp, api)
 	}
 
-	return apiGatewayApisDescribeSummarys(d, filteredApisTemp, meta)
+	return DiagnosticsFromError(apiGatewayApisDescribeSummarys(d, filteredApisTemp, meta))
 }
 
 func apiGatewayApisDescribeSummarys(d *schema.ResourceData, apis []cloudapi.ApiSummary, meta interface{}) error {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strconv"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackApiGatewayApps() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayAppsRead,
+		ReadContext: dataSourceAlibabacloudStackApigatewayAppsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -74,27 +76,15 @@ func dataSourceAlibabacloudStackApiGatewayApps() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackApigatewayAppsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 
 	request := cloudapi.CreateDescribeAppAttributesRequest()
 	request.RegionId = client.RegionId
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeAppAttributes",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	var apps []cloudapi.AppAttribute
 
 	for {
@@ -102,7 +92,7 @@ func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta
 			return cloudApiClient.DescribeAppAttributes(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_apps", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_apps", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*cloudapi.DescribeAppAttributesResponse)
@@ -115,7 +105,7 @@ func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -126,7 +116,7 @@ func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta
 	if ok && nameRegex.(string) != "" {
 		r, err := regexp.Compile(nameRegex.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		gatewayAppNameRegex = r
 	}
@@ -154,7 +144,7 @@ func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta
 		if value, ok := d.GetOk("tags"); ok {
 			tags, err := cloudApiService.DescribeTags(strconv.FormatInt(app.AppId, 10), value.(map[string]interface{}), TagResourceApp)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			if len(tags) < 1 {
 				continue
@@ -164,7 +154,7 @@ func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta
 		filteredAppsTemp = append(filteredAppsTemp, app)
 	}
 
-	return apigatewayAppsDecriptionAttributes(d, filteredAppsTemp, meta)
+	return DiagnosticsFromError(apigatewayAppsDecriptionAttributes(d, filteredAppsTemp, meta))
 }
 
 func apigatewayAppsDecriptionAttributes(d *schema.ResourceData, apps []cloudapi.AppAttribute, meta interface{}) error {
@@ -175,19 +165,7 @@ func apigatewayAppsDecriptionAttributes(d *schema.ResourceData, apps []cloudapi.
 	for _, app := range apps {
 		request := cloudapi.CreateDescribeAppSecurityRequest()
 		request.RegionId = client.RegionId
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "DescribeAppSecurity",
-			"Version":         "2016-07-14",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.AppId = requests.NewInteger64(app.AppId)
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.DescribeAppSecurity(request)
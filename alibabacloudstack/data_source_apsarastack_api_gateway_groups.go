@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackApiGatewayGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackApigatewayGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -88,26 +90,14 @@ func dataSourceAlibabacloudStackApiGatewayGroups() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackApigatewayGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := cloudapi.CreateDescribeApiGroupsRequest()
 	request.RegionId = client.RegionId
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeApiGroups",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	var allGroups []cloudapi.ApiGroupAttribute
 
 	for {
@@ -115,7 +105,7 @@ func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, met
 			return cloudApiClient.DescribeApiGroups(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*cloudapi.DescribeApiGroupsResponse)
@@ -128,7 +118,7 @@ func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, met
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -138,7 +128,7 @@ func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, met
 	if nameRegex, ok := d.GetOk("name_regex"); ok && nameRegex.(string) != "" {
 		r, err := regexp.Compile(nameRegex.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		gatewayGroupNameRegex = r
 	}
@@ -165,7 +155,7 @@ func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, met
 		}
 		filteredGroups = append(filteredGroups, group)
 	}
-	return apigatewayGroupsDecriptionAttributes(d, filteredGroups, meta)
+	return DiagnosticsFromError(apigatewayGroupsDecriptionAttributes(d, filteredGroups, meta))
 }
 
 func apigatewayGroupsDecriptionAttributes(d *schema.ResourceData, groupsSetTypes []cloudapi.ApiGroupAttribute, meta interface{}) error {
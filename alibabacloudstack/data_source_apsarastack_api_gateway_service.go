@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -8,12 +9,13 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackApiGatewayService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayServiceRead,
+		ReadContext: dataSourceAlibabacloudStackApigatewayServiceRead,
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -29,8 +31,8 @@ func dataSourceAlibabacloudStackApiGatewayService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackApigatewayServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := make(map[string]interface{})
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
 		d.SetId("ApiGatewayServicHasNotBeenOpened")
@@ -47,9 +49,9 @@ func dataSourceAlibabacloudStackApigatewayServiceRead(d *schema.ResourceData, me
 	request["PageSize"] = PageSizeLarge
 	request["PageNumber"] = 1
 
-	conn, err := meta.(*connectivity.AlibabacloudStackClient).NewTeaCommonClient(connectivity.OpenApiGatewayService)
+	conn, err := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx).NewTeaCommonClient(connectivity.OpenApiGatewayService)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["Product"] = "CloudAPI"
 	request["OrganizationId"] = client.Department
@@ -62,7 +64,7 @@ func dataSourceAlibabacloudStackApigatewayServiceRead(d *schema.ResourceData, me
 			d.Set("status", "Opened")
 			return nil
 		}
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_service", "OpenApiGatewayService", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_api_gateway_service", "OpenApiGatewayService", AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%v", response["OrderId"]))
@@ -1,19 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackEcsInstanceFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsInstanceFamiliesRead,
+		ReadContext: dataSourceAlibabacloudStackEcsInstanceFamiliesRead,
 		Schema: map[string]*schema.Schema{
 
 			"ids": {
@@ -52,33 +52,9 @@ func dataSourceAlibabacloudStackEcsInstanceFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "DescribeInstanceTypeFamilies"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeInstanceTypeFamilies",
-		"Version":         "2019-05-10",
-	}
+func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "DescribeInstanceTypeFamilies", "")
 	response := EcsInstanceFamily{}
 
 	for {
@@ -88,14 +64,14 @@ func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(d *schema.ResourceData,
 		log.Printf(" rsponse of raw DescribeInstanceTypeFamilies : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ecs_instance_families", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ecs_instance_families", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == 200 || len(response.Data.InstanceTypeFamilies) < 1 {
 			break
@@ -116,7 +92,7 @@ func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(d *schema.ResourceData,
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("families", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
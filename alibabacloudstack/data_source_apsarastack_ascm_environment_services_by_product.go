@@ -1,19 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackAscmEnvironmentServicesByProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead,
+		ReadContext: dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -37,24 +37,9 @@ func dataSourceAlibabacloudStackAscmEnvironmentServicesByProduct() *schema.Resou
 	}
 }
 
-func dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "GetEnvProducts"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Action": "GetEnvProducts", "Version": "2019-05-10"}
+func dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "GetEnvProducts", "")
 	response := EnvironmentProduct{}
 
 	for {
@@ -64,14 +49,14 @@ func dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead(d *schema.R
 		log.Printf(" rsponse of raw GetEnvProducts : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_environment_services_by_product", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_environment_services_by_product", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == 200 || len(response.Result) < 1 {
 			break
@@ -82,7 +67,7 @@ func dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead(d *schema.R
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("result", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,20 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackInstanceFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceFamiliesRead,
+		ReadContext: dataSourceAlibabacloudStackInstanceFamiliesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -81,34 +81,10 @@ func dataSourceAlibabacloudStackInstanceFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceFamiliesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackInstanceFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "DescribeSeriesIdFamilies"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeSeriesIdFamilies",
-		"Version":         "2019-05-10",
-	}
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "DescribeSeriesIdFamilies", "")
 	response := InstanceFamily{}
 
 	for {
@@ -117,14 +93,14 @@ func dataSourceAlibabacloudStackInstanceFamiliesRead(d *schema.ResourceData, met
 		})
 		log.Printf(" rsponse of raw DescribeSeriesIdFamilies : %s", raw)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_instance_families", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_instance_families", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Success == true || len(response.Data) < 1 {
 			break
@@ -157,7 +133,7 @@ func dataSourceAlibabacloudStackInstanceFamiliesRead(d *schema.ResourceData, met
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("families", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
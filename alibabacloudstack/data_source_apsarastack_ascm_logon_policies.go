@@ -1,22 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"regexp"
 )
 
 func dataSourceAlibabacloudStackAscmLogonPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmLogonPoliciesRead,
+		ReadContext: dataSourceAlibabacloudStackAscmLogonPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -98,34 +98,11 @@ func dataSourceAlibabacloudStackAscmLogonPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmLogonPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackAscmLogonPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	name := d.Get("name_regex").(string)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-
-	request.RegionId = client.RegionId
-	request.ApiName = "ListLoginPolicies"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListLoginPolicies",
-		"Version":         "2019-05-10",
-		"name":            name,
-	}
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "ListLoginPolicies", "")
+	request.QueryParams["name"] = name
 	response := LoginPolicy{}
 
 	for {
@@ -135,14 +112,14 @@ func dataSourceAlibabacloudStackAscmLogonPoliciesRead(d *schema.ResourceData, me
 		log.Printf(" rsponse of raw ListLoginPolicies : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_logon_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_logon_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" {
 			break
@@ -191,7 +168,7 @@ func dataSourceAlibabacloudStackAscmLogonPoliciesRead(d *schema.ResourceData, me
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("policies", t); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), t)
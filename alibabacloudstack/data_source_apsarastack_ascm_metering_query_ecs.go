@@ -1,20 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudstackAscmMeteringQueryEcs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackAscmMeteringQueryEcsRead,
+		ReadContext: dataSourceAlibabacloudstackAscmMeteringQueryEcsRead,
 		Schema: map[string]*schema.Schema{
 			"start_time": {
 				Type:     schema.TypeString,
@@ -149,42 +149,19 @@ func dataSourceAlibabacloudstackAscmMeteringQueryEcs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackAscmMeteringQueryEcsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudstackAscmMeteringQueryEcsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	starttime := d.Get("start_time").(string)
 	endtime := d.Get("end_time").(string)
-	request := requests.NewCommonRequest()
-	request.SetHTTPSInsecure(true)
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "MeteringWebQuery"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "ascm",
-		"ProductName":     "ascm",
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"resourceGroupId": client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"ApiName":         "MeteringWebQuery",
-		"Action":          "MeteringWebQuery",
-		"Version":         "2019-05-10",
-		"StartTime":       starttime,
-		"EndTime":         endtime,
-		"productName":     "ECS",
-	}
 	response := MeteringQueryDataEcs{}
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "MeteringWebQuery", "")
+	request.SetHTTPSInsecure(true)
+	request.QueryParams["ProductName"] = "ascm"
+	request.QueryParams["resourceGroupId"] = client.ResourceGroup
+	request.QueryParams["ApiName"] = "MeteringWebQuery"
+	request.QueryParams["StartTime"] = starttime
+	request.QueryParams["EndTime"] = endtime
+	request.QueryParams["productName"] = "ECS"
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -192,7 +169,7 @@ func dataSourceAlibabacloudstackAscmMeteringQueryEcsRead(d *schema.ResourceData,
 		})
 		log.Printf(" rsponse of raw MeteringWebQuery : %s", raw)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_metering_query", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_metering_query", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		bresponse, _ := raw.(*responses.CommonResponse)
 
@@ -241,7 +218,7 @@ func dataSourceAlibabacloudstackAscmMeteringQueryEcsRead(d *schema.ResourceData,
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("data", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,21 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackAscmOrganizations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmOrganizationsRead,
+		ReadContext: dataSourceAlibabacloudStackAscmOrganizationsRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -84,39 +84,17 @@ func dataSourceAlibabacloudStackAscmOrganizations() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmOrganizationsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
+func dataSourceAlibabacloudStackAscmOrganizationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var parentId string
 	if v, ok := d.GetOk("parent_id"); ok {
 		parentId = fmt.Sprint(v.(int))
 	} else {
 		parentId = client.Department
 	}
-
-	request.RegionId = client.RegionId
-	request.ApiName = "GetOrganizationList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "GetOrganizationList",
-		"Version":         "2019-05-10",
-		"id":              parentId}
 	response := Organization{}
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "GetOrganizationList", "")
+	request.QueryParams["id"] = parentId
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -125,14 +103,14 @@ func dataSourceAlibabacloudStackAscmOrganizationsRead(d *schema.ResourceData, me
 		log.Printf(" rsponse of raw MeteringWebQuery : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_organizations", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_organizations", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || len(response.Data) < 1 {
 			break
@@ -166,7 +144,7 @@ func dataSourceAlibabacloudStackAscmOrganizationsRead(d *schema.ResourceData, me
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("organizations", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,20 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackAscmPasswordPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmPasswordPoliciesRead,
+		ReadContext: dataSourceAlibabacloudStackAscmPasswordPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -119,24 +119,9 @@ func dataSourceAlibabacloudStackAscmPasswordPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "GetPasswordPolicy"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Action": "GetPasswordPolicy", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": "2019-05-10"}
+func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "GetPasswordPolicy", "")
 	response := PasswordPolicy{}
 
 	for {
@@ -146,14 +131,14 @@ func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(d *schema.ResourceData,
 		log.Printf(" response of raw GetPasswordPolicy : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_password_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_password_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || bresponse == nil {
 			break
@@ -178,7 +163,7 @@ func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(d *schema.ResourceData,
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("policies", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,20 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackQuotas() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackQuotasRead,
+		ReadContext: dataSourceAlibabacloudStackQuotasRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -165,40 +165,18 @@ func dataSourceAlibabacloudStackQuotas() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackQuotasRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "GetQuota"
+func dataSourceAlibabacloudStackQuotasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "GetQuota", "")
 	productName := d.Get("product_name").(string)
 	quotaType := d.Get("quota_type").(string)
 	quotaTypeId := d.Get("quota_type_id").(string)
 	targetType := d.Get("target_type").(string)
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey,
-		"Product":       "ascm",
-		"RegionId":      client.RegionId,
-		"Department":    client.Department,
-		"ResourceGroup": client.ResourceGroup,
-		"Action":        "GetQuota",
-		"Version":       "2019-05-10",
-		"productName":   productName,
-		"quotaType":     quotaType,
-		"quotaTypeId":   quotaTypeId,
-		"regionName":    client.RegionId,
-		"targetType":    targetType,
-	}
+	request.QueryParams["productName"] = productName
+	request.QueryParams["quotaType"] = quotaType
+	request.QueryParams["quotaTypeId"] = quotaTypeId
+	request.QueryParams["regionName"] = client.RegionId
+	request.QueryParams["targetType"] = targetType
 	response := AscmQuota{}
 
 	for {
@@ -208,14 +186,14 @@ func dataSourceAlibabacloudStackQuotasRead(d *schema.ResourceData, meta interfac
 		log.Printf(" response of raw GetQuota : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_quotas", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_quotas", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" {
 			break
@@ -257,7 +235,7 @@ func dataSourceAlibabacloudStackQuotasRead(d *schema.ResourceData, meta interfac
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("quotas", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
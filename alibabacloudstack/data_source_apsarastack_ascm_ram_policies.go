@@ -1,21 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 )
 
 func dataSourceAlibabacloudStackAscmRamPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmRamPoliciesRead,
+		ReadContext: dataSourceAlibabacloudStackAscmRamPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -80,32 +80,12 @@ func dataSourceAlibabacloudStackAscmRamPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmRamPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "ListRamPolicies"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListRamPolicies",
-		"Version":         "2019-05-10",
-		"pageSize":        "1000",
-		//"policyName":name,
-	}
+func dataSourceAlibabacloudStackAscmRamPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	response := RamPolicies{}
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "ListRamPolicies", "")
+	//"policyName":name,
+	request.QueryParams["pageSize"] = "1000"
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -114,14 +94,14 @@ func dataSourceAlibabacloudStackAscmRamPoliciesRead(d *schema.ResourceData, meta
 		log.Printf(" response of raw ListRamPolicies : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ram_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ram_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || len(response.Data) < 1 {
 			break
@@ -153,7 +133,7 @@ func dataSourceAlibabacloudStackAscmRamPoliciesRead(d *schema.ResourceData, meta
 	}
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("policies", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
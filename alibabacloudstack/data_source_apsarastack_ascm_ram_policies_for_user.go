@@ -1,20 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"strings"
 	"time"
 )
 
 func dataSourceAlibabacloudStackAscmRamPoliciesForUser() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmRamPoliciesForUserRead,
+		ReadContext: dataSourceAlibabacloudStackAscmRamPoliciesForUserRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -70,32 +70,12 @@ func dataSourceAlibabacloudStackAscmRamPoliciesForUser() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmRamPoliciesForUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackAscmRamPoliciesForUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	lname := d.Get("login_name").(string)
-	request := requests.NewCommonRequest()
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "ListRAMPoliciesForUser"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "ListRAMPoliciesForUser",
-		"Version":         "2019-05-10",
-		"LoginName":       lname,
-	}
 	response := RamPolicyUser{}
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "ListRAMPoliciesForUser", "")
+	request.QueryParams["LoginName"] = lname
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -104,14 +84,14 @@ func dataSourceAlibabacloudStackAscmRamPoliciesForUserRead(d *schema.ResourceDat
 		log.Printf(" response of raw ListRAMPoliciesForUser : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ram_policies_for_user", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ram_policies_for_user", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || len(response.Data) < 1 {
 			break
@@ -136,7 +116,7 @@ func dataSourceAlibabacloudStackAscmRamPoliciesForUserRead(d *schema.ResourceDat
 	}
 	d.SetId(dataResourceIdHash(names))
 	if err := d.Set("policies", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,19 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackServiceClusterByProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackServiceClusterByProductRead,
+		ReadContext: dataSourceAlibabacloudStackServiceClusterByProductRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -48,25 +48,11 @@ func dataSourceAlibabacloudStackServiceClusterByProduct() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackServiceClusterByProductRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "GetClustersByProduct"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+func dataSourceAlibabacloudStackServiceClusterByProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "GetClustersByProduct", "")
 	productName := d.Get("product_name").(string)
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "productName": productName, "Action": "GetClustersByProduct", "Version": "2019-05-10"}
+	request.QueryParams["productName"] = productName
 	response := ClustersByProduct1{}
 
 	for {
@@ -76,14 +62,14 @@ func dataSourceAlibabacloudStackServiceClusterByProductRead(d *schema.ResourceDa
 		log.Printf(" response of raw GetClustersByProduct : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_service_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_service_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == 200 || len(response.Body.ClusterList) < 1 {
 			break
@@ -125,7 +111,7 @@ func dataSourceAlibabacloudStackServiceClusterByProductRead(d *schema.ResourceDa
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("cluster_list", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
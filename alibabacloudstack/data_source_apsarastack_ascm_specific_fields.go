@@ -1,20 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackSpecificFields() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSpecificFieldsRead,
+		ReadContext: dataSourceAlibabacloudStackSpecificFieldsRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -53,26 +53,13 @@ func dataSourceAlibabacloudStackSpecificFields() *schema.Resource {
 
 }
 
-func dataSourceAlibabacloudStackSpecificFieldsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "GroupCommonSpec"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+func dataSourceAlibabacloudStackSpecificFieldsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "GroupCommonSpec", "")
 	resourceType := d.Get("resource_type").(string)
 	groupFiled := d.Get("group_filed").(string)
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Action": "GroupCommonSpec", "Version": "2019-05-10", "resourceType": resourceType, "groupFiled": groupFiled}
+	request.QueryParams["resourceType"] = resourceType
+	request.QueryParams["groupFiled"] = groupFiled
 	response := SpecificField{}
 
 	for {
@@ -82,14 +69,14 @@ func dataSourceAlibabacloudStackSpecificFieldsRead(d *schema.ResourceData, meta
 		log.Printf(" response of raw GroupCommonSpec : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_specific_fields", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_specific_fields", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == 200 || len(response.Data) < 1 {
 			break
@@ -101,7 +88,7 @@ func dataSourceAlibabacloudStackSpecificFieldsRead(d *schema.ResourceData, meta
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("specific_fields", response.Data); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
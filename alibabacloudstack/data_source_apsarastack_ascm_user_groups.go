@@ -1,21 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"regexp"
 )
 
 func dataSourceAlibabacloudStackAscmUserGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmUserGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackAscmUserGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -88,37 +88,12 @@ func dataSourceAlibabacloudStackAscmUserGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmUserGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
+func dataSourceAlibabacloudStackAscmUserGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	userGroupName := d.Get("name_regex").(string)
-
-	request.RegionId = client.RegionId
-	request.ApiName = "ListUserGroups"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
 	response := UserGroup{}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListUserGroups",
-		"Version":         "2019-05-10",
-		"userGroupName":   userGroupName,
-	}
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "ListUserGroups", "")
+	request.QueryParams["userGroupName"] = userGroupName
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -126,7 +101,7 @@ func dataSourceAlibabacloudStackAscmUserGroupsRead(d *schema.ResourceData, meta
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_users", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_users", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		addDebug("ListUserGroups", raw, request)
@@ -134,7 +109,7 @@ func dataSourceAlibabacloudStackAscmUserGroupsRead(d *schema.ResourceData, meta
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || len(response.Data) < 1 {
 			break
@@ -179,10 +154,10 @@ func dataSourceAlibabacloudStackAscmUserGroupsRead(d *schema.ResourceData, meta
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("groups", groups); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("role_ids", roleids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), groups)
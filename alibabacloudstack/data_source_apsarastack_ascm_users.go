@@ -1,22 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"regexp"
 )
 
 func dataSourceAlibabacloudStackAscmUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmUsersRead,
+		ReadContext: dataSourceAlibabacloudStackAscmUsersRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -111,39 +111,14 @@ func dataSourceAlibabacloudStackAscmUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmUsersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
+func dataSourceAlibabacloudStackAscmUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	loginName := d.Get("name_regex").(string)
 	orgId := d.Get("organization_id")
-
-	request.RegionId = client.RegionId
-	request.ApiName = "ListUsers"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
 	response := User{}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListUsers",
-		"Version":         "2019-05-10",
-		"loginName":       loginName,
-		"organizationId":  fmt.Sprint(orgId),
-	}
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "ListUsers", "")
+	request.QueryParams["loginName"] = loginName
+	request.QueryParams["organizationId"] = fmt.Sprint(orgId)
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -152,14 +127,14 @@ func dataSourceAlibabacloudStackAscmUsersRead(d *schema.ResourceData, meta inter
 		log.Printf(" response of raw ListUsers : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_users", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_users", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || len(response.Data) < 1 {
 			break
@@ -206,10 +181,10 @@ func dataSourceAlibabacloudStackAscmUsersRead(d *schema.ResourceData, meta inter
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("users", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("role_ids", roleids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -0,0 +1,179 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCenBandwidthPackages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackCenBandwidthPackagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"packages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"charge_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geographic_region_a_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geographic_region_b_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"business_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenBandwidthPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	objects, err := cenService.ListCenBandwidthPackages(d.Get("instance_id").(string))
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	ids := make([]string, 0)
+	names := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		if nameRegex != nil && !nameRegex.MatchString(object.Name) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[object.CenBandwidthPackageId]; !ok {
+				continue
+			}
+		}
+		cenId := ""
+		if len(object.CenIds.CenId) > 0 {
+			cenId = object.CenIds.CenId[0]
+		}
+		mapping := map[string]interface{}{
+			"id":                     object.CenBandwidthPackageId,
+			"name":                   object.Name,
+			"description":            object.Description,
+			"instance_id":            cenId,
+			"bandwidth":              object.Bandwidth,
+			"charge_type":            object.BandwidthPackageChargeType,
+			"geographic_region_a_id": object.GeographicRegionAId,
+			"geographic_region_b_id": object.GeographicRegionBId,
+			"business_status":        object.BusinessStatus,
+			"status":                 object.Status,
+			"creation_time":          object.CreationTime,
+			"expired_time":           object.ExpiredTime,
+		}
+		ids = append(ids, object.CenBandwidthPackageId)
+		names = append(names, object.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("names", names); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("packages", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackCenBandwidthPackagesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_cen_bandwidth_packages.default"
+	name := fmt.Sprintf("tf-testacc-cenbwps%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceCenBandwidthPackagesConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_cen_bandwidth_package.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_cen_bandwidth_package.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_cen_bandwidth_package.default.cen_bandwidth_package_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_cen_bandwidth_package.default.cen_bandwidth_package_name}_fake",
+		}),
+	}
+	instanceIdConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_cen_bandwidth_package.default.cen_id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_cen_instance.other.id}",
+		}),
+	}
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":         []string{"${alibabacloudstack_cen_bandwidth_package.default.id}"},
+			"name_regex":  "${alibabacloudstack_cen_bandwidth_package.default.cen_bandwidth_package_name}",
+			"instance_id": "${alibabacloudstack_cen_bandwidth_package.default.cen_id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":         []string{"${alibabacloudstack_cen_bandwidth_package.default.id}"},
+			"name_regex":  "${alibabacloudstack_cen_bandwidth_package.default.cen_bandwidth_package_name}_fake",
+			"instance_id": "${alibabacloudstack_cen_bandwidth_package.default.cen_id}",
+		}),
+	}
+
+	var existCenBandwidthPackagesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                             "1",
+			"names.#":                           "1",
+			"names.0":                           name,
+			"packages.#":                        "1",
+			"packages.0.id":                     CHECKSET,
+			"packages.0.name":                   name,
+			"packages.0.description":            "tf-testacc-cenbwp-description",
+			"packages.0.instance_id":            CHECKSET,
+			"packages.0.bandwidth":              "5",
+			"packages.0.charge_type":            "PostPaid",
+			"packages.0.geographic_region_a_id": "China",
+			"packages.0.geographic_region_b_id": "China",
+			"packages.0.status":                 "InUse",
+		}
+	}
+
+	var fakeCenBandwidthPackagesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"names.#":    "0",
+			"packages.#": "0",
+		}
+	}
+
+	var cenBandwidthPackagesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existCenBandwidthPackagesMapFunc,
+		fakeMapFunc:  fakeCenBandwidthPackagesMapFunc,
+	}
+
+	cenBandwidthPackagesCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf, instanceIdConf, allConf)
+}
+
+func dataSourceCenBandwidthPackagesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_cen_instance" "other" {
+  cen_instance_name = "${var.name}-other"
+}
+
+resource "alibabacloudstack_cen_bandwidth_package" "default" {
+  bandwidth                  = 5
+  geographic_region_a_id     = "China"
+  geographic_region_b_id     = "China"
+  cen_bandwidth_package_name = var.name
+  description                = "tf-testacc-cenbwp-description"
+  cen_id                     = alibabacloudstack_cen_instance.default.id
+}
+`, resourceCenBandwidthPackageConfigDependence(name))
+}
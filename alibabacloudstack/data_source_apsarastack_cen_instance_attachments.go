@@ -0,0 +1,121 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCenInstanceAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackCenInstanceAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{ChildInstanceTypeVpc, ChildInstanceTypeVbr, ChildInstanceTypeCcn}, false),
+			},
+			"child_instance_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_attach_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenInstanceAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	objects, err := cenService.ListCenInstanceAttachments(d.Get("instance_id").(string), d.Get("child_instance_type").(string), d.Get("child_instance_region_id").(string))
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	ids := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		id := object.CenId + COLON_SEPARATED + object.ChildInstanceId + COLON_SEPARATED + object.ChildInstanceRegionId
+		mapping := map[string]interface{}{
+			"id":                         id,
+			"instance_id":                object.CenId,
+			"child_instance_id":          object.ChildInstanceId,
+			"child_instance_type":        object.ChildInstanceType,
+			"child_instance_region_id":   object.ChildInstanceRegionId,
+			"status":                     object.Status,
+			"child_instance_attach_time": object.ChildInstanceAttachTime,
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("attachments", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
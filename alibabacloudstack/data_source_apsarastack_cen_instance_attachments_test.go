@@ -0,0 +1,92 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackCenInstanceAttachmentsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_cen_instance_attachments.default"
+	name := fmt.Sprintf("tf-testacc-cenattachments%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceCenInstanceAttachmentsConfigDependence)
+
+	instanceIdConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_cen_instance.other.id}",
+		}),
+	}
+	childInstanceTypeConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id":         "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+			"child_instance_type": "VPC",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id":         "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+			"child_instance_type": "VBR",
+		}),
+	}
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id":              "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+			"child_instance_type":      "VPC",
+			"child_instance_region_id": "${var.region}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id":              "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+			"child_instance_type":      "VPC",
+			"child_instance_region_id": "${var.region}-fake",
+		}),
+	}
+
+	var existCenInstanceAttachmentsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                                    "1",
+			"attachments.#":                            "1",
+			"attachments.0.id":                         CHECKSET,
+			"attachments.0.instance_id":                CHECKSET,
+			"attachments.0.child_instance_id":          CHECKSET,
+			"attachments.0.child_instance_type":        "VPC",
+			"attachments.0.child_instance_region_id":   defaultRegionToTest,
+			"attachments.0.status":                     "Attached",
+			"attachments.0.child_instance_attach_time": CHECKSET,
+		}
+	}
+
+	var fakeCenInstanceAttachmentsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":         "0",
+			"attachments.#": "0",
+		}
+	}
+
+	var cenInstanceAttachmentsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existCenInstanceAttachmentsMapFunc,
+		fakeMapFunc:  fakeCenInstanceAttachmentsMapFunc,
+	}
+
+	cenInstanceAttachmentsCheckInfo.dataSourceTestCheck(t, rand, instanceIdConf, childInstanceTypeConf, allConf)
+}
+
+func dataSourceCenInstanceAttachmentsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_cen_instance" "other" {
+  cen_instance_name = "${var.name}-other"
+}
+
+resource "alibabacloudstack_cen_instance_attachment" "default" {
+  instance_id              = alibabacloudstack_cen_instance.default.id
+  child_instance_id        = alibabacloudstack_vpc.default.id
+  child_instance_region_id = var.region
+}
+`, resourceCenInstanceAttachmentConfigDependence(name))
+}
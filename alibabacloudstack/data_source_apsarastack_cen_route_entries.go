@@ -0,0 +1,116 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackCenRouteEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackCenRouteEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operational_mode": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"publish_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenRouteEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	objects, err := cenService.ListCenPublishedRouteEntries(d.Get("instance_id").(string), d.Get("child_instance_id").(string), d.Get("route_table_id").(string), d.Get("cidr_block").(string))
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	ids := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		mapping := map[string]interface{}{
+			"route_table_id":   object.ChildInstanceRouteTableId,
+			"cidr_block":       object.DestinationCidrBlock,
+			"next_hop_type":    object.NextHopType,
+			"next_hop_id":      object.NextHopId,
+			"route_type":       object.RouteType,
+			"operational_mode": object.OperationalMode,
+			"publish_status":   object.PublishStatus,
+		}
+		ids = append(ids, object.ChildInstanceRouteTableId+COLON_SEPARATED+object.DestinationCidrBlock)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("entries", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackCloudFirewallControlPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead,
+		ReadContext: dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"acl_action": {
 				Type:         schema.TypeString,
@@ -195,8 +197,8 @@ func dataSourceAlibabacloudStackCloudFirewallControlPolicies() *schema.Resource
 	}
 }
 
-func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeControlPolicy"
 	request := make(map[string]interface{})
@@ -237,13 +239,13 @@ func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(d *schema.Resou
 	var response map[string]interface{}
 	conn, err := client.NewCloudfwClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -256,11 +258,11 @@ func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(d *schema.Resou
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudStack_cloud_firewall_control_policies", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudStack_cloud_firewall_control_policies", action, AlibabacloudStackSdkGoERROR))
 		}
 		resp, err := jsonpath.Get("$.Policys", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Policys", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Policys", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -308,11 +310,11 @@ func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(d *schema.Resou
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("policies", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudstackCmsAlarmContactGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsAlarmContactGroupsRead,
+		ReadContext: dataSourceAlibabacloudstackCmsAlarmContactGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -69,8 +71,8 @@ func dataSourceAlibabacloudstackCmsAlarmContactGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := cms.CreateDescribeContactGroupListRequest()
 	request.Headers["x-ascm-product-name"] = "Cms"
@@ -81,7 +83,7 @@ func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(d *schema.ResourceData
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		alarmContactGroupNameRegex = r
 	}
@@ -101,7 +103,7 @@ func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(d *schema.ResourceData
 			return cmsClient.DescribeContactGroupList(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_alarm_contact_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_alarm_contact_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw)
 		response, _ = raw.(*cms.DescribeContactGroupListResponse)
@@ -125,7 +127,7 @@ func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(d *schema.ResourceData
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -147,15 +149,15 @@ func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(d *schema.ResourceData
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("groups", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
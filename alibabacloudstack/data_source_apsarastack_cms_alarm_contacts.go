@@ -1,21 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudstackCmsAlarmContacts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsAlarmContactsRead,
+		ReadContext: dataSourceAlibabacloudstackCmsAlarmContactsRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -69,26 +69,11 @@ func dataSourceAlibabacloudstackCmsAlarmContacts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsAlarmContactsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackCmsAlarmContactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	request.Headers["x-ascm-product-name"] = "Cms"
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "ListCmsContacts"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Product": "ascm", "RegionId": client.RegionId, "Action": "ListCmsContacts", "Version": string(connectivity.ApiVersion20190510)}
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("GET", "ascm", "2019-05-10", "ListCmsContacts", "")
+	request.QueryParams["Version"] = string(connectivity.ApiVersion20190510)
 	response := CmsContact{}
 
 	for {
@@ -98,14 +83,14 @@ func dataSourceAlibabacloudstackCmsAlarmContactsRead(d *schema.ResourceData, met
 		log.Printf(" response of raw ListCmsContacts : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_alarm_contacts", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_alarm_contacts", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || len(response.Data) < 1 {
 			break
@@ -133,7 +118,7 @@ func dataSourceAlibabacloudstackCmsAlarmContactsRead(d *schema.ResourceData, met
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("contacts", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
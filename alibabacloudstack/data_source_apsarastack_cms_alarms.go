@@ -1,21 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudstackCmsAlarms() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsAlarmsRead,
+		ReadContext: dataSourceAlibabacloudstackCmsAlarmsRead,
 		Schema: map[string]*schema.Schema{
 			"rule_id": {
 				Type:         schema.TypeString,
@@ -179,31 +179,9 @@ func dataSourceAlibabacloudstackCmsAlarms() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudstackCmsAlarmsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "cms"
-	request.Version = "2019-01-01"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "DescribeMetricRuleList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Cms",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeMetricRuleList",
-		"Version":         "2019-01-01",
-	}
+func dataSourceAlibabacloudstackCmsAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("GET", "cms", "2019-01-01", "DescribeMetricRuleList", "")
 	response := AlarmsData{}
 
 	for {
@@ -213,14 +191,14 @@ func dataSourceAlibabacloudstackCmsAlarmsRead(d *schema.ResourceData, meta inter
 		log.Printf(" response of raw DescribeMetricRuleList : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_alarms", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_alarms", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Success == true || len(response.Alarms.Alarm) < 1 {
 			break
@@ -272,7 +250,7 @@ func dataSourceAlibabacloudstackCmsAlarmsRead(d *schema.ResourceData, meta inter
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("alarms", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
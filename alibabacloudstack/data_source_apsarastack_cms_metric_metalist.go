@@ -1,22 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudstackCmsMetricMetalist() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsMetricMetalistRead,
+		ReadContext: dataSourceAlibabacloudstackCmsMetricMetalistRead,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:         schema.TypeString,
@@ -75,35 +75,12 @@ func dataSourceAlibabacloudstackCmsMetricMetalist() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsMetricMetalistRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudstackCmsMetricMetalistRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	Namespace := d.Get("namespace").(string)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "cms"
-	request.Version = "2019-01-01"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-
-	request.RegionId = client.RegionId
-	request.ApiName = "DescribeMetricMetaList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Cms",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeMetricMetaList",
-		"Version":         "2019-01-01",
-		"Namespace":       Namespace,
-		"ProductName":     "cms",
-	}
+	request := client.NewCommonRequest("GET", "cms", "2019-01-01", "DescribeMetricMetaList", "")
+	request.QueryParams["Namespace"] = Namespace
+	request.QueryParams["ProductName"] = "cms"
 	response := MetaList{}
 
 	for {
@@ -113,14 +90,14 @@ func dataSourceAlibabacloudstackCmsMetricMetalistRead(d *schema.ResourceData, me
 		log.Printf(" response of raw DescribeMetricMetaList : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_metric_metalist", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cms_metric_metalist", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		if len(response.Resources.Resource) < 1 || response.Success == true {
@@ -153,7 +130,7 @@ func dataSourceAlibabacloudstackCmsMetricMetalistRead(d *schema.ResourceData, me
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("resources", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,12 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"encoding/json"
 	"fmt"
 	"regexp"
-	"strings"
 	
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -16,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackCmsMetricRuleTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead,
+		ReadContext: dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			"keyword": {
 				Type:     schema.TypeString,
@@ -88,15 +88,15 @@ func dataSourceAlibabacloudStackCmsMetricRuleTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	var objects []cms.Template
 	var templateNameRegex *regexp.Regexp
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		templateNameRegex = r
 	}
@@ -111,29 +111,9 @@ func dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead(d *schema.ResourceDat
 		}
 	}
 	
-	request := requests.NewCommonRequest()
-	request.Product = "cms"
-	request.Version = "2019-01-01"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "DescribeMetricRuleTemplateList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "cms",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeMetricRuleTemplateList",
-		"Version":         "2019-01-01",
-		"pageSize":        "10",
-		//"roleType":        roleType,
-	}
+	request := client.NewCommonRequest("GET", "cms", "2019-01-01", "DescribeMetricRuleTemplateList", "")
+	//"roleType":        roleType,
+	request.QueryParams["pageSize"] = "10"
 	
 	
 	if v, ok := d.GetOk("keyword"); ok {
@@ -150,7 +130,7 @@ func dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead(d *schema.ResourceDat
 			return cmsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "cms_metric_rule_templates", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "cms_metric_rule_templates", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw)
 		response, _ := raw.(*responses.CommonResponse)
@@ -175,7 +155,7 @@ func dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead(d *schema.ResourceDat
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -197,15 +177,15 @@ func dataSourceAlibabacloudStackCmsMetricRuleTemplatesRead(d *schema.ResourceDat
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	
 	if err := d.Set("templates", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
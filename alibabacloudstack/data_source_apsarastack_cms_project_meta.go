@@ -1,21 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudstackCmsProjectMeta() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsProjectMetaRead,
+		ReadContext: dataSourceAlibabacloudstackCmsProjectMetaRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -49,32 +49,11 @@ func dataSourceAlibabacloudstackCmsProjectMeta() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsProjectMetaRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudstackCmsProjectMetaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-01-01"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "DescribeProjectMeta"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Cms",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeProjectMeta",
-		"Version":         "2019-01-01",
-	}
+	request := client.NewCommonRequest("POST", "ascm", "2019-01-01", "DescribeProjectMeta", "")
+	request.QueryParams["Product"] = "Cms"
 	response := Data{}
 
 	for {
@@ -84,14 +63,14 @@ func dataSourceAlibabacloudstackCmsProjectMetaRead(d *schema.ResourceData, meta
 		log.Printf(" response of raw DescribeProjectMeta : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_instance_families", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_instance_families", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Success == true || len(response.Resources.Resource) < 1 {
 			break
@@ -118,7 +97,7 @@ func dataSourceAlibabacloudstackCmsProjectMetaRead(d *schema.ResourceData, meta
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("resources", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCommonBandwidthPackages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCommonBandwidthPackagesRead,
+		ReadContext: dataSourceAlibabacloudStackCommonBandwidthPackagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -105,8 +107,8 @@ func dataSourceAlibabacloudStackCommonBandwidthPackages() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeCommonBandwidthPackagesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -116,7 +118,7 @@ func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceDa
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -147,7 +149,7 @@ func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceDa
 			raw = response
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_common_bandwidth_packages", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_common_bandwidth_packages", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeCommonBandwidthPackagesResponse)
@@ -174,13 +176,13 @@ func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceDa
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
 
-	return CommonBandwidthPackagesDecriptionAttributes(d, allCommonBandwidthPackages, meta)
+	return DiagnosticsFromError(CommonBandwidthPackagesDecriptionAttributes(d, allCommonBandwidthPackages, meta))
 }
 
 func CommonBandwidthPackagesDecriptionAttributes(d *schema.ResourceData, cbwps []vpc.CommonBandwidthPackage, meta interface{}) error {
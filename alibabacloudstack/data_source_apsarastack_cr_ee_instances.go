@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"sort"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCrEEInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEEInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackCrEEInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -90,8 +92,8 @@ func dataSourceAlibabacloudStackCrEEInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEEInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCrEEInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	pageNo := 1
 	pageSize := 50
@@ -100,7 +102,7 @@ func dataSourceAlibabacloudStackCrEEInstancesRead(d *schema.ResourceData, meta i
 	for {
 		resp, err := crService.ListCrEEInstances(pageNo, pageSize)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		instances = append(instances, resp.Instances...)
 		if len(resp.Instances) < pageSize {
@@ -150,11 +152,11 @@ func dataSourceAlibabacloudStackCrEEInstancesRead(d *schema.ResourceData, meta i
 	for _, instance := range instances {
 		usageResp, err := crService.GetCrEEInstanceUsage(instance.InstanceId)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		endpointResp, err := crService.ListCrEEInstanceEndpoint(instance.InstanceId)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		var (
@@ -195,13 +197,13 @@ func dataSourceAlibabacloudStackCrEEInstancesRead(d *schema.ResourceData, meta i
 
 	d.SetId(dataResourceIdHash(names))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("instances", instanceMaps); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok {
@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"sort"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCrEENamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEENamespacesRead,
+		ReadContext: dataSourceAlibabacloudStackCrEENamespacesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -73,8 +75,8 @@ func dataSourceAlibabacloudStackCrEENamespaces() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEENamespacesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCrEENamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	pageNo := 1
 	pageSize := 50
@@ -84,7 +86,7 @@ func dataSourceAlibabacloudStackCrEENamespacesRead(d *schema.ResourceData, meta
 	for {
 		resp, err := crService.ListCrEENamespaces(instanceId, pageNo, pageSize)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		namespaces = append(namespaces, resp.Namespaces...)
 		if len(resp.Namespaces) < pageSize {
@@ -146,13 +148,13 @@ func dataSourceAlibabacloudStackCrEENamespacesRead(d *schema.ResourceData, meta
 
 	d.SetId(dataResourceIdHash(names))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("namespaces", namespaceMaps); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok {
@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCrEERepos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEEReposRead,
+		ReadContext: dataSourceAlibabacloudStackCrEEReposRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -126,8 +128,8 @@ func dataSourceAlibabacloudStackCrEERepos() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEEReposRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCrEEReposRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	pageNo := 1
 	pageSize := 100
@@ -140,7 +142,7 @@ func dataSourceAlibabacloudStackCrEEReposRead(d *schema.ResourceData, meta inter
 		for {
 			resp, err := crService.ListCrEENamespaces(instanceId, pageNo, pageSize)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			for _, n := range resp.Namespaces {
 				namespaces = append(namespaces, n.NamespaceName)
@@ -176,7 +178,7 @@ func dataSourceAlibabacloudStackCrEEReposRead(d *schema.ResourceData, meta inter
 		for {
 			resp, err := crService.ListCrEERepos(instanceId, namespace, pageNo, pageSize)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			for _, r := range resp.Repositories {
 				if nameRegex != nil && !nameRegex.MatchString(r.RepoName) {
@@ -204,7 +206,7 @@ func dataSourceAlibabacloudStackCrEEReposRead(d *schema.ResourceData, meta inter
 			for {
 				resp, err := crService.ListCrEERepoTags(instanceId, repo.RepoId, pageNo, pageSize)
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 				images = append(images, resp.Images...)
 
@@ -251,13 +253,13 @@ func dataSourceAlibabacloudStackCrEEReposRead(d *schema.ResourceData, meta inter
 
 	d.SetId(dataResourceIdHash(names))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("repos", reposMaps); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
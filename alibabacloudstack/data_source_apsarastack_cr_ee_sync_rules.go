@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"sort"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCrEESyncRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEESyncRulesRead,
+		ReadContext: dataSourceAlibabacloudStackCrEESyncRulesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -126,8 +128,8 @@ func dataSourceAlibabacloudStackCrEESyncRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEESyncRulesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCrEESyncRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
 
@@ -166,7 +168,7 @@ func dataSourceAlibabacloudStackCrEESyncRulesRead(d *schema.ResourceData, meta i
 		response := &cr_ee.ListRepoSyncRuleResponse{}
 		request := cr_ee.CreateListRepoSyncRuleRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.RegionId = crService.client.RegionId
 		request.InstanceId = instanceId
@@ -186,13 +188,13 @@ func dataSourceAlibabacloudStackCrEESyncRulesRead(d *schema.ResourceData, meta i
 			return creeClient.ListRepoSyncRule(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cr_ee_sync_rules", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cr_ee_sync_rules", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 		response, _ = raw.(*cr_ee.ListRepoSyncRuleResponse)
 		if !response.ListRepoSyncRuleIsSuccess {
-			return crService.wrapCrServiceError("alibabacloudstack_cr_ee_sync_rules", request.GetActionName(), response.Code)
+			return DiagnosticsFromError(crService.wrapCrServiceError("alibabacloudstack_cr_ee_sync_rules", request.GetActionName(), response.Code))
 		}
 
 		for _, rule := range response.SyncRules {
@@ -242,13 +244,13 @@ func dataSourceAlibabacloudStackCrEESyncRulesRead(d *schema.ResourceData, meta i
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("rules", rulesMaps); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,22 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"log"
 	"regexp"
-	"strings"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCRNamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCRNamespacesRead,
+		ReadContext: dataSourceAlibabacloudStackCRNamespacesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -62,37 +62,16 @@ func dataSourceAlibabacloudStackCRNamespaces() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCRNamespacesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCRNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := CrService{client}
 	//invoker := NewInvoker()
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = client.Domain
-	request.Version = "2016-06-07"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetNamespaceList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "GetNamespaceList",
-		"Version":         "2016-06-07",
-	}
+	request := client.NewCommonRequest("POST", "cr", "2016-06-07", "GetNamespaceList", "")
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	var crResp crListResponse
 	bresponse, _ := raw.(*responses.CommonResponse)
@@ -102,7 +81,7 @@ func dataSourceAlibabacloudStackCRNamespacesRead(d *schema.ResourceData, meta in
 
 	addDebug(request.GetActionName(), bresponse)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var names []string
@@ -125,7 +104,7 @@ func dataSourceAlibabacloudStackCRNamespacesRead(d *schema.ResourceData, meta in
 			if NotFoundError(err) {
 				continue
 			}
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		mapping["auto_create"] = raw.Data.Namespace.AutoCreate
 		mapping["default_visibility"] = raw.Data.Namespace.DefaultVisibility
@@ -136,13 +115,13 @@ func dataSourceAlibabacloudStackCRNamespacesRead(d *schema.ResourceData, meta in
 
 	d.SetId(dataResourceIdHash(names))
 	if err := d.Set("namespaces", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	// create a json file in current directory and write data source to it.
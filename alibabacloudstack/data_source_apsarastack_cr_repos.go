@@ -1,22 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"log"
 	"regexp"
-	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCRRepos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCRReposRead,
+		ReadContext: dataSourceAlibabacloudStackCRReposRead,
 
 		Schema: map[string]*schema.Schema{
 			"namespace": {
@@ -100,35 +100,14 @@ func dataSourceAlibabacloudStackCRRepos() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCRReposRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = client.Domain
-	request.Version = "2016-06-07"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetRepoList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "GetRepoList",
-		"Version":         "2016-06-07",
-	}
+func dataSourceAlibabacloudStackCRReposRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := client.NewCommonRequest("POST", "cr", "2016-06-07", "GetRepoList", "")
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	repos := crResponseList{}
 	resp := raw.(*responses.CommonResponse)
@@ -136,7 +115,7 @@ func dataSourceAlibabacloudStackCRReposRead(d *schema.ResourceData, meta interfa
 	err = json.Unmarshal(resp.GetHttpContentBytes(), &repos)
 	log.Printf("unmarshalled response %v", &repos)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var names []string
@@ -180,13 +159,13 @@ func dataSourceAlibabacloudStackCRReposRead(d *schema.ResourceData, meta interfa
 
 	d.SetId(dataResourceIdHash(names))
 	if err := d.Set("repos", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	// create a json file in current directory and write data source to it.
@@ -1,9 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -11,8 +11,8 @@ import (
 	"github.com/go-yaml/yaml"
 	"io/ioutil"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"regexp"
@@ -20,7 +20,7 @@ import (
 
 func dataSourceAlibabacloudStackCSKubernetesClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCSKubernetesClustersRead,
+		ReadContext: dataSourceAlibabacloudStackCSKubernetesClustersRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -301,28 +301,11 @@ func dataSourceAlibabacloudStackCSKubernetesClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackCSKubernetesClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "GET"
-	request.Product = "Cs"
-	request.Version = "2015-12-15"
-
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-
-	request.ServiceCode = "cs"
-	request.ApiName = "DescribeClustersV1"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "Cs", "RegionId": client.RegionId, "Action": "DescribeClustersV1", "Version": cs.CSAPIVersion, "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-	request.RegionId = client.RegionId
+	request := client.NewCommonRequest("GET", "Cs", "2015-12-15", "DescribeClustersV1", "")
+	request.QueryParams["Version"] = cs.CSAPIVersion
 	Cresponse := ClustersV1{}
 	Clusterresponse := ClustersV1{}
 
@@ -330,7 +313,7 @@ func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData,
 		return csClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cs_kubernetes_clusters", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cs_kubernetes_clusters", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	resp, _ := raw.(*responses.CommonResponse)
 	request.TransToAcsRequest()
@@ -338,7 +321,7 @@ func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData,
 	log.Printf("clusterResponse2 %v", resp.GetHttpContentBytes())
 	err = json.Unmarshal(resp.GetHttpContentBytes(), &Clusterresponse)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	//var nullc ClustersV1
 	//if Clusterresponsenullc{
@@ -404,13 +387,13 @@ func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData,
 	}
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("clusters", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if file, ok := d.GetOk("kube_config"); ok && file.(string) != "" {
@@ -418,37 +401,17 @@ func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData,
 
 		for i, k := range clusterids {
 			log.Printf("IDS %v", clusterids)
-			request.Method = "POST"
-			request.Product = "Cs"
-			request.Version = "2015-12-15"
-
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.ServiceCode = "cs"
-			request.ApiName = "DescribeClusterUserKubeconfig"
-			request.Headers = map[string]string{"RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-			request.RegionId = client.RegionId
-			request.QueryParams = map[string]string{
-				"AccessKeyId":      client.AccessKey,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "Cs",
-				"RegionId":         client.RegionId,
-				"Action":           "DescribeClustersV1",
-				"Version":          cs.CSAPIVersion,
-				"Department":       client.Department,
-				"ResourceGroup":    client.ResourceGroup,
-				"PrivateIpAddress": "false",
-				"ClusterId":        k,
-			}
+			request := client.NewCommonRequest("POST", "Cs", "2015-12-15", "DescribeClusterUserKubeconfig", "")
+			request.Headers["Department"] = client.Department
+			request.Headers["ResourceGroup"] = client.ResourceGroup
+			request.QueryParams["PrivateIpAddress"] = "false"
+			request.QueryParams["ClusterId"] = k
 			log.Printf("request body %v", request)
 			raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 				return csClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cs_kubernetes_clusters", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cs_kubernetes_clusters", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			resp, _ := raw.(*responses.CommonResponse)
 			//request.TransToAcsRequest()
@@ -456,7 +419,7 @@ func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData,
 			var kubeconf Config
 			err = json.Unmarshal(resp.GetHttpContentBytes(), &conf)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			err = yaml.Unmarshal([]byte(conf.Config), &kubeconf)
 			if err != nil {
@@ -1,14 +1,14 @@
 package alibabacloudstack
 
 import (
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
-	"strings"
 	"time"
 
 	_ "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -17,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackDatahubService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDatahubServiceRead,
+		ReadContext: dataSourceAlibabacloudStackDatahubServiceRead,
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -33,39 +33,18 @@ func dataSourceAlibabacloudStackDatahubService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDatahubServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDatahubServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
 		d.SetId("DatahubServiceHasNotBeenOpened")
 		d.Set("status", "")
 		return nil
 	}
 	action := "OpenDataHubService"
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "datahub"
-	request.Version = "2019-11-20"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-
-	request.RegionId = client.RegionId
-	request.ApiName = "OpenDataHubService"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Action":          "OpenDataHubService",
-		"Version":         "2019-11-20",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-	}
+	request := client.NewCommonRequest("GET", "datahub", "2019-11-20", "OpenDataHubService", "")
+	request.Domain = client.Domain
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -85,7 +64,7 @@ func dataSourceAlibabacloudStackDatahubServiceRead(d *schema.ResourceData, meta
 			d.Set("status", "Opened")
 			return nil
 		}
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_datahub_service", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_datahub_service", action, AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId("DatahubServiceHasBeenOpened")
 	d.Set("status", "Opened")
@@ -0,0 +1,159 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackDBAuditLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackDBAuditLogsRead,
+
+		Schema: map[string]*schema.Schema{
+			"db_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"query_keywords": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sql_text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_execution_times": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"return_row_counts": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"execute_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thread_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackDBAuditLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := rds.CreateDescribeSQLLogRecordsRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.DBInstanceId = d.Get("db_instance_id").(string)
+	request.StartTime = d.Get("start_time").(string)
+	request.EndTime = d.Get("end_time").(string)
+	if v, ok := d.GetOk("db_name"); ok {
+		request.Database = v.(string)
+	}
+	if v, ok := d.GetOk("user"); ok {
+		request.User = v.(string)
+	}
+	if v, ok := d.GetOk("query_keywords"); ok {
+		request.QueryKeywords = v.(string)
+	}
+	request.PageSize = requests.NewInteger(PageSizeXLarge)
+	request.PageNumber = requests.NewInteger(1)
+
+	var records []rds.SQLRecord
+	for {
+		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.DescribeSQLLogRecords(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_db_audit_logs", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*rds.DescribeSQLLogRecordsResponse)
+		records = append(records, response.Items.SQLRecord...)
+		if len(response.Items.SQLRecord) < PageSizeXLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		request.PageNumber = page
+	}
+
+	var ids []string
+	s := make([]map[string]interface{}, 0)
+	for _, record := range records {
+		mapping := map[string]interface{}{
+			"db_name":               record.DBName,
+			"account_name":          record.AccountName,
+			"host_address":          record.HostAddress,
+			"sql_text":              record.SQLText,
+			"total_execution_times": record.TotalExecutionTimes,
+			"return_row_counts":     record.ReturnRowCounts,
+			"execute_time":          record.ExecuteTime,
+			"thread_id":             record.ThreadID,
+		}
+		ids = append(ids, record.ExecuteTime+COLON_SEPARATED+record.ThreadID)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("logs", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
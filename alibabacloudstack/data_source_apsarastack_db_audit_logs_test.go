@@ -0,0 +1,53 @@
+package alibabacloudstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackDBAuditLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlibabacloudStackDBAuditLogsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlibabacloudStackDataSourceID("data.alibabacloudstack_db_audit_logs.default"),
+					resource.TestCheckResourceAttrSet("data.alibabacloudstack_db_audit_logs.default", "logs.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlibabacloudStackDBAuditLogsDataSourceConfig = RdsCommonTestCase + `
+
+variable "name" {
+  default = "tf-testAccDBAuditLogsConfig"
+}
+
+variable "creation" {
+  default = "Rds"
+}
+
+resource "alibabacloudstack_db_instance" "default" {
+  engine               = "MySQL"
+  engine_version       = "5.6"
+  instance_type        = "rds.mysql.s2.large"
+  instance_storage     = "30"
+  instance_name        = "${var.name}"
+  vswitch_id           = "${alibabacloudstack_vswitch.default.id}"
+  storage_type         = "local_ssd"
+  sql_collector_status = "Enabled"
+}
+
+data "alibabacloudstack_db_audit_logs" "default" {
+  db_instance_id = "${alibabacloudstack_db_instance.default.id}"
+  start_time     = "2020-01-01T00:00Z"
+  end_time       = "2030-01-01T00:00Z"
+}
+`
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 	"strings"
@@ -8,13 +9,14 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackDBInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDBInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackDBInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -187,8 +189,8 @@ func dataSourceAlibabacloudStackDBInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDBInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := rds.CreateDescribeDBInstancesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -198,7 +200,7 @@ func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta int
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Engine = d.Get("engine").(string)
 	request.DBInstanceStatus = d.Get("status").(string)
 	request.DBInstanceType = d.Get("db_type").(string)
@@ -209,7 +211,7 @@ func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta int
 		tagsMap := v.(map[string]interface{})
 		bs, err := json.Marshal(tagsMap)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Tags = string(bs)
 	}
@@ -222,7 +224,7 @@ func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta int
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		nameRegex = r
 	}
@@ -240,7 +242,7 @@ func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta int
 			return rdsClient.DescribeDBInstances(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_db_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_db_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*rds.DescribeDBInstancesResponse)
@@ -270,11 +272,11 @@ func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta int
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
-	return rdsInstancesDescription(d, meta, dbi)
+	return DiagnosticsFromError(rdsInstancesDescription(d, meta, dbi))
 }
 
 func rdsInstancesDescription(d *schema.ResourceData, meta interface{}, dbi []rds.DBInstance) error {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackDBZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDBZonesRead,
+		ReadContext: dataSourceAlibabacloudStackDBZonesRead,
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -60,8 +62,8 @@ func dataSourceAlibabacloudStackDBZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDBZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
@@ -73,9 +75,9 @@ func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	var response = &rds.DescribeRegionsResponse{}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (i interface{}, err error) {
 			return rdsClient.DescribeRegions(request)
 		})
@@ -91,10 +93,10 @@ func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_db_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_db_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	if len(response.Regions.RDSRegion) <= 0 {
-		return WrapError(fmt.Errorf("[ERROR] There is no available zone for RDS."))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available zone for RDS.")))
 	}
 	for _, r := range response.Regions.RDSRegion {
 		if multi && strings.Contains(r.ZoneId, MULTI_IZ_SYMBOL) && r.RegionId == string(client.Region) {
@@ -127,10 +129,10 @@ func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interfa
 	}
 	d.SetId(dataResourceIdHash(zoneIds))
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", zoneIds); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,23 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"regexp"
 	"strconv"
-	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackDisks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDisksRead,
+		ReadContext: dataSourceAlibabacloudStackDisksRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -148,35 +149,15 @@ func dataSourceAlibabacloudStackDisks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDisksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Version = "2014-05-26"
-
-	request.ApiName = "DescribeDisks"
-	request.Headers = map[string]string{"RegionId": client.RegionId, "Content-Type": requests.Json}
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", "DescribeDisks", "")
+	request.Headers["Content-Type"] = requests.Json
 	PageNumber := 1
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ecs",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeDisks",
-		"Version":         "2014-05-26",
-		"ResourceGroupId": client.ResourceGroup,
-		"PageSize":        "50",
-		"PageNumber":      strconv.Itoa(PageNumber),
-	}
+	request.QueryParams["ResourceGroupId"] = client.ResourceGroup
+	request.QueryParams["PageSize"] = "50"
+	request.QueryParams["PageNumber"] = strconv.Itoa(PageNumber)
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
 		request.QueryParams["DiskIds"] = convertListToJsonString(v.([]interface{}))
 	}
@@ -211,13 +192,13 @@ func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_disks", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_disks", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request, request.QueryParams)
 		response, _ := raw.(*responses.CommonResponse)
 		err = json.Unmarshal(response.GetHttpContentBytes(), &resp)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_disks", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_disks", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		Disks := resp["Disks"].(map[string]interface{})["Disk"].([]interface{})
 		log.Printf("ecsDescribeDisk25 %v", response)
@@ -235,7 +216,7 @@ func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface
 
 		PageNumber = PageNumber + 1
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.QueryParams["PageNumber"] = strconv.Itoa(PageNumber)
 	}
@@ -270,7 +251,7 @@ func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface
 	} else {
 		filteredDisksTemp = allDisks
 	}
-	return disksDescriptionAttributes(d, filteredDisksTemp, meta)
+	return DiagnosticsFromError(disksDescriptionAttributes(d, filteredDisksTemp, meta))
 }
 
 func disksDescriptionAttributes(d *schema.ResourceData, disks []interface{}, meta interface{}) error {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -8,13 +9,14 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackDmsEnterpriseInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDmsEnterpriseInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackDmsEnterpriseInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:          schema.TypeString,
@@ -188,8 +190,8 @@ func dataSourceAlibabacloudStackDmsEnterpriseInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDmsEnterpriseInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDmsEnterpriseInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "ListInstances"
 	request := make(map[string]interface{})
@@ -221,21 +223,21 @@ func dataSourceAlibabacloudStackDmsEnterpriseInstancesRead(d *schema.ResourceDat
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		instanceNameRegex = r
 	}
 	if v, ok := d.GetOk("instance_alias_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		instanceNameRegex = r
 	}
 	var response map[string]interface{}
 	conn, err := client.NewDmsenterpriseClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
@@ -245,13 +247,13 @@ func dataSourceAlibabacloudStackDmsEnterpriseInstancesRead(d *schema.ResourceDat
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dms_enterprise_instances", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dms_enterprise_instances", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.InstanceList.Instance", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.InstanceList.Instance", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.InstanceList.Instance", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -305,15 +307,15 @@ func dataSourceAlibabacloudStackDmsEnterpriseInstancesRead(d *schema.ResourceDat
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("instances", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -8,13 +9,14 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackDmsEnterpriseUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDmsEnterpriseUsersRead,
+		ReadContext: dataSourceAlibabacloudStackDmsEnterpriseUsersRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -113,8 +115,8 @@ func dataSourceAlibabacloudStackDmsEnterpriseUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "ListUsers"
 	request := make(map[string]interface{})
@@ -137,7 +139,7 @@ func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(d *schema.ResourceData, m
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		userNameRegex = r
 	}
@@ -154,7 +156,7 @@ func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(d *schema.ResourceData, m
 	var response map[string]interface{}
 	conn, err := client.NewDmsenterpriseClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
@@ -164,13 +166,13 @@ func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(d *schema.ResourceData, m
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dms_enterprise_users", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dms_enterprise_users", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.UserList.User", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.UserList.User", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.UserList.User", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -215,15 +217,15 @@ func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(d *schema.ResourceData, m
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("users", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,21 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
-	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackDnsDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsDomainsRead,
+		ReadContext: dataSourceAlibabacloudStackDnsDomainsRead,
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -115,36 +116,16 @@ func dataSourceAlibabacloudStackDnsDomains() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDnsDomainsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "CloudDns"
-	request.Domain = client.Domain
-	request.Version = "2021-06-24"
+func dataSourceAlibabacloudStackDnsDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	name := d.Get("domain_name").(string)
+	request := client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "DescribeGlobalZones", "")
 	request.PageNumber = requests.NewInteger(2)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DescribeGlobalZones"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":   client.SecretKey,
-		"AccessKeyId":       client.AccessKey,
-		"Product":           "CloudDns",
-		"RegionId":          client.RegionId,
-		"Action":            "DescribeGlobalZones",
-		"Version":           "2021-06-24",
-		"PageNumber":        fmt.Sprint(1),
-		"PageSize":          fmt.Sprint(PageSizeLarge),
-		"Name":              name,
-		"Forwardedregionid": client.RegionId,
-		"SignatureVersion":  "2.1",
-	}
+	request.QueryParams["PageNumber"] = fmt.Sprint(1)
+	request.QueryParams["PageSize"] = fmt.Sprint(PageSizeLarge)
+	request.QueryParams["Name"] = name
+	request.QueryParams["Forwardedregionid"] = client.RegionId
 
 	var addDomains = DnsDomains{}
 	for {
@@ -152,13 +133,13 @@ func dataSourceAlibabacloudStackDnsDomainsRead(d *schema.ResourceData, meta inte
 			return alidnsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dns_domains", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dns_domains", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request)
 		response, _ := raw.(*responses.CommonResponse)
 		err = json.Unmarshal(response.GetHttpContentBytes(), &addDomains)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.IsSuccess() == true || len(addDomains.Data) < 1 {
 			break
@@ -188,7 +169,7 @@ func dataSourceAlibabacloudStackDnsDomainsRead(d *schema.ResourceData, meta inte
 	}
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("domains", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackDnsGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackDnsGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -56,12 +58,12 @@ func dataSourceAlibabacloudStackDnsGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDnsGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDnsGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := alidns.CreateDescribeDomainGroupsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns", "product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns", "product": "alidns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	var allGroups []alidns.DomainGroup
@@ -79,7 +81,7 @@ func dataSourceAlibabacloudStackDnsGroupsRead(d *schema.ResourceData, meta inter
 			return dnsClient.DescribeDomainGroups(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dns_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dns_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*alidns.DescribeDomainGroupsResponse)
@@ -96,7 +98,7 @@ func dataSourceAlibabacloudStackDnsGroupsRead(d *schema.ResourceData, meta inter
 			break
 		}
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -115,7 +117,7 @@ func dataSourceAlibabacloudStackDnsGroupsRead(d *schema.ResourceData, meta inter
 		filteredGroups = allGroups[:]
 	}
 
-	return groupsDecriptionAttributes(d, filteredGroups, meta)
+	return DiagnosticsFromError(groupsDecriptionAttributes(d, filteredGroups, meta))
 }
 
 func groupsDecriptionAttributes(d *schema.ResourceData, groupTypes []alidns.DomainGroup, meta interface{}) error {
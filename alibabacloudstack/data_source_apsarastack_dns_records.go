@@ -1,22 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"regexp"
 	"strconv"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackDnsRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsRecordsRead,
+		ReadContext: dataSourceAlibabacloudStackDnsRecordsRead,
 
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -88,32 +88,11 @@ func dataSourceAlibabacloudStackDnsRecords() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDnsRecordsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDnsRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ZoneId := d.Get("zone_id").(string)
-	request := requests.NewCommonRequest()
-
-	request.Domain = client.Domain
-	request.Method = "POST"
-	request.Product = "CloudDns"
-	request.Version = "2021-06-24"
-	request.ServiceCode = "CloudDns"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DescribeGlobalZoneRecords"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"RegionId":        client.RegionId,
-		"Product":         "CloudDns",
-		"Action":          "DescribeGlobalZoneRecords",
-		"Version":         "2021-06-24",
-		"ZoneId":          ZoneId,
-	}
+	request := client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "DescribeGlobalZoneRecords", "")
+	request.QueryParams["ZoneId"] = ZoneId
 
 	response := DnsRecord{}
 
@@ -124,14 +103,14 @@ func dataSourceAlibabacloudStackDnsRecordsRead(d *schema.ResourceData, meta inte
 		log.Printf(" response of raw ObtainGlobalAuthRecordList : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dns_records", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dns_records", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Data != nil {
 			break
@@ -164,10 +143,10 @@ func dataSourceAlibabacloudStackDnsRecordsRead(d *schema.ResourceData, meta inte
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("records", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
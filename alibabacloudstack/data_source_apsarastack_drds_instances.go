@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/drds"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackDRDSInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDRDSInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackDRDSInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -84,8 +86,8 @@ func dataSourceAlibabacloudStackDRDSInstances() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDRDSInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackDRDSInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := drds.CreateDescribeDrdsInstancesRequest()
 	request.RegionId = client.RegionId
 	var dbi []drds.Instance
@@ -118,7 +120,7 @@ func dataSourceAlibabacloudStackDRDSInstancesRead(d *schema.ResourceData, meta i
 		return drdsClient.DescribeDrdsInstances(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_drds_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_drds_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*drds.DescribeDrdsInstancesResponse)
@@ -138,7 +140,7 @@ func dataSourceAlibabacloudStackDRDSInstancesRead(d *schema.ResourceData, meta i
 
 		dbi = append(dbi, item)
 	}
-	return drdsInstancesDescription(d, dbi)
+	return DiagnosticsFromError(drdsInstancesDescription(d, dbi))
 }
 func drdsInstancesDescription(d *schema.ResourceData, dbi []drds.Instance) error {
 	var ids []string
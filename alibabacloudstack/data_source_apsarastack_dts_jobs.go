@@ -0,0 +1,323 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dtsJobSteps are the phases a job goes through, keyed by the name of their status in the job detail.
+var dtsJobSteps = []struct {
+	name string
+	key  string
+}{
+	{"precheck", "PrecheckStatus"},
+	{"structure_initialization", "StructureInitializationStatus"},
+	{"data_initialization", "DataInitializationStatus"},
+	{"data_synchronization", "DataSynchronizationStatus"},
+	{"data_etl", "DataEtlStatus"},
+}
+
+func dataSourceAlibabacloudStackDtsJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackDtsJobsRead,
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+				ForceNew:     true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SYNC",
+				ValidateFunc: validation.StringInSlice([]string{"MIGRATION", "SYNC", "SUBSCRIBE"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enable_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dts_job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dts_job_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dts_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delay": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checkpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_engine_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_endpoint_engine_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_endpoint_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"steps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"percent": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"progress": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"delay": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"error_message": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackDtsJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	dtsService := DtsService{client}
+
+	action := "DescribeDtsJobs"
+	request := make(map[string]interface{})
+	request["JobType"] = d.Get("job_type")
+	if v, ok := d.GetOk("status"); ok {
+		request["Status"] = v
+	}
+	request["Region"] = client.RegionId
+	request["RegionId"] = client.RegionId
+	request["product"] = "Dts"
+	request["OrganizationId"] = client.Department
+	request["ResourceId"] = client.ResourceGroup
+	request["PageSize"] = PageSizeLarge
+	request["PageNumber"] = 1
+	var objects []map[string]interface{}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		nameRegex = r
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	var response map[string]interface{}
+	conn, err := client.NewDtsClient()
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	for {
+		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dts_jobs", action, AlibabacloudStackSdkGoERROR))
+		}
+		resp, err := jsonpath.Get("$.DtsJobList", response)
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.DtsJobList", response))
+		}
+		result, _ := resp.([]interface{})
+		for _, v := range result {
+			item := v.(map[string]interface{})
+			if nameRegex != nil && !nameRegex.MatchString(fmt.Sprint(item["DtsJobName"])) {
+				continue
+			}
+			if len(idsMap) > 0 {
+				if _, ok := idsMap[fmt.Sprint(item["DtsJobId"])]; !ok {
+					continue
+				}
+			}
+			objects = append(objects, item)
+		}
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+
+	ids := make([]string, 0)
+	names := make([]interface{}, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		mapping := map[string]interface{}{
+			"id":              fmt.Sprint(object["DtsJobId"]),
+			"dts_job_id":      fmt.Sprint(object["DtsJobId"]),
+			"dts_job_name":    object["DtsJobName"],
+			"dts_instance_id": object["DtsInstanceID"],
+			"status":          object["Status"],
+			"delay":           formatInt(object["Delay"]),
+			"error_message":   object["ErrorMessage"],
+			"create_time":     object["CreateTime"],
+		}
+		if m, ok := object["SourceEndpoint"].(map[string]interface{}); ok {
+			mapping["source_endpoint_engine_name"] = m["EngineName"]
+			mapping["source_endpoint_instance_id"] = m["InstanceID"]
+		}
+		if m, ok := object["DestinationEndpoint"].(map[string]interface{}); ok {
+			mapping["destination_endpoint_engine_name"] = m["EngineName"]
+			mapping["destination_endpoint_instance_id"] = m["InstanceID"]
+		}
+		ids = append(ids, fmt.Sprint(object["DtsJobId"]))
+		names = append(names, object["DtsJobName"])
+		if !d.Get("enable_details").(bool) {
+			s = append(s, mapping)
+			continue
+		}
+
+		detail, err := dtsService.DescribeDtsJobDetail(fmt.Sprint(object["DtsJobId"]))
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		mapping["status"] = detail["Status"]
+		mapping["delay"] = formatInt(detail["Delay"])
+		mapping["error_message"] = detail["ErrorMessage"]
+		mapping["checkpoint"] = fmt.Sprint(detail["Checkpoint"])
+		steps := make([]map[string]interface{}, 0)
+		for _, step := range dtsJobSteps {
+			status, ok := detail[step.key].(map[string]interface{})
+			if !ok || status["Status"] == nil {
+				continue
+			}
+			steps = append(steps, map[string]interface{}{
+				"name":          step.name,
+				"status":        status["Status"],
+				"percent":       fmt.Sprint(status["Percent"]),
+				"progress":      fmt.Sprint(status["Progress"]),
+				"delay":         fmt.Sprint(status["Delay"]),
+				"error_message": status["ErrorMessage"],
+			})
+		}
+		mapping["steps"] = steps
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("names", names); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("jobs", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
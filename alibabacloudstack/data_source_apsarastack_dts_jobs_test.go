@@ -0,0 +1,74 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDtsJobsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_dts_jobs.default"
+	name := fmt.Sprintf("tf-testacc%sdtsjobs%d", defaultRegionToTest, rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, testAccDtsJobMonitorRuleDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dts_synchronization_job.default.dts_job_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dts_synchronization_job.default.dts_job_name}_fake",
+		}),
+	}
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dts_synchronization_job.default.dts_job_id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dts_synchronization_job.default.dts_job_id}_fake"},
+		}),
+	}
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":      []string{"${alibabacloudstack_dts_synchronization_job.default.dts_job_id}"},
+			"job_type": "SYNC",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":      []string{"${alibabacloudstack_dts_synchronization_job.default.dts_job_id}"},
+			"job_type": "MIGRATION",
+		}),
+	}
+
+	var existDtsJobsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                  "1",
+			"names.#":                "1",
+			"names.0":                name,
+			"jobs.#":                 "1",
+			"jobs.0.id":              CHECKSET,
+			"jobs.0.dts_job_id":      CHECKSET,
+			"jobs.0.dts_job_name":    name,
+			"jobs.0.dts_instance_id": CHECKSET,
+			"jobs.0.status":          CHECKSET,
+			"jobs.0.steps.#":         CHECKSET,
+		}
+	}
+
+	var fakeDtsJobsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"jobs.#":  "0",
+		}
+	}
+
+	var dtsJobsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDtsJobsMapFunc,
+		fakeMapFunc:  fakeDtsJobsMapFunc,
+	}
+
+	dtsJobsCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf, allConf)
+}
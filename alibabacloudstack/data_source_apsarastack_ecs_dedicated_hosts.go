@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsDedicatedHosts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsDedicatedHostsRead,
+		ReadContext: dataSourceAlibabacloudStackEcsDedicatedHostsRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -269,8 +271,8 @@ func dataSourceAlibabacloudStackEcsDedicatedHosts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEcsDedicatedHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeDedicatedHosts"
 	request := make(map[string]interface{})
@@ -321,7 +323,7 @@ func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, me
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		dedicatedHostNameRegex = r
 	}
@@ -338,13 +340,13 @@ func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, me
 	var response map[string]interface{}
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -357,11 +359,11 @@ func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, me
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_dedicated_hosts", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_dedicated_hosts", action, AlibabacloudStackSdkGoERROR))
 		}
 		resp, err := jsonpath.Get("$.DedicatedHosts.DedicatedHost", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.DedicatedHosts.DedicatedHost", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.DedicatedHosts.DedicatedHost", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -445,15 +447,15 @@ func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, me
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("hosts", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsDeploymentSets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsDeploymentSetsRead,
+		ReadContext: dataSourceAlibabacloudStackEcsDeploymentSetsRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -104,8 +106,8 @@ func dataSourceAlibabacloudStackEcsDeploymentSets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEcsDeploymentSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeDeploymentSets"
 	request := make(map[string]interface{})
@@ -128,7 +130,7 @@ func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, me
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		deploymentSetNameRegex = r
 	}
@@ -145,13 +147,13 @@ func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, me
 	var response map[string]interface{}
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -164,11 +166,11 @@ func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, me
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_deployment_sets", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_deployment_sets", action, AlibabacloudStackSdkGoERROR))
 		}
 		resp, err := jsonpath.Get("$.DeploymentSets.DeploymentSet", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.DeploymentSets.DeploymentSet", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.DeploymentSets.DeploymentSet", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -213,15 +215,15 @@ func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, me
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("sets", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
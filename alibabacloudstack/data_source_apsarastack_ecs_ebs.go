@@ -1,13 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsEbsStorageSetsRead,
+		ReadContext: dataSourceAlibabacloudStackEcsEbsStorageSetsRead,
 		Schema: map[string]*schema.Schema{
 			"storage_set_name": {
 				Type:     schema.TypeString,
@@ -74,36 +75,12 @@ func dataSourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var addDomains = &datahub.EcsDescribeEcsEbsStorageSetsResult{}
 	action := "DescribeStorageSets"
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	request.RegionId = client.RegionId
-	request.ApiName = action
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["x-ascm-product-name"] = "Ecs"
-	request.Headers["x-acs-organizationId"] = client.Department
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"RegionId":        client.RegionId,
-		"Product":         "Ecs",
-		"Version":         "2014-05-26",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"PageNumber":      "1",
-	}
+	request := client.NewCommonRequest("GET", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["PageNumber"] = "1"
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
@@ -112,7 +89,7 @@ func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, me
 		return EcsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_drds_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_drds_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(action, raw, request)
 
@@ -120,7 +97,7 @@ func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, me
 	err = json.Unmarshal(response.GetHttpContentBytes(), &addDomains)
 	//v, err := jsonpath.Get("$.Commands.Command", bresponse)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	ids := make([]string, 0)
@@ -139,7 +116,7 @@ func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, me
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("storages", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -8,13 +9,14 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEcsHpcClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsHpcClustersRead,
+		ReadContext: dataSourceAlibabacloudStackEcsHpcClustersRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -66,8 +68,8 @@ func dataSourceAlibabacloudStackEcsHpcClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsHpcClustersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEcsHpcClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeHpcClusters"
 	request := make(map[string]interface{})
@@ -82,7 +84,7 @@ func dataSourceAlibabacloudStackEcsHpcClustersRead(d *schema.ResourceData, meta
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		nameRegex = r
 	}
@@ -99,7 +101,7 @@ func dataSourceAlibabacloudStackEcsHpcClustersRead(d *schema.ResourceData, meta
 	var response map[string]interface{}
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
@@ -107,13 +109,13 @@ func dataSourceAlibabacloudStackEcsHpcClustersRead(d *schema.ResourceData, meta
 		request["ClientToken"] = buildClientToken("DescribeHpcClusters")
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_hpc_clusters", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_hpc_clusters", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.HpcClusters.HpcCluster", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.HpcClusters.HpcCluster", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.HpcClusters.HpcCluster", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -152,15 +154,15 @@ func dataSourceAlibabacloudStackEcsHpcClustersRead(d *schema.ResourceData, meta
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("clusters", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
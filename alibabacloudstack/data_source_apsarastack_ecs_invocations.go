@@ -0,0 +1,201 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackEcsInvocations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackEcsInvocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"command_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"invoke_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Running", "Finished", "Failed", "Stopped"}, false),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"invocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"command_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"command_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"command_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameters": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invocation_results": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"invocation_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"exit_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"output": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"error_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"error_info": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"finish_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackEcsInvocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ecsService := EcsService{client}
+
+	objects, err := ecsService.ListEcsInvocations(d.Get("command_id").(string), d.Get("instance_id").(string), d.Get("invoke_status").(string))
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	ids := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[object.InvokeId]; !ok {
+				continue
+			}
+		}
+		results := make([]map[string]interface{}, 0)
+		for _, instance := range object.InvokeInstances.InvokeInstance {
+			results = append(results, map[string]interface{}{
+				"instance_id":       instance.InstanceId,
+				"invocation_status": instance.InvocationStatus,
+				"exit_code":         instance.ExitCode,
+				"output":            instance.Output,
+				"error_code":        instance.ErrorCode,
+				"error_info":        instance.ErrorInfo,
+				"start_time":        instance.StartTime,
+				"finish_time":       instance.FinishTime,
+			})
+		}
+		mapping := map[string]interface{}{
+			"id":                 object.InvokeId,
+			"command_id":         object.CommandId,
+			"command_name":       object.CommandName,
+			"command_type":       object.CommandType,
+			"parameters":         object.Parameters,
+			"frequency":          object.Frequency,
+			"timed":              object.Timed,
+			"status":             object.InvocationStatus,
+			"creation_time":      object.CreationTime,
+			"invocation_results": results,
+		}
+		ids = append(ids, object.InvokeId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("invocations", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
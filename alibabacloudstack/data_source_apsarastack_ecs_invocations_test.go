@@ -0,0 +1,81 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackEcsInvocationsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_ecs_invocations.default"
+	name := fmt.Sprintf("tf-testacc-ecsinvocations%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceEcsInvocationsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_ecs_invocation.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_ecs_invocation.default.id}_fake"},
+		}),
+	}
+
+	commandIdConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"command_id":  "${alibabacloudstack_ecs_invocation.default.command_id}",
+			"instance_id": "${alibabacloudstack_instance.default.id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"command_id":    "${alibabacloudstack_ecs_invocation.default.command_id}",
+			"instance_id":   "${alibabacloudstack_instance.default.id}",
+			"invoke_status": "Stopped",
+		}),
+	}
+
+	var existEcsInvocationsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                                        "1",
+			"invocations.#":                                "1",
+			"invocations.0.id":                             CHECKSET,
+			"invocations.0.command_id":                     CHECKSET,
+			"invocations.0.command_name":                   name,
+			"invocations.0.command_type":                   "RunShellScript",
+			"invocations.0.status":                         "Success",
+			"invocations.0.invocation_results.#":           "1",
+			"invocations.0.invocation_results.0.exit_code": "0",
+			"invocations.0.invocation_results.0.output":    "hello terraform\n",
+		}
+	}
+
+	var fakeEcsInvocationsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":         "0",
+			"invocations.#": "0",
+		}
+	}
+
+	var ecsInvocationsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existEcsInvocationsMapFunc,
+		fakeMapFunc:  fakeEcsInvocationsMapFunc,
+	}
+
+	ecsInvocationsCheckInfo.dataSourceTestCheck(t, rand, idsConf, commandIdConf)
+}
+
+func dataSourceEcsInvocationsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_ecs_invocation" "default" {
+	command_id  = "${alibabacloudstack_ecs_command.default.id}"
+	instance_id = ["${alibabacloudstack_instance.default.id}"]
+	parameters = {
+		name = "terraform"
+	}
+}
+`, AlibabacloudStackEcsInvocationBasicDependence(name))
+}
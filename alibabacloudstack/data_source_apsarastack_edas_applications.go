@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	//"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 func dataSourceAlibabacloudStackEdasApplications() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEdasApplicationsRead,
+		ReadContext: dataSourceAlibabacloudStackEdasApplicationsRead,
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -77,8 +79,8 @@ func dataSourceAlibabacloudStackEdasApplications() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEdasApplicationsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEdasApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	request := edas.CreateListApplicationRequest()
@@ -101,14 +103,14 @@ func dataSourceAlibabacloudStackEdasApplicationsRead(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_applications", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_applications", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.ListApplicationResponse)
 	if response.Code != 200 {
-		return WrapError(Error(response.Message))
+		return DiagnosticsFromError(WrapError(Error(response.Message)))
 	}
 	var filteredApps []edas.ApplicationInListApplication
 	nameRegex, ok := d.GetOk("name_regex")
@@ -117,7 +119,7 @@ func dataSourceAlibabacloudStackEdasApplicationsRead(d *schema.ResourceData, met
 		if nameRegex != "" {
 			r, err = regexp.Compile(nameRegex.(string))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		for _, app := range response.ApplicationList.Application {
@@ -135,7 +137,7 @@ func dataSourceAlibabacloudStackEdasApplicationsRead(d *schema.ResourceData, met
 		filteredApps = response.ApplicationList.Application
 	}
 
-	return edasApplicationAttributes(d, filteredApps)
+	return DiagnosticsFromError(edasApplicationAttributes(d, filteredApps))
 }
 
 func edasApplicationAttributes(d *schema.ResourceData, apps []edas.ApplicationInListApplication) error {
@@ -1,16 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackEdasClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEdasClustersRead,
+		ReadContext: dataSourceAlibabacloudStackEdasClustersRead,
 
 		Schema: map[string]*schema.Schema{
 			"logical_region_id": {
@@ -105,8 +107,8 @@ func dataSourceAlibabacloudStackEdasClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEdasClustersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEdasClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	logicalRegionId := d.Get("logical_region_id").(string)
@@ -131,14 +133,14 @@ func dataSourceAlibabacloudStackEdasClustersRead(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_clusters", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_clusters", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.ListClusterResponse)
 	if response.Code != 200 {
-		return WrapError(Error(response.Message))
+		return DiagnosticsFromError(WrapError(Error(response.Message)))
 	}
 
 	var filteredClusters []edas.Cluster
@@ -148,7 +150,7 @@ func dataSourceAlibabacloudStackEdasClustersRead(d *schema.ResourceData, meta in
 		if nameRegex != "" {
 			r, err = regexp.Compile(nameRegex.(string))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		for _, cluster := range response.ClusterList.Cluster {
@@ -166,7 +168,7 @@ func dataSourceAlibabacloudStackEdasClustersRead(d *schema.ResourceData, meta in
 		filteredClusters = response.ClusterList.Cluster
 	}
 
-	return edasClusterDescriptionAttributes(d, filteredClusters)
+	return DiagnosticsFromError(edasClusterDescriptionAttributes(d, filteredClusters))
 }
 
 func edasClusterDescriptionAttributes(d *schema.ResourceData, clusters []edas.Cluster) error {
@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	//"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 func dataSourceAlibabacloudStackEdasDeployGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEdasDeployGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackEdasDeployGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -86,8 +88,8 @@ func dataSourceAlibabacloudStackEdasDeployGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEdasDeployGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEdasDeployGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	regionId := client.RegionId
@@ -103,7 +105,7 @@ func dataSourceAlibabacloudStackEdasDeployGroupsRead(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_deploy_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_deploy_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
@@ -111,7 +113,7 @@ func dataSourceAlibabacloudStackEdasDeployGroupsRead(d *schema.ResourceData, met
 	response, _ := raw.(*edas.ListDeployGroupResponse)
 
 	if response.Code != 200 {
-		return WrapError(Error(response.Message))
+		return DiagnosticsFromError(WrapError(Error(response.Message)))
 	}
 
 	var filteredGroups []edas.DeployGroup
@@ -121,7 +123,7 @@ func dataSourceAlibabacloudStackEdasDeployGroupsRead(d *schema.ResourceData, met
 		if nameRegex != "" {
 			r, err = regexp.Compile(nameRegex.(string))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		for _, group := range response.DeployGroupList.DeployGroup {
@@ -135,7 +137,7 @@ func dataSourceAlibabacloudStackEdasDeployGroupsRead(d *schema.ResourceData, met
 		filteredGroups = response.DeployGroupList.DeployGroup
 	}
 
-	return edasDeployGroupAttributes(d, filteredGroups)
+	return DiagnosticsFromError(edasDeployGroupAttributes(d, filteredGroups))
 }
 
 func edasDeployGroupAttributes(d *schema.ResourceData, groups []edas.DeployGroup) error {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,13 +9,14 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackEhpcJobTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEhpcJobTemplatesRead,
+		ReadContext: dataSourceAlibabacloudStackEhpcJobTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -115,8 +117,8 @@ func dataSourceAlibabacloudStackEhpcJobTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEhpcJobTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "ListJobTemplates"
 	request := make(map[string]interface{})
@@ -137,13 +139,13 @@ func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, met
 	var response map[string]interface{}
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -156,11 +158,11 @@ func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, met
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ehpc_job_templates", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ehpc_job_templates", action, AlibabacloudStackSdkGoERROR))
 		}
 		resp, err := jsonpath.Get("$.Templates.JobTemplates", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Templates.JobTemplates", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Templates.JobTemplates", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -207,11 +209,11 @@ func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, met
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("templates", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
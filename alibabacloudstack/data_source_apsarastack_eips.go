@@ -1,16 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
 func dataSourceAlibabacloudStackEips() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEipsRead,
+		ReadContext: dataSourceAlibabacloudStackEipsRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -75,8 +77,8 @@ func dataSourceAlibabacloudStackEips() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackEipsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeEipAddressesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -86,7 +88,7 @@ func dataSourceAlibabacloudStackEipsRead(d *schema.ResourceData, meta interface{
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 
@@ -113,7 +115,7 @@ func dataSourceAlibabacloudStackEipsRead(d *schema.ResourceData, meta interface{
 			return vpcClient.DescribeEipAddresses(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_eips", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_eips", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeEipAddressesResponse)
@@ -142,12 +144,12 @@ func dataSourceAlibabacloudStackEipsRead(d *schema.ResourceData, meta interface{
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
 
-	return eipsDecriptionAttributes(d, allEips, meta)
+	return DiagnosticsFromError(eipsDecriptionAttributes(d, allEips, meta))
 }
 
 func eipsDecriptionAttributes(d *schema.ResourceData, eipSetTypes []vpc.EipAddress, meta interface{}) error {
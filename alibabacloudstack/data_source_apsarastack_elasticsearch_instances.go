@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/elasticsearch"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackElasticsearch() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackElasticsearchRead,
+		ReadContext: dataSourceAlibabacloudStackElasticsearchRead,
 
 		Schema: map[string]*schema.Schema{
 			"description_regex": {
@@ -111,8 +113,8 @@ func dataSourceAlibabacloudStackElasticsearch() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackElasticsearchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := elasticsearch.CreateListInstanceRequest()
 	request.RegionId = client.RegionId
@@ -120,7 +122,7 @@ func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta i
 	request.Size = requests.NewInteger(PageSizeLarge)
 	request.Page = requests.NewInteger(1)
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("tags"); ok {
 		var reqTags []map[string]string
@@ -134,7 +136,7 @@ func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta i
 
 		reqTagsStr, err := json.Marshal(reqTags)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Tags = string(reqTagsStr)
 	}
@@ -146,7 +148,7 @@ func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta i
 			return elasticsearchClient.ListInstance(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_elasticsearch_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_elasticsearch_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 		response, _ := raw.(*elasticsearch.ListInstanceResponse)
@@ -164,7 +166,7 @@ func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta i
 
 		page, err := getNextpageNumber(request.Page)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Page = page
 	}
@@ -175,7 +177,7 @@ func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta i
 	if v, ok := d.GetOk("description_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		descriptionRegex = r
 	}
@@ -203,7 +205,7 @@ func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta i
 		filteredInstances = append(filteredInstances, instance)
 	}
 
-	return WrapError(extractInstance(d, filteredInstances))
+	return DiagnosticsFromError(WrapError(extractInstance(d, filteredInstances)))
 }
 
 func extractInstance(d *schema.ResourceData, instances []elasticsearch.Instance) error {
@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/elasticsearch"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackElaticsearchZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackElaticsearchZonesRead,
+		ReadContext: dataSourceAlibabacloudStackElaticsearchZonesRead,
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -49,22 +51,22 @@ func dataSourceAlibabacloudStackElaticsearchZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackElaticsearchZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackElaticsearchZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
 
 	request := elasticsearch.CreateGetRegionConfigurationRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 		return elasticsearchClient.GetRegionConfiguration(request)
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_elasticsearch_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_elasticsearch_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.GetActionName(), request)
 	zones, _ := raw.(*elasticsearch.GetRegionConfigurationResponse)
@@ -99,10 +101,10 @@ func dataSourceAlibabacloudStackElaticsearchZonesRead(d *schema.ResourceData, me
 	}
 	d.SetId(dataResourceIdHash(zoneIds))
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", zoneIds); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
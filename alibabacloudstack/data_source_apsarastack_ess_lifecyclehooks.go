@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEssLifecycleHooks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssLifecycleHooksRead,
+		ReadContext: dataSourceAlibabacloudStackEssLifecycleHooksRead,
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -83,8 +85,8 @@ func dataSourceAlibabacloudStackEssLifecycleHooks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssLifecycleHooksRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEssLifecycleHooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateDescribeLifecycleHooksRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -94,7 +96,7 @@ func dataSourceAlibabacloudStackEssLifecycleHooksRead(d *schema.ResourceData, me
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
@@ -109,7 +111,7 @@ func dataSourceAlibabacloudStackEssLifecycleHooksRead(d *schema.ResourceData, me
 			return essClient.DescribeLifecycleHooks(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_lifecycle_hooks", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_lifecycle_hooks", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response := raw.(*ess.DescribeLifecycleHooksResponse)
@@ -121,7 +123,7 @@ func dataSourceAlibabacloudStackEssLifecycleHooksRead(d *schema.ResourceData, me
 			break
 		}
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -157,7 +159,7 @@ func dataSourceAlibabacloudStackEssLifecycleHooksRead(d *schema.ResourceData, me
 		filteredLifecycleHooks = allLifecycleHooks
 	}
 
-	return lifecycleHooksDescriptionAttribute(d, filteredLifecycleHooks, meta)
+	return DiagnosticsFromError(lifecycleHooksDescriptionAttribute(d, filteredLifecycleHooks, meta))
 }
 
 func lifecycleHooksDescriptionAttribute(d *schema.ResourceData, lifecycleHooks []ess.LifecycleHook, meta interface{}) error {
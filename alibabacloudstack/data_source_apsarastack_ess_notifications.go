@@ -1,15 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
 func dataSourceAlibabacloudStackEssNotifications() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssNotificationsRead,
+		ReadContext: dataSourceAlibabacloudStackEssNotificationsRead,
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -53,12 +55,12 @@ func dataSourceAlibabacloudStackEssNotifications() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssNotificationsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEssNotificationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateDescribeNotificationConfigurationsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -74,7 +76,7 @@ func dataSourceAlibabacloudStackEssNotificationsRead(d *schema.ResourceData, met
 			return essClient.DescribeNotificationConfigurations(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_notifications", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_notifications", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response := raw.(*ess.DescribeNotificationConfigurationsResponse)
@@ -105,7 +107,7 @@ func dataSourceAlibabacloudStackEssNotificationsRead(d *schema.ResourceData, met
 		filteredNotifications = allNotifications
 	}
 
-	return notificationsDescriptionAttribute(d, filteredNotifications, meta)
+	return DiagnosticsFromError(notificationsDescriptionAttribute(d, filteredNotifications, meta))
 }
 
 func notificationsDescriptionAttribute(d *schema.ResourceData, notifications []ess.NotificationConfigurationModel, meta interface{}) error {
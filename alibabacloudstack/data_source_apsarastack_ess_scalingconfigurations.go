@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEssScalingConfigurations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScalingConfigurationsRead,
+		ReadContext: dataSourceAlibabacloudStackEssScalingConfigurationsRead,
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -131,12 +133,12 @@ func dataSourceAlibabacloudStackEssScalingConfigurations() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScalingConfigurationsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEssScalingConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateDescribeScalingConfigurationsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	request.RegionId = client.RegionId
@@ -156,7 +158,7 @@ func dataSourceAlibabacloudStackEssScalingConfigurationsRead(d *schema.ResourceD
 			return essClient.DescribeScalingConfigurations(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scalingconfigurations", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scalingconfigurations", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response := raw.(*ess.DescribeScalingConfigurationsResponse)
@@ -168,7 +170,7 @@ func dataSourceAlibabacloudStackEssScalingConfigurationsRead(d *schema.ResourceD
 			break
 		}
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -204,7 +206,7 @@ func dataSourceAlibabacloudStackEssScalingConfigurationsRead(d *schema.ResourceD
 		filteredScalingConfigurations = allScalingConfigurations
 	}
 
-	return scalingConfigurationsDescriptionAttribute(d, filteredScalingConfigurations, meta)
+	return DiagnosticsFromError(scalingConfigurationsDescriptionAttribute(d, filteredScalingConfigurations, meta))
 }
 
 func scalingConfigurationsDescriptionAttribute(d *schema.ResourceData, scalingConfigurations []ess.ScalingConfiguration, meta interface{}) error {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEssScalingGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScalingGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackEssScalingGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -122,8 +124,8 @@ func dataSourceAlibabacloudStackEssScalingGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScalingGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEssScalingGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateDescribeScalingGroupsRequest()
 	request.RegionId = client.RegionId
 	request.RegionId = client.RegionId
@@ -133,7 +135,7 @@ func dataSourceAlibabacloudStackEssScalingGroupsRead(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -150,7 +152,7 @@ func dataSourceAlibabacloudStackEssScalingGroupsRead(d *schema.ResourceData, met
 			return essClient.DescribeScalingGroups(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scalinggroups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scalinggroups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*ess.DescribeScalingGroupsResponse)
@@ -165,7 +167,7 @@ func dataSourceAlibabacloudStackEssScalingGroupsRead(d *schema.ResourceData, met
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -199,7 +201,7 @@ func dataSourceAlibabacloudStackEssScalingGroupsRead(d *schema.ResourceData, met
 	} else {
 		filteredScalingGroupsTemp = allScalingGroups
 	}
-	return scalingGroupsDescriptionAttribute(d, filteredScalingGroupsTemp, meta)
+	return DiagnosticsFromError(scalingGroupsDescriptionAttribute(d, filteredScalingGroupsTemp, meta))
 }
 
 func scalingGroupsDescriptionAttribute(d *schema.ResourceData, scalingGroups []ess.ScalingGroup, meta interface{}) error {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEssScalingRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScalingRulesRead,
+		ReadContext: dataSourceAlibabacloudStackEssScalingRulesRead,
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -92,8 +94,8 @@ func dataSourceAlibabacloudStackEssScalingRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScalingRulesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEssScalingRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateDescribeScalingRulesRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -102,7 +104,7 @@ func dataSourceAlibabacloudStackEssScalingRulesRead(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
@@ -122,7 +124,7 @@ func dataSourceAlibabacloudStackEssScalingRulesRead(d *schema.ResourceData, meta
 			return essClient.DescribeScalingRules(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scalingrules", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scalingrules", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*ess.DescribeScalingRulesResponse)
@@ -137,7 +139,7 @@ func dataSourceAlibabacloudStackEssScalingRulesRead(d *schema.ResourceData, meta
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return err
+			return DiagnosticsFromError(err)
 		} else {
 			request.PageNumber = page
 		}
@@ -172,7 +174,7 @@ func dataSourceAlibabacloudStackEssScalingRulesRead(d *schema.ResourceData, meta
 	} else {
 		filteredScalingRulesTemp = allScalingRules
 	}
-	return scalingRulesDescriptionAttribute(d, filteredScalingRulesTemp, meta)
+	return DiagnosticsFromError(scalingRulesDescriptionAttribute(d, filteredScalingRulesTemp, meta))
 }
 
 func scalingRulesDescriptionAttribute(d *schema.ResourceData, scalingRules []ess.ScalingRule, meta interface{}) error {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEssScheduledTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScheduledTasksRead,
+		ReadContext: dataSourceAlibabacloudStackEssScheduledTasksRead,
 		Schema: map[string]*schema.Schema{
 			"scheduled_task_id": {
 				Type:     schema.TypeString,
@@ -103,12 +105,12 @@ func dataSourceAlibabacloudStackEssScheduledTasks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScheduledTasksRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackEssScheduledTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateDescribeScheduledTasksRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -132,7 +134,7 @@ func dataSourceAlibabacloudStackEssScheduledTasksRead(d *schema.ResourceData, me
 			return essClient.DescribeScheduledTasks(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scheduled_tasks", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ess_scheduled_tasks", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response := raw.(*ess.DescribeScheduledTasksResponse)
@@ -144,7 +146,7 @@ func dataSourceAlibabacloudStackEssScheduledTasksRead(d *schema.ResourceData, me
 			break
 		}
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -180,7 +182,7 @@ func dataSourceAlibabacloudStackEssScheduledTasksRead(d *schema.ResourceData, me
 		filteredScheduledtasks = allScheduledTasks
 	}
 
-	return scheduledTasksDescriptionAttribute(d, filteredScheduledtasks, meta)
+	return DiagnosticsFromError(scheduledTasksDescriptionAttribute(d, filteredScheduledtasks, meta))
 }
 
 func scheduledTasksDescriptionAttribute(d *schema.ResourceData, tasks []ess.ScheduledTask, meta interface{}) error {
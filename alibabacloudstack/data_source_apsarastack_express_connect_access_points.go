@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackExpressConnectAccessPoints() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackExpressConnectAccessPointsRead,
+		ReadContext: dataSourceAlibabacloudStackExpressConnectAccessPointsRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -94,8 +96,8 @@ func dataSourceAlibabacloudStackExpressConnectAccessPoints() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeAccessPoints"
 	request := map[string]interface{}{
@@ -110,7 +112,7 @@ func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(d *schema.Resourc
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		accessPointNameRegex = r
 	}
@@ -128,13 +130,13 @@ func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(d *schema.Resourc
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -147,11 +149,11 @@ func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(d *schema.Resourc
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_express_connect_access_points", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alicloud_express_connect_access_points", action, AlibabacloudStackSdkGoERROR))
 		}
 		resp, err := jsonpath.Get("$.AccessPointSet.AccessPointType", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.AccessPointSet.AccessPointType", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.AccessPointSet.AccessPointType", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -197,15 +199,15 @@ func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(d *schema.Resourc
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("points", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
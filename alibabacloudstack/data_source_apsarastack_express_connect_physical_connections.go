@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackExpressConnectPhysicalConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead,
+		ReadContext: dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead,
 		Schema: map[string]*schema.Schema{
 			"include_reservation_data": {
 				Type:     schema.TypeBool,
@@ -167,8 +169,8 @@ func dataSourceAlibabacloudStackExpressConnectPhysicalConnections() *schema.Reso
 	}
 }
 
-func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribePhysicalConnections"
 	request := map[string]interface{}{
@@ -187,7 +189,7 @@ func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(d *schema.
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		physicalConnectionNameRegex = r
 	}
@@ -205,13 +207,13 @@ func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(d *schema.
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -224,11 +226,11 @@ func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(d *schema.
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_express_connect_physical_connections", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_express_connect_physical_connections", action, AlibabacloudStackSdkGoERROR))
 		}
 		resp, err := jsonpath.Get("$.PhysicalConnectionSet.PhysicalConnectionType", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.PhysicalConnectionSet.PhysicalConnectionType", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.PhysicalConnectionSet.PhysicalConnectionType", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -290,15 +292,15 @@ func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(d *schema.
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("connections", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
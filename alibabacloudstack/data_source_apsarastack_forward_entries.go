@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackForwardEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackForwardEntriesRead,
+		ReadContext: dataSourceAlibabacloudStackForwardEntriesRead,
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -85,8 +87,8 @@ func dataSourceAlibabacloudStackForwardEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackForwardEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeForwardTableEntriesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -96,7 +98,7 @@ func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -111,7 +113,7 @@ func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta
 	var err error
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		if r, err = regexp.Compile(nameRegex.(string)); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	var allForwardEntries []vpc.ForwardTableEntry
@@ -124,7 +126,7 @@ func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta
 			})
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_forward_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_forward_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeForwardTableEntriesResponse)
@@ -155,13 +157,13 @@ func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
 
-	return ForwardEntriesDecriptionAttributes(d, allForwardEntries, meta)
+	return DiagnosticsFromError(ForwardEntriesDecriptionAttributes(d, allForwardEntries, meta))
 }
 
 func ForwardEntriesDecriptionAttributes(d *schema.ResourceData, entries []vpc.ForwardTableEntry, meta interface{}) error {
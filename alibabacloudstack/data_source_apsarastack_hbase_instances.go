@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/hbase"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackHBaseInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackHBaseInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackHBaseInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -136,8 +138,8 @@ func dataSourceAlibabacloudStackHBaseInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackHBaseInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackHBaseInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	hbaseService := HBaseService{client}
 
 	request := hbase.CreateDescribeInstancesRequest()
@@ -181,7 +183,7 @@ func dataSourceAlibabacloudStackHBaseInstancesRead(d *schema.ResourceData, meta
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_hbase_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_hbase_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*hbase.DescribeInstancesResponse)
@@ -210,7 +212,7 @@ func dataSourceAlibabacloudStackHBaseInstancesRead(d *schema.ResourceData, meta
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -252,21 +254,21 @@ func dataSourceAlibabacloudStackHBaseInstancesRead(d *schema.ResourceData, meta
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("instances", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// create a json file in current directory and write data source to it
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		err := writeToFile(output.(string), s)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	return nil
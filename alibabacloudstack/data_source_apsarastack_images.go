@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"regexp"
 	"sort"
@@ -12,11 +13,12 @@ import (
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 func dataSourceAlibabacloudStackImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackImagesRead,
+		ReadContext: dataSourceAlibabacloudStackImagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -173,15 +175,15 @@ func dataSourceAlibabacloudStackImages() *schema.Resource {
 }
 
 // dataSourceAlibabacloudStackImagesDescriptionRead performs the AlibabacloudStack Image lookup.
-func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
 	owners, ownersOk := d.GetOk("owners")
 	mostRecent, mostRecentOk := d.GetOk("most_recent")
 
 	if nameRegexOk == false && ownersOk == false && mostRecentOk == false {
-		return WrapError(Error("One of name_regex, owners or most_recent must be assigned"))
+		return DiagnosticsFromError(WrapError(Error("One of name_regex, owners or most_recent must be assigned")))
 	}
 
 	request := ecs.CreateDescribeImagesRequest()
@@ -191,7 +193,7 @@ func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interfac
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
@@ -208,7 +210,7 @@ func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interfac
 			return ecsClient.DescribeImages(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_images", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_images", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		response, _ := raw.(*ecs.DescribeImagesResponse)
 		if response == nil || len(response.Images.Image) < 1 {
@@ -223,7 +225,7 @@ func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interfac
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -258,7 +260,7 @@ func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interfac
 		images = filteredImages
 	}
 
-	return imagesDescriptionAttributes(d, images, meta)
+	return DiagnosticsFromError(imagesDescriptionAttributes(d, images, meta))
 }
 
 // populate the numerous fields that the image description returns.
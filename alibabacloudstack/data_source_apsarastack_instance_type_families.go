@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackInstanceTypeFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceTypeFamiliesRead,
+		ReadContext: dataSourceAlibabacloudStackInstanceTypeFamiliesRead,
 
 		Schema: map[string]*schema.Schema{
 			"generation": {
@@ -61,8 +63,8 @@ func dataSourceAlibabacloudStackInstanceTypeFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	request := ecs.CreateDescribeInstanceTypeFamiliesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -72,7 +74,7 @@ func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData,
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	if v, ok := d.GetOk("generation"); ok {
@@ -81,7 +83,7 @@ func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData,
 
 	zones, err := ecsService.DescribeZones(d)
 	if err != nil {
-		return WrapErrorf(err, "DescribeZones", "alibabacloudstack_instance_type_families", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, "DescribeZones", "alibabacloudstack_instance_type_families", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	families := make(map[string]map[string]string)
 	for _, zone := range zones {
@@ -98,7 +100,7 @@ func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData,
 		return ecsClient.DescribeInstanceTypeFamilies(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_instance_type_families", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_instance_type_families", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	var instanceTypeFamilies []ecs.InstanceTypeFamily
@@ -111,7 +113,7 @@ func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData,
 			instanceTypeFamilies = append(instanceTypeFamilies, family)
 		}
 	}
-	return instanceTypeFamiliesDescriptionAttributes(d, instanceTypeFamilies, families)
+	return DiagnosticsFromError(instanceTypeFamiliesDescriptionAttributes(d, instanceTypeFamilies, families))
 }
 
 func instanceTypeFamiliesDescriptionAttributes(d *schema.ResourceData, typeFamilies []ecs.InstanceTypeFamily, families map[string]map[string]string) error {
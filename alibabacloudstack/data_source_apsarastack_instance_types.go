@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -21,7 +23,7 @@ type instanceTypeWithOriginalPrice struct {
 
 func dataSourceAlibabacloudStackInstanceTypes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceTypesRead,
+		ReadContext: dataSourceAlibabacloudStackInstanceTypesRead,
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -164,13 +166,13 @@ func dataSourceAlibabacloudStackInstanceTypes() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	zoneId, validZones, _, err := ecsService.DescribeAvailableResources(d, meta, InstanceTypeResource)
 	if err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	mapInstanceTypes := make(map[string][]string)
@@ -204,14 +206,14 @@ func dataSourceAlibabacloudStackInstanceTypesRead(d *schema.ResourceData, meta i
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.InstanceTypeFamily = family
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeInstanceTypes(req)
 	})
 	if err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 	var instanceTypes []instanceTypeWithOriginalPrice
 	resp, _ := raw.(*ecs.DescribeInstanceTypesResponse)
@@ -270,7 +272,7 @@ func dataSourceAlibabacloudStackInstanceTypesRead(d *schema.ResourceData, meta i
 		}
 	}
 
-	return instanceTypesDescriptionAttributes(d, instanceTypes, mapInstanceTypes)
+	return DiagnosticsFromError(instanceTypesDescriptionAttributes(d, instanceTypes, mapInstanceTypes))
 }
 
 func instanceTypesDescriptionAttributes(d *schema.ResourceData, types []instanceTypeWithOriginalPrice, mapTypes map[string][]string) error {
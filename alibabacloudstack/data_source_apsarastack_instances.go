@@ -1,6 +1,8 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -191,8 +193,8 @@ func dataSourceAlibabacloudStackInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := ecs.CreateDescribeInstancesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -202,7 +204,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Status = d.Get("status").(string)
 
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
@@ -238,7 +240,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 			return ecsClient.DescribeInstances(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*ecs.DescribeInstancesResponse)
@@ -253,7 +255,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -298,7 +300,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceIds = convertListToJsonString(convertListStringToListInterface(instanceIds[index:IntMin(index+100, len(instanceIds))]))
 		request.RamRoleName = d.Get("ram_role_name").(string)
 		request.PageSize = requests.NewInteger(PageSizeLarge)
@@ -308,7 +310,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 				return ecsClient.DescribeInstanceRamRole(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			response, _ := raw.(*ecs.DescribeInstanceRamRoleResponse)
@@ -324,7 +326,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 			}
 
 			if page, err := getNextpageNumber(request.PageNumber); err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			} else {
 				request.PageNumber = page
 			}
@@ -332,10 +334,10 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 	}
 	instanceDiskMappings, err := getInstanceDisksMappings(instanceRoleNameMap, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return instancessDescriptionAttributes(d, filteredInstancesTemp, instanceRoleNameMap, instanceDiskMappings, meta)
+	return DiagnosticsFromError(instancessDescriptionAttributes(d, filteredInstancesTemp, instanceRoleNameMap, instanceDiskMappings, meta))
 }
 
 // populate the numerous fields that the instance description returns.
@@ -406,7 +408,7 @@ func getInstanceDisksMappings(instanceMap map[string]string, meta interface{}) (
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeXLarge)
 	request.PageNumber = requests.NewInteger(1)
 	instanceDisks := make(map[string][]map[string]interface{})
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackKeyPairs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKeyPairsRead,
+		ReadContext: dataSourceAlibabacloudStackKeyPairsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -74,8 +76,8 @@ func dataSourceAlibabacloudStackKeyPairs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackKeyPairsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	var regex *regexp.Regexp
 	if name, ok := d.GetOk("name_regex"); ok {
@@ -96,7 +98,7 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if fingerPrint, ok := d.GetOk("finger_print"); ok {
 		request.KeyPairFingerPrint = fingerPrint.(string)
 	}
@@ -123,7 +125,7 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 			return ecsClient.DescribeKeyPairs(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_key_pairs", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_key_pairs", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request, raw, request.RpcRequest, request)
 		response, _ := raw.(*ecs.DescribeKeyPairsResponse)
@@ -147,7 +149,7 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 		}
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -161,14 +163,14 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 	describeInstancesRequest.PageNumber = requests.NewInteger(1)
 	describeInstancesRequest.PageSize = requests.NewInteger(PageSizeLarge)
 	describeInstancesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	describeInstancesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	describeInstancesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstances(describeInstancesRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_key_pairs", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_key_pairs", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(describeInstancesRequest.GetActionName(), raw)
 		object, _ := raw.(*ecs.DescribeInstancesResponse)
@@ -209,12 +211,12 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 
 		page, err := getNextpageNumber(describeInstancesRequest.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		describeInstancesRequest.PageNumber = page
 	}
 
-	return keyPairsDescriptionAttributes(d, keyPairs, keyPairsAttach, meta)
+	return DiagnosticsFromError(keyPairsDescriptionAttributes(d, keyPairs, keyPairsAttach, meta))
 }
 
 func keyPairsDescriptionAttributes(d *schema.ResourceData, keyPairs []ecs.KeyPair, keyPairsAttach map[string][]map[string]interface{}, meta interface{}) error {
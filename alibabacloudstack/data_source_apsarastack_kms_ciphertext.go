@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKmsCiphertextRead,
+		ReadContext: dataSourceAlibabacloudStackKmsCiphertextRead,
 
 		Schema: map[string]*schema.Schema{
 			"plaintext": {
@@ -41,8 +43,8 @@ func dataSourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackKmsCiphertextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	// Since a ciphertext has no ID, we create an ID based on
 	// current unix time.
@@ -50,7 +52,7 @@ func dataSourceAlibabacloudStackKmsCiphertextRead(d *schema.ResourceData, meta i
 
 	request := kms.CreateEncryptRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.Plaintext = d.Get("plaintext").(string)
 	request.KeyId = d.Get("key_id").(string)
@@ -60,7 +62,7 @@ func dataSourceAlibabacloudStackKmsCiphertextRead(d *schema.ResourceData, meta i
 		cm := context.(map[string]interface{})
 		contextJson, err := convertMaptoJsonString(cm)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		request.EncryptionContext = string(contextJson)
 	}
@@ -69,7 +71,7 @@ func dataSourceAlibabacloudStackKmsCiphertextRead(d *schema.ResourceData, meta i
 		return kmsClient.Encrypt(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*kms.EncryptResponse)
@@ -0,0 +1,112 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackKmsKeyVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackKmsKeyVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			//Computed value
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackKmsKeyVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	kmsService := KmsService{client}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
+		for _, i := range v.([]interface{}) {
+			if i == nil {
+				continue
+			}
+			idsMap[i.(string)] = i.(string)
+		}
+	}
+
+	versions, err := kmsService.ListKmsKeyVersions(d.Get("key_id").(string))
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	ids := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, version := range versions {
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[version.KeyVersionId]; !ok {
+				continue
+			}
+		}
+		mapping := map[string]interface{}{
+			"id":             version.KeyVersionId,
+			"key_id":         version.KeyId,
+			"key_version_id": version.KeyVersionId,
+			"creation_date":  version.CreationDate,
+		}
+		s = append(s, mapping)
+		ids = append(ids, version.KeyVersionId)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("versions", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackKmsKeyVersionsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_kms_key_versions.default"
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId,
+		fmt.Sprintf("tf_testAccKmsKeyVersionsDataSource_%d", rand),
+		dataSourceKmsKeyVersionsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"key_id": "${alibabacloudstack_kms_key.default.id}",
+			"ids":    []string{"${alibabacloudstack_kms_key.default.primary_key_version}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"key_id": "${alibabacloudstack_kms_key.default.id}",
+			"ids":    []string{"${alibabacloudstack_kms_key.default.primary_key_version}-fake"},
+		}),
+	}
+
+	var existKmsKeyVersionsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                     "1",
+			"versions.#":                "1",
+			"versions.0.key_id":         CHECKSET,
+			"versions.0.key_version_id": CHECKSET,
+			"versions.0.creation_date":  CHECKSET,
+		}
+	}
+
+	var fakeKmsKeyVersionsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"versions.#": "0",
+		}
+	}
+
+	var kmsKeyVersionsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existKmsKeyVersionsMapFunc,
+		fakeMapFunc:  fakeKmsKeyVersionsMapFunc,
+	}
+
+	kmsKeyVersionsCheckInfo.dataSourceTestCheck(t, rand, idsConf)
+}
+
+func dataSourceKmsKeyVersionsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+resource "alibabacloudstack_kms_key" "default" {
+    description = "%s"
+    pending_window_in_days = 7
+}
+`, name)
+}
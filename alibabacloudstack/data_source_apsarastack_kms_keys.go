@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackKmsKeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKmsKeysRead,
+		ReadContext: dataSourceAlibabacloudStackKmsKeysRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -89,13 +91,13 @@ func dataSourceAlibabacloudStackKmsKeys() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackKmsKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := kms.CreateListKeysRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	idsMap := make(map[string]string)
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
@@ -115,7 +117,7 @@ func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interfa
 			return kmsClient.ListKeys(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kms_keys", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kms_keys", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*kms.ListKeysResponse)
@@ -135,7 +137,7 @@ func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interfa
 		}
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -150,14 +152,14 @@ func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interfa
 
 		request := kms.CreateDescribeKeyRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.KeyId = k
 		raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
 			return kmsClient.DescribeKey(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, k, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, k, request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		key, _ := raw.(*kms.DescribeKeyResponse)
@@ -183,10 +185,10 @@ func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interfa
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("keys", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	// create a json file in current directory and write data source to it.
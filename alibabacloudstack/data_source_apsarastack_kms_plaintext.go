@@ -0,0 +1,61 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackKmsPlaintext() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackKmsPlaintextRead,
+
+		Schema: map[string]*schema.Schema{
+			"ciphertext_blob": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"plaintext": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackKmsPlaintextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	kmsService := KmsService{client}
+
+	// Since a plaintext has no ID, we create an ID based on
+	// current unix time.
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 16))
+
+	response, err := kmsService.Decrypt(d.Get("ciphertext_blob").(string), d.Get("encryption_context").(map[string]interface{}))
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.Set("plaintext", response.Plaintext)
+	d.Set("key_id", response.KeyId)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package alibabacloudstack
+
+import (
+	"testing"
+)
+
+func TestAccAlibabacloudStackKmsPlaintextDataSource(t *testing.T) {
+	resourceId := "data.alibabacloudstack_kms_plaintext.default"
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, "", dataSourceKmsPlaintextDependence)
+
+	ciphertextConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ciphertext_blob": "${alibabacloudstack_kms_ciphertext.default.ciphertext_blob}",
+			"encryption_context": map[string]string{
+				"key": "value",
+			},
+		}),
+	}
+
+	var existKmsPlaintextMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"plaintext": "plaintext",
+			"key_id":    CHECKSET,
+		}
+	}
+
+	var fakeKmsPlaintextMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"plaintext": NOSET,
+		}
+	}
+
+	var kmsPlaintextCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existKmsPlaintextMapFunc,
+		fakeMapFunc:  fakeKmsPlaintextMapFunc,
+	}
+
+	kmsPlaintextCheckInfo.dataSourceTestCheck(t, 0, ciphertextConf)
+}
+
+func dataSourceKmsPlaintextDependence(name string) string {
+	return `
+	resource "alibabacloudstack_kms_key" "default" {
+    	is_enabled = true
+	}
+
+	resource "alibabacloudstack_kms_ciphertext" "default" {
+		key_id    = alibabacloudstack_kms_key.default.id
+		plaintext = "plaintext"
+		encryption_context = {
+			key = "value"
+		}
+	}
+	`
+}
@@ -0,0 +1,57 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackKmsPublicKey() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackKmsPublicKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"key_version_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackKmsPublicKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := kms.CreateGetPublicKeyRequest()
+	client.InitRpcRequest(request.RpcRequest)
+
+	request.KeyId = d.Get("key_id").(string)
+	request.KeyVersionId = d.Get("key_version_id").(string)
+
+	raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
+		return kmsClient.GetPublicKey(request)
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kms_public_key", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*kms.GetPublicKeyResponse)
+
+	d.SetId(response.KeyId + COLON_SEPARATED + response.KeyVersionId)
+	d.Set("public_key", response.PublicKey)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package alibabacloudstack
+
+import (
+	"testing"
+)
+
+func TestAccAlibabacloudStackKmsPublicKeyDataSource(t *testing.T) {
+	resourceId := "data.alibabacloudstack_kms_public_key.default"
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, "", dataSourceKmsPublicKeyDependence)
+
+	keyConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"key_id":         "${alibabacloudstack_kms_key.default.id}",
+			"key_version_id": "${alibabacloudstack_kms_key.default.primary_key_version}",
+		}),
+	}
+
+	var existKmsPublicKeyMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"public_key": CHECKSET,
+		}
+	}
+
+	var fakeKmsPublicKeyMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"public_key": NOSET,
+		}
+	}
+
+	var kmsPublicKeyCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existKmsPublicKeyMapFunc,
+		fakeMapFunc:  fakeKmsPublicKeyMapFunc,
+	}
+
+	kmsPublicKeyCheckInfo.dataSourceTestCheck(t, 0, keyConf)
+}
+
+func dataSourceKmsPublicKeyDependence(name string) string {
+	return `
+	resource "alibabacloudstack_kms_key" "default" {
+		key_spec               = "RSA_2048"
+		key_usage              = "SIGN/VERIFY"
+		pending_window_in_days = 7
+	}
+	`
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -17,7 +19,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreInstanceClasses() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreAvailableResourceRead,
+		ReadContext: dataSourceAlibabacloudStackKVStoreAvailableResourceRead,
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -127,8 +129,8 @@ func removeRepByMap(slc []string) []string {
 	return result
 }
 
-func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := r_kvstore.CreateSelectCommRequest()
 	request.RegionId = client.RegionId
@@ -136,7 +138,7 @@ func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceD
 	request.Status = "Available"
 	instanceChargeType := d.Get("instance_charge_type").(string)
 	var response = &r_kvstore.DescribeCommSelectResponse{}
-	err := resource.Retry(time.Minute*5, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Minute*5, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeCommSelect(request)
 		})
@@ -152,7 +154,7 @@ func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceD
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kvstore_instance_classes", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kvstore_instance_classes", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	var instanceClasses []string
@@ -172,7 +174,7 @@ func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceD
 		bssopenapiService := BssopenapiService{client}
 		priceList, err := getKVStoreInstanceClassPrice(bssopenapiService, instanceChargeType, instanceClasses)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		for i, instanceClass := range instanceClasses {
 			classPrice := map[string]interface{}{
@@ -189,7 +191,7 @@ func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceD
 
 		err = d.Set("classes", instanceClassPrices)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		instanceClasses = instanceClasses[:0]
@@ -200,13 +202,13 @@ func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceD
 
 	err = d.Set("instance_classes", instanceClasses)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok {
 		err = writeToFile(output.(string), instanceClassPrices)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	return nil
@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreInstanceEngines() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreInstanceEnginesRead,
+		ReadContext: dataSourceAlibabacloudStackKVStoreInstanceEnginesRead,
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -71,19 +73,19 @@ func dataSourceAlibabacloudStackKVStoreInstanceEngines() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := r_kvstore.CreateDescribeAvailableResourceRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ZoneId = d.Get("zone_id").(string)
 	instanceChargeType := d.Get("instance_charge_type").(string)
 	request.InstanceChargeType = instanceChargeType
 	request.Engine = d.Get("engine").(string)
 	var response = &r_kvstore.DescribeAvailableResourceResponse{}
-	err := resource.Retry(time.Minute*5, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Minute*5, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeAvailableResource(request)
 		})
@@ -99,7 +101,7 @@ func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kvstore_instance_engines", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kvstore_instance_engines", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	var infos []map[string]interface{}
@@ -151,12 +153,12 @@ func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(d *schema.ResourceDat
 	d.SetId(dataResourceIdHash(ids))
 	err = d.Set("instance_engines", infos)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok {
 		err = writeToFile(output.(string), infos)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	return nil
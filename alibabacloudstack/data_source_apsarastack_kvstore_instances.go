@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackKVStoreInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackKVStoreInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -143,13 +145,13 @@ func dataSourceAlibabacloudStackKVStoreInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackKVStoreInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := r_kvstore.CreateDescribeInstancesRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceType = d.Get("instance_type").(string)
 	request.InstanceStatus = d.Get("status").(string)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
@@ -184,7 +186,7 @@ func dataSourceAlibabacloudStackKVStoreInstancesRead(d *schema.ResourceData, met
 			return rkvClient.DescribeInstances(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kvstore_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kvstore_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*r_kvstore.DescribeInstancesResponse)
@@ -211,13 +213,13 @@ func dataSourceAlibabacloudStackKVStoreInstancesRead(d *schema.ResourceData, met
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
 
-	return kvstoreInstancesDescription(d, dbi)
+	return DiagnosticsFromError(kvstoreInstancesDescription(d, dbi))
 }
 
 func kvstoreInstancesDescription(d *schema.ResourceData, dbi []r_kvstore.KVStoreInstance) error {
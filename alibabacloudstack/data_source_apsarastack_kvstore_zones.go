@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackKVStoreZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreZoneRead,
+		ReadContext: dataSourceAlibabacloudStackKVStoreZoneRead,
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -58,8 +60,8 @@ func dataSourceAlibabacloudStackKVStoreZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackKVStoreZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
 	//instanceChargeType := d.Get("instance_charge_type").(string)
@@ -67,18 +69,18 @@ func dataSourceAlibabacloudStackKVStoreZoneRead(d *schema.ResourceData, meta int
 	request := r_kvstore.CreateDescribeRegionsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	//request.InstanceChargeType = instanceChargeType
 	raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 		return rkvClient.DescribeRegions(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kvstore_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_kvstore_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	zones, _ := raw.(*r_kvstore.DescribeRegionsResponse)
 	if len(zones.RegionIds.KVStoreRegion) <= 0 {
-		return WrapError(fmt.Errorf("[ERROR] There is no available zones for KVStore"))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available zones for KVStore")))
 	}
 	for _, zone := range zones.RegionIds.KVStoreRegion {
 		if multi && strings.Contains(zone.ZoneIds, MULTI_IZ_SYMBOL) {
@@ -115,10 +117,10 @@ func dataSourceAlibabacloudStackKVStoreZoneRead(d *schema.ResourceData, meta int
 	}
 	d.SetId(dataResourceIdHash(zoneIds))
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", zoneIds); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackMaxcomputeClusterQutaos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead,
+		ReadContext: dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead,
 		Schema: map[string]*schema.Schema{
 			"output_file": {
 				Type:     schema.TypeString,
@@ -47,12 +49,12 @@ func dataSourceAlibabacloudStackMaxcomputeClusterQutaos() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	conn, err := client.NewAscmClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	action := "GetOdpsQuota"
@@ -62,7 +64,7 @@ func dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead(d *schema.ResourceDa
 	roleId, err := client.RoleIds()
 	if err != nil {
 		err = WrapErrorf(Error(GetNotFoundMessage("ASCM User", "defaultRoleId")), NotFoundMsg, ProviderERROR)
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	request := map[string]interface{}{
@@ -81,21 +83,21 @@ func dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead(d *schema.ResourceDa
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &runtime)
 	if err != nil {
 		err = WrapErrorf(err, DefaultErrorMsg, cluster, action, AlibabacloudStackSdkGoERROR)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	addDebug(action, response, request)
 
 	if IsExpectedErrorCodes(fmt.Sprintf("%v", response["code"]), []string{"102", "403"}) {
 		err = WrapErrorf(Error(GetNotFoundMessage("Maxcompute Cluster", cluster)), NotFoundMsg, ProviderERROR)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	if fmt.Sprintf(`%v`, response["code"]) != "200" {
 		err = Error("ListOdpsCus failed for " + response["asapiErrorMessage"].(string))
-		return err
+		return DiagnosticsFromError(err)
 	}
 	v, err := jsonpath.Get("$", response)
 	if err != nil {
-		return WrapErrorf(err, FailedGetAttributeMsg, cluster, "$", response)
+		return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, cluster, "$", response))
 	}
 	object := v.(map[string]interface{})["data"].(map[string]interface{})
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -8,12 +9,13 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackMaxcomputeClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeClustersRead,
+		ReadContext: dataSourceAlibabacloudStackMaxcomputeClustersRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -63,11 +65,11 @@ func dataSourceAlibabacloudStackMaxcomputeClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMaxcomputeClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	objects, err := DescribeMaxcomputeProject(meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var r *regexp.Regexp
 	if rt, ok := d.GetOk("name_regex"); ok && rt.(string) != "" {
@@ -93,10 +95,10 @@ func dataSourceAlibabacloudStackMaxcomputeClustersRead(d *schema.ResourceData, m
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("clusters", t); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), t)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -8,12 +9,13 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackMaxcomputeCus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeCusRead,
+		ReadContext: dataSourceAlibabacloudStackMaxcomputeCusRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -69,21 +71,21 @@ func dataSourceAlibabacloudStackMaxcomputeCus() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeCusRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackMaxcomputeCusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	conn, err := client.NewAscmClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if d.Get("name_regex").(string) != "" && d.Get("cluster_name").(string) != "" {
 		err = Error("Only one filter condition can be set")
-		return err
+		return DiagnosticsFromError(err)
 	}
 	if d.Get("name_regex").(string) == "" && d.Get("cluster_name").(string) == "" {
 		err = Error("At least one filter condition needs to be set.")
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	action := "ListOdpsCus"
@@ -113,21 +115,21 @@ func dataSourceAlibabacloudStackMaxcomputeCusRead(d *schema.ResourceData, meta i
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &runtime)
 	if err != nil {
 		err = WrapErrorf(err, DefaultErrorMsg, filter_query, action, AlibabacloudStackSdkGoERROR)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	addDebug(action, response, request)
 
 	if IsExpectedErrorCodes(fmt.Sprintf("%v", response["code"]), []string{"102", "403"}) {
 		err = WrapErrorf(Error(GetNotFoundMessage("MaxcomputeProject", filter_query)), NotFoundMsg, ProviderERROR)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	if fmt.Sprintf(`%v`, response["code"]) != "200" {
 		err = Error("ListOdpsCus failed for " + response["asapiErrorMessage"].(string))
-		return err
+		return DiagnosticsFromError(err)
 	}
 	v, err := jsonpath.Get("$", response)
 	if err != nil {
-		return WrapErrorf(err, FailedGetAttributeMsg, filter_query, "$", response)
+		return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, filter_query, "$", response))
 	}
 	objects := v.(map[string]interface{})["data"].([]interface{})
 
@@ -137,7 +139,7 @@ func dataSourceAlibabacloudStackMaxcomputeCusRead(d *schema.ResourceData, meta i
 		cu_raw := object.(map[string]interface{})
 		max_cu, err := cu_raw["max_cu"].(json.Number).Float64()
 		if err != nil {
-			return WrapError(Error("illegal max_cu value"))
+			return DiagnosticsFromError(WrapError(Error("illegal max_cu value")))
 		}
 		cu := map[string]interface{}{
 			"id":           cu_raw["id"].(string),
@@ -152,10 +154,10 @@ func dataSourceAlibabacloudStackMaxcomputeCusRead(d *schema.ResourceData, meta i
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("cus", t); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), t)
@@ -1,16 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strconv"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackMaxcomputeProjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeProjectsRead,
+		ReadContext: dataSourceAlibabacloudStackMaxcomputeProjectsRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -92,8 +94,8 @@ func dataSourceAlibabacloudStackMaxcomputeProjects() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeProjectsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackMaxcomputeProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	maxcomputeService := MaxcomputeService{client}
 	objects, err := maxcomputeService.DescribeMaxcomputeProject(d.Get("name").(string))
 	if err != nil {
@@ -102,7 +104,7 @@ func dataSourceAlibabacloudStackMaxcomputeProjectsRead(d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var t []map[string]interface{}
@@ -119,10 +121,10 @@ func dataSourceAlibabacloudStackMaxcomputeProjectsRead(d *schema.ResourceData, m
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("projects", t); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), t)
@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strconv"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackMaxcomputeUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeUsersRead,
+		ReadContext: dataSourceAlibabacloudStackMaxcomputeUsersRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -79,8 +81,8 @@ func dataSourceAlibabacloudStackMaxcomputeUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeUsersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackMaxcomputeUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	maxcomputeService := MaxcomputeService{client}
 	objects, err := maxcomputeService.DescribeMaxcomputeUser(d.Get("name_regex").(string))
 	if err != nil {
@@ -89,7 +91,7 @@ func dataSourceAlibabacloudStackMaxcomputeUsersRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var t []map[string]interface{}
@@ -112,10 +114,10 @@ func dataSourceAlibabacloudStackMaxcomputeUsersRead(d *schema.ResourceData, meta
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("users", t); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), t)
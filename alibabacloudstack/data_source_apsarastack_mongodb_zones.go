@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackMongoDBZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMongoDBZonesRead,
+		ReadContext: dataSourceAlibabacloudStackMongoDBZonesRead,
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -50,26 +52,26 @@ func dataSourceAlibabacloudStackMongoDBZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMongoDBZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackMongoDBZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
 
 	request := dds.CreateDescribeRegionsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
 		return ddsClient.DescribeRegions(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_mongodb_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_mongodb_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	regions, _ := raw.(*dds.DescribeRegionsResponse)
 	if len(regions.Regions.DdsRegion) <= 0 {
-		return WrapError(fmt.Errorf("[ERROR] There is no available region for MongoDB."))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for MongoDB.")))
 	}
 	for _, r := range regions.Regions.DdsRegion {
 		for _, zonid := range r.Zones.Zone {
@@ -105,10 +107,10 @@ func dataSourceAlibabacloudStackMongoDBZonesRead(d *schema.ResourceData, meta in
 	}
 	d.SetId(dataResourceIdHash(zoneIds))
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", zoneIds); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
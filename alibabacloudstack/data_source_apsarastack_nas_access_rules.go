@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackAccessRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAccessRulesRead,
+		ReadContext: dataSourceAlibabacloudStackAccessRulesRead,
 
 		Schema: map[string]*schema.Schema{
 			"source_cidr_ip": {
@@ -74,8 +76,8 @@ func dataSourceAlibabacloudStackAccessRules() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackAccessRulesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackAccessRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeAccessRules"
 	request := make(map[string]interface{})
@@ -98,20 +100,20 @@ func dataSourceAlibabacloudStackAccessRulesRead(d *schema.ResourceData, meta int
 	var response map[string]interface{}
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_access_rules", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_access_rules", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.AccessRules.AccessRule", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.AccessRules.AccessRule", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.AccessRules.AccessRule", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -153,11 +155,11 @@ func dataSourceAlibabacloudStackAccessRulesRead(d *schema.ResourceData, meta int
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("rules", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackFileSystems() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackFileSystemsRead,
+		ReadContext: dataSourceAlibabacloudStackFileSystemsRead,
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -113,8 +115,8 @@ func dataSourceAlibabacloudStackFileSystems() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackFileSystemsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackFileSystemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeFileSystems"
 	request := make(map[string]interface{})
@@ -137,26 +139,26 @@ func dataSourceAlibabacloudStackFileSystemsRead(d *schema.ResourceData, meta int
 	if v, ok := d.GetOk("description_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		filesystemDescriptionRegex = r
 	}
 	var response map[string]interface{}
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_file_systems", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_file_systems", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 		resp, err := jsonpath.Get("$.FileSystems.FileSystem", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.FileSystems.FileSystem", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.FileSystems.FileSystem", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -209,15 +211,15 @@ func dataSourceAlibabacloudStackFileSystemsRead(d *schema.ResourceData, meta int
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("descriptions", descriptions); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("systems", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackNasMountTargets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNasMountTargetsRead,
+		ReadContext: dataSourceAlibabacloudStackNasMountTargetsRead,
 		Schema: map[string]*schema.Schema{
 			"access_group_name": {
 				Type:     schema.TypeString,
@@ -113,8 +115,8 @@ func dataSourceAlibabacloudStackNasMountTargets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackNasMountTargetsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackNasMountTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeMountTargets"
 	request := make(map[string]interface{})
@@ -139,20 +141,20 @@ func dataSourceAlibabacloudStackNasMountTargetsRead(d *schema.ResourceData, meta
 	var response map[string]interface{}
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_mount_targets", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_mount_targets", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.MountTargets.MountTarget", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.MountTargets.MountTarget", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.MountTargets.MountTarget", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -209,11 +211,11 @@ func dataSourceAlibabacloudStackNasMountTargetsRead(d *schema.ResourceData, meta
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("targets", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackNasProtocols() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNasProtocolsRead,
+		ReadContext: dataSourceAlibabacloudStackNasProtocolsRead,
 
 		Schema: map[string]*schema.Schema{
 			"type": {
@@ -43,8 +45,8 @@ func dataSourceAlibabacloudStackNasProtocols() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackNasProtocolsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackNasProtocolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DescribeZones"
 	var response map[string]interface{}
 	request := make(map[string]interface{})
@@ -53,19 +55,19 @@ func dataSourceAlibabacloudStackNasProtocolsRead(d *schema.ResourceData, meta in
 	request["OrganizationId"] = client.Department
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &runtime)
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_protocols", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_protocols", action, AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(action, response, request)
 	resp, err := jsonpath.Get("$.Zones.Zone", response)
 	if err != nil {
-		return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Zones.Zone", response)
+		return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Zones.Zone", response))
 	}
 	var nasProtocol [][]interface{}
 	result, _ := resp.([]interface{})
@@ -102,7 +104,7 @@ func dataSourceAlibabacloudStackNasProtocolsRead(d *schema.ResourceData, meta in
 	}
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("protocols", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
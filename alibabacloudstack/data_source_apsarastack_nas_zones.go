@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -8,12 +9,13 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackNasZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNasZonesRead,
+		ReadContext: dataSourceAlibabacloudStackNasZonesRead,
 		Schema: map[string]*schema.Schema{
 			"output_file": {
 				Type:     schema.TypeString,
@@ -51,8 +53,8 @@ func dataSourceAlibabacloudStackNasZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackNasZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackNasZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeZones"
 	request := make(map[string]interface{})
@@ -63,19 +65,19 @@ func dataSourceAlibabacloudStackNasZonesRead(d *schema.ResourceData, meta interf
 	var response map[string]interface{}
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &runtime)
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_zones", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nas_zones", action, AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(action, response, request)
 
 	resp, err := jsonpath.Get("$.Zones.Zone", response)
 	if err != nil {
-		return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Zones.Zone", response)
+		return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Zones.Zone", response))
 	}
 	result, _ := resp.([]interface{})
 	for _, v := range result {
@@ -112,7 +114,7 @@ func dataSourceAlibabacloudStackNasZonesRead(d *schema.ResourceData, meta interf
 	d.SetId(strconv.FormatInt(time.Now().Unix(), 16))
 
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
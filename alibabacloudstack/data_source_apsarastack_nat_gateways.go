@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackNatGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNatGatewaysRead,
+		ReadContext: dataSourceAlibabacloudStackNatGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -86,8 +88,8 @@ func dataSourceAlibabacloudStackNatGateways() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackNatGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeNatGatewaysRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -98,7 +100,7 @@ func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta int
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	request.VpcId = d.Get("vpc_id").(string)
@@ -115,7 +117,7 @@ func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta int
 		if r, err := regexp.Compile(Trim(v.(string))); err == nil {
 			nameRegex = r
 		} else {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	invoker := NewInvoker()
@@ -128,7 +130,7 @@ func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta int
 			raw = response
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nat_gateways", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nat_gateways", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeNatGatewaysResponse)
@@ -155,13 +157,13 @@ func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta int
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
 
-	return NatGatewaysDecriptionAttributes(d, allNatGateways, meta)
+	return DiagnosticsFromError(NatGatewaysDecriptionAttributes(d, allNatGateways, meta))
 }
 
 func NatGatewaysDecriptionAttributes(d *schema.ResourceData, gateways []vpc.NatGateway, meta interface{}) error {
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"regexp"
@@ -14,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackNetworkInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackNetworkInterfacesRead,
+		ReadContext: dataSourceAlibabacloudstackNetworkInterfacesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeSet,
@@ -136,8 +138,8 @@ func dataSourceAlibabacloudStackNetworkInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudstackNetworkInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ecs.CreateDescribeNetworkInterfacesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -145,7 +147,7 @@ func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	if networkInterfaceIds, ok := d.GetOk("ids"); ok {
 		ids := expandStringList(networkInterfaceIds.(*schema.Set).List())
@@ -193,7 +195,7 @@ func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, me
 			return ecsClient.DescribeNetworkInterfaces(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_network_interfaces", request.GetActionName(), AlibabacloudStackGoClientFailure)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_network_interfaces", request.GetActionName(), AlibabacloudStackGoClientFailure))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		object := raw.(*ecs.DescribeNetworkInterfacesResponse)
@@ -204,7 +206,7 @@ func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, me
 		}
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -223,7 +225,7 @@ func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, me
 	} else {
 		filterEnis = allEnis
 	}
-	return WrapError(networkInterfaceDescriptionAttributes(d, filterEnis, meta))
+	return DiagnosticsFromError(WrapError(networkInterfaceDescriptionAttributes(d, filterEnis, meta)))
 }
 
 func networkInterfaceDescriptionAttributes(d *schema.ResourceData, enis []ecs.NetworkInterfaceSet, meta interface{}) error {
@@ -1,21 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"log"
 	"regexp"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackOnsGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackOnsGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -80,30 +81,14 @@ func dataSourceAlibabacloudStackOnsGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOnsGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	namespaceid := d.Get("instance_id").(string)
 
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.Scheme = "http"
-	request.RegionId = client.RegionId
-	request.ApiName = "ConsoleGroupList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ons-inner",
-		"RegionId":        client.RegionId,
-		"Action":          "ConsoleGroupList",
-		"Version":         "2018-02-05",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"OnsRegionId":     client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      namespaceid,
-	}
+	request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleGroupList", "")
+	request.QueryParams["OnsRegionId"] = client.RegionId
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["InstanceId"] = namespaceid
 	response := OnsGroup{}
 
 	for {
@@ -113,13 +98,13 @@ func dataSourceAlibabacloudStackOnsGroupsRead(d *schema.ResourceData, meta inter
 		log.Printf(" response of raw ConsoleGroupList : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		bresponse, _ := raw.(*responses.CommonResponse)
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == 200 || len(response.Data) < 1 {
 			break
@@ -150,7 +135,7 @@ func dataSourceAlibabacloudStackOnsGroupsRead(d *schema.ResourceData, meta inter
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("groups", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
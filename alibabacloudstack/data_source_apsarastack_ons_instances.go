@@ -1,11 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackOnsInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackOnsInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -97,28 +98,12 @@ func dataSourceAlibabacloudStackOnsInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOnsInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.Scheme = "http"
-	request.RegionId = client.RegionId
-	request.ApiName = "ConsoleInstanceList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ons-inner",
-		"RegionId":        client.RegionId,
-		"Action":          "ConsoleInstanceList",
-		"Version":         "2018-02-05",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"OnsRegionId":     client.RegionId,
-		"PreventCache":    "",
-	}
+	request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleInstanceList", "")
+	request.QueryParams["OnsRegionId"] = client.RegionId
+	request.QueryParams["PreventCache"] = ""
 	response := OInstance{}
 
 	for {
@@ -128,13 +113,13 @@ func dataSourceAlibabacloudStackOnsInstancesRead(d *schema.ResourceData, meta in
 		log.Printf(" response of raw ConsoleInstanceList : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		bresponse, _ := raw.(*responses.CommonResponse)
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == "200" || len(response.Data) < 1 {
 			break
@@ -174,13 +159,13 @@ func dataSourceAlibabacloudStackOnsInstancesRead(d *schema.ResourceData, meta in
 	d.SetId(dataResourceIdHash(ids))
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("instances", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
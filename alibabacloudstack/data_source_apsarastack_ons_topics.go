@@ -1,11 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackOnsTopics() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsTopicsRead,
+		ReadContext: dataSourceAlibabacloudStackOnsTopicsRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -106,30 +107,14 @@ func dataSourceAlibabacloudStackOnsTopics() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsTopicsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOnsTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	namespaceid := d.Get("instance_id").(string)
 
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.Scheme = "http"
-	request.RegionId = client.RegionId
-	request.ApiName = "ConsoleTopicList"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ons-inner",
-		"RegionId":        client.RegionId,
-		"Action":          "ConsoleTopicList",
-		"Version":         "2018-02-05",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"OnsRegionId":     client.RegionId,
-		"PreventCache":    "",
-		"namespaceId":     namespaceid,
-	}
+	request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleTopicList", "")
+	request.QueryParams["OnsRegionId"] = client.RegionId
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["namespaceId"] = namespaceid
 	response := Topic{}
 
 	for {
@@ -139,13 +124,13 @@ func dataSourceAlibabacloudStackOnsTopicsRead(d *schema.ResourceData, meta inter
 		log.Printf(" response of raw ConsoleTopicList : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_topics", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_topics", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		bresponse, _ := raw.(*responses.CommonResponse)
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Code == 200 || len(response.Data) < 1 {
 			break
@@ -179,7 +164,7 @@ func dataSourceAlibabacloudStackOnsTopicsRead(d *schema.ResourceData, meta inter
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("topics", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackOosExecutions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOosExecutionsRead,
+		ReadContext: dataSourceAlibabacloudStackOosExecutionsRead,
 		Schema: map[string]*schema.Schema{
 			"category": {
 				Type:         schema.TypeString,
@@ -197,8 +199,8 @@ func dataSourceAlibabacloudStackOosExecutions() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOosExecutionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOosExecutionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "ListExecutions"
 	request := make(map[string]interface{})
@@ -248,7 +250,7 @@ func dataSourceAlibabacloudStackOosExecutionsRead(d *schema.ResourceData, meta i
 	if v, ok := d.GetOk("tags"); ok {
 		respJson, err := convertMaptoJsonString(v.(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request["Tags"] = respJson
 	}
@@ -270,20 +272,20 @@ func dataSourceAlibabacloudStackOosExecutionsRead(d *schema.ResourceData, meta i
 	var response map[string]interface{}
 	conn, err := client.NewOosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_oos_executions", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_oos_executions", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.Executions", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Executions", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Executions", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -335,11 +337,11 @@ func dataSourceAlibabacloudStackOosExecutionsRead(d *schema.ResourceData, meta i
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("executions", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
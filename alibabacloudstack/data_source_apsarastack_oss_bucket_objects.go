@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"regexp"
 	"time"
@@ -9,13 +10,14 @@ import (
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackOssBucketObjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOssBucketObjectsRead,
+		ReadContext: dataSourceAlibabacloudStackOssBucketObjectsRead,
 
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
@@ -100,53 +102,16 @@ func dataSourceAlibabacloudStackOssBucketObjects() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOssBucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOssBucketObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	bucketName := d.Get("bucket_name").(string)
 
-	// List bucket objects
-	var initialOptions []oss.Option
-	if v, ok := d.GetOk("key_prefix"); ok && v.(string) != "" {
-		keyPrefix := v.(string)
-		initialOptions = append(initialOptions, oss.Prefix(keyPrefix))
-	}
-	var requestInfo *oss.Client
-	var allObjects []oss.ObjectProperties
-	nextMarker := ""
-	for {
-		var options []oss.Option
-		options = append(options, initialOptions...)
-		if nextMarker != "" {
-			options = append(options, oss.Marker(nextMarker))
-		}
-
-		raw, err := client.WithOssBucketByName(bucketName, func(bucket *oss.Bucket) (interface{}, error) {
-			requestInfo = &bucket.Client
-			return bucket.ListObjects(options...)
-		})
-		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_oss_bucket_object", "ListObjects", AlibabacloudStackOssGoSdk)
-		}
-		if debugOn() {
-			addDebug("ListObjects", raw, requestInfo, map[string]interface{}{"options": options})
-		}
-		response, _ := raw.(oss.ListObjectsResult)
-
-		if response.Objects == nil || len(response.Objects) < 1 {
-			break
-		}
+	ossService := OssService{client}
 
-		if len(response.Objects) < 1 {
-			break
-		}
-
-		allObjects = append(allObjects, response.Objects...)
-
-		nextMarker = response.NextMarker
-		if nextMarker == "" {
-			break
-		}
+	allObjects, err := ossService.ListOssBucketObjects(bucketName, d.Get("key_prefix").(string))
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var filteredObjectsTemp []oss.ObjectProperties
@@ -166,7 +131,7 @@ func dataSourceAlibabacloudStackOssBucketObjectsRead(d *schema.ResourceData, met
 		filteredObjectsTemp = allObjects
 	}
 
-	return bucketObjectsDescriptionAttributes(d, bucketName, filteredObjectsTemp, meta)
+	return DiagnosticsFromError(bucketObjectsDescriptionAttributes(d, bucketName, filteredObjectsTemp, meta))
 }
 
 func bucketObjectsDescriptionAttributes(d *schema.ResourceData, bucketName string, objects []oss.ObjectProperties, meta interface{}) error {
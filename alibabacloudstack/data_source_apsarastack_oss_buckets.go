@@ -1,22 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"encoding/xml"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudStackOssBuckets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOssBucketsRead,
+		ReadContext: dataSourceAlibabacloudStackOssBucketsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -248,8 +248,8 @@ func dataSourceAlibabacloudStackOssBuckets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOssBucketsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOssBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *oss.Client
 	var allBuckets []oss.BucketProperties
 	nextMarker := ""
@@ -258,36 +258,13 @@ func dataSourceAlibabacloudStackOssBucketsRead(d *schema.ResourceData, meta inte
 		if nextMarker != "" {
 			options = append(options, oss.Marker(nextMarker))
 		}
-		request := requests.NewCommonRequest()
-
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "OneRouter",
-			//"Department":       client.Department,
-			//"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "GetService",
-			"ProductName":      "oss",
-		}
-		request.Method = "POST"        // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		//"Department":       client.Department,
+		//"ResourceGroup":    client.ResourceGroup,
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "GetService"
+		request.QueryParams["ProductName"] = "oss"
 
 		var bucketList = &BucketList{}
 		raw, err := client.WithOssNewClient(func(ossClient *ecs.Client) (interface{}, error) {
@@ -297,19 +274,19 @@ func dataSourceAlibabacloudStackOssBucketsRead(d *schema.ResourceData, meta inte
 
 		if err != nil {
 			if ossNotFoundError(err) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, "GetBucketInfo", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "GetBucketInfo", AlibabacloudStackOssGoSdk))
 		}
 		addDebug("GetBucketInfo", raw, requestInfo, request)
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), bucketList)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if bucketList.Code != "200" || len(bucketList.Data.ListAllMyBucketsResult.Buckets.Bucket) < 1 {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//var found = false
@@ -371,7 +348,7 @@ func dataSourceAlibabacloudStackOssBucketsRead(d *schema.ResourceData, meta inte
 	} else {
 		filteredBucketsTemp = allBuckets
 	}
-	return bucketsDescriptionAttributes(d, filteredBucketsTemp, meta)
+	return DiagnosticsFromError(bucketsDescriptionAttributes(d, filteredBucketsTemp, meta))
 }
 
 func bucketsDescriptionAttributes(d *schema.ResourceData, buckets []oss.BucketProperties, meta interface{}) error {
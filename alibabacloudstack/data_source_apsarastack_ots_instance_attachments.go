@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackOtsInstanceAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsInstanceAttachmentsRead,
+		ReadContext: dataSourceAlibabacloudStackOtsInstanceAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -79,13 +81,13 @@ func dataSourceAlibabacloudStackOtsInstanceAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOtsInstanceAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOtsInstanceAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	instanceName := d.Get("instance_name").(string)
 	allVpcs, err := otsService.ListOtsInstanceVpc(instanceName)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var filteredVpcs []ots.VpcInfo
@@ -99,7 +101,7 @@ func dataSourceAlibabacloudStackOtsInstanceAttachmentsRead(d *schema.ResourceDat
 	} else {
 		filteredVpcs = allVpcs[:]
 	}
-	return otsAttachmentsDescriptionAttributes(d, filteredVpcs, meta)
+	return DiagnosticsFromError(otsAttachmentsDescriptionAttributes(d, filteredVpcs, meta))
 }
 
 func otsAttachmentsDescriptionAttributes(d *schema.ResourceData, vpcInfos []ots.VpcInfo, meta interface{}) error {
@@ -1,16 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackOtsInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsInstancesRead,
+		ReadContext: dataSourceAlibabacloudStackOtsInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -96,13 +98,13 @@ func dataSourceAlibabacloudStackOtsInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOtsInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOtsInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 
 	allInstanceNames, err := otsService.ListOtsInstance(PageSizeLarge, 1)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ots_instances", "ListOtsInstance", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ots_instances", "ListOtsInstance", AlibabacloudStackSdkGoERROR))
 	}
 
 	idsMap := make(map[string]bool)
@@ -140,7 +142,7 @@ func dataSourceAlibabacloudStackOtsInstancesRead(d *schema.ResourceData, meta in
 	for _, instanceName := range filteredInstanceNames {
 		instanceInfo, err := otsService.DescribeOtsInstance(instanceName)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		allInstances = append(allInstances, instanceInfo)
 	}
@@ -161,7 +163,7 @@ func dataSourceAlibabacloudStackOtsInstancesRead(d *schema.ResourceData, meta in
 	} else {
 		filteredInstances = allInstances[:]
 	}
-	return otsInstancesDecriptionAttributes(d, filteredInstances, meta)
+	return DiagnosticsFromError(otsInstancesDecriptionAttributes(d, filteredInstances, meta))
 }
 
 func otsInstancesDecriptionAttributes(d *schema.ResourceData, instances []InstanceInfo, meta interface{}) error {
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -15,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackOtsService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsServiceRead,
+		ReadContext: dataSourceAlibabacloudStackOtsServiceRead,
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -31,8 +33,8 @@ func dataSourceAlibabacloudStackOtsService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOtsServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := make(map[string]interface{})
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
 		d.SetId("OtsServicHasNotBeenOpened")
@@ -42,13 +44,13 @@ func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta inte
 	}
 	conn, err := client.NewCloudfwClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "OpenOtsService"
 	request["RegionId"] = client.RegionId
 	request["Product"] = "Ots"
 	request["OrganizationId"] = client.Department
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-06-20"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -71,7 +73,7 @@ func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta inte
 			d.Set("status", "Opened")
 			return nil
 		}
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ots_service", "OpenOtsService", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ots_service", "OpenOtsService", AlibabacloudStackSdkGoERROR))
 	}
 
 	return nil
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"regexp"
 
 	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackOtsTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsTablesRead,
+		ReadContext: dataSourceAlibabacloudStackOtsTablesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -103,14 +105,14 @@ type OtsTableInfo struct {
 	maxVersion   int
 }
 
-func dataSourceAlibabacloudStackOtsTablesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackOtsTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	instanceName := d.Get("instance_name").(string)
 
 	object, err := otsService.ListOtsTable(instanceName)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	idsMap := make(map[string]bool)
@@ -149,7 +151,7 @@ func dataSourceAlibabacloudStackOtsTablesRead(d *schema.ResourceData, meta inter
 	for _, tableName := range filteredTableNames {
 		object, err := otsService.DescribeOtsTable(fmt.Sprintf("%s%s%s", instanceName, COLON_SEPARATED, tableName))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		allTableInfos = append(allTableInfos, OtsTableInfo{
 			instanceName: instanceName,
@@ -160,7 +162,7 @@ func dataSourceAlibabacloudStackOtsTablesRead(d *schema.ResourceData, meta inter
 		})
 	}
 
-	return otsTablesDescriptionAttributes(d, allTableInfos, meta)
+	return DiagnosticsFromError(otsTablesDescriptionAttributes(d, allTableInfos, meta))
 }
 
 func otsTablesDescriptionAttributes(d *schema.ResourceData, tableInfos []OtsTableInfo, meta interface{}) error {
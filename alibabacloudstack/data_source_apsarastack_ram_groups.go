@@ -0,0 +1,174 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackRamGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackRamGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Custom",
+				ValidateFunc: validation.StringInSlice([]string{"System", "Custom"}, false),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comments": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackRamGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := ram.CreateListGroupsRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.MaxItems = requests.NewInteger(1000)
+	var groups []ram.GroupInListGroups
+	for {
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListGroups(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ram.ListGroupsResponse)
+		groups = append(groups, response.Groups.Group...)
+		if !response.IsTruncated {
+			break
+		}
+		request.Marker = response.Marker
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		nameRegex = r
+	}
+
+	var userGroups map[string]bool
+	if v, ok := d.GetOk("user_name"); ok && v.(string) != "" {
+		request := ram.CreateListGroupsForUserRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.UserName = v.(string)
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListGroupsForUser(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		userGroups = make(map[string]bool)
+		for _, group := range raw.(*ram.ListGroupsForUserResponse).Groups.Group {
+			userGroups[group.GroupName] = true
+		}
+	}
+
+	var policyGroups map[string]bool
+	if v, ok := d.GetOk("policy_name"); ok && v.(string) != "" {
+		request := ram.CreateListEntitiesForPolicyRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.PolicyName = v.(string)
+		request.PolicyType = d.Get("policy_type").(string)
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListEntitiesForPolicy(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		policyGroups = make(map[string]bool)
+		for _, group := range raw.(*ram.ListEntitiesForPolicyResponse).Groups.Group {
+			policyGroups[group.GroupName] = true
+		}
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, group := range groups {
+		if nameRegex != nil && !nameRegex.MatchString(group.GroupName) {
+			continue
+		}
+		if userGroups != nil && !userGroups[group.GroupName] {
+			continue
+		}
+		if policyGroups != nil && !policyGroups[group.GroupName] {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"name":     group.GroupName,
+			"comments": group.Comments,
+		}
+		ids = append(ids, group.GroupName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("groups", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("names", ids); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackRamGroupsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_ram_groups.default"
+	name := fmt.Sprintf("tf-testAccRamGroups%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceRamGroupsConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_group.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_group.default.name}_fake",
+		}),
+	}
+	userNameConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"user_name": "${alibabacloudstack_ram_group_membership.default.user_names[0]}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_group.default.name}_fake",
+			"user_name":  "${alibabacloudstack_ram_group_membership.default.user_names[0]}",
+		}),
+	}
+	policyConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"policy_name": "${alibabacloudstack_ram_group_policy_attachment.default.policy_name}",
+			"policy_type": "Custom",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex":  "${alibabacloudstack_ram_group.default.name}_fake",
+			"policy_name": "${alibabacloudstack_ram_group_policy_attachment.default.policy_name}",
+			"policy_type": "Custom",
+		}),
+	}
+
+	var existRamGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"names.#":           "1",
+			"names.0":           name,
+			"groups.#":          "1",
+			"groups.0.name":     name,
+			"groups.0.comments": "tf test ram groups",
+		}
+	}
+
+	var fakeRamGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"names.#":  "0",
+			"groups.#": "0",
+		}
+	}
+
+	var ramGroupsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existRamGroupsMapFunc,
+		fakeMapFunc:  fakeRamGroupsMapFunc,
+	}
+
+	ramGroupsCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, userNameConf, policyConf)
+}
+
+func dataSourceRamGroupsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ram_user" "default" {
+  name  = var.name
+  force = true
+}
+
+resource "alibabacloudstack_ram_group" "default" {
+  name     = var.name
+  comments = "tf test ram groups"
+  force    = true
+}
+
+resource "alibabacloudstack_ram_group_membership" "default" {
+  group_name = alibabacloudstack_ram_group.default.name
+  user_names = [alibabacloudstack_ram_user.default.name]
+}
+
+resource "alibabacloudstack_ram_policy" "default" {
+  name  = var.name
+  force = true
+  statement {
+    effect   = "Allow"
+    action   = ["oss:ListObjects"]
+    resource = ["acs:oss:*:*:mybucket"]
+  }
+}
+
+resource "alibabacloudstack_ram_group_policy_attachment" "default" {
+  group_name  = alibabacloudstack_ram_group.default.name
+  policy_name = alibabacloudstack_ram_policy.default.name
+  policy_type = alibabacloudstack_ram_policy.default.type
+}
+`, name)
+}
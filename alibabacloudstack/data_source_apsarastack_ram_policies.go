@@ -0,0 +1,227 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackRamPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackRamPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"System", "Custom"}, false),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attachment_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackRamPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := ram.CreateListPoliciesRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.MaxItems = requests.NewInteger(1000)
+	if v, ok := d.GetOk("type"); ok {
+		request.PolicyType = v.(string)
+	}
+	var policies []ram.PolicyInListPolicies
+	for {
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListPolicies(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ram.ListPoliciesResponse)
+		policies = append(policies, response.Policies.Policy...)
+		if !response.IsTruncated {
+			break
+		}
+		request.Marker = response.Marker
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		nameRegex = r
+	}
+
+	// the policies attached to each of the principals set, keyed by <policy_type>:<policy_name>
+	var attached []map[string]bool
+	if v, ok := d.GetOk("user_name"); ok && v.(string) != "" {
+		request := ram.CreateListPoliciesForUserRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.UserName = v.(string)
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListPoliciesForUser(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		userPolicies := make(map[string]bool)
+		for _, policy := range raw.(*ram.ListPoliciesForUserResponse).Policies.Policy {
+			userPolicies[policy.PolicyType+COLON_SEPARATED+policy.PolicyName] = true
+		}
+		attached = append(attached, userPolicies)
+	}
+	if v, ok := d.GetOk("group_name"); ok && v.(string) != "" {
+		request := ram.CreateListPoliciesForGroupRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.GroupName = v.(string)
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListPoliciesForGroup(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		groupPolicies := make(map[string]bool)
+		for _, policy := range raw.(*ram.ListPoliciesForGroupResponse).Policies.Policy {
+			groupPolicies[policy.PolicyType+COLON_SEPARATED+policy.PolicyName] = true
+		}
+		attached = append(attached, groupPolicies)
+	}
+	if v, ok := d.GetOk("role_name"); ok && v.(string) != "" {
+		request := ram.CreateListPoliciesForRoleRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.RoleName = v.(string)
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListPoliciesForRole(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		rolePolicies := make(map[string]bool)
+		for _, policy := range raw.(*ram.ListPoliciesForRoleResponse).Policies.Policy {
+			rolePolicies[policy.PolicyType+COLON_SEPARATED+policy.PolicyName] = true
+		}
+		attached = append(attached, rolePolicies)
+	}
+
+	var names []string
+	var s []map[string]interface{}
+	for _, policy := range policies {
+		if nameRegex != nil && !nameRegex.MatchString(policy.PolicyName) {
+			continue
+		}
+		matched := true
+		for _, principalPolicies := range attached {
+			if !principalPolicies[policy.PolicyType+COLON_SEPARATED+policy.PolicyName] {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"name":             policy.PolicyName,
+			"type":             policy.PolicyType,
+			"description":      policy.Description,
+			"default_version":  policy.DefaultVersion,
+			"attachment_count": policy.AttachmentCount,
+			"create_date":      policy.CreateDate,
+			"update_date":      policy.UpdateDate,
+		}
+		names = append(names, policy.PolicyName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(names))
+	if err := d.Set("policies", s); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("names", names); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
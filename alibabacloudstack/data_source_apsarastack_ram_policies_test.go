@@ -0,0 +1,122 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackRamPoliciesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_ram_policies.default"
+	name := fmt.Sprintf("tf-testAccRamPolicies%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceRamPoliciesConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_policy.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_policy.default.name}_fake",
+		}),
+	}
+	typeConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_policy.default.name}",
+			"type":       "Custom",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_policy.default.name}",
+			"type":       "System",
+		}),
+	}
+	userNameConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"user_name": "${alibabacloudstack_ram_user_policy_attachment.default.user_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_policy.default.name}_fake",
+			"user_name":  "${alibabacloudstack_ram_user_policy_attachment.default.user_name}",
+		}),
+	}
+	groupNameConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"group_name": "${alibabacloudstack_ram_group_policy_attachment.default.group_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_policy.default.name}_fake",
+			"group_name": "${alibabacloudstack_ram_group_policy_attachment.default.group_name}",
+		}),
+	}
+
+	var existRamPoliciesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"names.#":                     "1",
+			"names.0":                     name,
+			"policies.#":                  "1",
+			"policies.0.name":             name,
+			"policies.0.type":             "Custom",
+			"policies.0.description":      "tf test ram policies",
+			"policies.0.default_version":  CHECKSET,
+			"policies.0.attachment_count": "2",
+		}
+	}
+
+	var fakeRamPoliciesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"names.#":    "0",
+			"policies.#": "0",
+		}
+	}
+
+	var ramPoliciesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existRamPoliciesMapFunc,
+		fakeMapFunc:  fakeRamPoliciesMapFunc,
+	}
+
+	ramPoliciesCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, typeConf, userNameConf, groupNameConf)
+}
+
+func dataSourceRamPoliciesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ram_user" "default" {
+  name  = var.name
+  force = true
+}
+
+resource "alibabacloudstack_ram_group" "default" {
+  name  = var.name
+  force = true
+}
+
+resource "alibabacloudstack_ram_policy" "default" {
+  name        = var.name
+  description = "tf test ram policies"
+  force       = true
+  statement {
+    effect   = "Allow"
+    action   = ["oss:ListObjects"]
+    resource = ["acs:oss:*:*:mybucket"]
+  }
+}
+
+resource "alibabacloudstack_ram_user_policy_attachment" "default" {
+  user_name   = alibabacloudstack_ram_user.default.name
+  policy_name = alibabacloudstack_ram_policy.default.name
+  policy_type = alibabacloudstack_ram_policy.default.type
+}
+
+resource "alibabacloudstack_ram_group_policy_attachment" "default" {
+  group_name  = alibabacloudstack_ram_group.default.name
+  policy_name = alibabacloudstack_ram_policy.default.name
+  policy_type = alibabacloudstack_ram_policy.default.type
+}
+`, name)
+}
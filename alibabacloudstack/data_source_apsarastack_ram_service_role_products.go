@@ -1,21 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"regexp"
-	"strings"
 )
 
 func dataSourceAlibabacloudstackRamServiceRoleProducts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackRamServiceRoleProductsRead,
+		ReadContext: dataSourceAlibabacloudstackRamServiceRoleProductsRead,
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -50,32 +50,10 @@ func dataSourceAlibabacloudstackRamServiceRoleProducts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackRamServiceRoleProductsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudstackRamServiceRoleProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.RegionId = client.RegionId
-	request.ApiName = "ListRAMServiceRoleProducts"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListRAMServiceRoleProducts",
-		"Version":         "2019-05-10",
-	}
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "ListRAMServiceRoleProducts", "")
 	response := RoleProducts{}
 
 	for {
@@ -85,14 +63,14 @@ func dataSourceAlibabacloudstackRamServiceRoleProductsRead(d *schema.ResourceDat
 		log.Printf(" response of raw ListRAMServiceRoleProducts : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_service_role_products", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_service_role_products", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if response.Success == true || len(response.Data) < 1 {
 			break
@@ -121,7 +99,7 @@ func dataSourceAlibabacloudstackRamServiceRoleProductsRead(d *schema.ResourceDat
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("products", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
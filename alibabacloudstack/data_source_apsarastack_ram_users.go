@@ -0,0 +1,229 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackRamUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlibabacloudStackRamUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Custom",
+				ValidateFunc: validation.StringInSlice([]string{"System", "Custom"}, false),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comments": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackRamUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := ram.CreateListUsersRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.MaxItems = requests.NewInteger(1000)
+	var users []ram.UserInListUsers
+	for {
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListUsers(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_users", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ram.ListUsersResponse)
+		users = append(users, response.Users.User...)
+		if !response.IsTruncated {
+			break
+		}
+		request.Marker = response.Marker
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		nameRegex = r
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	var groupUsers map[string]bool
+	if v, ok := d.GetOk("group_name"); ok && v.(string) != "" {
+		request := ram.CreateListUsersForGroupRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.GroupName = v.(string)
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListUsersForGroup(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_users", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		groupUsers = make(map[string]bool)
+		for _, user := range raw.(*ram.ListUsersForGroupResponse).Users.User {
+			groupUsers[user.UserName] = true
+		}
+	}
+
+	var policyUsers map[string]bool
+	if v, ok := d.GetOk("policy_name"); ok && v.(string) != "" {
+		request := ram.CreateListEntitiesForPolicyRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.PolicyName = v.(string)
+		request.PolicyType = d.Get("policy_type").(string)
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListEntitiesForPolicy(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ram_users", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		policyUsers = make(map[string]bool)
+		for _, user := range raw.(*ram.ListEntitiesForPolicyResponse).Users.User {
+			policyUsers[user.UserName] = true
+		}
+	}
+
+	var filteredUsers []ram.UserInListUsers
+	for _, user := range users {
+		if nameRegex != nil && !nameRegex.MatchString(user.UserName) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[user.UserId]; !ok {
+				continue
+			}
+		}
+		if groupUsers != nil && !groupUsers[user.UserName] {
+			continue
+		}
+		if policyUsers != nil && !policyUsers[user.UserName] {
+			continue
+		}
+		filteredUsers = append(filteredUsers, user)
+	}
+
+	return DiagnosticsFromError(ramUsersDescriptionAttributes(d, filteredUsers))
+}
+
+func ramUsersDescriptionAttributes(d *schema.ResourceData, users []ram.UserInListUsers) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, user := range users {
+		mapping := map[string]interface{}{
+			"id":           user.UserId,
+			"name":         user.UserName,
+			"display_name": user.DisplayName,
+			"comments":     user.Comments,
+			"create_date":  user.CreateDate,
+			"update_date":  user.UpdateDate,
+		}
+		ids = append(ids, user.UserId)
+		names = append(names, user.UserName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("users", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
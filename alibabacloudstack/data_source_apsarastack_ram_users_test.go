@@ -0,0 +1,123 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackRamUsersDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_ram_users.default"
+	name := fmt.Sprintf("tf-testAccRamUsers%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceRamUsersConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_user.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_user.default.name}_fake",
+		}),
+	}
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_ram_user.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_ram_user.default.id}_fake"},
+		}),
+	}
+	groupNameConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_group_membership.default.user_names[0]}",
+			"group_name": "${alibabacloudstack_ram_group.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ram_group_membership.default.user_names[0]}_fake",
+			"group_name": "${alibabacloudstack_ram_group.default.name}",
+		}),
+	}
+	policyConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"policy_name": "${alibabacloudstack_ram_user_policy_attachment.default.policy_name}",
+			"policy_type": "Custom",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex":  "${alibabacloudstack_ram_user.default.name}_fake",
+			"policy_name": "${alibabacloudstack_ram_user_policy_attachment.default.policy_name}",
+			"policy_type": "Custom",
+		}),
+	}
+
+	var existRamUsersMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                "1",
+			"names.#":              "1",
+			"names.0":              name,
+			"users.#":              "1",
+			"users.0.id":           CHECKSET,
+			"users.0.name":         name,
+			"users.0.display_name": name,
+			"users.0.create_date":  CHECKSET,
+		}
+	}
+
+	var fakeRamUsersMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"users.#": "0",
+		}
+	}
+
+	var ramUsersCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existRamUsersMapFunc,
+		fakeMapFunc:  fakeRamUsersMapFunc,
+	}
+
+	ramUsersCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf, groupNameConf, policyConf)
+}
+
+func dataSourceRamUsersConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ram_user" "default" {
+  name         = var.name
+  display_name = var.name
+  force        = true
+}
+
+resource "alibabacloudstack_ram_group" "default" {
+  name  = var.name
+  force = true
+}
+
+resource "alibabacloudstack_ram_group_membership" "default" {
+  group_name = alibabacloudstack_ram_group.default.name
+  user_names = [alibabacloudstack_ram_user.default.name]
+}
+
+resource "alibabacloudstack_ram_policy" "default" {
+  name  = var.name
+  force = true
+  statement {
+    effect   = "Allow"
+    action   = ["oss:ListObjects"]
+    resource = ["acs:oss:*:*:mybucket"]
+  }
+}
+
+resource "alibabacloudstack_ram_user_policy_attachment" "default" {
+  user_name   = alibabacloudstack_ram_user.default.name
+  policy_name = alibabacloudstack_ram_policy.default.name
+  policy_type = alibabacloudstack_ram_policy.default.type
+}
+`, name)
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -9,13 +10,14 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackRosStacks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRosStacksRead,
+		ReadContext: dataSourceAlibabacloudStackRosStacksRead,
 		Schema: map[string]*schema.Schema{
 			"parent_stack_id": {
 				Type:     schema.TypeString,
@@ -162,8 +164,8 @@ func dataSourceAlibabacloudStackRosStacks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackRosStacksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "ListStacks"
 	request := make(map[string]interface{})
@@ -194,7 +196,7 @@ func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		stackNameRegex = r
 	}
@@ -212,20 +214,20 @@ func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta inter
 	var response map[string]interface{}
 	conn, err := client.NewRosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ros_stacks", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ros_stacks", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.Stacks", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Stacks", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Stacks", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -273,7 +275,7 @@ func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta inter
 		id := fmt.Sprint(object["StackId"])
 		getResp, err := rosService.DescribeRosStack(id)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if statusOk && status != "" && status != getResp["Status"].(string) {
 			continue
@@ -300,14 +302,14 @@ func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta inter
 		mapping["template_description"] = getResp["TemplateDescription"]
 		getResp1, err := rosService.GetStackPolicy(id)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		b, err := json.Marshal(getResp1["StackPolicyBody"])
 		mapping["stack_policy_body"] = string(b)
 
 		getResp2, err := rosService.ListTagResources(id, "stack")
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		mapping["tags"] = tagsToMap(getResp2)
 
@@ -318,15 +320,15 @@ func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta inter
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("stacks", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -9,13 +10,14 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackRosTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRosTemplatesRead,
+		ReadContext: dataSourceAlibabacloudStackRosTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			"share_type": {
 				Type:         schema.TypeString,
@@ -112,8 +114,8 @@ func dataSourceAlibabacloudStackRosTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackRosTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "ListTemplates"
 	request := make(map[string]interface{})
@@ -140,7 +142,7 @@ func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta in
 	if v, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		templateNameRegex = r
 	}
@@ -157,20 +159,20 @@ func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta in
 	var response map[string]interface{}
 	conn, err := client.NewRosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ros_templates", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ros_templates", action, AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(action, response, request)
 
 		resp, err := jsonpath.Get("$.Templates", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Templates", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Templates", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -215,7 +217,7 @@ func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta in
 		id := fmt.Sprint(object["TemplateId"])
 		getResp, err := rosService.DescribeRosTemplate(id)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		mapping["change_set_id"] = getResp["ChangeSetId"]
 		mapping["stack_group_name"] = getResp["StackGroupName"]
@@ -223,13 +225,13 @@ func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta in
 
 		b, err := json.Marshal(getResp["TemplateBody"])
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		mapping["template_body"] = string(b)
 
 		getResp1, err := rosService.ListTagResources(id, "template")
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		mapping["tags"] = tagsToMap(getResp1)
 
@@ -240,15 +242,15 @@ func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta in
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("templates", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,16 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
 func dataSourceAlibabacloudStackRouteEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouteEntriesRead,
+		ReadContext: dataSourceAlibabacloudStackRouteEntriesRead,
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -68,8 +70,8 @@ func dataSourceAlibabacloudStackRouteEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouteEntriesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackRouteEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := vpc.CreateDescribeRouteTablesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -78,7 +80,7 @@ func dataSourceAlibabacloudStackRouteEntriesRead(d *schema.ResourceData, meta in
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -95,7 +97,7 @@ func dataSourceAlibabacloudStackRouteEntriesRead(d *schema.ResourceData, meta in
 			raw = response
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_route_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_route_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeRouteTablesResponse)
@@ -121,13 +123,13 @@ func dataSourceAlibabacloudStackRouteEntriesRead(d *schema.ResourceData, meta in
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
 
-	return RouteEntriesDecriptionAttributes(d, allRouteEntries, meta)
+	return DiagnosticsFromError(RouteEntriesDecriptionAttributes(d, allRouteEntries, meta))
 }
 
 func RouteEntriesDecriptionAttributes(d *schema.ResourceData, entries []vpc.RouteEntry, meta interface{}) error {
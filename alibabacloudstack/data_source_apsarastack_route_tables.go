@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackRouteTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouteTablesRead,
+		ReadContext: dataSourceAlibabacloudStackRouteTablesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -79,8 +81,8 @@ func dataSourceAlibabacloudStackRouteTables() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateDescribeRouteTableListRequest()
@@ -91,7 +93,7 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 
@@ -108,7 +110,7 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 		if r, err := regexp.Compile(Trim(v.(string))); err == nil {
 			nameRegex = r
 		} else {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	invoker := NewInvoker()
@@ -121,7 +123,7 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 			})
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_route_tables", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_route_tables", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeRouteTableListResponse)
@@ -146,7 +148,7 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 			if value, ok := d.GetOk("tags"); ok && len(value.(map[string]interface{})) > 0 {
 				tags, err := vpcService.DescribeTags(tables.RouteTableId, value.(map[string]interface{}), TagResourceRouteTable)
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 				if len(tags) < 1 {
 					continue
@@ -161,13 +163,13 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
 
-	return RouteTablesDecriptionAttributes(d, allRouteTables, meta)
+	return DiagnosticsFromError(RouteTablesDecriptionAttributes(d, allRouteTables, meta))
 }
 
 func RouteTablesDecriptionAttributes(d *schema.ResourceData, tables []vpc.RouterTableListType, meta interface{}) error {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackRouterInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouterInterfacesRead,
+		ReadContext: dataSourceAlibabacloudStackRouterInterfacesRead,
 
 		Schema: map[string]*schema.Schema{
 			"status": {
@@ -160,8 +162,8 @@ func dataSourceAlibabacloudStackRouterInterfaces() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackRouterInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeRouterInterfacesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -171,7 +173,7 @@ func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, met
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -212,7 +214,7 @@ func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_router_interfaces", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_router_interfaces", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		if len(response.RouterInterfaceSet.RouterInterfaceType) < 1 {
@@ -227,7 +229,7 @@ func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, met
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -256,7 +258,7 @@ func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, met
 		filteredRouterInterfaces = append(filteredRouterInterfaces, v)
 	}
 
-	return riDecriptionAttributes(d, filteredRouterInterfaces, meta)
+	return DiagnosticsFromError(riDecriptionAttributes(d, filteredRouterInterfaces, meta))
 }
 
 func riDecriptionAttributes(d *schema.ResourceData, riSetTypes []vpc.RouterInterfaceType, meta interface{}) error {
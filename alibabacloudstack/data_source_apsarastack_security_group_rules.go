@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSecurityGroupRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSecurityGroupRulesRead,
+		ReadContext: dataSourceAlibabacloudStackSecurityGroupRulesRead,
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -119,8 +121,8 @@ func dataSourceAlibabacloudStackSecurityGroupRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSecurityGroupRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	req := ecs.CreateDescribeSecurityGroupAttributeRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -130,7 +132,7 @@ func dataSourceAlibabacloudStackSecurityGroupRulesRead(d *schema.ResourceData, m
 	}
 	req.RegionId = client.RegionId
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.QueryParams["Department"] = client.Department
 	req.QueryParams["ResourceGroup"] = client.ResourceGroup
 	req.SecurityGroupId = d.Get("group_id").(string)
@@ -140,7 +142,7 @@ func dataSourceAlibabacloudStackSecurityGroupRulesRead(d *schema.ResourceData, m
 		return ecsClient.DescribeSecurityGroupAttribute(req)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "security_group_rules", req.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "security_group_rules", req.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(req.GetActionName(), raw, req.RpcRequest, req)
 	attr, _ := raw.(*ecs.DescribeSecurityGroupAttributeResponse)
@@ -173,25 +175,25 @@ func dataSourceAlibabacloudStackSecurityGroupRulesRead(d *schema.ResourceData, m
 
 			pri, err := strconv.Atoi(item.Priority)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			mapping["priority"] = pri
 			rules = append(rules, mapping)
 		}
 
 		if err := d.Set("group_name", attr.SecurityGroupName); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		if err := d.Set("group_desc", attr.Description); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
 	d.SetId(d.Get("group_id").(string))
 
 	if err := d.Set("rules", rules); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +22,7 @@ type SecurityGroup struct {
 
 func dataSourceAlibabacloudStackSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSecurityGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackSecurityGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -83,8 +85,8 @@ func dataSourceAlibabacloudStackSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request := ecs.CreateDescribeSecurityGroupsRequest()
@@ -94,7 +96,7 @@ func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	request.VpcId = d.Get("vpc_id").(string)
 	request.PageNumber = requests.NewInteger(1)
@@ -131,7 +133,7 @@ func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta
 			return ecsClient.DescribeSecurityGroups(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "security_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "security_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*ecs.DescribeSecurityGroupsResponse)
@@ -154,7 +156,7 @@ func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta
 
 			attr, err := ecsService.DescribeSecurityGroup(item.SecurityGroupId)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 
 			sg = append(sg,
@@ -173,12 +175,12 @@ func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
-	return securityGroupsDescription(d, sg, meta)
+	return DiagnosticsFromError(securityGroupsDescription(d, sg, meta))
 }
 
 func securityGroupsDescription(d *schema.ResourceData, sg []SecurityGroup, meta interface{}) error {
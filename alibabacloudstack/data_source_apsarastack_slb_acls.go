@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSlbAcls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbAclsRead,
+		ReadContext: dataSourceAlibabacloudStackSlbAclsRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -107,8 +109,8 @@ func dataSourceAlibabacloudStackSlbAcls() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbAclsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbAclsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := slb.CreateDescribeAccessControlListsRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -117,7 +119,7 @@ func dataSourceAlibabacloudStackSlbAclsRead(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	tags := d.Get("tags").(map[string]interface{})
 	if tags != nil && len(tags) > 0 {
 		KeyPairsTags := make([]slb.DescribeAccessControlListsTag, 0, len(tags))
@@ -140,7 +142,7 @@ func dataSourceAlibabacloudStackSlbAclsRead(d *schema.ResourceData, meta interfa
 		return slbClient.DescribeAccessControlLists(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_acls", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_acls", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeAccessControlListsResponse)
@@ -167,7 +169,7 @@ func dataSourceAlibabacloudStackSlbAclsRead(d *schema.ResourceData, meta interfa
 		filteredAclsTemp = response.Acls.Acl
 	}
 
-	return slbAclsDescriptionAttributes(d, filteredAclsTemp, client, meta)
+	return DiagnosticsFromError(slbAclsDescriptionAttributes(d, filteredAclsTemp, client, meta))
 }
 
 func aclTagsMappings(d *schema.ResourceData, aclId string, meta interface{}) map[string]string {
@@ -196,7 +198,7 @@ func slbAclsDescriptionAttributes(d *schema.ResourceData, acls []slb.Acl, client
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	for _, item := range acls {
 		request.AclId = item.AclId
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -1,15 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
 func dataSourceAlibabacloudStackSlbBackendServers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbBackendServersRead,
+		ReadContext: dataSourceAlibabacloudStackSlbBackendServersRead,
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -48,8 +50,8 @@ func dataSourceAlibabacloudStackSlbBackendServers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbBackendServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
 	request.RegionId = client.RegionId
@@ -59,7 +61,7 @@ func dataSourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	idsMap := make(map[string]string)
@@ -73,7 +75,7 @@ func dataSourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, me
 		return slbClient.DescribeLoadBalancerAttribute(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_backend_servers", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_backend_servers", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeLoadBalancerAttributeResponse)
@@ -92,7 +94,7 @@ func dataSourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, me
 		filteredBackendServersTemp = response.BackendServers.BackendServer
 	}
 
-	return slbBackendServersDescriptionAttributes(d, filteredBackendServersTemp)
+	return DiagnosticsFromError(slbBackendServersDescriptionAttributes(d, filteredBackendServersTemp))
 }
 
 func slbBackendServersDescriptionAttributes(d *schema.ResourceData, backendServers []slb.BackendServerInDescribeLoadBalancerAttribute) error {
@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSlbCACertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbCACertificatesRead,
+		ReadContext: dataSourceAlibabacloudStackSlbCACertificatesRead,
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -71,8 +73,8 @@ func dataSourceAlibabacloudStackSlbCACertificates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbCACertificatesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbCACertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeCACertificatesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -81,7 +83,7 @@ func dataSourceAlibabacloudStackSlbCACertificatesRead(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	idsMap := make(map[string]string)
 	if v, ok := d.GetOk("ids"); ok {
@@ -93,7 +95,7 @@ func dataSourceAlibabacloudStackSlbCACertificatesRead(d *schema.ResourceData, me
 		return slbClient.DescribeCACertificates(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_ca_certificates", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_ca_certificates", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeCACertificatesResponse)
@@ -120,7 +122,7 @@ func dataSourceAlibabacloudStackSlbCACertificatesRead(d *schema.ResourceData, me
 		filteredTemp = response.CACertificates.CACertificate
 	}
 
-	return slbCACertificatesDescriptionAttributes(d, filteredTemp, meta)
+	return DiagnosticsFromError(slbCACertificatesDescriptionAttributes(d, filteredTemp, meta))
 }
 
 func slbCACertificatesDescriptionAttributes(d *schema.ResourceData, certificates []slb.CACertificate, meta interface{}) error {
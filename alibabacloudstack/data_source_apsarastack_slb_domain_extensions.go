@@ -1,16 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
 func dataSourceAlibabacloudStackSlbDomainExtensions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbDomainExtensionsRead,
+		ReadContext: dataSourceAlibabacloudStackSlbDomainExtensionsRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -56,8 +58,8 @@ func dataSourceAlibabacloudStackSlbDomainExtensions() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbDomainExtensionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbDomainExtensionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeDomainExtensionsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
@@ -66,7 +68,7 @@ func dataSourceAlibabacloudStackSlbDomainExtensionsRead(d *schema.ResourceData,
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	request.ListenerPort = requests.NewInteger(d.Get("frontend_port").(int))
 
@@ -82,7 +84,7 @@ func dataSourceAlibabacloudStackSlbDomainExtensionsRead(d *schema.ResourceData,
 	})
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_domain_extensions", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_domain_extensions", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	response, _ := raw.(*slb.DescribeDomainExtensionsResponse)
 	var filteredDomainExtensionsTemp []slb.DomainExtension
@@ -98,7 +100,7 @@ func dataSourceAlibabacloudStackSlbDomainExtensionsRead(d *schema.ResourceData,
 	} else {
 		filteredDomainExtensionsTemp = response.DomainExtensions.DomainExtension
 	}
-	return slbDomainExtensionDescriptionAttributes(d, filteredDomainExtensionsTemp)
+	return DiagnosticsFromError(slbDomainExtensionDescriptionAttributes(d, filteredDomainExtensionsTemp))
 }
 
 func slbDomainExtensionDescriptionAttributes(d *schema.ResourceData, domainExtensions []slb.DomainExtension) error {
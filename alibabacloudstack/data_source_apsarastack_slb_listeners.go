@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
@@ -9,13 +10,14 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSlbListeners() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbListenersRead,
+		ReadContext: dataSourceAlibabacloudStackSlbListenersRead,
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -187,8 +189,8 @@ func dataSourceAlibabacloudStackSlbListeners() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbListenersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbListenersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
 	request.RegionId = client.RegionId
@@ -198,14 +200,14 @@ func dataSourceAlibabacloudStackSlbListenersRead(d *schema.ResourceData, meta in
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeLoadBalancerAttribute(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_listeners", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_listeners", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeLoadBalancerAttributeResponse)
@@ -240,7 +242,7 @@ func dataSourceAlibabacloudStackSlbListenersRead(d *schema.ResourceData, meta in
 		filteredListenersTemp = response.ListenerPortsAndProtocol.ListenerPortAndProtocol
 	}
 
-	return slbListenersDescriptionAttributes(d, filteredListenersTemp, meta)
+	return DiagnosticsFromError(slbListenersDescriptionAttributes(d, filteredListenersTemp, meta))
 }
 
 func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.ListenerPortAndProtocol, meta interface{}) error {
@@ -266,7 +268,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 			} else {
 				request.Scheme = "http"
 			}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			request.ListenerPort = requests.NewInteger(listener.ListenerPort)
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -303,7 +305,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 		case Https:
 			request := slb.CreateDescribeLoadBalancerHTTPSListenerAttributeRequest()
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			if strings.ToLower(client.Config.Protocol) == "https" {
 				request.Scheme = "https"
@@ -352,7 +354,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 			} else {
 				request.Scheme = "http"
 			}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			request.ListenerPort = requests.NewInteger(listener.ListenerPort)
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -389,7 +391,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 			} else {
 				request.Scheme = "http"
 			}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			request.ListenerPort = requests.NewInteger(listener.ListenerPort)
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
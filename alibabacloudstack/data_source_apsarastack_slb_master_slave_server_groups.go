@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSlbMasterSlaveServerGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -87,8 +89,8 @@ func dataSourceAlibabacloudStackSlbMasterSlaveServerGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeMasterSlaveServerGroupsRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -98,7 +100,7 @@ func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(d *schema.Resourc
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	idsMap := make(map[string]string)
@@ -112,7 +114,7 @@ func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(d *schema.Resourc
 		return slbClient.DescribeMasterSlaveServerGroups(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_master_slave_server_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_master_slave_server_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeMasterSlaveServerGroupsResponse)
@@ -139,7 +141,7 @@ func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(d *schema.Resourc
 		filteredServerGroupsTemp = response.MasterSlaveServerGroups.MasterSlaveServerGroup
 	}
 
-	return slbMasterSlaveServerGroupsDescriptionAttributes(d, filteredServerGroupsTemp, meta)
+	return DiagnosticsFromError(slbMasterSlaveServerGroupsDescriptionAttributes(d, filteredServerGroupsTemp, meta))
 }
 
 func slbMasterSlaveServerGroupsDescriptionAttributes(d *schema.ResourceData, serverGroups []slb.MasterSlaveServerGroup, meta interface{}) error {
@@ -162,7 +164,7 @@ func slbMasterSlaveServerGroupsDescriptionAttributes(d *schema.ResourceData, ser
 		} else {
 			request.Scheme = "http"
 		}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.MasterSlaveServerGroupId = serverGroup.MasterSlaveServerGroupId
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeMasterSlaveServerGroupAttribute(request)
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSlbRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbRulesRead,
+		ReadContext: dataSourceAlibabacloudStackSlbRulesRead,
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -80,8 +82,8 @@ func dataSourceAlibabacloudStackSlbRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbRulesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeRulesRequest()
 	request.RegionId = client.RegionId
@@ -91,7 +93,7 @@ func dataSourceAlibabacloudStackSlbRulesRead(d *schema.ResourceData, meta interf
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	request.ListenerPort = requests.NewInteger(d.Get("frontend_port").(int))
 
@@ -106,7 +108,7 @@ func dataSourceAlibabacloudStackSlbRulesRead(d *schema.ResourceData, meta interf
 		return slbClient.DescribeRules(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_rules", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_rules", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeRulesResponse)
@@ -133,7 +135,7 @@ func dataSourceAlibabacloudStackSlbRulesRead(d *schema.ResourceData, meta interf
 		filteredRulesTemp = response.Rules.Rule
 	}
 
-	return slbRulesDescriptionAttributes(d, filteredRulesTemp)
+	return DiagnosticsFromError(slbRulesDescriptionAttributes(d, filteredRulesTemp))
 }
 
 func slbRulesDescriptionAttributes(d *schema.ResourceData, rules []slb.Rule) error {
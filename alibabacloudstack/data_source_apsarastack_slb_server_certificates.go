@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSlbServerCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbServerCertificatesRead,
+		ReadContext: dataSourceAlibabacloudStackSlbServerCertificatesRead,
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -71,8 +73,8 @@ func dataSourceAlibabacloudStackSlbServerCertificates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbServerCertificatesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbServerCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeServerCertificatesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -82,7 +84,7 @@ func dataSourceAlibabacloudStackSlbServerCertificatesRead(d *schema.ResourceData
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	idsMap := make(map[string]string)
 	if v, ok := d.GetOk("ids"); ok {
@@ -95,7 +97,7 @@ func dataSourceAlibabacloudStackSlbServerCertificatesRead(d *schema.ResourceData
 		return slbClient.DescribeServerCertificates(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_server_certificates", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_server_certificates", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeServerCertificatesResponse)
@@ -122,7 +124,7 @@ func dataSourceAlibabacloudStackSlbServerCertificatesRead(d *schema.ResourceData
 		filteredTemp = response.ServerCertificates.ServerCertificate
 	}
 
-	return slbServerCertificatesDescriptionAttributes(d, filteredTemp, meta)
+	return DiagnosticsFromError(slbServerCertificatesDescriptionAttributes(d, filteredTemp, meta))
 }
 
 func slbServerCertificatesDescriptionAttributes(d *schema.ResourceData, certificates []slb.ServerCertificate, meta interface{}) error {
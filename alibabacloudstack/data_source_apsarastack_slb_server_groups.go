@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSlbServerGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbServerGroupsRead,
+		ReadContext: dataSourceAlibabacloudStackSlbServerGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -83,8 +85,8 @@ func dataSourceAlibabacloudStackSlbServerGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbServerGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbServerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateDescribeVServerGroupsRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -94,7 +96,7 @@ func dataSourceAlibabacloudStackSlbServerGroupsRead(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	idsMap := make(map[string]string)
@@ -108,7 +110,7 @@ func dataSourceAlibabacloudStackSlbServerGroupsRead(d *schema.ResourceData, meta
 		return slbClient.DescribeVServerGroups(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_server_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_server_groups", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.DescribeVServerGroupsResponse)
@@ -135,7 +137,7 @@ func dataSourceAlibabacloudStackSlbServerGroupsRead(d *schema.ResourceData, meta
 		filteredServerGroupsTemp = response.VServerGroups.VServerGroup
 	}
 
-	return slbServerGroupsDescriptionAttributes(d, filteredServerGroupsTemp, meta)
+	return DiagnosticsFromError(slbServerGroupsDescriptionAttributes(d, filteredServerGroupsTemp, meta))
 }
 
 func slbServerGroupsDescriptionAttributes(d *schema.ResourceData, serverGroups []slb.VServerGroup, meta interface{}) error {
@@ -158,7 +160,7 @@ func slbServerGroupsDescriptionAttributes(d *schema.ResourceData, serverGroups [
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.VServerGroupId = serverGroup.VServerGroupId
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeVServerGroupAttribute(request)
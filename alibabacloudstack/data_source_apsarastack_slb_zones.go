@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackSlbZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbZonesRead,
+		ReadContext: dataSourceAlibabacloudStackSlbZonesRead,
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -54,8 +56,8 @@ func dataSourceAlibabacloudStackSlbZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slaveZones := make(map[string][]string)
 	localName := make(map[string][]string)
 	request := slb.CreateDescribeZonesRequest()
@@ -66,13 +68,13 @@ func dataSourceAlibabacloudStackSlbZonesRead(d *schema.ResourceData, meta interf
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeZones(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_slb_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
@@ -118,10 +120,10 @@ func dataSourceAlibabacloudStackSlbZonesRead(d *schema.ResourceData, meta interf
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -14,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbsRead,
+		ReadContext: dataSourceAlibabacloudStackSlbsRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -133,8 +135,8 @@ func dataSourceAlibabacloudStackSlbs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSlbsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := &SlbService{client}
 
 	request := slb.CreateDescribeLoadBalancersRequest()
@@ -145,7 +147,7 @@ func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	//request.ResourceGroupId = d.Get("resource_group_id").(string)
 	if v, ok := d.GetOk("master_availability_zone"); ok && v.(string) != "" {
 		request.MasterZoneId = v.(string)
@@ -203,7 +205,7 @@ func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{
 		log.Printf("sss %s", raw)
 		err = json.Unmarshal(response.BaseResponse.GetHttpContentBytes(), response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		allLoadBalancers = append(allLoadBalancers, response.LoadBalancers.LoadBalancer...)
 
@@ -213,7 +215,7 @@ func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -242,7 +244,7 @@ func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{
 		filteredLoadBalancersTemp = allLoadBalancers
 	}
 
-	return slbsDescriptionAttributes(d, filteredLoadBalancersTemp, slbService)
+	return DiagnosticsFromError(slbsDescriptionAttributes(d, filteredLoadBalancersTemp, slbService))
 }
 
 func slbsDescriptionAttributes(d *schema.ResourceData, loadBalancers []slb.LoadBalancer, slbService *SlbService) error {
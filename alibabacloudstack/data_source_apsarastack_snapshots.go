@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackSnapshots() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSnapshotsRead,
+		ReadContext: dataSourceAlibabacloudStackSnapshotsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -137,12 +139,12 @@ func dataSourceAlibabacloudStackSnapshots() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ecs.CreateDescribeSnapshotsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -193,7 +195,7 @@ func dataSourceAlibabacloudStackSnapshotsRead(d *schema.ResourceData, meta inter
 			return ecsClient.DescribeSnapshots(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_snapshots", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_snapshots", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response := raw.(*ecs.DescribeSnapshotsResponse)
@@ -204,7 +206,7 @@ func dataSourceAlibabacloudStackSnapshotsRead(d *schema.ResourceData, meta inter
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -228,7 +230,7 @@ func dataSourceAlibabacloudStackSnapshotsRead(d *schema.ResourceData, meta inter
 		filteredSnapshots = allSnapshots
 	}
 
-	return snapshotsDescriptionAttributes(d, filteredSnapshots)
+	return DiagnosticsFromError(snapshotsDescriptionAttributes(d, filteredSnapshots))
 }
 
 func snapshotsDescriptionAttributes(d *schema.ResourceData, snapshots []ecs.Snapshot) error {
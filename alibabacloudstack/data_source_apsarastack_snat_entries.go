@@ -1,16 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
 func dataSourceAlibabacloudStackSnatEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSnatEntriesRead,
+		ReadContext: dataSourceAlibabacloudStackSnatEntriesRead,
 
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": {
@@ -65,8 +67,8 @@ func dataSourceAlibabacloudStackSnatEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackSnatEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeSnatTableEntriesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -76,7 +78,7 @@ func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta int
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -99,7 +101,7 @@ func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta int
 			raw = response
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_snat_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_snat_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeSnatTableEntriesResponse)
@@ -122,13 +124,13 @@ func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta int
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
 	}
 
-	return SnatEntriesDecriptionAttributes(d, allSnatEntries, meta)
+	return DiagnosticsFromError(SnatEntriesDecriptionAttributes(d, allSnatEntries, meta))
 }
 
 func SnatEntriesDecriptionAttributes(d *schema.ResourceData, entries []vpc.SnatTableEntry, meta interface{}) error {
@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackTsdbZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackTsdbZonesRead,
+		ReadContext: dataSourceAlibabacloudStackTsdbZonesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -48,8 +50,8 @@ func dataSourceAlibabacloudStackTsdbZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackTsdbZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackTsdbZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeZones"
 	request := make(map[string]interface{})
@@ -59,19 +61,19 @@ func dataSourceAlibabacloudStackTsdbZonesRead(d *schema.ResourceData, meta inter
 	var response map[string]interface{}
 	conn, err := client.NewHitsdbClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-01"), StringPointer("AK"), nil, request, &runtime)
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_tsdb_zones", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_tsdb_zones", action, AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(action, response, request)
 
 	resp, err := jsonpath.Get("$.ZoneList.ZoneModel", response)
 	if err != nil {
-		return WrapErrorf(err, FailedGetAttributeMsg, action, "$.ZoneList.ZoneModel", response)
+		return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.ZoneList.ZoneModel", response))
 	}
 	result, _ := resp.([]interface{})
 	for _, v := range result {
@@ -92,11 +94,11 @@ func dataSourceAlibabacloudStackTsdbZonesRead(d *schema.ResourceData, meta inter
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("zones", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
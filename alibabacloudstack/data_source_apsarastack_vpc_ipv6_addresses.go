@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackVpcIpv6Addresses() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcIpv6AddressesRead,
+		ReadContext: dataSourceAlibabacloudStackVpcIpv6AddressesRead,
 		Schema: map[string]*schema.Schema{
 			"associated_instance_id": {
 				Type:     schema.TypeString,
@@ -114,8 +116,8 @@ func dataSourceAlibabacloudStackVpcIpv6Addresses() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpcIpv6AddressesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackVpcIpv6AddressesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DescribeIpv6Addresses"
 	request := make(map[string]interface{})
@@ -137,13 +139,13 @@ func dataSourceAlibabacloudStackVpcIpv6AddressesRead(d *schema.ResourceData, met
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for {
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			request["Product"] = "Vpc"
 			request["OrganizationId"] = client.Department
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
@@ -158,11 +160,11 @@ func dataSourceAlibabacloudStackVpcIpv6AddressesRead(d *schema.ResourceData, met
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpc_ipv6_addresses", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpc_ipv6_addresses", action, AlibabacloudStackSdkGoERROR))
 		}
 		resp, err := jsonpath.Get("$.Ipv6Addresses.Ipv6Address", response)
 		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Ipv6Addresses.Ipv6Address", response)
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.Ipv6Addresses.Ipv6Address", response))
 		}
 		result, _ := resp.([]interface{})
 		for _, v := range result {
@@ -202,15 +204,15 @@ func dataSourceAlibabacloudStackVpcIpv6AddressesRead(d *schema.ResourceData, met
 
 	d.SetId(dataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("names", names); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("addresses", s); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		writeToFile(output.(string), s)
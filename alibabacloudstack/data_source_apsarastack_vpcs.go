@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +19,7 @@ import (
 
 func dataSourceAlibabacloudStackVpcs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcsRead,
+		ReadContext: dataSourceAlibabacloudStackVpcsRead,
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
@@ -180,8 +182,8 @@ func dataSourceAlibabacloudStackVpcs() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackVpcsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeVpcsRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -191,7 +193,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 
@@ -244,7 +246,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 			return err
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpcs", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpcs", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		response, _ := raw.(*vpc.DescribeVpcsResponse)
 		if len(response.Vpcs.Vpc) < 1 {
@@ -259,7 +261,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -313,13 +315,13 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.VRouterId = v.VRouterId
 		request.RegionId = string(client.Region)
 
 		var response *vpc.DescribeVRoutersResponse
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.DescribeVRouters(request)
 			})
@@ -336,7 +338,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpcs", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpcs", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		if len(response.VRouters.VRouter) > 0 {
@@ -348,7 +350,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 		filteredVpcs = append(filteredVpcs, v)
 	}
 
-	return vpcsDecriptionAttributes(d, filteredVpcs, route_tables, meta)
+	return DiagnosticsFromError(vpcsDecriptionAttributes(d, filteredVpcs, route_tables, meta))
 }
 func vpcVswitchIdListContains(vswitchIdList []string, vswitchId string) bool {
 	for _, idListItem := range vswitchIdList {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackVpnConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpnConnectionsRead,
+		ReadContext: dataSourceAlibabacloudStackVpnConnectionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -176,8 +178,8 @@ func dataSourceAlibabacloudStackVpnConnections() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackVpnConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeVpnConnectionsRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -200,7 +202,7 @@ func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta
 			return vpcClient.DescribeVpnConnections(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpn_connections", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpn_connections", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeVpnConnectionsResponse)
@@ -217,7 +219,7 @@ func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -237,7 +239,7 @@ func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta
 		if r, err := regexp.Compile(nameRegex.(string)); err == nil {
 			reg = r
 		} else {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -258,7 +260,7 @@ func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta
 		}
 	}
 
-	return vpnConnectionsDecriptionAttributes(d, filteredVpnConns, meta)
+	return DiagnosticsFromError(vpnConnectionsDecriptionAttributes(d, filteredVpnConns, meta))
 }
 
 func vpnConnectionsDecriptionAttributes(d *schema.ResourceData, vpnSetTypes []vpc.VpnConnection, meta interface{}) error {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackVpnCustomerGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpnCgwsRead,
+		ReadContext: dataSourceAlibabacloudStackVpnCgwsRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -76,8 +78,8 @@ func dataSourceAlibabacloudStackVpnCustomerGateways() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpnCgwsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackVpnCgwsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := vpc.CreateDescribeCustomerGatewaysRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
@@ -91,7 +93,7 @@ func dataSourceAlibabacloudStackVpnCgwsRead(d *schema.ResourceData, meta interfa
 			return vpcClient.DescribeCustomerGateways(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpn_customer_gateways", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpn_customer_gateways", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeCustomerGatewaysResponse)
@@ -104,7 +106,7 @@ func dataSourceAlibabacloudStackVpnCgwsRead(d *schema.ResourceData, meta interfa
 		}
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
@@ -125,7 +127,7 @@ func dataSourceAlibabacloudStackVpnCgwsRead(d *schema.ResourceData, meta interfa
 		if r, err := regexp.Compile(nameRegex.(string)); err == nil {
 			reg = r
 		} else {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -147,7 +149,7 @@ func dataSourceAlibabacloudStackVpnCgwsRead(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	return vpnCgwsDecriptionAttributes(d, filteredCgws, meta)
+	return DiagnosticsFromError(vpnCgwsDecriptionAttributes(d, filteredCgws, meta))
 }
 
 func vpnCgwsDecriptionAttributes(d *schema.ResourceData, vpnSetTypes []vpc.CustomerGateway, meta interface{}) error {
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackVpnGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpnsRead,
+		ReadContext: dataSourceAlibabacloudStackVpnsRead,
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -132,8 +134,8 @@ func dataSourceAlibabacloudStackVpnGateways() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpnsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackVpnsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeVpnGatewaysRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -161,7 +163,7 @@ func dataSourceAlibabacloudStackVpnsRead(d *schema.ResourceData, meta interface{
 			return vpcClient.DescribeVpnGateways(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpn_gateways", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpn_gateways", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeVpnGatewaysResponse)
@@ -177,7 +179,7 @@ func dataSourceAlibabacloudStackVpnsRead(d *schema.ResourceData, meta interface{
 		}
 
 		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.PageNumber = page
 		}
@@ -198,7 +200,7 @@ func dataSourceAlibabacloudStackVpnsRead(d *schema.ResourceData, meta interface{
 		if r, err := regexp.Compile(nameRegex.(string)); err == nil {
 			reg = r
 		} else {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -220,7 +222,7 @@ func dataSourceAlibabacloudStackVpnsRead(d *schema.ResourceData, meta interface{
 
 	}
 
-	return vpnsDecriptionAttributes(d, filteredVpns, meta)
+	return DiagnosticsFromError(vpnsDecriptionAttributes(d, filteredVpns, meta))
 }
 
 func convertStatus(lower string) string {
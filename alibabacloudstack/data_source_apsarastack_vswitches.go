@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -8,13 +9,14 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackVSwitches() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVSwitchesRead,
+		ReadContext: dataSourceAlibabacloudStackVSwitchesRead,
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
@@ -111,8 +113,8 @@ func dataSourceAlibabacloudStackVSwitches() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackVSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateDescribeVSwitchesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -123,7 +125,7 @@ func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta inter
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	// API DescribeVSwitches has some limitations
 	// If there is no vpc_id, setting PageSizeSmall can avoid ServiceUnavailable Error
 	request.PageSize = requests.NewInteger(PageSizeSmall)
@@ -161,7 +163,7 @@ func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta inter
 			})
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vswitches", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vswitches", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*vpc.DescribeVSwitchesResponse)
@@ -198,12 +200,12 @@ func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta inter
 
 		page, err := getNextpageNumber(request.PageNumber)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.PageNumber = page
 	}
 
-	return VSwitchesDecriptionAttributes(d, allVSwitches, meta)
+	return DiagnosticsFromError(VSwitchesDecriptionAttributes(d, allVSwitches, meta))
 }
 
 func VSwitchesDecriptionAttributes(d *schema.ResourceData, vsws []vpc.VSwitch, meta interface{}) error {
@@ -218,7 +220,7 @@ func VSwitchesDecriptionAttributes(d *schema.ResourceData, vsws []vpc.VSwitch, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	for _, vsw := range vsws {
 		mapping := map[string]interface{}{
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/hbase"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/denverdino/aliyungo/common"
 
@@ -32,7 +34,7 @@ import (
 
 func dataSourceAlibabacloudStackZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackZonesRead,
+		ReadContext: dataSourceAlibabacloudStackZonesRead,
 
 		Schema: map[string]*schema.Schema{
 			"available_instance_type": {
@@ -185,8 +187,8 @@ func dataSourceAlibabacloudStackZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func dataSourceAlibabacloudStackZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	resType := d.Get("available_resource_creation").(string)
@@ -210,14 +212,14 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		//if instanceChargeType == string(PostPaid) {
 		//	request.InstanceChargeType = string(Postpaid)
 		//} else {
 		//	request.InstanceChargeType = string(Prepaid)
 		//}
 		var response = &rds.DescribeRegionsResponse{}
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.DescribeRegions(request)
 			})
@@ -233,10 +235,10 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		if len(response.Regions.RDSRegion) <= 0 {
-			return WrapError(fmt.Errorf("[ERROR] There is no available zone for RDS."))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available zone for RDS.")))
 		}
 		for _, r := range response.Regions.RDSRegion {
 			if multi && strings.Contains(r.ZoneId, MULTI_IZ_SYMBOL) && r.RegionId == string(client.Region) {
@@ -253,12 +255,12 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			return polarDBClient.DescribeRegions(request)
 		})
 		if err != nil {
-			return WrapError(fmt.Errorf("[ERROR] DescribeRegions got an error: %#v", err))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] DescribeRegions got an error: %#v", err)))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		regions, _ := raw.(*polardb.DescribeRegionsResponse)
 		if len(regions.Regions.Region) <= 0 {
-			return WrapError(fmt.Errorf("[ERROR] There is no available region for PolarDB."))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for PolarDB.")))
 		}
 		for _, r := range regions.Regions.Region {
 			for _, zone := range r.Zones.Zone {
@@ -274,17 +276,17 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 		request := r_kvstore.CreateDescribeRegionsRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeRegions(request)
 		})
 		if err != nil {
-			return WrapError(fmt.Errorf("[ERROR] DescribeAvailableResource got an error: %#v", err))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] DescribeAvailableResource got an error: %#v", err)))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		regions, _ := raw.(*r_kvstore.DescribeRegionsResponse)
 		if len(regions.RegionIds.KVStoreRegion) <= 0 {
-			return WrapError(fmt.Errorf("[ERROR] There is no available region for KVStore"))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for KVStore")))
 		}
 		for _, r := range regions.RegionIds.KVStoreRegion {
 			if len(regions.RegionIds.KVStoreRegion) > 0 {
@@ -306,17 +308,17 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 	if strings.ToLower(Trim(resType)) == strings.ToLower(string(ResourceTypeMongoDB)) {
 		request := dds.CreateDescribeRegionsRequest()
 		request.RegionId = client.RegionId
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
 			return ddsClient.DescribeRegions(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		regions, _ := raw.(*dds.DescribeRegionsResponse)
 		if len(regions.Regions.DdsRegion) <= 0 {
-			return WrapError(fmt.Errorf("[ERROR] There is no available region for MongoDB."))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for MongoDB.")))
 		}
 		for _, r := range regions.Regions.DdsRegion {
 			for _, zonid := range r.Zones.Zone {
@@ -335,12 +337,12 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			return hbaseClient.DescribeRegions(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		regions, _ := raw.(*hbase.DescribeRegionsResponse)
 		if len(regions.Regions.Region) <= 0 {
-			return WrapError(fmt.Errorf("[ERROR] There is no available region for HBase."))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for HBase.")))
 		}
 		for _, r := range regions.Regions.Region {
 			for _, zonid := range r.Zones.Zone {
@@ -361,12 +363,12 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			return adbClient.DescribeRegions(request)
 		})
 		if err != nil {
-			return WrapError(fmt.Errorf("[ERROR] DescribeRegions got an error: %#v", err))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] DescribeRegions got an error: %#v", err)))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		regions, _ := raw.(*adb.DescribeRegionsResponse)
 		if len(regions.Regions.Region) <= 0 {
-			return WrapError(fmt.Errorf("[ERROR] There is no available region for adb."))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for adb.")))
 		}
 		for _, r := range regions.Regions.Region {
 			for _, zone := range r.Zones.Zone {
@@ -381,17 +383,17 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 	if strings.ToLower(Trim(resType)) == strings.ToLower(string(ResourceTypeGpdb)) {
 		request := gpdb.CreateDescribeRegionsRequest()
 		request.RegionId = client.RegionId
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 			return gpdbClient.DescribeRegions(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*gpdb.DescribeRegionsResponse)
 		if len(response.Regions.Region) <= 0 {
-			return WrapError(fmt.Errorf("[ERROR] There is no available region for gpdb."))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] There is no available region for gpdb.")))
 		}
 		for _, r := range response.Regions.Region {
 			for _, zoneId := range r.Zones.Zone {
@@ -412,7 +414,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.GetActionName(), request)
 		zones, _ := raw.(*elasticsearch.GetRegionConfigurationResponse)
@@ -428,7 +430,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 
 	if len(zoneIds) > 0 {
 		sort.Strings(zoneIds)
-		return zoneIdsDescriptionAttributes(d, zoneIds)
+		return DiagnosticsFromError(zoneIdsDescriptionAttributes(d, zoneIds))
 	}
 
 	// Retrieving available zones for VPC-FC
@@ -439,13 +441,13 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			return fcClient.GetAccountSettings(fc.NewGetAccountSettingsInput())
 		})
 		if err != nil {
-			return fmt.Errorf("[API ERROR] FC GetAccountSettings: %#v", err)
+			return DiagnosticsFromError(fmt.Errorf("[API ERROR] FC GetAccountSettings: %#v", err))
 		}
 		addDebug("GetAccountSettings", raw, clientInfo)
 		out, _ := raw.(*fc.GetAccountSettingsOutput)
 		if out != nil && len(out.AvailableAZs) > 0 {
 			sort.Strings(out.AvailableAZs)
-			return zoneIdsDescriptionAttributes(d, out.AvailableAZs)
+			return DiagnosticsFromError(zoneIdsDescriptionAttributes(d, out.AvailableAZs))
 		}
 	}
 
@@ -460,12 +462,12 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeZones(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_zones", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*slb.DescribeZonesResponse)
@@ -481,7 +483,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 
 	_, validZones, _, err := ecsService.DescribeAvailableResources(d, meta, ZoneResource)
 	if err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	req := ecs.CreateDescribeZonesRequest()
@@ -491,7 +493,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.RegionId = client.RegionId
 	req.InstanceChargeType = instanceChargeType
 	if v, ok := d.GetOk("spot_strategy"); ok && v.(string) != "" {
@@ -502,12 +504,12 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 		return ecsClient.DescribeZones(req)
 	})
 	if err != nil {
-		return fmt.Errorf("DescribeZones got an error: %#v", err)
+		return DiagnosticsFromError(fmt.Errorf("DescribeZones got an error: %#v", err))
 	}
 	addDebug(req.GetActionName(), raw, req.RpcRequest, req)
 	resp, _ := raw.(*ecs.DescribeZonesResponse)
 	if resp == nil || len(resp.Zones.Zone) < 1 {
-		return fmt.Errorf("There are no availability zones in the region: %#v.", client.Region)
+		return DiagnosticsFromError(fmt.Errorf("There are no availability zones in the region: %#v.", client.Region))
 	}
 
 	mapZones := make(map[string]ecs.Zone)
@@ -603,11 +605,11 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 	d.SetId(dataResourceIdHash(zoneIds))
 
 	if err := d.Set("zones", s); err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	if err := d.Set("ids", zoneIds); err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	// create a json file in current directory and write data source to it.
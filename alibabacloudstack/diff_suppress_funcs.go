@@ -414,3 +414,11 @@ func rdsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	}
 	return true
 }
+
+func ramPolicyDocumentDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	equal, err := compareJsonTemplateAreEquivalent(old, new)
+	return err == nil && equal
+}
@@ -12,12 +12,15 @@ import (
 
 	"log"
 	"runtime"
+	"strconv"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	//"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/fc-go-sdk"
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 const (
@@ -48,6 +51,8 @@ var SnapshotInvalidOperations = []string{"OperationConflict", "ServiceUnavailabl
 var SnapshotPolicyInvalidOperations = []string{"OperationConflict", "ServiceUnavailable", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
 var DiskNotSupportOnlineChangeErrors = []string{"InvalidDiskCategory.NotSupported", "InvalidRegion.NotSupport", "IncorrectInstanceStatus", "IncorrectDiskStatus", "InvalidOperation.InstanceTypeNotSupport"}
 var DBReadInstanceNotReadyStatus = []string{"OperationDenied.ReadDBInstanceStatus", "OperationDenied.MasterDBInstanceState", "ReadDBInstance.Mismatch"}
+var RdsUnsupportedErrors = []string{"InvalidAction.NotFound", "UnsupportedOperation", "OperationDenied.DBInstanceType", "IncorrectDBInstanceType", "IncorrectEngine"}
+var OssUnsupportedErrors = []string{"InvalidAction.NotFound", "NotImplemented", "UnsupportedOperation"}
 
 // An Error represents a custom error for Terraform failure response
 type ProviderError struct {
@@ -252,18 +257,18 @@ func IsThrottling(err error) bool {
 		return false
 	}
 
+	throttlingRegex := regexp.MustCompile("^Throttling.*")
+
+	if e, ok := err.(*tea.SDKError); ok {
+		return *e.Code == "Rejected.Throttling" || throttlingRegex.MatchString(*e.Code)
+	}
+
 	if e, ok := err.(*errors.ServerError); ok {
-		if e.ErrorCode() == Throttling {
-			return true
-		}
-		return false
+		return e.ErrorCode() == "Rejected.Throttling" || throttlingRegex.MatchString(e.ErrorCode())
 	}
 
 	if e, ok := err.(*common.Error); ok {
-		if e.Code == Throttling {
-			return true
-		}
-		return false
+		return e.Code == "Rejected.Throttling" || throttlingRegex.MatchString(e.Code)
 	}
 	return false
 }
@@ -370,6 +375,79 @@ func WrapComplexError(cause, err error, filepath string, fileline int) error {
 	}
 }
 
+// AttributeError is an error caused by the value of a resource attribute. Its diagnostic points at
+// the attribute, e.g. "vswitch_id" or "rules.0.port".
+type AttributeError struct {
+	Attribute string
+	Err       error
+}
+
+func (e *AttributeError) Error() string {
+	return e.Err.Error()
+}
+
+// Return an AttributeError reporting cause on the given attribute
+func WrapAttributeError(cause error, attribute string) error {
+	if cause == nil {
+		return nil
+	}
+	return &AttributeError{Attribute: attribute, Err: cause}
+}
+
+// DiagnosticsFromError converts the error returned to a CRUD function into its diagnostics. The error
+// is reported on the attribute of the AttributeError it wraps, if any.
+func DiagnosticsFromError(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+	}
+	if e := findAttributeError(err); e != nil {
+		diagnostic.AttributePath = attributePath(e.Attribute)
+	}
+	return diag.Diagnostics{diagnostic}
+}
+
+// AttributeWarning returns a warning diagnostic about the given attribute.
+func AttributeWarning(attribute, msg string, args ...interface{}) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       fmt.Sprintf(msg, args...),
+		AttributePath: attributePath(attribute),
+	}
+}
+
+func findAttributeError(err error) *AttributeError {
+	for err != nil {
+		switch e := err.(type) {
+		case *AttributeError:
+			return e
+		case *ComplexError:
+			err = e.Cause
+		case ComplexError:
+			err = e.Cause
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// attributePath converts a flatmap attribute address, e.g. "rules.0.port", into its cty path.
+func attributePath(attribute string) cty.Path {
+	var path cty.Path
+	for _, step := range strings.Split(attribute, ".") {
+		if index, err := strconv.Atoi(step); err == nil && len(path) > 0 {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return path
+}
+
 // A default message of ComplexError's Err. It is format to Resource <resource-id> <operation> Failed!!! <error source>
 const DefaultErrorMsg = "Resource %s %s Failed!!! %s"
 const VPCErrorMsg = "Resource %s %s Failed!!! %s"
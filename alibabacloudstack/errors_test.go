@@ -0,0 +1,33 @@
+package alibabacloudstack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestDiagnosticsFromError(t *testing.T) {
+	if diags := DiagnosticsFromError(nil); diags != nil {
+		t.Errorf("DiagnosticsFromError(nil): expected no diagnostics, got %#v", diags)
+	}
+
+	err := WrapErrorf(WrapAttributeError(Error("invalid port"), "rules.0.port"), DefaultErrorMsg, "sg-123", "Create", ProviderERROR)
+	diags := DiagnosticsFromError(err)
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("DiagnosticsFromError: expected one error, got %#v", diags)
+	}
+	expected := cty.GetAttrPath("rules").IndexInt(0).GetAttr("port")
+	if !diags[0].AttributePath.Equals(expected) {
+		t.Errorf("DiagnosticsFromError: expected the path %#v, got %#v", expected, diags[0].AttributePath)
+	}
+	if !strings.Contains(diags[0].Summary, "invalid port") {
+		t.Errorf("DiagnosticsFromError: the summary %q misses the cause", diags[0].Summary)
+	}
+
+	warning := AttributeWarning("payment_type", "cannot destroy %s", "dts-123")
+	if warning.Severity != diag.Warning || !warning.AttributePath.Equals(cty.GetAttrPath("payment_type")) {
+		t.Errorf("AttributeWarning: unexpected diagnostic %#v", warning)
+	}
+}
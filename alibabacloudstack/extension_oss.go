@@ -1,6 +1,9 @@
 package alibabacloudstack
 
 import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
@@ -13,6 +16,44 @@ const (
 	ExpirationStatusDisabled = LifecycleRuleStatus("Disabled")
 )
 
+type ossReplicationConfiguration struct {
+	XMLName xml.Name           `xml:"ReplicationConfiguration"`
+	Rule    ossReplicationRule `xml:"Rule"`
+}
+
+type ossReplicationRule struct {
+	ID                          string                    `xml:"ID,omitempty"`
+	PrefixSet                   *ossReplicationPrefixSet  `xml:"PrefixSet,omitempty"`
+	Action                      string                    `xml:"Action,omitempty"`
+	Destination                 ossReplicationDestination `xml:"Destination"`
+	HistoricalObjectReplication string                    `xml:"HistoricalObjectReplication,omitempty"`
+}
+
+type ossReplicationPrefixSet struct {
+	Prefix []string `xml:"Prefix"`
+}
+
+type ossReplicationDestination struct {
+	Bucket       string `xml:"Bucket"`
+	Location     string `xml:"Location"`
+	TransferType string `xml:"TransferType,omitempty"`
+}
+
+type ossReplicationRules struct {
+	XMLName xml.Name `xml:"ReplicationRules"`
+	ID      []string `xml:"ID"`
+}
+
+type ossInitiateWormConfiguration struct {
+	XMLName               xml.Name `xml:"InitiateWormConfiguration"`
+	RetentionPeriodInDays int      `xml:"RetentionPeriodInDays"`
+}
+
+type ossExtendWormConfiguration struct {
+	XMLName               xml.Name `xml:"ExtendWormConfiguration"`
+	RetentionPeriodInDays int      `xml:"RetentionPeriodInDays"`
+}
+
 func ossNotFoundError(err error) bool {
 	if e, ok := err.(oss.ServiceError); ok &&
 		(e.StatusCode == 404 || strings.HasPrefix(e.Code, "NoSuch") || strings.HasPrefix(e.Message, "No Row found")) {
@@ -20,3 +61,31 @@ func ossNotFoundError(err error) bool {
 	}
 	return false
 }
+
+// ossResponseElements normalizes an element of an OneRouter OSS response, which is returned as an
+// object when there is only one of it and as a list otherwise. It started as ossLifecycleElements in
+// the bucket lifecycle code and is shared with the tags, replication and WORM responses.
+func ossResponseElements(v interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	switch e := v.(type) {
+	case map[string]interface{}:
+		result = append(result, e)
+	case []interface{}:
+		for _, item := range e {
+			if m, ok := item.(map[string]interface{}); ok {
+				result = append(result, m)
+			}
+		}
+	}
+	return result
+}
+
+// ossIntValue converts a number of an OneRouter OSS response, which may be returned as a string.
+// It was ossLifecycleIntValue before it was shared with the replication and WORM responses.
+func ossIntValue(v interface{}) int {
+	if v == nil {
+		return 0
+	}
+	i, _ := strconv.Atoi(fmt.Sprint(v))
+	return i
+}
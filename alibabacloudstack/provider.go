@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -20,13 +21,14 @@ import (
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/helper/hashcode"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -223,12 +225,50 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_DBS_ENDPOINT", nil),
 				Description: descriptions["dbs_endpoint"],
 			},
+			"telemetry_log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_TELEMETRY_LOG_LEVEL", connectivity.DefaultTelemetryLogLevel),
+				Description:  descriptions["telemetry_log_level"],
+				ValidateFunc: validation.StringInSlice([]string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR"}, true),
+			},
+			"telemetry_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_TELEMETRY_FILE", nil),
+				Description: descriptions["telemetry_file"],
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_MAX_RETRIES", connectivity.DefaultMaxRetries),
+				Description:  descriptions["max_retries"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_MAX_BACKOFF", int(connectivity.DefaultMaxBackoff/time.Second)),
+				Description:  descriptions["max_backoff"],
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retryable_error_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["retryable_error_codes"],
+			},
+			"rate_limits":        rateLimitsSchema(),
+			"endpoint_discovery": endpointDiscoverySchema(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"alibabacloudstack_account":                                dataSourceAlibabacloudStackAccount(),
 			"alibabacloudstack_adb_clusters":                           dataSourceAlibabacloudStackAdbDbClusters(),
 			"alibabacloudstack_adb_zones":                              dataSourceAlibabacloudStackAdbZones(),
 			"alibabacloudstack_adb_db_clusters":                        dataSourceAlibabacloudStackAdbDbClusters(),
+			"alibabacloudstack_alikafka_consumer_groups":               dataSourceAlibabacloudStackAlikafkaConsumerGroups(),
+			"alibabacloudstack_alikafka_instances":                     dataSourceAlibabacloudStackAlikafkaInstances(),
+			"alibabacloudstack_alikafka_topics":                        dataSourceAlibabacloudStackAlikafkaTopics(),
 			"alibabacloudstack_api_gateway_apis":                       dataSourceAlibabacloudStackApiGatewayApis(),
 			"alibabacloudstack_api_gateway_apps":                       dataSourceAlibabacloudStackApiGatewayApps(),
 			"alibabacloudstack_api_gateway_groups":                     dataSourceAlibabacloudStackApiGatewayGroups(),
@@ -251,6 +291,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ascm_roles":                             dataSourceAlibabacloudStackAscmRoles(),
 			"alibabacloudstack_ascm_ram_policies":                      dataSourceAlibabacloudStackAscmRamPolicies(),
 			"alibabacloudstack_ascm_ram_policies_for_user":             dataSourceAlibabacloudStackAscmRamPoliciesForUser(),
+			"alibabacloudstack_cen_bandwidth_packages":                 dataSourceAlibabacloudStackCenBandwidthPackages(),
+			"alibabacloudstack_cen_instance_attachments":               dataSourceAlibabacloudStackCenInstanceAttachments(),
+			"alibabacloudstack_cen_instances":                          dataSourceAlibabacloudStackCenInstances(),
+			"alibabacloudstack_cen_route_entries":                      dataSourceAlibabacloudStackCenRouteEntries(),
 			"alibabacloudstack_common_bandwidth_packages":              dataSourceAlibabacloudStackCommonBandwidthPackages(),
 			"alibabacloudstack_cr_ee_instances":                        dataSourceAlibabacloudStackCrEEInstances(),
 			"alibabacloudstack_cr_ee_namespaces":                       dataSourceAlibabacloudStackCrEENamespaces(),
@@ -266,6 +310,7 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_cms_metric_metalist":                    dataSourceAlibabacloudstackCmsMetricMetalist(),
 			"alibabacloudstack_cms_alarms":                             dataSourceAlibabacloudstackCmsAlarms(),
 			"alibabacloudstack_datahub_service":                        dataSourceAlibabacloudStackDatahubService(),
+			"alibabacloudstack_db_audit_logs":                          dataSourceAlibabacloudStackDBAuditLogs(),
 			"alibabacloudstack_db_instances":                           dataSourceAlibabacloudStackDBInstances(),
 			"alibabacloudstack_db_zones":                               dataSourceAlibabacloudStackDBZones(),
 			"alibabacloudstack_disks":                                  dataSourceAlibabacloudStackDisks(),
@@ -275,7 +320,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_drds_instances":                         dataSourceAlibabacloudStackDRDSInstances(),
 			"alibabacloudstack_dms_enterprise_instances":               dataSourceAlibabacloudStackDmsEnterpriseInstances(),
 			"alibabacloudstack_dms_enterprise_users":                   dataSourceAlibabacloudStackDmsEnterpriseUsers(),
+			"alibabacloudstack_dts_jobs":                               dataSourceAlibabacloudStackDtsJobs(),
 			"alibabacloudstack_ecs_commands":                           dataSourceAlibabacloudStackEcsCommands(),
+			"alibabacloudstack_ecs_invocations":                        dataSourceAlibabacloudStackEcsInvocations(),
 			"alibabacloudstack_ecs_deployment_sets":                    dataSourceAlibabacloudStackEcsDeploymentSets(),
 			"alibabacloudstack_ecs_hpc_clusters":                       dataSourceAlibabacloudStackEcsHpcClusters(),
 			"alibabacloudstack_ecs_dedicated_hosts":                    dataSourceAlibabacloudStackEcsDedicatedHosts(),
@@ -300,7 +347,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_key_pairs":                              dataSourceAlibabacloudStackKeyPairs(),
 			"alibabacloudstack_kms_aliases":                            dataSourceAlibabacloudStackKmsAliases(),
 			"alibabacloudstack_kms_ciphertext":                         dataSourceAlibabacloudStackKmsCiphertext(),
+			"alibabacloudstack_kms_key_versions":                       dataSourceAlibabacloudStackKmsKeyVersions(),
 			"alibabacloudstack_kms_keys":                               dataSourceAlibabacloudStackKmsKeys(),
+			"alibabacloudstack_kms_plaintext":                          dataSourceAlibabacloudStackKmsPlaintext(),
+			"alibabacloudstack_kms_public_key":                         dataSourceAlibabacloudStackKmsPublicKey(),
 			"alibabacloudstack_kms_secrets":                            dataSourceAlibabacloudStackKmsSecrets(),
 			"alibabacloudstack_kvstore_instances":                      dataSourceAlibabacloudStackKVStoreInstances(),
 			"alibabacloudstack_kvstore_zones":                          dataSourceAlibabacloudStackKVStoreZones(),
@@ -333,6 +383,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_quick_bi_users":                         dataSourceAlibabacloudStackQuickBiUsers(),
 			"alibabacloudstack_router_interfaces":                      dataSourceAlibabacloudStackRouterInterfaces(),
 			"alibabacloudstack_ram_service_role_products":              dataSourceAlibabacloudstackRamServiceRoleProducts(),
+			"alibabacloudstack_ram_users":                              dataSourceAlibabacloudStackRamUsers(),
+			"alibabacloudstack_ram_groups":                             dataSourceAlibabacloudStackRamGroups(),
+			"alibabacloudstack_ram_policies":                           dataSourceAlibabacloudStackRamPolicies(),
 			"alibabacloudstack_route_tables":                           dataSourceAlibabacloudStackRouteTables(),
 			"alibabacloudstack_route_entries":                          dataSourceAlibabacloudStackRouteEntries(),
 			"alibabacloudstack_ros_stacks":                             dataSourceAlibabacloudStackRosStacks(),
@@ -381,6 +434,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_adb_cluster":                           resourceAlibabacloudStackAdbDbCluster(),
 			"alibabacloudstack_adb_connection":                        resourceAlibabacloudStackAdbConnection(),
 			"alibabacloudstack_adb_db_cluster":                        resourceAlibabacloudStackAdbDbCluster(),
+			"alibabacloudstack_alikafka_consumer_group":               resourceAlibabacloudStackAlikafkaConsumerGroup(),
+			"alibabacloudstack_alikafka_instance":                     resourceAlibabacloudStackAlikafkaInstance(),
 			"alibabacloudstack_alikafka_sasl_acl":                     resourceAlibabacloudStackAlikafkaSaslAcl(),
 			"alibabacloudstack_alikafka_sasl_user":                    resourceAlibabacloudStackAlikafkaSaslUser(),
 			"alibabacloudstack_alikafka_topic":                        resourceAlibabacloudStackAlikafkaTopic(),
@@ -405,6 +460,11 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ascm_user_group_role_binding":          resourceAlibabacloudStackAscmUserGroupRoleBinding(),
 			"alibabacloudstack_ascm_user_role_binding":                resourceAlibabacloudStackAscmUserRoleBinding(),
 			"alibabacloudstack_ascm_usergroup_user":                   resourceAlibabacloudStackAscmUserGroupUser(),
+			"alibabacloudstack_cen_bandwidth_package":                 resourceAlibabacloudStackCenBandwidthPackage(),
+			"alibabacloudstack_cen_instance":                          resourceAlibabacloudStackCenInstance(),
+			"alibabacloudstack_cen_instance_attachment":               resourceAlibabacloudStackCenInstanceAttachment(),
+			"alibabacloudstack_cen_instance_grant":                    resourceAlibabacloudStackCenInstanceGrant(),
+			"alibabacloudstack_cen_route_entry":                       resourceAlibabacloudStackCenRouteEntry(),
 			"alibabacloudstack_cms_alarm":                             resourceAlibabacloudStackCmsAlarm(),
 			"alibabacloudstack_cms_alarm_contact":                     resourceAlibabacloudstackCmsAlarmContact(),
 			"alibabacloudstack_cms_alarm_contact_group":               resourceAlibabacloudstackCmsAlarmContactGroup(),
@@ -439,10 +499,12 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_dns_group":                             resourceAlibabacloudStackDnsGroup(),
 			"alibabacloudstack_dns_record":                            resourceAlibabacloudStackDnsRecord(),
 			"alibabacloudstack_drds_instance":                         resourceAlibabacloudStackDRDSInstance(),
+			"alibabacloudstack_dts_job_monitor_rule":                  resourceAlibabacloudStackDtsJobMonitorRule(),
 			"alibabacloudstack_dts_subscription_job":                  resourceAlibabacloudStackDtsSubscriptionJob(),
 			"alibabacloudstack_dts_synchronization_instance":          resourceAlibabacloudStackDtsSynchronizationInstance(),
 			"alibabacloudstack_dts_synchronization_job":               resourceAlibabacloudStackDtsSynchronizationJob(),
 			"alibabacloudstack_ecs_command":                           resourceAlibabacloudStackEcsCommand(),
+			"alibabacloudstack_ecs_invocation":                        resourceAlibabacloudStackEcsInvocation(),
 			"alibabacloudstack_ecs_dedicated_host":                    resourceAlibabacloudStackEcsDedicatedHost(),
 			"alibabacloudstack_ecs_deployment_set":                    resourceAlibabacloudStackEcsDeploymentSet(),
 			"alibabacloudstack_ecs_hpc_cluster":                       resourceAlibabacloudStackEcsHpcCluster(),
@@ -460,12 +522,15 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_eip_association":                       resourceAlibabacloudStackEipAssociation(),
 			"alibabacloudstack_ess_alarm":                             resourceAlibabacloudStackEssAlarm(),
 			"alibabacloudstack_ess_attachment":                        resourceAlibabacloudstackEssAttachment(),
+			"alibabacloudstack_ess_instance_protection":               resourceAlibabacloudStackEssInstanceProtection(),
+			"alibabacloudstack_ess_instance_standby":                  resourceAlibabacloudStackEssInstanceStandby(),
 			"alibabacloudstack_ess_lifecycle_hook":                    resourceAlibabacloudStackEssLifecycleHook(),
 			"alibabacloudstack_ess_notification":                      resourceAlibabacloudStackEssNotification(),
 			"alibabacloudstack_ess_scaling_group":                     resourceAlibabacloudStackEssScalingGroup(),
 			"alibabacloudstack_ess_scaling_rule":                      resourceAlibabacloudStackEssScalingRule(),
 			"alibabacloudstack_ess_scalinggroup_vserver_groups":       resourceAlibabacloudStackEssScalingGroupVserverGroups(),
 			"alibabacloudstack_ess_scheduled_task":                    resourceAlibabacloudStackEssScheduledTask(),
+			"alibabacloudstack_ess_suspend_process":                   resourceAlibabacloudStackEssSuspendProcess(),
 			"alibabacloudstack_forward_entry":                         resourceAlibabacloudStackForwardEntry(),
 			"alibabacloudstack_gpdb_account":                          resourceAlibabacloudStackGpdbAccount(),
 			"alibabacloudstack_gpdb_connection":                       resourceAlibabacloudStackGpdbConnection(),
@@ -482,12 +547,16 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_kms_alias":                             resourceAlibabacloudStackKmsAlias(),
 			"alibabacloudstack_kms_ciphertext":                        resourceAlibabacloudStackKmsCiphertext(),
 			"alibabacloudstack_kms_key":                               resourceAlibabacloudStackKmsKey(),
+			"alibabacloudstack_kms_key_version":                       resourceAlibabacloudStackKmsKeyVersion(),
 			"alibabacloudstack_kms_secret":                            resourceAlibabacloudStackKmsSecret(),
 			"alibabacloudstack_kvstore_account":                       resourceAlibabacloudStackKVstoreAccount(),
 			"alibabacloudstack_kvstore_backup_policy":                 resourceAlibabacloudStackKVStoreBackupPolicy(),
 			"alibabacloudstack_kvstore_connection":                    resourceAlibabacloudStackKvstoreConnection(),
 			"alibabacloudstack_kvstore_instance":                      resourceAlibabacloudStackKVStoreInstance(),
 			"alibabacloudstack_launch_template":                       resourceAlibabacloudStackLaunchTemplate(),
+			"alibabacloudstack_log_alert":                             resourceAlibabacloudStackLogAlert(),
+			"alibabacloudstack_log_audit":                             resourceAlibabacloudStackLogAudit(),
+			"alibabacloudstack_log_dashboard":                         resourceAlibabacloudStackLogDashboard(),
 			"alibabacloudstack_log_machine_group":                     resourceAlibabacloudStackLogMachineGroup(),
 			"alibabacloudstack_log_project":                           resourceAlibabacloudStackLogProject(),
 			"alibabacloudstack_log_store":                             resourceAlibabacloudStackLogStore(),
@@ -516,6 +585,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_oss_bucket_quota":                      resourceAlibabacloudStackOssBucketQuota(),
 			"alibabacloudstack_oss_bucket_kms":                        resourceAlibabacloudStackOssBucketKms(),
 			"alibabacloudstack_oss_bucket_object":                     resourceAlibabacloudStackOssBucketObject(),
+			"alibabacloudstack_oss_bucket_objects_sync":               resourceAlibabacloudStackOssBucketObjectsSync(),
+			"alibabacloudstack_oss_bucket_replication":                resourceAlibabacloudStackOssBucketReplication(),
+			"alibabacloudstack_oss_bucket_worm":                       resourceAlibabacloudStackOssBucketWorm(),
 			"alibabacloudstack_ots_instance":                          resourceAlibabacloudStackOtsInstance(),
 			"alibabacloudstack_ots_instance_attachment":               resourceAlibabacloudStackOtsInstanceAttachment(),
 			"alibabacloudstack_ots_table":                             resourceAlibabacloudStackOtsTable(),
@@ -523,8 +595,19 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_quick_bi_user_group":                   resourceAlibabacloudStackQuickBiUserGroup(),
 			"alibabacloudstack_quick_bi_workspace":                    resourceAlibabacloudStackQuickBiWorkspace(),
 			"alibabacloudstack_ram_role_attachment":                   resourceAlibabacloudStackRamRoleAttachment(),
+			"alibabacloudstack_ram_user":                              resourceAlibabacloudStackRamUser(),
+			"alibabacloudstack_ram_group":                             resourceAlibabacloudStackRamGroup(),
+			"alibabacloudstack_ram_group_membership":                  resourceAlibabacloudStackRamGroupMembership(),
+			"alibabacloudstack_ram_login_profile":                     resourceAlibabacloudStackRamLoginProfile(),
+			"alibabacloudstack_ram_access_key":                        resourceAlibabacloudStackRamAccessKey(),
+			"alibabacloudstack_ram_policy":                            resourceAlibabacloudStackRamPolicy(),
+			"alibabacloudstack_ram_user_policy_attachment":            resourceAlibabacloudStackRamUserPolicyAttachment(),
+			"alibabacloudstack_ram_group_policy_attachment":           resourceAlibabacloudStackRamGroupPolicyAttachment(),
+			"alibabacloudstack_ram_role_policy_attachment":            resourceAlibabacloudStackRamRolePolicyAttachment(),
 			"alibabacloudstack_reserved_instance":                     resourceAlibabacloudStackReservedInstance(),
+			"alibabacloudstack_ros_change_set":                        resourceAlibabacloudStackRosChangeSet(),
 			"alibabacloudstack_ros_stack":                             resourceAlibabacloudStackRosStack(),
+			"alibabacloudstack_ros_stack_group":                       resourceAlibabacloudStackRosStackGroup(),
 			"alibabacloudstack_ros_template":                          resourceAlibabacloudStackRosTemplate(),
 			"alibabacloudstack_route_entry":                           resourceAlibabacloudStackRouteEntry(),
 			"alibabacloudstack_route_table":                           resourceAlibabacloudStackRouteTable(),
@@ -554,6 +637,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_vpn_customer_gateway":                  resourceAlibabacloudStackVpnCustomerGateway(),
 			"alibabacloudstack_vpn_gateway":                           resourceAlibabacloudStackVpnGateway(),
 			"alibabacloudstack_vpn_route_entry":                       resourceAlibabacloudStackVpnRouteEntry(),
+			"alibabacloudstack_ssl_vpn_server":                        resourceAlibabacloudStackSslVpnServer(),
+			"alibabacloudstack_ssl_vpn_client_cert":                   resourceAlibabacloudStackSslVpnClientCert(),
 			"alibabacloudstack_vswitch":                               resourceAlibabacloudStackSwitch(),
 			"alibabacloudstack_data_works_folder":                     resourceAlibabacloudStackDataWorksFolder(),
 			"alibabacloudstack_data_works_connection":                 resourceAlibabacloudStackDataWorksConnection(),
@@ -577,12 +662,41 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_csb_project":                           resourceAlibabacloudStackCsbProject(),
 			"alibabacloudstack_graph_database_db_instance":            resourceAlibabacloudStackGraphDatabaseDbInstance(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigureContext,
+	}
+	registerTelemetryResources(provider)
+	return provider
+}
+
+// registerTelemetryResources maps the CRUD functions of every resource and data source to their
+// address, so the API call telemetry can report which resource made a call.
+func registerTelemetryResources(provider *schema.Provider) {
+	for name, r := range provider.ResourcesMap {
+		connectivity.RegisterTelemetryResource(r.CreateContext, name+".Create")
+		connectivity.RegisterTelemetryResource(r.ReadContext, name+".Read")
+		connectivity.RegisterTelemetryResource(r.UpdateContext, name+".Update")
+		connectivity.RegisterTelemetryResource(r.DeleteContext, name+".Delete")
+	}
+	for name, r := range provider.DataSourcesMap {
+		connectivity.RegisterTelemetryResource(r.ReadContext, "data."+name+".Read")
 	}
 }
 
 var providerConfig map[string]interface{}
 
+// providerConfigureContext configures the client and binds it to the provider stop context, which
+// cancels the in-flight requests when Terraform is interrupted.
+func providerConfigureContext(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	client, err := providerConfigure(d)
+	if err != nil {
+		return nil, DiagnosticsFromError(err)
+	}
+	if stopCtx, ok := schema.StopContext(ctx); ok {
+		client.(*connectivity.AlibabacloudStackClient).SetStopContext(stopCtx)
+	}
+	return client, nil
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	var getProviderConfig = func(str string, key string) string {
 		if str == "" {
@@ -621,6 +735,24 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		ResourceSetName:      d.Get("resource_group_set_name").(string),
 		SourceIp:             strings.TrimSpace(d.Get("source_ip").(string)),
 		SecureTransport:      strings.TrimSpace(d.Get("secure_transport").(string)),
+		TelemetryLogLevel:    d.Get("telemetry_log_level").(string),
+		TelemetryFile:        strings.TrimSpace(d.Get("telemetry_file").(string)),
+		MaxRetries:           d.Get("max_retries").(int),
+		MaxBackoff:           d.Get("max_backoff").(int),
+		Endpoints:            make(map[string]interface{}),
+	}
+	retryableErrorCodes, err := connectivity.ParseRetryableErrorCodes(expandStringList(d.Get("retryable_error_codes").([]interface{})))
+	if err != nil {
+		return nil, err
+	}
+	config.RetryableErrorCodes = retryableErrorCodes
+	config.RateLimits = make(map[string]connectivity.RateLimit)
+	for _, v := range d.Get("rate_limits").(*schema.Set).List() {
+		rateLimit := v.(map[string]interface{})
+		config.RateLimits[strings.ToLower(strings.TrimSpace(rateLimit["product"].(string)))] = connectivity.RateLimit{
+			RequestsPerSecond: rateLimit["requests_per_second"].(float64),
+			Burst:             rateLimit["burst"].(int),
+		}
 	}
 	if v, ok := d.GetOk("security_transport"); config.SecureTransport == "" && ok && v.(string) != "" {
 		config.SecureTransport = v.(string)
@@ -716,71 +848,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.CloudfwEndpoint = domain
 		config.CsbEndpoint = domain
 		config.GdbEndpoint = domain
-	} else {
-
-		endpointsSet := d.Get("endpoints").(*schema.Set)
-
-		for _, endpointsSetI := range endpointsSet.List() {
-			endpoints := endpointsSetI.(map[string]interface{})
-			config.EcsEndpoint = strings.TrimSpace(endpoints["ecs"].(string))
-			config.VpcEndpoint = strings.TrimSpace(endpoints["vpc"].(string))
-			config.AscmEndpoint = strings.TrimSpace(endpoints["ascm"].(string))
-			config.RdsEndpoint = strings.TrimSpace(endpoints["rds"].(string))
-			config.OssEndpoint = strings.TrimSpace(endpoints["oss"].(string))
-			config.OnsEndpoint = strings.TrimSpace(endpoints["ons"].(string))
-			config.KmsEndpoint = strings.TrimSpace(endpoints["kms"].(string))
-			config.LogEndpoint = strings.TrimSpace(endpoints["log"].(string))
-			config.SlbEndpoint = strings.TrimSpace(endpoints["slb"].(string))
-			config.CrEndpoint = strings.TrimSpace(endpoints["cr"].(string))
-			config.EssEndpoint = strings.TrimSpace(endpoints["ess"].(string))
-			config.DnsEndpoint = strings.TrimSpace(endpoints["dns"].(string))
-			config.KVStoreEndpoint = strings.TrimSpace(endpoints["kvstore"].(string))
-			config.GpdbEndpoint = strings.TrimSpace(endpoints["gpdb"].(string))
-			config.DdsEndpoint = strings.TrimSpace(endpoints["dds"].(string))
-			config.CsEndpoint = strings.TrimSpace(endpoints["cs"].(string))
-			config.CmsEndpoint = strings.TrimSpace(endpoints["cms"].(string))
-			config.OtsEndpoint = strings.TrimSpace(endpoints["ots"].(string))
-			config.DatahubEndpoint = strings.TrimSpace(endpoints["datahub"].(string))
-			config.AdbEndpoint = strings.TrimSpace(endpoints["adb"].(string))
-			config.StsEndpoint = strings.TrimSpace(endpoints["sts"].(string))
-			config.RosEndpoint = strings.TrimSpace(endpoints["ros"].(string))
-			config.DtsEndpoint = strings.TrimSpace(endpoints["dts"].(string))
-			config.AlikafkaEndpoint = strings.TrimSpace(endpoints["alikafka"].(string))
-			config.NasEndpoint = strings.TrimSpace(endpoints["nas"].(string))
-			config.ApigatewayEndpoint = strings.TrimSpace(endpoints["apigateway"].(string))
-			config.DmsEnterpriseEndpoint = strings.TrimSpace(endpoints["dms_enterprise"].(string))
-			config.HBaseEndpoint = strings.TrimSpace(endpoints["hbase"].(string))
-			config.DrdsEndpoint = strings.TrimSpace(endpoints["drds"].(string))
-			config.QuickbiEndpoint = strings.TrimSpace(endpoints["quickbi"].(string))
-			config.CsbEndpoint = strings.TrimSpace(endpoints["csb"].(string))
-			config.GdbEndpoint = strings.TrimSpace(endpoints["gdb"].(string))
-			config.DataworkspublicEndpoint = strings.TrimSpace(endpoints["dataworkspublic"].(string))
-			config.DbsEndpoint = strings.TrimSpace(endpoints["dbs"].(string))
-			config.OosEndpoint = strings.TrimSpace(endpoints["oos"].(string))
-			config.ArmsEndpoint = strings.TrimSpace(endpoints["arms"].(string))
-			config.CloudfwEndpoint = strings.TrimSpace(endpoints["cloudfw"].(string))
-		}
-	}
-	DbsEndpoint := d.Get("dbs_endpoint").(string)
-	if DbsEndpoint != "" {
-		config.DbsEndpoint = DbsEndpoint
-	}
-	DataworkspublicEndpoint := d.Get("dataworkspublic").(string)
-	if DataworkspublicEndpoint != "" {
-		config.DataworkspublicEndpoint = DataworkspublicEndpoint
-	}
-	QuickbiEndpoint := d.Get("quickbi_endpoint").(string)
-	if QuickbiEndpoint != "" {
-		config.QuickbiEndpoint = QuickbiEndpoint
-	}
-	kafkaOpenApidomain := d.Get("kafkaopenapi_domain").(string)
-	if kafkaOpenApidomain != "" {
-		config.AlikafkaOpenAPIEndpoint = kafkaOpenApidomain
-	}
-	StsEndpoint := d.Get("sts_endpoint").(string)
-	if StsEndpoint != "" {
-		config.StsEndpoint = StsEndpoint
+		config.CenEndpoint = domain
+		config.Domain = domain
+		config.LocationEndpoint = domain
 	}
+	setEndpointOverrides(d, config)
 	organizationAccessKey := d.Get("organization_accesskey").(string)
 	if organizationAccessKey != "" {
 		config.OrganizationAccessKey = organizationAccessKey
@@ -789,10 +861,6 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if organizationSecretKey != "" {
 		config.OrganizationSecretKey = organizationSecretKey
 	}
-	slsOpenAPIEndpoint := d.Get("sls_openapi_endpoint").(string)
-	if slsOpenAPIEndpoint != "" {
-		config.SLSOpenAPIEndpoint = slsOpenAPIEndpoint
-	}
 	if strings.ToLower(config.Protocol) == "https" {
 		config.Protocol = "HTTPS"
 	} else {
@@ -816,6 +884,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	if v, ok := d.GetOk("endpoint_discovery"); ok && len(v.(*schema.Set).List()) == 1 {
+		discovery := v.(*schema.Set).List()[0].(map[string]interface{})
+		config.EndpointDiscovery = &connectivity.EndpointDiscovery{
+			CacheDir: strings.TrimSpace(discovery["cache_dir"].(string)),
+			CacheTTL: time.Duration(discovery["cache_ttl"].(int)) * time.Second,
+		}
+	}
+
 	if ots_instance_name, ok := d.GetOk("ots_instance_name"); ok && ots_instance_name.(string) != "" {
 		config.OtsInstanceName = strings.TrimSpace(ots_instance_name.(string))
 	}
@@ -836,9 +912,98 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
+	if config.EndpointDiscovery != nil {
+		if err := client.DiscoverEndpoints(); err != nil {
+			return nil, err
+		}
+		// The endpoints set explicitly win over the discovered ones
+		setEndpointOverrides(d, config)
+	}
+
 	return client, nil
 }
 
+// setEndpointOverrides sets the endpoints set in the endpoints block and by the top level endpoint
+// arguments, which win over the domain and the discovered endpoints.
+func setEndpointOverrides(d *schema.ResourceData, config *connectivity.Config) {
+	for _, v := range d.Get("endpoints").(*schema.Set).List() {
+		endpoints := v.(map[string]interface{})
+		override := func(field *string, key string) {
+			if endpoint, ok := endpoints[key].(string); ok && strings.TrimSpace(endpoint) != "" {
+				*field = strings.TrimSpace(endpoint)
+			}
+		}
+		override(&config.EcsEndpoint, "ecs")
+		override(&config.VpcEndpoint, "vpc")
+		override(&config.AscmEndpoint, "ascm")
+		override(&config.RdsEndpoint, "rds")
+		override(&config.OssEndpoint, "oss")
+		override(&config.OnsEndpoint, "ons")
+		override(&config.KmsEndpoint, "kms")
+		override(&config.LogEndpoint, "log")
+		override(&config.SlbEndpoint, "slb")
+		override(&config.CrEndpoint, "cr")
+		override(&config.EssEndpoint, "ess")
+		override(&config.DnsEndpoint, "dns")
+		override(&config.KVStoreEndpoint, "kvstore")
+		override(&config.GpdbEndpoint, "gpdb")
+		override(&config.DdsEndpoint, "dds")
+		override(&config.CsEndpoint, "cs")
+		override(&config.CmsEndpoint, "cms")
+		override(&config.OtsEndpoint, "ots")
+		override(&config.DatahubEndpoint, "datahub")
+		override(&config.AdbEndpoint, "adb")
+		override(&config.StsEndpoint, "sts")
+		override(&config.RosEndpoint, "ros")
+		override(&config.DtsEndpoint, "dts")
+		override(&config.AlikafkaEndpoint, "alikafka")
+		override(&config.NasEndpoint, "nas")
+		override(&config.ApigatewayEndpoint, "apigateway")
+		override(&config.DmsEnterpriseEndpoint, "dms_enterprise")
+		override(&config.HBaseEndpoint, "hbase")
+		override(&config.DrdsEndpoint, "drds")
+		override(&config.QuickbiEndpoint, "quickbi")
+		override(&config.CsbEndpoint, "csb")
+		override(&config.GdbEndpoint, "gdb")
+		override(&config.DataworkspublicEndpoint, "dataworkspublic")
+		override(&config.DbsEndpoint, "dbs_endpoint")
+		override(&config.OosEndpoint, "oos")
+		override(&config.ArmsEndpoint, "arms")
+		override(&config.CloudfwEndpoint, "cloudfw")
+		override(&config.MaxComputeEndpoint, "maxcompute")
+		override(&config.ElasticsearchEndpoint, "elasticsearch")
+		override(&config.CenEndpoint, "cen")
+		override(&config.RamEndpoint, "ram")
+		override(&config.PolarDBEndpoint, "polardb")
+		override(&config.FcEndpoint, "fc")
+		override(&config.LocationEndpoint, "location")
+	}
+	DbsEndpoint := d.Get("dbs_endpoint").(string)
+	if DbsEndpoint != "" {
+		config.DbsEndpoint = DbsEndpoint
+	}
+	DataworkspublicEndpoint := d.Get("dataworkspublic").(string)
+	if DataworkspublicEndpoint != "" {
+		config.DataworkspublicEndpoint = DataworkspublicEndpoint
+	}
+	QuickbiEndpoint := d.Get("quickbi_endpoint").(string)
+	if QuickbiEndpoint != "" {
+		config.QuickbiEndpoint = QuickbiEndpoint
+	}
+	kafkaOpenApidomain := d.Get("kafkaopenapi_domain").(string)
+	if kafkaOpenApidomain != "" {
+		config.AlikafkaOpenAPIEndpoint = kafkaOpenApidomain
+	}
+	StsEndpoint := d.Get("sts_endpoint").(string)
+	if StsEndpoint != "" {
+		config.StsEndpoint = StsEndpoint
+	}
+	slsOpenAPIEndpoint := d.Get("sls_openapi_endpoint").(string)
+	if slsOpenAPIEndpoint != "" {
+		config.SLSOpenAPIEndpoint = slsOpenAPIEndpoint
+	}
+}
+
 var descriptions map[string]string
 
 func init() {
@@ -854,6 +1019,26 @@ func init() {
 		"proxy": "Use this to set proxy connection",
 
 		"domain": "Use this to override the default domain. It's typically used to connect to custom domain.",
+
+		"telemetry_log_level": "The Terraform log level at which a structured record of every API call is written. Defaults to DEBUG.",
+
+		"telemetry_file": "The path of a file to which the structured API call records are appended as JSON lines instead of the Terraform log.",
+
+		"max_retries": "The maximum number of times an API call failing with a retryable error is retried. Defaults to 5.",
+
+		"max_backoff": "The maximum time, in seconds, to wait between two retries of an API call. The wait grows exponentially with jitter up to this value. Defaults to 30.",
+
+		"retryable_error_codes": "The error codes retried in addition to the throttling and service unavailable ones, formatted as `<product>:<code>`, e.g. `ecs:IncorrectInstanceStatus`, or `<code>` for every product. A code ending with `.*` matches every code with that prefix.",
+
+		"rate_limits_product": "The lower case code of the product to rate limit, e.g. `ecs`, or `*` for every product without a limit of its own.",
+
+		"rate_limits_requests_per_second": "The maximum number of requests per second sent to the product, shared by all the resources of a run.",
+
+		"rate_limits_burst": "The number of requests which can be sent at once before the rate limit applies. Defaults to one second of requests.",
+
+		"endpoint_discovery_cache_dir": "The directory where the endpoints discovered from the location service are cached. Defaults to `~/.terraform.d/alibabacloudstack`.",
+
+		"endpoint_discovery_cache_ttl": "The time, in seconds, the discovered endpoints are cached before the location service is queried again. Defaults to 86400.",
 	}
 }
 func endpointsSchema() *schema.Schema {
@@ -1138,6 +1323,54 @@ func endpointsSchema() *schema.Schema {
 					Default:     "",
 					Description: descriptions["quickbi_endpoint"],
 				},
+				"ros": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["ros_endpoint"],
+				},
+				"dts": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["dts_endpoint"],
+				},
+				"hbase": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["hbase_endpoint"],
+				},
+				"csb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["csb_endpoint"],
+				},
+				"gdb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["gdb_endpoint"],
+				},
+				"oos": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["oos_endpoint"],
+				},
+				"arms": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["arms_endpoint"],
+				},
+				"cloudfw": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["cloudfw_endpoint"],
+				},
 				"dataworkspublic": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -1202,6 +1435,14 @@ func endpointsToHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["maxcompute"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["dms_enterprise"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["quickbi"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["ros"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["dts"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["hbase"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["csb"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["gdb"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["oos"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["arms"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["cloudfw"].(string)))
 
 	return hashcode.String(buf.String())
 }
@@ -1310,6 +1551,60 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func rateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"product": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  descriptions["rate_limits_product"],
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					Description:  descriptions["rate_limits_requests_per_second"],
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["rate_limits_burst"],
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func endpointDiscoverySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cache_dir": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["endpoint_discovery_cache_dir"],
+					DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_ENDPOINT_CACHE_DIR", ""),
+				},
+				"cache_ttl": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(connectivity.DefaultEndpointCacheTTL.Seconds()),
+					Description:  descriptions["endpoint_discovery_cache_ttl"],
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
 func getAssumeRoleAK(config *connectivity.Config) (string, string, string, error) {
 	request := sts.CreateAssumeRoleRequest()
 	request.RoleArn = config.RamRoleArn
@@ -1403,7 +1698,6 @@ func getResourceCredentials(config *connectivity.Config) (string, string, error)
 	}
 	request.ApiName = "ListResourceGroup"
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":   config.SecretKey,
 		"Product":           "ascm",
 		"Department":        config.Department,
 		"ResourceGroup":     config.ResourceGroup,
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackAdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbAccountCreate,
-		Read:   resourceAlibabacloudStackAdbAccountRead,
-		Update: resourceAlibabacloudStackAdbAccountUpdate,
-		Delete: resourceAlibabacloudStackAdbAccountDelete,
+		CreateContext: resourceAlibabacloudStackAdbAccountCreate,
+		ReadContext:   resourceAlibabacloudStackAdbAccountRead,
+		UpdateContext: resourceAlibabacloudStackAdbAccountUpdate,
+		DeleteContext: resourceAlibabacloudStackAdbAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"db_cluster_id": {
 				Type:     schema.TypeString,
@@ -74,8 +82,8 @@ func resourceAlibabacloudStackAdbAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	request := adb.CreateCreateAccountRequest()
 	request.RegionId = client.RegionId
@@ -86,7 +94,7 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 	kmsPassword := d.Get("kms_encrypted_password").(string)
 
 	if password == "" && kmsPassword == "" {
-		return WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set."))
+		return DiagnosticsFromError(WrapAttributeError(WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set.")), "password"))
 	}
 
 	if password != "" {
@@ -95,7 +103,7 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 		kmsService := KmsService{client}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.AccountPassword = decryptResp.Plaintext
 	}
@@ -106,7 +114,7 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 	}
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationid"] = client.Department
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.CreateAccount(request)
 		})
@@ -122,20 +130,20 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_adb_account", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_adb_account", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBClusterId, COLON_SEPARATED, request.AccountName))
 
-	if err := adbService.WaitForAdbAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := adbService.WaitForAdbAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackAdbAccountRead(d, meta)
+	return resourceAlibabacloudStackAdbAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	object, err := adbService.DescribeAdbAccount(d.Id())
 	if err != nil {
@@ -143,12 +151,12 @@ func resourceAlibabacloudStackAdbAccountRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("db_cluster_id", parts[0])
@@ -159,8 +167,8 @@ func resourceAlibabacloudStackAdbAccountRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	d.Partial(true)
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
@@ -189,8 +197,8 @@ func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta inte
 	}*/
 
 	if d.HasChange("account_password") || d.HasChange("kms_encrypted_password") {
-		if err := adbService.WaitForAdbAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := adbService.WaitForAdbAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := adb.CreateResetAccountPasswordRequest()
 		request.RegionId = client.RegionId
@@ -202,16 +210,16 @@ func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta inte
 		password := d.Get("account_password").(string)
 		kmsPassword := d.Get("kms_encrypted_password").(string)
 		if password == "" && kmsPassword == "" {
-			return WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set."))
+			return DiagnosticsFromError(WrapAttributeError(WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set.")), "password"))
 		}
 
 		if password != "" {
 			request.AccountPassword = password
 		} else {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)}
 			decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request.AccountPassword = decryptResp.Plaintext
 		}
@@ -220,22 +228,22 @@ func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta inte
 			return adbClient.ResetAccountPassword(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("account_password")
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackAdbAccountRead(d, meta)
+	return resourceAlibabacloudStackAdbAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := adb.CreateDeleteAccountRequest()
 	request.RegionId = client.RegionId
@@ -251,9 +259,9 @@ func resourceAlibabacloudStackAdbAccountDelete(d *schema.ResourceData, meta inte
 		if IsExpectedErrors(err, []string{"InvalidAccountName.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return adbService.WaitForAdbAccount(d.Id(), Deleted, DefaultTimeoutMedium)
+	return DiagnosticsFromError(adbService.WaitForAdbAccount(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
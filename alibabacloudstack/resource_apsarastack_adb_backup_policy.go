@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -18,14 +20,20 @@ import (
 
 func resourceAlibabacloudStackAdbBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbBackupPolicyCreate,
-		Read:   resourceAlibabacloudStackAdbBackupPolicyRead,
-		Update: resourceAlibabacloudStackAdbBackupPolicyUpdate,
-		Delete: resourceAlibabacloudStackAdbBackupPolicyDelete,
+		CreateContext: resourceAlibabacloudStackAdbBackupPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackAdbBackupPolicyRead,
+		UpdateContext: resourceAlibabacloudStackAdbBackupPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackAdbBackupPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"db_cluster_id": {
 				Type:     schema.TypeString,
@@ -54,15 +62,15 @@ func resourceAlibabacloudStackAdbBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId(d.Get("db_cluster_id").(string))
 
-	return resourceAlibabacloudStackAdbBackupPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAdbBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	object, err := adbService.DescribeAdbBackupPolicy(d.Id())
 	if err != nil {
@@ -70,7 +78,7 @@ func resourceAlibabacloudStackAdbBackupPolicyRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("db_cluster_id", d.Id())
@@ -81,9 +89,9 @@ func resourceAlibabacloudStackAdbBackupPolicyRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 
 	if d.HasChange("preferred_backup_period") || d.HasChange("preferred_backup_time") {
@@ -92,10 +100,10 @@ func resourceAlibabacloudStackAdbBackupPolicyUpdate(d *schema.ResourceData, meta
 		preferredBackupTime := d.Get("preferred_backup_time").(string)
 
 		// wait instance running before modifying
-		if err := adbService.WaitForCluster(d.Id(), Running, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := adbService.WaitForCluster(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
-		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := adbService.ModifyAdbBackupPolicy(d.Id(), preferredBackupTime, preferredBackupPeriod); err != nil {
 				if IsExpectedErrors(err, OperationDeniedDBStatus) {
 					return resource.RetryableError(err)
@@ -104,16 +112,16 @@ func resourceAlibabacloudStackAdbBackupPolicyUpdate(d *schema.ResourceData, meta
 			}
 			return nil
 		}); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
-	return resourceAlibabacloudStackAdbBackupPolicyRead(d, meta)
+	return resourceAlibabacloudStackAdbBackupPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// In case of a delete we are resetting to default values which is Tuesday,Friday each 1am-2am
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := adb.CreateModifyBackupPolicyRequest()
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationId"] = client.Department
@@ -127,7 +135,7 @@ func resourceAlibabacloudStackAdbBackupPolicyDelete(d *schema.ResourceData, meta
 		return adbClient.ModifyBackupPolicy(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	return nil
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 
@@ -17,13 +19,18 @@ import (
 
 func resourceAlibabacloudStackAdbConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbConnectionCreate,
-		Read:   resourceAlibabacloudStackAdbConnectionRead,
-		Delete: resourceAlibabacloudStackAdbConnectionDelete,
+		CreateContext: resourceAlibabacloudStackAdbConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackAdbConnectionRead,
+		DeleteContext: resourceAlibabacloudStackAdbConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"db_cluster_id": {
 				Type:     schema.TypeString,
@@ -53,8 +60,8 @@ func resourceAlibabacloudStackAdbConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	dbClusterId := d.Get("db_cluster_id").(string)
 	prefix := d.Get("connection_prefix").(string)
@@ -70,7 +77,7 @@ func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta i
 	request.Headers["x-acs-organizationid"] = client.Department
 	var raw interface{}
 	var err error
-	err = resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err = client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.AllocateClusterPublicConnection(request)
 		})
@@ -86,24 +93,24 @@ func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_adb_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_adb_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", dbClusterId, COLON_SEPARATED, request.ConnectionStringPrefix))
 
-	if err := adbService.WaitForAdbConnection(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := adbService.WaitForAdbConnection(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// wait instance running after allocating
-	if err := adbService.WaitForCluster(dbClusterId, Running, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := adbService.WaitForCluster(dbClusterId, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackAdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackAdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	object, err := adbService.DescribeAdbConnection(d.Id())
 
@@ -112,11 +119,11 @@ func resourceAlibabacloudStackAdbConnectionRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("db_cluster_id", parts[0])
 	d.Set("connection_prefix", parts[1])
@@ -127,8 +134,8 @@ func resourceAlibabacloudStackAdbConnectionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAdbConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 
 	split := strings.Split(d.Id(), COLON_SEPARATED)
@@ -138,7 +145,7 @@ func resourceAlibabacloudStackAdbConnectionDelete(d *schema.ResourceData, meta i
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationid"] = client.Department
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var raw interface{}
 		raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.ReleaseClusterPublicConnection(request)
@@ -158,8 +165,8 @@ func resourceAlibabacloudStackAdbConnectionDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"InvalidDBClusterId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return adbService.WaitForAdbConnection(d.Id(), Deleted, DefaultTimeoutMedium)
+	return DiagnosticsFromError(adbService.WaitForAdbConnection(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
@@ -19,10 +21,10 @@ import (
 
 func resourceAlibabacloudStackAdbDbCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbDbClusterCreate,
-		Read:   resourceAlibabacloudStackAdbDbClusterRead,
-		Update: resourceAlibabacloudStackAdbDbClusterUpdate,
-		Delete: resourceAlibabacloudStackAdbDbClusterDelete,
+		CreateContext: resourceAlibabacloudStackAdbDbClusterCreate,
+		ReadContext:   resourceAlibabacloudStackAdbDbClusterRead,
+		UpdateContext: resourceAlibabacloudStackAdbDbClusterUpdate,
+		DeleteContext: resourceAlibabacloudStackAdbDbClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -221,8 +223,8 @@ func resourceAlibabacloudStackAdbDbCluster() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbDbClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbDbClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	var response *adb.CreateDBClusterResponse
 
@@ -319,7 +321,7 @@ func resourceAlibabacloudStackAdbDbClusterCreate(d *schema.ResourceData, meta in
 		var vsw, err = vpcService.DescribeVSwitch(vswitchId)
 		fmt.Sprint(vsw)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.DBClusterNetworkType = "VPC"
 		request.VPCId = vsw.VpcId
@@ -334,26 +336,26 @@ func resourceAlibabacloudStackAdbDbClusterCreate(d *schema.ResourceData, meta in
 	request.ClientToken = buildClientToken("CreateDBCluster")
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationId"] = client.Department
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "adb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "adb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 		return adbClient.CreateDBCluster(request)
 	})
 	if err != nil {
-		return WrapError(fmt.Errorf("[ERROR] CreateDBCluster got an error: %#v", err))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("[ERROR] CreateDBCluster got an error: %#v", err)))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ = raw.(*adb.CreateDBClusterResponse)
 
 	d.SetId(fmt.Sprint(response.DBClusterId))
 	stateConf := BuildStateConf([]string{"Preparing", "Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 900*time.Second, adbService.AdbDbClusterStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackAdbDbClusterUpdate(d, meta)
+	return resourceAlibabacloudStackAdbDbClusterUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackAdbDbClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbDbClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	object, err := adbService.DescribeAdbDbCluster(d.Id())
 	info, err := adbService.DescribeAdbClusterNetInfo2(d.Id())
@@ -364,7 +366,7 @@ func resourceAlibabacloudStackAdbDbClusterRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_inner_connection", info.ConnectionString)
@@ -393,7 +395,7 @@ func resourceAlibabacloudStackAdbDbClusterRead(d *schema.ResourceData, meta inte
 	if object["PayType"].(string) == string(Prepaid) {
 		describeAutoRenewAttributeObject, err := adbService.DescribeAutoRenewAttribute(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		renewPeriod := 1
 		if describeAutoRenewAttributeObject != nil {
@@ -413,19 +415,19 @@ func resourceAlibabacloudStackAdbDbClusterRead(d *schema.ResourceData, meta inte
 
 	describeDBClusterAccessWhiteListObject, err := adbService.DescribeDBClusterAccessWhiteList(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("security_ips", strings.Split(describeDBClusterAccessWhiteListObject["SecurityIPList"].(string), ","))
 
 	describeDBClustersObject, err := adbService.DescribeDBClusters(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("db_cluster_version", describeDBClustersObject["DBVersion"])
 	return nil
 }
-func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbDbClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	adbService := AdbService{client}
 	var response map[string]interface{}
 	d.Partial(true)
@@ -445,10 +447,10 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		action := "ModifyDBClusterDescription"
 		conn, err := client.NewAdsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			request["Product"] = "adb"
 			request["OrganizationId"] = client.Department
 			request["Department"] = client.Department
@@ -465,7 +467,7 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("description")
 	}
@@ -483,7 +485,7 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -514,7 +516,7 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -564,7 +566,7 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -601,7 +603,7 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		action := "ModifyDBClusterAccessWhiteList"
 		conn, err := client.NewAdsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if modifyDBClusterAccessWhiteListReq["SecurityIps"].(string) == "" {
 			modifyDBClusterAccessWhiteListReq["SecurityIps"] = LOCAL_HOST_IP
@@ -609,7 +611,7 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, modifyDBClusterAccessWhiteListReq, &runtime)
 		addDebug(action, response, modifyDBClusterAccessWhiteListReq)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("security_ips")
 	}
@@ -657,18 +659,18 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		action := "ModifyDBCluster"
 		conn, err := client.NewAdsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		modifyDBClusterReq["Product"] = "adb"
 		modifyDBClusterReq["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, modifyDBClusterReq, &runtime)
 		addDebug(action, response, modifyDBClusterReq)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{"ClassChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 900*time.Second, adbService.AdbDbClusterStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("compute_resource")
 		//d.SetPartial("db_cluster_category")
@@ -678,16 +680,16 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		//d.SetPartial("elastic_io_resource")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackAdbDbClusterRead(d, meta)
+	return resourceAlibabacloudStackAdbDbClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackAdbDbClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAdbDbClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//adbService := AdbService{client}
 	action := "DeleteDBCluster"
 	var response map[string]interface{}
 	conn, err := client.NewAdsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"DBClusterId": d.Id(),
@@ -699,7 +701,7 @@ func resourceAlibabacloudStackAdbDbClusterDelete(d *schema.ResourceData, meta in
 	runtime.SetIgnoreSSL(client.Config.Insecure)
 	//var taskId string
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -716,7 +718,7 @@ func resourceAlibabacloudStackAdbDbClusterDelete(d *schema.ResourceData, meta in
 		if IsExpectedErrors(err, []string{"InvalidDBCluster.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	//stateConf := BuildStateConf([]string{"Waiting", "Running", "Failed", "Retry", "Pause", "Stop"}, []string{"Finished", "Closed", "Cancel"}, d.Timeout(schema.TimeoutDelete), 10*time.Minute, adbService.AdbTaskStateRefreshFunc(d.Id(), taskId))
 	//if _, err = stateConf.WaitForState(); err != nil {
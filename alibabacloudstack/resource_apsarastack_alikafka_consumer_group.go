@@ -0,0 +1,168 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackAlikafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackAlikafkaConsumerGroupCreate,
+		ReadContext:   resourceAlibabacloudStackAlikafkaConsumerGroupRead,
+		UpdateContext: resourceAlibabacloudStackAlikafkaConsumerGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackAlikafkaConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	instanceId := d.Get("instance_id").(string)
+	consumerId := d.Get("consumer_id").(string)
+
+	request := alikafka.CreateCreateConsumerGroupRequest()
+	request.RegionId = client.RegionId
+	request.InstanceId = instanceId
+	request.ConsumerId = consumerId
+	if v, ok := d.GetOk("description"); ok {
+		request.Remark = v.(string)
+	}
+	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	client.InitRpcRequest(request.RpcRequest)
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.CreateConsumerGroup(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_consumer_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	d.SetId(instanceId + ":" + consumerId)
+	if err := alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	return resourceAlibabacloudStackAlikafkaConsumerGroupUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+	d.Partial(true)
+	if err := alikafkaService.setInstanceTags(d, TagResourceConsumerGroup); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.Partial(false)
+	return resourceAlibabacloudStackAlikafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	object, err := alikafkaService.DescribeAlikafkaConsumerGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("instance_id", object.InstanceId)
+	d.Set("consumer_id", object.ConsumerId)
+	d.Set("description", object.Remark)
+
+	tags := make(map[string]string)
+	for _, tag := range object.Tags.TagVO {
+		tags[tag.Key] = tag.Value
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	request := alikafka.CreateDeleteConsumerGroupRequest()
+	request.RegionId = client.RegionId
+	request.InstanceId = parts[0]
+	request.ConsumerId = parts[1]
+	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	client.InitRpcRequest(request.RpcRequest)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.DeleteConsumerGroup(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	return DiagnosticsFromError(WrapError(alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
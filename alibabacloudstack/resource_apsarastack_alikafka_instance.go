@@ -0,0 +1,358 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackAlikafkaInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackAlikafkaInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackAlikafkaInstanceRead,
+		UpdateContext: resourceAlibabacloudStackAlikafkaInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackAlikafkaInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"topic_quota": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"disk_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"deploy_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 5}),
+			},
+			"io_max": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"eip_max": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"spec_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "normal",
+				ValidateFunc: validation.StringInSlice([]string{"normal", "professional"}, false),
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_point": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+	vpcService := VpcService{client}
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vswitch, err := vpcService.DescribeVSwitch(vswitchId)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	// Create the order of the instance first.
+	createOrderReq := alikafka.CreateCreatePostPayOrderRequest()
+	createOrderReq.RegionId = client.RegionId
+	createOrderReq.TopicQuota = requests.NewInteger(d.Get("topic_quota").(int))
+	createOrderReq.DiskType = fmt.Sprint(d.Get("disk_type"))
+	createOrderReq.DiskSize = requests.NewInteger(d.Get("disk_size").(int))
+	createOrderReq.DeployType = requests.NewInteger(d.Get("deploy_type").(int))
+	createOrderReq.IoMax = requests.NewInteger(d.Get("io_max").(int))
+	createOrderReq.SpecType = d.Get("spec_type").(string)
+	if v, ok := d.GetOk("eip_max"); ok {
+		createOrderReq.EipMax = requests.NewInteger(v.(int))
+	}
+	createOrderReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	client.InitRpcRequest(createOrderReq.RpcRequest)
+
+	var raw interface{}
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err = client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.CreatePostPayOrder(createOrderReq)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(createOrderReq.GetActionName(), raw, createOrderReq.RpcRequest, createOrderReq)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_instance", createOrderReq.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	createOrderResp, _ := raw.(*alikafka.CreatePostPayOrderResponse)
+	if !createOrderResp.Success {
+		return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", createOrderReq.GetActionName(), createOrderResp)))
+	}
+
+	alikafkaInstance, err := alikafkaService.DescribeAlikafkaInstanceByOrderId(createOrderResp.OrderId, 60)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.SetId(alikafkaInstance.InstanceId)
+
+	// Then start the instance in the vswitch.
+	startInstanceReq := alikafka.CreateStartInstanceRequest()
+	startInstanceReq.RegionId = client.RegionId
+	startInstanceReq.InstanceId = d.Id()
+	startInstanceReq.VpcId = vswitch.VpcId
+	startInstanceReq.VSwitchId = vswitchId
+	startInstanceReq.ZoneId = vswitch.ZoneId
+	if v, ok := d.GetOk("name"); ok {
+		startInstanceReq.Name = v.(string)
+	}
+	if v, ok := d.GetOk("security_group"); ok {
+		startInstanceReq.SecurityGroup = v.(string)
+	}
+	startInstanceReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	client.InitRpcRequest(startInstanceReq.RpcRequest)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.StartInstance(startInstanceReq)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(startInstanceReq.GetActionName(), raw, startInstanceReq.RpcRequest, startInstanceReq)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), startInstanceReq.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	// Wait until the instance is in service.
+	if err := alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	return resourceAlibabacloudStackAlikafkaInstanceUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	object, err := alikafkaService.DescribeAlikafkaInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("name", object.Name)
+	d.Set("topic_quota", object.TopicNumLimit)
+	d.Set("disk_type", object.DiskType)
+	d.Set("disk_size", object.DiskSize)
+	d.Set("deploy_type", object.DeployType)
+	d.Set("io_max", object.IoMax)
+	d.Set("eip_max", object.EipMax)
+	d.Set("spec_type", object.SpecType)
+	d.Set("vswitch_id", object.VSwitchId)
+	d.Set("vpc_id", object.VpcId)
+	d.Set("zone_id", object.ZoneId)
+	d.Set("security_group", object.SecurityGroup)
+	d.Set("end_point", object.EndPoint)
+
+	tags := make(map[string]string)
+	for _, tag := range object.Tags.TagVO {
+		tags[tag.Key] = tag.Value
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+	d.Partial(true)
+
+	if err := alikafkaService.setInstanceTags(d, TagResourceInstance); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlibabacloudStackAlikafkaInstanceRead(ctx, d, meta)
+	}
+
+	if d.HasChange("name") {
+		request := alikafka.CreateModifyInstanceNameRequest()
+		request.RegionId = client.RegionId
+		request.InstanceId = d.Id()
+		request.InstanceName = d.Get("name").(string)
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		client.InitRpcRequest(request.RpcRequest)
+		if err := alikafkaInstanceRequest(ctx, client, d.Timeout(schema.TimeoutUpdate), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.ModifyInstanceName(request)
+		}); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+	}
+
+	// The specification is upgraded in place, and can not be scaled down.
+	if d.HasChanges("topic_quota", "disk_size", "io_max", "eip_max", "spec_type") {
+		for _, key := range []string{"topic_quota", "disk_size", "io_max", "eip_max"} {
+			if o, n := d.GetChange(key); n.(int) < o.(int) {
+				return DiagnosticsFromError(WrapError(fmt.Errorf("%s only support adjust to a greater value.", key)))
+			}
+		}
+		if o, n := d.GetChange("spec_type"); o.(string) == "professional" && n.(string) == "normal" {
+			return DiagnosticsFromError(WrapError(fmt.Errorf("spec_type only support upgrading from normal to professional.")))
+		}
+
+		request := alikafka.CreateUpgradePostPayOrderRequest()
+		request.RegionId = client.RegionId
+		request.InstanceId = d.Id()
+		request.TopicQuota = requests.NewInteger(d.Get("topic_quota").(int))
+		request.DiskSize = requests.NewInteger(d.Get("disk_size").(int))
+		request.IoMax = requests.NewInteger(d.Get("io_max").(int))
+		request.EipMax = requests.NewInteger(d.Get("eip_max").(int))
+		request.SpecType = d.Get("spec_type").(string)
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		client.InitRpcRequest(request.RpcRequest)
+		if err := alikafkaInstanceRequest(ctx, client, d.Timeout(schema.TimeoutUpdate), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.UpgradePostPayOrder(request)
+		}); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+
+		object, err := alikafkaService.DescribeAlikafkaInstance(d.Id())
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		if err := alikafkaService.WaitForAlikafkaInstanceUpdated(d.Id(), d.Get("topic_quota").(int), d.Get("disk_size").(int), d.Get("io_max").(int),
+			d.Get("eip_max").(int), object.PaidType, d.Get("spec_type").(string), int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		if err := alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlibabacloudStackAlikafkaInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	alikafkaService := AlikafkaService{client}
+
+	// Release the nodes of the instance before deleting it.
+	releaseReq := alikafka.CreateReleaseInstanceRequest()
+	releaseReq.RegionId = client.RegionId
+	releaseReq.InstanceId = d.Id()
+	releaseReq.ForceDeleteInstance = requests.NewBoolean(true)
+	releaseReq.ReleaseIgnoreTime = requests.NewBoolean(true)
+	releaseReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	client.InitRpcRequest(releaseReq.RpcRequest)
+	if err := alikafkaInstanceRequest(ctx, client, d.Timeout(schema.TimeoutDelete), releaseReq.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.ReleaseInstance(releaseReq)
+	}); err != nil {
+		if IsExpectedErrors(err, []string{"BIZ_INSTANCE_NOT_FOUND"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), releaseReq.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	if err := alikafkaService.WaitForAllAlikafkaNodeRelease(d.Id(), "released", int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	deleteReq := alikafka.CreateDeleteInstanceRequest()
+	deleteReq.RegionId = client.RegionId
+	deleteReq.InstanceId = d.Id()
+	deleteReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	client.InitRpcRequest(deleteReq.RpcRequest)
+	if err := alikafkaInstanceRequest(ctx, client, d.Timeout(schema.TimeoutDelete), deleteReq.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.DeleteInstance(deleteReq)
+	}); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), deleteReq.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	return DiagnosticsFromError(WrapError(alikafkaService.WaitForAlikafkaInstance(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
+
+// alikafkaInstanceRequest invokes an instance operation, retrying while the API is throttled.
+func alikafkaInstanceRequest(ctx context.Context, client *connectivity.AlibabacloudStackClient, timeout time.Duration, request *requests.RpcRequest, do func(*alikafka.Client) (interface{}, error)) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		raw, err := client.WithAlikafkaClient(do)
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request, request.QueryParams)
+		return nil
+	})
+}
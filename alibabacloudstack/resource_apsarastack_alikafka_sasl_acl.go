@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,13 +15,18 @@ import (
 
 func resourceAlibabacloudStackAlikafkaSaslAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaSaslAclCreate,
-		Read:   resourceAlibabacloudStackAlikafkaSaslAclRead,
-		Delete: resourceAlibabacloudStackAlikafkaSaslAclDelete,
+		CreateContext: resourceAlibabacloudStackAlikafkaSaslAclCreate,
+		ReadContext:   resourceAlibabacloudStackAlikafkaSaslAclRead,
+		DeleteContext: resourceAlibabacloudStackAlikafkaSaslAclDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -64,9 +71,9 @@ func resourceAlibabacloudStackAlikafkaSaslAcl() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	instanceId := d.Get("instance_id").(string)
@@ -86,7 +93,7 @@ func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta
 	request.AclResourcePatternType = aclResourcePatternType
 	request.AclOperationType = aclOperationType
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateAcl(request)
 		})
@@ -102,23 +109,23 @@ func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_sasl_acl", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_sasl_acl", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	// Server may have cache, sleep a while.
 	time.Sleep(60 * time.Second)
 	d.SetId(fmt.Sprintf("%s:%s:%s:%s:%s:%s", instanceId, username, aclResourceType, aclResourceName, aclResourcePatternType, aclOperationType))
-	return resourceAlibabacloudStackAlikafkaSaslAclRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslAclRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	parts, err := ParseResourceId(d.Id(), 6)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := alikafkaService.DescribeAlikafkaSaslAcl(d.Id())
 	if err != nil {
@@ -127,7 +134,7 @@ func resourceAlibabacloudStackAlikafkaSaslAclRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", parts[0])
@@ -141,14 +148,14 @@ func resourceAlibabacloudStackAlikafkaSaslAclRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	parts, err := ParseResourceId(d.Id(), 6)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	instanceId := parts[0]
 	username := parts[1]
@@ -166,7 +173,7 @@ func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta
 	request.AclResourcePatternType = aclResourcePatternType
 	request.AclOperationType = aclOperationType
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteAcl(request)
 		})
@@ -182,10 +189,10 @@ func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	// Server may have cache, sleep a while.
 	time.Sleep(60 * time.Second)
-	return WrapError(alikafkaService.WaitForAlikafkaSaslAcl(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(alikafkaService.WaitForAlikafkaSaslAcl(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,14 +14,20 @@ import (
 
 func resourceAlibabacloudStackAlikafkaSaslUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaSaslUserCreate,
-		Read:   resourceAlibabacloudStackAlikafkaSaslUserRead,
-		Update: resourceAlibabacloudStackAlikafkaSaslUserUpdate,
-		Delete: resourceAlibabacloudStackAlikafkaSaslUserDelete,
+		CreateContext: resourceAlibabacloudStackAlikafkaSaslUserCreate,
+		ReadContext:   resourceAlibabacloudStackAlikafkaSaslUserRead,
+		UpdateContext: resourceAlibabacloudStackAlikafkaSaslUserUpdate,
+		DeleteContext: resourceAlibabacloudStackAlikafkaSaslUserDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -64,9 +72,9 @@ func resourceAlibabacloudStackAlikafkaSaslUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	instanceId := d.Get("instance_id").(string)
@@ -77,7 +85,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 	kmsPassword := d.Get("kms_encrypted_password").(string)
 
 	if password == "" && kmsPassword == "" {
-		return WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set."))
+		return DiagnosticsFromError(WrapAttributeError(WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set.")), "password"))
 	}
 
 	request := alikafka.CreateCreateSaslUserRequest()
@@ -92,12 +100,12 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 		kmsService := KmsService{client}
 		decryptResp, err := kmsService.Decrypt2(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Password = decryptResp
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateSaslUser(request)
 		})
@@ -113,23 +121,23 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_alikafka_sasl_user", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_alikafka_sasl_user", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	// Server may have cache, sleep a while.
 	time.Sleep(2 * time.Second)
 	d.SetId(instanceId + ":" + username + ":" + usertype)
-	return resourceAlibabacloudStackAlikafkaSaslUserUpdate(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslUserUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := alikafkaService.DescribeAlikafkaSaslUser(d.Id())
 	if err != nil {
@@ -138,7 +146,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", parts[0])
@@ -149,14 +157,14 @@ func resourceAlibabacloudStackAlikafkaSaslUserRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	instanceId := parts[0]
 	username := parts[1]
@@ -174,7 +182,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 		kmsPassword := d.Get("kms_encrypted_password").(string)
 
 		if password == "" && kmsPassword == "" {
-			return WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set."))
+			return DiagnosticsFromError(WrapAttributeError(WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set.")), "password"))
 		}
 
 		if password != "" {
@@ -183,12 +191,12 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 			kmsService := KmsService{client}
 			decryptResp, err := kmsService.Decrypt2(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request.Password = decryptResp
 		}
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 				return alikafkaClient.CreateSaslUser(request)
 			})
@@ -204,23 +212,23 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_alikafka_sasl_user", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_alikafka_sasl_user", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		// Server may have cache, sleep a while.
 		time.Sleep(1000)
 	}
-	return resourceAlibabacloudStackAlikafkaSaslUserRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	instanceId := parts[0]
 	username := parts[1]
@@ -232,7 +240,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserDelete(d *schema.ResourceData, met
 	request.Username = username
 	request.Type = usertype
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteSaslUser(request)
 		})
@@ -248,8 +256,8 @@ func resourceAlibabacloudStackAlikafkaSaslUserDelete(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(alikafkaService.WaitForAlikafkaSaslUser(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(alikafkaService.WaitForAlikafkaSaslUser(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"strconv"
 
@@ -12,20 +13,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 func resourceAlibabacloudStackAlikafkaTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaTopicCreate,
-		Update: resourceAlibabacloudStackAlikafkaTopicUpdate,
-		Read:   resourceAlibabacloudStackAlikafkaTopicRead,
-		Delete: resourceAlibabacloudStackAlikafkaTopicDelete,
+		CreateContext: resourceAlibabacloudStackAlikafkaTopicCreate,
+		UpdateContext: resourceAlibabacloudStackAlikafkaTopicUpdate,
+		ReadContext:   resourceAlibabacloudStackAlikafkaTopicRead,
+		DeleteContext: resourceAlibabacloudStackAlikafkaTopicDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,8 +72,8 @@ func resourceAlibabacloudStackAlikafkaTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAlikafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	instanceId := d.Get("instance_id").(string)
@@ -81,16 +85,7 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 	request.RegionId = regionId
 	request.Topic = topic
 
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "alikafka",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateTopic",
-		"Version":         "2019-09-16",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	if v, ok := d.GetOk("local_topic"); ok {
 		request.LocalTopic = requests.NewBoolean(v.(bool))
 	}
@@ -105,7 +100,7 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 	}
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
 	request.QueryParams["Product"] = "alikafka"
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateTopic(request)
 		})
@@ -121,25 +116,25 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_topic", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_topic", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(instanceId + ":" + topic)
 
-	return resourceAlibabacloudStackAlikafkaTopicUpdate(d, meta)
+	return resourceAlibabacloudStackAlikafkaTopicUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 	d.Partial(true)
 	if err := alikafkaService.setInstanceTags(d, TagResourceTopic); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackAlikafkaTopicRead(d, meta)
+		return resourceAlibabacloudStackAlikafkaTopicRead(ctx, d, meta)
 	}
 
 	instanceId := d.Get("instance_id").(string)
@@ -154,18 +149,9 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 
 		
 
-		modifyRemarkRequest.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "alikafka",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyTopicRemark",
-			"Version":         "2019-09-16",
-		}
+		client.InitRpcRequest(modifyRemarkRequest.RpcRequest)
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 				return alikafkaClient.ModifyTopicRemark(modifyRemarkRequest)
 			})
@@ -180,7 +166,7 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyRemarkRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyRemarkRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("remark")
 	}
@@ -191,7 +177,7 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 		newPartitionNum := n.(int)
 
 		if newPartitionNum < oldPartitionNum {
-			return WrapError(errors.New("partition_num only support adjust to a greater value."))
+			return DiagnosticsFromError(WrapError(errors.New("partition_num only support adjust to a greater value.")))
 		} else {
 			topic := d.Get("topic").(string)
 			modifyPartitionReq := alikafka.CreateModifyPartitionNumRequest()
@@ -203,18 +189,9 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 			
 
 
-			modifyPartitionReq.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "alikafka",
-				"RegionId":        client.RegionId,
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"Action":          "ModifyPartitionNum",
-				"Version":         "2019-09-16",
-			}
+			client.InitRpcRequest(modifyPartitionReq.RpcRequest)
 
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 					return alikafkaClient.ModifyPartitionNum(modifyPartitionReq)
 				})
@@ -229,18 +206,18 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 				return nil
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyPartitionReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyPartitionReq.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			//d.SetPartial("partition_num")
 		}
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackAlikafkaTopicRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAlikafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	object, err := alikafkaService.DescribeAlikafkaTopic(d.Id())
@@ -250,7 +227,7 @@ func resourceAlibabacloudStackAlikafkaTopicRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.InstanceId)
@@ -269,13 +246,13 @@ func resourceAlibabacloudStackAlikafkaTopicRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAlikafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	alikafkaService := AlikafkaService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	instanceId := parts[0]
 	topic := parts[1]
@@ -285,17 +262,8 @@ func resourceAlibabacloudStackAlikafkaTopicDelete(d *schema.ResourceData, meta i
 	request.InstanceId = instanceId
 	request.RegionId = client.RegionId
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "alikafka",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteTopic",
-		"Version":         "2019-09-16",
-	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	client.InitRpcRequest(request.RpcRequest)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteTopic(request)
 		})
@@ -310,8 +278,8 @@ func resourceAlibabacloudStackAlikafkaTopicDelete(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(alikafkaService.WaitForAlikafkaTopic(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(alikafkaService.WaitForAlikafkaTopic(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
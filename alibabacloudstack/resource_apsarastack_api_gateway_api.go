@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -19,14 +21,20 @@ import (
 
 func resourceAlibabacloudStackApigatewayApi() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayApiCreate,
-		Read:   resourceAlibabacloudStackApigatewayApiRead,
-		Update: resourceAlibabacloudStackApigatewayApiUpdate,
-		Delete: resourceAlibabacloudStackApigatewayApiDelete,
+		CreateContext: resourceAlibabacloudStackApigatewayApiCreate,
+		ReadContext:   resourceAlibabacloudStackApigatewayApiRead,
+		UpdateContext: resourceAlibabacloudStackApigatewayApiUpdate,
+		DeleteContext: resourceAlibabacloudStackApigatewayApiDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:     schema.TypeString,
@@ -302,32 +310,19 @@ func resourceAlibabacloudStackApigatewayApi() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayApiCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayApiCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request, err := buildAlibabacloudStackApiArgs(d, meta)
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateApi",
-		"Version":         "2016-07-14",
-	}
-	request.RegionId = client.RegionId
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
+	client.InitRpcRequest(request.RpcRequest)
 
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.CreateApi(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_api-gateway_api", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_api-gateway_api", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*cloudapi.CreateApiResponse)
@@ -337,21 +332,21 @@ func resourceAlibabacloudStackApigatewayApiCreate(d *schema.ResourceData, meta i
 	if l, ok := d.GetOk("stage_names"); ok {
 		err = updateApiStages(d, l.(*schema.Set), meta)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
-	return resourceAlibabacloudStackApigatewayApiRead(d, meta)
+	return resourceAlibabacloudStackApigatewayApiRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayApiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateDescribeApiRequest()
 	request.RegionId = client.RegionId
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.ApiId = parts[1]
 	request.GroupId = parts[0]
@@ -361,17 +356,17 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	stageNames, err := getStageNameList(d, cloudApiService)
 	if err != nil {
 		if !NotFoundError(err) {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	if err := d.Set("stage_names", stageNames); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("api_id", object.ApiId)
@@ -390,7 +385,7 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 		requestConfig["body_format"] = object.RequestConfig.BodyFormat
 	}
 	if err := d.Set("request_config", []map[string]interface{}{requestConfig}); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if object.ServiceConfig.Mock == "TRUE" {
@@ -399,7 +394,7 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 		MockServiceConfig["result"] = object.ServiceConfig.MockResult
 		MockServiceConfig["aone_name"] = object.ServiceConfig.AoneAppName
 		if err := d.Set("mock_service_config", []map[string]interface{}{MockServiceConfig}); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	} else if object.ServiceConfig.ServiceVpcEnable == "TRUE" {
 		d.Set("service_type", "HTTP-VPC")
@@ -410,7 +405,7 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 		vpcServiceConfig["timeout"] = object.ServiceConfig.ServiceTimeout
 		vpcServiceConfig["aone_name"] = object.ServiceConfig.AoneAppName
 		if err := d.Set("http_vpc_service_config", []map[string]interface{}{vpcServiceConfig}); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	} else if object.ServiceConfig.ServiceProtocol == "FunctionCompute" {
 		d.Set("service_type", "FunctionCompute")
@@ -421,7 +416,7 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 		fcServiceConfig["arn_role"] = object.ServiceConfig.FunctionComputeConfig.RoleArn
 		fcServiceConfig["timeout"] = object.ServiceConfig.ServiceTimeout
 		if err := d.Set("fc_service_config", []map[string]interface{}{fcServiceConfig}); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	} else {
 		d.Set("service_type", "HTTP")
@@ -432,7 +427,7 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 		httpServiceConfig["timeout"] = object.ServiceConfig.ServiceTimeout
 		httpServiceConfig["aone_name"] = object.ServiceConfig.AoneAppName
 		if err := d.Set("http_service_config", []map[string]interface{}{httpServiceConfig}); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -494,12 +489,12 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayApiUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := cloudapi.CreateModifyApiRequest()
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.ApiId = parts[1]
 	request.GroupId = parts[0]
@@ -513,19 +508,7 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 	request.ApiName = d.Get("name").(string)
 	request.Description = d.Get("description").(string)
 	request.AuthType = d.Get("auth_type").(string)
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ModifyApi",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	if d.HasChange("force_nonce_check") {
 		update = true
 	}
@@ -540,7 +523,7 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 	}
 	paramConfig, paramErr = requestConfigToJsonStr(d.Get("request_config").([]interface{}))
 	if paramErr != nil {
-		return paramErr
+		return DiagnosticsFromError(paramErr)
 	}
 	request.RequestConfig = paramConfig
 
@@ -549,7 +532,7 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 	}
 	serviceConfig, err := serviceConfigToJsonStr(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.ServiceConfig = serviceConfig
 
@@ -558,7 +541,7 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 	}
 	rps, sps, spm, err := setParameters(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.RequestParameters = string(rps)
 	request.ServiceParameters = string(sps)
@@ -575,7 +558,7 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 			return cloudApiClient.ModifyApi(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
@@ -597,7 +580,7 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 		if l, ok := d.GetOk("stage_names"); ok {
 			err = updateApiStages(d, l.(*schema.Set), meta)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		//d.SetPartial("stage_names")
@@ -605,34 +588,22 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackApigatewayApiRead(d, meta)
+	return resourceAlibabacloudStackApigatewayApiRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayApiDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayApiDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateDeleteApiRequest()
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.ApiId = parts[1]
 	request.GroupId = parts[0]
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteApi",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	for _, stageName := range ApiGatewayStageNames {
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			err := cloudApiService.AbolishApi(d.Id(), stageName)
 			if err != nil {
 				if IsExpectedErrors(err, []string{"ConcurrencyLockTimeout"}) {
@@ -644,12 +615,12 @@ func resourceAlibabacloudStackApigatewayApiDelete(d *schema.ResourceData, meta i
 			return nil
 		})
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		_, err = cloudApiService.DescribeDeployedApi(d.Id(), stageName)
 		if err != nil {
 			if !NotFoundError(err) {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 	}
@@ -662,10 +633,10 @@ func resourceAlibabacloudStackApigatewayApiDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"NotFoundApi"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(cloudApiService.WaitForApiGatewayApi(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(cloudApiService.WaitForApiGatewayApi(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildAlibabacloudStackApiArgs(d *schema.ResourceData, meta interface{}) (*cloudapi.CreateApiRequest, error) {
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackApigatewayApp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayAppCreate,
-		Read:   resourceAlibabacloudStackApigatewayAppRead,
-		Update: resourceAlibabacloudStackApigatewayAppUpdate,
-		Delete: resourceAlibabacloudStackApigatewayAppDelete,
+		CreateContext: resourceAlibabacloudStackApigatewayAppCreate,
+		ReadContext:   resourceAlibabacloudStackApigatewayAppRead,
+		UpdateContext: resourceAlibabacloudStackApigatewayAppUpdate,
+		DeleteContext: resourceAlibabacloudStackApigatewayAppDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -38,8 +46,8 @@ func resourceAlibabacloudStackApigatewayApp() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := cloudapi.CreateCreateAppRequest()
 	request.RegionId = client.RegionId
@@ -48,20 +56,8 @@ func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta i
 		request.Description = v.(string)
 	}
 	request.Description = d.Get("description").(string)
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateApp",
-		"Version":         "2016-07-14",
-	}
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	client.InitRpcRequest(request.RpcRequest)
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.CreateApp(request)
 		})
@@ -76,17 +72,17 @@ func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta i
 		d.SetId(strconv.FormatInt(response.AppId, 10))
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_apigateway_app", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_apigateway_app", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return resourceAlibabacloudStackApigatewayAppUpdate(d, meta)
+	return resourceAlibabacloudStackApigatewayAppUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		tags, err := cloudApiService.DescribeTags(d.Id(), nil, TagResourceApp)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"NotFoundResourceId"}) {
@@ -98,10 +94,10 @@ func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta int
 		d.Set("tags", cloudApiService.tagsToMap(tags))
 		return nil
 	}); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	if err := resource.Retry(3*time.Second, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Second, func() *resource.RetryError {
 		object, err := cloudApiService.DescribeApiGatewayApp(d.Id())
 		if err != nil {
 			if NotFoundError(err) {
@@ -114,20 +110,20 @@ func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta int
 		d.Set("description", object.Description)
 		return nil
 	}); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 	if err := cloudApiService.setInstanceTags(d, TagResourceApp); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackApigatewayAppRead(d, meta)
+		return resourceAlibabacloudStackApigatewayAppRead(ctx, d, meta)
 	}
 	if d.HasChange("name") || d.HasChange("description") {
 		request := cloudapi.CreateModifyAppRequest()
@@ -137,51 +133,27 @@ func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta i
 		if v, exist := d.GetOk("description"); exist {
 			request.Description = v.(string)
 		}
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyApp",
-			"Version":         "2016-07-14",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.ModifyApp(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 	time.Sleep(3 * time.Second)
-	return resourceAlibabacloudStackApigatewayAppRead(d, meta)
+	return resourceAlibabacloudStackApigatewayAppRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayAppDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 
 	request := cloudapi.CreateDeleteAppRequest()
 	request.RegionId = client.RegionId
 	request.AppId = requests.Integer(d.Id())
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteApp",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DeleteApp(request)
 	})
@@ -189,8 +161,8 @@ func resourceAlibabacloudStackApigatewayAppDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"NotFoundApp"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(cloudApiService.WaitForApiGatewayApp(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(cloudApiService.WaitForApiGatewayApp(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,19 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunApigatewayAppAttachmentCreate,
-		Read:   resourceAliyunApigatewayAppAttachmentRead,
-		Delete: resourceAliyunApigatewayAppAttachmentDelete,
+		CreateContext: resourceAliyunApigatewayAppAttachmentCreate,
+		ReadContext:   resourceAliyunApigatewayAppAttachmentRead,
+		DeleteContext: resourceAliyunApigatewayAppAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -45,8 +56,8 @@ func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunApigatewayAppAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 
 	apiId := d.Get("api_id").(string)
@@ -60,49 +71,37 @@ func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta in
 	request.ApiId = apiId
 	request.AppIds = appId
 	request.StageName = stageName
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "SetAppsAuthorities",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.SetAppsAuthorities(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_apigateway_app_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_apigateway_app_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	id := fmt.Sprintf("%s%s%s%s%s%s%s", groupId, COLON_SEPARATED, apiId, COLON_SEPARATED, appId, COLON_SEPARATED, stageName)
 
-	err = cloudApiService.WaitForApiGatewayAppAttachment(id, Normal, DefaultTimeout)
+	err = cloudApiService.WaitForApiGatewayAppAttachment(id, Normal, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.SetId(id)
-	return resourceAliyunApigatewayAppAttachmentRead(d, meta)
+	return resourceAliyunApigatewayAppAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunApigatewayAppAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunApigatewayAppAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 
 	_, err := cloudApiService.DescribeApiGatewayAppAttachment(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	parts, err := ParseResourceId(d.Id(), 4)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("group_id", parts[0])
 	d.Set("api_id", parts[1])
@@ -112,32 +111,20 @@ func resourceAliyunApigatewayAppAttachmentRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAliyunApigatewayAppAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunApigatewayAppAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateRemoveAppsAuthoritiesRequest()
 	request.RegionId = client.RegionId
 	parts, err := ParseResourceId(d.Id(), 4)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.GroupId = parts[0]
 	request.ApiId = parts[1]
 	request.AppIds = parts[2]
 	request.StageName = parts[3]
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "RemoveAppsAuthorities",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.RemoveAppsAuthorities(request)
 	})
@@ -145,9 +132,9 @@ func resourceAliyunApigatewayAppAttachmentDelete(d *schema.ResourceData, meta in
 		if IsExpectedErrors(err, []string{"NotFoundAuthorization"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(cloudApiService.WaitForApiGatewayAppAttachment(d.Id(), Deleted, DefaultLongTimeout))
+	return DiagnosticsFromError(WrapError(cloudApiService.WaitForApiGatewayAppAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,24 +1,32 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackApigatewayGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayGroupCreate,
-		Read:   resourceAlibabacloudStackApigatewayGroupRead,
-		Update: resourceAlibabacloudStackApigatewayGroupUpdate,
-		Delete: resourceAlibabacloudStackApigatewayGroupDelete,
+		CreateContext: resourceAlibabacloudStackApigatewayGroupCreate,
+		ReadContext:   resourceAlibabacloudStackApigatewayGroupRead,
+		UpdateContext: resourceAlibabacloudStackApigatewayGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackApigatewayGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -41,28 +49,16 @@ func resourceAlibabacloudStackApigatewayGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := cloudapi.CreateCreateApiGroupRequest()
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateApiGroup",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	request.RegionId = client.RegionId
 	request.GroupName = d.Get("name").(string)
 	request.Description = d.Get("description").(string)
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.CreateApiGroup(request)
 		})
@@ -77,14 +73,14 @@ func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta
 		d.SetId(response.GroupId)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_api_gateway_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_api_gateway_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackApigatewayGroupRead(d, meta)
+	return resourceAlibabacloudStackApigatewayGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 	apiGroup, err := cloudApiService.DescribeApiGatewayGroup(d.Id())
 	if err != nil {
@@ -92,7 +88,7 @@ func resourceAlibabacloudStackApigatewayGroupRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", apiGroup.GroupName)
@@ -103,8 +99,8 @@ func resourceAlibabacloudStackApigatewayGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	if d.HasChange("name") || d.HasChange("description") {
 		request := cloudapi.CreateModifyApiGroupRequest()
@@ -112,56 +108,32 @@ func resourceAlibabacloudStackApigatewayGroupUpdate(d *schema.ResourceData, meta
 		request.Description = d.Get("description").(string)
 		request.GroupName = d.Get("name").(string)
 		request.GroupId = d.Id()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyApiGroup",
-			"Version":         "2016-07-14",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.ModifyApiGroup(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackApigatewayGroupRead(d, meta)
+	return resourceAlibabacloudStackApigatewayGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateDeleteApiGroupRequest()
 	request.RegionId = client.RegionId
 	request.GroupId = d.Id()
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteApiGroup",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DeleteApiGroup(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(cloudApiService.WaitForApiGatewayGroup(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(cloudApiService.WaitForApiGatewayGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 
 }
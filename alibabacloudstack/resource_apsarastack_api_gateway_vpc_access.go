@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,9 +19,9 @@ import (
 
 func resourceAlibabacloudStackApigatewayVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayVpcAccessCreate,
-		Read:   resourceAlibabacloudStackApigatewayVpcAccessRead,
-		Delete: resourceAlibabacloudStackApigatewayVpcAccessDelete,
+		CreateContext: resourceAlibabacloudStackApigatewayVpcAccessCreate,
+		ReadContext:   resourceAlibabacloudStackApigatewayVpcAccessRead,
+		DeleteContext: resourceAlibabacloudStackApigatewayVpcAccessDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -49,7 +51,7 @@ func resourceAlibabacloudStackApigatewayVpc() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayVpcAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//client := meta.(*connectivity.AlibabacloudStackClient)
 	//
 	//request := cloudapi.CreateSetVpcAccessRequest()
@@ -64,7 +66,7 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	//	"RegionId": client.RegionId,
 	//}
 	//request.QueryParams = map[string]string{
-	//	"AccessKeySecret": client.SecretKey,
+	//
 	//	"AccessKeyId":     client.AccessKey,
 	//	"Product":         "CloudAPI",
 	//	"RegionId":        client.RegionId,
@@ -82,7 +84,7 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	//	return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_api_gateway_vpc", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	//}
 	//addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "SetVpcAccess"
 	request := make(map[string]interface{})
@@ -96,13 +98,13 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	request["InstanceId"] = d.Get("instance_id")
 	conn, err := client.NewCloudApiClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["ClientToken"] = buildClientToken("SetVpcAccess")
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-07-14"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -115,11 +117,11 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	})
 	addDebug(action, response, request)
 	d.SetId(fmt.Sprintf("%s%s%s%s%s%s%s", request["Name"], COLON_SEPARATED, request["VpcId"], COLON_SEPARATED, request["InstanceId"], COLON_SEPARATED, request["Port"]))
-	return resourceAlibabacloudStackApigatewayVpcAccessRead(d, meta)
+	return resourceAlibabacloudStackApigatewayVpcAccessRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayVpcAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudApiService := CloudApiService{client}
 
 	vpc, err := cloudApiService.DescribeApiGatewayVpcAccess(d.Id())
@@ -128,7 +130,7 @@ func resourceAlibabacloudStackApigatewayVpcAccessRead(d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", vpc.Name)
@@ -139,31 +141,19 @@ func resourceAlibabacloudStackApigatewayVpcAccessRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackApigatewayVpcAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := cloudapi.CreateRemoveVpcAccessRequest()
 	request.RegionId = client.RegionId
 	request.VpcId = d.Get("vpc_id").(string)
 	request.InstanceId = d.Get("instance_id").(string)
 	request.Port = requests.NewInteger(d.Get("port").(int))
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "RemoveVpcAccess",
-		"Version":         "2016-07-14",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.RemoveVpcAccess(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
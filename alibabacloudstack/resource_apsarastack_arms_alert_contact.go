@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,16 +9,17 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackArmsAlertContact() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsAlertContactCreate,
-		Read:   resourceAlibabacloudStackArmsAlertContactRead,
-		Update: resourceAlibabacloudStackArmsAlertContactUpdate,
-		Delete: resourceAlibabacloudStackArmsAlertContactDelete,
+		CreateContext: resourceAlibabacloudStackArmsAlertContactCreate,
+		ReadContext:   resourceAlibabacloudStackArmsAlertContactRead,
+		UpdateContext: resourceAlibabacloudStackArmsAlertContactUpdate,
+		DeleteContext: resourceAlibabacloudStackArmsAlertContactDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -46,14 +48,14 @@ func resourceAlibabacloudStackArmsAlertContact() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsAlertContactCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateAlertContact"
 	request := make(map[string]interface{})
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("alert_contact_name"); ok {
 		request["ContactName"] = v
@@ -62,21 +64,21 @@ func resourceAlibabacloudStackArmsAlertContactCreate(d *schema.ResourceData, met
 		request["DingRobotWebhookUrl"] = v
 	} else if v, ok := d.GetOk("email"); ok && v.(string) == "" {
 		if v, ok := d.GetOk("phone_num"); ok && v.(string) == "" {
-			return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "ding_robot_webhook_url", "email", d.Get("email"), "phone_num", d.Get("phone_num")))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "ding_robot_webhook_url", "email", d.Get("email"), "phone_num", d.Get("phone_num"))))
 		}
 	}
 	if v, ok := d.GetOk("email"); ok {
 		request["Email"] = v
 	} else if v, ok := d.GetOk("ding_robot_webhook_url"); ok && v.(string) == "" {
 		if v, ok := d.GetOk("phone_num"); ok && v.(string) == "" {
-			return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "email", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "phone_num", d.Get("phone_num")))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "email", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "phone_num", d.Get("phone_num"))))
 		}
 	}
 	if v, ok := d.GetOk("phone_num"); ok {
 		request["PhoneNum"] = v
 	} else if v, ok := d.GetOk("ding_robot_webhook_url"); ok && v.(string) == "" {
 		if v, ok := d.GetOk("email"); ok && v.(string) == "" {
-			return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "phone_num", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "email", d.Get("email")))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "phone_num", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "email", d.Get("email"))))
 		}
 	}
 	request["RegionId"] = client.RegionId
@@ -88,7 +90,7 @@ func resourceAlibabacloudStackArmsAlertContactCreate(d *schema.ResourceData, met
 		request["SystemNoc"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -101,15 +103,15 @@ func resourceAlibabacloudStackArmsAlertContactCreate(d *schema.ResourceData, met
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_alert_contact", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_alert_contact", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["ContactId"]))
 
-	return resourceAlibabacloudStackArmsAlertContactRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	armsService := ArmsService{client}
 	object, err := armsService.DescribeArmsAlertContact(d.Id())
 	if err != nil {
@@ -118,7 +120,7 @@ func resourceAlibabacloudStackArmsAlertContactRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("alert_contact_name", object["ContactName"])
 	d.Set("ding_robot_webhook_url", object["DingRobot"])
@@ -127,8 +129,8 @@ func resourceAlibabacloudStackArmsAlertContactRead(d *schema.ResourceData, meta
 	d.Set("system_noc", object["SystemNoc"])
 	return nil
 }
-func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	update := false
 	request := map[string]interface{}{
@@ -151,7 +153,7 @@ func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, met
 		request["DingRobotWebhookUrl"] = v
 	} else if v, ok := d.GetOk("email"); ok && v.(string) == "" {
 		if v, ok := d.GetOk("phone_num"); ok && v.(string) == "" {
-			return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "ding_robot_webhook_url", "email", d.Get("email"), "phone_num", d.Get("phone_num")))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "ding_robot_webhook_url", "email", d.Get("email"), "phone_num", d.Get("phone_num"))))
 		}
 	}
 	if d.HasChange("email") {
@@ -161,7 +163,7 @@ func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, met
 		request["Email"] = v
 	} else if v, ok := d.GetOk("ding_robot_webhook_url"); ok && v.(string) == "" {
 		if v, ok := d.GetOk("phone_num"); ok && v.(string) == "" {
-			return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "email", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "phone_num", d.Get("phone_num")))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "email", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "phone_num", d.Get("phone_num"))))
 		}
 	}
 	if d.HasChange("phone_num") {
@@ -171,7 +173,7 @@ func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, met
 		request["PhoneNum"] = v
 	} else if v, ok := d.GetOk("ding_robot_webhook_url"); ok && v.(string) == "" {
 		if v, ok := d.GetOk("email"); ok && v.(string) == "" {
-			return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "phone_num", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "email", d.Get("email")))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "phone_num", "ding_robot_webhook_url", d.Get("ding_robot_webhook_url"), "email", d.Get("email"))))
 		}
 	}
 	if d.HasChange("system_noc") || d.IsNewResource() {
@@ -184,10 +186,10 @@ func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, met
 		action := "UpdateAlertContact"
 		conn, err := client.NewArmsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -200,18 +202,18 @@ func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, met
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackArmsAlertContactRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteAlertContact"
 	var response map[string]interface{}
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"ContactId": d.Id(),
@@ -222,7 +224,7 @@ func resourceAlibabacloudStackArmsAlertContactDelete(d *schema.ResourceData, met
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -235,7 +237,7 @@ func resourceAlibabacloudStackArmsAlertContactDelete(d *schema.ResourceData, met
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
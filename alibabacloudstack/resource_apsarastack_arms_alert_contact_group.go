@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,16 +9,17 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackArmsAlertContactGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsAlertContactGroupCreate,
-		Read:   resourceAlibabacloudStackArmsAlertContactGroupRead,
-		Update: resourceAlibabacloudStackArmsAlertContactGroupUpdate,
-		Delete: resourceAlibabacloudStackArmsAlertContactGroupDelete,
+		CreateContext: resourceAlibabacloudStackArmsAlertContactGroupCreate,
+		ReadContext:   resourceAlibabacloudStackArmsAlertContactGroupRead,
+		UpdateContext: resourceAlibabacloudStackArmsAlertContactGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackArmsAlertContactGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -35,14 +37,14 @@ func resourceAlibabacloudStackArmsAlertContactGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsAlertContactGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateAlertContactGroup"
 	request := make(map[string]interface{})
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["ContactGroupName"] = d.Get("alert_contact_group_name")
 	if v, ok := d.GetOk("contact_ids"); ok {
@@ -52,7 +54,7 @@ func resourceAlibabacloudStackArmsAlertContactGroupCreate(d *schema.ResourceData
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -65,15 +67,15 @@ func resourceAlibabacloudStackArmsAlertContactGroupCreate(d *schema.ResourceData
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_alert_contact_group", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_alert_contact_group", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["ContactGroupId"]))
 
-	return resourceAlibabacloudStackArmsAlertContactGroupRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	armsService := ArmsService{client}
 	object, err := armsService.DescribeArmsAlertContactGroup(d.Id())
 	if err != nil {
@@ -82,7 +84,7 @@ func resourceAlibabacloudStackArmsAlertContactGroupRead(d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("alert_contact_group_name", object["ContactGroupName"])
 	contactIdsItems := make([]string, 0)
@@ -96,8 +98,8 @@ func resourceAlibabacloudStackArmsAlertContactGroupRead(d *schema.ResourceData,
 	d.Set("contact_ids", contactIdsItems)
 	return nil
 }
-func resourceAlibabacloudStackArmsAlertContactGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	update := false
 	request := map[string]interface{}{
@@ -120,10 +122,10 @@ func resourceAlibabacloudStackArmsAlertContactGroupUpdate(d *schema.ResourceData
 		action := "UpdateAlertContactGroup"
 		conn, err := client.NewArmsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -136,18 +138,18 @@ func resourceAlibabacloudStackArmsAlertContactGroupUpdate(d *schema.ResourceData
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackArmsAlertContactGroupRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsAlertContactGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteAlertContactGroup"
 	var response map[string]interface{}
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"ContactGroupId": d.Id(),
@@ -157,7 +159,7 @@ func resourceAlibabacloudStackArmsAlertContactGroupDelete(d *schema.ResourceData
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -170,7 +172,7 @@ func resourceAlibabacloudStackArmsAlertContactGroupDelete(d *schema.ResourceData
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackArmsDispatchRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsDispatchRuleCreate,
-		Read:   resourceAlibabacloudStackArmsDispatchRuleRead,
-		Update: resourceAlibabacloudStackArmsDispatchRuleUpdate,
-		Delete: resourceAlibabacloudStackArmsDispatchRuleDelete,
+		CreateContext: resourceAlibabacloudStackArmsDispatchRuleCreate,
+		ReadContext:   resourceAlibabacloudStackArmsDispatchRuleRead,
+		UpdateContext: resourceAlibabacloudStackArmsDispatchRuleUpdate,
+		DeleteContext: resourceAlibabacloudStackArmsDispatchRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -151,14 +153,14 @@ func resourceAlibabacloudStackArmsDispatchRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsDispatchRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsDispatchRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateDispatchRule"
 	request := make(map[string]interface{})
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	dispatchRuleMap := make(map[string]interface{}, 0)
 	if v, ok := d.GetOk("is_recover"); ok {
@@ -238,13 +240,13 @@ func resourceAlibabacloudStackArmsDispatchRuleCreate(d *schema.ResourceData, met
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
 	if v, err := convertMaptoJsonString(dispatchRuleMap); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		request["DispatchRule"] = v
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -257,15 +259,15 @@ func resourceAlibabacloudStackArmsDispatchRuleCreate(d *schema.ResourceData, met
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_dispatch_rule", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_dispatch_rule", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["DispatchRuleId"]))
 
-	return resourceAlibabacloudStackArmsDispatchRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsDispatchRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsDispatchRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsDispatchRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	armsService := ArmsService{client}
 	object, err := armsService.DescribeArmsDispatchRule(d.Id())
 	if err != nil {
@@ -274,7 +276,7 @@ func resourceAlibabacloudStackArmsDispatchRuleRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if groupRulesList, ok := object["GroupRules"]; ok && groupRulesList != nil {
 		groupRulesMaps := make([]map[string]interface{}, 0)
@@ -318,7 +320,7 @@ func resourceAlibabacloudStackArmsDispatchRuleRead(d *schema.ResourceData, meta
 		labelMatchExpressionGridMap["label_match_expression_groups"] = labelMatchExpressionGroupsMaps
 		labelMatchExpressionGridMaps = append(labelMatchExpressionGridMaps, labelMatchExpressionGridMap)
 		if err := d.Set("label_match_expression_grid", labelMatchExpressionGridMaps); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -347,14 +349,14 @@ func resourceAlibabacloudStackArmsDispatchRuleRead(d *schema.ResourceData, meta
 	d.Set("dispatch_rule_name", object["Name"])
 	return nil
 }
-func resourceAlibabacloudStackArmsDispatchRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsDispatchRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "UpdateDispatchRule"
 	request := make(map[string]interface{})
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	dispatchRuleMap := make(map[string]interface{}, 0)
 	dispatchRuleMap["isRecover"] = d.Get("is_recover")
@@ -439,14 +441,14 @@ func resourceAlibabacloudStackArmsDispatchRuleUpdate(d *schema.ResourceData, met
 
 	request["RegionId"] = client.RegionId
 	if v, err := convertMaptoJsonString(dispatchRuleMap); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		request["DispatchRule"] = v
 	}
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -459,18 +461,18 @@ func resourceAlibabacloudStackArmsDispatchRuleUpdate(d *schema.ResourceData, met
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_dispatch_rule", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_dispatch_rule", action, AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackArmsDispatchRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsDispatchRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsDispatchRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsDispatchRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteDispatchRule"
 	var response map[string]interface{}
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"Id":       d.Id(),
@@ -479,7 +481,7 @@ func resourceAlibabacloudStackArmsDispatchRuleDelete(d *schema.ResourceData, met
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -492,7 +494,7 @@ func resourceAlibabacloudStackArmsDispatchRuleDelete(d *schema.ResourceData, met
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
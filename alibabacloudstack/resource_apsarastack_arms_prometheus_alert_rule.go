@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackArmsPrometheusAlertRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsPrometheusAlertRuleCreate,
-		Read:   resourceAlibabacloudStackArmsPrometheusAlertRuleRead,
-		Update: resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate,
-		Delete: resourceAlibabacloudStackArmsPrometheusAlertRuleDelete,
+		CreateContext: resourceAlibabacloudStackArmsPrometheusAlertRuleCreate,
+		ReadContext:   resourceAlibabacloudStackArmsPrometheusAlertRuleRead,
+		UpdateContext: resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate,
+		DeleteContext: resourceAlibabacloudStackArmsPrometheusAlertRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -111,14 +113,14 @@ func resourceAlibabacloudStackArmsPrometheusAlertRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreatePrometheusAlertRule"
 	request := make(map[string]interface{})
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("annotations"); ok {
 		annotationsMaps := make([]map[string]interface{}, 0)
@@ -129,7 +131,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceDa
 		if v, err := convertArrayObjectToJsonString(annotationsMaps); err == nil {
 			request["Annotations"] = v
 		} else {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	request["ClusterId"] = d.Get("cluster_id")
@@ -147,7 +149,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceDa
 		if v, err := convertArrayObjectToJsonString(labelsMaps); err == nil {
 			request["Labels"] = v
 		} else {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	request["Message"] = d.Get("message")
@@ -162,7 +164,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceDa
 		request["Type"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -175,15 +177,15 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceDa
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_prometheus_alert_rule", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_prometheus_alert_rule", action, AlibabacloudStackSdkGoERROR))
 	}
 	responsePrometheusAlertRule := response["PrometheusAlertRule"].(map[string]interface{})
 	d.SetId(fmt.Sprint(request["ClusterId"], ":", responsePrometheusAlertRule["AlertId"]))
 
-	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	armsService := ArmsService{client}
 	object, err := armsService.DescribeArmsPrometheusAlertRule(d.Id())
 	if err != nil {
@@ -192,11 +194,11 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("cluster_id", parts[0])
 	d.Set("prometheus_alert_rule_id", parts[1])
@@ -216,7 +218,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d *schema.ResourceData
 			annotations = append(annotations, temp)
 		}
 		if err := d.Set("annotations", annotations); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	d.Set("dispatch_rule_id", fmt.Sprint(formatInt(object["DispatchRuleId"])))
@@ -234,7 +236,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d *schema.ResourceData
 			labels = append(labels, temp)
 		}
 		if err := d.Set("labels", labels); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	d.Set("message", object["Message"])
@@ -244,12 +246,12 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d *schema.ResourceData
 	d.Set("type", object["Type"])
 	return nil
 }
-func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	update := false
 	request := map[string]interface{}{
@@ -287,7 +289,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(d *schema.ResourceDa
 			if v, err := convertArrayObjectToJsonString(annotationsMaps); err == nil {
 				request["Annotations"] = v
 			} else {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 	}
@@ -308,7 +310,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(d *schema.ResourceDa
 			if v, err := convertArrayObjectToJsonString(labelsMaps); err == nil {
 				request["Labels"] = v
 			} else {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 	}
@@ -323,10 +325,10 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(d *schema.ResourceDa
 		action := "UpdatePrometheusAlertRule"
 		conn, err := client.NewArmsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -339,22 +341,22 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(d *schema.ResourceDa
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsPrometheusAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackArmsPrometheusAlertRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "DeletePrometheusAlertRule"
 	var response map[string]interface{}
 	conn, err := client.NewArmsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"AlertId": parts[1],
@@ -363,7 +365,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleDelete(d *schema.ResourceDa
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -376,7 +378,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleDelete(d *schema.ResourceDa
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
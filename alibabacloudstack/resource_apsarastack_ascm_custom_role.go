@@ -1,15 +1,15 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRoleCreate,
-		Read:   resourceAlibabacloudStackAscmRoleRead,
-		Update: resourceAlibabacloudStackAscmRoleUpdate,
-		Delete: resourceAlibabacloudStackAscmRoleDelete,
+		CreateContext: resourceAlibabacloudStackAscmRoleCreate,
+		ReadContext:   resourceAlibabacloudStackAscmRoleRead,
+		UpdateContext: resourceAlibabacloudStackAscmRoleUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:         schema.TypeString,
@@ -52,8 +55,8 @@ func resourceAlibabacloudStackAscmRole() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackAscmRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
 	name := d.Get("role_name").(string)
@@ -73,58 +76,35 @@ func resourceAlibabacloudStackAscmRoleCreate(d *schema.ResourceData, meta interf
 	}
 	check, err := ascmService.DescribeAscmCustomRole(name)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_custom_role", "role alreadyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_custom_role", "role alreadyExist", AlibabacloudStackSdkGoERROR))
 	}
 	organizationvisibility := d.Get("organization_visibility").(string)
 	if len(check.Data) == 0 {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":               client.RegionId,
-			"AccessKeySecret":        client.SecretKey,
-			"Product":                "ascm",
-			"Action":                 "CreateRole",
-			"Version":                "2019-05-10",
-			"ProductName":            "ascm",
-			"roleName":               name,
-			"description":            description,
-			"roleRange":              roleRange,
-			"organizationVisibility": organizationvisibility,
-			//"privileges":             fmt.Sprintf("[\"%s\"]", priv),
-			"params": fmt.Sprintf("{\"privileges\":%s}", priv),
-		}
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "CreateRole"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "CreateRole", "")
+		//"privileges":             fmt.Sprintf("[\"%s\"]", priv),
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["roleName"] = name
+		request.QueryParams["description"] = description
+		request.QueryParams["roleRange"] = roleRange
+		request.QueryParams["organizationVisibility"] = organizationvisibility
+		request.QueryParams["params"] = fmt.Sprintf("{\"privileges\":%s}", priv)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_custom_role", "CreateRole", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_custom_role", "CreateRole", raw))
 		}
 		addDebug("CreateRole", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_custom_role", "CreateRole", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_custom_role", "CreateRole", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateRole", raw, requestInfo, bresponse.GetHttpContentString())
 	}
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmCustomRole(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -132,76 +112,57 @@ func resourceAlibabacloudStackAscmRoleCreate(d *schema.ResourceData, meta interf
 		return resource.RetryableError(err)
 	})
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
-	return resourceAlibabacloudStackAscmRoleUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRoleUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmRoleRead(d, meta)
+func resourceAlibabacloudStackAscmRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceAlibabacloudStackAscmRoleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
 	object, err := ascmService.DescribeAscmCustomRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	log.Printf("Privileges for did[0]:%v", object.Data[0].Privileges)
-	d.Set("role_name", did[0])
+	d.Set("role_name", parts[0])
 	d.Set("organization_visibility", object.Data[0].OrganizationVisibility)
 	d.Set("role_id", object.Data[0].ID)
 	d.Set("description", object.Data[0].Description)
+	d.Set("role_range", object.Data[0].RoleRange)
+	d.Set("privileges", object.Data[0].Privileges)
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmCustomRole(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRoleExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRoleExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsCustomRoleExist", check, requestInfo, map[string]string{"roleName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveRole",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"roleName":        did[0],
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RemoveRole"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveRole", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["roleName"] = did[0]
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -1,16 +1,15 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackLogonPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogonPolicyCreate,
-		Read:   resourceAlibabacloudStackLogonPolicyRead,
-		Update: resourceAlibabacloudStackLogonPolicyUpdate,
-		Delete: resourceAlibabacloudStackLogonPolicyDelete,
+		CreateContext: resourceAlibabacloudStackLogonPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackLogonPolicyRead,
+		UpdateContext: resourceAlibabacloudStackLogonPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackLogonPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -71,8 +70,8 @@ func resourceAlibabacloudStackLogonPolicy() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogonPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
 	name := d.Get("name").(string)
@@ -81,61 +80,35 @@ func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta int
 	object, err := ascmService.DescribeAscmLogonPolicy(name)
 	if err != nil {
 
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 
-		request := requests.NewCommonRequest()
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":               client.RegionId,
-			"AccessKeySecret":        client.SecretKey,
-			"Product":                "ascm",
-			"Department":             client.Department,
-			"ResourceGroup":          client.ResourceGroup,
-			"Action":                 "AddLoginPolicy",
-			"AccountInfo":            "123456",
-			"Version":                "2019-05-10",
-			"SignatureVersion":       "1.0",
-			"ProductName":            "ascm",
-			"name":                   name,
-			"description":            descr,
-			"rule":                   rule,
-			"organizationVisibility": "organizationVisibility.organization",
-		}
-		request.Domain = client.Domain
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.ApiName = "AddLoginPolicy"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "AddLoginPolicy", "")
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["name"] = name
+		request.QueryParams["description"] = descr
+		request.QueryParams["rule"] = rule
+		request.QueryParams["organizationVisibility"] = "organizationVisibility.organization"
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		log.Printf(" response of raw AddLoginPolicy : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "AddLoginPolicy", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "AddLoginPolicy", raw))
 		}
 		addDebug("AddLoginPolicy", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "AddLoginPolicy", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "AddLoginPolicy", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("AddLoginPolicy", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		object, err = ascmService.DescribeAscmLogonPolicy(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -146,26 +119,17 @@ func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta int
 		return resource.RetryableError(err)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "Failed to add login Policy", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "Failed to add login Policy", AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(object.Data[0].Name)
 
-	return resourceAlibabacloudStackLogonPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackLogonPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogonPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogonPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
 	var name, rule, desc string
 	if d.HasChange("name") {
 		name = d.Get("name").(string)
@@ -177,56 +141,39 @@ func resourceAlibabacloudStackLogonPolicyUpdate(d *schema.ResourceData, meta int
 		desc = d.Get("description").(string)
 	}
 	policyId := fmt.Sprint(d.Get("policy_id").(int))
-
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ModifyLoginPolicy",
-		"Version":         "2019-05-10",
-		"ProductName":     "ascm",
-		"id":              policyId,
-		"Name":            name,
-		"Rule":            rule,
-		"Description":     desc,
-	}
-	request.Domain = client.Domain
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.ApiName = "ModifyLoginPolicy"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "ModifyLoginPolicy", "")
+	request.QueryParams["ProductName"] = "ascm"
+	request.QueryParams["id"] = policyId
+	request.QueryParams["Name"] = name
+	request.QueryParams["Rule"] = rule
+	request.QueryParams["Description"] = desc
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "LoginPolicyUpdateRequestFailed", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "LoginPolicyUpdateRequestFailed", raw))
 	}
 	bresponse, _ := raw.(*responses.CommonResponse)
 
 	if !bresponse.IsSuccess() {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "LoginPolicyUpdateFailed", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_login_policy", "LoginPolicyUpdateFailed", raw))
 	}
 	err = json.Unmarshal(bresponse.GetHttpContentBytes(), bresponse)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := ascmService.DescribeAscmLogonPolicy(name)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.SetId(object.Data[0].Name)
 
-	return resourceAlibabacloudStackLogonPolicyRead(d, meta)
+	return resourceAlibabacloudStackLogonPolicyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackLogonPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmLogonPolicy(d.Id())
 	if err != nil {
@@ -234,7 +181,7 @@ func resourceAlibabacloudStackLogonPolicyRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.Data[0].Name)
@@ -243,8 +190,8 @@ func resourceAlibabacloudStackLogonPolicyRead(d *schema.ResourceData, meta inter
 	d.Set("rule", object.Data[0].Rule)
 	return nil
 }
-func resourceAlibabacloudStackLogonPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogonPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 
@@ -252,45 +199,14 @@ func resourceAlibabacloudStackLogonPolicyDelete(d *schema.ResourceData, meta int
 
 	check, err := ascmService.DescribeAscmLogonPolicy(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsLoginPolicyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsLoginPolicyExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsLoginPolicyExist", check, requestInfo, map[string]string{"loginpolicyName": d.Id()})
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		request := requests.NewCommonRequest()
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.RegionId = client.RegionId
-		request.QueryParams = map[string]string{
-			"RegionId":         client.RegionId,
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "ascm",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"Action":           "RemoveLoginPolicyByName",
-			"AccountInfo":      "123456",
-			"Version":          "2019-05-10",
-			"SignatureVersion": "1.0",
-			"ProductName":      "ascm",
-			"Name":             name,
-		}
-		request.Domain = client.Domain
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RemoveLoginPolicyByName"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveLoginPolicyByName", "")
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["Name"] = name
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
 		})
@@ -1,16 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmOrganization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmOrganizationCreate,
-		Read:   resourceAlibabacloudStackAscmOrganizationRead,
-		Update: resourceAlibabacloudStackAscmOrganizationUpdate,
-		Delete: resourceAlibabacloudStackAscmOrganizationDelete,
+		CreateContext: resourceAlibabacloudStackAscmOrganizationCreate,
+		ReadContext:   resourceAlibabacloudStackAscmOrganizationRead,
+		UpdateContext: resourceAlibabacloudStackAscmOrganizationUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmOrganizationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"org_id": {
 				Type:     schema.TypeString,
@@ -49,64 +52,43 @@ func resourceAlibabacloudStackAscmOrganization() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 
 	var requestInfo *ecs.Client
 	name := d.Get("name").(string)
 	check, err := ascmService.DescribeAscmOrganization(name)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_organization", "ORG alreadyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_organization", "ORG alreadyExist", AlibabacloudStackSdkGoERROR))
 	}
 	parentid := d.Get("parent_id").(string)
 
 	if len(check.Data) == 0 {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.Method = "POST"
-		request.Domain = client.Domain
-		request.RegionId = client.RegionId
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "CreateOrganization"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			//"Department":      client.Department,
-			//"ResourceGroup":   client.ResourceGroup,
-			"RegionId": client.RegionId,
-			"Action":   "CreateOrganization",
-			"ParentId": parentid,
-			"name":     name,
-		}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateOrganization", "")
+		//"Department":      client.Department,
+		//"ResourceGroup":   client.ResourceGroup,
+		request.QueryParams["ParentId"] = parentid
+		request.QueryParams["name"] = name
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		log.Printf("response of raw CreateOrganization is : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_organization", "CreateOrganization", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_organization", "CreateOrganization", raw))
 		}
 		addDebug("CreateOrganization", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_organization", "CreateOrganization", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_organization", "CreateOrganization", AlibabacloudStackSdkGoERROR))
 		}
 		// TODO: 父组织未找到这里不会报错，因为HttpStatus依旧为200
 		addDebug("CreateOrganization", raw, requestInfo, bresponse.GetHttpContentString())
 
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmOrganization(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -114,21 +96,21 @@ func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, met
 		return resource.RetryableError(err)
 	})
 
-	d.SetId(fmt.Sprint(check.Data[0].ID))
+	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmOrganizationUpdate(d, meta)
+	return resourceAlibabacloudStackAscmOrganizationUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	name := d.Get("name").(string)
 	attributeUpdate := false
 	check, err := ascmService.DescribeAscmOrganization(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsOrganizationExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsOrganizationExist", AlibabacloudStackSdkGoERROR))
 	}
 
 	if d.HasChange("name") {
@@ -143,31 +125,12 @@ func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, met
 		}
 		check.Data[0].Name = name
 	}
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		//"Department":      client.Department,
-		//"ResourceGroup":   client.ResourceGroup,
-		"Product": "Ascm",
-		"Action":  "UpdateOrganization",
-		"Version": "2019-05-10",
-		"name":    name,
-		"id":      did[1],
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateOrganization", "")
+	//"Department":      client.Department,
+	//"ResourceGroup":   client.ResourceGroup,
 	request.SetHTTPSInsecure(true)
-	request.ApiName = "UpdateOrganization"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams["name"] = name
+	request.QueryParams["id"] = did[1]
 
 	if attributeUpdate {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -176,86 +139,65 @@ func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, met
 		log.Printf(" response of raw UpdateOrganization : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", raw))
 		}
 		addDebug(request.GetActionName(), raw, request)
 
 	}
 
-	d.SetId(fmt.Sprint(check.Data[0].ID))
+	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmOrganizationRead(d, meta)
+	return resourceAlibabacloudStackAscmOrganizationRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
 	object, err := ascmService.DescribeAscmOrganization(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("org_id", did[1])
-	d.Set("name", did[0])
+	d.Set("org_id", parts[1])
+	d.Set("name", parts[0])
 	d.Set("parent_id", strconv.Itoa(object.Data[0].ParentID))
 
 	return nil
 
 }
-func resourceAlibabacloudStackAscmOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmOrganization(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsOrganizationExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsOrganizationExist", AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug("IsOrganizationExist", check, requestInfo, map[string]string{"id": did[1]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if len(check.Data) != 0 {
-			request := requests.NewCommonRequest()
-			if client.Config.Insecure {
-				request.SetHTTPSInsecure(client.Config.Insecure)
-			}
-			request.QueryParams = map[string]string{
-				"RegionId":        client.RegionId,
-				"AccessKeySecret": client.SecretKey,
-				//"Department":      client.Department,
-				//"ResourceGroup":   client.ResourceGroup,
-				"Product":     "ascm",
-				"Action":      "RemoveOrganization",
-				"Version":     "2019-05-10",
-				"ProductName": "ascm",
-				"id":          did[1],
-			}
-
-			request.Method = "POST"
-			request.Product = "ascm"
-			request.Version = "2019-05-10"
-			request.ServiceCode = "ascm"
-			request.Domain = client.Domain
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.ApiName = "RemoveOrganization"
-			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.RegionId = client.RegionId
+			request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveOrganization", "")
+			//"Department":      client.Department,
+			//"ResourceGroup":   client.ResourceGroup,
+			request.QueryParams["ProductName"] = "ascm"
+			request.QueryParams["id"] = did[1]
 
 			_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 				return csClient.ProcessCommonRequest(request)
@@ -34,6 +34,12 @@ func TestAccAlibabacloudStackAscm_OrganizationBasic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"person_num", "resource_group_num"},
+			},
 		},
 	})
 
@@ -1,28 +1,29 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-
-	"time"
 )
 
 func resourceAlibabacloudStackAscmPasswordPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmPasswordPolicyCreate,
-		Read:   resourceAlibabacloudStackAscmPasswordPolicyRead,
-		Update: resourceAlibabacloudStackAscmPasswordPolicyUpdate,
-		Delete: resourceAlibabacloudStackAscmPasswordPolicyDelete,
+		CreateContext: resourceAlibabacloudStackAscmPasswordPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackAscmPasswordPolicyRead,
+		UpdateContext: resourceAlibabacloudStackAscmPasswordPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmPasswordPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"hard_expiry": {
 				Type:     schema.TypeBool,
@@ -65,65 +66,40 @@ func resourceAlibabacloudStackAscmPasswordPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmPasswordPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	value123 := strconv.Itoa(d.Get("minimum_password_length").(int))
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "SetPasswordPolicy"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"RegionId":              client.RegionId,
-		"AccessKeySecret":       client.SecretKey,
-		"Department":            client.Department,
-		"ResourceGroup":         client.ResourceGroup,
-		"Product":               "ascm",
-		"Action":                "SetPasswordPolicy",
-		"Version":               "2019-05-10",
-		"ProductName":           "ascm",
-		"minimumPasswordLength": value123,
-	}
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "SetPasswordPolicy", "")
+	request.QueryParams["ProductName"] = "ascm"
+	request.QueryParams["minimumPasswordLength"] = value123
 	var response = PasswordPolicy{}
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_password_policy", "", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_password_policy", "", raw))
 	}
 
 	addDebug("SetPasswordPolicy", raw, requestInfo, request)
 
 	bresponse, _ := raw.(*responses.CommonResponse)
 	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_password_policy", "", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_password_policy", "", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("SetPasswordPolicy", raw, requestInfo, bresponse.GetHttpContentString())
 	_ = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 
 	d.SetId(fmt.Sprint(response.Data.ID))
 
-	return resourceAlibabacloudStackAscmPasswordPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAscmPasswordPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmPasswordPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmPasswordPolicy(d.Id())
 	if err != nil {
@@ -131,7 +107,7 @@ func resourceAlibabacloudStackAscmPasswordPolicyRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("hard_expiry", object.Data.HardExpiry)
 	d.Set("require_numbers", object.Data.RequireNumbers)
@@ -146,48 +122,25 @@ func resourceAlibabacloudStackAscmPasswordPolicyRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmPasswordPolicyRead(d, meta)
+func resourceAlibabacloudStackAscmPasswordPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceAlibabacloudStackAscmPasswordPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmPasswordPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmPasswordPolicy(d.Id())
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsResourceGroupExist", check, requestInfo, map[string]string{"resourceGroupName": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "ResetPasswordPolicy",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"id":              d.Id(),
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "ResetPasswordPolicy"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "ResetPasswordPolicy", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["id"] = d.Id()
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -203,7 +156,7 @@ func resourceAlibabacloudStackAscmPasswordPolicyDelete(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "ResetPasswordPolicy", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "ResetPasswordPolicy", AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
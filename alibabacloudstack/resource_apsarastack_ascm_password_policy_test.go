@@ -31,6 +31,11 @@ func TestAccAlibabacloudStackAscm_PasswordPolicy(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 
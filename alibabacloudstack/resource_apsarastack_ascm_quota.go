@@ -1,15 +1,15 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmQuota() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmQuotaCreate,
-		Read:   resourceAlibabacloudStackAscmQuotaRead,
-		Update: resourceAlibabacloudStackAscmQuotaUpdate,
-		Delete: resourceAlibabacloudStackAscmQuotaDelete,
+		CreateContext: resourceAlibabacloudStackAscmQuotaCreate,
+		ReadContext:   resourceAlibabacloudStackAscmQuotaRead,
+		UpdateContext: resourceAlibabacloudStackAscmQuotaUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"product_name": {
 				Type:     schema.TypeString,
@@ -107,8 +110,8 @@ func resourceAlibabacloudStackAscmQuota() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	productName := strings.ToUpper(d.Get("product_name").(string))
 	quotaType := d.Get("quota_type").(string)
@@ -119,37 +122,19 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalGpu := d.Get("total_gpu").(int)
 		totalDiskCloudSsd := d.Get("total_disk_cloud_ssd").(int)
 		totalDiskCloudEfficiency := d.Get("total_disk_cloud_efficiency").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"regionName":      client.RegionId,
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCpu,
-				"totalMem", totalMem,
-				"totalGpu", totalGpu,
-				"totalDisk_cloud_ssd", totalDiskCloudSsd,
-				"totalDisk_cloud_efficiency", totalDiskCloudEfficiency,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCpu,
+			"totalMem", totalMem,
+			"totalGpu", totalGpu,
+			"totalDisk_cloud_ssd", totalDiskCloudSsd,
+			"totalDisk_cloud_efficiency", totalDiskCloudEfficiency,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
@@ -157,197 +142,125 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		log.Printf(" response of raw CreateQuota : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
 	} else if productName == "OSS" {
 		totalAmount := d.Get("total_amount").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
-				"totalAmount", totalAmount,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\"}",
+			"totalAmount", totalAmount,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
 	} else if productName == "EIP" {
 		totalEIP := d.Get("total_eip").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
-				"totalEIP", totalEIP,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\"}",
+			"totalEIP", totalEIP,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
 	} else if productName == "SLB" {
 		totalVipPublic := d.Get("total_vip_public").(int)
 		totalVipInternal := d.Get("total_vip_internal").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalVipPublic", totalVipPublic,
-				"totalVipInternal", totalVipInternal,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalVipPublic", totalVipPublic,
+			"totalVipInternal", totalVipInternal,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
 	} else if productName == "ODPS" {
 		totalCu := d.Get("total_cu").(int)
 		totalDisk := d.Get("total_disk").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCu", totalCu,
-				"totalDisk", totalDisk,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCu", totalCu,
+			"totalDisk", totalDisk,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
@@ -355,48 +268,30 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalCpu := d.Get("total_cpu").(int)
 		totalMem := d.Get("total_mem").(int)
 		totalDisk := d.Get("total_disk").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCpu,
-				"totalMem", totalMem,
-				"totalDisk", totalDisk,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCpu,
+			"totalMem", totalMem,
+			"totalDisk", totalDisk,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
@@ -405,48 +300,30 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalCpu := d.Get("total_cpu").(int)
 		totalMem := d.Get("total_mem").(int)
 		totalDisk := d.Get("total_disk").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      targetType,
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCpu,
-				"totalMem", totalMem,
-				"totalDisk", totalDisk,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = targetType
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCpu,
+			"totalMem", totalMem,
+			"totalDisk", totalDisk,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
@@ -454,139 +331,85 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalCpu := d.Get("total_cpu").(int)
 		totalMem := d.Get("total_mem").(int)
 		totalDisk := d.Get("total_disk").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCpu,
-				"totalMem", totalMem,
-				"totalDisk", totalDisk,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCpu,
+			"totalMem", totalMem,
+			"totalDisk", totalDisk,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
 	} else if productName == "R-KVSTORE" {
 		targetType := d.Get("target_type").(string)
 		totalMem := d.Get("total_mem").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      targetType,
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
-				"totalMem", totalMem,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = targetType
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\"}",
+			"totalMem", totalMem,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 
 	} else if productName == "VPC" {
 		totalVPC := d.Get("total_vpc").(int)
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":%d}",
-				"totalVPC", totalVPC,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "CreateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = quotaType
+		request.QueryParams["quotaTypeId"] = quotaTypeId
+		request.QueryParams["productName"] = productName
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":%d}",
+			"totalVPC", totalVPC,
+		)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", raw))
 		}
 		addDebug("CreateQuota", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "CreateQuota", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateQuota", raw, requestInfo, bresponse.GetHttpContentString())
 	} else {
@@ -595,18 +418,18 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(productName + COLON_SEPARATED + quotaType + COLON_SEPARATED + quotaTypeId)
 
-	return resourceAlibabacloudStackAscmQuotaUpdate(d, meta)
+	return resourceAlibabacloudStackAscmQuotaUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	attributeUpdate := false
 	check, err := ascmService.DescribeAscmQuota(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsQuotaExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsQuotaExist", AlibabacloudStackSdkGoERROR))
 	}
 	if did[0] == "VPC" {
 		var totalVPC int
@@ -623,40 +446,22 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			}
 			check.Data.TotalVPC = totalVPC
 		}
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
-				"totalVPC", totalVPC,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\"}",
+			"totalVPC", totalVPC,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -701,42 +506,24 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			}
 			check.Data.TotalCPU = totalCPU
 		}
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "MySql",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCPU,
-				"totalMem", totalMEM,
-				"totalDisk", totalDISK,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = "MySql"
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCPU,
+			"totalMem", totalMEM,
+			"totalDisk", totalDISK,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -756,40 +543,22 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			}
 			check.Data.TotalMem = totalEIP
 		}
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
-				"totalEIP", totalEIP,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\"}",
+			"totalEIP", totalEIP,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -857,44 +626,26 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			}
 			check.Data.TotalDiskCloudEfficiency = totalDCE
 		}
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCPU,
-				"totalMem", totalMEM,
-				"totalGpu", totalGPU,
-				"totalDisk_cloud_ssd", totalDCS,
-				"totalDisk_cloud_efficiency", totalDCE,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCPU,
+			"totalMem", totalMEM,
+			"totalGpu", totalGPU,
+			"totalDisk_cloud_ssd", totalDCS,
+			"totalDisk_cloud_efficiency", totalDCE,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -927,41 +678,23 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			check.Data.TotalVipPublic = totalVP
 		}
 
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalVipPublic", totalVP,
-				"totalVipInternal", totalVI,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalVipPublic", totalVP,
+			"totalVipInternal", totalVI,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -982,40 +715,22 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			check.Data.TotalAmount = totalAmount
 		}
 
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
-				"totalAmount", totalAmount,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\"}",
+			"totalAmount", totalAmount,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -1059,42 +774,24 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			}
 			check.Data.TotalCPU = totalCPU
 		}
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCPU,
-				"totalMem", totalMEM,
-				"totalDisk", totalDISK,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCPU,
+			"totalMem", totalMEM,
+			"totalDisk", totalDISK,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -1126,41 +823,23 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			}
 			check.Data.TotalCU = totalCU
 		}
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCu", totalCU,
-				"totalDisk", totalDISK,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCu", totalCU,
+			"totalDisk", totalDISK,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -1204,42 +883,24 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			}
 			check.Data.TotalCPU = totalCPU
 		}
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
-				"totalCpu", totalCPU,
-				"totalMem", totalMEM,
-				"totalDisk", totalDISK,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = ""
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
+			"totalCpu", totalCPU,
+			"totalMem", totalMEM,
+			"totalDisk", totalDISK,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
@@ -1260,129 +921,59 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 			check.Data.TotalMem = totalMEM
 		}
 
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "redis",
-			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
-				"totalMem", totalMEM,
-			),
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "UpdateQuota"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UpdateQuota", "")
+		request.QueryParams["regionName"] = client.RegionId
+		request.QueryParams["quotaType"] = did[1]
+		request.QueryParams["quotaTypeId"] = did[2]
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["targetType"] = "redis"
+		request.QueryParams["quotaBody"] = fmt.Sprintf("{\"%s\":\"%d\"}",
+			"totalMem", totalMEM,
+		)
 
 		if attributeUpdate {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_quota", "UpdateQuota", raw))
 			}
 			addDebug(request.GetActionName(), raw, request)
 		}
 	}
 
-	return resourceAlibabacloudStackAscmQuotaRead(d, meta)
+	return resourceAlibabacloudStackAscmQuotaRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
 	object, err := ascmService.DescribeAscmQuota(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if object.Code != "200" {
 		d.SetId("")
 		return nil
 	}
 
-	if did[0] == "VPC" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-		return nil
-	} else if did[0] == "OSS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-		return nil
-	} else if did[0] == "ECS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-		return nil
-	} else if did[0] == "SLB" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "EIP" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "RDS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "ODPS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "GPDB" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "R-KVSTORE" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "DDS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else {
+	d.Set("product_name", parts[0])
+	d.Set("quota_type", parts[1])
+	d.Set("quota_type_id", parts[2])
+	d.Set("quota_id", object.Data.ID)
 
+	if parts[0] == "DRDS" || parts[0] == "NAS" {
 		d.Set("region_name", object.Data.RegionName)
-		d.Set("product_name", object.Data.ProductName)
 		d.Set("cluster_name", object.Data.Cluster)
 		d.Set("total_cpu", object.Data.TotalCPU)
 		d.Set("total_mem", object.Data.TotalMem)
@@ -1394,44 +985,27 @@ func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interfa
 
 	return nil
 }
-func resourceAlibabacloudStackAscmQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmQuota(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, did[0], "IsQuotaExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, did[0], "IsQuotaExist", AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug("IsQuotaExist", check, requestInfo, map[string]string{"productName": did[0]})
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"RegionName ":     client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "DeleteQuota",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"productName":     did[0],
-			"QuotaType":       did[1],
-			"QuotaTypeId":     did[2],
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		request.Scheme = "http"
-		request.ApiName = "DeleteQuota"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "DeleteQuota", "")
+		request.QueryParams["RegionName "] = client.RegionId
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["productName"] = did[0]
+		request.QueryParams["QuotaType"] = did[1]
+		request.QueryParams["QuotaTypeId"] = did[2]
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -34,6 +34,12 @@ func TestAccAlibabacloudStackAscm_QuotaBasic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"total_cpu", "total_mem", "total_gpu", "total_disk_cloud_ssd", "total_disk_cloud_efficiency"},
+			},
 		},
 	})
 
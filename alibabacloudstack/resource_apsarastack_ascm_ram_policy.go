@@ -1,15 +1,15 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackAscmRamPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamPolicyCreate,
-		Read:   resourceAlibabacloudStackAscmRamPolicyRead,
-		Update: resourceAlibabacloudStackAscmRamPolicyUpdate,
-		Delete: resourceAlibabacloudStackAscmRamPolicyDelete,
+		CreateContext: resourceAlibabacloudStackAscmRamPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackAscmRamPolicyRead,
+		UpdateContext: resourceAlibabacloudStackAscmRamPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmRamPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -47,8 +47,8 @@ func resourceAlibabacloudStackAscmRamPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmRamPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
 	name := d.Get("name").(string)
@@ -58,52 +58,30 @@ func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta i
 	//resp := RamPolicies{}
 	check, err := ascmService.DescribeAscmRamPolicy(name)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "policy alreadyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "policy alreadyExist", AlibabacloudStackSdkGoERROR))
 	}
 	if len(check.Data) == 0 {
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "ascm",
-			"Action":          "CreateRAMPolicy",
-			"Version":         "2019-05-10",
-			"policyName":      name,
-			"description":     description,
-			"policyDocument":  policyDoc,
-		}
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "CreateRAMPolicy"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "CreateRAMPolicy", "")
+		request.QueryParams["policyName"] = name
+		request.QueryParams["description"] = description
+		request.QueryParams["policyDocument"] = policyDoc
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		log.Printf(" rsponse of CreateRAMPolicy : %s", raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "CreateRAMPolicy", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "CreateRAMPolicy", raw))
 		}
 		bresponse, _ := raw.(*responses.CommonResponse)
 
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "CreateRAMPolicy", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "CreateRAMPolicy", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateRAMPolicy", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmRamPolicy(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -112,12 +90,12 @@ func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta i
 	})
 	d.SetId(check.Data[0].PolicyName + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmRamPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 
 	response, err := ascmService.DescribeAscmRamPolicy(d.Id())
@@ -129,7 +107,7 @@ func resourceAlibabacloudStackAscmRamPolicyRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", did[0])
@@ -139,15 +117,15 @@ func resourceAlibabacloudStackAscmRamPolicyRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmRamPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	attributeUpdate := false
 	check, err := ascmService.DescribeAscmRamPolicy(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR))
 	}
 	var name, description, policydoc string
 
@@ -192,31 +170,13 @@ func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta i
 		}
 		check.Data[0].PolicyDocument = policydoc
 	}
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
-		"AccessKeyId":       client.AccessKey,
-		"Product":           "ascm",
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Action":            "UpdateRAMPolicy",
-		"Version":           "2019-05-10",
-		"ProductName":       "ascm",
-		"RamPolicyId":       did[1],
-		"NewPolicyName":     name,
-		"NewDescription":    description,
-		"NewPolicyDocument": policydoc,
-	}
-	request.Domain = client.Domain
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.ApiName = "UpdateRAMPolicy"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "UpdateRAMPolicy", "")
 	//check.Data[0].ID = d.Id()
+	request.QueryParams["ProductName"] = "ascm"
+	request.QueryParams["RamPolicyId"] = did[1]
+	request.QueryParams["NewPolicyName"] = name
+	request.QueryParams["NewDescription"] = description
+	request.QueryParams["NewPolicyDocument"] = policydoc
 
 	if attributeUpdate {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -225,7 +185,7 @@ func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta i
 		log.Printf(" response of raw UpdateRAMPolicy : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "UpdateRAMPolicy", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_policy", "UpdateRAMPolicy", raw))
 		}
 		addDebug(request.GetActionName(), raw, request)
 		log.Printf("total QueryParams and rampolicy %v %v", request.GetQueryParams(), name)
@@ -239,49 +199,25 @@ func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta i
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmRamPolicyRead(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmRamPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmRamPolicy(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsPolicyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsPolicyExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsPolicyExist", check, requestInfo, map[string]string{"policyName": did[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "ascm",
-			"Action":          "RemoveRAMPolicy",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"ramPolicyId":     did[1],
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RemoveRAMPolicy"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveRAMPolicy", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["ramPolicyId"] = did[1]
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -33,6 +33,11 @@ func TestAccAlibabacloudStackAscm_RamPolicyForRoleBasic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 
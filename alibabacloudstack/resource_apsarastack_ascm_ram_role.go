@@ -1,15 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmRamRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamRoleCreate,
-		Read:   resourceAlibabacloudStackAscmRamRoleRead,
-		Update: resourceAlibabacloudStackAscmRamRoleUpdate,
-		Delete: resourceAlibabacloudStackAscmRamRoleDelete,
+		CreateContext: resourceAlibabacloudStackAscmRamRoleCreate,
+		ReadContext:   resourceAlibabacloudStackAscmRamRoleRead,
+		UpdateContext: resourceAlibabacloudStackAscmRamRoleUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmRamRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:         schema.TypeString,
@@ -47,8 +51,8 @@ func resourceAlibabacloudStackAscmRamRole() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmRamRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
 	name := d.Get("role_name").(string)
@@ -57,41 +61,16 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 	organizationvisibility := d.Get("organization_visibility").(string)
 	check, err := ascmService.DescribeAscmRamRole(name)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_role", "role alreadyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_role", "role alreadyExist", AlibabacloudStackSdkGoERROR))
 	}
 	if len(check.Data) == 0 {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":               client.RegionId,
-			"AccessKeySecret":        client.SecretKey,
-			"Department":             client.Department,
-			"ResourceGroup":          client.ResourceGroup,
-			"Product":                "ascm",
-			"Action":                 "CreateRole",
-			"Version":                "2019-05-10",
-			"ProductName":            "ascm",
-			"roleName":               name,
-			"description":            description,
-			"roleRange":              rolerange,
-			"roleType":               "ROLETYPE_RAM",
-			"organizationVisibility": organizationvisibility,
-		}
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "CreateRole"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "CreateRole", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["roleName"] = name
+		request.QueryParams["description"] = description
+		request.QueryParams["roleRange"] = rolerange
+		request.QueryParams["roleType"] = "ROLETYPE_RAM"
+		request.QueryParams["organizationVisibility"] = organizationvisibility
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
@@ -99,18 +78,18 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 		log.Printf(" rsponse of CreateRole : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_role", "CreateRole", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_role", "CreateRole", raw))
 		}
 		addDebug("CreateRole", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_role", "CreateRole", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ram_role", "CreateRole", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateRole", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmRamRole(name)
 		if err != nil {
 			if IsThrottling(err) {
@@ -122,81 +101,61 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DescribeAscmRamRole", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DescribeAscmRamRole", AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
-	return resourceAlibabacloudStackAscmRamRoleUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRamRoleUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmRamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmRamRoleRead(d, meta)
+func resourceAlibabacloudStackAscmRamRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceAlibabacloudStackAscmRamRoleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
 	object, err := ascmService.DescribeAscmRamRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if strings.Contains(object.Data[0].OrganizationVisibility, "organizationVisibility.") {
 		object.Data[0].OrganizationVisibility = strings.TrimPrefix(object.Data[0].OrganizationVisibility, "organizationVisibility.")
 	}
-	d.Set("role_name", did[0])
+	d.Set("role_name", parts[0])
 	d.Set("organization_visibility", object.Data[0].OrganizationVisibility)
 	d.Set("role_id", object.Data[0].ID)
 	d.Set("description", object.Data[0].Description)
+	d.Set("role_range", object.Data[0].RoleRange)
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmRamRole(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRamRoleExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRamRoleExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsRamRoleExist", check, requestInfo, map[string]string{"roleName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveRole",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"roleName":        did[0],
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RemoveRole"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveRole", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["roleName"] = did[0]
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -212,7 +171,7 @@ func resourceAlibabacloudStackAscmRamRoleDelete(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveRole", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveRole", AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
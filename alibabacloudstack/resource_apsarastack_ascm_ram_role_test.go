@@ -33,6 +33,11 @@ func TestAccAlibabacloudStackAscm_RamRoleBasic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 
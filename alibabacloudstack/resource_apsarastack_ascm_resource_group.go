@@ -1,16 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmResourceGroupCreate,
-		Read:   resourceAlibabacloudStackAscmResourceGroupRead,
-		Update: resourceAlibabacloudStackAscmResourceGroupUpdate,
-		Delete: resourceAlibabacloudStackAscmResourceGroupDelete,
+		CreateContext: resourceAlibabacloudStackAscmResourceGroupCreate,
+		ReadContext:   resourceAlibabacloudStackAscmResourceGroupRead,
+		UpdateContext: resourceAlibabacloudStackAscmResourceGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmResourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -40,47 +43,26 @@ func resourceAlibabacloudStackAscmResourceGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 
 	name := d.Get("name").(string)
 	check, err := ascmService.DescribeAscmResourceGroup(name)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "RG alreadyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "RG alreadyExist", AlibabacloudStackSdkGoERROR))
 	}
 	organizationid := d.Get("organization_id").(string)
 
 	if len(check.Data) == 0 {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":            client.RegionId,
-			"AccessKeySecret":     client.SecretKey,
-			"Product":             "Ascm",
-			"Action":              "CreateResourceGroup",
-			"Version":             "2019-05-10",
-			"ProductName":         "ascm",
-			"resource_group_name": name,
-			"organization_id":     organizationid,
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "CreateResourceGroup"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json", "Content-Type": "application/json"}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateResourceGroup", "")
+		request.Headers["x-acs-content-type"] = "application/json"
+		request.Headers["Content-Type"] = "application/json"
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["resource_group_name"] = name
+		request.QueryParams["organization_id"] = organizationid
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
@@ -88,17 +70,17 @@ func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, me
 		log.Printf("response of raw CreateResourceGroup : %s", raw)
 		addDebug("CreateResourceGroup", raw, request, request.QueryParams)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "CreateResourceGroup", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "CreateResourceGroup", raw))
 		}
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "CreateResourceGroup", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "CreateResourceGroup", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("CreateResourceGroup", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmResourceGroup(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -107,12 +89,12 @@ func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, me
 	})
 	d.SetId(check.Data[0].ResourceGroupName + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmResourceGroupUpdate(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	name := d.Get("name").(string)
 	attributeUpdate := false
@@ -120,7 +102,7 @@ func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, me
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR))
 	}
 
 	if d.HasChange("name") {
@@ -136,32 +118,12 @@ func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, me
 		check.Data[0].ResourceGroupName = name
 	}
 
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Product":           "ascm",
-		"Action":            "UpdateResourceGroup",
-		"Version":           "2019-05-10",
-		"resourceGroupName": name,
-		"id":                did[1],
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "UpdateResourceGroup", "")
 	request.SetHTTPSInsecure(true)
-	request.ApiName = "UpdateResourceGroup"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json", "Content-Type": "application/json"}
+	request.Headers["x-acs-content-type"] = "application/json"
+	request.Headers["Content-Type"] = "application/json"
+	request.QueryParams["resourceGroupName"] = name
+	request.QueryParams["id"] = did[1]
 
 	if attributeUpdate {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -170,83 +132,64 @@ func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, me
 		log.Printf(" response of raw UpdateResourceGroup : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "UpdateResourceGroup", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "UpdateResourceGroup", raw))
 		}
 		addDebug(request.GetActionName(), raw, request)
 
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmResourceGroupRead(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
 	object, err := ascmService.DescribeAscmResourceGroup(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("name", did[0])
-	d.Set("rg_id", did[1])
+	d.Set("name", parts[0])
+	d.Set("rg_id", parts[1])
 	d.Set("organization_id", strconv.Itoa(object.Data[0].OrganizationID))
 
 	return nil
 }
-func resourceAlibabacloudStackAscmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmResourceGroup(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsResourceGroupExist", check, requestInfo, map[string]string{"resourceGroupName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":          client.RegionId,
-			"AccessKeySecret":   client.SecretKey,
-			"Product":           "ascm",
-			"Action":            "RemoveResourceGroup",
-			"Version":           "2019-05-10",
-			"ProductName":       "ascm",
-			"resourceGroupName": did[0],
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RemoveResourceGroup"
-		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json", "Content-Type": "application/json"}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveResourceGroup", "")
+		request.Headers["x-acs-content-type"] = "application/json"
+		request.Headers["Content-Type"] = "application/json"
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["resourceGroupName"] = did[0]
 		raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
 		})
@@ -1,24 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackAscmResourceGroupUserAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate,
-		Read:   resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead,
-		Delete: resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -45,8 +44,8 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachment() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	//ascmService := AscmService{client}
 
@@ -71,43 +70,22 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.Re
 	//}
 	//if len(check.Data) == 0 {
 
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "BindAscmUserAndResourceGroup"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-
-	request.QueryParams = map[string]string{
-		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
-		"Product":           "Ascm",
-		"Action":            "BindAscmUserAndResourceGroup",
-		"Version":           "2019-05-10",
-		"ProductName":       "ascm",
-		"ascm_user_ids":     fmt.Sprintf("%s", userIds),
-		"resource_group_id": RgId,
-		//"X-acs-body": fmt.Sprintf("\"ascm_user_ids\":\"[\"5249\"]\""),
-	}
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "BindAscmUserAndResourceGroup", "")
+	//"X-acs-body": fmt.Sprintf("\"ascm_user_ids\":\"[\"5249\"]\""),
+	request.QueryParams["ProductName"] = "ascm"
+	request.QueryParams["ascm_user_ids"] = fmt.Sprintf("%s", userIds)
+	request.QueryParams["resource_group_id"] = RgId
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	log.Printf("response of raw BindAscmUserAndResourceGroup is : %s", raw)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group_user_attachment", "BindAscmUserAndResourceGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group_user_attachment", "BindAscmUserAndResourceGroup", raw))
 	}
 	addDebug("BindAscmUserAndResourceGroup", raw, requestInfo, request)
 	bresponse, _ := raw.(*responses.CommonResponse)
 	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group_user_attachment", "BindAscmUserAndResourceGroup", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group_user_attachment", "BindAscmUserAndResourceGroup", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("BindAscmUserAndResourceGroup", raw, requestInfo, bresponse.GetHttpContentString())
 	//}
@@ -120,12 +98,12 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.Re
 	//})
 	//log.Printf("CreateOrganization Test %+v", check)
 	d.SetId(RgId)
-	return resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	ascmService := &AscmService{client: client}
 	obj, err := ascmService.DescribeAscmResourceGroupUserAttachment(d.Id())
@@ -134,51 +112,27 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d *schema.Reso
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("rg_id", obj.ResourceGroupID)
 	//d.Set("user_ids", obj.AscmUserIds)
 
 	return nil
 }
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmResourceGroupUserAttachment(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"resourceGroupId": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "UnbindAscmUserAndResourceGroup",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"resourceGroupId": d.Id(),
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "UnbindAscmUserAndResourceGroup"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "UnbindAscmUserAndResourceGroup", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["resourceGroupId"] = d.Id()
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
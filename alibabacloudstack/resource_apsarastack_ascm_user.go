@@ -1,27 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackAscmUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserCreate,
-		Read:   resourceAlibabacloudStackAscmUserRead,
-		Update: resourceAlibabacloudStackAscmUserUpdate,
-		Delete: resourceAlibabacloudStackAscmUserDelete,
+		CreateContext: resourceAlibabacloudStackAscmUserCreate,
+		ReadContext:   resourceAlibabacloudStackAscmUserRead,
+		UpdateContext: resourceAlibabacloudStackAscmUserUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"cellphone_number": {
 				Type:     schema.TypeString,
@@ -73,8 +76,8 @@ func resourceAlibabacloudStackAscmUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
 	lname := d.Get("login_name").(string)
@@ -104,45 +107,24 @@ func resourceAlibabacloudStackAscmUserCreate(d *schema.ResourceData, meta interf
 
 	check, err := ascmService.DescribeAscmDeletedUser(lname)
 	if check.Data != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "\"Login Name already exist in Historical Users, try with a different name.\"", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_resource_group", "\"Login Name already exist in Historical Users, try with a different name.\"", AlibabacloudStackSdkGoERROR))
 	}
 	if check.Data == nil {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":         client.RegionId,
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "Ascm",
-			"Action":           "AddUser",
-			"Version":          "2019-05-10",
-			"ProductName":      "ascm",
-			"LoginName":        lname,
-			"DisplayName":      dname,
-			"CellphoneNum":     cellnum,
-			"MobileNationCode": mobnationcode,
-			"Email":            email,
-			"OrganizationId":   organizationid,
-			//"loginPolicyId":    fmt.Sprint(loginpolicyid),
-			"LoginPolicyId": fmt.Sprint(loginpolicyid),
-			//"LoginName":         dname,
-			//"userEmail":        email,
-			//"roleIdList": "[2,4]",
-		}
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "AddUser"
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json", "Content-Type": "application/json"}
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "AddUser", "")
+		//"loginPolicyId":    fmt.Sprint(loginpolicyid),
+		//"LoginName":         dname,
+		//"userEmail":        email,
+		//"roleIdList": "[2,4]",
+		request.Headers["x-acs-content-type"] = "application/json"
+		request.Headers["Content-Type"] = "application/json"
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["LoginName"] = lname
+		request.QueryParams["DisplayName"] = dname
+		request.QueryParams["CellphoneNum"] = cellnum
+		request.QueryParams["MobileNationCode"] = mobnationcode
+		request.QueryParams["Email"] = email
+		request.QueryParams["OrganizationId"] = organizationid
+		request.QueryParams["LoginPolicyId"] = fmt.Sprint(loginpolicyid)
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
@@ -150,14 +132,14 @@ func resourceAlibabacloudStackAscmUserCreate(d *schema.ResourceData, meta interf
 		log.Printf("response of raw AddUser is : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "AddUser", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "AddUser", raw))
 		}
 
 		addDebug("AddUser", raw, requestInfo, request)
 
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "AddUser", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "AddUser", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("AddUser", raw, requestInfo, bresponse.GetHttpContentString())
 	}
@@ -169,12 +151,12 @@ func resourceAlibabacloudStackAscmUserCreate(d *schema.ResourceData, meta interf
 	}
 	log.Printf("response of raw ExportInitPasswordByLoginName is : %s", init_password)
 
-	return resourceAlibabacloudStackAscmUserUpdate(d, meta)
+	return resourceAlibabacloudStackAscmUserUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	lname := d.Get("login_name").(string)
 	organizationid := d.Get("organization_id").(string)
 	var dname, cellnum, mobnationcode, email string
@@ -206,91 +188,47 @@ func resourceAlibabacloudStackAscmUserUpdate(d *schema.ResourceData, meta interf
 		loginpolicyid = d.Get("login_policy_id").(int)
 	}
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	request.QueryParams = map[string]string{
-		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
-		"Product":          "ascm",
-		"Department":       client.Department,
-		"ResourceGroup":    client.ResourceGroup,
-		"Action":           "ModifyUserInformation",
-		"Version":          "2019-05-10",
-		"ProductName":      "ascm",
-		"loginName":        lname,
-		"display_name":     dname,
-		"cellphone_num":    cellnum,
-		"mobileNationCode": mobnationcode,
-		"email":            email,
-		"organization_id":  organizationid,
-		"loginPolicyId":    fmt.Sprint(loginpolicyid),
-		"policyId":         fmt.Sprint(loginpolicyid),
-	}
-	request.Domain = client.Domain
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ModifyUserInformation"
-	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json", "Content-Type": "application/json"}
+	request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "ModifyUserInformation", "")
+	request.Headers["x-acs-content-type"] = "application/json"
+	request.Headers["Content-Type"] = "application/json"
+	request.QueryParams["ProductName"] = "ascm"
+	request.QueryParams["loginName"] = lname
+	request.QueryParams["display_name"] = dname
+	request.QueryParams["cellphone_num"] = cellnum
+	request.QueryParams["mobileNationCode"] = mobnationcode
+	request.QueryParams["email"] = email
+	request.QueryParams["organization_id"] = organizationid
+	request.QueryParams["loginPolicyId"] = fmt.Sprint(loginpolicyid)
+	request.QueryParams["policyId"] = fmt.Sprint(loginpolicyid)
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	addDebug("ModifyUserInformation", raw, request, request.QueryParams)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ModifyUserInformationRequestFailed", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ModifyUserInformationRequestFailed", raw))
 	}
 	bresponse, _ := raw.(*responses.CommonResponse)
 	if !bresponse.IsSuccess() {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ModifyUserInformationFailed", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ModifyUserInformationFailed", raw))
 	}
 	err = json.Unmarshal(bresponse.GetHttpContentBytes(), bresponse)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(d.Get("role_ids").(*schema.Set).List()) > 0 {
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 		var requestInfo *ecs.Client
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-
-		request.Headers["x-ascm-product-name"] = "ascm"
-		request.Headers["x-ascm-product-version"] = "2019-05-10"
-
 		QueryParams := map[string]interface{}{
 			"loginName":  lname,
 			"roleIdList": roleIdList,
 		}
-
-		request.Method = "POST"
-		request.Product = "Ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
 		requeststring, err := json.Marshal(QueryParams)
-
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.Headers["Content-Type"] = requests.Json
+		request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ResetRolesForUserByLoginName", "/roa/ascm/auth/user/ResetRolesForUserByLoginName")
 		request.SetContent(requeststring)
-		request.PathPattern = "/roa/ascm/auth/user/ResetRolesForUserByLoginName"
-		request.ApiName = "ResetRolesForUserByLoginName"
-		request.RegionId = client.RegionId
-		request.Headers["RegionId"] = client.RegionId
+		request.Headers["x-ascm-product-name"] = "ascm"
+		request.Headers["x-ascm-product-version"] = "2019-05-10"
+		request.Headers["Content-Type"] = requests.Json
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
@@ -299,19 +237,19 @@ func resourceAlibabacloudStackAscmUserUpdate(d *schema.ResourceData, meta interf
 		log.Printf("response of raw ResetRolesForUserByLoginName is : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ResetRolesForUserByLoginName", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ResetRolesForUserByLoginName", raw))
 		}
 
 		addDebug("ResetRolesForUserByLoginName", raw, requestInfo, request)
 	}
-	return resourceAlibabacloudStackAscmUserRead(d, meta)
+	return resourceAlibabacloudStackAscmUserRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmUser(d.Id())
 	if err != nil {
@@ -319,7 +257,7 @@ func resourceAlibabacloudStackAscmUserRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
@@ -348,45 +286,23 @@ func resourceAlibabacloudStackAscmUserRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmUser(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsUserExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsUserExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsUserExist", check, requestInfo, map[string]string{"loginName": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveUserByLoginName",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"loginName":       d.Id(),
-		}
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RemoveUserByLoginName"
-		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json", "Content-Type": "application/json"}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveUserByLoginName", "")
+		request.Headers["x-acs-content-type"] = "application/json"
+		request.Headers["Content-Type"] = "application/json"
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["loginName"] = d.Id()
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
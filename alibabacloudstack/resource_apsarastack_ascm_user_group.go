@@ -1,26 +1,29 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"strconv"
-	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackAscmUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupRead,
-		Update: resourceAlibabacloudStackAscmUserGroupUpdate,
-		Delete: resourceAlibabacloudStackAscmUserGroupDelete,
+		CreateContext: resourceAlibabacloudStackAscmUserGroupCreate,
+		ReadContext:   resourceAlibabacloudStackAscmUserGroupRead,
+		UpdateContext: resourceAlibabacloudStackAscmUserGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmUserGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:     schema.TypeString,
@@ -48,8 +51,8 @@ func resourceAlibabacloudStackAscmUserGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 
 	groupName := d.Get("group_name").(string)
@@ -65,41 +68,17 @@ func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	request.Headers["x-ascm-product-name"] = "ascm"
-	request.Headers["x-ascm-product-version"] = "2019-05-10"
-
 	QueryParams := map[string]interface{}{
-		"groupName":        groupName,
-		"organizationId":   organizationId,
-		"roleIdList":       loginNamesList,
-		"SecurityToken":    client.Config.SecurityToken,
-		"SignatureVersion": "1.0",
-		"SignatureMethod":  "HMAC-SHA1",
+		"groupName":      groupName,
+		"organizationId": organizationId,
+		"roleIdList":     loginNamesList,
 	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
 	requeststring, err := json.Marshal(QueryParams)
-
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["Content-Type"] = requests.Json
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "CreateUserGroup", "/roa/ascm/auth/user/createUserGroup")
 	request.SetContent(requeststring)
-	request.PathPattern = "/roa/ascm/auth/user/createUserGroup"
-	request.ApiName = "CreateUserGroup"
-	request.RegionId = client.RegionId
-	request.Headers["RegionId"] = client.RegionId
+	request.Headers["x-ascm-product-name"] = "ascm"
+	request.Headers["x-ascm-product-version"] = "2019-05-10"
+	request.Headers["Content-Type"] = requests.Json
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -108,7 +87,7 @@ func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta i
 	log.Printf("response of raw CreateUserGroup is : %s", raw)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group", "CreateUserGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group", "CreateUserGroup", raw))
 	}
 
 	addDebug("CreateUserGroup", raw, requestInfo, request)
@@ -116,24 +95,24 @@ func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta i
 	bresponse, _ := raw.(*responses.CommonResponse)
 
 	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group", "CreateUserGroup", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group", "CreateUserGroup", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("CreateUserGroup", raw, requestInfo, bresponse.GetHttpContentString())
 
 	d.SetId(groupName)
 
-	return resourceAlibabacloudStackAscmUserGroupUpdate(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	return resourceAlibabacloudStackAscmUserGroupRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmUserGroup(d.Id())
 	if err != nil {
@@ -141,7 +120,7 @@ func resourceAlibabacloudStackAscmUserGroupRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
@@ -163,49 +142,22 @@ func resourceAlibabacloudStackAscmUserGroupRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 
 	check, err := ascmService.DescribeAscmUserGroup(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsUserGroupExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsUserGroupExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsUserGroupExist", check, requestInfo, map[string]string{"groupName": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":         client.RegionId,
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "ascm",
-			"Action":           "DeleteUserGroup",
-			"Version":          "2019-05-10",
-			"ProductName":      "ascm",
-			"userGroupId":      strconv.Itoa(check.Data[0].Id),
-			"SecurityToken":    client.Config.SecurityToken,
-			"SignatureVersion": "1.0",
-			"SignatureMethod":  "HMAC-SHA1",
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "DeleteUserGroup"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "DeleteUserGroup", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["userGroupId"] = strconv.Itoa(check.Data[0].Id)
 
 		raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -1,24 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strconv"
-	"strings"
-	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackAscmUserGroupResourceSetBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead,
-		Delete: resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete,
+		CreateContext: resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate,
+		ReadContext:   resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead,
+		DeleteContext: resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -42,8 +41,8 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBinding() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	//ascmService := AscmService{client}
 
@@ -68,43 +67,22 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.Re
 	//}
 	//if len(check.Data) == 0 {
 	ascmRoleId := d.Get("ascm_role_id").(string)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "AddResourceSetToUserGroup"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ascm",
-		"Action":          "AddResourceSetToUserGroup",
-		"Version":         "2019-05-10",
-		"ProductName":     "ascm",
-		"userGroupId":     userGroupId,
-		"resourceSetId":   resourceSetId,
-		"ascmRoleId":      ascmRoleId,
-	}
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "AddResourceSetToUserGroup", "")
+	request.QueryParams["ProductName"] = "ascm"
+	request.QueryParams["userGroupId"] = userGroupId
+	request.QueryParams["resourceSetId"] = resourceSetId
+	request.QueryParams["ascmRoleId"] = ascmRoleId
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	log.Printf("response of raw AddResourceSetToUserGroup is : %s", raw)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group_resource_set_binding", "AddResourceSetToUserGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group_resource_set_binding", "AddResourceSetToUserGroup", raw))
 	}
 	addDebug("AddResourceSetToUserGroup", raw, requestInfo, request)
 	bresponse, _ := raw.(*responses.CommonResponse)
 	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group_resource_set_binding", "AddResourceSetToUserGroup", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_group_resource_set_binding", "AddResourceSetToUserGroup", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("AddResourceSetToUserGroup", raw, requestInfo, bresponse.GetHttpContentString())
 	//}
@@ -117,12 +95,12 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.Re
 	//})
 	//log.Printf("CreateOrganization Test %+v", check)
 	d.SetId(resourceSetId)
-	return resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	ascmService := &AscmService{client: client}
 	obj, err := ascmService.DescribeAscmUserGroupResourceSetBinding(d.Id())
@@ -131,52 +109,28 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d *schema.Reso
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("resource_set_id", strconv.Itoa(obj.Data[0].Id))
 
 	return nil
 }
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmUserGroupResourceSetBinding(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"resourceGroupId": d.Id()})
 	userGroupId := d.Get("user_group_id").(string)
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveResourceSetFromUserGroup",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"userGroupId":     userGroupId,
-			"resourceSetId":   d.Id(),
-		}
-
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RemoveResourceSetFromUserGroup"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveResourceSetFromUserGroup", "")
+		request.QueryParams["ProductName"] = "ascm"
+		request.QueryParams["userGroupId"] = userGroupId
+		request.QueryParams["resourceSetId"] = d.Id()
 
 		raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
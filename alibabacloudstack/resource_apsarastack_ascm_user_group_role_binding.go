@@ -1,27 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackAscmUserGroupRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupRoleBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupRoleBindingRead,
-		Update: resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackAscmUserGroupRoleBindingDelete,
+		CreateContext: resourceAlibabacloudStackAscmUserGroupRoleBindingCreate,
+		ReadContext:   resourceAlibabacloudStackAscmUserGroupRoleBindingRead,
+		UpdateContext: resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmUserGroupRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Type:     schema.TypeInt,
@@ -36,8 +39,8 @@ func resourceAlibabacloudStackAscmUserGroupRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	userGroupId := d.Get("user_group_id").(int)
 	flag := false
@@ -49,50 +52,24 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceD
 	flag = true
 	if flag {
 		for i := range roleids {
-			request := requests.NewCommonRequest()
-			if client.Config.Insecure {
-				request.SetHTTPSInsecure(client.Config.Insecure)
-			}
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "Ascm",
-				"Action":           "AddRoleToUserGroup",
-				"Version":          "2019-05-10",
-				"ProductName":      "ascm",
-				"userGroupId":      strconv.Itoa(userGroupId),
-				"RoleId":           fmt.Sprint(roleids[i]),
-				"SecurityToken":    client.Config.SecurityToken,
-				"SignatureVersion": "1.0",
-				"SignatureMethod":  "HMAC-SHA1",
-			}
-			request.Method = "POST"
-			request.Product = "Ascm"
-			request.Version = "2019-05-10"
-			request.ServiceCode = "ascm"
-			request.Domain = client.Domain
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.ApiName = "AddRoleToUserGroup"
-			request.RegionId = client.RegionId
-			request.Headers = map[string]string{"RegionId": client.RegionId}
+			request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "AddRoleToUserGroup", "")
+			request.QueryParams["ProductName"] = "ascm"
+			request.QueryParams["userGroupId"] = strconv.Itoa(userGroupId)
+			request.QueryParams["RoleId"] = fmt.Sprint(roleids[i])
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			log.Printf("response of raw AddRoleToUserGroup Role(%d) is : %s", roleids[i], raw)
 
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_role_binding", "AddRoleToUserGroup", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_role_binding", "AddRoleToUserGroup", raw))
 			}
 
 			addDebug("AddRoleToUserGroup", raw, requestInfo, request)
 
 			bresponse, _ := raw.(*responses.CommonResponse)
 			if bresponse.GetHttpStatus() != 200 {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_role_binding", "AddRoleToUserGroup", AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_role_binding", "AddRoleToUserGroup", AlibabacloudStackSdkGoERROR))
 			}
 			addDebug("AddRoleToUserGroup", raw, requestInfo, bresponse.GetHttpContentString())
 			log.Printf("response of queryparams AddRoleToUserGroup is : %s", request.QueryParams)
@@ -102,12 +79,12 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceD
 
 	d.SetId(strconv.Itoa(userGroupId))
 
-	return resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmUserGroupRoleBinding(d.Id())
 	if err != nil {
@@ -115,19 +92,27 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
 		return nil
 	}
-	atoi, err := strconv.Atoi(d.Id())
-	d.Set("user_group_id", atoi)
+	userGroupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.Set("user_group_id", userGroupId)
+	var roleIds []int
+	for _, role := range object.Data[0].Roles {
+		roleIds = append(roleIds, role.Id)
+	}
+	d.Set("role_ids", roleIds)
 
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var roleIdList []string
 
 	if v, ok := d.GetOk("role_ids"); ok {
@@ -138,42 +123,18 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(d *schema.ResourceD
 		}
 	}
 	user_group_id := d.Get("user_group_id").(int)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	request.Headers["x-ascm-product-name"] = "ascm"
-	request.Headers["x-ascm-product-version"] = "2019-05-10"
-
 	QueryParams := map[string]interface{}{
-		"userGroupId":      strconv.Itoa(user_group_id),
-		"roleIdList":       roleIdList,
-		"SecurityToken":    client.Config.SecurityToken,
-		"SignatureVersion": "1.0",
-		"SignatureMethod":  "HMAC-SHA1",
+		"userGroupId": strconv.Itoa(user_group_id),
+		"roleIdList":  roleIdList,
 	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
 	requeststring, _ := json.Marshal(QueryParams)
-
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["Content-Type"] = requests.Json
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ResetRolesForUserGroup", "/roa/ascm/auth/user/resetRolesForUserGroup")
 	request.SetContent(requeststring)
-	request.PathPattern = "/roa/ascm/auth/user/resetRolesForUserGroup"
-	request.ApiName = "ResetRolesForUserGroup"
-	request.RegionId = client.RegionId
-	request.Headers["RegionId"] = client.RegionId
+	request.Headers["x-ascm-product-name"] = "ascm"
+	request.Headers["x-ascm-product-version"] = "2019-05-10"
+	request.Headers["Content-Type"] = requests.Json
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -182,17 +143,17 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(d *schema.ResourceD
 	log.Printf("response of raw ResetRolesForUserGroup is : %s", raw)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ResetRolesForUserGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ResetRolesForUserGroup", raw))
 	}
 
 	addDebug("ResetRolesForUserGroup", raw, requestInfo, request)
-	return resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupRoleBindingRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	var roleid int
@@ -215,43 +176,16 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(d *schema.ResourceD
 	log.Printf("roleids is %v", roleids)
 	check, err := ascmService.DescribeAscmUserGroupRoleBinding(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"userGroupId": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if flag {
-			request := requests.NewCommonRequest()
-			if client.Config.Insecure {
-				request.SetHTTPSInsecure(client.Config.Insecure)
-			}
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "ascm",
-				"Action":           "RemoveRoleFromUserGroup",
-				"Version":          "2019-05-10",
-				"ProductName":      "ascm",
-				"userGroupId":      d.Id(),
-				"RoleId":           fmt.Sprint(roleid),
-				"SecurityToken":    client.Config.SecurityToken,
-				"SignatureVersion": "1.0",
-				"SignatureMethod":  "HMAC-SHA1",
-			}
-
-			request.Method = "POST"
-			request.Product = "ascm"
-			request.Version = "2019-05-10"
-			request.ServiceCode = "ascm"
-			request.Domain = client.Domain
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.ApiName = "RemoveRoleFromUserGroup"
-			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.RegionId = client.RegionId
+			request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveRoleFromUserGroup", "")
+			request.QueryParams["ProductName"] = "ascm"
+			request.QueryParams["userGroupId"] = d.Id()
+			request.QueryParams["RoleId"] = fmt.Sprint(roleid)
 
 			raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 				return csClient.ProcessCommonRequest(request)
@@ -270,7 +204,7 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(d *schema.ResourceD
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveRoleFromUserGroup", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveRoleFromUserGroup", AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
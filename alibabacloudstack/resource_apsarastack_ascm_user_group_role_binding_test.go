@@ -41,6 +41,11 @@ func TestAccAlibabacloudStackAscm_UserGroupRoleBinding(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 
@@ -49,6 +49,12 @@ func TestAccAlibabacloudStackAscm_User_Group_Basic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"role_in_ids"},
+			},
 		},
 	})
 
@@ -1,26 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
-	"time"
+	"strconv"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackAscmUserRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserRoleBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserRoleBindingRead,
-		Update: resourceAlibabacloudStackAscmUserRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackAscmUserRoleBindingDelete,
+		CreateContext: resourceAlibabacloudStackAscmUserRoleBindingCreate,
+		ReadContext:   resourceAlibabacloudStackAscmUserRoleBindingRead,
+		UpdateContext: resourceAlibabacloudStackAscmUserRoleBindingUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmUserRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"login_name": {
 				Type:     schema.TypeString,
@@ -36,8 +40,8 @@ func resourceAlibabacloudStackAscmUserRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	lname := d.Get("login_name").(string)
 	flag := false
@@ -49,50 +53,24 @@ func resourceAlibabacloudStackAscmUserRoleBindingCreate(d *schema.ResourceData,
 	flag = true
 	if flag {
 		for i := range roleids {
-			request := requests.NewCommonRequest()
-			if client.Config.Insecure {
-				request.SetHTTPSInsecure(client.Config.Insecure)
-			}
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "Ascm",
-				"Action":           "AddRoleToUser",
-				"Version":          "2019-05-10",
-				"ProductName":      "ascm",
-				"LoginName":        lname,
-				"RoleId":           fmt.Sprint(roleids[i]),
-				"SecurityToken":    client.Config.SecurityToken,
-				"SignatureVersion": "1.0",
-				"SignatureMethod":  "HMAC-SHA1",
-			}
-			request.Method = "POST"
-			request.Product = "Ascm"
-			request.Version = "2019-05-10"
-			request.ServiceCode = "ascm"
-			request.Domain = client.Domain
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.ApiName = "AddRoleToUser"
-			request.RegionId = client.RegionId
-			request.Headers = map[string]string{"RegionId": client.RegionId}
+			request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "AddRoleToUser", "")
+			request.QueryParams["ProductName"] = "ascm"
+			request.QueryParams["LoginName"] = lname
+			request.QueryParams["RoleId"] = fmt.Sprint(roleids[i])
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ProcessCommonRequest(request)
 			})
 			log.Printf("response of raw AddRoleToUser Role(%s) is : %s", roleids[i], raw)
 
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_role_binding", "AddRoleToUser", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_role_binding", "AddRoleToUser", raw))
 			}
 
 			addDebug("AddRoleToUser", raw, requestInfo, request)
 
 			bresponse, _ := raw.(*responses.CommonResponse)
 			if bresponse.GetHttpStatus() != 200 {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_role_binding", "AddRoleToUser", AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user_role_binding", "AddRoleToUser", AlibabacloudStackSdkGoERROR))
 			}
 			addDebug("AddRoleToUser", raw, requestInfo, bresponse.GetHttpContentString())
 			log.Printf("response of queryparams AddRoleToUser is : %s", request.QueryParams)
@@ -102,12 +80,12 @@ func resourceAlibabacloudStackAscmUserRoleBindingCreate(d *schema.ResourceData,
 
 	d.SetId(lname)
 
-	return resourceAlibabacloudStackAscmUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserRoleBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmUserRoleBinding(d.Id())
 	if err != nil {
@@ -115,18 +93,23 @@ func resourceAlibabacloudStackAscmUserRoleBindingRead(d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
 		return nil
 	}
 	d.Set("login_name", object.Data[0].LoginName)
+	var roleIds []string
+	for _, role := range object.Data[0].UserRoles {
+		roleIds = append(roleIds, strconv.Itoa(role.ID))
+	}
+	d.Set("role_ids", roleIds)
 
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var roleIdList []string
 
 	if v, ok := d.GetOk("role_ids"); ok {
@@ -137,42 +120,18 @@ func resourceAlibabacloudStackAscmUserRoleBindingUpdate(d *schema.ResourceData,
 		}
 	}
 	lname := d.Get("login_name").(string)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	request.Headers["x-ascm-product-name"] = "ascm"
-	request.Headers["x-ascm-product-version"] = "2019-05-10"
-
 	QueryParams := map[string]interface{}{
-		"loginName":        lname,
-		"roleIdList":       roleIdList,
-		"SecurityToken":    client.Config.SecurityToken,
-		"SignatureVersion": "1.0",
-		"SignatureMethod":  "HMAC-SHA1",
+		"loginName":  lname,
+		"roleIdList": roleIdList,
 	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
 	requeststring, err := json.Marshal(QueryParams)
-
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["Content-Type"] = requests.Json
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ResetRolesForUserByLoginName", "/roa/ascm/auth/user/ResetRolesForUserByLoginName")
 	request.SetContent(requeststring)
-	request.PathPattern = "/roa/ascm/auth/user/ResetRolesForUserByLoginName"
-	request.ApiName = "ResetRolesForUserByLoginName"
-	request.RegionId = client.RegionId
-	request.Headers["RegionId"] = client.RegionId
+	request.Headers["x-ascm-product-name"] = "ascm"
+	request.Headers["x-ascm-product-version"] = "2019-05-10"
+	request.Headers["Content-Type"] = requests.Json
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -181,16 +140,16 @@ func resourceAlibabacloudStackAscmUserRoleBindingUpdate(d *schema.ResourceData,
 	log.Printf("response of raw ResetRolesForUserByLoginName is : %s", raw)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ResetRolesForUserByLoginName", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_user", "ResetRolesForUserByLoginName", raw))
 	}
 
 	addDebug("ResetRolesForUserByLoginName", raw, requestInfo, request)
-	return resourceAlibabacloudStackAscmUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserRoleBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
 	var roleid int
@@ -213,42 +172,15 @@ func resourceAlibabacloudStackAscmUserRoleBindingDelete(d *schema.ResourceData,
 	log.Printf("roleids is %v", roleids)
 	check, err := ascmService.DescribeAscmUserRoleBinding(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"loginName": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if flag {
-			request := requests.NewCommonRequest()
-			if client.Config.Insecure {
-				request.SetHTTPSInsecure(client.Config.Insecure)
-			}
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "ascm",
-				"Action":           "RemoveRoleFromUser",
-				"Version":          "2019-05-10",
-				"ProductName":      "ascm",
-				"LoginName":        d.Id(),
-				"RoleId":           fmt.Sprint(roleid),
-				"SecurityToken":    client.Config.SecurityToken,
-				"SignatureVersion": "1.0",
-				"SignatureMethod":  "HMAC-SHA1",
-			}
-
-			request.Method = "POST"
-			request.Product = "ascm"
-			request.Version = "2019-05-10"
-			request.ServiceCode = "ascm"
-			request.Domain = client.Domain
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.ApiName = "RemoveRoleFromUser"
-			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.RegionId = client.RegionId
+			request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "RemoveRoleFromUser", "")
+			request.QueryParams["ProductName"] = "ascm"
+			request.QueryParams["LoginName"] = d.Id()
+			request.QueryParams["RoleId"] = fmt.Sprint(roleid)
 
 			_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 				return csClient.ProcessCommonRequest(request)
@@ -265,7 +197,7 @@ func resourceAlibabacloudStackAscmUserRoleBindingDelete(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveRoleFromUser", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveRoleFromUser", AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -40,6 +40,11 @@ func TestAccAlibabacloudStackAscm_UserRoleBinding(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 
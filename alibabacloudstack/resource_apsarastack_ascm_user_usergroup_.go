@@ -1,9 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"log"
-	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -11,14 +11,18 @@ import (
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	//"encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 func resourceAlibabacloudStackAscmUserGroupUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupUserCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupUserRead,
-		Update: resourceAlibabacloudStackAscmUserGroupUserUpdate,
-		Delete: resourceAlibabacloudStackAscmUserGroupUserDelete,
+		CreateContext: resourceAlibabacloudStackAscmUserGroupUserCreate,
+		ReadContext:   resourceAlibabacloudStackAscmUserGroupUserRead,
+		UpdateContext: resourceAlibabacloudStackAscmUserGroupUserUpdate,
+		DeleteContext: resourceAlibabacloudStackAscmUserGroupUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Type:     schema.TypeString,
@@ -39,8 +43,8 @@ func resourceAlibabacloudStackAscmUserGroupUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserGroupUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 
 	userGroupId := d.Get("user_group_id").(string)
@@ -54,44 +58,23 @@ func resourceAlibabacloudStackAscmUserGroupUserCreate(d *schema.ResourceData, me
 		}
 	}
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	request.Headers["x-ascm-product-name"] = "ascm"
-	request.Headers["x-ascm-product-version"] = "2019-05-10"
-
 	QueryParams := map[string]interface{}{
 		"userGroupId":   userGroupId,
 		"LoginNameList": loginNamesList,
 	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
 	requeststring, err := json.Marshal(QueryParams)
-
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["Content-Type"] = requests.Json
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "AddUsersToUserGroup", "/roa/ascm/auth/user/addUsersToUserGroup")
 	request.SetContent(requeststring)
-	request.PathPattern = "/roa/ascm/auth/user/addUsersToUserGroup"
-	request.ApiName = "AddUsersToUserGroup"
-	request.RegionId = client.RegionId
-	request.Headers["RegionId"] = client.RegionId
+	request.Headers["x-ascm-product-name"] = "ascm"
+	request.Headers["x-ascm-product-version"] = "2019-05-10"
+	request.Headers["Content-Type"] = requests.Json
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	log.Printf("response of raw AddUsersToUserGroup is : %s", raw)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw))
 	}
 
 	addDebug("AddUsersToUserGroup", raw, requestInfo, request)
@@ -99,19 +82,19 @@ func resourceAlibabacloudStackAscmUserGroupUserCreate(d *schema.ResourceData, me
 	bresponse, _ := raw.(*responses.CommonResponse)
 
 	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("AddUsersToUserGroup", raw, requestInfo, bresponse.GetHttpContentString())
 
 	d.SetId(userGroupId)
 
-	return resourceAlibabacloudStackAscmUserGroupUserRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmUsergroupUser(d.Id())
 	if err != nil {
@@ -119,7 +102,7 @@ func resourceAlibabacloudStackAscmUserGroupUserRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
@@ -131,15 +114,16 @@ func resourceAlibabacloudStackAscmUserGroupUserRead(d *schema.ResourceData, meta
 		loginNames = append(loginNames, data.LoginName)
 	}
 
+	d.Set("user_group_id", d.Id())
 	d.Set("login_names", loginNames)
 
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ascmService := AscmService{client}
 	object, err := ascmService.DescribeAscmUsergroupUser(d.Id())
 	if err != nil {
@@ -147,7 +131,7 @@ func resourceAlibabacloudStackAscmUserGroupUserUpdate(d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(object.Data) == 0 {
 		d.SetId("")
@@ -159,44 +143,23 @@ func resourceAlibabacloudStackAscmUserGroupUserUpdate(d *schema.ResourceData, me
 		loginNames = append(loginNames, data.LoginName)
 	}
 	userGroupId := d.Get("user_group_id").(string)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	request.Headers["x-ascm-product-name"] = "ascm"
-	request.Headers["x-ascm-product-version"] = "2019-05-10"
-
 	QueryParams := map[string]interface{}{
 		"userGroupId":   userGroupId,
 		"LoginNameList": loginNames,
 	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
 	requeststring, err := json.Marshal(QueryParams)
-
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["Content-Type"] = requests.Json
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "RemoveUsersFromUserGroup", "/roa/ascm/auth/user/RemoveUsersFromUserGroup")
 	request.SetContent(requeststring)
-	request.PathPattern = "/roa/ascm/auth/user/RemoveUsersFromUserGroup"
-	request.ApiName = "RemoveUsersFromUserGroup"
-	request.RegionId = client.RegionId
-	request.Headers["RegionId"] = client.RegionId
+	request.Headers["x-ascm-product-name"] = "ascm"
+	request.Headers["x-ascm-product-version"] = "2019-05-10"
+	request.Headers["Content-Type"] = requests.Json
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	log.Printf("response of raw AddUsersToUserGroup is : %s", raw)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw))
 	}
 	var loginNamesList []string
 
@@ -224,13 +187,13 @@ func resourceAlibabacloudStackAscmUserGroupUserUpdate(d *schema.ResourceData, me
 	log.Printf("response of raw AddUsersToUserGroup is : %s", raw)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw))
 	}
-	return resourceAlibabacloudStackAscmUserGroupUserRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackAscmUserGroupUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	var login_names []string
 	userGroupId := d.Get("user_group_id").(string)
@@ -238,44 +201,23 @@ func resourceAlibabacloudStackAscmUserGroupUserDelete(d *schema.ResourceData, me
 		login_names = expandStringList(v.(*schema.Set).List())
 	}
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
-	request.Headers["x-ascm-product-name"] = "ascm"
-	request.Headers["x-ascm-product-version"] = "2019-05-10"
-
 	QueryParams := map[string]interface{}{
 		"userGroupId":   userGroupId,
 		"LoginNameList": login_names,
 	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
 	requeststring, err := json.Marshal(QueryParams)
-
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["Content-Type"] = requests.Json
+	request := client.NewCommonRequest("POST", "Ascm", "2019-05-10", "RemoveUsersFromUserGroup", "/roa/ascm/auth/user/RemoveUsersFromUserGroup")
 	request.SetContent(requeststring)
-	request.PathPattern = "/roa/ascm/auth/user/RemoveUsersFromUserGroup"
-	request.ApiName = "RemoveUsersFromUserGroup"
-	request.RegionId = client.RegionId
-	request.Headers["RegionId"] = client.RegionId
+	request.Headers["x-ascm-product-name"] = "ascm"
+	request.Headers["x-ascm-product-version"] = "2019-05-10"
+	request.Headers["Content-Type"] = requests.Json
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	log.Printf("response of raw AddUsersToUserGroup is : %s", raw)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_usergroup_user", "AddUsersToUserGroup", raw))
 	}
 
 	return nil
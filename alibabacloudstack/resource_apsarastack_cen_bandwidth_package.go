@@ -0,0 +1,277 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackCenBandwidthPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackCenBandwidthPackageCreate,
+		ReadContext:   resourceAlibabacloudStackCenBandwidthPackageRead,
+		UpdateContext: resourceAlibabacloudStackCenBandwidthPackageUpdate,
+		DeleteContext: resourceAlibabacloudStackCenBandwidthPackageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
+			"geographic_region_a_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"geographic_region_b_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validation.StringInSlice([]string{string(PrePaid), string(PostPaid)}, false),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 6, 12}),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("charge_type").(string) == string(PostPaid)
+				},
+			},
+			"cen_bandwidth_package_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 256),
+			},
+			"cen_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expired_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	request := cbn.CreateCreateCenBandwidthPackageRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.Bandwidth = requests.NewInteger(d.Get("bandwidth").(int))
+	request.GeographicRegionAId = d.Get("geographic_region_a_id").(string)
+	request.GeographicRegionBId = d.Get("geographic_region_b_id").(string)
+	request.BandwidthPackageChargeType = d.Get("charge_type").(string)
+	if request.BandwidthPackageChargeType == string(PrePaid) {
+		request.Period = requests.NewInteger(d.Get("period").(int))
+		request.PricingCycle = "Month"
+		request.AutoPay = requests.NewBoolean(true)
+	}
+	request.Name = d.Get("cen_bandwidth_package_name").(string)
+	request.Description = d.Get("description").(string)
+	request.ClientToken = buildClientToken(request.GetActionName())
+
+	var response *cbn.CreateCenBandwidthPackageResponse
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.CreateCenBandwidthPackage(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ = raw.(*cbn.CreateCenBandwidthPackageResponse)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_bandwidth_package", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	d.SetId(response.CenBandwidthPackageId)
+	if err := cenService.WaitForCenBandwidthPackage(d.Id(), Idle, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	return resourceAlibabacloudStackCenBandwidthPackageUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	object, err := cenService.DescribeCenBandwidthPackage(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("bandwidth", object.Bandwidth)
+	d.Set("geographic_region_a_id", object.GeographicRegionAId)
+	d.Set("geographic_region_b_id", object.GeographicRegionBId)
+	d.Set("charge_type", object.BandwidthPackageChargeType)
+	d.Set("cen_bandwidth_package_name", object.Name)
+	d.Set("description", object.Description)
+	d.Set("status", object.Status)
+	d.Set("expired_time", object.ExpiredTime)
+	if len(object.CenIds.CenId) > 0 {
+		d.Set("cen_id", object.CenIds.CenId[0])
+	} else {
+		d.Set("cen_id", "")
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+	d.Partial(true)
+
+	if !d.IsNewResource() && d.HasChanges("cen_bandwidth_package_name", "description") {
+		request := cbn.CreateModifyCenBandwidthPackageAttributeRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.CenBandwidthPackageId = d.Id()
+		request.Name = d.Get("cen_bandwidth_package_name").(string)
+		request.Description = d.Get("description").(string)
+
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.ModifyCenBandwidthPackageAttribute(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	if !d.IsNewResource() && d.HasChange("bandwidth") {
+		request := cbn.CreateModifyCenBandwidthPackageSpecRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.CenBandwidthPackageId = d.Id()
+		request.Bandwidth = requests.NewInteger(d.Get("bandwidth").(int))
+
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.ModifyCenBandwidthPackageSpec(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	if d.HasChange("cen_id") {
+		oldCenId, newCenId := d.GetChange("cen_id")
+		if oldCenId.(string) != "" {
+			if err := cenService.UnassociateCenBandwidthPackage(d.Id(), oldCenId.(string), int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+		}
+		if newCenId.(string) != "" {
+			request := cbn.CreateAssociateCenBandwidthPackageRequest()
+			client.InitRpcRequest(request.RpcRequest)
+			request.CenBandwidthPackageId = d.Id()
+			request.CenId = newCenId.(string)
+
+			err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+					return cbnClient.AssociateCenBandwidthPackage(request)
+				})
+				if err != nil {
+					if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", Throttling}) {
+						time.Sleep(5 * time.Second)
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
+				}
+				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+				return nil
+			})
+			if err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+			}
+			if err := cenService.WaitForCenBandwidthPackage(d.Id(), InUse, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlibabacloudStackCenBandwidthPackageRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	if cenId := d.Get("cen_id").(string); cenId != "" {
+		if err := cenService.UnassociateCenBandwidthPackage(d.Id(), cenId, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	request := cbn.CreateDeleteCenBandwidthPackageRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenBandwidthPackageId = d.Id()
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.DeleteCenBandwidthPackage(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.BwpStatus", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterBwpInstanceId"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	return DiagnosticsFromError(WrapError(cenService.WaitForCenBandwidthPackage(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
@@ -0,0 +1,171 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackCenInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackCenInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackCenInstanceRead,
+		UpdateContext: resourceAlibabacloudStackCenInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackCenInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"cen_instance_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 256),
+			},
+			"protection_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "REDUCED",
+				ValidateFunc: validation.StringInSlice([]string{"REDUCED"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	request := cbn.CreateCreateCenRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.Name = d.Get("cen_instance_name").(string)
+	request.Description = d.Get("description").(string)
+	request.ProtectionLevel = d.Get("protection_level").(string)
+	request.ClientToken = buildClientToken(request.GetActionName())
+
+	var response *cbn.CreateCenResponse
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.CreateCen(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ = raw.(*cbn.CreateCenResponse)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	d.SetId(response.CenId)
+
+	stateConf := BuildStateConf([]string{"Creating"}, []string{"Active"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+
+	return resourceAlibabacloudStackCenInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	object, err := cenService.DescribeCenInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("cen_instance_name", object.Name)
+	d.Set("description", object.Description)
+	d.Set("protection_level", object.ProtectionLevel)
+	d.Set("status", object.Status)
+	return nil
+}
+
+func resourceAlibabacloudStackCenInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	if d.HasChanges("cen_instance_name", "description", "protection_level") {
+		request := cbn.CreateModifyCenAttributeRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.CenId = d.Id()
+		request.Name = d.Get("cen_instance_name").(string)
+		request.Description = d.Get("description").(string)
+		request.ProtectionLevel = d.Get("protection_level").(string)
+
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.ModifyCenAttribute(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	return resourceAlibabacloudStackCenInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	request := cbn.CreateDeleteCenRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenId = d.Id()
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.DeleteCen(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterCenInstanceId"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	stateConf := BuildStateConf([]string{"Active", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+	return nil
+}
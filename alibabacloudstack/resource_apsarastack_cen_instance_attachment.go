@@ -0,0 +1,185 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackCenInstanceAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackCenInstanceAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackCenInstanceAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackCenInstanceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{ChildInstanceTypeVpc, ChildInstanceTypeVbr, ChildInstanceTypeCcn}, false),
+			},
+			"child_instance_region_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_owner_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	cenId := d.Get("instance_id").(string)
+	instanceId := d.Get("child_instance_id").(string)
+	regionId := d.Get("child_instance_region_id").(string)
+	var err error
+	instanceType := d.Get("child_instance_type").(string)
+	if instanceType == "" {
+		if instanceType, err = GetCenChildInstanceType(instanceId); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	request := cbn.CreateAttachCenChildInstanceRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenId = cenId
+	request.ChildInstanceId = instanceId
+	request.ChildInstanceType = instanceType
+	request.ChildInstanceRegionId = regionId
+	if v, ok := d.GetOk("child_instance_owner_id"); ok {
+		request.ChildInstanceOwnerId = requests.NewInteger(v.(int))
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.AttachCenChildInstance(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", "InvalidOperation.ChildInstanceStatus", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_instance_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	d.SetId(cenId + COLON_SEPARATED + instanceId + COLON_SEPARATED + regionId)
+	if err := cenService.WaitForCenInstanceAttachment(d.Id(), Status("Attached"), int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	return resourceAlibabacloudStackCenInstanceAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	object, err := cenService.DescribeCenInstanceAttachment(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("instance_id", object.CenId)
+	d.Set("child_instance_id", object.ChildInstanceId)
+	d.Set("child_instance_type", object.ChildInstanceType)
+	d.Set("child_instance_region_id", object.ChildInstanceRegionId)
+	d.Set("child_instance_owner_id", object.ChildInstanceOwnerId)
+	d.Set("status", object.Status)
+	return nil
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	instanceType := d.Get("child_instance_type").(string)
+	if instanceType == "" {
+		if instanceType, err = GetCenChildInstanceType(parts[1]); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	request := cbn.CreateDetachCenChildInstanceRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenId = parts[0]
+	request.ChildInstanceId = parts[1]
+	request.ChildInstanceType = instanceType
+	request.ChildInstanceRegionId = parts[2]
+	if v, ok := d.GetOk("child_instance_owner_id"); ok {
+		request.ChildInstanceOwnerId = requests.NewInteger(v.(int))
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.DetachCenChildInstance(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", "InvalidOperation.ChildInstanceStatus", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterInstanceId", "ParameterCenInstanceId"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	return DiagnosticsFromError(WrapError(cenService.WaitForCenInstanceAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
@@ -0,0 +1,165 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackCenInstanceGrant() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackCenInstanceGrantCreate,
+		ReadContext:   resourceAlibabacloudStackCenInstanceGrantRead,
+		DeleteContext: resourceAlibabacloudStackCenInstanceGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cen_owner_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenInstanceGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpcService := VpcService{client}
+
+	cenId := d.Get("cen_id").(string)
+	instanceId := d.Get("child_instance_id").(string)
+	ownerId := d.Get("cen_owner_id").(string)
+	instanceType, err := GetCenChildInstanceType(instanceId)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	cenOwnerId, err := strconv.Atoi(ownerId)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	request := vpc.CreateGrantInstanceToCenRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenId = cenId
+	request.InstanceId = instanceId
+	request.InstanceType = instanceType
+	request.CenOwnerId = requests.NewInteger(cenOwnerId)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.GrantInstanceToCen(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "TaskConflict", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_instance_grant", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", cenId, COLON_SEPARATED, instanceId, COLON_SEPARATED, ownerId))
+	if err := vpcService.WaitForCenInstanceGrant(d.Id(), Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	return resourceAlibabacloudStackCenInstanceGrantRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpcService := VpcService{client}
+
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	object, err := vpcService.DescribeCenInstanceGrant(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("cen_id", object.CenInstanceId)
+	d.Set("child_instance_id", parts[1])
+	d.Set("cen_owner_id", fmt.Sprint(object.CenOwnerId))
+	return nil
+}
+
+func resourceAlibabacloudStackCenInstanceGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpcService := VpcService{client}
+
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	instanceType, err := GetCenChildInstanceType(parts[1])
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	cenOwnerId, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	request := vpc.CreateRevokeInstanceFromCenRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenId = parts[0]
+	request.InstanceId = parts[1]
+	request.InstanceType = instanceType
+	request.CenOwnerId = requests.NewInteger(cenOwnerId)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.RevokeInstanceFromCen(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "TaskConflict", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	return DiagnosticsFromError(WrapError(vpcService.WaitForCenInstanceGrant(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
@@ -0,0 +1,32 @@
+package alibabacloudstack
+
+import (
+	"testing"
+)
+
+func TestCenInstanceGrantId(t *testing.T) {
+	cases := []struct {
+		id           string
+		instanceType string
+		wantErr      bool
+	}{
+		{id: "cen-abc123456:vpc-abc123456:123456", instanceType: ChildInstanceTypeVpc},
+		{id: "cen-abc123456:vbr-abc123456:123456", instanceType: ChildInstanceTypeVbr},
+		{id: "cen-abc123456:ccn-abc123456:123456", instanceType: ChildInstanceTypeCcn},
+		{id: "cen-abc123456:vsw-abc123456:123456", wantErr: true},
+		{id: "cen-abc123456:vpc-abc123456", wantErr: true},
+	}
+	for _, c := range cases {
+		parts, err := ParseResourceId(c.id, 3)
+		if err == nil {
+			var instanceType string
+			instanceType, err = GetCenChildInstanceType(parts[1])
+			if err == nil && instanceType != c.instanceType {
+				t.Errorf("%s: got child instance type %q, want %q", c.id, instanceType, c.instanceType)
+			}
+		}
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, want error %v", c.id, err, c.wantErr)
+		}
+	}
+}
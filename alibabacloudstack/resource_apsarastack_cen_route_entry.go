@@ -0,0 +1,174 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackCenRouteEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackCenRouteEntryCreate,
+		ReadContext:   resourceAlibabacloudStackCenRouteEntryRead,
+		DeleteContext: resourceAlibabacloudStackCenRouteEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	cenId := d.Get("instance_id").(string)
+	instanceId := d.Get("child_instance_id").(string)
+	routeTableId := d.Get("route_table_id").(string)
+	cidrBlock := d.Get("cidr_block").(string)
+	instanceType, err := GetCenChildInstanceType(instanceId)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	request := cbn.CreatePublishRouteEntriesRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenId = cenId
+	request.ChildInstanceId = instanceId
+	request.ChildInstanceType = instanceType
+	request.ChildInstanceRegionId = client.RegionId
+	request.ChildInstanceRouteTableId = routeTableId
+	request.DestinationCidrBlock = cidrBlock
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.PublishRouteEntries(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", "InvalidStatus.RouteEntry", "InvalidOperation.ChildInstanceStatus", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_route_entry", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s%s%s", cenId, COLON_SEPARATED, instanceId, COLON_SEPARATED, routeTableId, COLON_SEPARATED, cidrBlock))
+	if err := cenService.WaitForCenRouteEntry(d.Id(), PUBLISHED, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	return resourceAlibabacloudStackCenRouteEntryRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	object, err := cenService.DescribeCenRouteEntry(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if object.PublishStatus == string(NOPUBLISHED) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("child_instance_id", parts[1])
+	d.Set("route_table_id", object.ChildInstanceRouteTableId)
+	d.Set("cidr_block", object.DestinationCidrBlock)
+	return nil
+}
+
+func resourceAlibabacloudStackCenRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	cenService := CenService{client}
+
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	instanceType, err := GetCenChildInstanceType(parts[1])
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	request := cbn.CreateWithdrawPublishedRouteEntriesRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CenId = parts[0]
+	request.ChildInstanceId = parts[1]
+	request.ChildInstanceType = instanceType
+	request.ChildInstanceRegionId = client.RegionId
+	request.ChildInstanceRouteTableId = parts[2]
+	request.DestinationCidrBlock = parts[3]
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.WithdrawPublishedRouteEntries(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", "InvalidStatus.RouteEntry", "InvalidOperation.ChildInstanceStatus", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterIllegal", "ParameterIllegalCenInstanceId"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+
+	return DiagnosticsFromError(WrapError(cenService.WaitForCenRouteEntry(d.Id(), NOPUBLISHED, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
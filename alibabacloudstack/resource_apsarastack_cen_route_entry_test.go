@@ -0,0 +1,94 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackCenRouteEntry_basic(t *testing.T) {
+	var v cbn.PublishedRouteEntry
+	resourceId := "alibabacloudstack_cen_route_entry.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+	serviceFunc := func() interface{} {
+		return &CenService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandIntRange(10000, 99999)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testacc-cenroute%v", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceCenRouteEntryConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_id":       "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+					"child_instance_id": "${alibabacloudstack_vpc.default.id}",
+					"route_table_id":    "${alibabacloudstack_vpc.default.route_table_id}",
+					"cidr_block":        "${alibabacloudstack_route_entry.default.destination_cidrblock}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_id":       CHECKSET,
+						"child_instance_id": CHECKSET,
+						"route_table_id":    CHECKSET,
+						"cidr_block":        "11.0.0.0/16",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceCenRouteEntryConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+variable "name" {
+  default = "%v"
+}
+
+resource "alibabacloudstack_instance" "default" {
+  security_groups      = ["${alibabacloudstack_security_group.default.id}"]
+  vswitch_id           = "${alibabacloudstack_vswitch.default.id}"
+  instance_type        = "${local.instance_type_id}"
+  system_disk_category = "cloud_efficiency"
+  image_id             = "${data.alibabacloudstack_images.default.images.0.id}"
+  instance_name        = "${var.name}"
+}
+
+resource "alibabacloudstack_route_entry" "default" {
+  route_table_id        = "${alibabacloudstack_vpc.default.route_table_id}"
+  destination_cidrblock = "11.0.0.0/16"
+  nexthop_type          = "Instance"
+  nexthop_id            = "${alibabacloudstack_instance.default.id}"
+}
+
+resource "alibabacloudstack_cen_instance" "default" {
+  cen_instance_name = "${var.name}"
+}
+
+resource "alibabacloudstack_cen_instance_attachment" "default" {
+  instance_id              = "${alibabacloudstack_cen_instance.default.id}"
+  child_instance_id        = "${alibabacloudstack_vpc.default.id}"
+  child_instance_region_id = "%s"
+}
+`, EcsInstanceCommonTestCase, name, defaultRegionToTest)
+}
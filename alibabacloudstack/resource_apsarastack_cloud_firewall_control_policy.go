@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackCloudFirewallControlPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCloudFirewallControlPolicyCreate,
-		Read:   resourceAlibabacloudStackCloudFirewallControlPolicyRead,
-		Update: resourceAlibabacloudStackCloudFirewallControlPolicyUpdate,
-		Delete: resourceAlibabacloudStackCloudFirewallControlPolicyDelete,
+		CreateContext: resourceAlibabacloudStackCloudFirewallControlPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackCloudFirewallControlPolicyRead,
+		UpdateContext: resourceAlibabacloudStackCloudFirewallControlPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackCloudFirewallControlPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -122,14 +124,14 @@ func resourceAlibabacloudStackCloudFirewallControlPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "AddControlPolicy"
 	request := make(map[string]interface{})
 	conn, err := client.NewCloudfwClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["AclAction"] = d.Get("acl_action")
 	request["ApplicationName"] = d.Get("application_name")
@@ -164,7 +166,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(d *schema.Resourc
 	}
 	request["SourceType"] = d.Get("source_type")
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -177,15 +179,15 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(d *schema.Resourc
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["AclUuid"], ":", request["Direction"]))
 
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCloudFirewallControlPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudfwService := CloudfwService{client}
 	object, err := cloudfwService.DescribeCloudFirewallControlPolicy(d.Id())
 	if err != nil {
@@ -194,11 +196,11 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyRead(d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("acl_uuid", parts[0])
@@ -218,12 +220,12 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyRead(d *schema.ResourceD
 	d.Set("source_type", object["SourceType"])
 	return nil
 }
-func resourceAlibabacloudStackCloudFirewallControlPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCloudFirewallControlPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	update := false
 	request := map[string]interface{}{
@@ -292,10 +294,10 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyUpdate(d *schema.Resourc
 		action := "ModifyControlPolicy"
 		conn, err := client.NewCloudfwClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -308,22 +310,22 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyUpdate(d *schema.Resourc
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCloudFirewallControlPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCloudFirewallControlPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "DeleteControlPolicy"
 	var response map[string]interface{}
 	conn, err := client.NewCloudfwClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"AclUuid":   parts[0],
@@ -337,7 +339,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyDelete(d *schema.Resourc
 	request["Product"] = "Cloudfw"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -350,7 +352,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyDelete(d *schema.Resourc
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackCloudFirewallControlPolicyOrder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate,
-		Read:   resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead,
-		Update: resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate,
-		Delete: resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete,
+		CreateContext: resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate,
+		ReadContext:   resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead,
+		UpdateContext: resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate,
+		DeleteContext: resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -43,14 +45,14 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrder() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "ModifyControlPolicyPriority"
 	request := make(map[string]interface{})
 	conn, err := client.NewCloudfwClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["Direction"] = d.Get("direction")
 	request["Order"] = d.Get("order")
@@ -59,7 +61,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(d *schema.Re
 	request["Product"] = "Cloudfw"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -72,25 +74,25 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(d *schema.Re
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy_order", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy_order", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(request["AclUuid"], ":", request["Direction"]))
 
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "ModifyControlPolicyPriority"
 	conn, err := client.NewCloudfwClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	update := false
@@ -108,7 +110,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(d *schema.Re
 
 	if update {
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -122,16 +124,16 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(d *schema.Re
 	}
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy_order", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy_order", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(request["AclUuid"], ":", request["Direction"]))
 
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cloudfwService := CloudfwService{client}
 	object, err := cloudfwService.DescribeCloudFirewallControlPolicy(d.Id())
 	if err != nil {
@@ -140,11 +142,11 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead(d *schema.Reso
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("acl_uuid", parts[0])
@@ -154,7 +156,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead(d *schema.Reso
 	return nil
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] Resource alibabacloudstack_cloud_firewall_control_policy_order [%s]  will not be deleted", d.Id())
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,13 +24,18 @@ import (
 
 func resourceAlibabacloudStackCmsAlarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCmsAlarmCreate,
-		Read:   resourceAlibabacloudStackCmsAlarmRead,
-		Update: resourceAlibabacloudStackCmsAlarmUpdate,
-		Delete: resourceAlibabacloudStackCmsAlarmDelete,
+		CreateContext: resourceAlibabacloudStackCmsAlarmCreate,
+		ReadContext:   resourceAlibabacloudStackCmsAlarmRead,
+		UpdateContext: resourceAlibabacloudStackCmsAlarmUpdate,
+		DeleteContext: resourceAlibabacloudStackCmsAlarmDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -187,9 +194,9 @@ func resourceAlibabacloudStackCmsAlarm() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 	d.Partial(true)
 
@@ -203,7 +210,7 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 	request.MetricName = d.Get("metric").(string)
 	request.Period = strconv.Itoa(d.Get("period").(int))
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ContactGroups = strings.Join(expandStringList(d.Get("contact_groups").([]interface{})), ",")
 	if v, ok := d.GetOk("escalations_critical"); ok && len(v.([]interface{})) != 0 {
 		for _, val := range v.([]interface{}) {
@@ -266,60 +273,36 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 	}
 	if len(dimList) > 0 {
 		if bytes, err := json.Marshal(dimList); err != nil {
-			return fmt.Errorf("marshaling dimensions to json string got an error: %#v", err)
+			return DiagnosticsFromError(fmt.Errorf("marshaling dimensions to json string got an error: %#v", err))
 		} else {
 			request.Resources = string(bytes[:])
 		}
 	}
 
 	// make a request
-	nrequest := requests.NewCommonRequest()
-	nrequest.RegionId = client.RegionId
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	nrequest.Method = "POST"
-	nrequest.Product = "cms"
-	nrequest.Domain = client.Domain
-	nrequest.Version = "2019-01-01"
-	nrequest.ApiName = "PutResourceMetricRule"
-
-	nrequest.Headers = map[string]string{"RegionId": client.RegionId}
-	nrequest.QueryParams = map[string]string{
-		"AccessKeySecret":                client.SecretKey,
-		"Product":                        "cms",
-		"Department":                     client.Department,
-		"ResourceGroup":                  client.ResourceGroup,
-		"RegionId":                       client.RegionId,
-		"Action":                         "PutResourceMetricRule",
-		"Version":                        "2019-01-01",
-		"Namespace":                      request.Namespace,
-		"EffectiveInterval":              request.EffectiveInterval,
-		"ContactGroups":                  request.ContactGroups,
-		"InstanceID":                     instanceId,
-		"Resources":                      request.Resources,
-		"Escalations.Critical.Threshold": request.EscalationsCriticalThreshold,
-		"Escalations.Critical.ComparisonOperator": request.EscalationsCriticalComparisonOperator,
-		"Escalations.Critical.Statistics":         request.EscalationsCriticalStatistics,
-		"Escalations.Critical.Times":              fmt.Sprint(request.EscalationsCriticalTimes),
-		"Escalations.Warn.Threshold":              request.EscalationsWarnThreshold,
-		"Escalations.Warn.ComparisonOperator":     request.EscalationsWarnComparisonOperator,
-		"Escalations.Warn.Statistics":             request.EscalationsWarnStatistics,
-		"Escalations.Warn.Times":                  fmt.Sprint(request.EscalationsWarnTimes),
-		"Escalations.Info.Threshold":              request.EscalationsInfoThreshold,
-		"Escalations.Info.ComparisonOperator":     request.EscalationsCriticalComparisonOperator,
-		"Escalations.Info.Statistics":             request.EscalationsInfoStatistics,
-		"Escalations.Info.Times":                  fmt.Sprint(request.EscalationsInfoTimes),
-		"RuleName":                                request.RuleName,
-		"RuleId":                                  request.RuleId,
-		"MetricName":                              request.MetricName,
-		"Format":                                  "JSON",
-		"SilenceTime":                             fmt.Sprint(request.SilenceTime),
-		"SignatureVersion":                        "1.0",
-		"Period":                                  request.Period,
-	}
+	nrequest := client.NewCommonRequest("POST", "cms", "2019-01-01", "PutResourceMetricRule", "")
+	nrequest.QueryParams["Namespace"] = request.Namespace
+	nrequest.QueryParams["EffectiveInterval"] = request.EffectiveInterval
+	nrequest.QueryParams["ContactGroups"] = request.ContactGroups
+	nrequest.QueryParams["InstanceID"] = instanceId
+	nrequest.QueryParams["Resources"] = request.Resources
+	nrequest.QueryParams["Escalations.Critical.Threshold"] = request.EscalationsCriticalThreshold
+	nrequest.QueryParams["Escalations.Critical.ComparisonOperator"] = request.EscalationsCriticalComparisonOperator
+	nrequest.QueryParams["Escalations.Critical.Statistics"] = request.EscalationsCriticalStatistics
+	nrequest.QueryParams["Escalations.Critical.Times"] = fmt.Sprint(request.EscalationsCriticalTimes)
+	nrequest.QueryParams["Escalations.Warn.Threshold"] = request.EscalationsWarnThreshold
+	nrequest.QueryParams["Escalations.Warn.ComparisonOperator"] = request.EscalationsWarnComparisonOperator
+	nrequest.QueryParams["Escalations.Warn.Statistics"] = request.EscalationsWarnStatistics
+	nrequest.QueryParams["Escalations.Warn.Times"] = fmt.Sprint(request.EscalationsWarnTimes)
+	nrequest.QueryParams["Escalations.Info.Threshold"] = request.EscalationsInfoThreshold
+	nrequest.QueryParams["Escalations.Info.ComparisonOperator"] = request.EscalationsCriticalComparisonOperator
+	nrequest.QueryParams["Escalations.Info.Statistics"] = request.EscalationsInfoStatistics
+	nrequest.QueryParams["Escalations.Info.Times"] = fmt.Sprint(request.EscalationsInfoTimes)
+	nrequest.QueryParams["RuleName"] = request.RuleName
+	nrequest.QueryParams["RuleId"] = request.RuleId
+	nrequest.QueryParams["MetricName"] = request.MetricName
+	nrequest.QueryParams["SilenceTime"] = fmt.Sprint(request.SilenceTime)
+	nrequest.QueryParams["Period"] = request.Period
 
 	raw, err := client.WithEcsClient(func(cmsClient *ecs.Client) (interface{}, error) {
 		return cmsClient.ProcessCommonRequest(nrequest)
@@ -327,7 +310,7 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 
 	log.Printf("testing cms %v", raw)
 	if err != nil {
-		return fmt.Errorf("Putting alarm got an error: %#v", err)
+		return DiagnosticsFromError(fmt.Errorf("Putting alarm got an error: %#v", err))
 	}
 	//d.SetPartial("name")
 	//d.SetPartial("period")
@@ -346,10 +329,10 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 		request := cms.CreateEnableMetricRulesRequest()
 		request.RuleId = &[]string{d.Id()}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		wait := incrementalWait(1*time.Second, 2*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 				return cmsClient.EnableMetricRules(request)
 			})
@@ -364,16 +347,16 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 			return nil
 		})
 		if err != nil {
-			return fmt.Errorf("Enabling alarm got an error: %#v", err)
+			return DiagnosticsFromError(fmt.Errorf("Enabling alarm got an error: %#v", err))
 		}
 	} else {
 		request := cms.CreateDisableMetricRulesRequest()
 		request.RuleId = &[]string{d.Id()}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		wait := incrementalWait(1*time.Second, 2*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 				return cmsClient.DisableMetricRules(request)
 			})
@@ -388,21 +371,21 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 			return nil
 		})
 		if err != nil {
-			return fmt.Errorf("Disableing alarm got an error: %#v", err)
+			return DiagnosticsFromError(fmt.Errorf("Disableing alarm got an error: %#v", err))
 		}
 	}
-	if err := cmsService.WaitForCmsAlarm(d.Id(), d.Get("enabled").(bool), 102); err != nil {
-		return err
+	if err := cmsService.WaitForCmsAlarm(d.Id(), d.Get("enabled").(bool), int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(err)
 	}
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackCmsAlarmUpdate(d, meta)
+	return resourceAlibabacloudStackCmsAlarmUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 
 	alarm, err := cmsService.DescribeCmsAlarm(d.Id())
@@ -412,14 +395,14 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	d.Set("name", alarm.RuleName)
 	d.Set("project", alarm.Namespace)
 	d.Set("metric", alarm.MetricName)
 	if period, err := strconv.Atoi(alarm.Period); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		d.Set("period", period)
 	}
@@ -427,7 +410,7 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 	escalationsCritical := make([]map[string]interface{}, 1)
 	if alarm.Escalations.Critical.Times != "" {
 		if count, err := strconv.Atoi(alarm.Escalations.Critical.Times); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			mapping := map[string]interface{}{
 				"statistics":          alarm.Escalations.Critical.Statistics,
@@ -443,7 +426,7 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 	escalationsWarn := make([]map[string]interface{}, 1)
 	if alarm.Escalations.Warn.Times != "" {
 		if count, err := strconv.Atoi(alarm.Escalations.Warn.Times); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			mappingWarn := map[string]interface{}{
 				"statistics":          alarm.Escalations.Warn.Statistics,
@@ -459,7 +442,7 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 	escalationsInfo := make([]map[string]interface{}, 1)
 	if alarm.Escalations.Info.Times != "" {
 		if count, err := strconv.Atoi(alarm.Escalations.Info.Times); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			mappingInfo := map[string]interface{}{
 				"statistics":          alarm.Escalations.Info.Statistics,
@@ -474,7 +457,7 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 
 	d.Set("effective_interval", alarm.EffectiveInterval)
 	if silence, err := strconv.Atoi(alarm.SilenceTime); err != nil {
-		return fmt.Errorf("Atoi SilenceTime got an error: %#v.", err)
+		return DiagnosticsFromError(fmt.Errorf("Atoi SilenceTime got an error: %#v.", err))
 	} else {
 		d.Set("silence_time", silence)
 	}
@@ -485,7 +468,7 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 	var dims map[string]interface{}
 	if alarm.Dimensions != "" {
 		if err := json.Unmarshal([]byte(alarm.Dimensions), &dims); err != nil {
-			return fmt.Errorf("Unmarshaling Dimensions got an error: %#v.", err)
+			return DiagnosticsFromError(fmt.Errorf("Unmarshaling Dimensions got an error: %#v.", err))
 		}
 	}
 	d.Set("dimensions", dims)
@@ -493,26 +476,26 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackCmsAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	return resourceAlibabacloudStackCmsAlarmRead(d, meta)
+	return resourceAlibabacloudStackCmsAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsAlarmDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCmsAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := cms.CreateDeleteMetricRulesRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.Id = &[]string{parts[0]}
 
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+	return DiagnosticsFromError(resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DeleteMetricRules(request)
 		})
@@ -534,7 +517,7 @@ func resourceAlibabacloudStackCmsAlarmDelete(d *schema.ResourceData, meta interf
 		}
 
 		return resource.RetryableError(fmt.Errorf("Deleting alarm rule got an error: %#v", err))
-	})
+	}))
 }
 
 func convertOperator(operator string) string {
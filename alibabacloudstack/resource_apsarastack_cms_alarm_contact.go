@@ -1,23 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudstackCmsAlarmContact() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackCmsAlarmContactCreate,
-		Read:   resourceAlibabacloudstackCmsAlarmContactRead,
-		Update: resourceAlibabacloudstackCmsAlarmContactUpdate,
-		Delete: resourceAlibabacloudstackCmsAlarmContactDelete,
+		CreateContext: resourceAlibabacloudstackCmsAlarmContactCreate,
+		ReadContext:   resourceAlibabacloudstackCmsAlarmContactRead,
+		UpdateContext: resourceAlibabacloudstackCmsAlarmContactUpdate,
+		DeleteContext: resourceAlibabacloudstackCmsAlarmContactDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -56,32 +56,13 @@ func resourceAlibabacloudstackCmsAlarmContact() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackCmsAlarmContactCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "PutContact"
-	request.Method = "POST"
-	request.Product = "Cms"
-	request.Domain = client.Domain
-	request.Version = "2019-01-01"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId, "Content-Type": "application/json; charset=UTF-8"}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Cms",
-		"Action":          "PutContact",
-		"Version":         "2019-01-01",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"ContactName":     d.Get("alarm_contact_name").(string),
-		"Describe":        d.Get("describe").(string),
-	}
+func resourceAlibabacloudstackCmsAlarmContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := client.NewCommonRequest("POST", "Cms", "2019-01-01", "PutContact", "")
+	request.Headers["Content-Type"] = "application/json; charset=UTF-8"
+	request.QueryParams["ContactName"] = d.Get("alarm_contact_name").(string)
+	request.QueryParams["Describe"] = d.Get("describe").(string)
 	if v, ok := d.GetOk("channels_aliim"); ok {
 		request.QueryParams["Channels.AliIM "] = v.(string)
 	}
@@ -106,21 +87,21 @@ func resourceAlibabacloudstackCmsAlarmContactCreate(d *schema.ResourceData, meta
 		return cmsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cms_alarm_contact", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cms_alarm_contact", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*cms.PutContactResponse)
 
 	if response.Code != "200" {
-		return WrapError(Error("PutContact failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("PutContact failed for " + response.Message)))
 	}
 	d.SetId(fmt.Sprintf("%v", d.Get("alarm_contact_name").(string)))
 
-	return resourceAlibabacloudstackCmsAlarmContactRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 	object, err := cmsService.DescribeCmsAlarmContact(d.Id())
 	if err != nil {
@@ -129,7 +110,7 @@ func resourceAlibabacloudstackCmsAlarmContactRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("alarm_contact_name", d.Id())
@@ -142,8 +123,8 @@ func resourceAlibabacloudstackCmsAlarmContactRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudstackCmsAlarmContactUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudstackCmsAlarmContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	update := false
 	request := cms.CreatePutContactRequest()
 	request.ContactName = d.Id()
@@ -179,16 +160,16 @@ func resourceAlibabacloudstackCmsAlarmContactUpdate(d *schema.ResourceData, meta
 		response, _ := raw.(*cms.PutContactResponse)
 
 		if response.Code != "200" {
-			return WrapError(Error("PutContact failed for " + response.Message))
+			return DiagnosticsFromError(WrapError(Error("PutContact failed for " + response.Message)))
 		}
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudstackCmsAlarmContactRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudstackCmsAlarmContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := cms.CreateDeleteContactRequest()
 	request.ContactName = d.Id()
 	raw, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
@@ -198,13 +179,13 @@ func resourceAlibabacloudstackCmsAlarmContactDelete(d *schema.ResourceData, meta
 	response, _ := raw.(*cms.DeleteContactResponse)
 
 	if response.Code != "200" {
-		return WrapError(Error("DeleteContact failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("DeleteContact failed for " + response.Message)))
 	}
 	if err != nil {
 		if IsExpectedErrors(err, []string{"400", "403", "404", "ContactNotExists"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,21 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudstackCmsAlarmContactGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackCmsAlarmContactGroupCreate,
-		Read:   resourceAlibabacloudstackCmsAlarmContactGroupRead,
-		Update: resourceAlibabacloudstackCmsAlarmContactGroupUpdate,
-		Delete: resourceAlibabacloudstackCmsAlarmContactGroupDelete,
+		CreateContext: resourceAlibabacloudstackCmsAlarmContactGroupCreate,
+		ReadContext:   resourceAlibabacloudstackCmsAlarmContactGroupRead,
+		UpdateContext: resourceAlibabacloudstackCmsAlarmContactGroupUpdate,
+		DeleteContext: resourceAlibabacloudstackCmsAlarmContactGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -45,8 +47,8 @@ func resourceAlibabacloudstackCmsAlarmContactGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackCmsAlarmContactGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudstackCmsAlarmContactGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := cms.CreatePutContactGroupRequest()
 	request.Headers["x-ascm-product-name"] = "Cms"
@@ -68,21 +70,21 @@ func resourceAlibabacloudstackCmsAlarmContactGroupCreate(d *schema.ResourceData,
 		return cmsClient.PutContactGroup(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cms_alarm_contact_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cms_alarm_contact_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*cms.PutContactGroupResponse)
 
 	if response.Code != "200" {
-		return WrapError(Error("PutContactGroup failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("PutContactGroup failed for " + response.Message)))
 	}
 	d.SetId(fmt.Sprintf("%v", request.ContactGroupName))
 
-	return resourceAlibabacloudstackCmsAlarmContactGroupRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 	object, err := cmsService.DescribeCmsAlarmContactGroup(d.Id())
 	if err != nil {
@@ -91,7 +93,7 @@ func resourceAlibabacloudstackCmsAlarmContactGroupRead(d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("alarm_contact_group_name", d.Id())
@@ -100,8 +102,8 @@ func resourceAlibabacloudstackCmsAlarmContactGroupRead(d *schema.ResourceData, m
 	d.Set("enable_subscribed", object.EnableSubscribed)
 	return nil
 }
-func resourceAlibabacloudstackCmsAlarmContactGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudstackCmsAlarmContactGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	update := false
 	request := cms.CreatePutContactGroupRequest()
 	request.ContactGroupName = d.Id()
@@ -127,16 +129,16 @@ func resourceAlibabacloudstackCmsAlarmContactGroupUpdate(d *schema.ResourceData,
 		response, _ := raw.(*cms.PutContactGroupResponse)
 
 		if response.Code != "200" {
-			return WrapError(Error("PutContactGroup failed for " + response.Message))
+			return DiagnosticsFromError(WrapError(Error("PutContactGroup failed for " + response.Message)))
 		}
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudstackCmsAlarmContactGroupRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudstackCmsAlarmContactGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := cms.CreateDeleteContactGroupRequest()
 	request.ContactGroupName = d.Id()
 	raw, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
@@ -146,13 +148,13 @@ func resourceAlibabacloudstackCmsAlarmContactGroupDelete(d *schema.ResourceData,
 	response, _ := raw.(*cms.DeleteContactGroupResponse)
 
 	if response.Code != "200" {
-		return WrapError(Error("DeleteContactGroup failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("DeleteContactGroup failed for " + response.Message)))
 	}
 	if err != nil {
 		if IsExpectedErrors(err, []string{"400", "403", "404", "ContactNotExists"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
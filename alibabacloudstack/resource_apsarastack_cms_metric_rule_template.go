@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -18,10 +20,10 @@ import (
 
 func resourceAlibabacloudCmsMetricRuleTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudCmsMetricRuleTemplateCreate,
-		Read:   resourceAlibabacloudCmsMetricRuleTemplateRead,
-		Update: resourceAlibabacloudCmsMetricRuleTemplateUpdate,
-		Delete: resourceAlibabacloudCmsMetricRuleTemplateDelete,
+		CreateContext: resourceAlibabacloudCmsMetricRuleTemplateCreate,
+		ReadContext:   resourceAlibabacloudCmsMetricRuleTemplateRead,
+		UpdateContext: resourceAlibabacloudCmsMetricRuleTemplateUpdate,
+		DeleteContext: resourceAlibabacloudCmsMetricRuleTemplateDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -198,8 +200,8 @@ func resourceAlibabacloudCmsMetricRuleTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudCmsMetricRuleTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudCmsMetricRuleTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := cms.CreateCreateMetricRuleTemplateRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -209,12 +211,11 @@ func resourceAlibabacloudCmsMetricRuleTemplateCreate(d *schema.ResourceData, met
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "cms",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Name":            d.Get("metric_rule_template_name").(string),
-		"Description":     d.Get("description").(string),
+		"Product":       "cms",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Name":          d.Get("metric_rule_template_name").(string),
+		"Description":   d.Get("description").(string),
 	}
 	request.Name = d.Get("metric_rule_template_name").(string)
 	request.Description = d.Get("description").(string)
@@ -269,7 +270,7 @@ func resourceAlibabacloudCmsMetricRuleTemplateCreate(d *schema.ResourceData, met
 	})
 	addDebug(request.GetActionName(), raw, request, request.QueryParams)
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "cms_metric_rule_templates", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "cms_metric_rule_templates", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	response, _ := raw.(*cms.CreateMetricRuleTemplateResponse)
 	// resp := make(map[string]interface{})
@@ -277,10 +278,10 @@ func resourceAlibabacloudCmsMetricRuleTemplateCreate(d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprint(response.Id))
 
-	return resourceAlibabacloudCmsMetricRuleTemplateUpdate(d, meta)
+	return resourceAlibabacloudCmsMetricRuleTemplateUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudCmsMetricRuleTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudCmsMetricRuleTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 	templateattr, err := cmsService.DescribeMetricRuleTemplateAttribute(d.Id())
 	if err != nil {
@@ -289,7 +290,7 @@ func resourceAlibabacloudCmsMetricRuleTemplateRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	resource := templateattr.Resource
 	if len(resource.AlertTemplates.AlertTemplate) > 0 {
@@ -346,34 +347,17 @@ func resourceAlibabacloudCmsMetricRuleTemplateRead(d *schema.ResourceData, meta
 	d.Set("rest_version", resource.RestVersion)
 	return nil
 }
-func resourceAlibabacloudCmsMetricRuleTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudCmsMetricRuleTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	d.Partial(true)
 	update := false
 	if v, ok := d.GetOk("enable"); ok && v.(bool) {
-		request := requests.NewCommonRequest()
-		request.Method = "POST"
-		request.ApiName = "ApplyMetricRuleTemplate"
-		request.Version = "2019-01-01"
-		request.Product = "Cms"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.RegionId = client.RegionId
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "cms",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"TemplateId":      "[]",
-			"TemplateIds":     d.Id(),
-			"ResourceGroupId": client.ResourceGroup,
-			"GroupId":         client.ResourceGroup,
-			"Overwrite":       fmt.Sprintf("%t", d.Get("overwrite").(bool)),
-		}
+		request := client.NewCommonRequest("POST", "Cms", "2019-01-01", "ApplyMetricRuleTemplate", "")
+		request.QueryParams["TemplateId"] = "[]"
+		request.QueryParams["TemplateIds"] = d.Id()
+		request.QueryParams["ResourceGroupId"] = client.ResourceGroup
+		request.QueryParams["GroupId"] = client.ResourceGroup
+		request.QueryParams["Overwrite"] = fmt.Sprintf("%t", d.Get("overwrite").(bool))
 		if v, ok := d.GetOk("apply_mode"); ok {
 			request.QueryParams["ApplyMode"] = v.(string)
 		}
@@ -398,16 +382,16 @@ func resourceAlibabacloudCmsMetricRuleTemplateUpdate(d *schema.ResourceData, met
 		})
 		addDebug(request.GetActionName(), raw, request, request.QueryParams)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "ApplyMetricRuleTemplate", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "ApplyMetricRuleTemplate", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		bresponse, _ := raw.(*responses.CommonResponse)
 		resource := make(map[string]interface{})
 		err = json.Unmarshal(bresponse.GetHttpContentBytes(), &resource)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "ApplyMetricRuleTemplate", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "ApplyMetricRuleTemplate", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		if resource["Code"].(float64) != 200 {
-			return WrapError(fmt.Errorf("ApplyMetricRuleTemplate Error: %v", resource))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("ApplyMetricRuleTemplate Error: %v", resource)))
 		}
 		d.Set("group_id", client.ResourceGroup)
 		// d.SetPartial("group_id")
@@ -417,10 +401,9 @@ func resourceAlibabacloudCmsMetricRuleTemplateUpdate(d *schema.ResourceData, met
 	modifyMetricRuleTemplateReq.RegionId = client.RegionId
 	modifyMetricRuleTemplateReq.Headers = map[string]string{"RegionId": client.RegionId}
 	modifyMetricRuleTemplateReq.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "cms",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
+		"Product":       "cms",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
 	}
 
 	if v, ok := d.GetOk("rest_version"); ok {
@@ -491,11 +474,11 @@ func resourceAlibabacloudCmsMetricRuleTemplateUpdate(d *schema.ResourceData, met
 		})
 		addDebug(modifyMetricRuleTemplateReq.GetActionName(), raw, modifyMetricRuleTemplateReq, modifyMetricRuleTemplateReq.QueryParams)
 		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "ApplyMetricRuleTemplate", modifyMetricRuleTemplateReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "ApplyMetricRuleTemplate", modifyMetricRuleTemplateReq.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		response, _ := raw.(*cms.ModifyMetricRuleTemplateResponse)
 		if response.Code != 200 {
-			return WrapError(fmt.Errorf("%s", response.Message))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s", response.Message)))
 		}
 		// d.SetPartial("rest_version")
 		// d.SetPartial("alert_templates")
@@ -503,18 +486,17 @@ func resourceAlibabacloudCmsMetricRuleTemplateUpdate(d *schema.ResourceData, met
 		// d.SetPartial("metric_rule_template_name")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudCmsMetricRuleTemplateRead(d, meta)
+	return resourceAlibabacloudCmsMetricRuleTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudCmsMetricRuleTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudCmsMetricRuleTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := cms.CreateDeleteMetricRuleTemplateRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "cms",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
+		"Product":       "cms",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
 	}
 	request.TemplateId = d.Id()
 
@@ -523,11 +505,11 @@ func resourceAlibabacloudCmsMetricRuleTemplateDelete(d *schema.ResourceData, met
 	})
 	addDebug(request.GetActionName(), raw, request, request.QueryParams)
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "DeleteMetricRuleTemplate", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "DeleteMetricRuleTemplate", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	response, _ := raw.(*cms.DeleteMetricRuleTemplateResponse)
 	if response.Code != 200 {
-		return WrapError(fmt.Errorf("%s", response.Message))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("%s", response.Message)))
 	}
 	return nil
 }
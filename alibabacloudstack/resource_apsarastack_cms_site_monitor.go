@@ -2,13 +2,14 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,10 +19,10 @@ import (
 
 func resourceAlibabacloudStackCmsSiteMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCmsSiteMonitorCreate,
-		Read:   resourceAlibabacloudStackCmsSiteMonitorRead,
-		Update: resourceAlibabacloudStackCmsSiteMonitorUpdate,
-		Delete: resourceAlibabacloudStackCmsSiteMonitorDelete,
+		CreateContext: resourceAlibabacloudStackCmsSiteMonitorCreate,
+		ReadContext:   resourceAlibabacloudStackCmsSiteMonitorRead,
+		UpdateContext: resourceAlibabacloudStackCmsSiteMonitorUpdate,
+		DeleteContext: resourceAlibabacloudStackCmsSiteMonitorDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -89,14 +90,14 @@ func resourceAlibabacloudStackCmsSiteMonitor() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCmsSiteMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 
 	taskName := d.Get("task_name").(string)
 	request := cms.CreateCreateSiteMonitorRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Address = d.Get("address").(string)
 	request.TaskName = taskName
 	request.TaskType = d.Get("task_type").(string)
@@ -116,7 +117,7 @@ func resourceAlibabacloudStackCmsSiteMonitorCreate(d *schema.ResourceData, meta
 		}
 		b, err := json.Marshal(a)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.IspCities = bytes.NewBuffer(b).String()
 	}
@@ -125,22 +126,22 @@ func resourceAlibabacloudStackCmsSiteMonitorCreate(d *schema.ResourceData, meta
 		return cmsClient.CreateSiteMonitor(request)
 	})
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	siteMonitor, err := cmsService.DescribeSiteMonitor("", taskName)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.SetId(siteMonitor.TaskId)
 
-	return resourceAlibabacloudStackCmsSiteMonitorRead(d, meta)
+	return resourceAlibabacloudStackCmsSiteMonitorRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 
 	siteMonitor, err := cmsService.DescribeSiteMonitor(d.Id(), "")
@@ -149,7 +150,7 @@ func resourceAlibabacloudStackCmsSiteMonitorRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("address", siteMonitor.Address)
@@ -169,18 +170,18 @@ func resourceAlibabacloudStackCmsSiteMonitorRead(d *schema.ResourceData, meta in
 	}
 
 	if err = d.Set("isp_cities", list); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCmsSiteMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := cms.CreateModifySiteMonitorRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.TaskId = d.Id()
 	request.Address = d.Get("address").(string)
 	request.Interval = strconv.Itoa(d.Get("interval").(int))
@@ -200,7 +201,7 @@ func resourceAlibabacloudStackCmsSiteMonitorUpdate(d *schema.ResourceData, meta
 		}
 		b, err := json.Marshal(a)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.IspCities = bytes.NewBuffer(b).String()
 	}
@@ -209,23 +210,23 @@ func resourceAlibabacloudStackCmsSiteMonitorUpdate(d *schema.ResourceData, meta
 		return cmsClient.ModifySiteMonitor(request)
 	})
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackCmsSiteMonitorRead(d, meta)
+	return resourceAlibabacloudStackCmsSiteMonitorRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCmsSiteMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	cmsService := CmsService{client}
 	request := cms.CreateDeleteSiteMonitorsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.TaskIds = d.Id()
 	request.IsDeleteAlarms = "false"
 
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+	return DiagnosticsFromError(resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DeleteSiteMonitors(request)
 		})
@@ -244,7 +245,7 @@ func resourceAlibabacloudStackCmsSiteMonitorDelete(d *schema.ResourceData, meta
 
 		return resource.RetryableError(fmt.Errorf("Deleting site monitor got an error: %#v", err))
 
-	})
+	}))
 }
 
 func getAlertId(alertIds []interface{}) string {
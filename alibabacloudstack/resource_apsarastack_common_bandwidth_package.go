@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,14 +18,20 @@ import (
 
 func resourceAlibabacloudStackCommonBandwidthPackage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCommonBandwidthPackageCreate,
-		Read:   resourceAlibabacloudStackCommonBandwidthPackageRead,
-		Update: resourceAlibabacloudStackCommonBandwidthPackageUpdate,
-		Delete: resourceAlibabacloudStackCommonBandwidthPackageDelete,
+		CreateContext: resourceAlibabacloudStackCommonBandwidthPackageCreate,
+		ReadContext:   resourceAlibabacloudStackCommonBandwidthPackageRead,
+		UpdateContext: resourceAlibabacloudStackCommonBandwidthPackageUpdate,
+		DeleteContext: resourceAlibabacloudStackCommonBandwidthPackageDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -63,8 +71,8 @@ func resourceAlibabacloudStackCommonBandwidthPackage() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCommonBandwidthPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateCreateCommonBandwidthPackageRequest()
@@ -76,7 +84,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.Bandwidth = requests.NewInteger(d.Get("bandwidth").(int))
 	request.Name = d.Get("name").(string)
@@ -85,7 +93,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 	request.Ratio = requests.NewInteger(d.Get("ratio").(int))
 
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request.ClientToken = buildClientToken(request.GetActionName())
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateCommonBandwidthPackage(request)
@@ -104,19 +112,19 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_common_bandwidth_package", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_common_bandwidth_package", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	if err = vpcService.WaitForCommonBandwidthPackage(d.Id(), Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err = vpcService.WaitForCommonBandwidthPackage(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackCommonBandwidthPackageRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeCommonBandwidthPackage(d.Id())
 	if err != nil {
@@ -124,12 +132,12 @@ func resourceAlibabacloudStackCommonBandwidthPackageRead(d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	bandwidth, err := strconv.Atoi(object.Bandwidth)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("bandwidth", bandwidth)
 	d.Set("name", object.Name)
@@ -139,8 +147,8 @@ func resourceAlibabacloudStackCommonBandwidthPackageRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCommonBandwidthPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	d.Partial(true)
 	update := false
@@ -153,7 +161,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = d.Id()
 	if d.HasChange("description") {
 		request.Description = d.Get("description").(string)
@@ -170,7 +178,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceDat
 			return vpcClient.ModifyCommonBandwidthPackageAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("description")
@@ -187,25 +195,25 @@ func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceDat
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.BandwidthPackageId = d.Id()
 		request.Bandwidth = strconv.Itoa(d.Get("bandwidth").(int))
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ModifyCommonBandwidthPackageSpec(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("bandwidth")
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackCommonBandwidthPackageRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCommonBandwidthPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateDeleteCommonBandwidthPackageRequest()
@@ -217,15 +225,15 @@ func resourceAlibabacloudStackCommonBandwidthPackageDelete(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = d.Id()
 	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 		return vpcClient.DeleteCommonBandwidthPackage(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(vpcService.WaitForCommonBandwidthPackage(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForCommonBandwidthPackage(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,23 +1,29 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackCommonBandwidthPackageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate,
-		Read:   resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead,
-		Delete: resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(25 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"bandwidth_package_id": {
 				Type:     schema.TypeString,
@@ -34,8 +40,8 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachment() *schema.Resourc
 	}
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	request := vpc.CreateAddCommonBandwidthPackageIpRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -46,32 +52,32 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(d *schema.R
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = Trim(d.Get("bandwidth_package_id").(string))
 	request.IpInstanceId = Trim(d.Get("instance_id").(string))
 	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 		return vpcClient.AddCommonBandwidthPackageIp(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_common_bandwidth_package_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_common_bandwidth_package_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	//check the common bandwidth package attachment
 	d.SetId(request.BandwidthPackageId + COLON_SEPARATED + request.IpInstanceId)
-	if err := vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Available, 5*DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	bandwidthPackageId, ipInstanceId := parts[0], parts[1]
 	_, err = vpcService.DescribeCommonBandwidthPackageAttachment(d.Id())
@@ -80,7 +86,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("bandwidth_package_id", bandwidthPackageId)
@@ -88,12 +94,12 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d *schema.Res
 	return nil
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	bandwidthPackageId, ipInstanceId := parts[0], parts[1]
 
@@ -105,11 +111,11 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(d *schema.R
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = bandwidthPackageId
 	request.IpInstanceId = ipInstanceId
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.RemoveCommonBandwidthPackageIp(request)
 		})
@@ -124,7 +130,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(d *schema.R
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -46,7 +46,7 @@ func testSweepCommonBandwidthPackageAttachment(region string) error {
 	req.Headers = map[string]string{"RegionId": client.RegionId}
 	req.QueryParams["Department"] = client.Department
 	req.QueryParams["ResourceGroup"] = client.ResourceGroup
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -97,7 +97,7 @@ func testSweepCommonBandwidthPackageAttachment(region string) error {
 			}
 			req.RegionId = client.RegionId
 			req.Headers = map[string]string{"RegionId": client.RegionId}
-			req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			req.BandwidthPackageId = id
 			req.IpInstanceId = eip.AllocationId
 			_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
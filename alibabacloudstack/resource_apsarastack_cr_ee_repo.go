@@ -1,23 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackCrEERepo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEERepoCreate,
-		Read:   resourceAlibabacloudStackCrEERepoRead,
-		Update: resourceAlibabacloudStackCrEERepoUpdate,
-		Delete: resourceAlibabacloudStackCrEERepoDelete,
+		CreateContext: resourceAlibabacloudStackCrEERepoCreate,
+		ReadContext:   resourceAlibabacloudStackCrEERepoRead,
+		UpdateContext: resourceAlibabacloudStackCrEERepoUpdate,
+		DeleteContext: resourceAlibabacloudStackCrEERepoDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -65,35 +66,20 @@ func resourceAlibabacloudStackCrEERepo() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEERepoCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCrEERepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
 	namespace := d.Get("namespace").(string)
 	repoName := d.Get("name").(string)
 	repoType := d.Get("repo_type").(string)
 	summary := d.Get("summary").(string)
-	request := requests.NewCommonRequest()
-	request.RegionId = client.RegionId
-	request.Product = "cr-ee"
-	request.Method = "POST"
-	request.Domain = client.Domain
-	request.Version = "2018-12-01"
-	request.ApiName = "CreateRepository"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":   client.SecretKey,
-		"Product":           "cr-ee",
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Action":            "CreateRepository",
-		"Version":           "2018-12-01",
-		"InstanceId":        instanceId,
-		"RepoNamespaceName": namespace,
-		"RepoName":          repoName,
-		"RepoType":          repoType,
-		"Summary":           summary,
-	}
+	request := client.NewCommonRequest("POST", "cr-ee", "2018-12-01", "CreateRepository", "")
+	request.QueryParams["InstanceId"] = instanceId
+	request.QueryParams["RepoNamespaceName"] = namespace
+	request.QueryParams["RepoName"] = repoName
+	request.QueryParams["RepoType"] = repoType
+	request.QueryParams["Summary"] = summary
 	if detail, ok := d.GetOk("detail"); ok && detail.(string) != "" {
 		request.QueryParams["Detail"] = detail.(string)
 	}
@@ -103,68 +89,53 @@ func resourceAlibabacloudStackCrEERepoCreate(d *schema.ResourceData, meta interf
 	response := make(map[string]interface{})
 	addDebug(request.GetActionName(), raw, request, request.QueryParams)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	err = json.Unmarshal(raw.(*responses.CommonResponse).GetHttpContentBytes(), &response)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if !response["asapiSuccess"].(bool) {
-		return fmt.Errorf("create ee repo failed, %s", response["asapiErrorMessage"].(string))
+		return DiagnosticsFromError(fmt.Errorf("create ee repo failed, %s", response["asapiErrorMessage"].(string)))
 	}
 
 	d.SetId(crService.GenResourceId(instanceId, namespace, repoName))
 
-	return resourceAlibabacloudStackCrEERepoRead(d, meta)
+	return resourceAlibabacloudStackCrEERepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEERepoRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	strRet := crService.ParseResourceId(d.Id())
 	instanceId := strRet[0]
 	namespace := strRet[1]
 	repo := strRet[2]
-	request := requests.NewCommonRequest()
-	request.RegionId = client.RegionId
-	request.Product = "cr-ee"
-	request.Method = "POST"
-	request.Domain = client.Domain
-	request.Version = "2018-12-01"
-	request.ApiName = "ListRepository"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":   client.SecretKey,
-		"Product":           "cr-ee",
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Action":            "ListRepository",
-		"Version":           "2018-12-01",
-		"InstanceId":        instanceId,
-		"RepoNamespaceName": namespace,
-		"RepoName":          repo,
-	}
+	request := client.NewCommonRequest("POST", "cr-ee", "2018-12-01", "ListRepository", "")
+	request.QueryParams["InstanceId"] = instanceId
+	request.QueryParams["RepoNamespaceName"] = namespace
+	request.QueryParams["RepoName"] = repo
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	response := make(map[string]interface{})
 	addDebug(request.GetActionName(), raw, request, request.QueryParams)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	err = json.Unmarshal(raw.(*responses.CommonResponse).GetHttpContentBytes(), &response)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if !response["asapiSuccess"].(bool) {
-		return fmt.Errorf("read ee repo failed, %s", response["asapiErrorMessage"].(string))
+		return DiagnosticsFromError(fmt.Errorf("read ee repo failed, %s", response["asapiErrorMessage"].(string)))
 	}
 	repoList := response["Repositories"].([]interface{})
 	if len(repoList) == 0 {
-		return WrapError(fmt.Errorf("repo %s not found", repoList))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("repo %s not found", repoList)))
 	}
 	item := repoList[0].(map[string]interface{})
 
@@ -178,30 +149,15 @@ func resourceAlibabacloudStackCrEERepoRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackCrEERepoUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCrEERepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	instanceId := d.Get("instance_id").(string)
 	if d.HasChanges("repo_type", "summary", "detail") {
-		request := requests.NewCommonRequest()
-		request.RegionId = client.RegionId
-		request.Product = "cr-ee"
-		request.Method = "POST"
-		request.Domain = client.Domain
-		request.Version = "2018-12-01"
-		request.ApiName = "UpdateRepository"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "cr-ee",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "UpdateRepository",
-			"Version":         "2018-12-01",
-			"InstanceId":      instanceId,
-			"RepoId":          d.Get("repo_id").(string),
-			"RepoType":        d.Get("repo_type").(string),
-			"Summary":         d.Get("summary").(string),
-		}
+		request := client.NewCommonRequest("POST", "cr-ee", "2018-12-01", "UpdateRepository", "")
+		request.QueryParams["InstanceId"] = instanceId
+		request.QueryParams["RepoId"] = d.Get("repo_id").(string)
+		request.QueryParams["RepoType"] = d.Get("repo_type").(string)
+		request.QueryParams["Summary"] = d.Get("summary").(string)
 		if d.HasChange("detail") {
 			request.QueryParams["Detail"] = d.Get("detail").(string)
 		}
@@ -211,59 +167,44 @@ func resourceAlibabacloudStackCrEERepoUpdate(d *schema.ResourceData, meta interf
 		response := make(map[string]interface{})
 		addDebug(request.GetActionName(), raw, request, request.QueryParams)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		err = json.Unmarshal(raw.(*responses.CommonResponse).GetHttpContentBytes(), &response)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if !response["asapiSuccess"].(bool) {
-			return fmt.Errorf("update ee repo failed, %s", response["asapiErrorMessage"].(string))
+			return DiagnosticsFromError(fmt.Errorf("update ee repo failed, %s", response["asapiErrorMessage"].(string)))
 		}
 
 	}
 
-	return resourceAlibabacloudStackCrEERepoRead(d, meta)
+	return resourceAlibabacloudStackCrEERepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEERepoDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCrEERepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	instanceId := d.Get("instance_id").(string)
 	repoId := d.Get("repo_id").(string)
-	request := requests.NewCommonRequest()
-	request.RegionId = client.RegionId
-	request.Product = "cr-ee"
-	request.Method = "POST"
-	request.Domain = client.Domain
-	request.Version = "2018-12-01"
-	request.ApiName = "DeleteRepository"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "cr-ee",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteRepository",
-		"Version":         "2018-12-01",
-		"InstanceId":      instanceId,
-		"RepoId":          repoId,
-	}
+	request := client.NewCommonRequest("POST", "cr-ee", "2018-12-01", "DeleteRepository", "")
+	request.QueryParams["InstanceId"] = instanceId
+	request.QueryParams["RepoId"] = repoId
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	response := make(map[string]interface{})
 	addDebug(request.GetActionName(), raw, request, request.QueryParams)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	err = json.Unmarshal(raw.(*responses.CommonResponse).GetHttpContentBytes(), &response)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if !response["asapiSuccess"].(bool) {
-		return fmt.Errorf("delete ee repo failed, %s", response["asapiErrorMessage"].(string))
+		return DiagnosticsFromError(fmt.Errorf("delete ee repo failed, %s", response["asapiErrorMessage"].(string)))
 	}
 
 	return nil
@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackCrEESyncRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEESyncRuleCreate,
-		Read:   resourceAlibabacloudStackCrEESyncRuleRead,
-		Delete: resourceAlibabacloudStackCrEESyncRuleDelete,
+		CreateContext: resourceAlibabacloudStackCrEESyncRuleCreate,
+		ReadContext:   resourceAlibabacloudStackCrEESyncRuleRead,
+		DeleteContext: resourceAlibabacloudStackCrEESyncRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -83,8 +85,8 @@ func resourceAlibabacloudStackCrEESyncRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCrEESyncRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	syncRuleName := d.Get("name").(string)
 	instanceId := d.Get("instance_id").(string)
@@ -102,14 +104,14 @@ func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta in
 		targetRepoName = v.(string)
 	}
 	if (repoName != "" && targetRepoName == "") || (repoName == "" && targetRepoName != "") {
-		return WrapError(Error(DefaultErrorMsg, syncRuleName, "create", "[Params repo_name or target_repo_name is empty]"))
+		return DiagnosticsFromError(WrapError(Error(DefaultErrorMsg, syncRuleName, "create", "[Params repo_name or target_repo_name is empty]")))
 	}
 
 	response := &cr_ee.CreateRepoSyncRuleResponse{}
 	request := cr_ee.CreateCreateRepoSyncRuleRequest()
 	request.RegionId = crService.client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.SyncRuleName = syncRuleName
 	request.InstanceId = instanceId
@@ -131,23 +133,23 @@ func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta in
 		return creeClient.CreateRepoSyncRule(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_ee_sync_rule", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_ee_sync_rule", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	response, _ = raw.(*cr_ee.CreateRepoSyncRuleResponse)
 	if !response.CreateRepoSyncRuleIsSuccess {
-		return crService.wrapCrServiceError("alibabacloudstack_cr_ee_sync_rule", request.GetActionName(), response.Code)
+		return DiagnosticsFromError(crService.wrapCrServiceError("alibabacloudstack_cr_ee_sync_rule", request.GetActionName(), response.Code))
 	}
 
 	d.SetId(crService.GenResourceId(instanceId, namespaceName, response.SyncRuleId))
 
-	return resourceAlibabacloudStackCrEESyncRuleRead(d, meta)
+	return resourceAlibabacloudStackCrEESyncRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEESyncRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEESyncRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	resp, err := crService.DescribeCrEESyncRule(d.Id())
 	if err != nil {
@@ -155,7 +157,7 @@ func resourceAlibabacloudStackCrEESyncRuleRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", resp.SyncRuleName)
@@ -174,20 +176,20 @@ func resourceAlibabacloudStackCrEESyncRuleRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackCrEESyncRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCrEESyncRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
 	syncRuleId := d.Get("rule_id").(string)
 	syncDirection := d.Get("sync_direction").(string)
 	if syncDirection != "FROM" {
-		return WrapError(Error(DefaultErrorMsg, d.Id(), "delete", "[Please delete sync rule in the source instance]"))
+		return DiagnosticsFromError(WrapError(Error(DefaultErrorMsg, d.Id(), "delete", "[Please delete sync rule in the source instance]")))
 	}
 
 	response := &cr_ee.DeleteRepoSyncRuleResponse{}
 	request := cr_ee.CreateDeleteRepoSyncRuleRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.RegionId = crService.client.RegionId
 	request.InstanceId = instanceId
@@ -196,13 +198,13 @@ func resourceAlibabacloudStackCrEESyncRuleDelete(d *schema.ResourceData, meta in
 		return creeClient.DeleteRepoSyncRule(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	response, _ = raw.(*cr_ee.DeleteRepoSyncRuleResponse)
 	if !response.DeleteRepoSyncRuleIsSuccess {
-		return crService.wrapCrServiceError(d.Id(), request.GetActionName(), response.Code)
+		return DiagnosticsFromError(crService.wrapCrServiceError(d.Id(), request.GetActionName(), response.Code))
 	}
 
 	return nil
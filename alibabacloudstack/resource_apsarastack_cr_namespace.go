@@ -1,14 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -17,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackCRNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCRNamespaceCreate,
-		Read:   resourceAlibabacloudStackCRNamespaceRead,
-		Update: resourceAlibabacloudStackCRNamespaceUpdate,
-		Delete: resourceAlibabacloudStackCRNamespaceDelete,
+		CreateContext: resourceAlibabacloudStackCRNamespaceCreate,
+		ReadContext:   resourceAlibabacloudStackCRNamespaceRead,
+		UpdateContext: resourceAlibabacloudStackCRNamespaceUpdate,
+		DeleteContext: resourceAlibabacloudStackCRNamespaceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -45,88 +45,56 @@ func resourceAlibabacloudStackCRNamespace() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCRNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var uresponse, bresponse *responses.CommonResponse
 	resp := crResponse{}
 	namespaceName := d.Get("name").(string)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = client.Domain
-	request.Version = "2016-06-07"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "CreateNamespace"
+	request := client.NewCommonRequest("POST", "cr", "2016-06-07", "CreateNamespace", "")
 	request.SetContentType("application/json")
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":  client.SecretKey,
-		"AccessKeyId":      client.AccessKey,
-		"Product":          "cr",
-		"Department":       client.Department,
-		"ResourceGroup":    client.ResourceGroup,
-		"RegionId":         client.RegionId,
-		"Action":           "CreateNamespace",
-		"Version":          "2016-06-07",
-		"NamespaceName":    namespaceName,
-		"Arch":             "x86_64",
-		"HaApsaraStack":    "false",
-		"SignatureVersion": "2.1",
-		"Language":         "zh",
-		"X-acs-body": fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%d\",\"%s\":\"%d\"}}",
-			"namespace", "NamespaceName", namespaceName, "namespace", namespaceName, "Language", "zh", "haApsaraStack", "false", "arch", "x86_64", "RegionId", "cn-wulan-env48-d01", "Department", 37, "ResourceGroup", 124),
-	}
+	request.QueryParams["NamespaceName"] = namespaceName
+	request.QueryParams["Arch"] = "x86_64"
+	request.QueryParams["HaApsaraStack"] = "false"
+	request.QueryParams["Language"] = "zh"
+	request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%d\",\"%s\":\"%d\"}}",
+		"namespace", "NamespaceName", namespaceName, "namespace", namespaceName, "Language", "zh", "haApsaraStack", "false", "arch", "x86_64", "RegionId", "cn-wulan-env48-d01", "Department", 37, "ResourceGroup", 124)
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	bresponse, _ = raw.(*responses.CommonResponse)
 	log.Printf("response for create %v", bresponse)
 	err = json.Unmarshal(bresponse.GetHttpContentBytes(), &resp)
 	log.Printf("umarshalled response for create %v", resp)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	create := d.Get("auto_create").(bool)
 	visibility := d.Get("default_visibility").(string)
 	if create == false || visibility == "PUBLIC" {
-		request.ApiName = "UpdateNamespace"
-		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceId": namespaceName, "x-acs-content-type": "application/json;charset=UTF-8", "Content-type": "application/json;charset=UTF-8"}
-
-		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
-			"AccessKeyId":      client.AccessKey,
-			"Product":          "cr",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "UpdateNamespace",
-			"Method":           "POST",
-			"Version":          "2016-06-07",
-			"ContentType":      "application/json",
-			"SignatureVersion": "2.1",
-			"Accept-Language":  "zh-CN",
-			"X-acs-body": fmt.Sprintf("{\"%s\":{\"%s\":%t,\"%s\":\"%s\"}}",
-				"Namespace", "AutoCreate", create, "DefaultVisibility", visibility),
-			"Namespace": namespaceName,
-		}
+		request = client.NewCommonRequest("POST", "cr", "2016-06-07", "UpdateNamespace", "")
+		request.Headers["x-acs-instanceId"] = namespaceName
+		request.Headers["x-acs-content-type"] = "application/json;charset=UTF-8"
+		request.Headers["Content-type"] = "application/json;charset=UTF-8"
+		request.QueryParams["Method"] = "POST"
+		request.QueryParams["ContentType"] = "application/json"
+		request.QueryParams["Accept-Language"] = "zh-CN"
+		request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":{\"%s\":%t,\"%s\":\"%s\"}}",
+			"Namespace", "AutoCreate", create, "DefaultVisibility", visibility)
+		request.QueryParams["Namespace"] = namespaceName
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		uresponse, _ = raw.(*responses.CommonResponse)
 		err = json.Unmarshal(uresponse.GetHttpContentBytes(), &resp)
 		log.Printf("response for update %v", &resp)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 	}
@@ -134,60 +102,40 @@ func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta int
 
 	d.SetId(namespaceName)
 
-	return resourceAlibabacloudStackCRNamespaceUpdate(d, meta)
+	return resourceAlibabacloudStackCRNamespaceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCRNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//
 	create := d.Get("auto_create").(bool)
 	visibility := d.Get("default_visibility").(string)
 	if d.HasChange("auto_create") || d.HasChange("default_visibility") {
-		request := requests.NewCommonRequest()
-		request.Method = "POST"
-		request.Product = "cr"
-		request.Domain = client.Domain
-		request.Version = "2016-06-07"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "UpdateNamespace"
-		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceId": d.Id(), "x-acs-content-type": "application/json;charset=UTF-8", "Content-type": "application/json;charset=UTF-8"}
-
-		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
-			"AccessKeyId":      client.AccessKey,
-			"Product":          "cr",
-			"Format":           "JSON",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "UpdateNamespace",
-			"Method":           "POST",
-			"Version":          "2016-06-07",
-			"SignatureVersion": "2.1",
-			"Accept-Language":  "zh-CN",
-			"X-acs-body": fmt.Sprintf("{\"%s\":{\"%s\":%t,\"%s\":\"%s\"}}",
-				"Namespace", "AutoCreate", create, "DefaultVisibility", visibility),
-			"Namespace": d.Id(),
-		}
+		request := client.NewCommonRequest("POST", "cr", "2016-06-07", "UpdateNamespace", "")
+		request.Headers["x-acs-instanceId"] = d.Id()
+		request.Headers["x-acs-content-type"] = "application/json;charset=UTF-8"
+		request.Headers["Content-type"] = "application/json;charset=UTF-8"
+		request.QueryParams["Format"] = "JSON"
+		request.QueryParams["Method"] = "POST"
+		request.QueryParams["Accept-Language"] = "zh-CN"
+		request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":{\"%s\":%t,\"%s\":\"%s\"}}",
+			"Namespace", "AutoCreate", create, "DefaultVisibility", visibility)
+		request.QueryParams["Namespace"] = d.Id()
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request)
 	}
 
-	return resourceAlibabacloudStackCRNamespaceRead(d, meta)
+	return resourceAlibabacloudStackCRNamespaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := CrService{client}
 
 	object, err := crService.DescribeCrNamespace(d.Id())
@@ -196,7 +144,7 @@ func resourceAlibabacloudStackCRNamespaceRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.Data.Namespace.Namespace)
@@ -206,44 +154,25 @@ func resourceAlibabacloudStackCRNamespaceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackCRNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCRNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	resp := crResponse{}
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = client.Domain
-	request.Version = "2016-06-07"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DeleteNamespace"
-	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceId": d.Id(), "x-acs-content-type": "application/json;charset=UTF-8", "Content-type": "application/json;charset=UTF-8"}
-
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "DeleteNamespace",
-		"Version":         "2016-06-07",
-		"Namespace":       d.Id(),
-	}
+	request := client.NewCommonRequest("POST", "cr", "2016-06-07", "DeleteNamespace", "")
+	request.Headers["x-acs-instanceId"] = d.Id()
+	request.Headers["x-acs-content-type"] = "application/json;charset=UTF-8"
+	request.Headers["Content-type"] = "application/json;charset=UTF-8"
+	request.QueryParams["Namespace"] = d.Id()
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	uresponse, _ := raw.(*responses.CommonResponse)
 	err = json.Unmarshal(uresponse.GetHttpContentBytes(), &resp)
 	log.Printf("response for delete %v", &resp)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug(request.GetActionName(), raw, request)
@@ -53,14 +53,13 @@ func testSweepCRNamespace(region string) error {
 	request.ApiName = "GetNamespaceList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "GetNamespaceList",
-		"Version":         "2016-06-07",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "cr",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "GetNamespaceList",
+		"Version":       "2016-06-07",
 	}
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
@@ -97,15 +96,14 @@ func testSweepCRNamespace(region string) error {
 		request.ApiName = "DeleteNamespace"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "cr",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "DeleteNamespace",
-			"Version":         "2016-06-07",
-			"Namespace":       n,
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "cr",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"RegionId":      client.RegionId,
+			"Action":        "DeleteNamespace",
+			"Version":       "2016-06-07",
+			"Namespace":     n,
 		}
 		_, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 			return crClient.ProcessCommonRequest(request)
@@ -1,14 +1,15 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -17,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackCRRepo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCRRepoCreate,
-		Read:   resourceAlibabacloudStackCRRepoRead,
-		Update: resourceAlibabacloudStackCRRepoUpdate,
-		Delete: resourceAlibabacloudStackCRRepoDelete,
+		CreateContext: resourceAlibabacloudStackCRRepoCreate,
+		ReadContext:   resourceAlibabacloudStackCRRepoRead,
+		UpdateContext: resourceAlibabacloudStackCRRepoUpdate,
+		DeleteContext: resourceAlibabacloudStackCRRepoDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -78,8 +79,8 @@ func resourceAlibabacloudStackCRRepo() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCRRepoCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCRRepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	resp := ResponseCr{}
 	repoNamespace := d.Get("namespace").(string)
@@ -87,43 +88,26 @@ func resourceAlibabacloudStackCRRepoCreate(d *schema.ResourceData, meta interfac
 	summary := d.Get("summary").(string)
 	repoType := d.Get("repo_type").(string)
 	detail := d.Get("detail").(string)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = client.Domain
-	request.Version = "2016-06-07"
-	request.Scheme = "http"
-	request.ApiName = "CreateRepo"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "CreateRepo",
-		"Version":         "2016-06-07",
-		"X-acs-body":      fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "RepoName", repoName, "RepoNamespace", repoNamespace, "repoType", repoType, "summary", summary, "detail", detail),
-	}
+	request := client.NewCommonRequest("POST", "cr", "2016-06-07", "CreateRepo", "")
+	request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "RepoName", repoName, "RepoNamespace", repoNamespace, "repoType", repoType, "summary", summary, "detail", detail)
 
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	response := raw.(*responses.CommonResponse)
 	log.Printf("repo create response %v", response)
 	err = json.Unmarshal(response.GetHttpContentBytes(), &resp)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	log.Printf("repo create unmarshalled response %v", &resp)
 	addDebug(request.GetActionName(), raw, request)
 	d.SetId(fmt.Sprintf("%s%s%s", repoNamespace, SLASH_SEPARATED, repoName))
 
-	return resourceAlibabacloudStackCRRepoRead(d, meta)
+	return resourceAlibabacloudStackCRRepoRead(ctx, d, meta)
 }
 
 type ResponseCr struct {
@@ -136,8 +120,8 @@ type ResponseCr struct {
 	SuccessResponse bool `json:"successResponse"`
 }
 
-func resourceAlibabacloudStackCRRepoUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCRRepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	resp := ResponseCr{}
 	repoNamespace := d.Get("namespace").(string)
 	repoName := d.Get("name").(string)
@@ -145,48 +129,31 @@ func resourceAlibabacloudStackCRRepoUpdate(d *schema.ResourceData, meta interfac
 	repoType := d.Get("repo_type").(string)
 	detail := d.Get("detail").(string)
 	if d.HasChange("summary") || d.HasChange("detail") || d.HasChange("repo_type") {
-		request := requests.NewCommonRequest()
-		request.Method = "POST"
-		request.Product = "cr"
-		request.Domain = client.Domain
-		request.Version = "2016-06-07"
-		request.Scheme = "http"
-		request.ApiName = "UpdateRepo"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "cr",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "UpdateRepo",
-			"Version":         "2016-06-07",
-			"RepoNamespace":   repoNamespace,
-			"RepoName":        repoName,
-			"X-acs-body":      fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "repoType", repoType, "summary", summary, "detail", detail),
-		}
+		request := client.NewCommonRequest("POST", "cr", "2016-06-07", "UpdateRepo", "")
+		request.QueryParams["RepoNamespace"] = repoNamespace
+		request.QueryParams["RepoName"] = repoName
+		request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "repoType", repoType, "summary", summary, "detail", detail)
 		raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 			return crClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		response := raw.(*responses.CommonResponse)
 		log.Printf("repo create response %v", response)
 		err = json.Unmarshal(response.GetHttpContentBytes(), &resp)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		log.Printf("repo create unmarshalled response %v", &resp)
 		addDebug(request.GetActionName(), raw, request)
 	}
-	return resourceAlibabacloudStackCRRepoRead(d, meta)
+	return resourceAlibabacloudStackCRRepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRRepoRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	crService := CrService{client}
 
 	object, err := crService.DescribeCrRepo(d.Id())
@@ -195,7 +162,7 @@ func resourceAlibabacloudStackCRRepoRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("namespace", object.Data.Repo.RepoNamespace)
@@ -214,38 +181,21 @@ func resourceAlibabacloudStackCRRepoRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAlibabacloudStackCRRepoDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCRRepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	sli := strings.Split(d.Id(), SLASH_SEPARATED)
 	repoNamespace := sli[0]
 	repoName := sli[1]
 	//
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = client.Domain
-	request.Version = "2016-06-07"
-	request.Scheme = "http"
-	request.ApiName = "DeleteRepo"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "DeleteRepo",
-		"Version":         "2016-06-07",
-		"RepoNamespace":   repoNamespace,
-		"RepoName":        repoName,
-	}
+	request := client.NewCommonRequest("POST", "cr", "2016-06-07", "DeleteRepo", "")
+	request.QueryParams["RepoNamespace"] = repoNamespace
+	request.QueryParams["RepoName"] = repoName
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_repo", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request)
 
@@ -1,14 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	//	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	//	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
 	"regexp"
@@ -42,10 +42,10 @@ var (
 
 func resourceAlibabacloudStackCSKubernetes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCSKubernetesCreate,
-		Read:   resourceAlibabacloudStackCSKubernetesRead,
-		Update: resourceAlibabacloudStackCSKubernetesUpdate,
-		Delete: resourceAlibabacloudStackCSKubernetesDelete,
+		CreateContext: resourceAlibabacloudStackCSKubernetesCreate,
+		ReadContext:   resourceAlibabacloudStackCSKubernetesRead,
+		UpdateContext: resourceAlibabacloudStackCSKubernetesUpdate,
+		DeleteContext: resourceAlibabacloudStackCSKubernetesDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -612,9 +612,9 @@ type ClusterCommonResponse struct {
 	InstanceId string `json:"instanceId"`
 }
 
-func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("check meta %v", meta)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csService := CsService{client}
 	invoker := NewInvoker()
 	var requestInfo *cs.Client
@@ -709,7 +709,7 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 			kmsService := KmsService{client}
 			decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			password = decryptResp.Plaintext
 		}
@@ -738,10 +738,8 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 		SecurityGroup = d.Get("security_group_id").(bool)
 	}
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
+	request := client.NewCommonRequest("POST", "CS", "2015-12-15", "CreateCluster", "")
+	request.Headers["x-acs-content-type"] = "application/json"
 	var wvid, mvid, winst, minst, podid, inst string
 	var formatDisk, retainIname bool
 
@@ -827,248 +825,197 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 	log.Printf("winsts %v minsts %v", winst, minst)
 	if attachinst == 1 {
 		if pod == 0 {
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "Cs",
-				"Department":       client.Department,
-				"ResourceGroup":    client.ResourceGroup,
-				"Action":           "CreateCluster",
-				"AccountInfo":      "123456",
-				"Version":          "2015-12-15",
-				"SignatureVersion": "1.0",
-				"ProductName":      "cs",
-				"X-acs-body": fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
-					"Product", "Cs",
-					"os_type", OsType,
-					"platform", Platform,
-					"cluster_type", clustertype,
-					"region_id", client.RegionId,
-					"timeout_mins", timeout,
-					"disable_rollback", true,
-					"kubernetes_version", KubernetesVersion,
-					"container_cidr", pcidr,
-					"service_cidr", scdir,
-					"name", Name,
-					"master_instance_types", minst,
-					"master_vswitch_ids", mvid,
-					"login_Password", LoginPassword,
-					"num_of_nodes", NumOfNodes,
-					"master_count", mastercount,
-					"snat_entry", SnatEntry,
-					"endpoint_public_access", end,
-					"ssh_flags", enabSsh,
-					"master_system_disk_category", msysdiskcat,
-					"master_system_disk_size", msysdisksize,
-					"deletion_protection", delete_pro,
-					"node_cidr_mask", nodecidr,
-					"vpcid", VpcId,
-					"addons", req,
-					"proxy_mode", proxy_mode,
-					"instances", inst,
-					"format_disk", formatDisk,
-					"keep_instance_name", retainIname,
-					"user_data", udata,
-					"runtime", runtime,
-					"node_port_range", nodeportrange,
-					"cpu_policy", cpuPolicy,
-					"worker_data_disks", workerdisks,
-					secgroup, SecurityGroup,
-					"cloud_monitor_flags", CloudMonitorFlags,
-					"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
-					"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
-					"is_enterprise_security_group", IsEnterpriseSecurityGroup,
-					"image_id", ImageId,
-					"tags", tags,
-				),
-			}
+			request.QueryParams["AccountInfo"] = "123456"
+			request.QueryParams["ProductName"] = "cs"
+			request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
+				"Product", "Cs",
+				"os_type", OsType,
+				"platform", Platform,
+				"cluster_type", clustertype,
+				"region_id", client.RegionId,
+				"timeout_mins", timeout,
+				"disable_rollback", true,
+				"kubernetes_version", KubernetesVersion,
+				"container_cidr", pcidr,
+				"service_cidr", scdir,
+				"name", Name,
+				"master_instance_types", minst,
+				"master_vswitch_ids", mvid,
+				"login_Password", LoginPassword,
+				"num_of_nodes", NumOfNodes,
+				"master_count", mastercount,
+				"snat_entry", SnatEntry,
+				"endpoint_public_access", end,
+				"ssh_flags", enabSsh,
+				"master_system_disk_category", msysdiskcat,
+				"master_system_disk_size", msysdisksize,
+				"deletion_protection", delete_pro,
+				"node_cidr_mask", nodecidr,
+				"vpcid", VpcId,
+				"addons", req,
+				"proxy_mode", proxy_mode,
+				"instances", inst,
+				"format_disk", formatDisk,
+				"keep_instance_name", retainIname,
+				"user_data", udata,
+				"runtime", runtime,
+				"node_port_range", nodeportrange,
+				"cpu_policy", cpuPolicy,
+				"worker_data_disks", workerdisks,
+				secgroup, SecurityGroup,
+				"cloud_monitor_flags", CloudMonitorFlags,
+				"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
+				"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
+				"is_enterprise_security_group", IsEnterpriseSecurityGroup,
+				"image_id", ImageId,
+				"tags", tags,
+			)
 		} else {
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "Cs",
-				"Department":       client.Department,
-				"ResourceGroup":    client.ResourceGroup,
-				"Action":           "CreateCluster",
-				"AccountInfo":      "123456",
-				"Version":          "2015-12-15",
-				"SignatureVersion": "1.0",
-				"ProductName":      "cs",
-				"X-acs-body": fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
-					"Product", "Cs",
-					"os_type", OsType,
-					"platform", Platform,
-					"cluster_type", clustertype,
-					"region_id", client.RegionId,
-					"timeout_mins", timeout,
-					"disable_rollback", true,
-					"kubernetes_version", KubernetesVersion,
-					"container_cidr", pcidr,
-					"service_cidr", scdir,
-					"name", Name,
-					"master_instance_types", minst,
-					"master_vswitch_ids", mvid,
-					"login_Password", LoginPassword,
-					"num_of_nodes", NumOfNodes,
-					"master_count", mastercount,
-					"snat_entry", SnatEntry,
-					"endpoint_public_access", end,
-					"ssh_flags", enabSsh,
-					"master_system_disk_category", msysdiskcat,
-					"master_system_disk_size", msysdisksize,
-					"deletion_protection", delete_pro,
-					"node_cidr_mask", nodecidr,
-					"vpcid", VpcId,
-					"addons", req,
-					"pod_vswitch_ids", podid,
-					"proxy_mode", proxy_mode,
-					"instances", inst,
-					"format_disk", formatDisk,
-					"keep_instance_name", retainIname,
-					"user_data", udata,
-					"runtime", runtime,
-					"node_port_range", nodeportrange,
-					"cpu_policy", cpuPolicy,
-					"worker_data_disks", workerdisks,
-					secgroup, SecurityGroup,
-					"cloud_monitor_flags", CloudMonitorFlags,
-					"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
-					"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
-					"is_enterprise_security_group", IsEnterpriseSecurityGroup,
-					"image_id", ImageId,
-					"tags", tags,
-				),
-			}
+			request.QueryParams["AccountInfo"] = "123456"
+			request.QueryParams["ProductName"] = "cs"
+			request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
+				"Product", "Cs",
+				"os_type", OsType,
+				"platform", Platform,
+				"cluster_type", clustertype,
+				"region_id", client.RegionId,
+				"timeout_mins", timeout,
+				"disable_rollback", true,
+				"kubernetes_version", KubernetesVersion,
+				"container_cidr", pcidr,
+				"service_cidr", scdir,
+				"name", Name,
+				"master_instance_types", minst,
+				"master_vswitch_ids", mvid,
+				"login_Password", LoginPassword,
+				"num_of_nodes", NumOfNodes,
+				"master_count", mastercount,
+				"snat_entry", SnatEntry,
+				"endpoint_public_access", end,
+				"ssh_flags", enabSsh,
+				"master_system_disk_category", msysdiskcat,
+				"master_system_disk_size", msysdisksize,
+				"deletion_protection", delete_pro,
+				"node_cidr_mask", nodecidr,
+				"vpcid", VpcId,
+				"addons", req,
+				"pod_vswitch_ids", podid,
+				"proxy_mode", proxy_mode,
+				"instances", inst,
+				"format_disk", formatDisk,
+				"keep_instance_name", retainIname,
+				"user_data", udata,
+				"runtime", runtime,
+				"node_port_range", nodeportrange,
+				"cpu_policy", cpuPolicy,
+				"worker_data_disks", workerdisks,
+				secgroup, SecurityGroup,
+				"cloud_monitor_flags", CloudMonitorFlags,
+				"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
+				"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
+				"is_enterprise_security_group", IsEnterpriseSecurityGroup,
+				"image_id", ImageId,
+				"tags", tags,
+			)
 		}
 	} else {
 		if pod == 0 {
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "Cs",
-				"Department":       client.Department,
-				"ResourceGroup":    client.ResourceGroup,
-				"Action":           "CreateCluster",
-				"AccountInfo":      "123456",
-				"Version":          "2015-12-15",
-				"SignatureVersion": "1.0",
-				"ProductName":      "cs",
-				"X-acs-body": fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
-					"Product", "Cs",
-					"os_type", OsType,
-					"platform", Platform,
-					"cluster_type", clustertype,
-					"region_id", client.RegionId,
-					"timeout_mins", timeout,
-					"disable_rollback", true,
-					"kubernetes_version", KubernetesVersion,
-					"container_cidr", pcidr,
-					"service_cidr", scdir,
-					"name", Name,
-					"master_instance_types", minst,
-					"worker_instance_types", winst,
-					"master_vswitch_ids", mvid,
-					"worker_vswitch_ids", wvid,
-					"login_Password", LoginPassword,
-					"num_of_nodes", NumOfNodes,
-					"master_count", mastercount,
-					"snat_entry", SnatEntry,
-					"endpoint_public_access", end,
-					"ssh_flags", enabSsh,
-					"master_system_disk_category", msysdiskcat,
-					"master_system_disk_size", msysdisksize,
-					"worker_system_disk_category", wsysdiskcat,
-					"worker_system_disk_size", wsysdisksize,
-					"deletion_protection", delete_pro,
-					"node_cidr_mask", nodecidr,
-					"vpcid", VpcId,
-					"addons", req,
-					"proxy_mode", proxy_mode,
-					"user_data", udata,
-					"runtime", runtime,
-					"node_port_range", nodeportrange,
-					"cpu_policy", cpuPolicy,
-					secgroup, SecurityGroup,
-					"cloud_monitor_flags", CloudMonitorFlags,
-					"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
-					"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
-					"worker_data_disks", workerdisks,
-					"is_enterprise_security_group", IsEnterpriseSecurityGroup,
-					"image_id", ImageId,
-					"tags", tags,
-				),
-			}
+			request.QueryParams["AccountInfo"] = "123456"
+			request.QueryParams["ProductName"] = "cs"
+			request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
+				"Product", "Cs",
+				"os_type", OsType,
+				"platform", Platform,
+				"cluster_type", clustertype,
+				"region_id", client.RegionId,
+				"timeout_mins", timeout,
+				"disable_rollback", true,
+				"kubernetes_version", KubernetesVersion,
+				"container_cidr", pcidr,
+				"service_cidr", scdir,
+				"name", Name,
+				"master_instance_types", minst,
+				"worker_instance_types", winst,
+				"master_vswitch_ids", mvid,
+				"worker_vswitch_ids", wvid,
+				"login_Password", LoginPassword,
+				"num_of_nodes", NumOfNodes,
+				"master_count", mastercount,
+				"snat_entry", SnatEntry,
+				"endpoint_public_access", end,
+				"ssh_flags", enabSsh,
+				"master_system_disk_category", msysdiskcat,
+				"master_system_disk_size", msysdisksize,
+				"worker_system_disk_category", wsysdiskcat,
+				"worker_system_disk_size", wsysdisksize,
+				"deletion_protection", delete_pro,
+				"node_cidr_mask", nodecidr,
+				"vpcid", VpcId,
+				"addons", req,
+				"proxy_mode", proxy_mode,
+				"user_data", udata,
+				"runtime", runtime,
+				"node_port_range", nodeportrange,
+				"cpu_policy", cpuPolicy,
+				secgroup, SecurityGroup,
+				"cloud_monitor_flags", CloudMonitorFlags,
+				"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
+				"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
+				"worker_data_disks", workerdisks,
+				"is_enterprise_security_group", IsEnterpriseSecurityGroup,
+				"image_id", ImageId,
+				"tags", tags,
+			)
 		} else {
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "Cs",
-				"Department":       client.Department,
-				"ResourceGroup":    client.ResourceGroup,
-				"Action":           "CreateCluster",
-				"AccountInfo":      "123456",
-				"Version":          "2015-12-15",
-				"SignatureVersion": "1.0",
-				"ProductName":      "cs",
-				"X-acs-body": fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":[\"%s\"],\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
-					"Product", "Cs",
-					"os_type", OsType,
-					"platform", Platform,
-					"cluster_type", clustertype,
-					"region_id", client.RegionId,
-					"timeout_mins", timeout,
-					"disable_rollback", true,
-					"kubernetes_version", KubernetesVersion,
-					"container_cidr", pcidr,
-					"service_cidr", scdir,
-					"name", Name,
-					"master_instance_types", minst,
-					"worker_instance_types", winst,
-					"master_vswitch_ids", mvid,
-					"worker_vswitch_ids", wvid,
-					"login_Password", LoginPassword,
-					"num_of_nodes", NumOfNodes,
-					"master_count", mastercount,
-					"snat_entry", SnatEntry,
-					"endpoint_public_access", end,
-					"ssh_flags", enabSsh,
-					"master_system_disk_category", msysdiskcat,
-					"master_system_disk_size", msysdisksize,
-					"worker_system_disk_category", wsysdiskcat,
-					"worker_system_disk_size", wsysdisksize,
-					"deletion_protection", delete_pro,
-					"node_cidr_mask", nodecidr,
-					"vpcid", VpcId,
-					"addons", req,
-					"proxy_mode", proxy_mode,
-					"user_data", udata,
-					"runtime", runtime,
-					"node_port_range", nodeportrange,
-					"cpu_policy", cpuPolicy,
-					secgroup, SecurityGroup,
-					"cloud_monitor_flags", CloudMonitorFlags,
-					"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
-					"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
-					"worker_data_disks", workerdisks,
-					"pod_vswitch_ids", podid,
-					"is_enterprise_security_group", IsEnterpriseSecurityGroup,
-					"image_id", ImageId,
-					"tags", tags,
-				),
-			}
+			request.QueryParams["AccountInfo"] = "123456"
+			request.QueryParams["ProductName"] = "cs"
+			request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":[\"%s\"],\"%s\":\"%s\",\"%s\":%d,\"%s\":%d,\"%s\":%t,\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":%d,\"%s\":\"%s\",\"%s\":%d,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":{%s},\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%t,\"%s\":%t,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":[%s],\"%s\":[\"%s\"],\"%s\":%t,\"%s\":\"%s\",\"%s\":%s}",
+				"Product", "Cs",
+				"os_type", OsType,
+				"platform", Platform,
+				"cluster_type", clustertype,
+				"region_id", client.RegionId,
+				"timeout_mins", timeout,
+				"disable_rollback", true,
+				"kubernetes_version", KubernetesVersion,
+				"container_cidr", pcidr,
+				"service_cidr", scdir,
+				"name", Name,
+				"master_instance_types", minst,
+				"worker_instance_types", winst,
+				"master_vswitch_ids", mvid,
+				"worker_vswitch_ids", wvid,
+				"login_Password", LoginPassword,
+				"num_of_nodes", NumOfNodes,
+				"master_count", mastercount,
+				"snat_entry", SnatEntry,
+				"endpoint_public_access", end,
+				"ssh_flags", enabSsh,
+				"master_system_disk_category", msysdiskcat,
+				"master_system_disk_size", msysdisksize,
+				"worker_system_disk_category", wsysdiskcat,
+				"worker_system_disk_size", wsysdisksize,
+				"deletion_protection", delete_pro,
+				"node_cidr_mask", nodecidr,
+				"vpcid", VpcId,
+				"addons", req,
+				"proxy_mode", proxy_mode,
+				"user_data", udata,
+				"runtime", runtime,
+				"node_port_range", nodeportrange,
+				"cpu_policy", cpuPolicy,
+				secgroup, SecurityGroup,
+				"cloud_monitor_flags", CloudMonitorFlags,
+				"master_system_disk_performance_level", MasterSystemDiskPerformanceLevel,
+				"worker_system_disk_performance_level", WorkerSystemDiskPerformanceLevel,
+				"worker_data_disks", workerdisks,
+				"pod_vswitch_ids", podid,
+				"is_enterprise_security_group", IsEnterpriseSecurityGroup,
+				"image_id", ImageId,
+				"tags", tags,
+			)
 		}
 	}
-	request.Method = "POST"        // Set request method
-	request.Product = "CS"         // Specify product
-	request.Version = "2015-12-15" // Specify product version
-	request.ServiceCode = "cs"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "CreateCluster"
-	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json"}
 
 	var err error
 	err = nil
@@ -1079,7 +1026,7 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 		return err
 	}); err != nil {
 		//return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "CreateKubernetesCluster", raw)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	if debugOn() {
 		requestMap := make(map[string]interface{})
@@ -1090,7 +1037,7 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		//return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	if debugOn() {
@@ -1100,25 +1047,25 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 	cluster, _ := raw.(*responses.CommonResponse)
 	if cluster.IsSuccess() == false {
 		//return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm", "API Action", cluster.GetHttpContentString())
-		return err
+		return DiagnosticsFromError(err)
 	}
 	ok := json.Unmarshal(cluster.GetHttpContentBytes(), &clusterresponse)
 	if ok != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "ParseKubernetesClusterResponse", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "ParseKubernetesClusterResponse", raw))
 	}
 	d.SetId(clusterresponse.ClusterID)
 
 	stateConf := BuildStateConf([]string{"initial", " "}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 15*time.Minute, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackCSKubernetesUpdate(d, meta)
+	return resourceAlibabacloudStackCSKubernetesUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csService := CsService{client}
 	d.Partial(true)
 	var raw interface{}
@@ -1126,7 +1073,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 
 	nodepool, err := csService.DescribeClusterNodePools(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var nodepoolid string
 	for _, k := range nodepool.Nodepools {
@@ -1139,7 +1086,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 		if len(nodepool.Nodepools) == 1 && nodepool.Nodepools[0].NodepoolInfo.Name == "default-nodepool" {
 			nodepoolid = nodepool.Nodepools[0].NodepoolInfo.NodepoolID
 		} else {
-			return WrapErrorf(fmt.Errorf("can not found default node_pool"), "DescribeClusterNodePools", nodepool.Nodepools)
+			return DiagnosticsFromError(WrapErrorf(fmt.Errorf("can not found default node_pool"), "DescribeClusterNodePools", nodepool.Nodepools))
 		}
 	}
 	d.Set("nodepool_id", nodepoolid)
@@ -1150,7 +1097,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 				kmsService := KmsService{client}
 				decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 				password = decryptResp.Plaintext
 			}
@@ -1159,11 +1106,11 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 		oldV, newV := d.GetChange("num_of_nodes")
 		oldValue, ok := oldV.(int)
 		if ok != true {
-			return WrapErrorf(fmt.Errorf("num_of_nodes old value can not be parsed"), "parseError %d", oldValue)
+			return DiagnosticsFromError(WrapErrorf(fmt.Errorf("num_of_nodes old value can not be parsed"), "parseError %d", oldValue))
 		}
 		newValue, ok := newV.(int)
 		if ok != true {
-			return WrapErrorf(fmt.Errorf("num_of_nodes new value can not be parsed"), "parseError %d", newValue)
+			return DiagnosticsFromError(WrapErrorf(fmt.Errorf("num_of_nodes new value can not be parsed"), "parseError %d", newValue))
 		}
 
 		if newValue < oldValue {
@@ -1174,7 +1121,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 					d.SetId("")
 					return nil
 				}
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			var allNodeName []string
 			for _, value := range object.Nodes {
@@ -1184,41 +1131,16 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 			removeNodesName := allNodeName[:count]
 			if len(removeNodesName) > 0 {
 			}
-			req := requests.NewCommonRequest()
-			if csService.client.Config.Insecure {
-				req.SetHTTPSInsecure(csService.client.Config.Insecure)
-			}
-			req.QueryParams = map[string]string{
-				"RegionId":         csService.client.RegionId,
-				"AccessKeySecret":  csService.client.SecretKey,
-				"Product":          "CS",
-				"Department":       csService.client.Department,
-				"ResourceGroup":    csService.client.ResourceGroup,
-				"Action":           "RemoveClusterNodes",
-				"AccountInfo":      "123456",
-				"Version":          "2015-12-15",
-				"SignatureVersion": "1.0",
-				"ProductName":      "cs",
-
-				"X-acs-body": fmt.Sprintf("{\"%s\":%t,\"%s\":%t,\"%s\":%q,\"%s\":\"%s\"}",
-
-					"release_node", true,
-					"drain_node", true,
-					"nodes", removeNodesName,
-					"ClusterId", d.Id(),
-				),
-			}
-			req.Method = "POST"        // Set request method
-			req.Product = "CS"         // Specify product
-			req.Version = "2015-12-15" // Specify product version
-			req.ServiceCode = "cs"
-			if strings.ToLower(csService.client.Config.Protocol) == "https" {
-				req.Scheme = "https"
-			} else {
-				req.Scheme = "http"
-			} // Set request scheme. Default: http
-			req.ApiName = "RemoveClusterNodes"
-			req.Headers = map[string]string{"RegionId": csService.client.RegionId, "x-acs-content-type": "application/json"}
+			req := csService.client.NewCommonRequest("POST", "CS", "2015-12-15", "RemoveClusterNodes", "")
+			req.QueryParams["AccountInfo"] = "123456"
+			req.QueryParams["ProductName"] = "cs"
+			req.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":%t,\"%s\":%t,\"%s\":%q,\"%s\":\"%s\"}",
+				"release_node", true,
+				"drain_node", true,
+				"nodes", removeNodesName,
+				"ClusterId", d.Id(),
+			)
+			req.Headers["x-acs-content-type"] = "application/json"
 			if err := invoker.Run(func() error {
 				var err error
 				raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1227,54 +1149,30 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 
 				return err
 			}); err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, nodepoolid, "DeleteKubernetesClusterNodes", DenverdinoAliyungo)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, nodepoolid, "DeleteKubernetesClusterNodes", DenverdinoAliyungo))
 			}
 			resp, _ := raw.(*responses.CommonResponse)
 			if resp.IsSuccess() == false {
 				//return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm", "API Action", cluster.GetHttpContentString())
-				return err
+				return DiagnosticsFromError(err)
 			}
 			stateConf := BuildStateConf([]string{"removing"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 60*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(nodepoolid, d.Id(), []string{"deleting", "failed"}))
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapErrorf(err, IdMsg, d.Id())
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 		}
 
 		if newValue > oldValue {
-			request := requests.NewCommonRequest()
-			if client.Config.Insecure {
-				request.SetHTTPSInsecure(client.Config.Insecure)
-			}
-			request.QueryParams = map[string]string{
-				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "CS",
-				"Department":       client.Department,
-				"ResourceGroup":    client.ResourceGroup,
-				"Action":           "ScaleClusterNodePool",
-				"AccountInfo":      "123456",
-				"Version":          "2015-12-15",
-				"SignatureVersion": "1.0",
-				"ProductName":      "cs",
-				"NodepoolId":       nodepoolid,
-				"ClusterId":        d.Id(),
-				"X-acs-body": fmt.Sprintf("{\"%s\":%d}",
-
-					"count", int64(newValue)-int64(oldValue),
-				),
-			}
-			request.Method = "POST"        // Set request method
-			request.Product = "CS"         // Specify product
-			request.Version = "2015-12-15" // Specify product version
-			request.ServiceCode = "cs"
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			} // Set request scheme. Default: http
-			request.ApiName = "ScaleClusterNodePool"
-			request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json"}
+			request := client.NewCommonRequest("POST", "CS", "2015-12-15", "ScaleClusterNodePool", "")
 			//var err error
+			request.Headers["x-acs-content-type"] = "application/json"
+			request.QueryParams["AccountInfo"] = "123456"
+			request.QueryParams["ProductName"] = "cs"
+			request.QueryParams["NodepoolId"] = nodepoolid
+			request.QueryParams["ClusterId"] = d.Id()
+			request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":%d}",
+				"count", int64(newValue)-int64(oldValue),
+			)
 			err = nil
 			if err = invoker.Run(func() error {
 				raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1282,7 +1180,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 				})
 				return err
 			}); err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "CreateKubernetesCluster", raw)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "CreateKubernetesCluster", raw))
 			}
 
 			if debugOn() {
@@ -1294,22 +1192,22 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 
 			stateConf := BuildStateConf([]string{"scaling"}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
 
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapErrorf(err, IdMsg, d.Id())
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 			//d.SetPartial("num_of_nodes")
 		}
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackCSKubernetesRead(d, meta)
+	return resourceAlibabacloudStackCSKubernetesRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csService := CsService{client}
 	object, err := csService.DescribeCsKubernetes(d.Id())
 	if err != nil {
@@ -1317,12 +1215,12 @@ func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	nodepoolid := d.Get("nodepool_id").(string)
 	clusternode, err := csService.DescribeClusterNodes(d.Id(), nodepoolid)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("name", object.Name)
 	//d.Set("id", object.ClusterId)
@@ -1356,45 +1254,22 @@ func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta inte
 	d.Set("master_nodes", smaster)
 	d.Set("worker_nodes", sworker)
 	if err := d.Set("tags", flattenTagsConfig(object.Tags)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCSKubernetesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csService := CsService{client}
 	invoker := NewInvoker()
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
-		"Product":          "CS",
-		"Department":       client.Department,
-		"ResourceGroup":    client.ResourceGroup,
-		"Action":           "DeleteCluster",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-		"ClusterId":        d.Id(),
-		"X-acs-body":       fmt.Sprintf("{\"%s\":\"%t\",\"%s\":\"%s\"}", "keep_slb", false, "ClusterId", d.Id()),
-	}
-	request.Method = "POST"        // Set request method
-	request.Product = "Cs"         // Specify product
-	request.Version = "2015-12-15" // Specify product version
-	request.ServiceCode = "cs"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "DeleteCluster"
-	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-content-type": "application/json"}
+	request := client.NewCommonRequest("POST", "Cs", "2015-12-15", "DeleteCluster", "")
+	request.Headers["x-acs-content-type"] = "application/json"
+	request.QueryParams["ProductName"] = "cs"
+	request.QueryParams["ClusterId"] = d.Id()
+	request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%t\",\"%s\":\"%s\"}", "keep_slb", false, "ClusterId", d.Id())
 	var response interface{}
-	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := invoker.Run(func() error {
 			raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 				return csClient.ProcessCommonRequest(request)
@@ -1415,12 +1290,12 @@ func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta in
 		if IsExpectedErrors(err, []string{"ErrorClusterNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteCluster", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteCluster", AlibabacloudStackLogGoSdkERROR))
 	}
 
 	stateConf := BuildStateConf([]string{"running", "deleting", "initial"}, []string{}, d.Timeout(schema.TimeoutDelete), 10*time.Minute, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"delete_failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
@@ -1430,10 +1305,6 @@ func updateKubernetesClusterTag(d *schema.ResourceData, meta interface{}) error
 	d.Partial(true)
 	var raw interface{}
 	invoker := NewInvoker()
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
 	var tags string
 	tagss := make([]interface{}, 0)
 	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
@@ -1447,38 +1318,15 @@ func updateKubernetesClusterTag(d *schema.ResourceData, meta interface{}) error
 	tagsBytes, _ := json.Marshal(tagss)
 	tags = string(tagsBytes)
 	log.Printf("checking tags %v", tags)
-	request.QueryParams = map[string]string{
-		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
-		"Product":          "CS",
-		"Department":       client.Department,
-		"ResourceGroup":    client.ResourceGroup,
-		"Action":           "ModifyClusterTags",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-		"ClusterId":        d.Id(),
-		"X-acs-body": fmt.Sprintf("{\"%s\":%s}",
-			"tags", tags,
-		),
-	}
-	request.Method = "POST"        // Set request method
-	request.Product = "CS"         // Specify product
-	request.Version = "2015-12-15" // Specify product version
-	request.ServiceCode = "cs"
-	request.PathPattern = fmt.Sprintf("/clusters/%s/tags", d.Id())
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "ModifyClusterTags"
-	request.Headers = map[string]string{
-		"RegionId":           client.RegionId,
-		"Authorization":      "AuthorizationString",
-		"Content-Type":       "application/json",
-		"x-acs-content-type": "application/json",
-	}
+	request := client.NewCommonRequest("POST", "CS", "2015-12-15", "ModifyClusterTags", fmt.Sprintf("/clusters/%s/tags", d.Id()))
+	request.Headers["Authorization"] = "AuthorizationString"
+	request.Headers["Content-Type"] = "application/json"
+	request.Headers["x-acs-content-type"] = "application/json"
+	request.QueryParams["ProductName"] = "cs"
+	request.QueryParams["ClusterId"] = d.Id()
+	request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":%s}",
+		"tags", tags,
+	)
 	var err error
 	if err = invoker.Run(func() error {
 		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1496,7 +1344,7 @@ func updateKubernetesClusterTag(d *schema.ResourceData, meta interface{}) error
 		addDebug("ModifyClusterTags", raw, resizeRequestMap)
 	}
 	stateConf := BuildStateConf([]string{"scaling"}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(client.Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
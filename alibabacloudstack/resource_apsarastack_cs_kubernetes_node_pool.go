@@ -1,15 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -27,10 +28,10 @@ const ResourceName = "resource_alibabacloudstack_cs_kubernetes_permissions"
 
 func resourceAlibabacloudStackCSKubernetesNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCSKubernetesNodePoolCreate,
-		Read:   resourceAlibabacloudStackCSNodePoolRead,
-		Update: resourceAlibabacloudStackCSNodePoolUpdate,
-		Delete: resourceAlibabacloudStackCSNodePoolDelete,
+		CreateContext: resourceAlibabacloudStackCSKubernetesNodePoolCreate,
+		ReadContext:   resourceAlibabacloudStackCSNodePoolRead,
+		UpdateContext: resourceAlibabacloudStackCSNodePoolUpdate,
+		DeleteContext: resourceAlibabacloudStackCSNodePoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -480,8 +481,8 @@ type CreateNodePoolRequest struct {
 	AutoScaling      autoScaling      `json:"auto_scaling"`
 }
 
-func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCSKubernetesNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csService := CsService{client}
 	invoker := NewInvoker()
 
@@ -492,20 +493,8 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 	// prepare args and set default value
 	request, err := buildNodePoolArgs(d, meta)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "PrepareKubernetesNodePoolArgs", err)
-	}
-	request.Method = "POST"        // Set request method
-	request.Product = "CS"         // Specify product
-	request.Version = "2015-12-15" // Specify product version
-	request.ServiceCode = "cs"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "CreateClusterNodePool"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "PrepareKubernetesNodePoolArgs", err))
+	}
 
 	if err = invoker.Run(func() error {
 		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -515,7 +504,7 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 		return err
 	}); err != nil {
 
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "CreateKubernetesNodePool", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "CreateKubernetesNodePool", raw))
 	}
 
 	if debugOn() {
@@ -528,19 +517,19 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 	nodePool, _ := raw.(*responses.CommonResponse)
 	if nodePool.IsSuccess() == false {
 		//return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm", "API Action", cluster.GetHttpContentString())
-		return err
+		return DiagnosticsFromError(err)
 	}
 	ok := json.Unmarshal(nodePool.GetHttpContentBytes(), &nodepoolresponse)
 	if ok != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "ParseKubernetesNodePoolResponse", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "ParseKubernetesNodePoolResponse", raw))
 	}
 
 	d.SetId(nodepoolresponse.NodePoolID)
 
 	// reset interval to 10s
 	stateConf := BuildStateConf([]string{"initial", "scaling"}, []string{"active"}, d.Timeout(schema.TimeoutCreate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, "ResourceID:%s , TaskID:%s ", d.Id(), nodepoolresponse.TaskId)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, "ResourceID:%s , TaskID:%s ", d.Id(), nodepoolresponse.TaskId))
 	}
 
 	// attach existing node
@@ -548,11 +537,11 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 		attachExistingInstance(d, meta)
 	}
 
-	return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+	return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCSNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csService := CsService{client}
 
 	clusterId := d.Get("cluster_id").(string)
@@ -574,34 +563,34 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 
 		oldValue, ok := oldV.(int)
 		if ok != true {
-			return WrapErrorf(fmt.Errorf("node_count old value can not be parsed"), "parseError %d", oldValue)
+			return DiagnosticsFromError(WrapErrorf(fmt.Errorf("node_count old value can not be parsed"), "parseError %d", oldValue))
 		}
 		newValue, ok := newV.(int)
 		if ok != true {
-			return WrapErrorf(fmt.Errorf("node_count new value can not be parsed"), "parseError %d", newValue)
+			return DiagnosticsFromError(WrapErrorf(fmt.Errorf("node_count new value can not be parsed"), "parseError %d", newValue))
 		}
 		log.Printf("UUUUUUUUUUUUUUUUUUUUUUUUUUUU %d , %d", newValue, oldValue)
 		if newValue < oldValue {
 			err := RemoveNodePoolNodes(d, meta, clusterId, d.Id(), nil, nil)
 			if err != nil {
-				return err
+				return DiagnosticsFromError(err)
 			}
 
 			// The removal of a node is logically independent.
 			// The removal of a node should not involve parameter changes.
-			return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+			return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 
 		}
 		//update = true
 		if newValue > oldValue {
 			err := ScaleClusterNodePool(d, meta, clusterId, d.Id(), oldValue, newValue)
 			if err != nil {
-				return err
+				return DiagnosticsFromError(err)
 			}
 
 			// The removal of a node is logically independent.
 			// The removal of a node should not involve parameter changes.
-			return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+			return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 
 		}
 	}
@@ -710,37 +699,13 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 
 	if update {
 		//begin
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
 		argJson, _ := json.Marshal(args)
-		request.QueryParams = map[string]string{
-			"RegionId":         client.RegionId,
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "CS",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"Action":           "ModifyClusterNodePool",
-			"ClusterId":        d.Get("cluster_id").(string),
-			"AccountInfo":      "123456",
-			"Version":          "2015-12-15",
-			"SignatureVersion": "1.0",
-			"ProductName":      "cs",
-			"X-acs-body":       fmt.Sprintf("%s", argJson),
-		}
-		request.Method = "POST"        // Set request method
-		request.Product = "CS"         // Specify product
-		request.Version = "2015-12-15" // Specify product version
-		request.ServiceCode = "cs"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "ModifyClusterNodePool"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
+		request := client.NewCommonRequest("POST", "CS", "2015-12-15", "ModifyClusterNodePool", "")
+		request.Headers["x-acs-asapi-gateway-version"] = "3.0"
+		request.QueryParams["ClusterId"] = d.Get("cluster_id").(string)
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["ProductName"] = "cs"
+		request.QueryParams["X-acs-body"] = fmt.Sprintf("%s", argJson)
 
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -751,7 +716,7 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 			})
 			return err
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateKubernetesNodePool", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateKubernetesNodePool", raw))
 		}
 		if debugOn() {
 			resizeRequestMap := make(map[string]interface{})
@@ -763,8 +728,8 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 
 		stateConf := BuildStateConf([]string{"scaling", "updating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"deleting", "failed"}))
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
@@ -773,11 +738,11 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		rawOldValue, rawNewValue := d.GetChange("instances")
 		oldValue, ok := rawOldValue.([]interface{})
 		if ok != true {
-			return WrapErrorf(fmt.Errorf("instances old value can not be parsed"), "parseError %d", oldValue)
+			return DiagnosticsFromError(WrapErrorf(fmt.Errorf("instances old value can not be parsed"), "parseError %d", oldValue))
 		}
 		newValue, ok := rawNewValue.([]interface{})
 		if ok != true {
-			return WrapErrorf(fmt.Errorf("instances new value can not be parsed"), "parseError %d", oldValue)
+			return DiagnosticsFromError(WrapErrorf(fmt.Errorf("instances new value can not be parsed"), "parseError %d", oldValue))
 		}
 
 		if len(newValue) > len(oldValue) {
@@ -785,18 +750,18 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		} else {
 			err := RemoveNodePoolNodes(d, meta, clusterId, d.Id(), oldValue, newValue)
 			if err != nil {
-				return err
+				return DiagnosticsFromError(err)
 			}
 		}
 	}
 
 	update = false
 	d.Partial(false)
-	return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+	return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCSNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	clusterId := d.Get("cluster_id").(string)
 	csService := CsService{client}
 
@@ -806,7 +771,7 @@ func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("node_count", object.Status.TotalNodes)
@@ -850,19 +815,19 @@ func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interf
 	// }
 
 	if err := d.Set("data_disks", flattenNodeDataDisksConfig(object.ScalingGroup.DataDisks)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("taints", flattenTaintsConfig(object.KubernetesConfig.Taints)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("labels", flattenLabelsConfig(object.KubernetesConfig.Labels)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("tags", flattenTagsConfig(object.ScalingGroup.Tags)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	// if object.Management.Enable == true {
@@ -873,19 +838,19 @@ func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interf
 
 	if object.AutoScaling.Enable == true {
 		if err := d.Set("scaling_config", flattenAutoScalingConfig(&object.AutoScaling)); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
 	if err := d.Set("spot_price_limit", flattenSpotPriceLimit(object.ScalingGroup.SpotPriceLimit)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCSNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csService := CsService{client}
 	invoker := NewInvoker()
 	clusterId := d.Get("cluster_id").(string)
@@ -893,43 +858,17 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 	// delete all nodes
 	err := RemoveNodePoolNodes(d, meta, clusterId, d.Id(), nil, nil)
 	if err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
-	req := requests.NewCommonRequest()
-	if csService.client.Config.Insecure {
-		req.SetHTTPSInsecure(csService.client.Config.Insecure)
-	}
-	req.QueryParams = map[string]string{
-		"RegionId":         csService.client.RegionId,
-		"AccessKeySecret":  csService.client.SecretKey,
-		"Product":          "CS",
-		"Department":       csService.client.Department,
-		"ResourceGroup":    csService.client.ResourceGroup,
-		"Action":           "DeleteClusterNodepool",
-		"AccountInfo":      "123456",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-
-		"X-acs-body": fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\"}",
-
-			"ClusterId", clusterId,
-			"NodepoolId", d.Id(),
-		),
-	}
-	req.Method = "POST"        // Set request method
-	req.Product = "CS"         // Specify product
-	req.Version = "2015-12-15" // Specify product version
-	req.ServiceCode = "cs"
-	if strings.ToLower(csService.client.Config.Protocol) == "https" {
-		req.Scheme = "https"
-	} else {
-		req.Scheme = "http"
-	} // Set request scheme. Default: http
-	req.ApiName = "DeleteClusterNodepool"
-	req.Headers = map[string]string{"RegionId": csService.client.RegionId}
-	req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
+	req := csService.client.NewCommonRequest("POST", "CS", "2015-12-15", "DeleteClusterNodepool", "")
+	req.QueryParams["AccountInfo"] = "123456"
+	req.QueryParams["ProductName"] = "cs"
+	req.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\"}",
+		"ClusterId", clusterId,
+		"NodepoolId", d.Id(),
+	)
+	req.Headers["x-acs-asapi-gateway-version"] = "3.0"
 	if err := invoker.Run(func() error {
 		var err error
 		raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -938,7 +877,7 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 
 		return err
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteClusterNodePool", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteClusterNodePool", raw))
 	}
 	if debugOn() {
 		requestMap := make(map[string]interface{})
@@ -947,9 +886,9 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 		addDebug("DeleteClusterNodePool", raw, d.Id(), requestMap)
 	}
 
-	stateConf := BuildStateConf([]string{"deleting"}, []string{}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	stateConf := BuildStateConf([]string{"deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"failed"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
 	return nil
@@ -969,10 +908,8 @@ func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*requests.Comm
 			password = decryptResp
 		}
 	}
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
+	request := client.NewCommonRequest("POST", "CS", "2015-12-15", "CreateClusterNodePool", "")
+	request.Headers["x-acs-asapi-gateway-version"] = "3.0"
 	//begin
 	NodePoolInfo := cs.NodePoolInfo{
 		Name:         d.Get("name").(string),
@@ -1048,27 +985,17 @@ func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*requests.Comm
 	ScalingGroupJson, _ := json.Marshal(ScalingGroup)
 	KubernetesConfigJson, _ := json.Marshal(KubernetesConfig)
 	AutoScalingJson, _ := json.Marshal(AutoScaling)
-	request.QueryParams = map[string]string{
-		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
-		"Product":          "CS",
-		"Department":       client.Department,
-		"ResourceGroup":    client.ResourceGroup,
-		"Action":           "CreateClusterNodePool",
-		"ClusterId":        d.Get("cluster_id").(string),
-		"AccountInfo":      "123456",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-		"X-acs-body": fmt.Sprintf("{\"%s\":%d,\"%s\":%s,\"%s\":%s,\"%s\":%s,\"%s\":%s}",
-
-			"count", int64(d.Get("node_count").(int)),
-			"nodepool_info", NodePoolInfoJson,
-			"scaling_group", ScalingGroupJson,
-			"kubernetes_config", KubernetesConfigJson,
-			"auto_scaling", AutoScalingJson,
-		),
-	}
+	request.QueryParams["ClusterId"] = d.Get("cluster_id").(string)
+	request.QueryParams["AccountInfo"] = "123456"
+	request.QueryParams["ProductName"] = "cs"
+	request.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":%d,\"%s\":%s,\"%s\":%s,\"%s\":%s,\"%s\":%s}",
+
+		"count", int64(d.Get("node_count").(int)),
+		"nodepool_info", NodePoolInfoJson,
+		"scaling_group", ScalingGroupJson,
+		"kubernetes_config", KubernetesConfigJson,
+		"auto_scaling", AutoScalingJson,
+	)
 
 	return request, nil
 }
@@ -1388,42 +1315,16 @@ func RemoveNodePoolNodes(d *schema.ResourceData, meta interface{}, clusterid, no
 		removeNodesName = removeInstanceList
 	}
 	if len(removeNodesName) > 0 {
-		req := requests.NewCommonRequest()
-		if csService.client.Config.Insecure {
-			req.SetHTTPSInsecure(csService.client.Config.Insecure)
-		}
-		req.QueryParams = map[string]string{
-			"RegionId":         csService.client.RegionId,
-			"AccessKeySecret":  csService.client.SecretKey,
-			"Product":          "CS",
-			"Department":       csService.client.Department,
-			"ResourceGroup":    csService.client.ResourceGroup,
-			"Action":           "RemoveClusterNodes",
-			"AccountInfo":      "123456",
-			"Version":          "2015-12-15",
-			"SignatureVersion": "1.0",
-			"ProductName":      "cs",
-
-			"X-acs-body": fmt.Sprintf("{\"%s\":%t,\"%s\":%t,\"%s\":%q,\"%s\":\"%s\"}",
-
-				"release_node", true,
-				"drain_node", true,
-				"nodes", removeNodesName,
-				"ClusterId", clusterid,
-			),
-		}
-		req.Method = "POST"        // Set request method
-		req.Product = "CS"         // Specify product
-		req.Version = "2015-12-15" // Specify product version
-		req.ServiceCode = "cs"
-		if strings.ToLower(csService.client.Config.Protocol) == "https" {
-			req.Scheme = "https"
-		} else {
-			req.Scheme = "http"
-		} // Set request scheme. Default: http
-		req.ApiName = "RemoveClusterNodes"
-		req.Headers = map[string]string{"RegionId": csService.client.RegionId}
-		req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
+		req := csService.client.NewCommonRequest("POST", "CS", "2015-12-15", "RemoveClusterNodes", "")
+		req.QueryParams["AccountInfo"] = "123456"
+		req.QueryParams["ProductName"] = "cs"
+		req.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":%t,\"%s\":%t,\"%s\":%q,\"%s\":\"%s\"}",
+			"release_node", true,
+			"drain_node", true,
+			"nodes", removeNodesName,
+			"ClusterId", clusterid,
+		)
+		req.Headers["x-acs-asapi-gateway-version"] = "3.0"
 		if err := invoker.Run(func() error {
 			var err error
 			raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1440,7 +1341,7 @@ func RemoveNodePoolNodes(d *schema.ResourceData, meta interface{}, clusterid, no
 			return err
 		}
 		stateConf := BuildStateConf([]string{"removing"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 60*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterid, []string{"deleting", "failed"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(client.Context()); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
@@ -1454,41 +1355,15 @@ func ScaleClusterNodePool(d *schema.ResourceData, meta interface{}, clusterid, n
 
 	// list all nodes of the nodepool
 
-	req := requests.NewCommonRequest()
-	if csService.client.Config.Insecure {
-		req.SetHTTPSInsecure(csService.client.Config.Insecure)
-	}
-	req.QueryParams = map[string]string{
-		"RegionId":         csService.client.RegionId,
-		"AccessKeySecret":  csService.client.SecretKey,
-		"Product":          "CS",
-		"Department":       csService.client.Department,
-		"ResourceGroup":    csService.client.ResourceGroup,
-		"Action":           "ScaleClusterNodePool",
-		"AccountInfo":      "123456",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-
-		"X-acs-body": fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d}",
-
-			"ClusterId", clusterid,
-			"NodepoolId", nodepoolid,
-			"count", int64(newValue)-int64(oldValue),
-		),
-	}
-	req.Method = "POST"        // Set request method
-	req.Product = "CS"         // Specify product
-	req.Version = "2015-12-15" // Specify product version
-	req.ServiceCode = "cs"
-	if strings.ToLower(csService.client.Config.Protocol) == "https" {
-		req.Scheme = "https"
-	} else {
-		req.Scheme = "http"
-	} // Set request scheme. Default: http
-	req.ApiName = "ScaleClusterNodePool"
-	req.Headers = map[string]string{"RegionId": csService.client.RegionId}
-	req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
+	req := csService.client.NewCommonRequest("POST", "CS", "2015-12-15", "ScaleClusterNodePool", "")
+	req.QueryParams["AccountInfo"] = "123456"
+	req.QueryParams["ProductName"] = "cs"
+	req.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":%d}",
+		"ClusterId", clusterid,
+		"NodepoolId", nodepoolid,
+		"count", int64(newValue)-int64(oldValue),
+	)
+	req.Headers["x-acs-asapi-gateway-version"] = "3.0"
 	if err := invoker.Run(func() error {
 		var err error
 		raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1501,7 +1376,7 @@ func ScaleClusterNodePool(d *schema.ResourceData, meta interface{}, clusterid, n
 	}
 
 	stateConf := BuildStateConf([]string{"scaling"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterid, []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(client.Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1574,7 +1449,7 @@ func attachExistingInstance(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stateConf := BuildStateConf([]string{"scaling"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(csService.client.Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
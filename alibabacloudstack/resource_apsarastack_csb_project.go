@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,16 +9,17 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackCsbProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCsbProjectCreate,
-		Read:   resourceAlibabacloudStackCsbProjectRead,
-		Update: resourceAlibabacloudStackCsbProjectUpdate,
-		Delete: resourceAlibabacloudStackCsbProjectDelete,
+		CreateContext: resourceAlibabacloudStackCsbProjectCreate,
+		ReadContext:   resourceAlibabacloudStackCsbProjectRead,
+		UpdateContext: resourceAlibabacloudStackCsbProjectUpdate,
+		DeleteContext: resourceAlibabacloudStackCsbProjectDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -101,14 +103,14 @@ func resourceAlibabacloudStackCsbProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCsbProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCsbProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateProject"
 	request := make(map[string]interface{})
 	conn, err := client.NewCsbClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if v, ok := d.GetOk("csb_id"); ok {
@@ -126,7 +128,7 @@ func resourceAlibabacloudStackCsbProjectCreate(d *schema.ResourceData, meta inte
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-11-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_csb_project", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_csb_project", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	request1 := make(map[string]interface{})
@@ -135,10 +137,10 @@ func resourceAlibabacloudStackCsbProjectCreate(d *schema.ResourceData, meta inte
 	}
 	addDebug(action, response, request)
 	d.SetId(fmt.Sprint(request["CsbId"], ":", request1["ProjectName"]))
-	return resourceAlibabacloudStackCsbProjectRead(d, meta)
+	return resourceAlibabacloudStackCsbProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCsbProjectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCsbProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csbService := CsbService{client}
 	object, err := csbService.DescribeCsbProjectDetail(d.Id())
 	if err != nil {
@@ -147,7 +149,7 @@ func resourceAlibabacloudStackCsbProjectRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	fmt.Sprint(object["ProjectName"])
 	d.Set("project_name", fmt.Sprint(object["ProjectName"]))
@@ -165,8 +167,8 @@ func resourceAlibabacloudStackCsbProjectRead(d *schema.ResourceData, meta interf
 
 	return nil
 }
-func resourceAlibabacloudStackCsbProjectUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackCsbProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	update := false
 	var response map[string]interface{}
 	request := map[string]interface{}{}
@@ -187,28 +189,28 @@ func resourceAlibabacloudStackCsbProjectUpdate(d *schema.ResourceData, meta inte
 		action := "UpdateProject"
 		conn, err := client.NewCsbClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-11-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 
 	}
-	return resourceAlibabacloudStackCsbProjectRead(d, meta)
+	return resourceAlibabacloudStackCsbProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCsbProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCsbProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	csbService := CsbService{client}
 	object, err := csbService.DescribeCsbProjectDetail(d.Id())
 	action := "DeleteProject"
 	var response map[string]interface{}
 	conn, err := client.NewCsbClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{}
 
@@ -223,7 +225,7 @@ func resourceAlibabacloudStackCsbProjectDelete(d *schema.ResourceData, meta inte
 
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
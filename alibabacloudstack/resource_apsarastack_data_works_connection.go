@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -17,10 +19,10 @@ import (
 
 func resourceAlibabacloudStackDataWorksConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksConnectionCreate,
-		Read:   resourceAlibabacloudStackDataWorksConnectionRead,
-		Update: resourceAlibabacloudStackDataWorksConnectionUpdate,
-		Delete: resourceAlibabacloudStackDataWorksConnectionDelete,
+		CreateContext: resourceAlibabacloudStackDataWorksConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackDataWorksConnectionRead,
+		UpdateContext: resourceAlibabacloudStackDataWorksConnectionUpdate,
+		DeleteContext: resourceAlibabacloudStackDataWorksConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -67,14 +69,14 @@ func resourceAlibabacloudStackDataWorksConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateConnection"
 	request := make(map[string]interface{})
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("connection_type"); ok {
 		request["ConnectionType"] = v.(string)
@@ -109,7 +111,7 @@ func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData,
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -122,15 +124,15 @@ func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData,
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["Data"], ":", request["ProjectId"], ":", request["Name"]))
 
-	return resourceAlibabacloudStackDataWorksConnectionRead(d, meta)
+	return resourceAlibabacloudStackDataWorksConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksConnection(d.Id())
 	if err != nil {
@@ -139,11 +141,11 @@ func resourceAlibabacloudStackDataWorksConnectionRead(d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("connection_id", parts[0])
 	d.Set("project_id", parts[1])
@@ -156,12 +158,12 @@ func resourceAlibabacloudStackDataWorksConnectionRead(d *schema.ResourceData, me
 	//d.Set("content", tempMap)
 	return nil
 }
-func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"ConnectionId": parts[0],
@@ -189,10 +191,10 @@ func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData,
 	action := "UpdateConnection"
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("PUT"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -205,21 +207,21 @@ func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData,
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
-	return resourceAlibabacloudStackDataWorksConnectionRead(d, meta)
+	return resourceAlibabacloudStackDataWorksConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "DeleteConnection"
 	var response map[string]interface{}
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"ConnectionId": parts[0],
@@ -229,7 +231,7 @@ func resourceAlibabacloudStackDataWorksConnectionDelete(d *schema.ResourceData,
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -242,7 +244,7 @@ func resourceAlibabacloudStackDataWorksConnectionDelete(d *schema.ResourceData,
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
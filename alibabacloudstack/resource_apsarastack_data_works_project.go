@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,16 +9,17 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDataWorksProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksProjectCreate,
-		Read:   resourceAlibabacloudStackDataWorksProjectRead,
-		Update: resourceAlibabacloudStackDataWorksProjectUpdate,
-		Delete: resourceAlibabacloudStackDataWorksProjectDelete,
+		CreateContext: resourceAlibabacloudStackDataWorksProjectCreate,
+		ReadContext:   resourceAlibabacloudStackDataWorksProjectRead,
+		UpdateContext: resourceAlibabacloudStackDataWorksProjectUpdate,
+		DeleteContext: resourceAlibabacloudStackDataWorksProjectDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -39,14 +41,14 @@ func resourceAlibabacloudStackDataWorksProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateProject"
 	request := make(map[string]interface{})
 	conn, err := client.NewDataworksPrivateClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("project_name"); ok {
 		request["ProjectName"] = v.(string)
@@ -63,7 +65,7 @@ func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, met
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-01-17"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		addDebug(action, response, request)
 		if err != nil {
@@ -76,15 +78,15 @@ func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_project", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_project", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["RequestId"], ":", response["Data"]))
 
-	return resourceAlibabacloudStackDataWorksProjectRead(d, meta)
+	return resourceAlibabacloudStackDataWorksProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksProjectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksProject(d.Id())
 	log.Printf(fmt.Sprint(object))
@@ -94,21 +96,21 @@ func resourceAlibabacloudStackDataWorksProjectRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("project_id", parts[1])
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksProjectRead(d, meta)
+	return resourceAlibabacloudStackDataWorksProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// 没有对应 API
 	return nil
 }
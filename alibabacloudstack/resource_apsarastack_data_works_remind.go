@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -18,10 +20,10 @@ import (
 
 func resourceAlibabacloudStackDataWorksRemind() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksRemindCreate,
-		Read:   resourceAlibabacloudStackDataWorksRemindRead,
-		Update: resourceAlibabacloudStackDataWorksRemindUpdate,
-		Delete: resourceAlibabacloudStackDataWorksRemindDelete,
+		CreateContext: resourceAlibabacloudStackDataWorksRemindCreate,
+		ReadContext:   resourceAlibabacloudStackDataWorksRemindRead,
+		UpdateContext: resourceAlibabacloudStackDataWorksRemindUpdate,
+		DeleteContext: resourceAlibabacloudStackDataWorksRemindDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -113,13 +115,13 @@ func resourceAlibabacloudStackDataWorksRemind() *schema.Resource {
 var RemindUnit string
 var RemindType string
 
-func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksRemindCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateRemind"
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := buildRemindArgs(d)
@@ -128,7 +130,7 @@ func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -141,15 +143,15 @@ func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_remind", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_remind", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["Data"]))
 
-	return resourceAlibabacloudStackDataWorksRemindRead(d, meta)
+	return resourceAlibabacloudStackDataWorksRemindRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksRemindRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksRemindRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksRemind(d.Id())
 	if err != nil {
@@ -158,10 +160,10 @@ func resourceAlibabacloudStackDataWorksRemindRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	for key, value := range object {
@@ -201,8 +203,8 @@ func resourceAlibabacloudStackDataWorksRemindRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksRemindUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := make(map[string]interface{})
 	var response map[string]interface{}
 
@@ -261,10 +263,10 @@ func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta
 	request["OrganizationId"] = client.Department
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -277,19 +279,19 @@ func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
-	return resourceAlibabacloudStackDataWorksRemindRead(d, meta)
+	return resourceAlibabacloudStackDataWorksRemindRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDataWorksRemindDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksRemindDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	action := "DeleteRemind"
 	var response map[string]interface{}
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"RemindId": d.Id(),
@@ -297,7 +299,7 @@ func resourceAlibabacloudStackDataWorksRemindDelete(d *schema.ResourceData, meta
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -310,7 +312,7 @@ func resourceAlibabacloudStackDataWorksRemindDelete(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
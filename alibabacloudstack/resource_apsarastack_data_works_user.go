@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -17,10 +19,10 @@ import (
 
 func resourceAlibabacloudStackDataWorksUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksUserCreate,
-		Read:   resourceAlibabacloudStackDataWorksUserRead,
-		Update: resourceAlibabacloudStackDataWorksUserUpdate,
-		Delete: resourceAlibabacloudStackDataWorksUserDelete,
+		CreateContext: resourceAlibabacloudStackDataWorksUserCreate,
+		ReadContext:   resourceAlibabacloudStackDataWorksUserRead,
+		UpdateContext: resourceAlibabacloudStackDataWorksUserUpdate,
+		DeleteContext: resourceAlibabacloudStackDataWorksUserDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -42,14 +44,14 @@ func resourceAlibabacloudStackDataWorksUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateProjectMember"
 	request := make(map[string]interface{})
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("project_id"); ok {
 		request["ProjectId"] = v.(string)
@@ -69,7 +71,7 @@ func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta i
 	request["OrganizationId"] = client.Department
 	request["ClientToken"] = fmt.Sprint(uuid.NewRandom())
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -82,15 +84,15 @@ func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta i
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["RequestId"], ":", request["ProjectId"], ":", request["UserId"]))
 
-	return resourceAlibabacloudStackDataWorksUserRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksUser(d.Id())
 	log.Printf(fmt.Sprint(object))
@@ -100,32 +102,32 @@ func resourceAlibabacloudStackDataWorksUserRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("user_id", parts[2])
 	d.Set("project_id", parts[1])
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksUserRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "DeleteProjectMember"
 	var response map[string]interface{}
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"ProjectId": parts[1],
@@ -137,7 +139,7 @@ func resourceAlibabacloudStackDataWorksUserDelete(d *schema.ResourceData, meta i
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -150,7 +152,7 @@ func resourceAlibabacloudStackDataWorksUserDelete(d *schema.ResourceData, meta i
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -17,10 +19,10 @@ import (
 
 func resourceAlibabacloudStackDataWorksUserRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksUserRoleBindingCreate,
-		Read:   resourceAlibabacloudStackDataWorksUserRoleBindingRead,
-		Update: resourceAlibabacloudStackDataWorksUserRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackDataWorksUserRoleBindingDelete,
+		CreateContext: resourceAlibabacloudStackDataWorksUserRoleBindingCreate,
+		ReadContext:   resourceAlibabacloudStackDataWorksUserRoleBindingRead,
+		UpdateContext: resourceAlibabacloudStackDataWorksUserRoleBindingUpdate,
+		DeleteContext: resourceAlibabacloudStackDataWorksUserRoleBindingDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -42,14 +44,14 @@ func resourceAlibabacloudStackDataWorksUserRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "AddProjectMemberToRole"
 	request := make(map[string]interface{})
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("project_id"); ok {
 		request["ProjectId"] = v.(string)
@@ -69,7 +71,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceD
 	request["OrganizationId"] = client.Department
 	request["ClientToken"] = fmt.Sprint(uuid.NewRandom())
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -82,15 +84,15 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceD
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(request["RoleCode"], ":", request["ProjectId"], ":", request["UserId"]))
 
-	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksUserRoleBinding(d.Id())
 	log.Printf(fmt.Sprint(object))
@@ -100,11 +102,11 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingRead(d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("user_id", parts[2])
 	d.Set("project_id", parts[1])
@@ -112,21 +114,21 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingRead(d *schema.ResourceDat
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "RemoveProjectMemberFromRole"
 	var response map[string]interface{}
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"ProjectId": parts[1],
@@ -139,7 +141,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(d *schema.ResourceD
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -152,7 +154,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(d *schema.ResourceD
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,12 +1,13 @@
 package alibabacloudstack
 
 import (
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
@@ -18,14 +19,20 @@ import (
 
 func resourceAlibabacloudStackDatahubProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubProjectCreate,
-		Read:   resourceAlibabacloudStackDatahubProjectRead,
-		Update: resourceAlibabacloudStackDatahubProjectUpdate,
-		Delete: resourceAlibabacloudStackDatahubProjectDelete,
+		CreateContext: resourceAlibabacloudStackDatahubProjectCreate,
+		ReadContext:   resourceAlibabacloudStackDatahubProjectRead,
+		UpdateContext: resourceAlibabacloudStackDatahubProjectUpdate,
+		DeleteContext: resourceAlibabacloudStackDatahubProjectDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -57,41 +64,21 @@ func resourceAlibabacloudStackDatahubProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDatahubProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//var dataService DatahubService = DatahubService{client: client}
 	projectName := d.Get("name").(string)
 	projectComment := d.Get("comment").(string)
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "datahub"
+	request := client.NewCommonRequest("GET", "datahub", "2019-11-20", "CreateProject", "")
 	request.Domain = client.Domain
-	request.Version = "2019-11-20"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "CreateProject"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateProject",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"Comment":         projectComment,
-	}
+	request.QueryParams["ProjectName"] = projectName
+	request.QueryParams["Comment"] = projectComment
 
 	raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 		return dataHubClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_datahub_project", request.GetActionName(), AlibabacloudStackDatahubSdkGo)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_datahub_project", request.GetActionName(), AlibabacloudStackDatahubSdkGo))
 	}
 	if debugOn() {
 		requestMap := make(map[string]string)
@@ -101,11 +88,11 @@ func resourceAlibabacloudStackDatahubProjectCreate(d *schema.ResourceData, meta
 	}
 
 	d.SetId(strings.ToLower(projectName))
-	return resourceAlibabacloudStackDatahubProjectRead(d, meta)
+	return resourceAlibabacloudStackDatahubProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubProjectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDatahubProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	datahubService := DatahubService{client}
 	object, err := datahubService.DescribeDatahubProject(d.Id())
 	if err != nil {
@@ -113,7 +100,7 @@ func resourceAlibabacloudStackDatahubProjectRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.SetId(strings.ToLower(d.Id()))
@@ -124,7 +111,7 @@ func resourceAlibabacloudStackDatahubProjectRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	/*
 		client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -152,39 +139,19 @@ func resourceAlibabacloudStackDatahubProjectUpdate(d *schema.ResourceData, meta
 
 	*/
 
-	return resourceAlibabacloudStackDatahubProjectRead(d, meta)
+	return resourceAlibabacloudStackDatahubProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubProjectDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDatahubProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	datahubService := DatahubService{client}
 	projectName := d.Id()
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "datahub"
+	request := client.NewCommonRequest("GET", "datahub", "2019-11-20", "DeleteProject", "")
 	request.Domain = client.Domain
-	request.Version = "2019-11-20"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DeleteProject"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteProject",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-	}
+	request.QueryParams["ProjectName"] = projectName
 
 	var requestInfo *datahub.DataHub
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -205,7 +172,7 @@ func resourceAlibabacloudStackDatahubProjectDelete(d *schema.ResourceData, meta
 		if isDatahubNotExistError(err) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteProject", AlibabacloudStackDatahubSdkGo)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteProject", AlibabacloudStackDatahubSdkGo))
 	}
-	return WrapError(datahubService.WaitForDatahubProject(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(datahubService.WaitForDatahubProject(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
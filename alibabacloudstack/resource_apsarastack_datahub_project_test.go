@@ -45,14 +45,13 @@ func testSweepDatahubProject(region string) error {
 	request.ApiName = "ListProjects"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "ListProjects",
-		"Version":         "2019-11-20",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "ListProjects",
+		"Version":       "2019-11-20",
 	}
 
 	raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
@@ -83,15 +82,14 @@ func testSweepDatahubProject(region string) error {
 		request.ApiName = "ListTopics"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "datahub",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "ListTopics",
-			"Version":         "2019-11-20",
-			"ProjectName":     projectName,
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "datahub",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"RegionId":      client.RegionId,
+			"Action":        "ListTopics",
+			"Version":       "2019-11-20",
+			"ProjectName":   projectName,
 		}
 		raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
@@ -114,16 +112,15 @@ func testSweepDatahubProject(region string) error {
 			request.ApiName = "ListSubscription"
 			request.Headers = map[string]string{"RegionId": client.RegionId}
 			request.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "datahub",
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"RegionId":        client.RegionId,
-				"Action":          "ListSubscription",
-				"Version":         "2019-11-20",
-				"ProjectName":     projectName,
-				"TopicName":       topicName,
+				"AccessKeyId":   client.AccessKey,
+				"Product":       "datahub",
+				"Department":    client.Department,
+				"ResourceGroup": client.ResourceGroup,
+				"RegionId":      client.RegionId,
+				"Action":        "ListSubscription",
+				"Version":       "2019-11-20",
+				"ProjectName":   projectName,
+				"TopicName":     topicName,
 			}
 			raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 				return slsClient.ProcessCommonRequest(request)
@@ -147,16 +144,15 @@ func testSweepDatahubProject(region string) error {
 				request.ApiName = "DeleteSubscription"
 				request.Headers = map[string]string{"RegionId": client.RegionId}
 				request.QueryParams = map[string]string{
-					"AccessKeySecret": client.SecretKey,
-					"AccessKeyId":     client.AccessKey,
-					"Product":         "datahub",
-					"Department":      client.Department,
-					"ResourceGroup":   client.ResourceGroup,
-					"RegionId":        client.RegionId,
-					"Action":          "DeleteSubscription",
-					"Version":         "2019-11-20",
-					"ProjectName":     projectName,
-					"TopicName":       topicName,
+					"AccessKeyId":   client.AccessKey,
+					"Product":       "datahub",
+					"Department":    client.Department,
+					"ResourceGroup": client.ResourceGroup,
+					"RegionId":      client.RegionId,
+					"Action":        "DeleteSubscription",
+					"Version":       "2019-11-20",
+					"ProjectName":   projectName,
+					"TopicName":     topicName,
 				}
 				_, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 					return slsClient.ProcessCommonRequest(request)
@@ -177,16 +173,15 @@ func testSweepDatahubProject(region string) error {
 			topicrequest.ApiName = "DeleteTopic"
 			topicrequest.Headers = map[string]string{"RegionId": client.RegionId}
 			topicrequest.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "datahub",
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"RegionId":        client.RegionId,
-				"Action":          "DeleteTopic",
-				"Version":         "2019-11-20",
-				"ProjectName":     projectName,
-				"TopicName":       topicName,
+				"AccessKeyId":   client.AccessKey,
+				"Product":       "datahub",
+				"Department":    client.Department,
+				"ResourceGroup": client.ResourceGroup,
+				"RegionId":      client.RegionId,
+				"Action":        "DeleteTopic",
+				"Version":       "2019-11-20",
+				"ProjectName":   projectName,
+				"TopicName":     topicName,
 			}
 			_, err = client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 				return slsClient.ProcessCommonRequest(topicrequest)
@@ -207,15 +202,14 @@ func testSweepDatahubProject(region string) error {
 		projectrequest.ApiName = "DeleteProject"
 		projectrequest.Headers = map[string]string{"RegionId": client.RegionId}
 		projectrequest.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "datahub",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "DeleteProject",
-			"Version":         "2019-11-20",
-			"ProjectName":     projectName,
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "datahub",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"RegionId":      client.RegionId,
+			"Action":        "DeleteProject",
+			"Version":       "2019-11-20",
+			"ProjectName":   projectName,
 		}
 		_, err = client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(projectrequest)
@@ -1,16 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	//"encoding/json"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
@@ -22,14 +23,20 @@ import (
 
 func resourceAlibabacloudStackDatahubSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubSubscriptionCreate,
-		Read:   resourceAlibabacloudStackDatahubSubscriptionRead,
-		Update: resourceAlibabacloudStackDatahubSubscriptionUpdate,
-		Delete: resourceAlibabacloudStackDatahubSubscriptionDelete,
+		CreateContext: resourceAlibabacloudStackDatahubSubscriptionCreate,
+		ReadContext:   resourceAlibabacloudStackDatahubSubscriptionRead,
+		UpdateContext: resourceAlibabacloudStackDatahubSubscriptionUpdate,
+		DeleteContext: resourceAlibabacloudStackDatahubSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:         schema.TypeString,
@@ -74,46 +81,26 @@ func resourceAlibabacloudStackDatahubSubscription() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDatahubSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	subscription := &datahub.SubscriptionCreate{}
 	projectName := d.Get("project_name").(string)
 	topicName := d.Get("topic_name").(string)
 	subComment := d.Get("comment").(string)
 
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "datahub"
+	request := client.NewCommonRequest("GET", "datahub", "2019-11-20", "CreateSubscription", "")
 	request.Domain = client.Domain
-	request.Version = "2019-11-20"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "CreateSubscription"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateSubscription",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
-		"Application":     "CreateSubscription",
-		"Comment":         subComment,
-	}
+	request.QueryParams["ProjectName"] = projectName
+	request.QueryParams["TopicName"] = topicName
+	request.QueryParams["Application"] = "CreateSubscription"
+	request.QueryParams["Comment"] = subComment
 
 	raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 		return dataHubClient.ProcessCommonRequest(request)
 	})
 	var requestInfo *datahub.DataHub
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_datahub_subscription", "CreateSubscription", AlibabacloudStackDatahubSdkGo)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_datahub_subscription", "CreateSubscription", AlibabacloudStackDatahubSdkGo))
 	}
 	bresponse := raw.(*responses.CommonResponse)
 	err = json.Unmarshal(bresponse.GetHttpContentBytes(), subscription)
@@ -126,15 +113,15 @@ func resourceAlibabacloudStackDatahubSubscriptionCreate(d *schema.ResourceData,
 		addDebug("CreateSubscription", raw, requestInfo, requestMap)
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", strings.ToLower(projectName), COLON_SEPARATED, strings.ToLower(topicName), COLON_SEPARATED, subscription.SubId))
-	return resourceAlibabacloudStackDatahubSubscriptionRead(d, meta)
+	return resourceAlibabacloudStackDatahubSubscriptionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDatahubSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	datahubService := DatahubService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	projectName := parts[0]
 	TopicName := parts[1]
@@ -146,7 +133,7 @@ func resourceAlibabacloudStackDatahubSubscriptionRead(d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", strings.ToLower(projectName), COLON_SEPARATED, strings.ToLower(TopicName), COLON_SEPARATED, SubId))
 
@@ -159,7 +146,7 @@ func resourceAlibabacloudStackDatahubSubscriptionRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//parts, err := ParseResourceId(d.Id(), 3)
 	//if err != nil {
 	//	return WrapError(err)
@@ -189,48 +176,28 @@ func resourceAlibabacloudStackDatahubSubscriptionUpdate(d *schema.ResourceData,
 	//	}
 	//}
 
-	return resourceAlibabacloudStackDatahubSubscriptionRead(d, meta)
+	return resourceAlibabacloudStackDatahubSubscriptionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDatahubSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	datahubService := DatahubService{client}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	projectName, topicName, subId := parts[0], parts[1], parts[2]
 
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "datahub"
+	request := client.NewCommonRequest("GET", "datahub", "2019-11-20", "DeleteSubscription", "")
 	request.Domain = client.Domain
-	request.Version = "2019-11-20"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DeleteSubscription"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteSubscription",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
-		"SubscriptionId":  subId,
-	}
+	request.QueryParams["ProjectName"] = projectName
+	request.QueryParams["TopicName"] = topicName
+	request.QueryParams["SubscriptionId"] = subId
 
 	var requestInfo *datahub.DataHub
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -253,7 +220,7 @@ func resourceAlibabacloudStackDatahubSubscriptionDelete(d *schema.ResourceData,
 		if isDatahubNotExistError(err) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteSubscription", AlibabacloudStackDatahubSdkGo)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteSubscription", AlibabacloudStackDatahubSdkGo))
 	}
-	return WrapError(datahubService.WaitForDatahubSubscription(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(datahubService.WaitForDatahubSubscription(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
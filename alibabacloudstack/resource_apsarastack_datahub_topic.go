@@ -1,11 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,14 +23,20 @@ import (
 
 func resourceAlibabacloudStackDatahubTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubTopicCreate,
-		Read:   resourceAlibabacloudStackDatahubTopicRead,
-		Update: resourceAlibabacloudStackDatahubTopicUpdate,
-		Delete: resourceAlibabacloudStackDatahubTopicDelete,
+		CreateContext: resourceAlibabacloudStackDatahubTopicCreate,
+		ReadContext:   resourceAlibabacloudStackDatahubTopicRead,
+		UpdateContext: resourceAlibabacloudStackDatahubTopicUpdate,
+		DeleteContext: resourceAlibabacloudStackDatahubTopicDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:         schema.TypeString,
@@ -102,8 +109,8 @@ func resourceAlibabacloudStackDatahubTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDatahubTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	t := &datahub.GetTopicResult{
 		ProjectName: d.Get("project_name").(string),
 		TopicName:   d.Get("name").(string),
@@ -124,43 +131,19 @@ func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta in
 		t.RecordType = datahub.BLOB
 	}
 	// var requestInfo *datahub.DataHub
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "datahub"
+	request := client.NewCommonRequest("POST", "datahub", "2019-11-20", "CreateTopic", "")
+	//"Content-Type":  "application/json",
 	request.Domain = client.Domain
-	request.Version = "2019-11-20"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "CreateTopic"
-	request.Headers = map[string]string{
-		"RegionId":      client.RegionId,
-		"Authorization": "AuthorizationString",
-		//"Content-Type":  "application/json",
-	}
-
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":   client.SecretKey,
-		"AccessKeyId":       client.AccessKey,
-		"Product":           "datahub",
-		"RegionId":          client.RegionId,
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Action":            "CreateTopic",
-		"Version":           "2019-11-20",
-		"ProjectName":       t.ProjectName,
-		"Lifecycle":         strconv.Itoa(t.LifeCycle),
-		"ShardCount":        strconv.Itoa(t.ShardCount),
-		"TopicName":         t.TopicName,
-		"RecordType":        recordType,
-		"Comment":           t.Comment,
-		"SignatureVersion":  "2.1",
-		"AcceptLanguage":    "zh-CN",
-		"ExpandMode":        "false",
-		"Forwardedregionid": client.RegionId,
-	}
+	request.Headers["Authorization"] = "AuthorizationString"
+	request.QueryParams["ProjectName"] = t.ProjectName
+	request.QueryParams["Lifecycle"] = strconv.Itoa(t.LifeCycle)
+	request.QueryParams["ShardCount"] = strconv.Itoa(t.ShardCount)
+	request.QueryParams["TopicName"] = t.TopicName
+	request.QueryParams["RecordType"] = recordType
+	request.QueryParams["Comment"] = t.Comment
+	request.QueryParams["AcceptLanguage"] = "zh-CN"
+	request.QueryParams["ExpandMode"] = "false"
+	request.QueryParams["Forwardedregionid"] = client.RegionId
 	if t.RecordSchema != nil {
 		var record_schema []map[string]string
 		for _, v := range t.RecordSchema.Fields {
@@ -181,16 +164,16 @@ func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta in
 	})
 	addDebug("CreateTopic", raw, request.Content, t)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_datahub_topic", "CreateTopic")
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_datahub_topic", "CreateTopic"))
 	}
 
 	d.SetId(strings.ToLower(fmt.Sprintf("%s%s%s", t.ProjectName, COLON_SEPARATED, t.TopicName)))
-	return resourceAlibabacloudStackDatahubTopicRead(d, meta)
+	return resourceAlibabacloudStackDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	datahubService := DatahubService{client}
 	object, err := datahubService.DescribeDatahubTopic(d.Id())
 	if err != nil {
@@ -198,7 +181,7 @@ func resourceAlibabacloudStackDatahubTopicRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.TopicName)
@@ -219,7 +202,7 @@ func resourceAlibabacloudStackDatahubTopicRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//parts, err := ParseResourceId(d.Id(), 2)
 	//if err != nil {
 	//	return WrapError(err)
@@ -266,46 +249,26 @@ func resourceAlibabacloudStackDatahubTopicUpdate(d *schema.ResourceData, meta in
 	//	}
 	//}
 
-	return resourceAlibabacloudStackDatahubTopicRead(d, meta)
+	return resourceAlibabacloudStackDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	projectName, topicName := parts[0], parts[1]
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	datahubService := DatahubService{client}
 	var requestInfo *datahub.DataHub
 
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "datahub"
+	request := client.NewCommonRequest("GET", "datahub", "2019-11-20", "DeleteTopic", "")
 	request.Domain = client.Domain
-	request.Version = "2019-11-20"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DeleteTopic"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteTopic",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
-	}
+	request.QueryParams["ProjectName"] = projectName
+	request.QueryParams["TopicName"] = topicName
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -327,7 +290,7 @@ func resourceAlibabacloudStackDatahubTopicDelete(d *schema.ResourceData, meta in
 		if isDatahubNotExistError(err) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTopic", AlibabacloudStackDatahubSdkGo)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTopic", AlibabacloudStackDatahubSdkGo))
 	}
-	return WrapError(datahubService.WaitForDatahubTopic(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(datahubService.WaitForDatahubTopic(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackDBAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBAccountCreate,
-		Read:   resourceAlibabacloudStackDBAccountRead,
-		Update: resourceAlibabacloudStackDBAccountUpdate,
-		Delete: resourceAlibabacloudStackDBAccountDelete,
+		CreateContext: resourceAlibabacloudStackDBAccountCreate,
+		ReadContext:   resourceAlibabacloudStackDBAccountRead,
+		UpdateContext: resourceAlibabacloudStackDBAccountUpdate,
+		DeleteContext: resourceAlibabacloudStackDBAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -71,8 +79,8 @@ func resourceAlibabacloudStackDBAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	request := rds.CreateCreateAccountRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -82,7 +90,7 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Get("instance_id").(string)
 	request.AccountName = d.Get("name").(string)
 
@@ -90,7 +98,7 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 	kmsPassword := d.Get("kms_encrypted_password").(string)
 
 	if password == "" && kmsPassword == "" {
-		return WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set."))
+		return DiagnosticsFromError(WrapAttributeError(WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set.")), "password"))
 	}
 
 	if password != "" {
@@ -99,7 +107,7 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 		kmsService := KmsService{client}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.AccountPassword = decryptResp.Plaintext
 	}
@@ -110,10 +118,10 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 		request.AccountDescription = v.(string)
 	}
 	// wait instance running before modifying
-	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.CreateAccount(request)
 		})
@@ -128,21 +136,21 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_account", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_account", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.AccountName))
 
-	if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackDBAccountRead(d, meta)
+	return resourceAlibabacloudStackDBAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	object, err := rdsService.DescribeDBAccount(d.Id())
 	if err != nil {
@@ -150,7 +158,7 @@ func resourceAlibabacloudStackDBAccountRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.DBInstanceId)
@@ -161,8 +169,8 @@ func resourceAlibabacloudStackDBAccountRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	d.Partial(true)
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
@@ -170,8 +178,8 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 	accountName := parts[1]
 
 	if d.HasChange("description") {
-		if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := rds.CreateModifyAccountDescriptionRequest()
 		request.RegionId = client.RegionId
@@ -181,7 +189,7 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = instanceId
 		request.AccountName = accountName
 		request.AccountDescription = d.Get("description").(string)
@@ -190,20 +198,20 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 			return rdsClient.ModifyAccountDescription(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("description")
 	}
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
-		if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := rds.CreateResetAccountPasswordRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = instanceId
 		request.AccountName = accountName
 		if strings.ToLower(client.Config.Protocol) == "https" {
@@ -215,17 +223,17 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 		kmsPassword := d.Get("kms_encrypted_password").(string)
 
 		if password == "" && kmsPassword == "" {
-			return WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set."))
+			return DiagnosticsFromError(WrapAttributeError(WrapError(Error("One of the 'password' and 'kms_encrypted_password' should be set.")), "password"))
 		}
 
 		if password != "" {
 			//d.SetPartial("password")
 			request.AccountPassword = password
 		} else {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)}
 			decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request.AccountPassword = decryptResp.Plaintext
 			//d.SetPartial("kms_encrypted_password")
@@ -236,27 +244,27 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 			return rdsClient.ResetAccountPassword(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("password")
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBAccountRead(d, meta)
+	return resourceAlibabacloudStackDBAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := rds.CreateDeleteAccountRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -268,9 +276,9 @@ func resourceAlibabacloudStackDBAccountDelete(d *schema.ResourceData, meta inter
 		return rdsClient.DeleteAccount(request)
 	})
 	if err != nil && !IsExpectedErrors(err, []string{"InvalidAccountName.NotFound"}) {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return rdsService.WaitForAccount(d.Id(), Deleted, DefaultTimeoutMedium)
+	return DiagnosticsFromError(rdsService.WaitForAccount(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
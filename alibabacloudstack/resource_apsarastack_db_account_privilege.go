@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,14 +18,20 @@ import (
 
 func resourceAlibabacloudStackDBAccountPrivilege() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBAccountPrivilegeCreate,
-		Read:   resourceAlibabacloudStackDBAccountPrivilegeRead,
-		Update: resourceAlibabacloudStackDBAccountPrivilegeUpdate,
-		Delete: resourceAlibabacloudStackDBAccountPrivilegeDelete,
+		CreateContext: resourceAlibabacloudStackDBAccountPrivilegeCreate,
+		ReadContext:   resourceAlibabacloudStackDBAccountPrivilegeRead,
+		UpdateContext: resourceAlibabacloudStackDBAccountPrivilegeUpdate,
+		DeleteContext: resourceAlibabacloudStackDBAccountPrivilegeDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -54,22 +62,22 @@ func resourceAlibabacloudStackDBAccountPrivilege() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBAccountPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	instanceId := d.Get("instance_id").(string)
 	account := d.Get("account_name").(string)
 	privilege := d.Get("privilege").(string)
 	dbList := d.Get("db_names").(*schema.Set).List()
 	// wait instance running before granting
-	if err := rdsService.WaitForDBInstance(instanceId, Running, DefaultLongTimeout); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForDBInstance(instanceId, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", instanceId, COLON_SEPARATED, account, COLON_SEPARATED, privilege))
 
 	if len(dbList) > 0 {
 		for _, db := range dbList {
-			if err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 				if err := rdsService.GrantAccountPrivilege(d.Id(), db.(string)); err != nil {
 					if IsExpectedErrors(err, OperationDeniedDBStatus) {
 						return resource.RetryableError(err)
@@ -78,21 +86,21 @@ func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, m
 				}
 				return nil
 			}); err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 	}
 
-	return resourceAlibabacloudStackDBAccountPrivilegeRead(d, meta)
+	return resourceAlibabacloudStackDBAccountPrivilegeRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rsdService := RdsService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := rsdService.DescribeDBAccountPrivilege(d.Id())
 	if err != nil {
@@ -100,7 +108,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.DBInstanceId)
@@ -118,14 +126,14 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 		request := rds.CreateDescribeDatabasesRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = object.DBInstanceId
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
 		} else {
 			request.Scheme = "http"
 		}
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.DescribeDatabases(request)
 			})
@@ -149,7 +157,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -158,8 +166,8 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBAccountPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	d.Partial(true)
 
@@ -174,27 +182,27 @@ func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, m
 
 		if len(remove) > 0 {
 			if strings.HasPrefix(d.Id(), "pgm-") {
-				return WrapError(fmt.Errorf("At present, the PostgreSql database does not support revoking the current privilege."))
+				return DiagnosticsFromError(WrapError(fmt.Errorf("At present, the PostgreSql database does not support revoking the current privilege.")))
 			}
 			// wait instance running before revoking
-			if err := rdsService.WaitForDBInstance(parts[0], Running, DefaultTimeoutMedium); err != nil {
-				return WrapError(err)
+			if err := rdsService.WaitForDBInstance(parts[0], Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 			for _, db := range remove {
 				if err := rdsService.RevokeAccountPrivilege(d.Id(), db.(string)); err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 		}
 
 		if len(add) > 0 {
 			// wait instance running before granting
-			if err := rdsService.WaitForDBInstance(parts[0], Running, DefaultTimeoutMedium); err != nil {
-				return WrapError(err)
+			if err := rdsService.WaitForDBInstance(parts[0], Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 			for _, db := range add {
 				if err := rdsService.GrantAccountPrivilege(d.Id(), db.(string)); err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 		}
@@ -202,22 +210,22 @@ func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, m
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBAccountPrivilegeRead(d, meta)
+	return resourceAlibabacloudStackDBAccountPrivilegeRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBAccountPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := rdsService.DescribeDBAccountPrivilege(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if strings.HasPrefix(d.Id(), "pgm-") {
 		return nil
@@ -229,11 +237,11 @@ func resourceAlibabacloudStackDBAccountPrivilegeDelete(d *schema.ResourceData, m
 			if pri.AccountPrivilege == parts[2] {
 				dbName = pri.DBName
 				if err := rdsService.RevokeAccountPrivilege(d.Id(), pri.DBName); err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 		}
 	}
 
-	return rdsService.WaitForAccountPrivilege(d.Id(), dbName, Deleted, DefaultTimeoutMedium)
+	return DiagnosticsFromError(rdsService.WaitForAccountPrivilege(d.Id(), dbName, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackDBBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBBackupPolicyCreate,
-		Read:   resourceAlibabacloudStackDBBackupPolicyRead,
-		Update: resourceAlibabacloudStackDBBackupPolicyUpdate,
-		Delete: resourceAlibabacloudStackDBBackupPolicyDelete,
+		CreateContext: resourceAlibabacloudStackDBBackupPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackDBBackupPolicyRead,
+		UpdateContext: resourceAlibabacloudStackDBBackupPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackDBBackupPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -127,16 +135,16 @@ func resourceAlibabacloudStackDBBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId(d.Get("instance_id").(string))
 
-	return resourceAlibabacloudStackDBBackupPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackDBBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	object, err := rdsService.DescribeBackupPolicy(d.Id())
 	if err != nil {
@@ -144,7 +152,7 @@ func resourceAlibabacloudStackDBBackupPolicyRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("instance_id", d.Id())
 
@@ -161,7 +169,7 @@ func resourceAlibabacloudStackDBBackupPolicyRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// At present, the sql server database does not support setting high_space_usage_protection and it`s has default value
 	if instance.Engine == "SQLServer" {
@@ -177,8 +185,8 @@ func resourceAlibabacloudStackDBBackupPolicyRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDBBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	updateForData := false
@@ -196,10 +204,10 @@ func resourceAlibabacloudStackDBBackupPolicyUpdate(d *schema.ResourceData, meta
 
 	if updateForData || updateForLog {
 		// wait instance running before modifying
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
-		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := rdsService.ModifyDBBackupPolicy(d, updateForData, updateForLog); err != nil {
 				if IsExpectedErrors(err, OperationDeniedDBStatus) {
 					return resource.RetryableError(err)
@@ -208,15 +216,15 @@ func resourceAlibabacloudStackDBBackupPolicyUpdate(d *schema.ResourceData, meta
 			}
 			return nil
 		}); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
-	return resourceAlibabacloudStackDBBackupPolicyRead(d, meta)
+	return resourceAlibabacloudStackDBBackupPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	request := rds.CreateModifyBackupPolicyRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -226,7 +234,7 @@ func resourceAlibabacloudStackDBBackupPolicyDelete(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 	request.PreferredBackupPeriod = "Tuesday,Thursday,Saturday"
 	request.BackupRetentionPeriod = "7"
@@ -237,7 +245,7 @@ func resourceAlibabacloudStackDBBackupPolicyDelete(d *schema.ResourceData, meta
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if instance.Engine != "SQLServer" {
 		request.LogBackupRetentionPeriod = "7"
@@ -252,9 +260,9 @@ func resourceAlibabacloudStackDBBackupPolicyDelete(d *schema.ResourceData, meta
 		return rdsClient.ModifyBackupPolicy(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium)
+	return DiagnosticsFromError(rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"regexp"
@@ -22,14 +24,20 @@ var dbConnectionIdWithSuffixRegexp = regexp.MustCompile(dbConnectionIdWithSuffix
 
 func resourceAlibabacloudStackDBConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBConnectionCreate,
-		Read:   resourceAlibabacloudStackDBConnectionRead,
-		Update: resourceAlibabacloudStackDBConnectionUpdate,
-		Delete: resourceAlibabacloudStackDBConnectionDelete,
+		CreateContext: resourceAlibabacloudStackDBConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackDBConnectionRead,
+		UpdateContext: resourceAlibabacloudStackDBConnectionUpdate,
+		DeleteContext: resourceAlibabacloudStackDBConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -61,8 +69,8 @@ func resourceAlibabacloudStackDBConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	instanceId := d.Get("instance_id").(string)
 	prefix := d.Get("connection_prefix").(string)
@@ -79,13 +87,13 @@ func resourceAlibabacloudStackDBConnectionCreate(d *schema.ResourceData, meta in
 	}
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = instanceId
 	request.ConnectionStringPrefix = prefix
 	request.Port = d.Get("port").(string)
 	var raw interface{}
 	var err error
-	err = resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.AllocateInstancePublicConnection(request)
 		})
@@ -101,30 +109,30 @@ func resourceAlibabacloudStackDBConnectionCreate(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, request.ConnectionStringPrefix))
 
-	if err := rdsService.WaitForDBConnection(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForDBConnection(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// wait instance running after allocating
-	if err := rdsService.WaitForDBInstance(instanceId, Running, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForDBInstance(instanceId, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackDBConnectionRead(d, meta)
+	return resourceAlibabacloudStackDBConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 	submatch := dbConnectionIdWithSuffixRegexp.FindStringSubmatch(d.Id())
 	if len(submatch) > 1 {
 		d.SetId(submatch[1])
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	object, err := rdsService.DescribeDBConnection(d.Id())
 
@@ -133,11 +141,11 @@ func resourceAlibabacloudStackDBConnectionRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("instance_id", parts[0])
 	d.Set("connection_prefix", parts[1])
@@ -148,8 +156,8 @@ func resourceAlibabacloudStackDBConnectionRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	submatch := dbConnectionIdWithSuffixRegexp.FindStringSubmatch(d.Id())
@@ -159,7 +167,7 @@ func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta in
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if d.HasChange("port") {
@@ -171,16 +179,16 @@ func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta in
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = parts[0]
 		object, err := rdsService.DescribeDBConnection(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.CurrentConnectionString = object.ConnectionString
 		request.ConnectionStringPrefix = parts[1]
 		request.Port = d.Get("port").(string)
-		if err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceConnectionString(request)
 			})
@@ -193,19 +201,19 @@ func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta in
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			return nil
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		// wait instance running after modifying
-		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
-	return resourceAlibabacloudStackDBConnectionRead(d, meta)
+	return resourceAlibabacloudStackDBConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	submatch := dbConnectionIdWithSuffixRegexp.FindStringSubmatch(d.Id())
@@ -222,11 +230,11 @@ func resourceAlibabacloudStackDBConnectionDelete(d *schema.ResourceData, meta in
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.DBInstanceId = split[0]
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		object, err := rdsService.DescribeDBConnection(d.Id())
 		if err != nil {
 			return resource.NonRetryableError(WrapError(err))
@@ -251,8 +259,8 @@ func resourceAlibabacloudStackDBConnectionDelete(d *schema.ResourceData, meta in
 		if NotFoundError(err) || IsExpectedErrors(err, []string{"InvalidCurrentConnectionString.NotFound", "AtLeastOneNetTypeExists"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return rdsService.WaitForDBConnection(d.Id(), Deleted, DefaultTimeoutMedium)
+	return DiagnosticsFromError(rdsService.WaitForDBConnection(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
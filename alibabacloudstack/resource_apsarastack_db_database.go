@@ -1,26 +1,34 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDBDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBDatabaseCreate,
-		Read:   resourceAlibabacloudStackDBDatabaseRead,
-		Update: resourceAlibabacloudStackDBDatabaseUpdate,
-		Delete: resourceAlibabacloudStackDBDatabaseDelete,
+		CreateContext: resourceAlibabacloudStackDBDatabaseCreate,
+		ReadContext:   resourceAlibabacloudStackDBDatabaseRead,
+		UpdateContext: resourceAlibabacloudStackDBDatabaseUpdate,
+		DeleteContext: resourceAlibabacloudStackDBDatabaseDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -48,9 +56,9 @@ func resourceAlibabacloudStackDBDatabase() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := rds.CreateCreateDatabaseRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -59,7 +67,7 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Get("instance_id").(string)
 	request.DBName = d.Get("name").(string)
 	request.CharacterSetName = d.Get("character_set").(string)
@@ -72,7 +80,7 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 		request.DBDescription = v.(string)
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.CreateDatabase(request)
 		})
@@ -87,17 +95,17 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.DBName))
 
-	return resourceAlibabacloudStackDBDatabaseRead(d, meta)
+	return resourceAlibabacloudStackDBDatabaseRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rsdService := RdsService{client}
 	object, err := rsdService.DescribeDBDatabase(d.Id())
 	if err != nil {
@@ -105,7 +113,7 @@ func resourceAlibabacloudStackDBDatabaseRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.DBInstanceId)
@@ -116,12 +124,12 @@ func resourceAlibabacloudStackDBDatabaseRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if d.HasChange("description") && !d.IsNewResource() {
 		parts, err := ParseResourceId(d.Id(), 2)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := rds.CreateModifyDBDescriptionRequest()
 		request.RegionId = client.RegionId
@@ -131,7 +139,7 @@ func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
 		} else {
@@ -145,19 +153,19 @@ func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta inte
 			return rdsClient.ModifyDBDescription(request)
 		})
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackDBDatabaseRead(d, meta)
+	return resourceAlibabacloudStackDBDatabaseRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := rds.CreateDeleteDatabaseRequest()
 	request.RegionId = client.RegionId
@@ -172,12 +180,12 @@ func resourceAlibabacloudStackDBDatabaseDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.DBName = parts[1]
 	// wait instance status is running before deleting database
-	if err := rdsService.WaitForDBInstance(parts[0], Running, 1800); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForDBInstance(parts[0], Running, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DeleteDatabase(request)
@@ -186,12 +194,12 @@ func resourceAlibabacloudStackDBDatabaseDelete(d *schema.ResourceData, meta inte
 		if NotFoundError(err) || IsExpectedErrors(err, []string{"InvalidDBName.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(rdsService.WaitForDBDatabase(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(rdsService.WaitForDBDatabase(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,10 +28,10 @@ import (
 
 func resourceAlibabacloudStackDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBInstanceCreate,
-		Read:   resourceAlibabacloudStackDBInstanceRead,
-		Update: resourceAlibabacloudStackDBInstanceUpdate,
-		Delete: resourceAlibabacloudStackDBInstanceDelete,
+		CreateContext: resourceAlibabacloudStackDBInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackDBInstanceRead,
+		UpdateContext: resourceAlibabacloudStackDBInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackDBInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -62,6 +64,7 @@ func resourceAlibabacloudStackDBInstance() *schema.Resource {
 			"tde_status": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 			"enable_ssl": {
 				Type:     schema.TypeBool,
@@ -205,6 +208,18 @@ func resourceAlibabacloudStackDBInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"sql_collector_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+			},
+			"sql_collector_config_value": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{30, 180, 365, 1095, 1825}),
+			},
 		},
 	}
 }
@@ -214,8 +229,8 @@ func parameterToHash(v interface{}) int {
 	return hashcode.String(m["name"].(string) + "|" + m["value"].(string))
 }
 
-func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	//request, err := buildDBCreateRequest(d, meta)
@@ -225,75 +240,31 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 	//client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	//request := rds.CreateCreateDBInstanceRequest()
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Rds"
-	request.Domain = client.Domain
-	request.Version = "2014-08-15"
-	request.Scheme = "http"
-	request.ApiName = "CreateDBInstance"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.RegionId = string(client.Region)
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
+	request := client.NewCommonRequest("POST", "Rds", "2014-08-15", "CreateDBInstance", "")
 
 	//request.Headers = map[string]string{"RegionId": string(client.RegionId)}
 	var VSwitchId, InstanceNetworkType, ZoneIdSlave1, ZoneIdSlave2, ZoneId, VPCId, arnrole string
 	var encryption bool
 	EncryptionKey := d.Get("encryption_key").(string)
 	encryption = d.Get("encryption").(bool)
-	log.Print("Encryption key input")
-	if EncryptionKey != "" && encryption == true {
-		log.Print("Encryption key condition passed")
-		req := requests.NewCommonRequest()
-		req.Method = "POST"
-		req.Product = "Rds"
-		req.Domain = client.Domain
-		req.Version = "2014-08-15"
-		req.Scheme = "http"
-		req.ApiName = "CheckCloudResourceAuthorized"
-		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.RegionId = string(client.Region)
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			req.Scheme = "https"
+	tde := d.Get("tde_status").(bool)
+	if EncryptionKey == "" && encryption == true {
+		return DiagnosticsFromError(WrapErrorf(nil, "Add EncryptionKey or Set encryption to false", "CheckCloudResourceAuthorized", request.GetActionName()))
+	} else if EncryptionKey != "" && encryption == false && tde == false {
+		return DiagnosticsFromError(WrapErrorf(nil, "Set encryption or tde_status to true", "CheckCloudResourceAuthorized", request.GetActionName()))
+	}
+	if EncryptionKey != "" {
+		if v, ok := d.GetOk("role_arn"); ok && v.(string) != "" {
+			arnrole = v.(string)
 		} else {
-			req.Scheme = "http"
-		}
-		req.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Rds",
-			"RegionId":        client.RegionId,
-			"TargetRegionId":  client.RegionId,
-		}
-		ram, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
-			return crClient.ProcessCommonRequest(req)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), req.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		var arnresp RoleARN
-		addDebug(req.GetActionName(), ram, req)
-		resparn, _ := ram.(*responses.CommonResponse)
-		log.Printf("raw response %v", resparn)
-		err = json.Unmarshal(resparn.GetHttpContentBytes(), &arnresp)
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "CheckCloudResourceAuthorized", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			roleArn, err := rdsService.DescribeRdsRoleArn()
+			if err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+			arnrole = roleArn
 		}
-		arnrole = arnresp.RoleArn
 		d.Set("role_arn", arnrole)
 		log.Printf("check arnrole %v", arnrole)
-	} else if EncryptionKey == "" && encryption == true {
-		return WrapErrorf(nil, "Add EncryptionKey or Set encryption to false", "CheckCloudResourceAuthorized", request.GetActionName())
-	} else if EncryptionKey != "" && encryption == false {
-		return WrapErrorf(nil, "Set encryption to true", "CheckCloudResourceAuthorized", request.GetActionName())
-	} else {
-		log.Print("Encryption key condition failed")
 	}
 	d.Set("encryption", encryption)
 	log.Printf("encryptionbool %v", d.Get("encryption").(bool))
@@ -339,40 +310,38 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 	if len(d.Get("security_ips").(*schema.Set).List()) > 0 {
 		SecurityIPList = strings.Join(expandStringList(d.Get("security_ips").(*schema.Set).List())[:], COMMA_SEPARATED)
 	}
+	// The key only encrypts the disks when encryption is on, otherwise it is kept for TDE.
+	diskEncryptionKey, diskRoleArn := "", ""
+	if encryption {
+		diskEncryptionKey, diskRoleArn = EncryptionKey, arnrole
+	}
 	uuid, err := uuid.GenerateUUID()
 	if err != nil {
 		uuid = resource.UniqueId()
 	}
 	ClientToken := fmt.Sprintf("Terraform-AlibabacloudStack-%d-%s", time.Now().Unix(), uuid)
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":       client.SecretKey,
-		"Product":               "rds",
-		"Department":            client.Department,
-		"ResourceGroup":         client.ResourceGroup,
-		"EngineVersion":         enginever,
-		"Engine":                engine,
-		"Encryption":            strconv.FormatBool(encryption),
-		"DBInstanceStorage":     string(DBInstanceStorage),
-		"DBInstanceClass":       DBInstanceClass,
-		"DBInstanceNetType":     DBInstanceNetType,
-		"DBInstanceDescription": DBInstanceDescription,
-		//"MultiZone":MultiZone,
-		"InstanceNetworkType":   InstanceNetworkType,
-		"VSwitchId":             VSwitchId,
-		"PayType":               PayType,
-		"DBInstanceStorageType": DBInstanceStorageType,
-		"SecurityIPList":        SecurityIPList,
-		"ClientToken":           ClientToken,
-		"ZoneIdSlave1":          ZoneIdSlave1,
-		"ZoneIdSlave2":          ZoneIdSlave2,
-		"EncryptionKey":         EncryptionKey,
-		"ZoneId":                ZoneId,
-		"VPCId":                 VPCId,
-		"RoleARN":               arnrole,
-		"DBInstanceType":        "Primary",
-	}
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	//request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams["EngineVersion"] = enginever
+	request.QueryParams["Engine"] = engine
+	request.QueryParams["Encryption"] = strconv.FormatBool(encryption)
+	request.QueryParams["DBInstanceStorage"] = string(DBInstanceStorage)
+	request.QueryParams["DBInstanceClass"] = DBInstanceClass
+	request.QueryParams["DBInstanceNetType"] = DBInstanceNetType
+	request.QueryParams["DBInstanceDescription"] = DBInstanceDescription
+	//"MultiZone":MultiZone,
+	request.QueryParams["InstanceNetworkType"] = InstanceNetworkType
+	request.QueryParams["VSwitchId"] = VSwitchId
+	request.QueryParams["PayType"] = PayType
+	request.QueryParams["DBInstanceStorageType"] = DBInstanceStorageType
+	request.QueryParams["SecurityIPList"] = SecurityIPList
+	request.QueryParams["ClientToken"] = ClientToken
+	request.QueryParams["ZoneIdSlave1"] = ZoneIdSlave1
+	request.QueryParams["ZoneIdSlave2"] = ZoneIdSlave2
+	request.QueryParams["EncryptionKey"] = diskEncryptionKey
+	request.QueryParams["ZoneId"] = ZoneId
+	request.QueryParams["VPCId"] = VPCId
+	request.QueryParams["RoleARN"] = diskRoleArn
+	request.QueryParams["DBInstanceType"] = "Primary"
+	//request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	log.Printf("request245 %v", request.QueryParams)
 	//log.Printf("request245 %v",request)
 	//raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -383,7 +352,7 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	if arnrole != "" {
 		log.Print("arnrole has been added")
@@ -396,7 +365,7 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 	err = json.Unmarshal(response.GetHttpContentBytes(), &resp)
 	//log.Printf("response for create %v", &resp)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	log.Printf("response25 %v", response)
 	d.SetId(resp.DBInstanceId)
@@ -404,13 +373,11 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 
 	// wait instance status change from Creating to running
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 2*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	if tde := d.Get("tde_status"); tde == true {
-		client := meta.(*connectivity.AlibabacloudStackClient)
-		rdsService = RdsService{client}
+	if tde {
 		tde_req := rds.CreateModifyDBInstanceTDERequest()
 		tde_req.RegionId = client.RegionId
 		tde_req.Headers = map[string]string{"RegionId": client.RegionId}
@@ -418,71 +385,71 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 		if EncryptionKey != "" {
 			tde_req.EncryptionKey = EncryptionKey
 		}
-		tde_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "RoleARN": arnrole}
+		tde_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "RoleARN": arnrole}
 
 		tde_req.TDEStatus = "Enabled"
 		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
+			tde_req.Scheme = "https"
 		} else {
-			request.Scheme = "http"
+			tde_req.Scheme = "http"
 		}
 		tderaw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 			return client.ModifyDBInstanceTDE(tde_req)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_instance", tde_req.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		log.Print("enabled TDE")
-		addDebug(request.GetActionName(), tderaw, request)
+		addDebug(tde_req.GetActionName(), tderaw, tde_req.RpcRequest, tde_req)
 	}
 	if ssl := d.Get("enable_ssl"); ssl == true {
 		ssl_req := rds.CreateModifyDBInstanceSSLRequest()
 		ssl_req.RegionId = client.RegionId
 		ssl_req.Headers = map[string]string{"RegionId": client.RegionId}
-		ssl_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
+		ssl_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
 		ssl_req.DBInstanceId = d.Id()
 		ssl_req.SSLEnabled = "1"
 		ssl_req.ConnectionString = d.Get("connection_string").(string)
 		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
+			ssl_req.Scheme = "https"
 		} else {
-			request.Scheme = "http"
+			ssl_req.Scheme = "http"
 		}
 		sslraw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 			return client.ModifyDBInstanceSSL(ssl_req)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), ssl_req.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		log.Print("enabled SSL")
-		addDebug(request.GetActionName(), sslraw, request)
+		addDebug(ssl_req.GetActionName(), sslraw, ssl_req.RpcRequest, ssl_req)
 	}
-	return resourceAlibabacloudStackDBInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDBInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//const tde_set = false
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
 	if d.HasChange("parameters") {
 		if err := rdsService.ModifyParameters(d, "parameters"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
 	if err := rdsService.setInstanceTags(d); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	payType := PayType(d.Get("instance_charge_type").(string))
@@ -495,7 +462,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 		prePaidRequest.RegionId = client.RegionId
 		prePaidRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		prePaidRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		prePaidRequest.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		prePaidRequest.DBInstanceId = d.Id()
 		prePaidRequest.PayType = string(payType)
 		prePaidRequest.AutoPay = "true"
@@ -510,12 +477,12 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			return client.ModifyDBInstancePayType(prePaidRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), prePaidRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), prePaidRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(prePaidRequest.GetActionName(), raw, prePaidRequest.RpcRequest, prePaidRequest)
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("instance_charge_type")
 		//d.SetPartial("period")
@@ -532,7 +499,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		auto_renew := d.Get("auto_renew").(bool)
 		if auto_renew {
 			request.AutoRenew = "True"
@@ -545,7 +512,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			return client.ModifyInstanceAutoRenewalAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
@@ -563,7 +530,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.Period = strconv.Itoa(period)
 
@@ -571,7 +538,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			return client.ModifyDBInstanceMonitor(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
@@ -585,7 +552,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.MaintainTime = d.Get("maintain_time").(string)
 		request.ClientToken = buildClientToken(request.GetActionName())
@@ -594,7 +561,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			return client.ModifyDBInstanceMaintainTime(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("maintain_time")
@@ -609,7 +576,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	//		request.Scheme = "http"
 	//	}
 	//	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	//	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	//	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	//	request.DBInstanceId = d.Id()
 	//	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 	//		return rdsClient.MigrateSecurityIPMode(request)
@@ -621,9 +588,53 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	//	//d.SetPartial("security_ip_mode")
 	//}
 
+	if d.HasChange("sql_collector_status") {
+		request := rds.CreateModifySQLCollectorPolicyRequest()
+		request.RegionId = client.RegionId
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.DBInstanceId = d.Id()
+		request.SQLCollectorStatus = d.Get("sql_collector_status").(string)
+
+		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.ModifySQLCollectorPolicy(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	if d.HasChange("sql_collector_config_value") {
+		request := rds.CreateModifySQLCollectorRetentionRequest()
+		request.RegionId = client.RegionId
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.DBInstanceId = d.Id()
+		request.ConfigValue = strconv.Itoa(d.Get("sql_collector_config_value").(int))
+
+		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.ModifySQLCollectorRetention(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDBInstanceRead(d, meta)
+		return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_name") {
@@ -635,7 +646,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("instance_name").(string)
 
@@ -643,7 +654,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			return rdsClient.ModifyDBInstanceDescription(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("instance_name")
@@ -659,7 +670,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 
 		if err := rdsService.ModifyDBSecurityIps(d.Id(), ipstr); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		//d.SetPartial("security_ips")
 	}
@@ -673,7 +684,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 	request.PayType = d.Get("instance_charge_type").(string)
 
@@ -688,10 +699,10 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	}
 	if update {
 		// wait instance status is running before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceSpec(request)
 			})
@@ -708,47 +719,57 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		// wait instance status is running after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
 	d.Partial(false)
 	if d.HasChange("tde_status") {
-		//if tde:=d.Get("tde_status");tde==true{
-		client := meta.(*connectivity.AlibabacloudStackClient)
-		rdsService = RdsService{client}
+		// TDE can not be disabled once it is enabled.
+		if !d.Get("tde_status").(bool) {
+			return DiagnosticsFromError(WrapError(Error("tde_status can not be disabled once it has been enabled.")))
+		}
+		encryptionKey := d.Get("encryption_key").(string)
+		roleArn := d.Get("role_arn").(string)
+		if encryptionKey != "" && roleArn == "" {
+			arn, err := rdsService.DescribeRdsRoleArn()
+			if err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+			roleArn = arn
+			d.Set("role_arn", roleArn)
+		}
 		tde_req := rds.CreateModifyDBInstanceTDERequest()
 		tde_req.RegionId = client.RegionId
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			tde_req.Scheme = "https"
+		} else {
+			tde_req.Scheme = "http"
+		}
 		tde_req.Headers = map[string]string{"RegionId": client.RegionId}
-		tde_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		tde_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		tde_req.DBInstanceId = d.Id()
 		tde_req.TDEStatus = "Enabled"
-		//tde_req.RoleArn=d.Get("role_arn").(string)
-		//tde_req.EncryptionKey=d.Get("encryption_key").(string)
-
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
+		if encryptionKey != "" {
+			tde_req.EncryptionKey = encryptionKey
+			tde_req.QueryParams["RoleARN"] = roleArn
 		}
+
 		raw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 			return client.ModifyDBInstanceTDE(tde_req)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), tde_req.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		addDebug(tde_req.GetActionName(), raw, tde_req.RpcRequest, tde_req)
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
-		log.Print("Updated TDE")
-		addDebug(request.GetActionName(), raw, request)
-		//}
-
 	}
 	if d.HasChange("enable_ssl") {
 		ssl := d.Get("enable_ssl").(bool)
@@ -756,58 +777,58 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			ssl_req := rds.CreateModifyDBInstanceSSLRequest()
 			ssl_req.RegionId = client.RegionId
 			ssl_req.Headers = map[string]string{"RegionId": client.RegionId}
-			ssl_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
+			ssl_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
 			ssl_req.DBInstanceId = d.Id()
 			ssl_req.SSLEnabled = "1"
 			ssl_req.ConnectionString = d.Get("connection_string").(string)
 			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
+				ssl_req.Scheme = "https"
 			} else {
-				request.Scheme = "http"
+				ssl_req.Scheme = "http"
 			}
 			sslraw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 				return client.ModifyDBInstanceSSL(ssl_req)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), ssl_req.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
-			if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
-				return WrapError(err)
+			if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 			log.Print("Updated SSL to true")
-			addDebug(request.GetActionName(), sslraw, request)
+			addDebug(ssl_req.GetActionName(), sslraw, ssl_req.RpcRequest, ssl_req)
 		} else {
 			ssl_req := rds.CreateModifyDBInstanceSSLRequest()
 			ssl_req.RegionId = client.RegionId
 			ssl_req.Headers = map[string]string{"RegionId": client.RegionId}
-			ssl_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
+			ssl_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
 			ssl_req.DBInstanceId = d.Id()
 			ssl_req.SSLEnabled = "0"
 			ssl_req.ConnectionString = d.Get("connection_string").(string)
 			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
+				ssl_req.Scheme = "https"
 			} else {
-				request.Scheme = "http"
+				ssl_req.Scheme = "http"
 			}
 			sslraw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 				return client.ModifyDBInstanceSSL(ssl_req)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), ssl_req.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
-			if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
-				return WrapError(err)
+			if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 			log.Print("Updated SSL to false")
-			addDebug(request.GetActionName(), sslraw, request)
+			addDebug(ssl_req.GetActionName(), sslraw, ssl_req.RpcRequest, ssl_req)
 		}
 	}
-	return resourceAlibabacloudStackDBInstanceRead(d, meta)
+	return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
@@ -816,17 +837,17 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	ips, err := rdsService.GetSecurityIps(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	tags, err := rdsService.describeTags(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(tags) > 0 {
 		d.Set("tags", rdsService.tagsToMap(tags))
@@ -834,7 +855,7 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 
 	monitoringPeriod, err := rdsService.DescribeDbInstanceMonitor(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("monitoring_period", monitoringPeriod)
@@ -854,8 +875,38 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 	d.Set("maintain_time", instance.MaintainTime)
 	d.Set("storage_type", instance.DBInstanceStorageType)
 
+	// TDE is only available for MySQL and SQL Server instances.
+	if instance.Engine == string(MySQL) || instance.Engine == string(SQLServer) {
+		tdeInfo, err := rdsService.DescribeRdsTDEInfo(d.Id())
+		if err != nil && !IsExpectedErrors(err, RdsUnsupportedErrors) {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		if err == nil {
+			d.Set("tde_status", tdeInfo.TDEStatus == "Enabled")
+		}
+	}
+
+	// the SQL collector is left unset when the engine or the deployment does not provide it.
+	collectorPolicy, err := rdsService.DescribeSQLCollectorPolicy(d.Id())
+	if err != nil && !IsExpectedErrors(err, RdsUnsupportedErrors) {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err == nil {
+		d.Set("sql_collector_status", collectorPolicy.SQLCollectorStatus)
+	}
+
+	collectorRetention, err := rdsService.DescribeSQLCollectorRetention(d.Id())
+	if err != nil && !IsExpectedErrors(err, RdsUnsupportedErrors) {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err == nil {
+		if configValue, err := strconv.Atoi(collectorRetention.ConfigValue); err == nil {
+			d.Set("sql_collector_config_value", configValue)
+		}
+	}
+
 	if err = rdsService.RefreshParameters(d, "parameters"); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if instance.PayType == string(Prepaid) {
@@ -867,14 +918,14 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DescribeInstanceAutoRenewalAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*rds.DescribeInstanceAutoRenewalAttributeResponse)
@@ -885,7 +936,7 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 		}
 		period, err := computePeriodByUnit(instance.CreationTime, instance.ExpireTime, d.Get("period").(int), "Month")
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		d.Set("period", period)
 	}
@@ -893,8 +944,8 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
@@ -902,10 +953,10 @@ func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta inte
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if PayType(instance.PayType) == Prepaid {
-		return WrapError(Error("At present, 'Prepaid' instance cannot be deleted and must wait it to be expired and release it automatically."))
+		return DiagnosticsFromError(WrapError(Error("At present, 'Prepaid' instance cannot be deleted and must wait it to be expired and release it automatically.")))
 	}
 
 	request := rds.CreateDeleteDBInstanceRequest()
@@ -916,10 +967,10 @@ func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(request)
 		})
@@ -936,7 +987,7 @@ func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	//stateConf := BuildStateConf([]string{"Processing", "Pending", "NoStart", "Failed", "Default"}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, rdsService.RdsTaskStateRefreshFunc(d.Id(), "DeleteDBInstance"))
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -39,7 +40,7 @@ func testSweepDBInstances(region string) error {
 	req := rds.CreateDescribeDBInstancesRequest()
 	req.RegionId = client.RegionId
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		req.Scheme = "https"
@@ -106,7 +107,7 @@ func testSweepDBInstances(region string) error {
 			}
 			request.DBInstanceId = id
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			if _, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ReleaseReadWriteSplittingConnection(request)
 			}); err != nil {
@@ -123,7 +124,7 @@ func testSweepDBInstances(region string) error {
 			req.Scheme = "http"
 		}
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(req)
 		})
@@ -265,6 +266,28 @@ func TestAccAlibabacloudStackDBInstanceMysql(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"sql_collector_status":       "Enabled",
+					"sql_collector_config_value": "30",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"sql_collector_status":       "Enabled",
+						"sql_collector_config_value": "30",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"tde_status": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tde_status": "true",
+					}),
+				),
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"engine":               "MySQL",
@@ -525,3 +548,40 @@ var instanceBasicMap = map[string]string{
 	"connection_string": CHECKSET,
 	"port":              CHECKSET,
 }
+
+// TestAlibabacloudStackDBInstance_cassette replays the creation, import, drift and deletion of a MySQL
+// instance from its cassette, without any network access.
+func TestAlibabacloudStackDBInstance_cassette(t *testing.T) {
+	client := testCassetteClient(t, "db_instance")
+	r := resourceAlibabacloudStackDBInstance()
+
+	d := testCassetteCreate(t, r, client, map[string]interface{}{
+		"engine":           "MySQL",
+		"engine_version":   "5.7",
+		"instance_type":    "rds.mysql.s2.large",
+		"instance_storage": 20,
+		"storage_type":     "local_ssd",
+		"vswitch_id":       "vsw-cassette01",
+		"instance_name":    "tf-testacc-cassette-rds",
+		"security_ips":     []interface{}{"10.0.0.0/8"},
+	})
+	expected := map[string]interface{}{
+		"engine":            "MySQL",
+		"engine_version":    "5.7",
+		"instance_type":     "rds.mysql.s2.large",
+		"instance_storage":  20,
+		"vswitch_id":        "vsw-cassette01",
+		"zone_id":           "cn-cassette-env1-amtest1001-a",
+		"instance_name":     "tf-testacc-cassette-rds",
+		"connection_string": "rm-cassette01.mysql.cassette.example.com",
+		"port":              "3306",
+	}
+	testCassetteCheckAttributes(t, "create", d, expected)
+	testCassetteCheckAttributes(t, "import", testCassetteImport(t, r, client, d.Id()), expected)
+
+	// The instance was scaled out of Terraform
+	testCassetteCheckDiags(t, "drift", r.ReadContext(context.Background(), d, client))
+	testCassetteCheckAttributes(t, "drift", d, map[string]interface{}{"instance_storage": 50})
+
+	testCassetteCheckDiags(t, "delete", r.DeleteContext(context.Background(), d, client))
+}
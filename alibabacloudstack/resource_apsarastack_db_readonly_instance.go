@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBReadonlyInstanceCreate,
-		Read:   resourceAlibabacloudStackDBReadonlyInstanceRead,
-		Update: resourceAlibabacloudStackDBReadonlyInstanceUpdate,
-		Delete: resourceAlibabacloudStackDBReadonlyInstanceDelete,
+		CreateContext: resourceAlibabacloudStackDBReadonlyInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackDBReadonlyInstanceRead,
+		UpdateContext: resourceAlibabacloudStackDBReadonlyInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackDBReadonlyInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -189,18 +191,18 @@ func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBReadonlyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	request, err := buildDBReadonlyCreateRequest(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	log.Print("wait for instance to be ready")
 
-	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	log.Print("instance is ready")
 	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -208,7 +210,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceCreate(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	resp, _ := raw.(*rds.CreateReadOnlyDBInstanceResponse)
@@ -216,31 +218,31 @@ func resourceAlibabacloudStackDBReadonlyInstanceCreate(d *schema.ResourceData, m
 
 	// wait instance status change from Creating to running
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackDBReadonlyInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDBReadonlyInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBReadonlyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	d.Partial(true)
 
 	if d.HasChange("parameters") {
 		if err := rdsService.ModifyParameters(d, "parameters"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
 	if err := rdsService.setInstanceTags(d); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDBInstanceRead(d, meta)
+		return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_name") {
@@ -252,11 +254,11 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("instance_name").(string)
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceDescription(request)
 			})
@@ -274,7 +276,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 	}
@@ -289,7 +291,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 	}
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 	request.PayType = string(Postpaid)
 
@@ -310,12 +312,12 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 	if update {
 		// wait instance status is running before modifying
 		stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-		_, err := stateConf.WaitForState()
+		_, err := stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceSpec(request)
 			})
@@ -333,23 +335,23 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		// wait instance status is running after modifying
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBReadonlyInstanceRead(d, meta)
+	return resourceAlibabacloudStackDBReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
@@ -358,7 +360,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("engine", instance.Engine)
@@ -374,12 +376,12 @@ func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, met
 	d.Set("db_instance_storage_type", instance.DBInstanceStorageType)
 
 	if err = rdsService.RefreshParameters(d, "parameters"); err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	tags, err := rdsService.describeTags(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(tags) > 0 {
 		d.Set("tags", rdsService.tagsToMap(tags))
@@ -388,8 +390,8 @@ func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBReadonlyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
@@ -397,10 +399,10 @@ func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, m
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if PayType(instance.PayType) == Prepaid {
-		return WrapError(Error("At present, 'Prepaid' instance cannot be deleted and must wait it to be expired and release it automatically."))
+		return DiagnosticsFromError(WrapError(Error("At present, 'Prepaid' instance cannot be deleted and must wait it to be expired and release it automatically.")))
 	}
 
 	request := rds.CreateDeleteDBInstanceRequest()
@@ -411,10 +413,10 @@ func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(request)
@@ -436,7 +438,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, m
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	waitSecondsIfWithTest(600)
 	return nil
@@ -453,7 +455,7 @@ func buildDBReadonlyCreateRequest(d *schema.ResourceData, meta interface{}) (*rd
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = Trim(d.Get("master_db_instance_id").(string))
 	request.EngineVersion = Trim(d.Get("engine_version").(string))
 	request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
@@ -21,14 +23,20 @@ var dbConnectionPrefixWithSuffixRegexp = regexp.MustCompile(dbConnectionPrefixWi
 
 func resourceAlibabacloudStackDBReadWriteSplittingConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate,
-		Read:   resourceAlibabacloudStackDBReadWriteSplittingConnectionRead,
-		Update: resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate,
-		Delete: resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete,
+		CreateContext: resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackDBReadWriteSplittingConnectionRead,
+		UpdateContext: resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate,
+		DeleteContext: resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -68,8 +76,8 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnection() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	request := rds.CreateAllocateReadWriteSplittingConnectionRequest()
@@ -80,7 +88,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = Trim(d.Get("instance_id").(string))
 	request.MaxDelayTime = strconv.Itoa(d.Get("max_delay_time").(int))
 
@@ -98,13 +106,13 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 
 	if weight, ok := d.GetOk("weight"); ok && weight != nil && len(weight.(map[string]interface{})) > 0 {
 		if serial, err := json.Marshal(weight); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			request.Weight = string(serial)
 		}
 	}
 
-	if err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.AllocateReadWriteSplittingConnection(request)
 		})
@@ -117,34 +125,34 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(request.DBInstanceId)
 
 	// wait read write splitting connection ready after creation
 	// for it may take up to 10 hours to create a readonly instance
-	if err := rdsService.WaitForDBReadWriteSplitting(request.DBInstanceId, "", 60*60*10); err != nil {
-		return WrapError(err)
+	if err := rdsService.WaitForDBReadWriteSplitting(request.DBInstanceId, "", int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d, meta)
+	return resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	err := rdsService.WaitForDBReadWriteSplitting(d.Id(), "", DefaultLongTimeout)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	object, err := rdsService.DescribeDBReadWriteSplittingConnection(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", d.Id())
@@ -179,8 +187,8 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d *schema.Resou
 	return nil
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 
 	request := rds.CreateModifyReadWriteSplittingConnectionRequest()
@@ -191,7 +199,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
 	update := false
@@ -202,13 +210,13 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 	}
 
 	if !update && d.IsNewResource() {
-		return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d, meta)
+		return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx, d, meta)
 	}
 
 	if d.HasChange("weight") {
 		if weight, ok := d.GetOk("weight"); ok && weight != nil && len(weight.(map[string]interface{})) > 0 {
 			if serial, err := json.Marshal(weight); err != nil {
-				return err
+				return DiagnosticsFromError(err)
 			} else {
 				request.Weight = string(serial)
 			}
@@ -223,11 +231,11 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 
 	if update {
 		// wait instance running before modifying
-		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, 60*60); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
-		if err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyReadWriteSplittingConnection(request)
 			})
@@ -240,20 +248,20 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			return nil
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		// wait instance running after modifying
-		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
-	return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d, meta)
+	return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rdsService := RdsService{client}
 	request := rds.CreateReleaseReadWriteSplittingConnectionRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -263,10 +271,10 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(d *schema.Res
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	if err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.ReleaseReadWriteSplittingConnection(request)
@@ -283,8 +291,8 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(d *schema.Res
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(rdsService.WaitForDBReadWriteSplitting(d.Id(), Deleted, DefaultLongTimeout))
+	return DiagnosticsFromError(WrapError(rdsService.WaitForDBReadWriteSplitting(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,17 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -20,10 +20,10 @@ import (
 
 func resourceAlibabacloudStackDbsBackupPlan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDbsBackupPlanCreate,
-		Read:   resourceAlibabacloudStackDbsBackupPlanRead,
-		Update: resourceAlibabacloudStackDbsBackupPlanUpdate,
-		Delete: resourceAlibabacloudStackDbsBackupPlanDelete,
+		CreateContext: resourceAlibabacloudStackDbsBackupPlanCreate,
+		ReadContext:   resourceAlibabacloudStackDbsBackupPlanRead,
+		UpdateContext: resourceAlibabacloudStackDbsBackupPlanUpdate,
+		DeleteContext: resourceAlibabacloudStackDbsBackupPlanDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -75,38 +75,18 @@ func resourceAlibabacloudStackDbsBackupPlan() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDbsBackupPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateBackupPlan"
-	request := requests.NewCommonRequest()
-	request.ApiName = action
-	request.Version = "2019-03-06"
-	request.Method = "POST"
-	request.Product = "dbs"
-	request.RegionId = client.RegionId
-	request.Domain = client.Domain
-	request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	request.Headers["x-acs-regionid"] = client.RegionId
-	request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	request.Headers["x-acs-organizationid"] = client.Department
+	request := client.NewCommonRequest("POST", "dbs", "2019-03-06", action, "")
+	request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	request.Headers["x-acs-content-type"] = "application/json"
 	request.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":       client.RegionId,
-		"Product":        "dbs",
-		"Version":        "2019-03-06",
-		"Action":         action,
-		"OrganizationId": client.Department,
-		"Period":         "Year",
-		"UsedTime":       "1",
-		"ClientToken":    buildClientToken("CreateBackupPlan"),
-	}
+	request.QueryParams["OrganizationId"] = client.Department
+	request.QueryParams["Period"] = "Year"
+	request.QueryParams["UsedTime"] = "1"
+	request.QueryParams["ClientToken"] = buildClientToken("CreateBackupPlan")
 	if v, ok := d.GetOk("backup_method"); ok {
 		request.QueryParams["BackupMethod"] = v.(string)
 	}
@@ -144,7 +124,7 @@ func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta i
 		dbsClient, err = sts.NewClientWithStsToken(client.Config.RegionId, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
 	}
 	dbsClient.Domain = client.Config.AscmEndpoint
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := dbsClient.ProcessCommonRequest(request)
 		addDebug(action, raw, request, request.QueryParams)
 		if err != nil {
@@ -161,16 +141,16 @@ func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dbs_backup_plan", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dbs_backup_plan", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["BackupPlanId"]))
 
-	return resourceAlibabacloudStackDbsBackupPlanRead(d, meta)
+	return resourceAlibabacloudStackDbsBackupPlanRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDbsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDbsBackupPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dbsService := DbsService{client}
 	object, err := dbsService.DescribeDbsBackupPlan(d.Id())
 	if err != nil {
@@ -179,10 +159,10 @@ func resourceAlibabacloudStackDbsBackupPlanRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	for key, value := range object {
@@ -195,8 +175,8 @@ func resourceAlibabacloudStackDbsBackupPlanRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDbsBackupPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := make(map[string]interface{})
 	var response map[string]interface{}
 
@@ -212,10 +192,10 @@ func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta i
 	request["ClientToken"] = buildClientToken("ModifyBackupPlanName")
 	conn, err := client.NewDbsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -228,12 +208,12 @@ func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta i
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
-	return resourceAlibabacloudStackDbsBackupPlanRead(d, meta)
+	return resourceAlibabacloudStackDbsBackupPlanRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDbsBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// 没有接口
 	return nil
 }
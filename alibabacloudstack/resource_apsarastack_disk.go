@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -16,14 +18,20 @@ import (
 
 func resourceAlibabacloudStackDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDiskCreate,
-		Read:   resourceAlibabacloudStackDiskRead,
-		Update: resourceAlibabacloudStackDiskUpdate,
-		Delete: resourceAlibabacloudStackDiskDelete,
+		CreateContext: resourceAlibabacloudStackDiskCreate,
+		ReadContext:   resourceAlibabacloudStackDiskRead,
+		UpdateContext: resourceAlibabacloudStackDiskUpdate,
+		DeleteContext: resourceAlibabacloudStackDiskDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -113,13 +121,13 @@ func resourceAlibabacloudStackDisk() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	availabilityZone, err := ecsService.DescribeZone(d.Get("availability_zone").(string))
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := ecs.CreateCreateDiskRequest()
@@ -131,12 +139,12 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 	request.RegionId = client.RegionId
 	request.ZoneId = availabilityZone.ZoneId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("category"); ok && v.(string) != "" {
 		category := DiskCategory(v.(string))
 		if err := ecsService.DiskAvailable(availabilityZone, category); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.DiskCategory = v.(string)
 	}
@@ -161,7 +169,7 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 				request.KMSKeyId = j.(string)
 			}
 			if request.KMSKeyId == "" {
-				return WrapError(errors.New("KmsKeyId can not be empty if encrypted is set to \"true\""))
+				return DiagnosticsFromError(WrapError(errors.New("KmsKeyId can not be empty if encrypted is set to \"true\"")))
 			}
 		}
 	}
@@ -181,7 +189,7 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 		if i >= 1 {
 			request.StorageSetPartitionNumber = requests.NewInteger(d.Get("storage_set_partition_number").(int))
 		} else {
-			 return WrapError(errors.New("cant empty storage_set_partition_number when you set storage_set_id and >=2"))
+			 return DiagnosticsFromError(WrapError(errors.New("cant empty storage_set_partition_number when you set storage_set_id and >=2")))
 		}
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
@@ -189,21 +197,21 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 		return ecsClient.CreateDisk(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_disk", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_disk", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ecs.CreateDiskResponse)
 	d.SetId(response.DiskId)
-	if err := ecsService.WaitForDisk(d.Id(), Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := ecsService.WaitForDisk(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackDiskUpdate(d, meta)
+	return resourceAlibabacloudStackDiskUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeDisk(d.Id())
 
@@ -212,7 +220,7 @@ func resourceAlibabacloudStackDiskRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("availability_zone", object.ZoneId)
@@ -233,8 +241,8 @@ func resourceAlibabacloudStackDiskRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	d.Partial(true)
 
@@ -242,7 +250,7 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 	request := ecs.CreateModifyDiskAttributeRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DiskId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -287,19 +295,19 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 			return ecsClient.ModifyDiskAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDiskRead(d, meta)
+		return resourceAlibabacloudStackDiskRead(ctx, d, meta)
 	}
 
 	err := setTags(client, TagResourceDisk, d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	//d.SetPartial("tags")
 
@@ -308,7 +316,7 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 		request := ecs.CreateResizeDiskRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DiskId = d.Id()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -327,23 +335,23 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 			})
 		}
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("size")
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDiskRead(d, meta)
+	return resourceAlibabacloudStackDiskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request := ecs.CreateDeleteDiskRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	request.DiskId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -351,7 +359,7 @@ func resourceAlibabacloudStackDiskDelete(d *schema.ResourceData, meta interface{
 	} else {
 		request.Scheme = "http"
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteDisk(request)
 		})
@@ -365,7 +373,7 @@ func resourceAlibabacloudStackDiskDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(ecsService.WaitForDisk(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(ecsService.WaitForDisk(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -14,9 +16,17 @@ import (
 
 func resourceAlibabacloudStackDiskAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDiskAttachmentCreate,
-		Read:   resourceAlibabacloudStackDiskAttachmentRead,
-		Delete: resourceAlibabacloudStackDiskAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackDiskAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackDiskAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -40,15 +50,15 @@ func resourceAlibabacloudStackDiskAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDiskAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	diskID := d.Get("disk_id").(string)
 	instanceID := d.Get("instance_id").(string)
 	oldDisk, err := ecsService.DescribeDisk(diskID)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := ecs.CreateAttachDiskRequest()
 	request.RegionId = client.RegionId
@@ -59,10 +69,10 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DiskId = diskID
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachDisk(request)
 		})
@@ -77,16 +87,16 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_disk_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_disk_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(request.DiskId + ":" + request.InstanceId)
 
-	if err := ecsService.WaitForDiskAttachment(d.Id(), DiskInUse, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := ecsService.WaitForDiskAttachment(d.Id(), DiskInUse, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	newDisk, err := ecsService.DescribeDisk(diskID)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if newDisk.DeleteAutoSnapshot != oldDisk.DeleteAutoSnapshot {
 		request := ecs.CreateModifyDiskAttributeRequest()
@@ -97,23 +107,23 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DiskId = diskID
 		request.DeleteAutoSnapshot = requests.NewBoolean(oldDisk.DeleteAutoSnapshot)
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ModifyDiskAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackDiskAttachmentRead(d, meta)
+	return resourceAlibabacloudStackDiskAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	disk, err := ecsService.DescribeDiskAttachment(d.Id())
 
@@ -122,7 +132,7 @@ func resourceAlibabacloudStackDiskAttachmentRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", disk.InstanceId)
@@ -136,12 +146,12 @@ func resourceAlibabacloudStackDiskAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDiskAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := ecs.CreateDetachDiskRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -151,11 +161,11 @@ func resourceAlibabacloudStackDiskAttachmentDelete(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = parts[1]
 	request.DiskId = parts[0]
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachDisk(request)
 		})
@@ -170,7 +180,7 @@ func resourceAlibabacloudStackDiskAttachmentDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(ecsService.WaitForDiskAttachment(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(ecsService.WaitForDiskAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -43,6 +43,11 @@ func TestAccAlibabacloudStackDiskAttachment(t *testing.T) {
 						"alibabacloudstack_disk_attachment.default", "device_name"),
 				),
 			},
+			{
+				ResourceName:      "alibabacloudstack_disk_attachment.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccDiskAttachmentConfigResize(),
 				Check: resource.ComposeTestCheckFunc(
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,15 +17,17 @@ import (
 
 func resourceAlibabacloudStackDmsEnterpriseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDmsEnterpriseInstanceCreate,
-		Read:   resourceAlibabacloudStackDmsEnterpriseInstanceRead,
-		Update: resourceAlibabacloudStackDmsEnterpriseInstanceUpdate,
-		Delete: resourceAlibabacloudStackDmsEnterpriseInstanceDelete,
+		CreateContext: resourceAlibabacloudStackDmsEnterpriseInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackDmsEnterpriseInstanceRead,
+		UpdateContext: resourceAlibabacloudStackDmsEnterpriseInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackDmsEnterpriseInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"data_link_name": {
@@ -164,14 +168,14 @@ func resourceAlibabacloudStackDmsEnterpriseInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "RegisterInstance"
 	request := make(map[string]interface{})
 	conn, err := client.NewDmsenterpriseClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("data_link_name"); ok {
 		request["DataLinkName"] = v
@@ -200,7 +204,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData
 	} else if v, ok := d.GetOk("instance_alias"); ok {
 		request["InstanceAlias"] = v
 	} else {
-		return WrapError(Error(`[ERROR] Argument "instance_alias" or "instance_name" must be set one!`))
+		return DiagnosticsFromError(WrapAttributeError(WrapError(Error(`[ERROR] Argument "instance_alias" or "instance_name" must be set one!`)), "instance_alias"))
 	}
 
 	request["InstanceSource"] = d.Get("instance_source")
@@ -230,7 +234,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData
 	}
 
 	wait := incrementalWait(3*time.Second, 2*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["RegionId"] = client.RegionId
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
@@ -246,15 +250,15 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dms_enterprise_instance", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dms_enterprise_instance", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(request["Host"], ":", request["Port"]))
 
-	return resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dms_enterpriseService := Dms_enterpriseService{client}
 	object, err := dms_enterpriseService.DescribeDmsEnterpriseInstance(d.Id())
 	if err != nil {
@@ -263,11 +267,11 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceRead(d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("host", parts[0])
 	d.Set("port", formatInt(parts[1]))
@@ -295,12 +299,12 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceRead(d *schema.ResourceData,
 
 	return nil
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	update := false
 	request := map[string]interface{}{
@@ -396,10 +400,10 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData
 		action := "UpdateInstance"
 		conn, err := client.NewDmsenterpriseClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			request["RegionId"] = client.RegionId
 			request["Product"] = "dms-enterprise"
 			request["OrganizationId"] = client.Department
@@ -415,22 +419,22 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackDmsEnterpriseInstanceRead(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "DeleteInstance"
 	var response map[string]interface{}
 	conn, err := client.NewDmsenterpriseClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"Host": parts[0],
@@ -444,7 +448,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(d *schema.ResourceData
 		request["Tid"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["RegionId"] = client.RegionId
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
@@ -463,7 +467,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(d *schema.ResourceData
 		if IsExpectedErrors(err, []string{"InstanceNoEnoughNumber"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
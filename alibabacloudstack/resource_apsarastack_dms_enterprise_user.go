@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackDmsEnterpriseUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDmsEnterpriseUserCreate,
-		Read:   resourceAlibabacloudStackDmsEnterpriseUserRead,
-		Update: resourceAlibabacloudStackDmsEnterpriseUserUpdate,
-		Delete: resourceAlibabacloudStackDmsEnterpriseUserDelete,
+		CreateContext: resourceAlibabacloudStackDmsEnterpriseUserCreate,
+		ReadContext:   resourceAlibabacloudStackDmsEnterpriseUserRead,
+		UpdateContext: resourceAlibabacloudStackDmsEnterpriseUserUpdate,
+		DeleteContext: resourceAlibabacloudStackDmsEnterpriseUserDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -74,14 +76,14 @@ func resourceAlibabacloudStackDmsEnterpriseUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "RegisterUser"
 	request := make(map[string]interface{})
 	conn, err := client.NewDmsenterpriseClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("mobile"); ok {
 		request["Mobile"] = v
@@ -103,7 +105,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, me
 	}
 	request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
@@ -118,15 +120,15 @@ func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dms_enterprise_user", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dms_enterprise_user", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(request["Uid"]))
 
-	return resourceAlibabacloudStackDmsEnterpriseUserUpdate(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseUserUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dms_enterpriseService := Dms_enterpriseService{client}
 	object, err := dms_enterpriseService.DescribeDmsEnterpriseUser(d.Id())
 	if err != nil {
@@ -135,7 +137,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("uid", d.Id())
@@ -146,8 +148,8 @@ func resourceAlibabacloudStackDmsEnterpriseUserRead(d *schema.ResourceData, meta
 	d.Set("nick_name", object["NickName"])
 	return nil
 }
-func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dms_enterpriseService := Dms_enterpriseService{client}
 	var response map[string]interface{}
 	d.Partial(true)
@@ -185,10 +187,10 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 		action := "UpdateUser"
 		conn, err := client.NewDmsenterpriseClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			request["RegionId"] = client.RegionId
 			request["Product"] = "dms-enterprise"
 			request["OrganizationId"] = client.Department
@@ -204,7 +206,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("mobile")
 		//d.SetPartial("role_names")
@@ -214,7 +216,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 	if d.HasChange("status") {
 		object, err := dms_enterpriseService.DescribeDmsEnterpriseUser(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		target := d.Get("status").(string)
 		if object["State"].(string) != target {
@@ -228,10 +230,10 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 				action := "DisableUser"
 				conn, err := client.NewDmsenterpriseClient()
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					request["RegionId"] = client.RegionId
 					request["Product"] = "dms-enterprise"
 					request["OrganizationId"] = client.Department
@@ -247,7 +249,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 					return nil
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 				}
 			}
 			if target == "NORMAL" {
@@ -260,10 +262,10 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 				action := "EnableUser"
 				conn, err := client.NewDmsenterpriseClient()
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					request["RegionId"] = client.RegionId
 					request["Product"] = "dms-enterprise"
 					request["OrganizationId"] = client.Department
@@ -279,22 +281,22 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 					return nil
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 				}
 			}
 			//d.SetPartial("status")
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackDmsEnterpriseUserRead(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDmsEnterpriseUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteUser"
 	var response map[string]interface{}
 	conn, err := client.NewDmsenterpriseClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"Uid": d.Id(),
@@ -304,7 +306,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserDelete(d *schema.ResourceData, me
 		request["Tid"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["RegionId"] = client.RegionId
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
@@ -320,7 +322,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserDelete(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,26 +1,33 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDnsDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsDomainCreate,
-		Read:   resourceAlibabacloudStackDnsDomainRead,
-		Update: resourceAlibabacloudStackDnsDomainUpdate,
-		Delete: resourceAlibabacloudStackDnsDomainDelete,
+		CreateContext: resourceAlibabacloudStackDnsDomainCreate,
+		ReadContext:   resourceAlibabacloudStackDnsDomainRead,
+		UpdateContext: resourceAlibabacloudStackDnsDomainUpdate,
+		DeleteContext: resourceAlibabacloudStackDnsDomainDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"dns_servers": {
 				Type:     schema.TypeSet,
@@ -58,70 +65,55 @@ func resourceAlibabacloudStackDnsDomain() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 	var requestInfo *ecs.Client
 	DomainName := d.Get("domain_name").(string)
 	check, err := dnsService.DescribeDnsDomain(DomainName)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "domain alreadyExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "domain alreadyExist", AlibabacloudStackSdkGoERROR))
 	}
 	if len(check.Data) == 0 {
 
-		request := requests.NewCommonRequest()
-		request.Method = "POST"        // Set request method
-		request.Product = "CloudDns"   // Specify product
-		request.Domain = client.Domain // Location Service will not be enabled if the host is specified. For example, service with a Certification type-Bearer Token should be specified
-		request.Version = "2021-06-24" // Specify product version
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "AddGlobalZone"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudDns",
-			"RegionId":        client.RegionId,
-			"Action":          "AddGlobalZone",
-			"Version":         "2021-06-24",
-			"Name":            DomainName,
-		}
+		request := client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "AddGlobalZone", "")
+		request.QueryParams["Name"] = DomainName
 		raw, err := client.WithEcsClient(func(dnsClient *ecs.Client) (interface{}, error) {
 			return dnsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw)
 		bresponse, _ := raw.(*responses.CommonResponse)
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "AddGlobalZone", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "AddGlobalZone", AlibabacloudStackSdkGoERROR))
 		}
 		addDebug("AddGlobalZone", raw, requestInfo, bresponse.GetHttpContentString())
 	}
-	//err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-	check, err = dnsService.DescribeDnsDomain(DomainName)
-	//if err != nil {
-	//	return resource.NonRetryableError(err)
-	//}
-	//return resource.RetryableError(err)
-	//})
+	// The new zone is not listed right away.
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		check, err = dnsService.DescribeDnsDomain(DomainName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(check.Data) == 0 {
+			return resource.RetryableError(WrapErrorf(Error(GetNotFoundMessage("alibabacloudstack_dns_domain", DomainName)), NotFoundMsg, ProviderERROR))
+		}
+		return nil
+	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "DescribeDnsDomain")
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "DescribeDnsDomain"))
 	}
 	//id := strconv.Itoa(dnsresp.ID)
 	//d.SetId(id)
 	d.SetId(check.Data[0].Name + COLON_SEPARATED + fmt.Sprint(check.Data[0].Id))
 	//d.SetId(DomainName)
-	return resourceAlibabacloudStackDnsDomainUpdate(d, meta)
+	return resourceAlibabacloudStackDnsDomainUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDnsDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 	object, err := dnsService.DescribeDnsDomain(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
@@ -129,7 +121,7 @@ func resourceAlibabacloudStackDnsDomainRead(d *schema.ResourceData, meta interfa
 		if NotFoundError(err) {
 			d.SetId("")
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("domain_name", did[0])
@@ -137,15 +129,15 @@ func resourceAlibabacloudStackDnsDomainRead(d *schema.ResourceData, meta interfa
 	d.Set("remark", object.Data[0].Remark)
 	return nil
 }
-func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 	remarkUpdate := false
 	check, err := dnsService.DescribeDnsDomain(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsDomainExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsDomainExist", AlibabacloudStackSdkGoERROR))
 	}
 
 	var desc string
@@ -162,31 +154,10 @@ func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta inter
 		}
 		check.Data[0].Remark = desc
 	}
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "CloudDns"
-	request.Domain = client.Domain
-	request.Version = "2021-06-24"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "UpdateGlobalZoneRemark"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.RegionId = client.RegionId
-
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudDns",
-		"RegionId":        client.RegionId,
-		"Action":          "UpdateGlobalZoneRemark",
-		"Version":         "2021-06-24",
-		"Name":            did[0],
-		"Id":              did[1],
-		"Remark":          desc,
-	}
+	request := client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "UpdateGlobalZoneRemark", "")
+	request.QueryParams["Name"] = did[0]
+	request.QueryParams["Id"] = did[1]
+	request.QueryParams["Remark"] = desc
 	if remarkUpdate {
 
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -195,52 +166,49 @@ func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta inter
 		log.Printf(" response of raw UpdateGlobalZoneRemark : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "UpdateGlobalZoneRemark", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "UpdateGlobalZoneRemark", raw))
 		}
 		addDebug(request.GetActionName(), raw, request)
 	}
 	d.SetId(check.Data[0].Name + COLON_SEPARATED + fmt.Sprint(check.Data[0].Id))
 	//d.SetId(did[0])
-	return resourceAlibabacloudStackDnsDomainRead(d, meta)
+	return resourceAlibabacloudStackDnsDomainRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDnsDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 	var requestInfo *ecs.Client
 	check, err := dnsService.DescribeDnsDomain(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsDomainExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsDomainExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsDomainExist", check, requestInfo, map[string]string{"Id": did[1]})
 
 	if len(check.Data) != 0 {
-		request := requests.NewCommonRequest()
-		request.Method = "POST"        // Set request method
-		request.Product = "CloudDns"   // Specify product
-		request.Domain = client.Domain // Location Service will not be enabled if the host is specified. For example, service with a Certification type-Bearer Token should be specified
-		request.Version = "2021-06-24" // Specify product version
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "DeleteGlobalZone"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudDns",
-			"RegionId":        client.RegionId,
-			"Action":          "DeleteGlobalZone",
-			"Version":         "2021-06-24",
-			"Id":              did[1],
-		}
+		request := client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "DeleteGlobalZone", "")
+		request.QueryParams["Id"] = did[1]
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "DeleteGlobalZone", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_domain", "DeleteGlobalZone", AlibabacloudStackSdkGoERROR))
+		}
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			check, err := dnsService.DescribeDnsDomain(d.Id())
+			if err != nil {
+				if NotFoundError(err) {
+					return nil
+				}
+				return resource.NonRetryableError(err)
+			}
+			if len(check.Data) != 0 {
+				return resource.RetryableError(WrapErrorf(Error("the dns domain %s is still being deleted", d.Id()), DefaultErrorMsg, d.Id(), "DeleteGlobalZone", ProviderERROR))
+			}
+			return nil
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
@@ -1,22 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDnsDomainAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsDomainAttachmentCreate,
-		Read:   resourceApasaraStackDnsDomainAttachmentRead,
-		Update: resourceAlibabacloudStackDnsDomainAttachmentUpdate,
-		Delete: resourceAlibabacloudStackDnsdomainAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackDnsDomainAttachmentCreate,
+		ReadContext:   resourceApasaraStackDnsDomainAttachmentRead,
+		UpdateContext: resourceAlibabacloudStackDnsDomainAttachmentUpdate,
+		DeleteContext: resourceAlibabacloudStackDnsdomainAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -32,13 +40,13 @@ func resourceAlibabacloudStackDnsDomainAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsDomainAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId(d.Get("instance_id").(string))
-	return resourceAlibabacloudStackDnsDomainAttachmentUpdate(d, meta)
+	return resourceAlibabacloudStackDnsDomainAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceApasaraStackDnsDomainAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceApasaraStackDnsDomainAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 
 	object, err := dnsService.DescribeDnsDomainAttachment(d.Id())
@@ -47,15 +55,15 @@ func resourceApasaraStackDnsDomainAttachmentRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("instance_id", d.Id())
 	d.Set("domain_names", flatten(object))
 	return nil
 
 }
-func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsDomainAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 
 	o, n := d.GetChange("domain_names")
@@ -84,7 +92,7 @@ func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData,
 		removeNames := strings.Join(remove, ",")
 		request := alidns.CreateUnbindInstanceDomainsRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = client.Department
 		request.QueryParams["ResourceGroup"] = client.ResourceGroup
 		request.InstanceId = d.Id()
@@ -94,14 +102,14 @@ func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData,
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
 	if len(add) > 0 {
 		addNames := strings.Join(add, ",")
 		request := alidns.CreateBindInstanceDomainsRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = client.Department
 		request.QueryParams["ResourceGroup"] = client.ResourceGroup
 		request.InstanceId = d.Id()
@@ -111,17 +119,17 @@ func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData,
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
-	if err := dnsService.WaitForAlidnsDomainAttachment(d.Id(), map[string]interface{}{"Domain": d.Get("domain_names").(*schema.Set).List()}, false, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := dnsService.WaitForAlidnsDomainAttachment(d.Id(), map[string]interface{}{"Domain": d.Get("domain_names").(*schema.Set).List()}, false, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceApasaraStackDnsDomainAttachmentRead(d, meta)
+	return resourceApasaraStackDnsDomainAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsdomainAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsdomainAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 
 	domainNames := d.Get("domain_names").(*schema.Set).List()
@@ -132,7 +140,7 @@ func resourceAlibabacloudStackDnsdomainAttachmentDelete(d *schema.ResourceData,
 
 	request := alidns.CreateUnbindInstanceDomainsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	request.InstanceId = d.Id()
@@ -143,9 +151,9 @@ func resourceAlibabacloudStackDnsdomainAttachmentDelete(d *schema.ResourceData,
 	})
 	addDebug(request.GetActionName(), raw)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(dnsService.WaitForAlidnsDomainAttachment(d.Id(), nil, true, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(dnsService.WaitForAlidnsDomainAttachment(d.Id(), nil, true, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func flatten(input alidns.DescribeInstanceDomainsResponse) []string {
@@ -1,11 +1,10 @@
 package alibabacloudstack
 
 import (
-	"strings"
-	"time"
+	"context"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -15,10 +14,13 @@ import (
 
 func resourceAlibabacloudStackDnsGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsGroupCreate,
-		Read:   resourceAlibabacloudStackDnsGroupRead,
-		Update: resourceAlibabacloudStackDnsGroupUpdate,
-		Delete: resourceAlibabacloudStackDnsGroupDelete,
+		CreateContext: resourceAlibabacloudStackDnsGroupCreate,
+		ReadContext:   resourceAlibabacloudStackDnsGroupRead,
+		UpdateContext: resourceAlibabacloudStackDnsGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackDnsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -29,49 +31,30 @@ func resourceAlibabacloudStackDnsGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//request := alidns.CreateAddDomainGroupRequest()
 	GroupName := d.Get("name").(string)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"        // Set request method
-	request.Product = "GenesisDns" // Specify product
-	request.Domain = client.Domain // Location Service will not be enabled if the host is specified. For example, service with a Certification type-Bearer Token should be specified
-	request.Version = "2018-07-20" // Specify product version
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "AddDomainGroup"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "GenesisDns",
-		"RegionId":        client.RegionId,
-		"Action":          "AddDomainGroup",
-		"Version":         "2018-07-20",
-		"GroupName":       GroupName,
-	}
+	request := client.NewCommonRequest("POST", "GenesisDns", "2018-07-20", "AddDomainGroup", "")
+	request.QueryParams["GroupName"] = GroupName
 	raw, err := client.WithEcsClient(func(dnsClient *ecs.Client) (interface{}, error) {
 		return dnsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request)
 	response, _ := raw.(*alidns.AddDomainGroupResponse)
 	d.SetId(response.GroupId)
-	return resourceAlibabacloudStackDnsGroupRead(d, meta)
+	return resourceAlibabacloudStackDnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := alidns.CreateUpdateDomainGroupRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "GenesisDns"}
+	request.QueryParams = map[string]string{"Product": "GenesisDns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	request.RegionId = client.RegionId
@@ -83,17 +66,17 @@ func resourceAlibabacloudStackDnsGroupUpdate(d *schema.ResourceData, meta interf
 			return dnsClient.UpdateDomainGroup(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackDnsGroupRead(d, meta)
+	return resourceAlibabacloudStackDnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := &DnsService{client: client}
 	object, err := dnsService.DescribeDnsGroup(d.Id())
 	if err != nil {
@@ -101,24 +84,24 @@ func resourceAlibabacloudStackDnsGroupRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("name", object.GroupName)
 	return nil
 }
 
-func resourceAlibabacloudStackDnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := alidns.CreateDeleteDomainGroupRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	request.RegionId = client.RegionId
 	request.GroupId = d.Id()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return DiagnosticsFromError(resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithDnsClient(func(dnsClient *alidns.Client) (interface{}, error) {
 			return dnsClient.DeleteDomainGroup(request)
 		})
@@ -130,5 +113,5 @@ func resourceAlibabacloudStackDnsGroupDelete(d *schema.ResourceData, meta interf
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
-	})
+	}))
 }
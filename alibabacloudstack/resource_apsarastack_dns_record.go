@@ -1,16 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsRecordCreate,
-		Read:   resourceAlibabacloudStackDnsRecordRead,
-		Update: resourceAlibabacloudStackDnsRecordUpdate,
-		Delete: resourceAlibabacloudStackDnsRecordDelete,
+		CreateContext: resourceAlibabacloudStackDnsRecordCreate,
+		ReadContext:   resourceAlibabacloudStackDnsRecordRead,
+		UpdateContext: resourceAlibabacloudStackDnsRecordUpdate,
+		DeleteContext: resourceAlibabacloudStackDnsRecordDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -68,8 +68,8 @@ func resourceAlibabacloudStackDnsRecord() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//var requestInfo *ecs.Client
 	request := make(map[string]interface{})
 	ZoneId := d.Get("zone_id").(int)
@@ -100,13 +100,13 @@ func resourceAlibabacloudStackDnsRecordCreate(d *schema.ResourceData, meta inter
 	request["Name"] = Name
 	conn, err := client.NewCloudApiClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["ClientToken"] = buildClientToken("AddGlobalZoneRecord")
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2021-06-24"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -121,12 +121,12 @@ func resourceAlibabacloudStackDnsRecordCreate(d *schema.ResourceData, meta inter
 	addDebug("AddGlobalZoneRecord", response, request)
 	d.SetId(fmt.Sprint(ZoneId))
 
-	return resourceAlibabacloudStackDnsRecordRead(d, meta)
+	return resourceAlibabacloudStackDnsRecordRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	dnsService := &DnsService{client: client}
 	object, err := dnsService.DescribeDnsRecord(d.Id())
@@ -135,7 +135,7 @@ func resourceAlibabacloudStackDnsRecordRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("ttl", object.Data[0].TTL)
 	d.Set("record_id", object.Data[0].Id)
@@ -147,8 +147,8 @@ func resourceAlibabacloudStackDnsRecordRead(d *schema.ResourceData, meta interfa
 
 	return nil
 }
-func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dnsService := DnsService{client}
 	ID := d.Get("record_id").(int)
 	ZoneId := d.Get("zone_id").(int)
@@ -156,7 +156,7 @@ func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta inter
 	LbaStrategy := d.Get("lba_strategy").(string)
 	check, err := dnsService.DescribeDnsRecord(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRecordExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRecordExist", AlibabacloudStackSdkGoERROR))
 	}
 	attributeUpdate := false
 
@@ -167,37 +167,16 @@ func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta inter
 			desc = v.(string)
 		}
 		check.Data[0].Remark = desc
-		request := requests.NewCommonRequest()
-		request.Method = "POST"
-		request.Product = "CloudDns"
-		request.Domain = client.Domain
-		request.Version = "2021-06-24"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "UpdateGlobalZoneRecordRemark"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
-
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudDns",
-			"RegionId":        client.RegionId,
-			"Action":          "UpdateGlobalZoneRecordRemark",
-			"Version":         "2021-06-24",
-			"Id":              fmt.Sprint(ID),
-			"Remark":          desc,
-		}
+		request := client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "UpdateGlobalZoneRecordRemark", "")
+		request.QueryParams["Id"] = fmt.Sprint(ID)
+		request.QueryParams["Remark"] = desc
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		log.Printf(" response of raw UpdateGlobalZoneRecordRemark : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_record", "UpdateGlobalZoneRecordRemark", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dns_record", "UpdateGlobalZoneRecordRemark", raw))
 		}
 		addDebug(request.GetActionName(), raw, request)
 	} else {
@@ -273,14 +252,14 @@ func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta inter
 		request["Remark"] = check.Data[0].Remark
 		conn, err := client.NewCloudApiClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		var response map[string]interface{}
 		request["ClientToken"] = buildClientToken("UpdateGlobalZoneRecord")
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2021-06-24"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -296,35 +275,16 @@ func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta inter
 
 	}
 
-	return resourceAlibabacloudStackDnsRecordRead(d, meta)
+	return resourceAlibabacloudStackDnsRecordRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ID := d.Get("record_id").(int)
 	ZoneId := d.Get("zone_id").(int)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "CloudDns"
-	request.Domain = client.Domain
-	request.Version = "2021-06-24"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DeleteGlobalZoneRecord"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudDns",
-		"RegionId":        client.RegionId,
-		"Action":          "DeleteGlobalZoneRecord",
-		"Version":         "2021-06-24",
-		"Id":              fmt.Sprint(ID),
-		"ZoneId":          fmt.Sprint(ZoneId),
-	}
+	request := client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "DeleteGlobalZoneRecord", "")
+	request.QueryParams["Id"] = fmt.Sprint(ID)
+	request.QueryParams["ZoneId"] = fmt.Sprint(ZoneId)
 	raw, err := client.WithEcsClient(func(dnsClient *ecs.Client) (interface{}, error) {
 		return dnsClient.ProcessCommonRequest(request)
 	})
@@ -335,7 +295,7 @@ func resourceAlibabacloudStackDnsRecordDelete(d *schema.ResourceData, meta inter
 			return nil
 		}
 		if IsExpectedErrors(err, []string{"RecordForbidden.DNSChange", "InternalError"}) {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
 	return nil
@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/drds"
@@ -12,10 +14,10 @@ import (
 
 func resourceAlibabacloudStackDRDSInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDRDSInstanceCreate,
-		Read:   resourceAlibabacloudStackDRDSInstanceRead,
-		Update: resourceAlibabacloudStackDRDSInstanceUpdate,
-		Delete: resourceAlibabacloudStackDRDSInstanceDelete,
+		CreateContext: resourceAlibabacloudStackDRDSInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackDRDSInstanceRead,
+		UpdateContext: resourceAlibabacloudStackDRDSInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackDRDSInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -64,8 +66,8 @@ func resourceAlibabacloudStackDRDSInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDRDSInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDRDSInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	drdsService := DrdsService{client}
 
 	request := drds.CreateCreateDrdsInstanceRequest()
@@ -85,7 +87,7 @@ func resourceAlibabacloudStackDRDSInstanceCreate(d *schema.ResourceData, meta in
 		vpcService := VpcService{client}
 		vsw, err := vpcService.DescribeVSwitch(request.VswitchId)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		request.VpcId = vsw.VpcId
@@ -99,35 +101,35 @@ func resourceAlibabacloudStackDRDSInstanceCreate(d *schema.ResourceData, meta in
 		request.PayType = "drdsPre"
 	}
 	request.ResourceGroupId = client.ResourceGroup
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Headers["x-ascm-product-name"] = "Drds"
 	request.Headers["x-acs-organizationId"] = client.Department
 	raw, err := client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
 		return drdsClient.CreateDrdsInstance(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_drds_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_drds_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*drds.CreateDrdsInstanceResponse)
 	idList := response.Data.DrdsInstanceIdList.DrdsInstanceId
 	if len(idList) != 1 {
-		return WrapError(Error("failed to get DRDS instance id and response. DrdsInstanceIdList is %#v", idList))
+		return DiagnosticsFromError(WrapError(Error("failed to get DRDS instance id and response. DrdsInstanceIdList is %#v", idList)))
 	}
 	d.SetId(idList[0])
 
 	// wait instance status change from DO_CREATE to RUN
 	stateConf := BuildStateConf([]string{"DO_CREATE"}, []string{"RUN"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackDRDSInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDRDSInstanceUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackDRDSInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDRDSInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	drdsService := DrdsService{client}
 
 	configItem := make(map[string]string)
@@ -136,16 +138,16 @@ func resourceAlibabacloudStackDRDSInstanceUpdate(d *schema.ResourceData, meta in
 		request.DrdsInstanceId = d.Id()
 		request.Description = d.Get("description").(string)
 		configItem["description"] = request.Description
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 		request.RegionId = client.RegionId
 		request.Headers["x-ascm-product-name"] = "Drds"
 		request.Headers["x-acs-organizationId"] = client.Department
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
 			return drdsClient.ModifyDrdsInstanceDescription(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
@@ -153,18 +155,18 @@ func resourceAlibabacloudStackDRDSInstanceUpdate(d *schema.ResourceData, meta in
 	//wait for update effected and instance status returning to run
 	if err := drdsService.WaitDrdsInstanceConfigEffect(
 		d.Id(), configItem, d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	stateConf := BuildStateConf([]string{}, []string{"RUN"}, d.Timeout(schema.TimeoutUpdate), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackDRDSInstanceRead(d, meta)
+	return resourceAlibabacloudStackDRDSInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDRDSInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDRDSInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	drdsService := DrdsService{client}
 
 	object, err := drdsService.DescribeDrdsInstance(d.Id())
@@ -173,7 +175,7 @@ func resourceAlibabacloudStackDRDSInstanceRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	data := object.Data
 	//other attribute not set,because these attribute from `data` can't  get
@@ -183,8 +185,8 @@ func resourceAlibabacloudStackDRDSInstanceRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackDRDSInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDRDSInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	drdsService := DrdsService{client}
 	request := drds.CreateRemoveDrdsInstanceRequest()
 	request.RegionId = client.RegionId
@@ -199,19 +201,19 @@ func resourceAlibabacloudStackDRDSInstanceDelete(d *schema.ResourceData, meta in
 		if IsExpectedErrors(err, []string{"InvalidDrdsInstanceId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*drds.RemoveDrdsInstanceResponse)
 
 	if !response.Success {
-		return WrapError(Error("failed to delete instance timeout "+"and got an error: %#v", err))
+		return DiagnosticsFromError(WrapError(Error("failed to delete instance timeout "+"and got an error: %#v", err)))
 	}
 
 	//0 -> RUN, 1->DO_CREATE, 2->EXCEPTION, 3->EXPIRE, 4->DO_RELEASE, 5->RELEASE, 6->UPGRADE, 7->DOWNGRADE, 10->VersionUpgrade, 11->VersionRollback, 14->RESTART
 	stateConf := BuildStateConf([]string{"RUN", "DO_CREATE", "EXCEPTION", "EXPIRE", "DO_RELEASE", "RELEASE", "UPGRADE", "DOWNGRADE", "VersionUpgrade", "VersionRollback", "RESTART"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err = stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
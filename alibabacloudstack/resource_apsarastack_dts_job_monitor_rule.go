@@ -0,0 +1,165 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackDtsJobMonitorRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackDtsJobMonitorRuleCreate,
+		ReadContext:   resourceAlibabacloudStackDtsJobMonitorRuleRead,
+		UpdateContext: resourceAlibabacloudStackDtsJobMonitorRuleUpdate,
+		DeleteContext: resourceAlibabacloudStackDtsJobMonitorRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"dts_job_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"delay", "error"}, false),
+			},
+			"delay_rule_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Y",
+				ValidateFunc: validation.StringInSlice([]string{"Y", "N"}, false),
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackDtsJobMonitorRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%v%s%v", d.Get("dts_job_id"), COLON_SEPARATED, d.Get("type")))
+	if err := dtsJobMonitorRule(ctx, d, meta, d.Get("state").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackDtsJobMonitorRuleRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackDtsJobMonitorRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	dtsService := DtsService{client}
+	object, err := dtsService.DescribeDtsJobMonitorRuleByType(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.Set("dts_job_id", parts[0])
+	d.Set("type", parts[1])
+	if v, ok := object["DelayRuleTime"]; ok && v != nil {
+		d.Set("delay_rule_time", formatInt(v))
+	}
+	d.Set("phone", object["Phone"])
+	d.Set("state", object["State"])
+	return nil
+}
+
+func resourceAlibabacloudStackDtsJobMonitorRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("delay_rule_time", "phone", "state") {
+		if err := dtsJobMonitorRule(ctx, d, meta, d.Get("state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	return resourceAlibabacloudStackDtsJobMonitorRuleRead(ctx, d, meta)
+}
+
+// resourceAlibabacloudStackDtsJobMonitorRuleDelete disables the rule, as the monitor rules of a job can not be removed.
+func resourceAlibabacloudStackDtsJobMonitorRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := dtsJobMonitorRule(ctx, d, meta, "N", d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, []string{"Forbidden.InstanceNotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+// dtsJobMonitorRule creates or modifies the monitor rule, as CreateJobMonitorRule overwrites the rule of the same type.
+func dtsJobMonitorRule(ctx context.Context, d *schema.ResourceData, meta interface{}, state string, timeout time.Duration) error {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	var response map[string]interface{}
+	action := "CreateJobMonitorRule"
+	conn, err := client.NewDtsClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	request := map[string]interface{}{
+		"DtsJobId": parts[0],
+		"Type":     parts[1],
+		"State":    state,
+	}
+	if v, ok := d.GetOk("delay_rule_time"); ok {
+		request["DelayRuleTime"] = v
+	}
+	if v, ok := d.GetOk("phone"); ok {
+		request["Phone"] = v
+	}
+	request["RegionId"] = client.RegionId
+	request["product"] = "Dts"
+	request["OrganizationId"] = client.Department
+	request["ResourceId"] = client.ResourceGroup
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+	if fmt.Sprint(response["Success"]) == "false" {
+		return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+	}
+	return nil
+}
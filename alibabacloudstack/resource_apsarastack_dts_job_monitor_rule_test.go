@@ -0,0 +1,98 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackDtsJobMonitorRule_basic0(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_dts_job_monitor_rule.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeDtsJobMonitorRuleByType")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sdtsjobmonitorrule%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, testAccDtsJobMonitorRuleDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"dts_job_id":      "${alibabacloudstack_dts_synchronization_job.default.dts_job_id}",
+					"type":            "delay",
+					"delay_rule_time": "10",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"dts_job_id":      CHECKSET,
+						"type":            "delay",
+						"delay_rule_time": "10",
+						"state":           "Y",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"delay_rule_time": "20",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"delay_rule_time": "20",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"state": "N",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"state": "N",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDtsJobMonitorRuleDependence(name string) string {
+	return AlibabacloudStackDTSSynchronizationJobBasicDependence0(name) + `
+resource "alibabacloudstack_dts_synchronization_job" "default" {
+  dts_instance_id                    = alibabacloudstack_dts_synchronization_instance.default.id
+  dts_job_name                       = var.name
+  source_endpoint_instance_type      = "RDS"
+  source_endpoint_instance_id        = alibabacloudstack_db_instance.rsinstance.id
+  source_endpoint_engine_name        = "MySQL"
+  source_endpoint_database_name      = "tfaccountpri_0"
+  source_endpoint_user_name          = "tftestdts"
+  source_endpoint_password           = "inputYourCodeHere"
+  destination_endpoint_instance_type = "RDS"
+  destination_endpoint_instance_id   = alibabacloudstack_db_instance.dsinstance.id
+  destination_endpoint_engine_name   = "MySQL"
+  destination_endpoint_database_name = "tfaccountpri_0"
+  destination_endpoint_user_name     = "tftestdts"
+  destination_endpoint_password      = "inputYourCodeHere"
+  db_list                            = "{\"tfaccountpri_0\":{\"name\":\"tfaccountpri_0\",\"all\":true,\"state\":\"normal\"}}"
+  structure_initialization           = true
+  data_initialization                = true
+  data_synchronization               = true
+}
+`
+}
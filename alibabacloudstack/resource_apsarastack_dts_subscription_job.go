@@ -1,15 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,15 +18,17 @@ import (
 
 func resourceAlibabacloudStackDtsSubscriptionJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDtsSubscriptionJobCreate,
-		Read:   resourceAlibabacloudStackDtsSubscriptionJobRead,
-		Update: resourceAlibabacloudStackDtsSubscriptionJobUpdate,
-		Delete: resourceAlibabacloudStackDtsSubscriptionJobDelete,
+		CreateContext: resourceAlibabacloudStackDtsSubscriptionJobCreate,
+		ReadContext:   resourceAlibabacloudStackDtsSubscriptionJobRead,
+		UpdateContext: resourceAlibabacloudStackDtsSubscriptionJobUpdate,
+		DeleteContext: resourceAlibabacloudStackDtsSubscriptionJobDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"checkpoint": {
@@ -202,27 +205,13 @@ func resourceAlibabacloudStackDtsSubscriptionJob() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSubscriptionJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "CreateDtsInstance"
-	request := requests.NewCommonRequest()
-	request.ApiName = action
-	request.Version = "2020-01-01"
-	request.Method = "POST"
-	request.Product = "Dts"
-	request.RegionId = client.RegionId
-	request.Domain = client.Domain
-	request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	request.Headers["x-acs-regionid"] = client.RegionId
-	request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	request.Headers["x-acs-organizationid"] = client.Department
+	request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+	request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	request.Headers["x-acs-content-type"] = "application/json"
 	request.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
 	request.QueryParams["AutoPay"] = "false"
 	request.QueryParams["AutoStart"] = "true"
 	if v, ok := d.GetOk("compute_unit"); ok {
@@ -247,7 +236,6 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 		request.QueryParams["Period"] = v.(string)
 	}
 	request.QueryParams["RegionId"] = client.RegionId
-	request.QueryParams["product"] = "Dts"
 	request.QueryParams["OrganizationId"] = client.Department
 	if v, ok := d.GetOk("source_endpoint_engine_name"); ok {
 		request.QueryParams["SourceEndpointEngineName"] = v.(string)
@@ -273,7 +261,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 		dtsClient, err = sts.NewClientWithStsToken(client.Config.RegionId, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
 	}
 	dtsClient.Domain = client.Config.AscmEndpoint
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := dtsClient.ProcessCommonRequest(request)
 		addDebug(action, raw, request, request.QueryParams)
 		if err != nil {
@@ -290,39 +278,19 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR))
 	}
 	d.Set("dts_instance_id", response["InstanceId"])
-	configureSubscriptionReq := requests.NewCommonRequest()
-	configureSubscriptionReq.Version = "2020-01-01"
-	configureSubscriptionReq.Method = "POST"
-	configureSubscriptionReq.Product = "Dts"
-	configureSubscriptionReq.RegionId = client.RegionId
-	configureSubscriptionReq.Domain = client.Domain
-	configureSubscriptionReq.ApiName = "ConfigureSubscription"
-	configureSubscriptionReq.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	configureSubscriptionReq.Headers["x-acs-regionid"] = client.RegionId
-	configureSubscriptionReq.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	configureSubscriptionReq.Headers["x-acs-organizationid"] = client.Department
+	configureSubscriptionReq := client.NewCommonRequest("POST", "Dts", "2020-01-01", "ConfigureSubscription", "")
+	configureSubscriptionReq.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	configureSubscriptionReq.Headers["x-acs-content-type"] = "application/json"
 	configureSubscriptionReq.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		configureSubscriptionReq.Scheme = "https"
-	} else {
-		configureSubscriptionReq.Scheme = "http"
-	}
-	configureSubscriptionReq.QueryParams = map[string]string{
-		"RegionId":                        client.RegionId,
-		"Product":                         "Dts",
-		"Version":                         "2020-01-01",
-		"Action":                          "ConfigureSubscription",
-		"OrganizationId":                  client.Department,
-		"ResourceId":                      client.ResourceGroup,
-		"DbList":                          d.Get("db_list").(string),
-		"SubscriptionInstanceNetworkType": d.Get("subscription_instance_network_type").(string),
-		"DtsInstanceId":                   d.Get("dts_instance_id").(string),
-		"DtsJobName":                      d.Get("dts_job_name").(string),
-	}
+	configureSubscriptionReq.QueryParams["OrganizationId"] = client.Department
+	configureSubscriptionReq.QueryParams["ResourceId"] = client.ResourceGroup
+	configureSubscriptionReq.QueryParams["DbList"] = d.Get("db_list").(string)
+	configureSubscriptionReq.QueryParams["SubscriptionInstanceNetworkType"] = d.Get("subscription_instance_network_type").(string)
+	configureSubscriptionReq.QueryParams["DtsInstanceId"] = d.Get("dts_instance_id").(string)
+	configureSubscriptionReq.QueryParams["DtsJobName"] = d.Get("dts_job_name").(string)
 	if v, ok := d.GetOk("checkpoint"); ok {
 		configureSubscriptionReq.QueryParams["Checkpoint"] = v.(string)
 	}
@@ -395,7 +363,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 		configureSubscriptionReq.QueryParams["Reserve"] = v.(string)
 	}
 	configureSubscriptionRsp := make(map[string]interface{})
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := dtsClient.ProcessCommonRequest(configureSubscriptionReq)
 		addDebug(action, raw, configureSubscriptionReq, configureSubscriptionReq.QueryParams)
 		if err != nil {
@@ -412,17 +380,17 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR))
 	}
 	if fmt.Sprint(configureSubscriptionRsp["Success"]) == "false" {
-		return WrapError(fmt.Errorf("%s failed, response: %v", "ConfigureSubscription", configureSubscriptionRsp))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", "ConfigureSubscription", configureSubscriptionRsp)))
 	}
 	fmt.Printf("==================================  %s", fmt.Sprint(configureSubscriptionRsp["DtsJobId"]))
 	d.SetId(fmt.Sprint(configureSubscriptionRsp["DtsJobId"]))
-	return resourceAlibabacloudStackDtsSubscriptionJobUpdate(d, meta)
+	return resourceAlibabacloudStackDtsSubscriptionJobUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSubscriptionJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dtsService := DtsService{client}
 	object, err := dtsService.DescribeDtsSubscriptionJob(d.Id())
 	if err != nil {
@@ -431,7 +399,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("checkpoint", fmt.Sprint(formatInt(object["Checkpoint"])))
 	d.Set("db_list", object["DbObject"])
@@ -462,19 +430,19 @@ func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, met
 	d.Set("subscription_instance_vswitch_id", jsonData["vswitchId"])
 	listTagResourcesObject, err := dtsService.ListTagResources(object["DtsInstanceID"].(string), "ALIYUN::DTS::INSTANCE")
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("tags", tagsToMap(listTagResourcesObject))
 
 	return nil
 }
-func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSubscriptionJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dtsService := DtsService{client}
 	d.Partial(true)
 	if d.HasChange("tags") {
 		if err := dtsService.SetResourceTags(d, "ALIYUN::DTS::INSTANCE"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		//d.SetPartial("tags")
 	}
@@ -487,34 +455,16 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 	}
 	dtsClient.Domain = client.Config.AscmEndpoint
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	update := false
-	request := requests.NewCommonRequest()
-	request.Version = "2020-01-01"
-	request.Method = "POST"
-	request.Product = "Dts"
-	request.RegionId = client.RegionId
-	request.Domain = client.Domain
-	request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	request.Headers["x-acs-regionid"] = client.RegionId
-	request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	request.Headers["x-acs-organizationid"] = client.Department
+	request := client.NewCommonRequest("POST", "Dts", "2020-01-01", "", "")
+	request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	request.Headers["x-acs-content-type"] = "application/json"
 	request.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.QueryParams = map[string]string{
-		"DtsJobId":       d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Dts",
-		"Version":        "2020-01-01",
-		"OrganizationId": client.Department,
-		"ResourceId":     client.ResourceGroup,
-	}
+	request.QueryParams["DtsJobId"] = d.Id()
+	request.QueryParams["OrganizationId"] = client.Department
+	request.QueryParams["ResourceId"] = client.ResourceGroup
 	if d.HasChange("dts_job_name") {
 		update = true
 		if v, ok := d.GetOk("dts_job_name"); ok {
@@ -528,7 +478,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		request.ApiName = action
 		request.QueryParams["Action"] = action
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := dtsClient.ProcessCommonRequest(request)
 			addDebug(action, raw, request, request.QueryParams)
 			if err != nil {
@@ -545,39 +495,21 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR))
 		}
 		if fmt.Sprint(response["Success"]) == "false" {
-			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 		}
 		//d.SetPartial("dts_job_name")
 	}
 	update = false
-	modifyDtsJobPasswordReq := requests.NewCommonRequest()
-	modifyDtsJobPasswordReq.Version = "2020-01-01"
-	modifyDtsJobPasswordReq.Method = "POST"
-	modifyDtsJobPasswordReq.Product = "Dts"
-	modifyDtsJobPasswordReq.RegionId = client.RegionId
-	modifyDtsJobPasswordReq.Domain = client.Domain
-	modifyDtsJobPasswordReq.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	modifyDtsJobPasswordReq.Headers["x-acs-regionid"] = client.RegionId
-	modifyDtsJobPasswordReq.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	modifyDtsJobPasswordReq.Headers["x-acs-organizationid"] = client.Department
+	modifyDtsJobPasswordReq := client.NewCommonRequest("POST", "Dts", "2020-01-01", "", "")
+	modifyDtsJobPasswordReq.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	modifyDtsJobPasswordReq.Headers["x-acs-content-type"] = "application/json"
 	modifyDtsJobPasswordReq.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		modifyDtsJobPasswordReq.Scheme = "https"
-	} else {
-		modifyDtsJobPasswordReq.Scheme = "http"
-	}
-	modifyDtsJobPasswordReq.QueryParams = map[string]string{
-		"DtsJobId":       d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Dts",
-		"Version":        "2020-01-01",
-		"OrganizationId": client.Department,
-		"ResourceId":     client.ResourceGroup,
-	}
+	modifyDtsJobPasswordReq.QueryParams["DtsJobId"] = d.Id()
+	modifyDtsJobPasswordReq.QueryParams["OrganizationId"] = client.Department
+	modifyDtsJobPasswordReq.QueryParams["ResourceId"] = client.ResourceGroup
 	modifyDtsJobPasswordReq.QueryParams["Endpoint"] = "src"
 	if !d.IsNewResource() && d.HasChange("source_endpoint_password") {
 		update = true
@@ -594,7 +526,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		modifyDtsJobPasswordReq.ApiName = action
 		modifyDtsJobPasswordReq.QueryParams["Action"] = action
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := dtsClient.ProcessCommonRequest(modifyDtsJobPasswordReq)
 			addDebug(action, raw, modifyDtsJobPasswordReq, modifyDtsJobPasswordReq.QueryParams)
 			if err != nil {
@@ -611,52 +543,34 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR))
 		}
 		if fmt.Sprint(response["Success"]) == "false" {
-			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 		}
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
-			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
+			return DiagnosticsFromError(WrapError(Error(FailedToReachTargetStatus, d.Get("status"))))
 		}
 	}
 
 	if !d.IsNewResource() && d.HasChange("status") {
 		target := d.Get("status").(string)
-		err := resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err := resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
-			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
+			return DiagnosticsFromError(WrapError(Error(FailedToReachTargetStatus, d.Get("status"))))
 		}
 	}
 
 	update = false
-	configureSubscriptionReq := requests.NewCommonRequest()
-	configureSubscriptionReq.Version = "2020-01-01"
-	configureSubscriptionReq.Method = "POST"
-	configureSubscriptionReq.Product = "Dts"
-	configureSubscriptionReq.RegionId = client.RegionId
-	configureSubscriptionReq.Domain = client.Domain
-	configureSubscriptionReq.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	configureSubscriptionReq.Headers["x-acs-regionid"] = client.RegionId
-	configureSubscriptionReq.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	configureSubscriptionReq.Headers["x-acs-organizationid"] = client.Department
+	configureSubscriptionReq := client.NewCommonRequest("POST", "Dts", "2020-01-01", "", "")
+	configureSubscriptionReq.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	configureSubscriptionReq.Headers["x-acs-content-type"] = "application/json"
 	configureSubscriptionReq.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		configureSubscriptionReq.Scheme = "https"
-	} else {
-		configureSubscriptionReq.Scheme = "http"
-	}
-	configureSubscriptionReq.QueryParams = map[string]string{
-		"DtsJobId":       d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Dts",
-		"Version":        "2020-01-01",
-		"OrganizationId": client.Department,
-		"ResourceId":     client.ResourceGroup,
-	}
+	configureSubscriptionReq.QueryParams["DtsJobId"] = d.Id()
+	configureSubscriptionReq.QueryParams["OrganizationId"] = client.Department
+	configureSubscriptionReq.QueryParams["ResourceId"] = client.ResourceGroup
 	if d.IsNewResource() {
 		update = true
 	}
@@ -798,7 +712,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		configureSubscriptionReq.ApiName = action
 		configureSubscriptionReq.QueryParams["Action"] = action
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := dtsClient.ProcessCommonRequest(configureSubscriptionReq)
 			addDebug(action, raw, configureSubscriptionReq, configureSubscriptionReq.QueryParams)
 			if err != nil {
@@ -815,10 +729,10 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR))
 		}
 		if fmt.Sprint(response["Success"]) == "false" {
-			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 		}
 		//d.SetPartial("db_list")
 		//d.SetPartial("dts_job_name")
@@ -842,22 +756,22 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		//d.SetPartial("subscription_instance_vswitch_id")
 
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
-			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
+			return DiagnosticsFromError(WrapError(Error(FailedToReachTargetStatus, d.Get("status"))))
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackDtsSubscriptionJobRead(d, meta)
+	return resourceAlibabacloudStackDtsSubscriptionJobRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if v, ok := d.GetOk("payment_type"); ok {
 		if v.(string) == "Subscription" {
 			return nil
 		}
 	}
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteDtsJob"
 	var err error
 	var dtsClient *sts.Client
@@ -868,35 +782,15 @@ func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, m
 	}
 	dtsClient.Domain = client.Config.AscmEndpoint
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	request := requests.NewCommonRequest()
-	request.Version = "2020-01-01"
-	request.Method = "POST"
-	request.Product = "Dts"
-	request.RegionId = client.RegionId
-	request.Domain = client.Domain
-	request.ApiName = action
-	request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	request.Headers["x-acs-regionid"] = client.RegionId
-	request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	request.Headers["x-acs-organizationid"] = client.Department
+	request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+	request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	request.Headers["x-acs-content-type"] = "application/json"
 	request.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.QueryParams = map[string]string{
-		"DtsJobId":       d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Dts",
-		"Version":        "2020-01-01",
-		"Action":         action,
-		"OrganizationId": client.Department,
-		"ResourceId":     client.ResourceGroup,
-	}
+	request.QueryParams["DtsJobId"] = d.Id()
+	request.QueryParams["OrganizationId"] = client.Department
+	request.QueryParams["ResourceId"] = client.ResourceGroup
 
 	if v, ok := d.GetOk("dts_instance_id"); ok {
 		request.QueryParams["DtsInstanceId"] = v.(string)
@@ -906,7 +800,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, m
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
 	response := make(map[string]interface{})
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := dtsClient.ProcessCommonRequest(request)
 		addDebug(action, raw, request, request.QueryParams)
 		if err != nil {
@@ -923,14 +817,14 @@ func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR))
 	}
 	if fmt.Sprint(response["Success"]) == "false" {
-		return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 	}
 	return nil
 }
-func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceData, meta interface{}, target string) error {
+func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx context.Context, d *schema.ResourceData, meta interface{}, target string) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dtsService := DtsService{client}
 	object, err := dtsService.DescribeDtsSubscriptionJob(d.Id())
@@ -951,39 +845,19 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 		}
 		if target == "NotConfigured" {
 			action := "ResetDtsJob"
-			request := requests.NewCommonRequest()
-			request.Version = "2020-01-01"
-			request.Method = "POST"
-			request.Product = "Dts"
-			request.RegionId = client.RegionId
-			request.Domain = client.Domain
-			request.ApiName = action
-			request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-			request.Headers["x-acs-regionid"] = client.RegionId
-			request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-			request.Headers["x-acs-organizationid"] = client.Department
+			request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+			request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 			request.Headers["x-acs-content-type"] = "application/json"
 			request.Headers["Content-type"] = "application/json"
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.QueryParams = map[string]string{
-				"DtsJobId":       d.Id(),
-				"RegionId":       client.RegionId,
-				"Product":        "Dts",
-				"Version":        "2020-01-01",
-				"Action":         action,
-				"OrganizationId": client.Department,
-				"ResourceId":     client.ResourceGroup,
-			}
+			request.QueryParams["DtsJobId"] = d.Id()
+			request.QueryParams["OrganizationId"] = client.Department
+			request.QueryParams["ResourceId"] = client.ResourceGroup
 			if v, ok := d.GetOk("synchronization_direction"); ok {
 				request.QueryParams["SynchronizationDirection"] = v.(string)
 			}
 			wait := incrementalWait(3*time.Second, 3*time.Second)
 			response := make(map[string]interface{})
-			err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := dtsClient.ProcessCommonRequest(request)
 				addDebug(action, raw, request, request.QueryParams)
 				if err != nil {
@@ -1006,45 +880,25 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 			}
 			stateConf := BuildStateConf([]string{}, []string{"NotConfigured"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
 		if target == "Normal" || (target == "Abnormal" && object["Status"].(string) == "NotStarted") {
 			action := "StartDtsJob"
-			request := requests.NewCommonRequest()
-			request.Version = "2020-01-01"
-			request.Method = "POST"
-			request.Product = "Dts"
-			request.RegionId = client.RegionId
-			request.Domain = client.Domain
-			request.ApiName = action
-			request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-			request.Headers["x-acs-regionid"] = client.RegionId
-			request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-			request.Headers["x-acs-organizationid"] = client.Department
+			request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+			request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 			request.Headers["x-acs-content-type"] = "application/json"
 			request.Headers["Content-type"] = "application/json"
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.QueryParams = map[string]string{
-				"DtsJobId":       d.Id(),
-				"RegionId":       client.RegionId,
-				"Product":        "Dts",
-				"Version":        "2020-01-01",
-				"Action":         action,
-				"OrganizationId": client.Department,
-				"ResourceId":     client.ResourceGroup,
-			}
+			request.QueryParams["DtsJobId"] = d.Id()
+			request.QueryParams["OrganizationId"] = client.Department
+			request.QueryParams["ResourceId"] = client.ResourceGroup
 			if v, ok := d.GetOk("synchronization_direction"); ok {
 				request.QueryParams["SynchronizationDirection"] = v.(string)
 			}
 			wait := incrementalWait(3*time.Second, 3*time.Second)
 			response := make(map[string]interface{})
-			err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := dtsClient.ProcessCommonRequest(request)
 				addDebug(action, raw, request, request.QueryParams)
 				if err != nil {
@@ -1067,45 +921,25 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Starting", "Normal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
 		if target == "Abnormal" {
 			action := "SuspendDtsJob"
-			request := requests.NewCommonRequest()
-			request.Version = "2020-01-01"
-			request.Method = "POST"
-			request.Product = "Dts"
-			request.RegionId = client.RegionId
-			request.Domain = client.Domain
-			request.ApiName = action
-			request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-			request.Headers["x-acs-regionid"] = client.RegionId
-			request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-			request.Headers["x-acs-organizationid"] = client.Department
+			request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+			request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 			request.Headers["x-acs-content-type"] = "application/json"
 			request.Headers["Content-type"] = "application/json"
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.QueryParams = map[string]string{
-				"DtsJobId":       d.Id(),
-				"RegionId":       client.RegionId,
-				"Product":        "Dts",
-				"Version":        "2020-01-01",
-				"Action":         action,
-				"OrganizationId": client.Department,
-				"ResourceId":     client.ResourceGroup,
-			}
+			request.QueryParams["DtsJobId"] = d.Id()
+			request.QueryParams["OrganizationId"] = client.Department
+			request.QueryParams["ResourceId"] = client.ResourceGroup
 			if v, ok := d.GetOk("synchronization_direction"); ok {
 				request.QueryParams["SynchronizationDirection"] = v.(string)
 			}
 			wait := incrementalWait(3*time.Second, 3*time.Second)
 			response := make(map[string]interface{})
-			err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := dtsClient.ProcessCommonRequest(request)
 				addDebug(action, raw, request, request.QueryParams)
 				if err != nil {
@@ -1128,7 +962,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Abnormal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
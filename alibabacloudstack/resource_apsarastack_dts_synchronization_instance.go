@@ -1,18 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,10 +20,10 @@ import (
 
 func resourceAlibabacloudStackDtsSynchronizationInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDtsSynchronizationInstanceCreate,
-		Read:   resourceAlibabacloudStackDtsSynchronizationInstanceRead,
-		Update: resourceAlibabacloudStackDtsSynchronizationInstanceUpdate,
-		Delete: resourceAlibabacloudStackDtsSynchronizationInstanceDelete,
+		CreateContext: resourceAlibabacloudStackDtsSynchronizationInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackDtsSynchronizationInstanceRead,
+		UpdateContext: resourceAlibabacloudStackDtsSynchronizationInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackDtsSynchronizationInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -101,40 +101,21 @@ func resourceAlibabacloudStackDtsSynchronizationInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateDtsInstance"
-	request := requests.NewCommonRequest()
-	request.ApiName = action
-	request.Version = "2020-01-01"
-	request.Method = "POST"
-	request.Product = "Dts"
-	request.RegionId = client.RegionId
-	request.Domain = client.Domain
-	request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	request.Headers["x-acs-regionid"] = client.RegionId
-	request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	request.Headers["x-acs-organizationid"] = client.Department
+	request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+	request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	request.Headers["x-acs-content-type"] = "application/json"
 	request.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.QueryParams = map[string]string{
-		"Product":        "Dts",
-		"Version":        "2020-01-01",
-		"Action":         "CreateDtsInstance",
-		"AutoPay":        "false",
-		"AutoStart":      "true",
-		"InstanceClass":  "small",
-		"RegionId":       client.RegionId,
-		"product":        "Dts",
-		"OrganizationId": client.Department,
-		"Type":           "SYNC",
-	}
+	request.QueryParams["Action"] = "CreateDtsInstance"
+	request.QueryParams["AutoPay"] = "false"
+	request.QueryParams["AutoStart"] = "true"
+	request.QueryParams["InstanceClass"] = "small"
+	request.QueryParams["product"] = "Dts"
+	request.QueryParams["OrganizationId"] = client.Department
+	request.QueryParams["Type"] = "SYNC"
 	if v, ok := d.GetOk("compute_unit"); ok {
 		request.QueryParams["ComputeUnit"] = v.(string)
 	}
@@ -182,7 +163,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.Resourc
 		dtsClient, err = sts.NewClientWithStsToken(client.Config.RegionId, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
 	}
 	dtsClient.Domain = client.Config.AscmEndpoint
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := dtsClient.ProcessCommonRequest(request)
 		addDebug(action, raw, request, request.QueryParams)
 		if err != nil {
@@ -199,14 +180,14 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_instance", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_instance", action, AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(fmt.Sprint(response["InstanceId"]))
 
-	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dtsService := DtsService{client}
 	object, err := dtsService.DescribeDtsSynchronizationInstance(d.Id())
 	if err != nil {
@@ -215,32 +196,31 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceRead(d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("instance_class", object["SynchronizationJobClass"])
 	d.Set("payment_type", convertDtsSyncPaymentTypeResponse(object["PayType"]))
 	d.Set("dts_job_id", object["SynchronizationJobId"])
 	return nil
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Println(fmt.Sprintf("[WARNING] The resouce has not update operation."))
-	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if v, ok := d.GetOk("payment_type"); ok {
 		if v.(string) == "Subscription" {
-			log.Printf("[WARN] Cannot destroy resource: alibabacloudstack_dts_synchronization_job because it's s. Terraform will remove this resource from the state file, however resources may remain.")
-			return nil
+			return diag.Diagnostics{AttributeWarning("payment_type", "Cannot destroy the Subscription resource alibabacloudstack_dts_synchronization_instance %s. Terraform will remove this resource from the state file, however resources may remain.", d.Id())}
 		}
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteSynchronizationJob"
 	var response map[string]interface{}
 	conn, err := client.NewDtsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"SynchronizationJobId": d.Id(),
@@ -250,7 +230,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(d *schema.Resourc
 	request["product"] = "Dts"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -266,7 +246,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(d *schema.Resourc
 		if IsExpectedErrors(err, []string{"InvalidJobId"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,17 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,15 +19,17 @@ import (
 
 func resourceAlibabacloudStackDtsSynchronizationJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDtsSynchronizationJobCreate,
-		Read:   resourceAlibabacloudStackDtsSynchronizationJobRead,
-		Update: resourceAlibabacloudStackDtsSynchronizationJobUpdate,
-		Delete: resourceAlibabacloudStackDtsSynchronizationJobDelete,
+		CreateContext: resourceAlibabacloudStackDtsSynchronizationJobCreate,
+		ReadContext:   resourceAlibabacloudStackDtsSynchronizationJobRead,
+		UpdateContext: resourceAlibabacloudStackDtsSynchronizationJobUpdate,
+		DeleteContext: resourceAlibabacloudStackDtsSynchronizationJobDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"dts_instance_id": {
@@ -236,44 +238,25 @@ func resourceAlibabacloudStackDtsSynchronizationJob() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDtsSynchronizationJobCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSynchronizationJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "ConfigureDtsJob"
-	request := requests.NewCommonRequest()
-	request.ApiName = action
-	request.Version = "2020-01-01"
-	request.Method = "POST"
-	request.Product = "Dts"
-	request.RegionId = client.RegionId
-	request.Domain = client.Domain
-	request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	request.Headers["x-acs-regionid"] = client.RegionId
-	request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	request.Headers["x-acs-organizationid"] = client.Department
+	request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+	request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	request.Headers["x-acs-content-type"] = "application/json"
 	request.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":                        client.RegionId,
-		"Product":                         "Dts",
-		"Version":                         "2020-01-01",
-		"Action":                          "ConfigureDtsJob",
-		"OrganizationId":                  client.Department,
-		"DbList":                          d.Get("db_list").(string),
-		"DtsJobName":                      d.Get("dts_job_name").(string),
-		"DataInitialization":              fmt.Sprintf("%t", d.Get("data_initialization").(bool)),
-		"DataSynchronization":             fmt.Sprintf("%t", d.Get("data_synchronization").(bool)),
-		"StructureInitialization":         fmt.Sprintf("%t", d.Get("structure_initialization").(bool)),
-		"SynchronizationDirection":        d.Get("synchronization_direction").(string),
-		"DestinationEndpointInstanceType": d.Get("destination_endpoint_instance_type").(string),
-		"SourceEndpointInstanceType":      d.Get("source_endpoint_instance_type").(string),
-		"JobType":                         "SYNC",
-	}
+	request.QueryParams["Action"] = "ConfigureDtsJob"
+	request.QueryParams["OrganizationId"] = client.Department
+	request.QueryParams["DbList"] = d.Get("db_list").(string)
+	request.QueryParams["DtsJobName"] = d.Get("dts_job_name").(string)
+	request.QueryParams["DataInitialization"] = fmt.Sprintf("%t", d.Get("data_initialization").(bool))
+	request.QueryParams["DataSynchronization"] = fmt.Sprintf("%t", d.Get("data_synchronization").(bool))
+	request.QueryParams["StructureInitialization"] = fmt.Sprintf("%t", d.Get("structure_initialization").(bool))
+	request.QueryParams["SynchronizationDirection"] = d.Get("synchronization_direction").(string)
+	request.QueryParams["DestinationEndpointInstanceType"] = d.Get("destination_endpoint_instance_type").(string)
+	request.QueryParams["SourceEndpointInstanceType"] = d.Get("source_endpoint_instance_type").(string)
+	request.QueryParams["JobType"] = "SYNC"
 	if v, ok := d.GetOk("dts_job_id"); ok {
 		request.QueryParams["DtsJobId"] = v.(string)
 	}
@@ -375,7 +358,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobCreate(d *schema.ResourceData
 		dtsClient, err = sts.NewClientWithStsToken(client.Config.RegionId, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
 	}
 	dtsClient.Domain = client.Config.AscmEndpoint
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := dtsClient.ProcessCommonRequest(request)
 		addDebug(action, raw, request, request.QueryParams)
 		if err != nil {
@@ -392,21 +375,21 @@ func resourceAlibabacloudStackDtsSynchronizationJobCreate(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["DtsJobId"]))
 	d.Set("dts_instance_id", response["DtsInstanceId"])
 	dtsService := DtsService{client}
-	stateConf := BuildStateConf([]string{}, []string{"Synchronizing"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, dtsService.DtsSynchronizationJobStateRefreshFunc(d.Id(), []string{"InitializeFailed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	stateConf := BuildStateConf([]string{}, []string{"Synchronizing"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, dtsService.DtsSynchronizationJobStateRefreshFunc(d.Id(), []string{"InitializeFailed"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackDtsSynchronizationJobUpdate(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationJobUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSynchronizationJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	dtsService := DtsService{client}
 	object, err := dtsService.DescribeDtsSynchronizationJob(d.Id())
 	if err != nil {
@@ -415,7 +398,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobRead(d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	migrationModeObj := object["MigrationMode"].(map[string]interface{})
 	destinationEndpointObj := object["DestinationEndpoint"].(map[string]interface{})
@@ -452,8 +435,8 @@ func resourceAlibabacloudStackDtsSynchronizationJobRead(d *schema.ResourceData,
 
 	return nil
 }
-func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSynchronizationJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	d.Partial(true)
 	var err error
 	var dtsClient *sts.Client
@@ -464,31 +447,14 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 	}
 	dtsClient.Domain = client.Config.AscmEndpoint
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	request := requests.NewCommonRequest()
-	request.Version = "2020-01-01"
-	request.Method = "POST"
-	request.Product = "Dts"
-	request.RegionId = client.RegionId
-	request.Domain = client.Domain
-	request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	request.Headers["x-acs-regionid"] = client.RegionId
-	request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	request.Headers["x-acs-organizationid"] = client.Department
+	request := client.NewCommonRequest("POST", "Dts", "2020-01-01", "", "")
+	request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	request.Headers["x-acs-content-type"] = "application/json"
 	request.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":       client.RegionId,
-		"product":        "Dts",
-		"OrganizationId": client.Department,
-		"DtsJobId":       d.Id(),
-	}
+	request.QueryParams["OrganizationId"] = client.Department
+	request.QueryParams["DtsJobId"] = d.Id()
 	update := false
 	if !d.IsNewResource() && d.HasChange("dts_job_name") {
 		update = true
@@ -501,7 +467,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 		request.ApiName = action
 		request.QueryParams["Action"] = action
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := dtsClient.ProcessCommonRequest(request)
 			addDebug(action, raw, request, request.QueryParams)
 			if err != nil {
@@ -518,38 +484,20 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR))
 		}
 		if fmt.Sprint(response["Success"]) == "false" {
-			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 		}
 		// d.SetPartial("dts_job_name")
 	}
-	modifyDtsJobPasswordReq := requests.NewCommonRequest()
-	modifyDtsJobPasswordReq.Version = "2020-01-01"
-	modifyDtsJobPasswordReq.Method = "POST"
-	modifyDtsJobPasswordReq.Product = "Dts"
-	modifyDtsJobPasswordReq.RegionId = client.RegionId
-	modifyDtsJobPasswordReq.Domain = client.Domain
-	modifyDtsJobPasswordReq.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	modifyDtsJobPasswordReq.Headers["x-acs-regionid"] = client.RegionId
-	modifyDtsJobPasswordReq.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	modifyDtsJobPasswordReq.Headers["x-acs-organizationid"] = client.Department
+	modifyDtsJobPasswordReq := client.NewCommonRequest("POST", "Dts", "2020-01-01", "", "")
+	modifyDtsJobPasswordReq.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	modifyDtsJobPasswordReq.Headers["x-acs-content-type"] = "application/json"
 	modifyDtsJobPasswordReq.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		modifyDtsJobPasswordReq.Scheme = "https"
-	} else {
-		modifyDtsJobPasswordReq.Scheme = "http"
-	}
-	modifyDtsJobPasswordReq.QueryParams = map[string]string{
-		"DtsJobId":       d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Dts",
-		"Version":        "2020-01-01",
-		"OrganizationId": client.Department,
-		"ResourceId":     client.ResourceGroup,
-	}
+	modifyDtsJobPasswordReq.QueryParams["DtsJobId"] = d.Id()
+	modifyDtsJobPasswordReq.QueryParams["OrganizationId"] = client.Department
+	modifyDtsJobPasswordReq.QueryParams["ResourceId"] = client.ResourceGroup
 	if !d.IsNewResource() && d.HasChange("source_endpoint_password") {
 
 		modifyDtsJobPasswordReq.QueryParams["Endpoint"] = "src"
@@ -566,7 +514,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 		modifyDtsJobPasswordReq.ApiName = action
 		modifyDtsJobPasswordReq.QueryParams["Action"] = action
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := dtsClient.ProcessCommonRequest(modifyDtsJobPasswordReq)
 			addDebug(action, raw, modifyDtsJobPasswordReq, modifyDtsJobPasswordReq.QueryParams)
 			if err != nil {
@@ -583,18 +531,18 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR))
 		}
 		if fmt.Sprint(response["Success"]) == "false" {
-			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 		}
 		// d.SetPartial("source_endpoint_password")
 		// d.SetPartial("source_endpoint_user_name")
 
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
-			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
+			return DiagnosticsFromError(WrapError(Error(FailedToReachTargetStatus, d.Get("status"))))
 		}
 	}
 
@@ -614,7 +562,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 		modifyDtsJobPasswordReq.ApiName = action
 		modifyDtsJobPasswordReq.QueryParams["Action"] = action
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := dtsClient.ProcessCommonRequest(modifyDtsJobPasswordReq)
 			addDebug(action, raw, modifyDtsJobPasswordReq, modifyDtsJobPasswordReq.QueryParams)
 			if err != nil {
@@ -631,48 +579,30 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR))
 		}
 		if fmt.Sprint(response["Success"]) == "false" {
-			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 		}
 		// d.SetPartial("source_endpoint_password")
 		// d.SetPartial("source_endpoint_user_name")
 
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
-			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
+			return DiagnosticsFromError(WrapError(Error(FailedToReachTargetStatus, d.Get("status"))))
 		}
 	}
 
 	update = false
-	transferInstanceClassReq := requests.NewCommonRequest()
-	transferInstanceClassReq.Version = "2020-01-01"
-	transferInstanceClassReq.Method = "POST"
-	transferInstanceClassReq.Product = "Dts"
-	transferInstanceClassReq.RegionId = client.RegionId
-	transferInstanceClassReq.Domain = client.Domain
-	transferInstanceClassReq.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-	transferInstanceClassReq.Headers["x-acs-regionid"] = client.RegionId
-	transferInstanceClassReq.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-	transferInstanceClassReq.Headers["x-acs-organizationid"] = client.Department
+	transferInstanceClassReq := client.NewCommonRequest("POST", "Dts", "2020-01-01", "", "")
+	transferInstanceClassReq.Headers["x-acs-caller-sdk-source"] = "Terraform"
 	transferInstanceClassReq.Headers["x-acs-content-type"] = "application/json"
 	transferInstanceClassReq.Headers["Content-type"] = "application/json"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		transferInstanceClassReq.Scheme = "https"
-	} else {
-		transferInstanceClassReq.Scheme = "http"
-	}
-	transferInstanceClassReq.QueryParams = map[string]string{
-		"DtsJobId":       d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Dts",
-		"Version":        "2020-01-01",
-		"OrganizationId": client.Department,
-		"ResourceId":     client.ResourceGroup,
-		"OrderType":      "UPGRADE",
-	}
+	transferInstanceClassReq.QueryParams["DtsJobId"] = d.Id()
+	transferInstanceClassReq.QueryParams["OrganizationId"] = client.Department
+	transferInstanceClassReq.QueryParams["ResourceId"] = client.ResourceGroup
+	transferInstanceClassReq.QueryParams["OrderType"] = "UPGRADE"
 	if !d.IsNewResource() && d.HasChange("instance_class") {
 		if v, ok := d.GetOk("instance_class"); ok {
 			transferInstanceClassReq.QueryParams["InstanceClass"] = v.(string)
@@ -686,7 +616,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 		response := make(map[string]interface{})
 		transferInstanceClassReq.QueryParams["Action"] = action
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := dtsClient.ProcessCommonRequest(transferInstanceClassReq)
 			addDebug(action, raw, transferInstanceClassReq, transferInstanceClassReq.QueryParams)
 			if err != nil {
@@ -703,31 +633,31 @@ func resourceAlibabacloudStackDtsSynchronizationJobUpdate(d *schema.ResourceData
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_job", action, AlibabacloudStackSdkGoERROR))
 		}
 		if fmt.Sprint(response["Success"]) == "false" {
-			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("%s failed, response: %v", action, response)))
 		}
 	}
 
 	if !d.IsNewResource() && d.HasChange("status") {
 		target := d.Get("status").(string)
-		err := resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(d, meta, target)
+		err := resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
-			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
+			return DiagnosticsFromError(WrapError(Error(FailedToReachTargetStatus, d.Get("status"))))
 		}
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDtsSynchronizationJobRead(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationJobRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationJobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackDtsSynchronizationJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "ResetDtsJob"
 	var response map[string]interface{}
 	conn, err := client.NewDtsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"DtsJobId": d.Id(),
@@ -740,7 +670,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobDelete(d *schema.ResourceData
 	request["product"] = "Dts"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -756,12 +686,12 @@ func resourceAlibabacloudStackDtsSynchronizationJobDelete(d *schema.ResourceData
 		if IsExpectedErrors(err, []string{"Forbidden.InstanceNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(d *schema.ResourceData, meta interface{}, target string) error {
+func resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(ctx context.Context, d *schema.ResourceData, meta interface{}, target string) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dtsService := DtsService{client}
@@ -783,36 +713,16 @@ func resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(d *schema.Resource
 		}
 		if target == "Synchronizing" || target == "Suspending" {
 			action := "StartDtsJob"
-			request := requests.NewCommonRequest()
-			request.Version = "2020-01-01"
-			request.Method = "POST"
-			request.Product = "Dts"
-			request.RegionId = client.RegionId
-			request.Domain = client.Domain
-			request.ApiName = action
-			request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-			request.Headers["x-acs-regionid"] = client.RegionId
-			request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-			request.Headers["x-acs-organizationid"] = client.Department
+			request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+			request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 			request.Headers["x-acs-content-type"] = "application/json"
 			request.Headers["Content-type"] = "application/json"
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.QueryParams = map[string]string{
-				"DtsJobId":       d.Id(),
-				"RegionId":       client.RegionId,
-				"Product":        "Dts",
-				"Version":        "2020-01-01",
-				"Action":         action,
-				"OrganizationId": client.Department,
-				"ResourceId":     client.ResourceGroup,
-			}
+			request.QueryParams["DtsJobId"] = d.Id()
+			request.QueryParams["OrganizationId"] = client.Department
+			request.QueryParams["ResourceId"] = client.ResourceGroup
 			wait := incrementalWait(3*time.Second, 3*time.Second)
 			response := make(map[string]interface{})
-			err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := dtsClient.ProcessCommonRequest(request)
 				addDebug(action, raw, request, request.QueryParams)
 				if err != nil {
@@ -835,42 +745,22 @@ func resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(d *schema.Resource
 				return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Synchronizing"}, d.Timeout(schema.TimeoutUpdate), 60*time.Second, dtsService.DtsSynchronizationJobStateRefreshFunc(d.Id(), []string{"InitializeFailed"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
 		if target == "Suspending" {
 			action := "SuspendDtsJob"
-			request := requests.NewCommonRequest()
-			request.Version = "2020-01-01"
-			request.Method = "POST"
-			request.Product = "Dts"
-			request.RegionId = client.RegionId
-			request.Domain = client.Domain
-			request.ApiName = action
-			request.Headers["x-acs-caller-sdk-source"] = "Terraform" // 必填，调用来源说明
-			request.Headers["x-acs-regionid"] = client.RegionId
-			request.Headers["x-acs-resourcegroupid"] = client.ResourceGroup
-			request.Headers["x-acs-organizationid"] = client.Department
+			request := client.NewCommonRequest("POST", "Dts", "2020-01-01", action, "")
+			request.Headers["x-acs-caller-sdk-source"] = "Terraform"
 			request.Headers["x-acs-content-type"] = "application/json"
 			request.Headers["Content-type"] = "application/json"
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				request.Scheme = "https"
-			} else {
-				request.Scheme = "http"
-			}
-			request.QueryParams = map[string]string{
-				"DtsJobId":       d.Id(),
-				"RegionId":       client.RegionId,
-				"Product":        "Dts",
-				"Version":        "2020-01-01",
-				"Action":         action,
-				"OrganizationId": client.Department,
-				"ResourceId":     client.ResourceGroup,
-			}
+			request.QueryParams["DtsJobId"] = d.Id()
+			request.QueryParams["OrganizationId"] = client.Department
+			request.QueryParams["ResourceId"] = client.ResourceGroup
 			wait := incrementalWait(3*time.Second, 3*time.Second)
 			response := make(map[string]interface{})
-			err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := dtsClient.ProcessCommonRequest(request)
 				addDebug(action, raw, request, request.QueryParams)
 				if err != nil {
@@ -893,7 +783,7 @@ func resourceAlibabacloudStackDtsSynchronizationJobStatusFlow(d *schema.Resource
 				return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Suspending"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, dtsService.DtsSynchronizationJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -1,17 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,9 +19,9 @@ import (
 
 func resourceAlibabacloudStackEcsCommand() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsCommandCreate,
-		Read:   resourceAlibabacloudStackEcsCommandRead,
-		Delete: resourceAlibabacloudStackEcsCommandDelete,
+		CreateContext: resourceAlibabacloudStackEcsCommandCreate,
+		ReadContext:   resourceAlibabacloudStackEcsCommandRead,
+		DeleteContext: resourceAlibabacloudStackEcsCommandDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -68,8 +68,8 @@ func resourceAlibabacloudStackEcsCommand() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//var response map[string]interface{}
 	action := "CreateCommand"
 	response := &datahub.EcsCreate{}
@@ -119,43 +119,17 @@ func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta inte
 	if v, ok := d.GetOk("working_dir"); ok {
 		WorkingDir = fmt.Sprint(v.(string))
 	}
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"Name":            name,
-		"CommandContent":  CommandContent,
-		"Description":     Description,
-		"EnableParameter": EnableParameter,
-		"Timeout":         Timeout,
-		"WorkingDir":      WorkingDir,
-		"Type":            Type,
-	}
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["Name"] = name
+	request.QueryParams["CommandContent"] = CommandContent
+	request.QueryParams["Description"] = Description
+	request.QueryParams["EnableParameter"] = EnableParameter
+	request.QueryParams["Timeout"] = Timeout
+	request.QueryParams["WorkingDir"] = WorkingDir
+	request.QueryParams["Type"] = Type
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -176,13 +150,13 @@ func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEcsCommandRead(d, meta)
+	return resourceAlibabacloudStackEcsCommandRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsCommandRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeEcsCommand(d.Id())
 	if err != nil {
@@ -191,7 +165,7 @@ func resourceAlibabacloudStackEcsCommandRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("command_content", object.Commands.Command[0].CommandContent)
@@ -203,8 +177,8 @@ func resourceAlibabacloudStackEcsCommandRead(d *schema.ResourceData, meta interf
 	d.Set("working_dir", object.Commands.Command[0].WorkingDir)
 	return nil
 }
-func resourceAlibabacloudStackEcsCommandDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteCommand"
 	//var response map[string]interface{}
 	//conn, err := client.NewEcsClient()
@@ -214,38 +188,11 @@ func resourceAlibabacloudStackEcsCommandDelete(d *schema.ResourceData, meta inte
 	//request := map[string]interface{}{
 	//	"CommandId": d.Id(),
 	//}
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"CommandId":       d.Id(),
-	}
-
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
 	//request["RegionId"] = client.RegionId
+	request.QueryParams["CommandId"] = d.Id()
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
@@ -264,7 +211,7 @@ func resourceAlibabacloudStackEcsCommandDelete(d *schema.ResourceData, meta inte
 		if IsExpectedErrors(err, []string{"InvalidCmdId.NotFound", "InvalidRegionId.NotFound", "Operation.Forbidden"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
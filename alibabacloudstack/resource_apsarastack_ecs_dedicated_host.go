@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackEcsDedicatedHost() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsDedicatedHostCreate,
-		Read:   resourceAlibabacloudStackEcsDedicatedHostRead,
-		Update: resourceAlibabacloudStackEcsDedicatedHostUpdate,
-		Delete: resourceAlibabacloudStackEcsDedicatedHostDelete,
+		CreateContext: resourceAlibabacloudStackEcsDedicatedHostCreate,
+		ReadContext:   resourceAlibabacloudStackEcsDedicatedHostRead,
+		UpdateContext: resourceAlibabacloudStackEcsDedicatedHostUpdate,
+		DeleteContext: resourceAlibabacloudStackEcsDedicatedHostDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -141,15 +143,15 @@ func resourceAlibabacloudStackEcsDedicatedHost() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDedicatedHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	var response map[string]interface{}
 	action := "AllocateDedicatedHosts"
 	request := make(map[string]interface{})
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("action_on_maintenance"); ok {
 		request["ActionOnMaintenance"] = v
@@ -236,7 +238,7 @@ func resourceAlibabacloudStackEcsDedicatedHostCreate(d *schema.ResourceData, met
 	request["RegionId"] = client.RegionId
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -249,19 +251,19 @@ func resourceAlibabacloudStackEcsDedicatedHostCreate(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_dedicated_host", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_dedicated_host", action, AlibabacloudStackSdkGoERROR))
 	}
 	responseDedicatedHostIdSets := response["DedicatedHostIdSets"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseDedicatedHostIdSets["DedicatedHostId"].([]interface{})[0]))
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 15*time.Second, ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{"PermanentFailure"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackEcsDedicatedHostRead(d, meta)
+	return resourceAlibabacloudStackEcsDedicatedHostRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDedicatedHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeEcsDedicatedHost(d.Id())
 	if err != nil {
@@ -270,7 +272,7 @@ func resourceAlibabacloudStackEcsDedicatedHostRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("action_on_maintenance", object.ActionOnMaintenance)
 	d.Set("auto_placement", object.AutoPlacement)
@@ -289,15 +291,15 @@ func resourceAlibabacloudStackEcsDedicatedHostRead(d *schema.ResourceData, meta
 	d.Set("zone_id", object.ZoneId)
 	return nil
 }
-func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDedicatedHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	var response map[string]interface{}
 	d.Partial(true)
 
 	if !d.IsNewResource() && d.HasChange("tags") {
 		if err := ecsService.SetResourceTags(d, "ddh"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		d.SetPartial("tags")
 	}
@@ -312,10 +314,10 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 		action := "ModifyDedicatedHostAutoReleaseTime"
 		conn, err := client.NewEcsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -328,11 +330,11 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		d.SetPartial("auto_release_time")
 	}
@@ -348,10 +350,10 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 		action := "JoinResourceGroup"
 		conn, err := client.NewEcsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -364,11 +366,11 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		d.SetPartial("resource_group_id")
 	}
@@ -391,10 +393,10 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 		action := "RenewDedicatedHosts"
 		conn, err := client.NewEcsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -407,11 +409,11 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		d.SetPartial("expired_time")
 		d.SetPartial("sale_cycle")
@@ -440,10 +442,10 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 		modifyDedicatedHostsChargeTypeReq["organizationId"] = client.Department
 		conn, err := client.NewEcsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, modifyDedicatedHostsChargeTypeReq, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -456,11 +458,11 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		d.SetPartial("detail_fee")
 		d.SetPartial("dry_run")
@@ -514,10 +516,10 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 		modifyDedicatedHostAttributeReq["organizationId"] = client.Department
 		conn, err := client.NewEcsClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, modifyDedicatedHostAttributeReq, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -530,11 +532,11 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		d.SetPartial("action_on_maintenance")
 		d.SetPartial("auto_placement")
@@ -545,15 +547,15 @@ func resourceAlibabacloudStackEcsDedicatedHostUpdate(d *schema.ResourceData, met
 		d.SetPartial("network_attributes")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEcsDedicatedHostRead(d, meta)
+	return resourceAlibabacloudStackEcsDedicatedHostRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDedicatedHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "ReleaseDedicatedHost"
 	var response map[string]interface{}
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"DedicatedHostId": d.Id(),
@@ -562,7 +564,7 @@ func resourceAlibabacloudStackEcsDedicatedHostDelete(d *schema.ResourceData, met
 	request["organizationId"] = client.Department
 	request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"IncorrectHostStatus.Initializing"}) || NeedRetry(err) {
@@ -578,7 +580,7 @@ func resourceAlibabacloudStackEcsDedicatedHostDelete(d *schema.ResourceData, met
 		if IsExpectedErrors(err, []string{"InvalidDedicatedHostId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
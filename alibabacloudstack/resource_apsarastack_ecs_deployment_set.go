@@ -1,17 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
@@ -23,10 +23,10 @@ import (
 
 func resourceAlibabacloudStackEcsDeploymentSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsDeploymentSetCreate,
-		Read:   resourceAlibabacloudStackEcsDeploymentSetRead,
-		Update: resourceAlibabacloudStackEcsDeploymentSetUpdate,
-		Delete: resourceAlibabacloudStackEcsDeploymentSetDelete,
+		CreateContext: resourceAlibabacloudStackEcsDeploymentSetCreate,
+		ReadContext:   resourceAlibabacloudStackEcsDeploymentSetRead,
+		UpdateContext: resourceAlibabacloudStackEcsDeploymentSetUpdate,
+		DeleteContext: resourceAlibabacloudStackEcsDeploymentSetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -69,8 +69,8 @@ func resourceAlibabacloudStackEcsDeploymentSet() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDeploymentSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//var response map[string]interface{}
 	action := "CreateDeploymentSet"
 	//request := make(map[string]interface{})
@@ -102,44 +102,18 @@ func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, met
 		Strategy = fmt.Sprint(v.(string))
 	}
 	ClientToken := buildClientToken("CreateDeploymentSet")
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":                  client.SecretKey,
-		"AccessKeyId":                      client.AccessKey,
-		"Product":                          "Ecs",
-		"RegionId":                         client.RegionId,
-		"Department":                       client.Department,
-		"ResourceGroup":                    client.ResourceGroup,
-		"Action":                           action,
-		"Version":                          "2014-05-26",
-		"DeploymentSetName":                DeploymentSetName,
-		"Domain":                           "Default",
-		"Description":                      Description,
-		"Granularity":                      "Host",
-		"OnUnableToRedeployFailedInstance": OnUnableToRedeployFailedInstance,
-		"Strategy":                         Strategy,
-		"ClientToken":                      ClientToken,
-	}
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["DeploymentSetName"] = DeploymentSetName
+	request.QueryParams["Domain"] = "Default"
+	request.QueryParams["Description"] = Description
+	request.QueryParams["Granularity"] = "Host"
+	request.QueryParams["OnUnableToRedeployFailedInstance"] = OnUnableToRedeployFailedInstance
+	request.QueryParams["Strategy"] = Strategy
+	request.QueryParams["ClientToken"] = ClientToken
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -159,13 +133,13 @@ func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_deployment_set", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_deployment_set", action, AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEcsDeploymentSetRead(d, meta)
+	return resourceAlibabacloudStackEcsDeploymentSetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDeploymentSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDeploymentSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeEcsDeploymentSet(d.Id())
 	if err != nil {
@@ -174,7 +148,7 @@ func resourceAlibabacloudStackEcsDeploymentSetRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("domain", convertEcsDeploymentSetDomainResponse(object["Domain"]))
 	d.Set("granularity", convertEcsDeploymentSetGranularityResponse(object["Granularity"]))
@@ -189,21 +163,18 @@ func resourceAlibabacloudStackEcsDeploymentSetRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDeploymentSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	ecsService := EcsService{client}
 	if d.HasChange("tags") {
 		if err := ecsService.SetResourceTagsNew(d, "deployment_set"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
-	request := requests.NewCommonRequest()
-
 	update := false
 	DeploymentSetId := d.Id()
-
 	var DeploymentSetName string
 	if d.HasChange("deployment_set_name") {
 		update = true
@@ -211,6 +182,9 @@ func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, met
 			DeploymentSetName = fmt.Sprint(v.(string))
 		}
 	}
+	Description := d.Get("description").(string)
+	action := "ModifyDeploymentSetAttribute"
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
 	//var Description string
 	//if d.HasChange("description") {
 	//	update = true
@@ -218,36 +192,13 @@ func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, met
 	//		Description  = fmt.Sprint(v.(string))
 	//	}
 	//}
-	Description := d.Get("description").(string)
-	action := "ModifyDeploymentSetAttribute"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":   client.SecretKey,
-		"AccessKeyId":       client.AccessKey,
-		"Product":           "Ecs",
-		"RegionId":          client.RegionId,
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Action":            action,
-		"Version":           "2014-05-26",
-		"DeploymentSetId":   DeploymentSetId,
-		"DeploymentSetName": DeploymentSetName,
-		"Description":       Description,
-	}
+	request.QueryParams["DeploymentSetId"] = DeploymentSetId
+	request.QueryParams["DeploymentSetName"] = DeploymentSetName
+	request.QueryParams["Description"] = Description
 	if update {
 
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 				return EcsClient.ProcessCommonRequest(request)
 			})
@@ -262,40 +213,19 @@ func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackEcsDeploymentSetRead(d, meta)
+	return resourceAlibabacloudStackEcsDeploymentSetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDeploymentSetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsDeploymentSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteDeploymentSet"
 	DeploymentSetId := d.Id()
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"DeploymentSetId": DeploymentSetId,
-	}
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["DeploymentSetId"] = DeploymentSetId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -310,7 +240,7 @@ func resourceAlibabacloudStackEcsDeploymentSetDelete(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,27 +1,27 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsEbsStorageSetsCreate,
-		Read:   resourceAlibabacloudStackEcsEbsStorageSetsRead,
-		Delete: resourceAlibabacloudStackEcsEbsStorageSetsDelete,
+		CreateContext: resourceAlibabacloudStackEcsEbsStorageSetsCreate,
+		ReadContext:   resourceAlibabacloudStackEcsEbsStorageSetsRead,
+		DeleteContext: resourceAlibabacloudStackEcsEbsStorageSetsDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -50,8 +50,8 @@ func resourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsEbsStorageSetsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//var response map[string]interface{}
 	action := "CreateStorageSet"
 	response := &datahub.EcsStorageSetsCreate{}
@@ -66,39 +66,13 @@ func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, me
 	ZoneId := d.Get("zone_id").(string)
 
 	//zoneid := d.Get("zone_id").(string)
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":    client.SecretKey,
-		"AccessKeyId":        client.AccessKey,
-		"Product":            "Ecs",
-		"RegionId":           client.RegionId,
-		"Department":         client.Department,
-		"ResourceGroup":      client.ResourceGroup,
-		"Action":             action,
-		"Version":            "2014-05-26",
-		"StorageSetName":     StorageSetName,
-		"MaxPartitionNumber": MaxPartitionNumber,
-		"ZoneId":             ZoneId,
-	}
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["StorageSetName"] = StorageSetName
+	request.QueryParams["MaxPartitionNumber"] = MaxPartitionNumber
+	request.QueryParams["ZoneId"] = ZoneId
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -119,13 +93,13 @@ func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEcsEbsStorageSetsRead(d, meta)
+	return resourceAlibabacloudStackEcsEbsStorageSetsRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsEbsStorageSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeEcsEbsStorageSet(d.Id())
 	if err != nil {
@@ -134,7 +108,7 @@ func resourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("storage_set_name", object.StorageSets.StorageSet[0].StorageSetName)
@@ -143,8 +117,8 @@ func resourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta
 	d.Set("storage_set_id", object.StorageSets.StorageSet[0].StorageSetId)
 	return nil
 }
-func resourceAlibabacloudStackEcsEbsStorageSetsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsEbsStorageSetsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteStorageSet"
 	//var response map[string]interface{}
 	//conn, err := client.NewEcsClient()
@@ -154,38 +128,11 @@ func resourceAlibabacloudStackEcsEbsStorageSetsDelete(d *schema.ResourceData, me
 	//request := map[string]interface{}{
 	//	"CommandId": d.Id(),
 	//}
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"StorageSetId":    d.Id(),
-	}
-
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
 	//request["RegionId"] = client.RegionId
+	request.QueryParams["StorageSetId"] = d.Id()
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
@@ -204,7 +151,7 @@ func resourceAlibabacloudStackEcsEbsStorageSetsDelete(d *schema.ResourceData, me
 		if IsExpectedErrors(err, []string{"InvalidCmdId.NotFound", "InvalidRegionId.NotFound", "Operation.Forbidden"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
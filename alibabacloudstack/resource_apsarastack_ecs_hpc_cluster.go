@@ -1,14 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
@@ -18,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackEcsHpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsHpcClusterCreate,
-		Read:   resourceAlibabacloudStackEcsHpcClusterRead,
-		Update: resourceAlibabacloudStackEcsHpcClusterUpdate,
-		Delete: resourceAlibabacloudStackEcsHpcClusterDelete,
+		CreateContext: resourceAlibabacloudStackEcsHpcClusterCreate,
+		ReadContext:   resourceAlibabacloudStackEcsHpcClusterRead,
+		UpdateContext: resourceAlibabacloudStackEcsHpcClusterUpdate,
+		DeleteContext: resourceAlibabacloudStackEcsHpcClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -38,8 +38,8 @@ func resourceAlibabacloudStackEcsHpcCluster() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsHpcClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//var response map[string]interface{}
 	action := "CreateHpcCluster"
 	//request := make(map[string]interface{})
@@ -56,38 +56,16 @@ func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta i
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	ClientToken := buildClientToken("CreateHpcCluster")
-	request := requests.NewCommonRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"Name":            Name,
-		"ClientToken":     ClientToken,
-		"Description":     Description,
-	}
-
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
 	//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
+	request.QueryParams["Name"] = Name
+	request.QueryParams["ClientToken"] = ClientToken
+	request.QueryParams["Description"] = Description
 	raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 		return EcsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_hpc_cluster", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_hpc_cluster", action, AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(action, raw, request)
 	//bresponse := raw.(*responses.CommonResponse)
@@ -98,10 +76,10 @@ func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta i
 	//var response *ecs.CreateHpcClusterResponse
 	//response, _ = raw.(*ecs.CreateHpcClusterResponse)
 	d.SetId(fmt.Sprint(resp.HpcClusterId))
-	return resourceAlibabacloudStackEcsHpcClusterRead(d, meta)
+	return resourceAlibabacloudStackEcsHpcClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsHpcClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsHpcClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	ecsService := EcsService{client}
 
@@ -112,22 +90,20 @@ func resourceAlibabacloudStackEcsHpcClusterRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("description", object.HpcClusters.HpcCluster[0].Description)
 	d.Set("name", object.HpcClusters.HpcCluster[0].Name)
 	return nil
 }
-func resourceAlibabacloudStackEcsHpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsHpcClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	//var response map[string]interface{}
 	update := false
 	//request := map[string]interface{}{
 	//	"HpcClusterId": d.Id(),
 	//}
-	request := requests.NewCommonRequest()
-	//request["RegionId"] = client.RegionId
 	Description := d.Get("description").(string)
 
 	//var Description string
@@ -150,81 +126,40 @@ func resourceAlibabacloudStackEcsHpcClusterUpdate(d *schema.ResourceData, meta i
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		ClientToken := buildClientToken("ModifyHpcClusterAttribute")
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.Method = "POST"
-		request.Product = "Ecs"
-		request.Domain = client.Domain
-		request.Version = "2014-05-26"
-		request.ApiName = action
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "Ecs",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          action,
-			"Version":         "2014-05-26",
-			"ClientToken":     ClientToken,
-			"HpcClusterId":    HpcClusterId,
-			"Description":     Description,
-			"Name":            Name,
-		}
+		request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
+		request.QueryParams["ClientToken"] = ClientToken
+		request.QueryParams["HpcClusterId"] = HpcClusterId
+		request.QueryParams["Description"] = Description
+		request.QueryParams["Name"] = Name
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
 		//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackEcsHpcClusterRead(d, meta)
+	return resourceAlibabacloudStackEcsHpcClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsHpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEcsHpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteHpcCluster"
 
-	request := requests.NewCommonRequest()
 	HpcClusterId := d.Id()
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	ClientToken := buildClientToken("DeleteHpcCluster")
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = client.Domain
-	request.Version = "2014-05-26"
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"ClientToken":     ClientToken,
-		"HpcClusterId":    HpcClusterId,
-	}
+	request := client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["ClientToken"] = ClientToken
+	request.QueryParams["HpcClusterId"] = HpcClusterId
 	response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 		return EcsClient.ProcessCommonRequest(request)
 	})
 	//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
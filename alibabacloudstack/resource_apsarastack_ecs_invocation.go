@@ -0,0 +1,253 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackEcsInvocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackEcsInvocationCreate,
+		ReadContext:   resourceAlibabacloudStackEcsInvocationRead,
+		DeleteContext: resourceAlibabacloudStackEcsInvocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"repeat_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Once",
+				ValidateFunc: validation.StringInSlice([]string{"Once", "Period", "NextRebootOnly", "EveryReboot"}, false),
+			},
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("repeat_mode").(string) != "Period"
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invocation_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invocation_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exit_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finish_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackEcsInvocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateInvokeCommandRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.CommandId = d.Get("command_id").(string)
+	instanceIds := expandStringList(d.Get("instance_id").(*schema.Set).List())
+	request.InstanceId = &instanceIds
+	if v, ok := d.GetOk("parameters"); ok {
+		request.Parameters = v.(map[string]interface{})
+	}
+	repeatMode := d.Get("repeat_mode").(string)
+	// RepeatMode is not modelled by the pinned SDK, Timed is kept for older ECS versions.
+	request.QueryParams["RepeatMode"] = repeatMode
+	if repeatMode == "Period" {
+		if v, ok := d.GetOk("frequency"); ok {
+			request.Frequency = v.(string)
+		} else {
+			return DiagnosticsFromError(WrapError(Error("'frequency' is required when 'repeat_mode' is 'Period'")))
+		}
+		request.Timed = requests.NewBoolean(true)
+	}
+
+	var response *ecs.InvokeCommandResponse
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.InvokeCommand(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InvalidInstance.NotRunning", "InstanceNotReady", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ = raw.(*ecs.InvokeCommandResponse)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_invocation", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	d.SetId(response.InvokeId)
+
+	// A one-off run is waited on until it finishes and fails when the command fails on any instance,
+	// scheduled runs are only waited on until they are accepted.
+	pending := []string{"Pending", "Running", "Stopping"}
+	target := []string{"Success", "Finished", "Stopped"}
+	failStates := []string{"Failed", "PartialFailed"}
+	if repeatMode != "Once" {
+		pending = []string{"Pending"}
+		target = append(target, "Failed", "PartialFailed", "Scheduled", "Running")
+		failStates = []string{}
+	}
+	stateConf := BuildStateConf(pending, target, d.Timeout(schema.TimeoutCreate), 5*time.Second, ecsService.EcsInvocationStateRefreshFunc(d.Id(), failStates))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+
+	return resourceAlibabacloudStackEcsInvocationRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackEcsInvocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ecsService := EcsService{client}
+
+	object, err := ecsService.DescribeEcsInvocation(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	instanceIds := make([]string, 0)
+	results := make([]map[string]interface{}, 0)
+	for _, instance := range object.InvokeInstances.InvokeInstance {
+		instanceIds = append(instanceIds, instance.InstanceId)
+		results = append(results, map[string]interface{}{
+			"instance_id":       instance.InstanceId,
+			"invocation_status": instance.InvocationStatus,
+			"exit_code":         instance.ExitCode,
+			"output":            instance.Output,
+			"error_code":        instance.ErrorCode,
+			"error_info":        instance.ErrorInfo,
+			"start_time":        instance.StartTime,
+			"finish_time":       instance.FinishTime,
+		})
+	}
+
+	d.Set("command_id", object.CommandId)
+	d.Set("instance_id", instanceIds)
+	d.Set("status", object.InvocationStatus)
+	if object.Frequency != "" {
+		d.Set("frequency", object.Frequency)
+	}
+	if err := d.Set("invocation_results", results); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackEcsInvocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ecsService := EcsService{client}
+
+	object, err := ecsService.DescribeEcsInvocation(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	// Finished invocations are kept by ECS as history records and can not be removed.
+	switch object.InvocationStatus {
+	case "Pending", "Scheduled", "Running":
+	default:
+		return nil
+	}
+
+	request := ecs.CreateStopInvocationRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.InvokeId = d.Id()
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.StopInvocation(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidInvokeId.NotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+	stateConf := BuildStateConf([]string{"Pending", "Scheduled", "Running", "Stopping"}, []string{"Stopped", "Success", "Finished", "Failed", "PartialFailed", ""}, d.Timeout(schema.TimeoutDelete), 5*time.Second, ecsService.EcsInvocationStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackEcsInvocation_basic(t *testing.T) {
+	var v ecs.Invocation
+	resourceId := "alibabacloudstack_ecs_invocation.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackEcsInvocationMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeEcsInvocation")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAcc%sAlibabacloudStackEcsInvocation%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackEcsInvocationBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"command_id":  "${alibabacloudstack_ecs_command.default.id}",
+					"instance_id": []string{"${alibabacloudstack_instance.default.id}"},
+					"parameters": map[string]string{
+						"name": "terraform",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"command_id":                             CHECKSET,
+						"instance_id.#":                          "1",
+						"parameters.%":                           "1",
+						"status":                                 "Success",
+						"invocation_results.#":                   "1",
+						"invocation_results.0.exit_code":         "0",
+						"invocation_results.0.output":            "hello terraform\n",
+						"invocation_results.0.invocation_status": "Success",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters", "repeat_mode"},
+			},
+		},
+	})
+}
+
+var AlibabacloudStackEcsInvocationMap = map[string]string{
+	"repeat_mode": "Once",
+}
+
+func AlibabacloudStackEcsInvocationBasicDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+variable "name" {
+	default = "%s"
+}
+
+resource "alibabacloudstack_instance" "default" {
+	vswitch_id = "${alibabacloudstack_vswitch.default.id}"
+	image_id = "${data.alibabacloudstack_images.default.images.0.id}"
+	instance_type = "${local.instance_type_id}"
+	instance_name = "${var.name}"
+	system_disk_category = "cloud_efficiency"
+	security_groups = ["${alibabacloudstack_security_group.default.id}"]
+}
+
+resource "alibabacloudstack_ecs_command" "default" {
+	name             = "${var.name}"
+	command_content  = "ZWNobyBoZWxsbyB7e25hbWV9fQ=="
+	type             = "RunShellScript"
+	enable_parameter = true
+}
+`, EcsInstanceCommonTestCase, name)
+}
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"strings"
@@ -20,14 +22,20 @@ var component_id int
 
 func resourceAlibabacloudStackEdasApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasApplicationCreate,
-		Update: resourceAlibabacloudStackEdasApplicationUpdate,
-		Read:   resourceAlibabacloudStackEdasApplicationRead,
-		Delete: resourceAlibabacloudStackEdasApplicationDelete,
+		CreateContext: resourceAlibabacloudStackEdasApplicationCreate,
+		UpdateContext: resourceAlibabacloudStackEdasApplicationUpdate,
+		ReadContext:   resourceAlibabacloudStackEdasApplicationRead,
+		DeleteContext: resourceAlibabacloudStackEdasApplicationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Type:     schema.TypeString,
@@ -85,8 +93,8 @@ func resourceAlibabacloudStackEdasApplication() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 	request := edas.CreateInsertApplicationRequest()
 
@@ -142,7 +150,7 @@ func resourceAlibabacloudStackEdasApplicationCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_application", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_application", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
@@ -157,13 +165,13 @@ func resourceAlibabacloudStackEdasApplicationCreate(d *schema.ResourceData, meta
 	changeOrderId = response.ApplicationInfo.ChangeOrderId
 	d.SetId(appId)
 	if response.Code != 200 {
-		return WrapError(Error("create application failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("create application failed for " + response.Message)))
 	}
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
@@ -199,29 +207,29 @@ func resourceAlibabacloudStackEdasApplicationCreate(d *schema.ResourceData, meta
 			return edasClient.DeployApplication(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 		response, _ := raw.(*edas.DeployApplicationResponse)
 		changeOrderId := response.ChangeOrderId
 		if response.Code != 200 {
-			return WrapError(Error("deploy application failed for " + response.Message))
+			return DiagnosticsFromError(WrapError(Error("deploy application failed for " + response.Message)))
 		}
 
 		if len(changeOrderId) > 0 {
 			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapErrorf(err, IdMsg, d.Id())
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 		}
 	}
 
-	return resourceAlibabacloudStackEdasApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	if d.HasChange("application_name") || d.HasChange("descriotion") {
@@ -239,17 +247,17 @@ func resourceAlibabacloudStackEdasApplicationUpdate(d *schema.ResourceData, meta
 			return edasClient.UpdateApplicationBaseInfo(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 	}
 
 	time.Sleep(3 * time.Second)
-	return resourceAlibabacloudStackEdasApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	regionId := client.RegionId
@@ -263,7 +271,7 @@ func resourceAlibabacloudStackEdasApplicationRead(d *schema.ResourceData, meta i
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.GetApplication(request)
 		})
@@ -297,14 +305,14 @@ func resourceAlibabacloudStackEdasApplicationRead(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_application", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_application", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	regionId := client.RegionId
@@ -321,16 +329,16 @@ func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta
 		return edasClient.StopApplication(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 	response, _ := raw.(*edas.StopApplicationResponse)
 	changeOrderId := response.ChangeOrderId
 
 	if len(changeOrderId) > 0 {
-		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
@@ -342,7 +350,7 @@ func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta
 	req.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteApplication(req)
 		})
@@ -363,15 +371,15 @@ func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta
 		//component_ids[request.AppId] = 0
 		delete(component_ids, request.AppId)
 		if len(changeOrderId) > 0 {
-			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return resource.NonRetryableError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 		}
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), req.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), req.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -8,18 +9,24 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEdasApplicationPackageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasApplicationPackageAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackEdasApplicationPackageAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"app_id": {
 				Type:     schema.TypeString,
@@ -50,8 +57,8 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachment() *schema.Resourc
 	}
 }
 
-func resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	appId := d.Get("app_id").(string)
@@ -75,7 +82,7 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate(d *schema.R
 	request.PackageVersion = packageVersion
 
 	if version, err := edasService.GetLastPackgeVersion(appId, groupId); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		d.Set("last_package_version", version)
 	}
@@ -84,29 +91,29 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate(d *schema.R
 		return edasClient.DeployApplication(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.DeployApplicationResponse)
 	changeOrderId := response.ChangeOrderId
 	if response.Code != 200 {
-		return WrapError(Error("deploy application failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("deploy application failed for " + response.Message)))
 	}
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 	d.SetId(appId + ":" + packageVersion)
 
-	return resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	regionId := client.RegionId
@@ -122,13 +129,13 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(d *schema.Res
 		return edasClient.QueryApplicationStatus(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_application_package_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_application_package_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 	response, _ := raw.(*edas.QueryApplicationStatusResponse)
 
 	if response.Code != 200 {
-		return WrapError(Error("QueryApplicationStatus failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("QueryApplicationStatus failed for " + response.Message)))
 	}
 
 	groupId := d.Get("group_id").(string)
@@ -141,8 +148,8 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(d *schema.Res
 	return nil
 }
 
-func resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	regionId := client.RegionId
@@ -166,20 +173,20 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete(d *schema.R
 		return edasClient.RollbackApplication(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.RollbackApplicationResponse)
 	changeOrderId := response.ChangeOrderId
 	if response.Code != 200 && !strings.Contains(response.Message, "ex.app.deploy.group.empty") {
-		return WrapError(Error("deploy application failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("deploy application failed for " + response.Message)))
 	}
 
 	if len(changeOrderId) > 0 {
-		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"strings"
@@ -17,9 +19,9 @@ import (
 
 func resourceAlibabacloudStackEdasCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: rresourceAlibabacloudStackEdasClusterCreate,
-		Read:   resourceAlibabacloudStackEdasClusterRead,
-		Delete: resourceAlibabacloudStackEdasClusterDelete,
+		CreateContext: rresourceAlibabacloudStackEdasClusterCreate,
+		ReadContext:   resourceAlibabacloudStackEdasClusterRead,
+		DeleteContext: resourceAlibabacloudStackEdasClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -55,8 +57,8 @@ func resourceAlibabacloudStackEdasCluster() *schema.Resource {
 	}
 }
 
-func rresourceAlibabacloudStackEdasClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func rresourceAlibabacloudStackEdasClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	request := edas.CreateInsertClusterRequest()
@@ -73,7 +75,7 @@ func rresourceAlibabacloudStackEdasClusterCreate(d *schema.ResourceData, meta in
 
 	if v, ok := d.GetOk("vpc_id"); !ok {
 		if d.Get("network_mode") == 2 {
-			return WrapError(Error("vpcId is required for vpc network mode"))
+			return DiagnosticsFromError(WrapError(Error("vpcId is required for vpc network mode")))
 		}
 	} else {
 		request.VpcId = v.(string)
@@ -83,22 +85,22 @@ func rresourceAlibabacloudStackEdasClusterCreate(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	log.Printf("request domainaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa: %s", request.Domain)
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.InsertClusterResponse)
 	if response.Code != 200 {
-		return WrapError(Error("create cluster failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("create cluster failed for " + response.Message)))
 	}
 	d.SetId(response.Cluster.ClusterId)
 
-	return resourceAlibabacloudStackEdasClusterRead(d, meta)
+	return resourceAlibabacloudStackEdasClusterRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	clusterId := d.Id()
@@ -117,13 +119,13 @@ func resourceAlibabacloudStackEdasClusterRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.GetClusterResponse)
 	if response.Code != 200 {
-		return WrapError(Error("create cluster failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("create cluster failed for " + response.Message)))
 	}
 
 	d.Set("cluster_name", response.Cluster.ClusterName)
@@ -135,8 +137,8 @@ func resourceAlibabacloudStackEdasClusterRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackEdasClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	clusterId := d.Id()
@@ -151,7 +153,7 @@ func resourceAlibabacloudStackEdasClusterDelete(d *schema.ResourceData, meta int
 	request.Headers["Content-Type"] = "application/json"
 
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteCluster(request)
 		})
@@ -174,7 +176,7 @@ func resourceAlibabacloudStackEdasClusterDelete(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	return nil
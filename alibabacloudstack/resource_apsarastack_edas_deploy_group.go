@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEdasDeployGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasDeployGroupCreate,
-		Read:   resourceAlibabacloudStackEdasDeployGroupRead,
-		Delete: resourceAlibabacloudStackEdasDeployGroupDelete,
+		CreateContext: resourceAlibabacloudStackEdasDeployGroupCreate,
+		ReadContext:   resourceAlibabacloudStackEdasDeployGroupRead,
+		DeleteContext: resourceAlibabacloudStackEdasDeployGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -42,8 +44,8 @@ func resourceAlibabacloudStackEdasDeployGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasDeployGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	appId := d.Get("app_id").(string)
@@ -61,7 +63,7 @@ func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.InsertDeployGroup(request)
 		})
@@ -80,19 +82,19 @@ func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_deploy_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_deploy_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEdasDeployGroupRead(d, meta)
+	return resourceAlibabacloudStackEdasDeployGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasDeployGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasDeployGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	strs, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	appId := strs[0]
@@ -100,7 +102,7 @@ func resourceAlibabacloudStackEdasDeployGroupRead(d *schema.ResourceData, meta i
 
 	deployGroup, err := edasService.GetDeployGroup(appId, groupId)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if deployGroup == nil {
 		return nil
@@ -113,8 +115,8 @@ func resourceAlibabacloudStackEdasDeployGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackEdasDeployGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasDeployGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	request := edas.CreateDeleteDeployGroupRequest()
@@ -125,7 +127,7 @@ func resourceAlibabacloudStackEdasDeployGroupDelete(d *schema.ResourceData, meta
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteDeployGroup(request)
 		})
@@ -140,7 +142,7 @@ func resourceAlibabacloudStackEdasDeployGroupDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	return nil
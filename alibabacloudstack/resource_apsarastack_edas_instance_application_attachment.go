@@ -1,23 +1,29 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEdasInstanceApplicationAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"ecc_info": {
 				Type:     schema.TypeString,
@@ -49,8 +55,8 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachment() *schema.Resour
 	}
 }
 
-func resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	appId := d.Get("app_id").(string)
@@ -77,7 +83,7 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate(d *schema.
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_application_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_application_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
@@ -85,26 +91,26 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate(d *schema.
 	changeOrderId = response.ChangeOrderId
 	d.SetId(appId + ":" + strings.Join(aString, ","))
 	if response.Code != 200 {
-		return WrapError(Error("scaleOut application failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("scaleOut application failed for " + response.Message)))
 	}
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
-	return resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	strs, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	appId := strs[0]
@@ -122,13 +128,13 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d *schema.Re
 	})
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_application_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_application_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	var eccs []string
 	response := raw.(*edas.QueryApplicationStatusResponse)
 	if response.Code != 200 {
-		return WrapError(Error("QueryApplicationStatus failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("QueryApplicationStatus failed for " + response.Message)))
 	}
 	for _, ecc := range response.AppInfo.EccList.Ecc {
 		for _, ecu := range aString {
@@ -144,8 +150,8 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d *schema.Re
 	return nil
 }
 
-func resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	request := edas.CreateScaleInApplicationRequest()
@@ -164,20 +170,20 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete(d *schema.
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_application_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_application_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	var changeOrderId string
 	response, _ := raw.(*edas.ScaleInApplicationResponse)
 	if response.Code != 200 {
-		return WrapError(Error("scaleIn application failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("scaleIn application failed for " + response.Message)))
 	}
 	changeOrderId = response.ChangeOrderId
 
 	if len(changeOrderId) > 0 {
-		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
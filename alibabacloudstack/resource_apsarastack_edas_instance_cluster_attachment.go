@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
@@ -14,12 +16,16 @@ import (
 
 func resourceAlibabacloudStackEdasInstanceClusterAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasInstanceClusterAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackEdasInstanceClusterAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -65,8 +71,8 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	clusterId := d.Get("cluster_id").(string)
@@ -87,11 +93,11 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 	request.SetReadTimeout(30 * time.Second)
 
 	if err := edasService.SyncResource("ecs"); err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.InsertClusterMember(request)
 		})
@@ -113,7 +119,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_cluster_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_cluster_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	//使用这种方法有问题，超过120s还没有导入成功，则用例失败，需要改成
 	//time.Sleep(120 * time.Second)
@@ -134,11 +140,11 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 		})
 		addDebug(requestList.GetActionName(), rawList, requestList.RoaRequest, requestList)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_List_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_List_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		strs, err := ParseResourceId(d.Id(), 2)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		instanceIdstr := strs[1]
@@ -151,7 +157,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 					break
 				}
 				if member.Status == 7 {
-					return Error("Instance:`%s` Import Timeout! ", member.EcsId)
+					return DiagnosticsFromError(Error("Instance:`%s` Import Timeout! ", member.EcsId))
 				}
 				if member.EcuId != "" {
 					ImportSuccessFlag = true
@@ -165,16 +171,16 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 		time.Sleep(30 * time.Second)
 		cnt++
 	}
-	return resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	strs, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	clusterId := strs[0]
@@ -192,7 +198,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d *schema.Resour
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_cluster_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_cluster_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
@@ -217,8 +223,8 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d *schema.Resour
 }
 
 // 有问题 单个实例删除失败会影响整个过程
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	memIds := d.Get("cluster_member_ids").(map[string]interface{})
@@ -231,7 +237,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(d *schema.Reso
 		request.Headers["x-acs-organizationid"] = client.Department
 		request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 				return edasClient.DeleteClusterMember(request)
 
@@ -256,7 +262,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(d *schema.Reso
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_cluster_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_instance_cluster_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
 
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"strings"
@@ -19,15 +21,16 @@ import (
 
 func resourceAlibabacloudStackEdasK8sApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasK8sApplicationCreate,
-		Read:   resourceAlibabacloudStackEdasK8sApplicationRead,
-		Update: resourceAlibabacloudStackEdasK8sApplicationUpdate,
-		Delete: resourceAlibabacloudStackEdasK8sApplicationDelete,
+		CreateContext: resourceAlibabacloudStackEdasK8sApplicationCreate,
+		ReadContext:   resourceAlibabacloudStackEdasK8sApplicationRead,
+		UpdateContext: resourceAlibabacloudStackEdasK8sApplicationUpdate,
+		DeleteContext: resourceAlibabacloudStackEdasK8sApplicationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -242,8 +245,8 @@ func resourceAlibabacloudStackEdasK8sApplication() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasK8sApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 	request := edas.CreateInsertK8sApplicationRequest()
 
@@ -258,7 +261,7 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	if strings.ToLower(packageType) == "image" {
 		if v, ok := d.GetOk("image_url"); !ok {
-			return WrapError(Error("image_url is needed for creating image k8s application"))
+			return DiagnosticsFromError(WrapError(Error("image_url is needed for creating image k8s application")))
 		} else {
 			request.ImageUrl = v.(string)
 		}
@@ -269,7 +272,7 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 		//	request.ImageUrl = v.(string)
 		//}
 		if v, ok := d.GetOk("package_url"); !ok {
-			return WrapError(Error("package_url is needed for creating fatjar k8s application"))
+			return DiagnosticsFromError(WrapError(Error("package_url is needed for creating fatjar k8s application")))
 		} else {
 			request.PackageUrl = v.(string)
 			request.ImageUrl = v.(string)
@@ -278,7 +281,7 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 			request.PackageVersion = v.(string)
 		}
 		if v, ok := d.GetOk("jdk"); !ok {
-			return WrapError(Error("jdk is needed for creating non-image k8s application"))
+			return DiagnosticsFromError(WrapError(Error("jdk is needed for creating non-image k8s application")))
 		} else {
 			request.JDK = v.(string)
 		}
@@ -292,7 +295,7 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 				edasContainer = v.(string)
 			}
 			if len(webContainer) == 0 && len(edasContainer) == 0 {
-				return WrapError(Error("web_container or edas_container_version is needed for creating war k8s application"))
+				return DiagnosticsFromError(WrapError(Error("web_container or edas_container_version is needed for creating war k8s application")))
 			}
 			request.WebContainer = webContainer
 			request.EdasContainerVersion = edasContainer
@@ -320,7 +323,7 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 	if v, ok := d.GetOk("command_args"); ok {
 		commands, err := edasService.GetK8sCommandArgs(v.([]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.CommandArgs = commands
 	}
@@ -359,7 +362,7 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 	if v, ok := d.GetOk("envs"); ok {
 		envs, err := edasService.GetK8sEnvs(v.(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Envs = envs
 	}
@@ -416,7 +419,7 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 	})
 	addDebug("InsertK8sApplication", raw, request, request.RoaRequest)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_k8s_application", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_k8s_application", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	response, _ := raw.(*edas.InsertK8sApplicationResponse)
@@ -424,24 +427,24 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 	changeOrderId = response.ApplicationInfo.ChangeOrderId
 	d.SetId(appId)
 	if response.Code != 200 {
-		return WrapError(Error("Create k8s application failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("Create k8s application failed for " + response.Message)))
 	}
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 	bind_slb_err := K8sBindSlb(d, meta)
 	if bind_slb_err != nil {
-		return WrapError(bind_slb_err)
+		return DiagnosticsFromError(WrapError(bind_slb_err))
 	}
-	return resourceAlibabacloudStackEdasK8sApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasK8sApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasK8sApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	response, err := edasService.DescribeEdasK8sApplication(d.Id())
@@ -451,7 +454,7 @@ func resourceAlibabacloudStackEdasK8sApplicationRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("application_name", response.App.ApplicationName)
 	d.Set("application_descriotion", response.App.Description)
@@ -514,8 +517,8 @@ func resourceAlibabacloudStackEdasK8sApplicationRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasK8sApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 	var partialKeys []string
 	request := edas.CreateDeployK8sApplicationRequest()
@@ -527,7 +530,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 	d.Partial(true)
 	packageType, err := edasService.QueryK8sAppPackageType(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if strings.ToLower(packageType) == "image" {
 		if d.HasChange("image_url") {
@@ -535,7 +538,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		}
 		request.Image = d.Get("image_url").(string)
 		if len(request.Image) == 0 {
-			return WrapError(Error("image_url is needed for creating image k8s application"))
+			return DiagnosticsFromError(WrapError(Error("image_url is needed for creating image k8s application")))
 		}
 	} else {
 		if d.HasChange("package_url") {
@@ -543,7 +546,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		}
 		request.PackageUrl = d.Get("package_url").(string)
 		if len(request.PackageUrl) == 0 {
-			return WrapError(Error("package_url is needed for creating fatjar k8s application"))
+			return DiagnosticsFromError(WrapError(Error("package_url is needed for creating fatjar k8s application")))
 		}
 		request.PackageVersion = d.Get("package_version").(string)
 
@@ -552,7 +555,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		}
 		request.JDK = d.Get("jdk").(string)
 		if len(request.JDK) == 0 {
-			return WrapError(Error("jdk is needed for creating non-image k8s application"))
+			return DiagnosticsFromError(WrapError(Error("jdk is needed for creating non-image k8s application")))
 		}
 		if strings.ToLower(packageType) == "war" {
 			var webContainer string
@@ -567,7 +570,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 			}
 			edasContainer = d.Get("edas_container_version").(string)
 			if len(webContainer) == 0 && len(edasContainer) == 0 {
-				return WrapError(Error("web_container or edas_container_version is needed for updating war k8s application"))
+				return DiagnosticsFromError(WrapError(Error("web_container or edas_container_version is needed for updating war k8s application")))
 			}
 			request.WebContainer = webContainer
 			request.EdasContainerVersion = edasContainer
@@ -598,7 +601,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		partialKeys = append(partialKeys, "command_args")
 		commands, err := edasService.GetK8sCommandArgsForDeploy(d.Get("command_args").([]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Args = commands
 	}
@@ -607,7 +610,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		partialKeys = append(partialKeys, "envs")
 		envs, err := edasService.GetK8sEnvs(d.Get("envs").(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Envs = envs
 	}
@@ -670,7 +673,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		update_type := d.Get("update_type").(string)
 		update_batch := d.Get("update_batch").(int)
 		if update_batch < 2 {
-			return WrapError(Error("`update_batch` must be greater than 2"))
+			return DiagnosticsFromError(WrapError(Error("`update_batch` must be greater than 2")))
 		}
 		update_release_type := d.Get("update_release_type").(string)
 		gray_update_strategy := ""
@@ -691,18 +694,18 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		response, _ := raw.(*edas.DeployK8sApplicationResponse)
 		changeOrderId := response.ChangeOrderId
 		if response.Code != 200 {
-			return WrapError(Error("deploy k8s application failed for " + response.Message))
+			return DiagnosticsFromError(WrapError(Error("deploy k8s application failed for " + response.Message)))
 		}
 
 		if len(changeOrderId) > 0 {
-			stateConf := BuildStateConf([]string{"0", "1", "9"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapErrorf(err, IdMsg, d.Id())
+			stateConf := BuildStateConf([]string{"0", "1", "9"}, []string{"2"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 		}
 		//for _, key := range partialKeys {
@@ -710,11 +713,11 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		//}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEdasK8sApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasK8sApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasK8sApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	request := edas.CreateDeleteK8sApplicationRequest()
@@ -724,7 +727,7 @@ func resourceAlibabacloudStackEdasK8sApplicationDelete(d *schema.ResourceData, m
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteK8sApplication(request)
 		})
@@ -743,15 +746,15 @@ func resourceAlibabacloudStackEdasK8sApplicationDelete(d *schema.ResourceData, m
 		changeOrderId := response.ChangeOrderId
 
 		if len(changeOrderId) > 0 {
-			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return resource.NonRetryableError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 		}
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -792,7 +795,7 @@ func K8sBindSlb(d *schema.ResourceData, meta interface{}) error {
 			return WrapError(fmt.Errorf("BindK8sSlb Failed , response: %#v", response))
 		}
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(response.ChangeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(client.Context()); err != nil {
 			return WrapError(fmt.Errorf("BindK8sSlb Failed , response: %#v", response))
 		}
 	}
@@ -830,7 +833,7 @@ func K8sBindSlb(d *schema.ResourceData, meta interface{}) error {
 			return WrapError(fmt.Errorf("BindK8sSlb Failed , response: %#v", response))
 		}
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(response.ChangeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(client.Context()); err != nil {
 			return WrapError(fmt.Errorf("BindK8sSlb Failed , response: %#v", response))
 		}
 	}
@@ -1,20 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEdasK8sCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasK8sClusterCreate,
-		Read:   resourceAlibabacloudStackEdasK8sClusterRead,
-		Delete: resourceAlibabacloudStackEdasK8sClusterDelete,
+		CreateContext: resourceAlibabacloudStackEdasK8sClusterCreate,
+		ReadContext:   resourceAlibabacloudStackEdasK8sClusterRead,
+		DeleteContext: resourceAlibabacloudStackEdasK8sClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -57,8 +59,8 @@ func resourceAlibabacloudStackEdasK8sCluster() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasK8sClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasK8sClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	request := edas.CreateImportK8sClusterRequest()
@@ -76,16 +78,16 @@ func resourceAlibabacloudStackEdasK8sClusterCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_k8s_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_k8s_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.ImportK8sClusterResponse)
 	if response.Code != 200 {
-		return WrapError(Error("import k8s cluster failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("import k8s cluster failed for " + response.Message)))
 	}
 	if len(response.Data) == 0 {
-		return WrapError(Error("null cluster id after import k8s cluster"))
+		return DiagnosticsFromError(WrapError(Error("null cluster id after import k8s cluster")))
 	}
 	d.SetId(response.Data)
 	// Wait until import succeed
@@ -97,7 +99,7 @@ func resourceAlibabacloudStackEdasK8sClusterCreate(d *schema.ResourceData, meta
 	request.Headers["Content-Type"] = "application/json"
 	req.RegionId = client.RegionId
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.GetCluster(req)
 		})
@@ -118,14 +120,14 @@ func resourceAlibabacloudStackEdasK8sClusterCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEdasK8sClusterRead(d, meta)
+	return resourceAlibabacloudStackEdasK8sClusterRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasK8sClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasK8sClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	object, err := edasService.DescribeEdasListCluster(d.Id())
@@ -134,7 +136,7 @@ func resourceAlibabacloudStackEdasK8sClusterRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	region := object.RegionId
 	pos := strings.Index(region, ":")
@@ -153,8 +155,8 @@ func resourceAlibabacloudStackEdasK8sClusterRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasK8sClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	clusterId := d.Id()
@@ -167,7 +169,7 @@ func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteCluster(request)
 		})
@@ -187,7 +189,7 @@ func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	reqGet := edas.CreateGetClusterRequest()
@@ -196,7 +198,7 @@ func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta
 	reqGet.Headers["x-ascm-product-name"] = "Edas"
 	reqGet.Headers["x-acs-organizationid"] = client.Department
 	reqGet.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.GetCluster(reqGet)
 		})
@@ -219,7 +221,7 @@ func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta
 		}
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	return nil
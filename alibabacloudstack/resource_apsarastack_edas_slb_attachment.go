@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackEdasSlbAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasSlbAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasSlbAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasSlbAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackEdasSlbAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackEdasSlbAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackEdasSlbAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -65,8 +67,8 @@ func resourceAlibabacloudStackEdasSlbAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasSlbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasSlbAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	appId := d.Get("app_id").(string)
@@ -89,7 +91,7 @@ func resourceAlibabacloudStackEdasSlbAttachmentCreate(d *schema.ResourceData, me
 	}
 
 	if err := edasService.SyncResource("slb"); err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
@@ -97,27 +99,27 @@ func resourceAlibabacloudStackEdasSlbAttachmentCreate(d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response, _ := raw.(*edas.BindSlbResponse)
 	if response.Code != 200 {
-		return WrapError(Error("bind slb failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("bind slb failed for " + response.Message)))
 	}
 	d.SetId(appId + ":" + slbId)
 	//return resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d, meta)
-	return resourceAlibabacloudStackEdasSlbAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasSlbAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasSlbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasSlbAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	id := d.Id()
 	strs, err := ParseResourceId(id, 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	regionId := client.RegionId
@@ -135,14 +137,14 @@ func resourceAlibabacloudStackEdasSlbAttachmentRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", rq.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", rq.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(rq.GetActionName(), raw, rq.RoaRequest, rq)
 
 	rs := raw.(*edas.GetApplicationResponse)
 	if rs.Applcation.AppId != appId {
 		//if rs.Applcation.SlbId != slbId && rs.Applcation.ExtSlbId != slbId {
-		return WrapError(Error("can not find appid:" + appId))
+		return DiagnosticsFromError(WrapError(Error("can not find appid:" + appId)))
 	}
 
 	request := edas.CreateListSlbRequest()
@@ -155,13 +157,13 @@ func resourceAlibabacloudStackEdasSlbAttachmentRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response := raw.(*edas.ListSlbResponse)
 	if response.Code != 200 {
-		return WrapError(Error("List Slb failed for " + response.Message))
+		return DiagnosticsFromError(WrapError(Error("List Slb failed for " + response.Message)))
 	}
 
 	for _, slb := range response.SlbList.SlbEntity {
@@ -175,8 +177,8 @@ func resourceAlibabacloudStackEdasSlbAttachmentRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEdasSlbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEdasSlbAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	edasService := EdasService{client}
 
 	request := edas.CreateUnbindSlbRequest()
@@ -192,13 +194,13 @@ func resourceAlibabacloudStackEdasSlbAttachmentDelete(d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_edas_slb_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 
 	response := raw.(*edas.UnbindSlbResponse)
 	if response.Code != 200 {
-		return WrapError(Error("unbind slb failed," + response.Message))
+		return DiagnosticsFromError(WrapError(Error("unbind slb failed," + response.Message)))
 	}
 
 	return nil
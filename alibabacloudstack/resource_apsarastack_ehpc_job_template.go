@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -9,16 +10,17 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEhpcJobTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEhpcJobTemplateCreate,
-		Read:   resourceAlibabacloudStackEhpcJobTemplateRead,
-		Update: resourceAlibabacloudStackEhpcJobTemplateUpdate,
-		Delete: resourceAlibabacloudStackEhpcJobTemplateDelete,
+		CreateContext: resourceAlibabacloudStackEhpcJobTemplateCreate,
+		ReadContext:   resourceAlibabacloudStackEhpcJobTemplateRead,
+		UpdateContext: resourceAlibabacloudStackEhpcJobTemplateUpdate,
+		DeleteContext: resourceAlibabacloudStackEhpcJobTemplateDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -96,14 +98,14 @@ func resourceAlibabacloudStackEhpcJobTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEhpcJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateJobTemplate"
 	request := make(map[string]interface{})
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("array_request"); ok {
 		request["ArrayRequest"] = v
@@ -153,7 +155,7 @@ func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta
 		request["Variables"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -166,15 +168,15 @@ func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ehpc_job_template", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ehpc_job_template", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["TemplateId"]))
 
-	return resourceAlibabacloudStackEhpcJobTemplateRead(d, meta)
+	return resourceAlibabacloudStackEhpcJobTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEhpcJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEhpcJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ehpcService := EhpcService{client}
 	object, err := ehpcService.DescribeEhpcJobTemplate(d.Id())
 	if err != nil {
@@ -183,7 +185,7 @@ func resourceAlibabacloudStackEhpcJobTemplateRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("array_request", object["ArrayRequest"])
 	d.Set("clock_time", object["ClockTime"])
@@ -219,8 +221,8 @@ func resourceAlibabacloudStackEhpcJobTemplateRead(d *schema.ResourceData, meta i
 	d.Set("variables", object["Variables"])
 	return nil
 }
-func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEhpcJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	request := map[string]interface{}{
 		"TemplateId": d.Id(),
@@ -293,10 +295,10 @@ func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta
 	action := "EditJobTemplate"
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -309,25 +311,25 @@ func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEhpcJobTemplateRead(d, meta)
+	return resourceAlibabacloudStackEhpcJobTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEhpcJobTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEhpcJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteJobTemplates"
 	var response map[string]interface{}
 	conn, err := client.NewEcsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"Templates": fmt.Sprintf("[{\"Id\":\"%s\"}]", d.Id()),
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -340,7 +342,7 @@ func resourceAlibabacloudStackEhpcJobTemplateDelete(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
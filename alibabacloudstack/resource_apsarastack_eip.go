@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,14 +16,20 @@ import (
 
 func resourceAlibabacloudStackEip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEipCreate,
-		Read:   resourceAlibabacloudStackEipRead,
-		Update: resourceAlibabacloudStackEipUpdate,
-		Delete: resourceAlibabacloudStackEipDelete,
+		CreateContext: resourceAlibabacloudStackEipCreate,
+		ReadContext:   resourceAlibabacloudStackEipRead,
+		UpdateContext: resourceAlibabacloudStackEipUpdate,
+		DeleteContext: resourceAlibabacloudStackEipDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -51,8 +59,8 @@ func resourceAlibabacloudStackEip() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateAllocateEipAddressRequest()
@@ -64,7 +72,7 @@ func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Bandwidth = strconv.Itoa(d.Get("bandwidth").(int))
 	request.ClientToken = buildClientToken(request.GetActionName())
 
@@ -72,21 +80,21 @@ func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}
 		return vpcClient.AllocateEipAddress(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_eip", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_eip", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*vpc.AllocateEipAddressResponse)
 	d.SetId(response.AllocationId)
-	err = vpcService.WaitForEip(d.Id(), Available, DefaultTimeoutMedium)
+	err = vpcService.WaitForEip(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackEipUpdate(d, meta)
+	return resourceAlibabacloudStackEipUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	object, err := vpcService.DescribeEip(d.Id())
@@ -95,7 +103,7 @@ func resourceAlibabacloudStackEipRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.Name)
@@ -113,13 +121,13 @@ func resourceAlibabacloudStackEipRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	if d.HasChange("tags") {
 		if err := vpcService.SetResourceTags(d, "EIP"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -132,7 +140,7 @@ func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AllocationId = d.Id()
 
 	if d.HasChange("bandwidth") && !d.IsNewResource() {
@@ -152,15 +160,15 @@ func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}
 			return vpcClient.ModifyEipAddressAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackEipRead(d, meta)
+	return resourceAlibabacloudStackEipRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateReleaseEipAddressRequest()
@@ -172,8 +180,8 @@ func resourceAlibabacloudStackEipDelete(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ReleaseEipAddress(request)
 		})
@@ -189,7 +197,7 @@ func resourceAlibabacloudStackEipDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpcService.WaitForEip(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForEip(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
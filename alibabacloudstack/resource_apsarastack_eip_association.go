@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -14,9 +16,17 @@ import (
 
 func resourceAlibabacloudStackEipAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEipAssociationCreate,
-		Read:   resourceAlibabacloudStackEipAssociationRead,
-		Delete: resourceAlibabacloudStackEipAssociationDelete,
+		CreateContext: resourceAlibabacloudStackEipAssociationCreate,
+		ReadContext:   resourceAlibabacloudStackEipAssociationRead,
+		DeleteContext: resourceAlibabacloudStackEipAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
@@ -47,8 +57,8 @@ func resourceAlibabacloudStackEipAssociation() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEipAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateAssociateEipAddressRequest()
@@ -59,7 +69,7 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AllocationId = Trim(d.Get("allocation_id").(string))
 	request.InstanceId = Trim(d.Get("instance_id").(string))
 	request.InstanceType = EcsInstance
@@ -74,7 +84,7 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 	if instanceType, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = instanceType.(string)
 	}
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.AssociateEipAddress(request)
 		})
@@ -87,11 +97,11 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_eip_association", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_eip_association", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	if err := vpcService.WaitForEip(request.AllocationId, InUse, 60); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForEip(request.AllocationId, InUse, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// There is at least 30 seconds delay for ecs instance
 	if request.InstanceType == EcsInstance {
@@ -100,12 +110,12 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 
 	d.SetId(request.AllocationId + ":" + request.InstanceId)
 
-	return resourceAlibabacloudStackEipAssociationRead(d, meta)
+	return resourceAlibabacloudStackEipAssociationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	object, err := vpcService.DescribeEipAssociation(d.Id())
@@ -114,7 +124,7 @@ func resourceAlibabacloudStackEipAssociationRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("instance_id", object.InstanceId)
 	d.Set("allocation_id", object.AllocationId)
@@ -123,16 +133,16 @@ func resourceAlibabacloudStackEipAssociationRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEipAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	allocationId, instanceId := parts[0], parts[1]
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := vpc.CreateUnassociateEipAddressRequest()
@@ -143,7 +153,7 @@ func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AllocationId = allocationId
 	request.InstanceId = instanceId
 	request.Force = requests.NewBoolean(d.Get("force").(bool))
@@ -159,7 +169,7 @@ func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta
 	if instanceType, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = instanceType.(string)
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateEipAddress(request)
 		})
@@ -174,7 +184,7 @@ func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpcService.WaitForEipAssociation(d.Id(), Available, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForEipAssociation(d.Id(), Available, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -69,6 +69,12 @@ func TestAccAlibabacloudStackEipAssociationBasic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
 		},
 	})
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/denverdino/aliyungo/common"
@@ -20,10 +22,10 @@ import (
 
 func resourceAlibabacloudStackElasticsearch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackElasticsearchCreate,
-		Read:   resourceAlibabacloudStackElasticsearchRead,
-		Update: resourceAlibabacloudStackElasticsearchUpdate,
-		Delete: resourceAlibabacloudStackElasticsearchDelete,
+		CreateContext: resourceAlibabacloudStackElasticsearchCreate,
+		ReadContext:   resourceAlibabacloudStackElasticsearchRead,
+		UpdateContext: resourceAlibabacloudStackElasticsearchUpdate,
+		DeleteContext: resourceAlibabacloudStackElasticsearchDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -239,8 +241,8 @@ func resourceAlibabacloudStackElasticsearch() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackElasticsearchCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 	action := "createInstance"
 
@@ -254,7 +256,7 @@ func resourceAlibabacloudStackElasticsearchCreate(d *schema.ResourceData, meta i
 
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, requestBody, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, errorCodeList) || NeedRetry(err) {
@@ -269,26 +271,26 @@ func resourceAlibabacloudStackElasticsearchCreate(d *schema.ResourceData, meta i
 
 	addDebug(action, response, nil)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_elasticsearch_instance", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_elasticsearch_instance", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	resp, err := jsonpath.Get("$.body.Result.instanceId", response)
 	if err != nil {
-		return WrapErrorf(err, FailedGetAttributeMsg, action, "$.body.Result.instanceId", response)
+		return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, action, "$.body.Result.instanceId", response))
 	}
 	d.SetId(resp.(string))
 
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackElasticsearchUpdate(d, meta)
+	return resourceAlibabacloudStackElasticsearchUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 
 	object, err := elasticsearchService.DescribeElasticsearchInstance(d.Id())
@@ -297,7 +299,7 @@ func resourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("description", object["description"])
@@ -353,7 +355,7 @@ func resourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta int
 	// tags
 	tags, err := elasticsearchService.DescribeElasticsearchTags(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(tags) > 0 {
 		d.Set("tags", tags)
@@ -362,8 +364,8 @@ func resourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
@@ -371,7 +373,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("description") {
 		if err := updateDescription(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("description")
@@ -383,7 +385,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["nodeType"] = string(WORKER)
 		content["whiteIpList"] = d.Get("private_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("private_whitelist")
@@ -395,7 +397,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["nodeType"] = string(WORKER)
 		content["actionType"] = elasticsearchService.getActionType(d.Get("enable_public").(bool))
 		if err := elasticsearchService.TriggerNetwork(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("enable_public")
@@ -407,7 +409,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["nodeType"] = string(WORKER)
 		content["whiteIpList"] = d.Get("public_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("public_whitelist")
@@ -419,7 +421,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["nodeType"] = string(KIBANA)
 		content["actionType"] = elasticsearchService.getActionType(d.Get("enable_kibana_public_network").(bool))
 		if err := elasticsearchService.TriggerNetwork(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("enable_kibana_public_network")
@@ -431,7 +433,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["nodeType"] = string(KIBANA)
 		content["whiteIpList"] = d.Get("kibana_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("kibana_whitelist")
@@ -443,7 +445,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["nodeType"] = string(KIBANA)
 		content["actionType"] = elasticsearchService.getActionType(d.Get("enable_kibana_private_network").(bool))
 		if err := elasticsearchService.TriggerNetwork(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("enable_kibana_private_network")
@@ -455,7 +457,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["nodeType"] = string(KIBANA)
 		content["whiteIpList"] = d.Get("kibana_private_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("kibana_private_whitelist")
@@ -463,7 +465,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("tags") {
 		if err := updateInstanceTags(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("tags")
@@ -471,12 +473,12 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("client_node_spec") || d.HasChange("client_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateClientNode(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateClientNode(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("client_node_spec")
@@ -485,11 +487,11 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("protocol") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		var https func(*schema.ResourceData, interface{}) error
+		var https func(context.Context, *schema.ResourceData, interface{}) error
 
 		if d.Get("protocol") == "HTTPS" {
 			https = openHttps
@@ -498,8 +500,8 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		}
 
 		if nil != https {
-			if err := https(d, meta); err != nil {
-				return WrapError(err)
+			if err := https(ctx, d, meta); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 
@@ -508,7 +510,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("setting_config") {
 		conn, err := client.NewElasticsearchClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		var response map[string]interface{}
 		action := "UpdateInstanceSettings"
@@ -525,7 +527,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		runtime.SetAutoretry(true)
 		// retry
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 
 			if err != nil {
@@ -540,31 +542,31 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		})
 
 		if err != nil && !IsExpectedErrors(err, []string{"MustChangeOneResource", "CssCheckUpdowngradeError"}) {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 		stateConf.PollInterval = 5 * time.Second
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("setting_config")
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackElasticsearchRead(d, meta)
+		return resourceAlibabacloudStackElasticsearchRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_charge_type") {
 		if err := updateInstanceChargeType(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("instance_charge_type")
 		//d.SetPartial("period")
 	} else if d.Get("instance_charge_type").(string) == string(PrePaid) && d.HasChange("period") {
 		if err := renewInstance(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("period")
@@ -572,12 +574,12 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("data_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateDataNodeAmount(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateDataNodeAmount(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("data_node_amount")
@@ -585,12 +587,12 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("data_node_spec") || d.HasChange("data_node_disk_size") || d.HasChange("data_node_disk_type") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateDataNodeSpec(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateDataNodeSpec(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("data_node_spec")
@@ -601,12 +603,12 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("master_node_spec") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateMasterNode(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateMasterNode(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("master_node_spec")
@@ -614,28 +616,28 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updatePassword(d, meta); err != nil {
-			return WrapError(err)
+		if err := updatePassword(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("password")
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackElasticsearchRead(d, meta)
+	return resourceAlibabacloudStackElasticsearchRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackElasticsearchDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 	action := "DeleteInstance"
 
 	if strings.ToLower(d.Get("instance_charge_type").(string)) == strings.ToLower(string(PrePaid)) {
-		return WrapError(Error("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically"))
+		return DiagnosticsFromError(WrapError(Error("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically")))
 	}
 	var response map[string]interface{}
 	request := map[string]interface{}{
@@ -652,7 +654,7 @@ func resourceAlibabacloudStackElasticsearchDelete(d *schema.ResourceData, meta i
 	errorCodeList := []string{"InstanceActivating", "TokenPreviousRequestProcessError"}
 	conn, err := client.NewElasticsearchClient()
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
 
 		if err != nil {
@@ -671,14 +673,14 @@ func resourceAlibabacloudStackElasticsearchDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"InstanceNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{"activating", "inactive", "active"}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err = stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	// Instance will be completed deleted in 5 minutes, so deleting vswitch is available after the time.
 	time.Sleep(5 * time.Minute)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"regexp"
@@ -9,13 +10,13 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/denverdino/aliyungo/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -24,10 +25,10 @@ import (
 
 func resourceAlibabacloudStackElasticsearchOnk8s() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackElasticsearchOnk8sCreate,
-		Read:   resourceAlibabacloudStackElasticsearchOnk8sRead,
-		Update: resourceAlibabacloudStackElasticsearchOnk8sUpdate,
-		Delete: resourceAlibabacloudStackElasticsearchOnk8sDelete,
+		CreateContext: resourceAlibabacloudStackElasticsearchOnk8sCreate,
+		ReadContext:   resourceAlibabacloudStackElasticsearchOnk8sRead,
+		UpdateContext: resourceAlibabacloudStackElasticsearchOnk8sUpdate,
+		DeleteContext: resourceAlibabacloudStackElasticsearchOnk8sDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -243,8 +244,8 @@ func resourceAlibabacloudStackElasticsearchOnk8s() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchOnk8sCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 	action := "createInstance"
 	requestBody, _ := buildElasticsearchOnk8sCreateRequestBody(d, meta)
@@ -272,33 +273,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, m
 
 	// response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, Params, &runtime)
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "elasticsearch-k8s",
-		"Action":          "createInstance",
-		"Version":         "2017-06-13",
-		"X-acs-body":      bodydata,
-		"ClientToken":     buildClientToken("createInstance"),
-		"OrganizationId":  client.Department,
-	}
-	request.Method = "POST"
-	request.Product = "elasticsearch-k8s"
-	request.Version = "2017-06-13"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "createInstance"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId, "Content-Type": "application/json"}
+	request := client.NewCommonRequest("POST", "elasticsearch-k8s", "2017-06-13", "createInstance", "")
+	request.Headers["Content-Type"] = "application/json"
+	request.QueryParams["X-acs-body"] = bodydata
+	request.QueryParams["ClientToken"] = buildClientToken("createInstance")
+	request.QueryParams["OrganizationId"] = client.Department
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -307,7 +286,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, m
 	addDebug(action, raw, request.QueryParams)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_elasticsearch_k8s_instance", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_elasticsearch_k8s_instance", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	var resp CreateElasticsearchOnk8sResponse
@@ -316,7 +295,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, m
 	err = json.Unmarshal(response.GetHttpContentBytes(), &resp)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "apsarastack_elasticsearch_on_k8s_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "apsarastack_elasticsearch_on_k8s_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	Result := resp.Result
 	log.Printf("############## Result : %s", Result)
@@ -325,15 +304,15 @@ func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, m
 
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, elasticsearchService.ElasticsearchOnK8sStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackElasticsearchOnk8sRead(d, meta)
+	return resourceAlibabacloudStackElasticsearchOnk8sRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchOnk8sRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 
 	object, err := elasticsearchService.DescribeElasticsearchOnk8sInstance(d.Id())
@@ -342,10 +321,10 @@ func resourceAlibabacloudStackElasticsearchOnk8sRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if (object["instanceId"].(string)) != d.Id() {
-		return WrapErrorf(Error(GetNotFoundMessage("ElasticsearchOnK8s Instance", d.Id())), NotFoundWithResponse)
+		return DiagnosticsFromError(WrapErrorf(Error(GetNotFoundMessage("ElasticsearchOnK8s Instance", d.Id())), NotFoundWithResponse))
 	}
 
 	d.Set("description", object["description"])
@@ -410,8 +389,8 @@ func resourceAlibabacloudStackElasticsearchOnk8sRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchOnk8sUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchOnK8sStateRefreshFunc(d.Id(), []string{"inactive"}))
@@ -419,7 +398,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("description") {
 		if err := updateDescription(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("description")
@@ -431,7 +410,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		content["nodeType"] = string(WORKER)
 		content["whiteIpList"] = d.Get("private_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("private_whitelist")
@@ -455,7 +434,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		content["nodeType"] = string(WORKER)
 		content["whiteIpList"] = d.Get("public_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("public_whitelist")
@@ -479,7 +458,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		content["nodeType"] = string(KIBANA)
 		content["whiteIpList"] = d.Get("kibana_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("kibana_whitelist")
@@ -503,7 +482,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		content["nodeType"] = string(KIBANA)
 		content["whiteIpList"] = d.Get("kibana_private_whitelist").(*schema.Set).List()
 		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("kibana_private_whitelist")
@@ -511,7 +490,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("tags") {
 		if err := updateInstanceTags(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("tags")
@@ -519,12 +498,12 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("client_node_spec") || d.HasChange("client_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateClientNode(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateClientNode(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("client_node_spec")
@@ -556,7 +535,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 	if d.HasChange("setting_config") {
 		conn, err := client.NewElasticsearchClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		var response map[string]interface{}
 		action := "UpdateInstanceSettings"
@@ -573,7 +552,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		runtime.SetAutoretry(true)
 		// retry
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 
 			if err != nil {
@@ -588,31 +567,31 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		})
 
 		if err != nil && !IsExpectedErrors(err, []string{"MustChangeOneResource", "CssCheckUpdowngradeError"}) {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchOnK8sStateRefreshFunc(d.Id(), []string{"inactive"}))
 		stateConf.PollInterval = 5 * time.Second
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("setting_config")
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackElasticsearchOnk8sRead(d, meta)
+		return resourceAlibabacloudStackElasticsearchOnk8sRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_charge_type") {
 		if err := updateInstanceChargeType(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("instance_charge_type")
 		//d.SetPartial("period")
 	} else if d.Get("instance_charge_type").(string) == string(PrePaid) && d.HasChange("period") {
 		if err := renewInstance(d, meta); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("period")
@@ -620,12 +599,12 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("data_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateDataNodeAmount(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateDataNodeAmount(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("data_node_amount")
@@ -633,12 +612,12 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("data_node_spec") || d.HasChange("data_node_disk_size") || d.HasChange("data_node_disk_type") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateDataNodeSpec(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateDataNodeSpec(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("data_node_spec")
@@ -649,12 +628,12 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("master_node_spec") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updateMasterNode(d, meta); err != nil {
-			return WrapError(err)
+		if err := updateMasterNode(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("master_node_spec")
@@ -662,12 +641,12 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if err := updatePassword(d, meta); err != nil {
-			return WrapError(err)
+		if err := updatePassword(ctx, d, meta); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("password")
@@ -677,13 +656,13 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackElasticsearchOnk8sDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	elasticsearchService := ElasticsearchService{client}
 	action := "DeleteInstance"
 
 	if strings.ToLower(d.Get("instance_charge_type").(string)) == strings.ToLower(string(PrePaid)) {
-		return WrapError(Error("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically"))
+		return DiagnosticsFromError(WrapError(Error("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically")))
 	}
 	var response map[string]interface{}
 	request := map[string]interface{}{
@@ -700,7 +679,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sDelete(d *schema.ResourceData, m
 	errorCodeList := []string{"InstanceActivating", "TokenPreviousRequestProcessError"}
 	conn, err := client.NewElasticsearchClient()
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
 
 		if err != nil {
@@ -719,14 +698,14 @@ func resourceAlibabacloudStackElasticsearchOnk8sDelete(d *schema.ResourceData, m
 		if IsExpectedErrors(err, []string{"InstanceNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutDelete), 5*time.Minute, elasticsearchService.ElasticsearchOnK8sStateRefreshFunc(d.Id(), []string{}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err = stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	// Instance will be completed deleted in 5 minutes, so deleting vswitch is available after the time.
 	time.Sleep(5 * time.Minute)
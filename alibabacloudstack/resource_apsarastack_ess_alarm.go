@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,14 +18,20 @@ import (
 
 func resourceAlibabacloudStackEssAlarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssAlarmCreate,
-		Read:   resourceAlibabacloudStackEssAlarmRead,
-		Update: resourceAlibabacloudStackEssAlarmUpdate,
-		Delete: resourceAlibabacloudStackEssAlarmDelete,
+		CreateContext: resourceAlibabacloudStackEssAlarmCreate,
+		ReadContext:   resourceAlibabacloudStackEssAlarmRead,
+		UpdateContext: resourceAlibabacloudStackEssAlarmUpdate,
+		DeleteContext: resourceAlibabacloudStackEssAlarmDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -112,25 +120,25 @@ func resourceAlibabacloudStackEssAlarm() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	request, err := buildAlibabacloudStackEssAlarmArgs(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Domain = client.Domain
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
 		request.Scheme = "http"
 	}
 	var raw interface{}
-	if err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err = client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateAlarm(request)
 		})
@@ -143,7 +151,7 @@ func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interf
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_alarm", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_alarm", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	response, _ := raw.(*ess.CreateAlarmResponse)
 	d.SetId(response.AlarmTaskId)
@@ -157,15 +165,15 @@ func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interf
 			return essClient.DisableAlarm(disableAlarmRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), disableAlarmRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), disableAlarmRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(disableAlarmRequest.GetActionName(), raw, disableAlarmRequest.RpcRequest, disableAlarmRequest)
 	}
-	return resourceAlibabacloudStackEssAlarmRead(d, meta)
+	return resourceAlibabacloudStackEssAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssAlarmRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	object, err := essService.DescribeEssAlarm(d.Id())
@@ -174,7 +182,7 @@ func resourceAlibabacloudStackEssAlarmRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.Name)
@@ -201,20 +209,20 @@ func resourceAlibabacloudStackEssAlarmRead(d *schema.ResourceData, meta interfac
 	}
 
 	if err := d.Set("dimensions", essService.flattenDimensionsToMap(dims)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateModifyAlarmRequest()
 	request.AlarmTaskId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Domain = client.Domain
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -263,7 +271,7 @@ func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interf
 	createAlarmDimensions := make([]ess.ModifyAlarmDimension, 0, len(dimensions))
 	for k, v := range dimensions {
 		if k == UserId || k == ScalingGroup {
-			return WrapError(Error("Invalide dimension keys, %s", k))
+			return DiagnosticsFromError(WrapError(Error("Invalide dimension keys, %s", k)))
 		}
 		if k != "" {
 			dimension := ess.ModifyAlarmDimension{
@@ -279,7 +287,7 @@ func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interf
 		return essClient.ModifyAlarm(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	//d.SetPartial("name")
 	//d.SetPartial("description")
@@ -302,7 +310,7 @@ func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interf
 				return essClient.EnableAlarm(enableAlarmRequest)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), enableAlarmRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), enableAlarmRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(enableAlarmRequest.GetActionName(), raw)
 		} else {
@@ -312,25 +320,25 @@ func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interf
 				return essClient.DisableAlarm(disableAlarmRequest)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), disableAlarmRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), disableAlarmRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(disableAlarmRequest.GetActionName(), raw)
 		}
 		//d.SetPartial("enable")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEssAlarmRead(d, meta)
+	return resourceAlibabacloudStackEssAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssAlarmDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	request := ess.CreateDeleteAlarmRequest()
 	request.AlarmTaskId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Domain = client.Domain
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -343,10 +351,10 @@ func resourceAlibabacloudStackEssAlarmDelete(d *schema.ResourceData, meta interf
 		if IsExpectedErrors(err, []string{"404"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(essService.WaitForEssAlarm(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForEssAlarm(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildAlibabacloudStackEssAlarmArgs(d *schema.ResourceData) (*ess.CreateAlarmRequest, error) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -9,20 +10,27 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudstackEssAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackEssAttachmentCreate,
-		Read:   resourceAlibabacloudstackEssAttachmentRead,
-		Update: resourceAlibabacloudstackEssAttachmentUpdate,
-		Delete: resourceAlibabacloudstackEssAttachmentDelete,
+		CreateContext: resourceAlibabacloudstackEssAttachmentCreate,
+		ReadContext:   resourceAlibabacloudstackEssAttachmentRead,
+		UpdateContext: resourceAlibabacloudstackEssAttachmentUpdate,
+		DeleteContext: resourceAlibabacloudstackEssAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -47,27 +55,27 @@ func resourceAlibabacloudstackEssAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackEssAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId(d.Get("scaling_group_id").(string))
-	return resourceAlibabacloudstackEssAttachmentUpdate(d, meta)
+	return resourceAlibabacloudstackEssAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudstackEssAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	d.Partial(true)
 
 	if d.HasChange("instance_ids") {
 		object, err := essService.DescribeEssScalingGroup(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if object.LifecycleState == string(Inactive) {
-			return WrapError(Error("Scaling group current status is %s, please active it before attaching or removing ECS instances.", object.LifecycleState))
+			return DiagnosticsFromError(WrapError(Error("Scaling group current status is %s, please active it before attaching or removing ECS instances.", object.LifecycleState)))
 		} else {
-			if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, DefaultTimeout); err != nil {
-				return WrapError(err)
+			if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		o, n := d.GetChange("instance_ids")
@@ -85,12 +93,12 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 			request.ScalingGroupId = d.Id()
 			s := reflect.ValueOf(request).Elem()
 
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				for i, id := range add {
 					s.FieldByName(fmt.Sprintf("InstanceId%d", i+1)).Set(reflect.ValueOf(id))
 				}
@@ -147,10 +155,10 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 				return nil
 			})
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 
-			err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 
 				instances, err := essService.DescribeEssAttachment(d.Id(), add)
 				if err != nil {
@@ -168,12 +176,12 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 				return nil
 			})
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		if len(remove) > 0 {
 			if err := essService.EssRemoveInstances(d.Id(), convertArrayInterfaceToArrayString(remove)); err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 
@@ -182,12 +190,12 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudstackEssAttachmentRead(d, meta)
+	return resourceAlibabacloudstackEssAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackEssAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	object, err := essService.DescribeEssAttachment(d.Id(), make([]string, 0))
 
@@ -196,7 +204,7 @@ func resourceAlibabacloudstackEssAttachmentRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var instanceIds []string
@@ -210,8 +218,8 @@ func resourceAlibabacloudstackEssAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudstackEssAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	removed := convertArrayInterfaceToArrayString(d.Get("instance_ids").(*schema.Set).List())
@@ -221,17 +229,17 @@ func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta i
 	}
 	object, err := essService.DescribeEssScalingGroup(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, DefaultTimeout); err != nil {
+	if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request := ess.CreateRemoveInstancesRequest()
 		request.RegionId = client.RegionId
 		if strings.ToLower(client.Config.Protocol) == "https" {
@@ -240,7 +248,7 @@ func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta i
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.ScalingGroupId = d.Id()
 
@@ -291,10 +299,10 @@ func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta i
 
 		return nil
 	}); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return WrapError(essService.WaitForEssAttachment(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForEssAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func convertArrayInterfaceToArrayString(elm []interface{}) (arr []string) {
@@ -0,0 +1,125 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackEssInstanceProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackEssInstanceProtectionCreate,
+		ReadContext:   resourceAlibabacloudStackEssInstanceProtectionRead,
+		UpdateContext: resourceAlibabacloudStackEssInstanceProtectionUpdate,
+		DeleteContext: resourceAlibabacloudStackEssInstanceProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackEssInstanceProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("scaling_group_id").(string))
+	return resourceAlibabacloudStackEssInstanceProtectionUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackEssInstanceProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	essService := EssService{client}
+
+	instances, err := essService.DescribeEssAttachment(d.Id(), expandStringList(d.Get("instance_ids").(*schema.Set).List()))
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	var instanceIds []string
+	for _, instance := range instances {
+		if instance.LifecycleState == "Protected" {
+			instanceIds = append(instanceIds, instance.InstanceId)
+		}
+	}
+	if len(instanceIds) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("scaling_group_id", d.Id())
+	d.Set("instance_ids", instanceIds)
+	return nil
+}
+
+func resourceAlibabacloudStackEssInstanceProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	essService := EssService{client}
+
+	o, n := d.GetChange("instance_ids")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+	if remove := expandStringList(os.Difference(ns).List()); len(remove) > 0 {
+		if err := essSetInstancesProtection(client, d.Id(), remove, false); err != nil {
+			return DiagnosticsFromError(err)
+		}
+	}
+	if add := expandStringList(ns.Difference(os).List()); len(add) > 0 {
+		if err := essService.CheckEssInstancesNotIn(d.Id(), add, "Standby"); err != nil {
+			return DiagnosticsFromError(err)
+		}
+		if err := essSetInstancesProtection(client, d.Id(), add, true); err != nil {
+			return DiagnosticsFromError(err)
+		}
+	}
+
+	return resourceAlibabacloudStackEssInstanceProtectionRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackEssInstanceProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	if err := essSetInstancesProtection(client, d.Id(), expandStringList(d.Get("instance_ids").(*schema.Set).List()), false); err != nil {
+		if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(err)
+	}
+	return nil
+}
+
+func essSetInstancesProtection(client *connectivity.AlibabacloudStackClient, scalingGroupId string, instanceIds []string, protected bool) error {
+	request := ess.CreateSetInstancesProtectionRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.ScalingGroupId = scalingGroupId
+	request.InstanceId = &instanceIds
+	request.ProtectedFromScaleIn = requests.NewBoolean(protected)
+
+	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+		return essClient.SetInstancesProtection(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, scalingGroupId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
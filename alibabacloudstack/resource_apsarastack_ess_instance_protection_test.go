@@ -0,0 +1,121 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackEssInstanceProtection_basic(t *testing.T) {
+	var v ess.ScalingGroup
+	resourceId := "alibabacloudstack_ess_instance_protection.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"scaling_group_id": CHECKSET,
+	})
+	serviceFunc := func() interface{} {
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeEssScalingGroup")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 999999)
+	name := fmt.Sprintf("tf-testAccEssInstanceProtection-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEssScalingInstancesConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"scaling_group_id": "${alibabacloudstack_ess_attachment.default.scaling_group_id}",
+					"instance_ids":     []string{"${alibabacloudstack_instance.default.0.id}"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_ids.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_ids": []string{"${alibabacloudstack_instance.default.0.id}", "${alibabacloudstack_instance.default.1.id}"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_ids.#": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_ids": []string{"${alibabacloudstack_instance.default.1.id}"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_ids.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// resourceEssScalingInstancesConfigDependence attaches two instances to a scaling group, for the
+// resources that change the lifecycle state of the instances of a scaling group.
+func resourceEssScalingInstancesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+variable "name" {
+	default = "%s"
+}
+
+resource "alibabacloudstack_ess_scaling_group" "default" {
+	min_size = 0
+	max_size = 2
+	scaling_group_name = "${var.name}"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+	vswitch_ids = ["${alibabacloudstack_vswitch.default.id}"]
+}
+
+resource "alibabacloudstack_ess_scaling_configuration" "default" {
+	scaling_group_id = "${alibabacloudstack_ess_scaling_group.default.id}"
+	image_id = "${data.alibabacloudstack_images.default.images.0.id}"
+	instance_type = "${local.instance_type_id}"
+	security_group_ids = ["${alibabacloudstack_security_group.default.id}"]
+	active = true
+	enable = true
+	force_delete = true
+}
+
+resource "alibabacloudstack_instance" "default" {
+	count = 2
+	vswitch_id = "${alibabacloudstack_vswitch.default.id}"
+	image_id = "${data.alibabacloudstack_images.default.images.0.id}"
+	instance_type = "${local.instance_type_id}"
+	instance_name = "${var.name}"
+	system_disk_category = "cloud_efficiency"
+	security_groups = ["${alibabacloudstack_security_group.default.id}"]
+}
+
+resource "alibabacloudstack_ess_attachment" "default" {
+	scaling_group_id = "${alibabacloudstack_ess_scaling_configuration.default.scaling_group_id}"
+	instance_ids = "${alibabacloudstack_instance.default.*.id}"
+	force = true
+}
+`, EcsInstanceCommonTestCase, name)
+}
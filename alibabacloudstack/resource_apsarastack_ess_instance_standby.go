@@ -0,0 +1,178 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackEssInstanceStandby() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackEssInstanceStandbyCreate,
+		ReadContext:   resourceAlibabacloudStackEssInstanceStandbyRead,
+		UpdateContext: resourceAlibabacloudStackEssInstanceStandbyUpdate,
+		DeleteContext: resourceAlibabacloudStackEssInstanceStandbyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackEssInstanceStandbyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("scaling_group_id").(string))
+	return resourceAlibabacloudStackEssInstanceStandbyUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackEssInstanceStandbyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	essService := EssService{client}
+
+	instances, err := essService.DescribeEssAttachment(d.Id(), expandStringList(d.Get("instance_ids").(*schema.Set).List()))
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	var instanceIds []string
+	for _, instance := range instances {
+		if instance.LifecycleState == "Standby" {
+			instanceIds = append(instanceIds, instance.InstanceId)
+		}
+	}
+	if len(instanceIds) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("scaling_group_id", d.Id())
+	d.Set("instance_ids", instanceIds)
+	return nil
+}
+
+func resourceAlibabacloudStackEssInstanceStandbyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	essService := EssService{client}
+
+	o, n := d.GetChange("instance_ids")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+	if remove := expandStringList(os.Difference(ns).List()); len(remove) > 0 {
+		if err := essExitStandby(ctx, client, d.Id(), remove, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return DiagnosticsFromError(err)
+		}
+	}
+	if add := expandStringList(ns.Difference(os).List()); len(add) > 0 {
+		if err := essService.CheckEssInstancesNotIn(d.Id(), add, "Protected"); err != nil {
+			return DiagnosticsFromError(err)
+		}
+		if err := essEnterStandby(ctx, client, d.Id(), add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return DiagnosticsFromError(err)
+		}
+	}
+
+	return resourceAlibabacloudStackEssInstanceStandbyRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackEssInstanceStandbyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	if err := essExitStandby(ctx, client, d.Id(), expandStringList(d.Get("instance_ids").(*schema.Set).List()), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(err)
+	}
+	return nil
+}
+
+func essEnterStandby(ctx context.Context, client *connectivity.AlibabacloudStackClient, scalingGroupId string, instanceIds []string, timeout time.Duration) error {
+	essService := EssService{client}
+	request := ess.CreateEnterStandbyRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.ScalingGroupId = scalingGroupId
+	request.InstanceId = &instanceIds
+
+	// Instances can not enter standby while a scaling activity is in progress.
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			return essClient.EnterStandby(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"ScalingActivityInProgress", "IncorrectScalingGroupStatus", Throttling}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, scalingGroupId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"InService", "EnteringStandby", "Mixed"}, []string{"Standby"}, timeout, 5*time.Second, essService.EssScalingInstancesStateRefreshFunc(scalingGroupId, instanceIds, []string{"Removing"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, scalingGroupId)
+	}
+	return nil
+}
+
+func essExitStandby(ctx context.Context, client *connectivity.AlibabacloudStackClient, scalingGroupId string, instanceIds []string, timeout time.Duration) error {
+	essService := EssService{client}
+	request := ess.CreateExitStandbyRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.ScalingGroupId = scalingGroupId
+	request.InstanceId = &instanceIds
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			return essClient.ExitStandby(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"ScalingActivityInProgress", "IncorrectScalingGroupStatus", Throttling}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, scalingGroupId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Standby", "ExitingStandby", "Mixed"}, []string{"InService", ""}, timeout, 5*time.Second, essService.EssScalingInstancesStateRefreshFunc(scalingGroupId, instanceIds, []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, scalingGroupId)
+	}
+	return nil
+}
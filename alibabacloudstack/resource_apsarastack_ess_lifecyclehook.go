@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackEssLifecycleHook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssLifeCycleHookCreate,
-		Read:   resourceAlibabacloudStackEssLifeCycleHookRead,
-		Update: resourceAlibabacloudStackEssLifeCycleHookUpdate,
-		Delete: resourceAlibabacloudStackEssLifeCycleHookDelete,
+		CreateContext: resourceAlibabacloudStackEssLifeCycleHookCreate,
+		ReadContext:   resourceAlibabacloudStackEssLifeCycleHookRead,
+		UpdateContext: resourceAlibabacloudStackEssLifeCycleHookUpdate,
+		DeleteContext: resourceAlibabacloudStackEssLifeCycleHookDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -66,10 +74,10 @@ func resourceAlibabacloudStackEssLifecycleHook() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	request := buildAlibabacloudStackEssLifeCycleHookArgs(d)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -77,9 +85,9 @@ func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, met
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateLifecycleHook(request)
 		})
@@ -94,16 +102,16 @@ func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, met
 		d.SetId(response.LifecycleHookId)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_lifecyclehook", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_lifecyclehook", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEssLifeCycleHookRead(d, meta)
+	return resourceAlibabacloudStackEssLifeCycleHookRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	object, err := essService.DescribeEssLifecycleHook(d.Id())
@@ -112,7 +120,7 @@ func resourceAlibabacloudStackEssLifeCycleHookRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("scaling_group_id", object.ScalingGroupId)
@@ -126,9 +134,9 @@ func resourceAlibabacloudStackEssLifeCycleHookRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateModifyLifecycleHookRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -139,7 +147,7 @@ func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, met
 	request.LifecycleHookId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("lifecycle_transition") {
 		request.LifecycleTransition = d.Get("lifecycle_transition").(string)
@@ -165,15 +173,15 @@ func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, met
 		return essClient.ModifyLifecycleHook(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssLifeCycleHookRead(d, meta)
+	return resourceAlibabacloudStackEssLifeCycleHookRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	request := ess.CreateDeleteLifecycleHookRequest()
 	request.LifecycleHookId = d.Id()
@@ -191,7 +199,7 @@ func resourceAlibabacloudStackEssLifeCycleHookDelete(d *schema.ResourceData, met
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteLifecycleHook(request)
@@ -200,11 +208,11 @@ func resourceAlibabacloudStackEssLifeCycleHookDelete(d *schema.ResourceData, met
 		if IsExpectedErrors(err, []string{"InvalidLifecycleHookId.NotExist"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForEssLifecycleHook(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForEssLifecycleHook(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 
 }
 
@@ -1,23 +1,31 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEssNotification() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssNotificationCreate,
-		Read:   resourceAlibabacloudStackEssNotificationRead,
-		Update: resourceAlibabacloudStackEssNotificationUpdate,
-		Delete: resourceAlibabacloudStackEssNotificationDelete,
+		CreateContext: resourceAlibabacloudStackEssNotificationCreate,
+		ReadContext:   resourceAlibabacloudStackEssNotificationRead,
+		UpdateContext: resourceAlibabacloudStackEssNotificationUpdate,
+		DeleteContext: resourceAlibabacloudStackEssNotificationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"notification_arn": {
 				Type:     schema.TypeString,
@@ -39,8 +47,8 @@ func resourceAlibabacloudStackEssNotification() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssNotificationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := ess.CreateCreateNotificationConfigurationRequest()
 	request.RegionId = client.RegionId
@@ -51,7 +59,7 @@ func resourceAlibabacloudStackEssNotificationCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = d.Get("scaling_group_id").(string)
 	request.NotificationArn = d.Get("notification_arn").(string)
@@ -72,16 +80,16 @@ func resourceAlibabacloudStackEssNotificationCreate(d *schema.ResourceData, meta
 		return essClient.CreateNotificationConfiguration(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_notification", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_notification", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	d.SetId(fmt.Sprintf("%s:%s", request.ScalingGroupId, request.NotificationArn))
-	return resourceAlibabacloudStackEssNotificationRead(d, meta)
+	return resourceAlibabacloudStackEssNotificationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssNotificationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	object, err := essService.DescribeEssNotification(d.Id())
 	if err != nil {
@@ -89,7 +97,7 @@ func resourceAlibabacloudStackEssNotificationRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("scaling_group_id", object.ScalingGroupId)
 	d.Set("notification_arn", object.NotificationArn)
@@ -97,8 +105,8 @@ func resourceAlibabacloudStackEssNotificationRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackEssNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateModifyNotificationConfigurationRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -107,7 +115,7 @@ func resourceAlibabacloudStackEssNotificationUpdate(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	parts := strings.SplitN(d.Id(), ":", 2)
 	request.ScalingGroupId = parts[0]
@@ -129,14 +137,14 @@ func resourceAlibabacloudStackEssNotificationUpdate(d *schema.ResourceData, meta
 		return essClient.ModifyNotificationConfiguration(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssNotificationRead(d, meta)
+	return resourceAlibabacloudStackEssNotificationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssNotificationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	request := ess.CreateDeleteNotificationConfigurationRequest()
 	request.RegionId = client.RegionId
@@ -146,7 +154,7 @@ func resourceAlibabacloudStackEssNotificationDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	parts := strings.SplitN(d.Id(), ":", 2)
 
@@ -160,8 +168,8 @@ func resourceAlibabacloudStackEssNotificationDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"NotificationConfigurationNotExist", "InvalidScalingGroupId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(essService.WaitForEssNotification(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForEssNotification(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,14 +20,20 @@ import (
 
 func resourceAlibabacloudStackEssScalingConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingConfigurationCreate,
-		Read:   resourceAlibabacloudStackEssScalingConfigurationRead,
-		Update: resourceAlibabacloudStackEssScalingConfigurationUpdate,
-		Delete: resourceAlibabacloudStackEssScalingConfigurationDelete,
+		CreateContext: resourceAlibabacloudStackEssScalingConfigurationCreate,
+		ReadContext:   resourceAlibabacloudStackEssScalingConfigurationRead,
+		UpdateContext: resourceAlibabacloudStackEssScalingConfigurationUpdate,
+		DeleteContext: resourceAlibabacloudStackEssScalingConfigurationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"active": {
 				Type:     schema.TypeBool,
@@ -208,10 +216,10 @@ func resourceAlibabacloudStackEssScalingConfiguration() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	// Ensure instance_type is generation three
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request, err := buildAlibabacloudStackEssScalingConfigurationArgs(d, meta)
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -219,7 +227,7 @@ func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceDa
 		request.Scheme = "http"
 	}
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request.IoOptimized = string(IOOptimized)
@@ -227,7 +235,7 @@ func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceDa
 		request.IoOptimized = string(NoneOptimized)
 	}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateScalingConfiguration(request)
 		})
@@ -242,14 +250,14 @@ func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceDa
 		d.SetId(response.ScalingConfigurationId)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalingconfiguration", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalingconfiguration", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackEssScalingConfigurationUpdate(d, meta)
+	return resourceAlibabacloudStackEssScalingConfigurationUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssScalingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	d.Partial(true)
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -263,7 +271,7 @@ func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceDa
 				d.SetId("")
 				return nil
 			}
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		if d.Get("active").(bool) {
@@ -271,14 +279,14 @@ func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceDa
 
 				err := essService.ActiveEssScalingConfiguration(c.ScalingGroupId, d.Id())
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 		} else {
 			if c.LifecycleState == string(Active) {
 				_, err := activeSubstituteScalingConfiguration(d, meta)
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 		}
@@ -286,16 +294,16 @@ func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceDa
 	}
 
 	if err := enableEssScalingConfiguration(d, meta); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := modifyEssScalingConfiguration(d, meta); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackEssScalingConfigurationRead(d, meta)
+	return resourceAlibabacloudStackEssScalingConfigurationRead(ctx, d, meta)
 }
 
 func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) error {
@@ -307,7 +315,7 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingConfigurationId = d.Id()
 
@@ -489,7 +497,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					request.Scheme = "http"
 				}
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.ScalingGroupId = sgId
 				request.ActiveScalingConfigurationId = activeConfig
@@ -501,7 +509,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-				if err := essService.WaitForEssScalingGroup(sgId, Active, DefaultTimeout); err != nil {
+				if err := essService.WaitForEssScalingGroup(sgId, Active, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 					return WrapError(err)
 				}
 
@@ -517,7 +525,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					request.Scheme = "http"
 				}
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.ScalingGroupId = sgId
 				raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
@@ -527,7 +535,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-				if err := essService.WaitForEssScalingGroup(sgId, Inactive, DefaultTimeout); err != nil {
+				if err := essService.WaitForEssScalingGroup(sgId, Inactive, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -538,10 +546,10 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(strings.Split(d.Id(), COLON_SEPARATED)[1])
@@ -552,7 +560,7 @@ func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("scaling_group_id", object.ScalingGroupId)
@@ -595,8 +603,8 @@ func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssScalingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -608,7 +616,7 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := ess.CreateDescribeScalingConfigurationsRequest()
@@ -619,7 +627,7 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = object.ScalingGroupId
 
@@ -627,7 +635,7 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 		return essClient.DescribeScalingConfigurations(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ess.DescribeScalingConfigurationsResponse)
@@ -642,13 +650,13 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 			request.ScalingGroupId = object.ScalingGroupId
 			request.ForceDelete = requests.NewBoolean(true)
 			request.RegionId = client.RegionId
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 			raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 				return essClient.DeleteScalingGroup(request)
@@ -658,13 +666,13 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 				if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
 					return nil
 				}
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, DefaultTimeout))
+			return DiagnosticsFromError(WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 		}
-		return WrapError(Error("Current scaling configuration %s is the last configuration for the scaling group %s. Please launch a new "+
-			"active scaling configuration or set 'force_delete' to 'true' to delete it with deleting its scaling group.", d.Id(), object.ScalingGroupId))
+		return DiagnosticsFromError(WrapError(Error("Current scaling configuration %s is the last configuration for the scaling group %s. Please launch a new "+
+			"active scaling configuration or set 'force_delete' to 'true' to delete it with deleting its scaling group.", d.Id(), object.ScalingGroupId)))
 	}
 
 	deleteScalingConfigurationRequest := ess.CreateDeleteScalingConfigurationRequest()
@@ -675,7 +683,7 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 		deleteScalingConfigurationRequest.Scheme = "http"
 	}
 	deleteScalingConfigurationRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	deleteScalingConfigurationRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	deleteScalingConfigurationRequest.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	rawDeleteScalingConfiguration, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteScalingConfiguration(deleteScalingConfigurationRequest)
@@ -685,11 +693,11 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 		if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound", "InvalidScalingConfigurationId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), rawDeleteScalingConfiguration, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForScalingConfiguration(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForScalingConfiguration(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildAlibabacloudStackEssScalingConfigurationArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingConfigurationRequest, error) {
@@ -698,7 +706,7 @@ func buildAlibabacloudStackEssScalingConfigurationArgs(d *schema.ResourceData, m
 	request := ess.CreateCreateScalingConfigurationRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "ZoneId": d.Get("zone_id").(string)}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "ZoneId": d.Get("zone_id").(string)}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -831,7 +839,7 @@ func activeSubstituteScalingConfiguration(d *schema.ResourceData, meta interface
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = c.ScalingGroupId
 
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"reflect"
@@ -18,14 +20,20 @@ import (
 
 func resourceAlibabacloudStackEssScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingGroupCreate,
-		Read:   resourceAlibabacloudStackEssScalingGroupRead,
-		Update: resourceAlibabacloudStackEssScalingGroupUpdate,
-		Delete: resourceAlibabacloudStackEssScalingGroupDelete,
+		CreateContext: resourceAlibabacloudStackEssScalingGroupCreate,
+		ReadContext:   resourceAlibabacloudStackEssScalingGroupRead,
+		UpdateContext: resourceAlibabacloudStackEssScalingGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackEssScalingGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"min_size": {
 				Type:         schema.TypeInt,
@@ -74,26 +82,53 @@ func resourceAlibabacloudStackEssScalingGroup() *schema.Resource {
 				Optional: true,
 				MinItems: 0,
 			},
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_template_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"health_check_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ECS", "NONE"}, false),
+			},
+			"multi_az_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PRIORITY", "BALANCE", "COST_OPTIMIZED"}, false),
+			},
+			"group_deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
-func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	request, err := buildAlibabacloudStackEssScalingGroupArgs(d, meta)
 
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
 		request.Scheme = "http"
 	}
 	essService := EssService{client}
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateScalingGroup(request)
 		})
@@ -108,19 +143,19 @@ func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta
 		d.SetId(response.ScalingGroupId)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalinggroup", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalinggroup", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	if err := essService.WaitForEssScalingGroup(d.Id(), Inactive, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := essService.WaitForEssScalingGroup(d.Id(), Inactive, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackEssScalingGroupUpdate(d, meta)
+	return resourceAlibabacloudStackEssScalingGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	object, err := essService.DescribeEssScalingGroup(d.Id())
@@ -129,7 +164,7 @@ func resourceAlibabacloudStackEssScalingGroupRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("min_size", object.MinSize)
@@ -166,13 +201,18 @@ func resourceAlibabacloudStackEssScalingGroupRead(d *schema.ResourceData, meta i
 		}
 	}
 	d.Set("vswitch_ids", vswitchIds)
+	d.Set("launch_template_id", object.LaunchTemplateId)
+	d.Set("launch_template_version", object.LaunchTemplateVersion)
+	d.Set("health_check_type", object.HealthCheckType)
+	d.Set("multi_az_policy", object.MultiAZPolicy)
+	d.Set("group_deletion_protection", object.GroupDeletionProtection)
 
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateModifyScalingGroupRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -181,7 +221,7 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = d.Id()
 
@@ -206,6 +246,22 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 		request.VSwitchIds = &vSwitchIds
 	}
 
+	if d.HasChange("launch_template_id") {
+		request.LaunchTemplateId = d.Get("launch_template_id").(string)
+	}
+
+	if d.HasChange("launch_template_version") {
+		request.LaunchTemplateVersion = d.Get("launch_template_version").(string)
+	}
+
+	if d.HasChange("health_check_type") {
+		request.HealthCheckType = d.Get("health_check_type").(string)
+	}
+
+	if d.HasChange("group_deletion_protection") {
+		request.GroupDeletionProtection = requests.NewBoolean(d.Get("group_deletion_protection").(bool))
+	}
+
 	if d.HasChange("removal_policies") {
 		policyies := expandStringList(d.Get("removal_policies").([]interface{}))
 		s := reflect.ValueOf(request).Elem()
@@ -218,7 +274,7 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 		return essClient.ModifyScalingGroup(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	//d.SetPartial("scaling_group_name")
 	//d.SetPartial("min_size")
@@ -242,11 +298,11 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 	// 	//d.SetPartial("db_instance_ids")
 	// }
 	d.Partial(false)
-	return resourceAlibabacloudStackEssScalingGroupRead(d, meta)
+	return resourceAlibabacloudStackEssScalingGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	request := ess.CreateDeleteScalingGroupRequest()
@@ -257,7 +313,7 @@ func resourceAlibabacloudStackEssScalingGroupDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = d.Id()
 	request.ForceDelete = requests.NewBoolean(true)
@@ -270,11 +326,11 @@ func resourceAlibabacloudStackEssScalingGroupDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildAlibabacloudStackEssScalingGroupArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingGroupRequest, error) {
@@ -288,7 +344,7 @@ func buildAlibabacloudStackEssScalingGroupArgs(d *schema.ResourceData, meta inte
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.MinSize = requests.NewInteger(d.Get("min_size").(int))
 	request.MaxSize = requests.NewInteger(d.Get("max_size").(int))
@@ -303,13 +359,33 @@ func buildAlibabacloudStackEssScalingGroupArgs(d *schema.ResourceData, meta inte
 		request.VSwitchIds = &ids
 	}
 
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		request.LaunchTemplateId = v.(string)
+		if v, ok := d.GetOk("launch_template_version"); ok {
+			request.LaunchTemplateVersion = v.(string)
+		}
+	}
+
+	if v, ok := d.GetOk("health_check_type"); ok {
+		request.HealthCheckType = v.(string)
+	}
+
+	if v, ok := d.GetOk("multi_az_policy"); ok {
+		request.MultiAZPolicy = v.(string)
+	}
+
+	// GroupDeletionProtection is only sent when it is enabled, as older ESS versions do not accept it.
+	if v, ok := d.GetOk("group_deletion_protection"); ok {
+		request.GroupDeletionProtection = requests.NewBoolean(v.(bool))
+	}
+
 	if dbs, ok := d.GetOk("db_instance_ids"); ok {
 		request.DBInstanceIds = convertListToJsonString(dbs.(*schema.Set).List())
 	}
 
 	if lbs, ok := d.GetOk("loadbalancer_ids"); ok {
 		for _, lb := range lbs.(*schema.Set).List() {
-			if err := slbService.WaitForSlb(lb.(string), Active, DefaultTimeout); err != nil {
+			if err := slbService.WaitForSlb(lb.(string), Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 				return nil, WrapError(err)
 			}
 		}
@@ -440,6 +440,65 @@ func TestAccAlibabacloudStackEssScalingGroup_slb(t *testing.T) {
 
 }
 
+func TestAccAlibabacloudStackEssScalingGroup_launchTemplate(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 999999)
+	var v ess.ScalingGroup
+	resourceId := "alibabacloudstack_ess_scaling_group.default"
+
+	basicMap := map[string]string{
+		"min_size":                  "0",
+		"max_size":                  "2",
+		"scaling_group_name":        fmt.Sprintf("tf-testAccEssScalingGroup-%d", rand),
+		"launch_template_id":        CHECKSET,
+		"launch_template_version":   "Default",
+		"health_check_type":         "ECS",
+		"multi_az_policy":           "BALANCE",
+		"group_deletion_protection": "true",
+	}
+
+	ra := resourceAttrInit(resourceId, basicMap)
+	rc := resourceCheckInit(resourceId, &v, func() interface{} {
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	})
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEssScalingGroupLaunchTemplate(EcsInstanceCommonTestCase, rand, "ECS", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The protection is turned off again so that the group can be destroyed.
+				Config: testAccEssScalingGroupLaunchTemplate(EcsInstanceCommonTestCase, rand, "NONE", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"health_check_type":         "NONE",
+						"group_deletion_protection": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEssScalingGroupDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
@@ -1049,3 +1108,31 @@ func testAccEssScalingGroupModifyVSwitchIds(common string, rand int) string {
 		removal_policies = ["OldestInstance"]
 	}`, common, rand)
 }
+
+func testAccEssScalingGroupLaunchTemplate(common string, rand int, healthCheckType string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccEssScalingGroup-%d"
+	}
+
+	resource "alibabacloudstack_launch_template" "default" {
+		name              = "${var.name}"
+		image_id          = "${data.alibabacloudstack_images.default.images.0.id}"
+		instance_type     = "${data.alibabacloudstack_instance_types.default.instance_types.0.id}"
+		security_group_id = "${alibabacloudstack_security_group.default.id}"
+		vswitch_id        = "${alibabacloudstack_vswitch.default.id}"
+	}
+
+	resource "alibabacloudstack_ess_scaling_group" "default" {
+		min_size                  = 0
+		max_size                  = 2
+		scaling_group_name        = "${var.name}"
+		vswitch_ids               = ["${alibabacloudstack_vswitch.default.id}"]
+		launch_template_id        = "${alibabacloudstack_launch_template.default.id}"
+		launch_template_version   = "Default"
+		health_check_type         = "%s"
+		multi_az_policy           = "BALANCE"
+		group_deletion_protection = %t
+	}`, common, rand, healthCheckType, deletionProtection)
+}
@@ -2,10 +2,12 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackEssScalingGroupVserverGroups() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssVserverGroupsCreate,
-		Read:   resourceAlibabacloudStackEssVserverGroupsRead,
-		Update: resourceAlibabacloudStackEssVserverGroupsUpdate,
-		Delete: resourceAlibabacloudStackEssVserverGroupsDelete,
+		CreateContext: resourceAlibabacloudStackEssVserverGroupsCreate,
+		ReadContext:   resourceAlibabacloudStackEssVserverGroupsRead,
+		UpdateContext: resourceAlibabacloudStackEssVserverGroupsUpdate,
+		DeleteContext: resourceAlibabacloudStackEssVserverGroupsDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -83,36 +85,36 @@ func resourceAlibabacloudStackEssScalingGroupVserverGroups() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssVserverGroupsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssVserverGroupsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId(d.Get("scaling_group_id").(string))
-	return resourceAlibabacloudStackEssVserverGroupsUpdate(d, meta)
+	return resourceAlibabacloudStackEssVserverGroupsUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssVserverGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssVserverGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	object, err := essService.DescribeEssScalingGroup(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	err = d.Set("scaling_group_id", object.ScalingGroupId)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	err = d.Set("vserver_groups", essService.flattenVserverGroupList(object.VServerGroups.VServerGroup))
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackEssVserverGroupsUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssVserverGroupsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	object, err := essService.DescribeEssScalingGroup(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Partial(true)
 	vserverGroupsMapFromScalingGroup := vserverGroupMapFromScalingGroup(object.VServerGroups.VServerGroup)
@@ -125,21 +127,21 @@ func resourceAlibabacloudStackEssVserverGroupsUpdate(d *schema.ResourceData, met
 	}
 	err = detachVserverGroups(d, client, detachMap, force)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	//d.SetPartial("vserver_groups")
 
 	err = attachVserverGroups(d, client, attachMap, force)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	//d.SetPartial("vserver_groups")
 	d.Partial(false)
-	return resourceAlibabacloudStackEssVserverGroupsRead(d, meta)
+	return resourceAlibabacloudStackEssVserverGroupsRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssVserverGroupsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssVserverGroupsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vserverGroupsFromConfig := vserverGroupMapFromConfig(d.Get("vserver_groups").(*schema.Set))
 	_, detachMap := attachOrDetachVserverGroupMap(make(map[string]string, 0), vserverGroupsFromConfig)
 	v, ok := d.GetOkExists("force")
@@ -149,7 +151,7 @@ func resourceAlibabacloudStackEssVserverGroupsDelete(d *schema.ResourceData, met
 	}
 	err := detachVserverGroups(d, client, detachMap, force)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	return nil
 }
@@ -252,7 +254,7 @@ func attachVserverGroups(d *schema.ResourceData, client *connectivity.Alibabaclo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.ScalingGroupId = d.Id()
 		request.ForceAttach = requests.NewBoolean(force)
 		request.VServerGroup = &attachScalingGroupVserverGroups
@@ -295,7 +297,7 @@ func detachVserverGroups(d *schema.ResourceData, client *connectivity.Alibabaclo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.ScalingGroupId = d.Id()
 		request.ForceDetach = requests.NewBoolean(force)
@@ -1,8 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -13,13 +16,18 @@ import (
 
 func resourceAlibabacloudStackEssScalingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingRuleCreate,
-		Read:   resourceAlibabacloudStackEssScalingRuleRead,
-		Update: resourceAlibabacloudStackEssScalingRuleUpdate,
-		Delete: resourceAlibabacloudStackEssScalingRuleDelete,
+		CreateContext: resourceAlibabacloudStackEssScalingRuleCreate,
+		ReadContext:   resourceAlibabacloudStackEssScalingRuleRead,
+		UpdateContext: resourceAlibabacloudStackEssScalingRuleUpdate,
+		DeleteContext: resourceAlibabacloudStackEssScalingRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -56,14 +64,14 @@ func resourceAlibabacloudStackEssScalingRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScalingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	request, err := buildAlibabacloudStackEssScalingRuleArgs(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -71,22 +79,22 @@ func resourceAlibabacloudStackEssScalingRuleCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.CreateScalingRule(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalingrule", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalingrule", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ess.CreateScalingRuleResponse)
 	d.SetId(response.ScalingRuleId)
 
-	return resourceAlibabacloudStackEssScalingRuleRead(d, meta)
+	return resourceAlibabacloudStackEssScalingRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
 	//Compatible with older versions id
@@ -95,7 +103,7 @@ func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta in
 		d.SetId(parts[1])
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	object, err := essService.DescribeEssScalingRule(d.Id())
@@ -104,7 +112,7 @@ func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("scaling_group_id", object.ScalingGroupId)
@@ -117,7 +125,7 @@ func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Compatible with older versions id
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -125,7 +133,7 @@ func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta
 		d.SetId(parts[1])
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 	request := ess.CreateDeleteScalingRuleRequest()
 	request.ScalingRuleId = d.Id()
@@ -136,7 +144,7 @@ func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteScalingRule(request)
@@ -145,13 +153,13 @@ func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"InvalidScalingRuleId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(essService.WaitForEssScalingRule(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForEssScalingRule(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
-func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Compatible with older versions id
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -159,7 +167,7 @@ func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta
 		d.SetId(parts[1])
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ess.CreateModifyScalingRuleRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -169,7 +177,7 @@ func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta
 	request.ScalingRuleId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("scaling_rule_name") {
 		request.ScalingRuleName = d.Get("scaling_rule_name").(string)
@@ -188,10 +196,10 @@ func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta
 		return essClient.ModifyScalingRule(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssScalingRuleRead(d, meta)
+	return resourceAlibabacloudStackEssScalingRuleRead(ctx, d, meta)
 }
 
 func buildAlibabacloudStackEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingRuleRequest, error) {
@@ -204,7 +212,7 @@ func buildAlibabacloudStackEssScalingRuleArgs(d *schema.ResourceData, meta inter
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	// common params
 	request.ScalingGroupId = d.Get("scaling_group_id").(string)
@@ -1,25 +1,34 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackEssScheduledTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScheduledTaskCreate,
-		Read:   resourceAlibabacloudStackEssScheduledTaskRead,
-		Update: resourceAlibabacloudStackEssScheduledTaskUpdate,
-		Delete: resourceAlibabacloudStackEssScheduledTaskDelete,
+		CreateContext: resourceAlibabacloudStackEssScheduledTaskCreate,
+		ReadContext:   resourceAlibabacloudStackEssScheduledTaskRead,
+		UpdateContext: resourceAlibabacloudStackEssScheduledTaskUpdate,
+		DeleteContext: resourceAlibabacloudStackEssScheduledTaskDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scheduled_action": {
 				Type:     schema.TypeString,
@@ -69,10 +78,10 @@ func resourceAlibabacloudStackEssScheduledTask() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScheduledTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	request := buildAlibabacloudStackEssScheduledTaskArgs(d)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -80,25 +89,25 @@ func resourceAlibabacloudStackEssScheduledTaskCreate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.CreateScheduledTask(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scheduled_task", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scheduled_task", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ess.CreateScheduledTaskResponse)
 	d.SetId(response.ScheduledTaskId)
 
-	return resourceAlibabacloudStackEssScheduledTaskRead(d, meta)
+	return resourceAlibabacloudStackEssScheduledTaskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScheduledTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	object, err := essService.DescribeEssScheduledTask(d.Id())
@@ -107,7 +116,7 @@ func resourceAlibabacloudStackEssScheduledTaskRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("scheduled_action", object.ScheduledAction)
@@ -123,9 +132,9 @@ func resourceAlibabacloudStackEssScheduledTaskRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := ess.CreateModifyScheduledTaskRequest()
 	request.RegionId = client.RegionId
@@ -135,7 +144,7 @@ func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScheduledTaskId = d.Id()
 	request.LaunchExpirationTime = requests.NewInteger(d.Get("launch_expiration_time").(int))
@@ -170,14 +179,14 @@ func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, met
 		return essClient.ModifyScheduledTask(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssScheduledTaskRead(d, meta)
+	return resourceAlibabacloudStackEssScheduledTaskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScheduledTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackEssScheduledTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	essService := EssService{client}
 
 	request := ess.CreateDeleteScheduledTaskRequest()
@@ -188,12 +197,12 @@ func resourceAlibabacloudStackEssScheduledTaskDelete(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScheduledTaskId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteScheduledTask(request)
@@ -202,11 +211,11 @@ func resourceAlibabacloudStackEssScheduledTaskDelete(d *schema.ResourceData, met
 		if IsExpectedErrors(err, []string{"InvalidScheduledTaskId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForEssScheduledTask(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(essService.WaitForEssScheduledTask(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildAlibabacloudStackEssScheduledTaskArgs(d *schema.ResourceData) *ess.CreateScheduledTaskRequest {
@@ -47,7 +47,7 @@ func testSweepEssSchedules(region string) error {
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageNumber = requests.NewInteger(1)
 	for {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
@@ -95,7 +95,7 @@ func testSweepEssSchedules(region string) error {
 			req.Scheme = "http"
 		}
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.QueryParams["Department"] = client.Department
 		req.QueryParams["ResourceGroup"] = client.ResourceGroup
 		req.ScheduledTaskId = id
@@ -0,0 +1,105 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackEssSuspendProcess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackEssSuspendProcessCreate,
+		ReadContext:   resourceAlibabacloudStackEssSuspendProcessRead,
+		DeleteContext: resourceAlibabacloudStackEssSuspendProcessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"process": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ScaleIn", "ScaleOut", "HealthCheck", "AlarmNotification", "ScheduledAction"}, false),
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackEssSuspendProcessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := ess.CreateSuspendProcessesRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.ScalingGroupId = d.Get("scaling_group_id").(string)
+	process := []string{d.Get("process").(string)}
+	request.Process = &process
+
+	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+		return essClient.SuspendProcesses(request)
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_suspend_process", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	d.SetId(fmt.Sprintf("%s%s%s", request.ScalingGroupId, COLON_SEPARATED, process[0]))
+
+	return resourceAlibabacloudStackEssSuspendProcessRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackEssSuspendProcessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	essService := EssService{client}
+
+	if _, err := essService.DescribeEssSuspendProcess(d.Id()); err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("scaling_group_id", parts[0])
+	d.Set("process", parts[1])
+	return nil
+}
+
+func resourceAlibabacloudStackEssSuspendProcessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	request := ess.CreateResumeProcessesRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.ScalingGroupId = parts[0]
+	process := []string{parts[1]}
+	request.Process = &process
+
+	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+		return essClient.ResumeProcesses(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
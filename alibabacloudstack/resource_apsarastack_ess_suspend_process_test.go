@@ -0,0 +1,71 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackEssSuspendProcess_basic(t *testing.T) {
+	var v ess.ScalingGroup
+	resourceId := "alibabacloudstack_ess_suspend_process.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"scaling_group_id": CHECKSET,
+		"process":          "ScaleIn",
+	})
+	serviceFunc := func() interface{} {
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeEssSuspendProcess")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 999999)
+	name := fmt.Sprintf("tf-testAccEssSuspendProcess-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEssSuspendProcessConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"scaling_group_id": "${alibabacloudstack_ess_scaling_group.default.id}",
+					"process":          "ScaleIn",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceEssSuspendProcessConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+variable "name" {
+	default = "%s"
+}
+
+resource "alibabacloudstack_ess_scaling_group" "default" {
+	min_size = 0
+	max_size = 1
+	scaling_group_name = "${var.name}"
+	vswitch_ids = ["${alibabacloudstack_vswitch.default.id}"]
+}
+`, EcsInstanceCommonTestCase, name)
+}
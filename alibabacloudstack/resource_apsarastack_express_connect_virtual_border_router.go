@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,15 +19,17 @@ import (
 
 func resourceAlibabacloudStackExpressConnectVirtualBorderRouter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate,
-		Read:   resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead,
-		Update: resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate,
-		Delete: resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete,
+		CreateContext: resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate,
+		ReadContext:   resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead,
+		UpdateContext: resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate,
+		DeleteContext: resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"associated_physical_connections": {
@@ -123,21 +127,12 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouter() *schema.Resour
 	}
 }
 
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateVirtualBorderRouter"
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Vpc"
-	request.ApiName = action
-	request.Version = "2016-04-28"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"RegionId":       client.RegionId,
-		"Product":        "Vpc",
-		"OrganizationId": client.Department,
-	}
+	request := client.NewCommonRequest("POST", "Vpc", "2016-04-28", action, "")
+	request.QueryParams["OrganizationId"] = client.Department
 	if v, ok := d.GetOk("bandwidth"); ok {
 		request.QueryParams["Bandwidth"] = v.(string)
 	}
@@ -171,7 +166,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(d *schema.
 		request.QueryParams["Name"] = v.(string)
 	}
 	request.QueryParams["VlanId"] = fmt.Sprintf("%d", d.Get("vlan_id").(int))
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ProcessCommonRequest(request)
 		})
@@ -191,13 +186,13 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(d *schema.
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_express_connect_virtual_border_router", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_express_connect_virtual_border_router", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d, meta)
+	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeExpressConnectVirtualBorderRouter(d.Id())
 	if err != nil {
@@ -206,7 +201,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(d *schema.Re
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("circuit_code", object["CircuitCode"])
 	d.Set("description", object["Description"])
@@ -235,19 +230,15 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(d *schema.Re
 	d.Set("route_table_id", object["RouteTableId"])
 	return nil
 }
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	d.Partial(true)
 
 	update := false
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"VbrId":          d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Vpc",
-		"OrganizationId": client.Department,
-	}
+	request := client.NewCommonRequest("POST", "Vpc", "2016-04-28", "ModifyVirtualBorderRouterAttribute", "")
+	request.QueryParams["VbrId"] = d.Id()
+	request.QueryParams["OrganizationId"] = client.Department
 	if !d.IsNewResource() && d.HasChange("circuit_code") {
 		update = true
 		if v, ok := d.GetOk("circuit_code"); ok {
@@ -266,7 +257,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 			request.QueryParams["DetectMultiplier"] = fmt.Sprint(v)
 		} else if v, ok := d.GetOk("min_rx_interval"); ok && fmt.Sprint(v) != "" {
 			if v, ok := d.GetOk("min_tx_interval"); ok && fmt.Sprint(v) != "" {
-				return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "detect_multiplier", "min_rx_interval", d.Get("min_rx_interval"), "min_tx_interval", d.Get("min_tx_interval")))
+				return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "detect_multiplier", "min_rx_interval", d.Get("min_rx_interval"), "min_tx_interval", d.Get("min_tx_interval"))))
 			}
 		}
 		request.QueryParams["MinRxInterval"] = fmt.Sprint(d.Get("min_rx_interval"))
@@ -294,7 +285,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 			request.QueryParams["MinRxInterval"] = fmt.Sprint(v)
 		} else if v, ok := d.GetOk("detect_multiplier"); ok && fmt.Sprint(v) != "" {
 			if v, ok := d.GetOk("min_tx_interval"); ok && fmt.Sprint(v) != "" {
-				return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "min_rx_interval", "detect_multiplier", d.Get("detect_multiplier"), "min_tx_interval", d.Get("min_tx_interval")))
+				return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "min_rx_interval", "detect_multiplier", d.Get("detect_multiplier"), "min_tx_interval", d.Get("min_tx_interval"))))
 			}
 		}
 		request.QueryParams["DetectMultiplier"] = fmt.Sprint(d.Get("detect_multiplier"))
@@ -306,7 +297,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 			request.QueryParams["MinTxInterval"] = fmt.Sprint(v)
 		} else if v, ok := d.GetOk("detect_multiplier"); ok && fmt.Sprint(v) != "" {
 			if v, ok := d.GetOk("min_rx_interval"); ok && fmt.Sprint(v) != "" {
-				return WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "min_tx_interval", "detect_multiplier", d.Get("detect_multiplier"), "min_rx_interval", d.Get("min_rx_interval")))
+				return DiagnosticsFromError(WrapError(fmt.Errorf("attribute '%s' is required when '%s' is %v and '%s' is %v ", "min_tx_interval", "detect_multiplier", d.Get("detect_multiplier"), "min_rx_interval", d.Get("min_rx_interval"))))
 			}
 		}
 		request.QueryParams["DetectMultiplier"] = fmt.Sprint(d.Get("detect_multiplier"))
@@ -349,13 +340,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 		if v, ok := d.GetOk("bandwidth"); ok {
 			request.QueryParams["Bandwidth"] = v.(string)
 		}
-		action := "ModifyVirtualBorderRouterAttribute"
-		request.Method = "POST"
-		request.ApiName = action
-		request.Product = "Vpc"
-		request.Version = "2016-04-28"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.ProcessCommonRequest(request)
 			})
@@ -370,30 +355,21 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
 	if d.HasChange("status") {
 		object, err := vpcService.DescribeExpressConnectVirtualBorderRouter(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		target := d.Get("status").(string)
 		if object["Status"].(string) != target {
 			if target == "active" {
-				rqs := requests.NewCommonRequest()
-				rqs.QueryParams = map[string]string{
-					"VbrId":          d.Id(),
-					"RegionId":       client.RegionId,
-					"Product":        "Vpc",
-					"OrganizationId": client.Department,
-				}
-				rqs.Method = requests.POST
-				rqs.Product = "Vpc"
-				rqs.Version = "2016-04-28"
-				rqs.ApiName = "RecoverVirtualBorderRouter"
-				rqs.Headers = map[string]string{"RegionId": client.RegionId}
-				err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+				rqs := client.NewCommonRequest("POST", "Vpc", "2016-04-28", "RecoverVirtualBorderRouter", "")
+				rqs.QueryParams["VbrId"] = d.Id()
+				rqs.QueryParams["OrganizationId"] = client.Department
+				err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 						return vpcClient.ProcessCommonRequest(rqs)
 					})
@@ -408,27 +384,18 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 					return nil
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), rqs.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), rqs.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				stateConf := BuildStateConf([]string{}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.ExpressConnectVirtualBorderRouterStateRefreshFunc(d.Id(), []string{}))
-				if _, err := stateConf.WaitForState(); err != nil {
-					return WrapErrorf(err, IdMsg, d.Id())
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 				}
 			}
 			if target == "terminated" {
-				rqs := requests.NewCommonRequest()
-				rqs.QueryParams = map[string]string{
-					"VbrId":          d.Id(),
-					"RegionId":       client.RegionId,
-					"Product":        "Vpc",
-					"OrganizationId": client.Department,
-				}
-				rqs.Method = requests.POST
-				rqs.Product = "Vpc"
-				rqs.Version = "2016-04-28"
-				rqs.ApiName = "TerminateVirtualBorderRouter"
-				rqs.Headers = map[string]string{"RegionId": client.RegionId}
-				err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+				rqs := client.NewCommonRequest("POST", "Vpc", "2016-04-28", "TerminateVirtualBorderRouter", "")
+				rqs.QueryParams["VbrId"] = d.Id()
+				rqs.QueryParams["OrganizationId"] = client.Department
+				err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 						return vpcClient.ProcessCommonRequest(rqs)
 					})
@@ -443,35 +410,26 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 					return nil
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), rqs.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), rqs.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				stateConf := BuildStateConf([]string{}, []string{"terminated"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.ExpressConnectVirtualBorderRouterStateRefreshFunc(d.Id(), []string{}))
-				if _, err := stateConf.WaitForState(); err != nil {
-					return WrapErrorf(err, IdMsg, d.Id())
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 				}
 			}
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(d, meta)
+	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteVirtualBorderRouter"
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"VbrId":          d.Id(),
-		"RegionId":       client.RegionId,
-		"Product":        "Vpc",
-		"OrganizationId": client.Department,
-	}
-	request.Method = requests.POST
-	request.Product = "Vpc"
-	request.Version = "2016-04-28"
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request := client.NewCommonRequest(requests.POST, "Vpc", "2016-04-28", action, "")
+	request.QueryParams["VbrId"] = d.Id()
+	request.QueryParams["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ProcessCommonRequest(request)
 		})
@@ -490,7 +448,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete(d *schema.
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,10 +15,19 @@ import (
 
 func resourceAlibabacloudStackForwardEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackForwardEntryCreate,
-		Read:   resourceAlibabacloudStackForwardEntryRead,
-		Update: resourceAlibabacloudStackForwardEntryUpdate,
-		Delete: resourceAlibabacloudStackForwardEntryDelete,
+		CreateContext: resourceAlibabacloudStackForwardEntryCreate,
+		ReadContext:   resourceAlibabacloudStackForwardEntryRead,
+		UpdateContext: resourceAlibabacloudStackForwardEntryUpdate,
+		DeleteContext: resourceAlibabacloudStackForwardEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -59,8 +70,8 @@ func resourceAlibabacloudStackForwardEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackForwardEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateCreateForwardEntryRequest()
@@ -71,7 +82,7 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ForwardTableId = d.Get("forward_table_id").(string)
 	request.ExternalIp = d.Get("external_ip").(string)
 	request.ExternalPort = d.Get("external_port").(string)
@@ -83,7 +94,7 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 	}
 	var raw interface{}
 	var err error
-	if err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		ar := request
 		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateForwardEntry(ar)
@@ -96,21 +107,21 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 		}
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_forward_entry", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_forward_entry", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*vpc.CreateForwardEntryResponse)
 
 	d.SetId(request.ForwardTableId + COLON_SEPARATED + response.ForwardEntryId)
-	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForForwardEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackForwardEntryRead(d, meta)
+	return resourceAlibabacloudStackForwardEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackForwardEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(d.Get("forward_table_id").(string) + COLON_SEPARATED + d.Id())
@@ -121,7 +132,7 @@ func resourceAlibabacloudStackForwardEntryRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("forward_table_id", forwardEntry.ForwardTableId)
@@ -136,8 +147,8 @@ func resourceAlibabacloudStackForwardEntryRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackForwardEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(d.Get("forward_table_id").(string) + COLON_SEPARATED + d.Id())
@@ -145,7 +156,7 @@ func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta in
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := vpc.CreateModifyForwardEntryRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -156,7 +167,7 @@ func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta in
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ForwardEntryId = parts[1]
 	request.ForwardTableId = parts[0]
 
@@ -187,24 +198,24 @@ func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta in
 		return vpcClient.ModifyForwardEntry(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForForwardEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackForwardEntryRead(d, meta)
+	return resourceAlibabacloudStackForwardEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(d.Get("forward_table_id").(string) + COLON_SEPARATED + d.Id())
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	request := vpc.CreateDeleteForwardEntryRequest()
 	request.RegionId = string(client.Region)
@@ -214,11 +225,11 @@ func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta in
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ForwardTableId = parts[0]
 	request.ForwardEntryId = parts[1]
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteForwardEntry(request)
 		})
@@ -237,5 +248,5 @@ func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta in
 		}
 		WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForForwardEntry(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForForwardEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
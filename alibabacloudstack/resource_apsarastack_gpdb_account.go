@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -17,15 +19,17 @@ import (
 
 func resourceAlibabacloudStackGpdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackGpdbAccountCreate,
-		Read:   resourceAlibabacloudStackGpdbAccountRead,
-		Update: resourceAlibabacloudStackGpdbAccountUpdate,
-		Delete: resourceAlibabacloudStackGpdbAccountDelete,
+		CreateContext: resourceAlibabacloudStackGpdbAccountCreate,
+		ReadContext:   resourceAlibabacloudStackGpdbAccountRead,
+		UpdateContext: resourceAlibabacloudStackGpdbAccountUpdate,
+		DeleteContext: resourceAlibabacloudStackGpdbAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"account_description": {
@@ -58,14 +62,14 @@ func resourceAlibabacloudStackGpdbAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateAccount"
 	request := make(map[string]interface{})
 	conn, err := client.NewGpdbClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("account_description"); ok {
 		request["AccountDescription"] = v
@@ -77,7 +81,7 @@ func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta int
 	request["DBInstanceId"] = d.Get("db_instance_id")
 	request["AccountPassword"] = d.Get("account_password")
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -90,20 +94,20 @@ func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta int
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_gpdb_account", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_gpdb_account", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(request["DBInstanceId"], ":", request["AccountName"]))
 	gpdbService := GpdbService{client}
 	stateConf := BuildStateConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), 60*time.Second, gpdbService.GpdbAccountStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackGpdbAccountRead(d, meta)
+	return resourceAlibabacloudStackGpdbAccountRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackGpdbAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	gpdbService := GpdbService{client}
 	object, err := gpdbService.DescribeGpdbAccount(d.Id())
 	if err != nil {
@@ -112,11 +116,11 @@ func resourceAlibabacloudStackGpdbAccountRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("account_name", parts[1])
 	d.Set("db_instance_id", parts[0])
@@ -124,12 +128,12 @@ func resourceAlibabacloudStackGpdbAccountRead(d *schema.ResourceData, meta inter
 	d.Set("status", convertGpdbAccountStatusResponse(object["AccountStatus"]))
 	return nil
 }
-func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := map[string]interface{}{
@@ -152,10 +156,10 @@ func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta int
 		request["RegionId"] = client.RegionId
 		conn, err := client.NewGpdbClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
@@ -169,15 +173,17 @@ func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta int
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
 
-	return resourceAlibabacloudStackGpdbAccountRead(d, meta)
+	return resourceAlibabacloudStackGpdbAccountRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackGpdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy resourcealibabacloudstackGpdbAccount. Terraform will remove this resource from the state file, however resources may remain.")
-	return nil
+func resourceAlibabacloudStackGpdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Cannot destroy the resource alibabacloudstack_gpdb_account %s. Terraform will remove this resource from the state file, however resources may remain.", d.Id()),
+	}}
 }
 func convertGpdbAccountStatusResponse(source interface{}) interface{} {
 	switch source {
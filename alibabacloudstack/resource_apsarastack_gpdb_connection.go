@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackGpdbConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackGpdbConnectionCreate,
-		Read:   resourceAlibabacloudStackGpdbConnectionRead,
-		Update: resourceAlibabacloudStackGpdbConnectionUpdate,
-		Delete: resourceAlibabacloudStackGpdbConnectionDelete,
+		CreateContext: resourceAlibabacloudStackGpdbConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackGpdbConnectionRead,
+		UpdateContext: resourceAlibabacloudStackGpdbConnectionUpdate,
+		DeleteContext: resourceAlibabacloudStackGpdbConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -61,8 +63,8 @@ func resourceAlibabacloudStackGpdbConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	gpdbService := GpdbService{client}
 	instanceId := d.Get("instance_id").(string)
 	prefix := d.Get("connection_prefix").(string)
@@ -73,7 +75,7 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 	request.Headers["x-ascm-product-name"] = "Gpdb"
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = instanceId
 	request.ConnectionStringPrefix = prefix
 	request.Port = d.Get("port").(string)
@@ -85,7 +87,7 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 	if client.Config.Insecure {
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
-	err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 			return gpdbClient.AllocateInstancePublicConnection(request)
 		})
@@ -99,28 +101,28 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_gpdb_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_gpdb_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, request.ConnectionStringPrefix))
 	// wait instance running after allocating
 	stateConf := BuildStateConf([]string{"Creating", "NetAddressCreating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, gpdbService.GpdbInstanceStateRefreshFunc(instanceId, []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackGpdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackGpdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	gpdbService := GpdbService{client}
 	object, err := gpdbService.DescribeGpdbConnection(d.Id())
 	if err != nil {
@@ -128,7 +130,7 @@ func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", parts[0])
@@ -140,30 +142,30 @@ func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if d.HasChange("port") {
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 		gpdbService := GpdbService{client}
 
 		request := gpdb.CreateModifyDBInstanceConnectionStringRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = parts[0]
 		object, err := gpdbService.DescribeGpdbConnection(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.CurrentConnectionString = object.ConnectionString
 		request.ConnectionStringPrefix = parts[1]
 		request.Port = d.Get("port").(string)
 
-		if err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 				return gpdbClient.ModifyDBInstanceConnectionString(request)
 			})
@@ -176,34 +178,34 @@ func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			return nil
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		// wait instance running after modifying
 		stateConf := BuildStateConf([]string{"NET_MODIFYING"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 3*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(request.DBInstanceId, []string{"Deleting"}))
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
-	return resourceAlibabacloudStackGpdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackGpdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := gpdb.CreateReleaseInstancePublicConnectionRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 
 	gpdbService := GpdbService{client}
 	request.RegionId = client.RegionId
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		object, err := gpdbService.DescribeGpdbConnection(d.Id())
 		if err != nil {
 			return resource.NonRetryableError(WrapError(err))
@@ -227,12 +229,12 @@ func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound", "InvalidCurrentConnectionString.NotFound", "AtLeastOneNetTypeExists"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	stateConf := BuildStateConf([]string{"NetAddressDeleting"}, []string{"Running"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, gpdbService.GpdbInstanceStateRefreshFunc(request.DBInstanceId, []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return WrapError(gpdbService.WaitForGpdbConnection(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(gpdbService.WaitForGpdbConnection(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,16 +17,18 @@ import (
 
 func resourceAlibabacloudStackGpdbInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceAlibabacloudStackGpdbInstanceRead,
-		Create: resourceAlibabacloudStackGpdbInstanceCreate,
-		Update: resourceAlibabacloudStackGpdbInstanceUpdate,
-		Delete: resourceAlibabacloudStackGpdbInstanceDelete,
+		ReadContext:   resourceAlibabacloudStackGpdbInstanceRead,
+		CreateContext: resourceAlibabacloudStackGpdbInstanceCreate,
+		UpdateContext: resourceAlibabacloudStackGpdbInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackGpdbInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(50 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,9 +122,9 @@ func resourceAlibabacloudStackGpdbInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	gpdbService := GpdbService{client}
 
 	instance, err := gpdbService.DescribeGpdbInstance(d.Id())
@@ -129,7 +133,7 @@ func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", instance.DBInstanceId)
@@ -144,7 +148,7 @@ func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta inte
 	d.Set("instance_network_type", instance.InstanceNetworkType)
 	security_ips, err := gpdbService.DescribeGpdbSecurityIps(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("security_ip_list", security_ips)
 	//d.Set("create_time", instance.CreationTime)
@@ -156,8 +160,8 @@ func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	gpdbService := GpdbService{client}
 
 	request, err := buildGpdbCreateRequest(d, meta)
@@ -170,12 +174,12 @@ func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta in
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err = client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
 			return client.CreateDBInstance(request)
 		})
@@ -190,20 +194,20 @@ func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta in
 	})
 	response, _ := raw.(*gpdb.CreateDBInstanceResponse)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_gpdb_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_gpdb_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(response.DBInstanceId)
 
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return resourceAlibabacloudStackGpdbInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackGpdbInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	gpdbService := GpdbService{client}
 
 	// Begin Update
@@ -214,14 +218,14 @@ func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta in
 		request := gpdb.CreateModifyDBInstanceDescriptionRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("description").(string)
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 			return gpdbClient.ModifyDBInstanceDescription(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("description")
@@ -236,31 +240,31 @@ func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta in
 			ipStr = LOCAL_HOST_IP
 		}
 		if err := gpdbService.ModifyGpdbSecurityIps(d.Id(), ipStr); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		//d.SetPartial("security_ip_list")
 	}
 
 	if err := gpdbService.setInstanceTags(d); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	// Finish Update
 	d.Partial(false)
 
-	return resourceAlibabacloudStackGpdbInstanceRead(d, meta)
+	return resourceAlibabacloudStackGpdbInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackGpdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := gpdb.CreateDeleteDBInstanceRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err := resource.Retry(10*5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
 			return client.DeleteDBInstance(request)
 		})
@@ -278,7 +282,7 @@ func resourceAlibabacloudStackGpdbInstanceDelete(d *schema.ResourceData, meta in
 		if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	// because DeleteDBInstance is called synchronously, there is no wait or describe here.
 	return nil
@@ -289,7 +293,7 @@ func buildGpdbCreateRequest(d *schema.ResourceData, meta interface{}) (*gpdb.Cre
 	request := gpdb.CreateCreateDBInstanceRequest()
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId, "ZoneId": Trim(d.Get("availability_zone").(string)), "EngineVersion": Trim(d.Get("engine").(string))}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "ZoneId": Trim(d.Get("availability_zone").(string)), "EngineVersion": Trim(d.Get("engine").(string)), "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "ZoneId": Trim(d.Get("availability_zone").(string)), "EngineVersion": Trim(d.Get("engine").(string)), "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ZoneId = Trim(d.Get("availability_zone").(string))
 	request.PayType = d.Get("instance_charge_type").(string)
 	request.VSwitchId = Trim(d.Get("vswitch_id").(string))
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackHBaseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackHBaseInstanceCreate,
-		Read:   resourceAlibabacloudStackHBaseInstanceRead,
-		Update: resourceAlibabacloudStackHBaseInstanceUpdate,
-		Delete: resourceAlibabacloudStackHBaseInstanceDelete,
+		CreateContext: resourceAlibabacloudStackHBaseInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackHBaseInstanceRead,
+		UpdateContext: resourceAlibabacloudStackHBaseInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackHBaseInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -288,34 +290,22 @@ func buildHBaseCreateRequest(d *schema.ResourceData, meta interface{}) (*hbase.C
 	return request, checkParams(request)
 }
 
-func resourceAlibabacloudStackHBaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackHBaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	hBaseService := HBaseService{client}
 
 	request, err := buildHBaseCreateRequest(d, meta)
 	if err != nil {
-		return WrapError(err)
-	}
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "HBase",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateCluster",
-		"Version":         "2019-01-01",
+		return DiagnosticsFromError(WrapError(err))
 	}
+	client.InitRpcRequest(request.RpcRequest)
 
 	raw, err := client.WithHbaseClient(func(client *hbase.Client) (interface{}, error) {
 		return client.CreateCluster(request)
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_hbase_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_hbase_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -325,15 +315,15 @@ func resourceAlibabacloudStackHBaseInstanceCreate(d *schema.ResourceData, meta i
 
 	stateConf := BuildStateConf([]string{Hb_LAUNCHING, Hb_CREATING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutCreate),
 		5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_CREATE_FAILED}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapError(err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackHBaseInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackHBaseInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackHBaseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	hbaseService := HBaseService{client}
 
 	instance, err := hbaseService.DescribeHBaseInstance(d.Id())
@@ -342,7 +332,7 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", instance["InstanceName"])
@@ -362,7 +352,7 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 		d.Set("pay_type", string(PrePaid))
 		period, err := computePeriodByUnit(instance["CreatedTimeUTC"], instance["ExpireTimeUTC"], d.Get("duration").(int), "Month")
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		d.Set("duration", period)
 	}
@@ -378,7 +368,7 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 
 	ipWhitelist, err := hbaseService.DescribeIpWhitelist(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	ipWhite := LOCAL_HOST_IP
 	for _, value := range ipWhitelist.Groups.Group {
@@ -390,13 +380,13 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 
 	securityGroups, err := hbaseService.DescribeSecurityGroups(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("security_groups", securityGroups.SecurityGroupIds.SecurityGroupId)
 
 	conns, err := hbaseService.DescribeClusterConnection(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// UiProxyConnAddrInfo
 	var uiConnAddrs []map[string]interface{}
@@ -432,31 +422,19 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackHBaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	hBaseService := HBaseService{client}
 	d.Partial(true)
 	if d.HasChange("pay_type") {
 		object, err := hBaseService.DescribeHBaseInstance(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		target := strings.ToLower(d.Get("pay_type").(string))
 		if strings.ToLower(object["PayType"].(string)) != target {
 			request := hbase.CreateConvertInstanceRequest()
-			request.Headers = map[string]string{
-				"RegionId": client.RegionId,
-			}
-			request.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "HBase",
-				"RegionId":        client.RegionId,
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"Action":          "ConvertInstance",
-				"Version":         "2019-01-01",
-			}
+			client.InitRpcRequest(request.RpcRequest)
 			request.ClusterId = d.Id()
 			request.PayType = string(Postpaid)
 			if target == "prepaid" {
@@ -474,12 +452,12 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			})
 			addDebug(request.GetActionName(), raw)
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			stateConf := BuildStateConf([]string{}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 				2*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapErrorf(err, IdMsg, d.Id())
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 			d.SetPartial("pay_type")
 		}
@@ -487,19 +465,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("ip_white") {
 		request := hbase.CreateModifyIpWhitelistRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyIpWhitelist",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		request.IpList = d.Get("ip_white").(string)
 		request.GroupName = "default"
@@ -509,26 +475,14 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		d.SetPartial("ip_white")
 	}
 
 	if d.HasChange("security_groups") {
 		request := hbase.CreateModifySecurityGroupsRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifySecurityGroups",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		securityGroups := d.Get("security_groups").(*schema.Set).List()
 		if securityGroups != nil && len(securityGroups) > 0 {
@@ -541,31 +495,19 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			5*time.Second, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		d.SetPartial("security_groups")
 	}
 
 	if d.HasChange("maintain_start_time") || d.HasChange("maintain_end_time") {
 		request := hbase.CreateModifyInstanceMaintainTimeRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyInstanceMaintainTime",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		request.MaintainStartTime = d.Get("maintain_start_time").(string)
 		request.MaintainEndTime = d.Get("maintain_end_time").(string)
@@ -574,7 +516,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			return client.ModifyInstanceMaintainTime(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("maintain_start_time")
@@ -583,30 +525,18 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("deletion_protection") {
 		if err := hBaseService.ModifyClusterDeletionProtection(d.Id(), d.Get("deletion_protection").(bool)); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		d.SetPartial("deletion_protection")
 	}
 
 	if err := hBaseService.setInstanceTags(d); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if d.HasChange("account") || d.HasChange("password") {
 		request := hbase.CreateModifyUIAccountPasswordRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyUIAccountPassword",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		request.AccountName = d.Get("account").(string)
 		request.AccountPassword = d.Get("password").(string)
@@ -615,7 +545,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			return client.ModifyUIAccountPassword(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("account")
@@ -624,24 +554,12 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackHBaseInstanceRead(d, meta)
+		return resourceAlibabacloudStackHBaseInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("name") {
 		request := hbase.CreateModifyInstanceNameRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyInstanceName",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		request.ClusterName = d.Get("name").(string)
 
@@ -649,7 +567,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			return client.ModifyInstanceName(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("name")
@@ -657,19 +575,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("core_instance_quantity") {
 		request := hbase.CreateResizeNodeCountRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ResizeNodeCount",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		request.NodeCount = requests.NewInteger(d.Get("core_instance_quantity").(int))
 
@@ -677,14 +583,14 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			return client.ResizeNodeCount(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		// Cumbersome operation，async call, wait for state change
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_NODE_RESIZING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_NODE_RESIZING_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("core_instance_quantity")
@@ -692,19 +598,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("master_instance_type") || d.HasChange("core_instance_type") {
 		request := hbase.CreateModifyInstanceTypeRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyInstanceType",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		typeChange := false
 		if d.HasChange("master_instance_type") &&
@@ -723,14 +617,14 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 				return client.ModifyInstanceType(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			// Cumbersome operation，async call, wait for state change
 			// wait instance status is running after modifying
 			stateConf := BuildStateConf([]string{Hb_LEVEL_MODIFY}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 				5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_LEVEL_MODIFY_FAILED}))
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapError(err)
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			d.SetPartial("master_instance_type")
@@ -740,19 +634,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("core_disk_size") && d.Get("engine") != "bds" {
 		request := hbase.CreateResizeDiskSizeRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ResizeDiskSize",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		request.NodeDiskSize = requests.NewInteger(d.Get("core_disk_size").(int))
 
@@ -760,14 +642,14 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			return client.ResizeDiskSize(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		// Cumbersome operation，async call, wait for state change
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_DISK_RESIZING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			2*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_DISK_RESIZE_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("core_disk_size")
@@ -775,19 +657,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("cold_storage_size") {
 		request := hbase.CreateResizeColdStorageSizeRequest()
-		request.Headers = map[string]string{
-			"RegionId": client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ResizeColdStorageSize",
-			"Version":         "2019-01-01",
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.ClusterId = d.Id()
 		request.ColdStorageSize = requests.NewInteger(d.Get("cold_storage_size").(int))
 
@@ -795,41 +665,29 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			return client.ResizeColdStorageSize(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		// Cumbersome operation，async call, wait for state change
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_HBASE_COLD_EXPANDING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			10*time.Second, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_DISK_RESIZE_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("cold_storage_size")
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackHBaseInstanceRead(d, meta)
+	return resourceAlibabacloudStackHBaseInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackHBaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackHBaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	hbaseService := HBaseService{client}
 
 	request := hbase.CreateDeleteInstanceRequest()
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "HBase",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteInstance",
-		"Version":         "2019-01-01",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	request.ClusterId = d.Id()
 	if v, ok := d.GetOk("immediate_delete_flag"); ok {
 		request.ImmediateDeleteFlag = requests.NewBoolean(v.(bool))
@@ -845,10 +703,10 @@ func resourceAlibabacloudStackHBaseInstanceDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"Instance.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{Hb_DELETING}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, hbaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-	_, err = stateConf.WaitForState()
-	return WrapError(err)
+	_, err = stateConf.WaitForStateContext(ctx)
+	return DiagnosticsFromError(WrapError(err))
 }
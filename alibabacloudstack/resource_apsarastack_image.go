@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,16 +18,17 @@ import (
 
 func resourceAlibabacloudStackImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageCreate,
-		Read:   resourceAlibabacloudStackImageRead,
-		Update: resourceAlibabacloudStackImageUpdate,
-		Delete: resourceAlibabacloudStackImageDelete,
+		CreateContext: resourceAlibabacloudStackImageCreate,
+		ReadContext:   resourceAlibabacloudStackImageRead,
+		UpdateContext: resourceAlibabacloudStackImageUpdate,
+		DeleteContext: resourceAlibabacloudStackImageDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -86,19 +89,19 @@ func resourceAlibabacloudStackImage() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	// Make sure the instance status is Running or Stopped
 	if v, ok := d.GetOk("instance_id"); ok {
 		instance, err := ecsService.DescribeInstance(v.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		status := Status(instance.Status)
 		if status != Running && status != Stopped {
-			return WrapError(Error("You must make sure that the status of the specified instance is Running or Stopped. "))
+			return DiagnosticsFromError(WrapError(Error("You must make sure that the status of the specified instance is Running or Stopped. ")))
 		}
 	}
 
@@ -106,15 +109,15 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 	if snapshotId, ok := d.GetOk("snapshot_id"); ok {
 		snapshot, err := ecsService.DescribeSnapshot(snapshotId.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		snapshotCreationTime, err := time.Parse("2006-01-02T15:04:05Z", snapshot.CreationTime)
 		if err != nil {
-			return WrapErrorf(err, IdMsg, snapshotId)
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, snapshotId))
 		}
 		deadlineTime, _ := time.Parse("2006-01-02T15:04:05Z", "2013-07-16T00:00:00Z")
 		if deadlineTime.After(snapshotCreationTime) {
-			return WrapError(Error("the specified snapshot cannot be created on or before July 15, 2013."))
+			return DiagnosticsFromError(WrapError(Error("the specified snapshot cannot be created on or before July 15, 2013.")))
 		}
 	}
 	request := ecs.CreateCreateImageRequest()
@@ -125,7 +128,7 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if instanceId, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = instanceId.(string)
 	}
@@ -164,7 +167,7 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 	request.ImageName = d.Get("image_name").(string)
 	request.Description = d.Get("description").(string)
 
-	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.CreateImage(request)
 		})
@@ -182,27 +185,27 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{"Creating", ""}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 20*time.Minute, ecsService.ImageStateRefreshFunc(d.Id(), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	err := ecsService.updateImage(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeImageById(d.Id())
@@ -211,7 +214,7 @@ func resourceAlibabacloudStackImageRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("image_name", object.ImageName)
@@ -221,13 +224,13 @@ func resourceAlibabacloudStackImageRead(d *schema.ResourceData, meta interface{}
 	if len(tags) > 0 {
 		err = d.Set("tags", ecsService.tagsToMap(tags))
 	}
-	return WrapError(err)
+	return DiagnosticsFromError(WrapError(err))
 }
 
-func resourceAlibabacloudStackImageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
-	return ecsService.deleteImage(d)
+	return DiagnosticsFromError(ecsService.deleteImage(d))
 }
 
 func FlattenImageDiskDeviceMappings(list []ecs.DiskDeviceMapping) []map[string]interface{} {
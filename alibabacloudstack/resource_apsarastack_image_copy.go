@@ -1,27 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackImageCopy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageCopyCreate,
-		Read:   resourceAlibabacloudStackImageCopyRead,
-		Update: resourceAlibabacloudStackImageCopyUpdate,
-		Delete: resourceAlibabacloudStackImageCopyDelete,
+		CreateContext: resourceAlibabacloudStackImageCopyCreate,
+		ReadContext:   resourceAlibabacloudStackImageCopyRead,
+		UpdateContext: resourceAlibabacloudStackImageCopyUpdate,
+		DeleteContext: resourceAlibabacloudStackImageCopyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -53,8 +56,8 @@ func resourceAlibabacloudStackImageCopy() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request := ecs.CreateCopyImageRequest()
@@ -65,7 +68,7 @@ func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ImageId = d.Get("source_image_id").(string)
 	request.DestinationRegionId = d.Get("destination_region_id").(string)
 	request.DestinationImageName = d.Get("image_name").(string)
@@ -75,7 +78,7 @@ func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta inter
 		return ecsClient.CopyImage(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "apsrastack_image_copy", request.GetActionName(), AlibabacloudStackGoClientFailure)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "apsrastack_image_copy", request.GetActionName(), AlibabacloudStackGoClientFailure))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
@@ -83,23 +86,23 @@ func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta inter
 	d.SetId(response.ImageId)
 	log.Printf("[DEBUG] state %#v", d.Id())
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFuncforcopy(d.Id(), d.Get("destination_region_id").(string), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return resourceAlibabacloudStackImageCopyRead(d, meta)
+	return resourceAlibabacloudStackImageCopyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageCopyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageCopyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	err := ecsService.updateImage(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageCopyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeImage(d.Id(), d.Get("destination_region_id").(string))
@@ -108,26 +111,26 @@ func resourceAlibabacloudStackImageCopyRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.ImageName)
 	d.Set("image_name", object.ImageName)
 	d.Set("description", object.Description)
 
-	return WrapError(err)
+	return DiagnosticsFromError(WrapError(err))
 }
 
-func resourceAlibabacloudStackImageCopyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	err := ecsService.deleteImageforDest(d, d.Get("destination_region_id").(string))
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), AlibabacloudStackSdkGoERROR))
 	}
-	stateConf := BuildStateConf([]string{"Available", "CreateFailed"}, []string{"Deprecated", "UnAvailable"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFuncforcopy(d.Id(), d.Get("destination_region_id").(string), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	stateConf := BuildStateConf([]string{"Available", "CreateFailed"}, []string{"Deprecated", "UnAvailable"}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, ecsService.ImageStateRefreshFuncforcopy(d.Id(), d.Get("destination_region_id").(string), []string{"CreateFailed", "UnAvailable"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return resourceAlibabacloudStackImageCopyRead(d, meta)
+	return resourceAlibabacloudStackImageCopyRead(ctx, d, meta)
 }
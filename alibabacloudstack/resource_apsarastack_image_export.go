@@ -1,24 +1,29 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackImageExport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageExportCreate,
-		Read:   resourceAlibabacloudStackImageExportRead,
-		Delete: resourceAlibabacloudStackImageExportDelete,
+		CreateContext: resourceAlibabacloudStackImageExportCreate,
+		ReadContext:   resourceAlibabacloudStackImageExportRead,
+		DeleteContext: resourceAlibabacloudStackImageExportDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlibabacloudStackImageExportImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"image_id": {
@@ -40,8 +45,8 @@ func resourceAlibabacloudStackImageExport() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client: client}
 
 	request := ecs.CreateExportImageRequest()
@@ -52,7 +57,7 @@ func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ImageId = d.Get("image_id").(string)
 	request.OSSBucket = d.Get("oss_bucket").(string)
 	request.OSSPrefix = d.Get("oss_prefix").(string)
@@ -60,7 +65,7 @@ func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta int
 		return ecsClient.ExportImage(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_image_export", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_image_export", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
@@ -68,16 +73,16 @@ func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta int
 	taskId := response.TaskId
 	d.SetId(request.ImageId)
 	stateConf := BuildStateConf([]string{"ExportImage", "Waiting", "Processing"}, []string{"Finished"}, d.Timeout(schema.TimeoutCreate), 2*time.Minute, ecsService.TaskStateRefreshFunc(taskId, []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return resourceAlibabacloudStackImageExportRead(d, meta)
+	return resourceAlibabacloudStackImageExportRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackImageExportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client: client}
 
 	object, err := ecsService.DescribeImageById(d.Id())
@@ -86,14 +91,29 @@ func resourceAlibabacloudStackImageExportRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("image_id", object.ImageId)
-	return WrapError(err)
+	return DiagnosticsFromError(WrapError(err))
 }
 
-func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+// resourceAlibabacloudStackImageExportImport imports an export from the id <image_id>:<oss_bucket> or
+// <image_id>:<oss_bucket>:<oss_prefix>, as the image does not keep where it was exported to.
+func resourceAlibabacloudStackImageExportImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), COLON_SEPARATED, 3)
+	if len(parts) < 2 {
+		return nil, WrapError(Error("Invalid import id %s. Expected <image_id>:<oss_bucket> or <image_id>:<oss_bucket>:<oss_prefix>.", d.Id()))
+	}
+	d.SetId(parts[0])
+	d.Set("oss_bucket", parts[1])
+	if len(parts) == 3 {
+		d.Set("oss_prefix", parts[2])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAlibabacloudStackImageExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
@@ -101,7 +121,7 @@ func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta int
 		return ossClient.Bucket(d.Get("oss_bucket").(string))
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Get("oss_bucket").(string), "OSS Bucket", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Get("oss_bucket").(string), "OSS Bucket", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("OSS Bucket", raw, requestInfo, map[string]string{"bucketName": d.Get("oss_bucket").(string)})
 	bucket, _ := raw.(*oss.Bucket)
@@ -110,34 +130,13 @@ func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta int
 		objectName = d.Get("oss_prefix").(string) + "_" + objectName
 	}
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "OneRouter",
-		"Action":          "DoApi",
-		"AppAction":       "DeleteObjects",
-		"AppName":         "one-console-app-oss",
-		"Version":         "2018-12-12",
-		"Params":          "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + d.Get("oss_bucket").(string) + "\",\"objects\":[\"" + objectName + "\"]}}",
-		"AccountInfo":     "",
-	}
-	request.Method = "POST"
-	request.Product = "OneRouter"
-	request.Version = "2018-12-12"
+	request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoApi", "")
 	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DoApi"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceid": d.Get("oss_bucket").(string)}
+	request.Headers["x-acs-instanceid"] = d.Get("oss_bucket").(string)
+	request.QueryParams["AppAction"] = "DeleteObjects"
+	request.QueryParams["AppName"] = "one-console-app-oss"
+	request.QueryParams["Params"] = "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + d.Get("oss_bucket").(string) + "\",\"objects\":[\"" + objectName + "\"]}}"
+	request.QueryParams["AccountInfo"] = ""
 
 	raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -151,9 +150,9 @@ func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta int
 
 	bresponse, _ := raw.(*responses.CommonResponse)
 	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObject", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObject", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("DeleteObjects", raw, requestInfo, bresponse.GetHttpContentString())
 
-	return WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
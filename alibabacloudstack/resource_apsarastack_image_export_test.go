@@ -45,6 +45,12 @@ func TestAccAlibabacloudStackImageExport(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: resourceImportStateIdFunc(resourceId, "id", "oss_bucket", "oss_prefix"),
+			},
 		},
 	})
 }
@@ -96,3 +102,11 @@ resource "alibabacloudstack_oss_bucket" "default" {
 }
 `, DataAlibabacloudstackVswitchZones, DataAlibabacloudstackInstanceTypes, DataAlibabacloudstackImages, name)
 }
+
+func TestResourceAlibabacloudStackImageExportImport(t *testing.T) {
+	testResourceImportState(t, resourceAlibabacloudStackImageExport(), "m-123:tf-bucket:ecsExport",
+		"m-123", map[string]interface{}{"oss_bucket": "tf-bucket", "oss_prefix": "ecsExport"})
+	testResourceImportState(t, resourceAlibabacloudStackImageExport(), "m-123:tf-bucket",
+		"m-123", map[string]interface{}{"oss_bucket": "tf-bucket", "oss_prefix": ""})
+	testResourceImportState(t, resourceAlibabacloudStackImageExport(), "malformed", "", nil)
+}
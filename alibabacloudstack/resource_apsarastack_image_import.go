@@ -1,27 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackImageImport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageImportCreate,
-		Read:   resourceAlibabacloudStackImageImportRead,
-		Update: resourceAlibabacloudStackImageImportUpdate,
-		Delete: resourceAlibabacloudStackImageImportDelete,
+		CreateContext: resourceAlibabacloudStackImageImportCreate,
+		ReadContext:   resourceAlibabacloudStackImageImportRead,
+		UpdateContext: resourceAlibabacloudStackImageImportUpdate,
+		DeleteContext: resourceAlibabacloudStackImageImportDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -124,8 +127,8 @@ func resourceAlibabacloudStackImageImport() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client: client}
 
 	request := ecs.CreateImportImageRequest()
@@ -136,7 +139,7 @@ func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Architecture = d.Get("architecture").(string)
 	request.Description = d.Get("description").(string)
 	request.ImageName = d.Get("image_name").(string)
@@ -165,21 +168,21 @@ func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta int
 		return ecsClient.ImportImage(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_import_image", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_import_image", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	resp, _ := raw.(*ecs.ImportImageResponse)
 	d.SetId(resp.ImageId)
 	stateConf := BuildStateConfByTimes([]string{"Waiting"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFunc(d.Id(), []string{"CreateFailed", "UnAvailable"}), 200)
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return resourceAlibabacloudStackImageImportRead(d, meta)
+	return resourceAlibabacloudStackImageImportRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageImportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client: client}
 
 	object, err := ecsService.DescribeImageById(d.Id())
@@ -188,7 +191,7 @@ func resourceAlibabacloudStackImageImportRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("image_name", object.ImageName)
 	d.Set("description", object.Description)
@@ -200,20 +203,20 @@ func resourceAlibabacloudStackImageImportRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackImageImportUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	err := ecsService.updateImage(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackImageImportRead(d, meta)
+	return resourceAlibabacloudStackImageImportRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageImportDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
-	return ecsService.deleteImage(d)
+	return DiagnosticsFromError(ecsService.deleteImage(d))
 }
 
 func FlattenImageImportDiskDeviceMappings(list []ecs.DiskDeviceMapping) []map[string]interface{} {
@@ -1,18 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackImageSharePermission() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageSharePermissionCreate,
-		Read:   resourceAlibabacloudStackImageSharePermissionRead,
-		Delete: resourceAlibabacloudStackImageSharePermissionDelete,
+		CreateContext: resourceAlibabacloudStackImageSharePermissionCreate,
+		ReadContext:   resourceAlibabacloudStackImageSharePermissionRead,
+		DeleteContext: resourceAlibabacloudStackImageSharePermissionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -31,8 +33,8 @@ func resourceAlibabacloudStackImageSharePermission() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageSharePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageSharePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	imageId := d.Get("image_id").(string)
 	accountId := d.Get("account_id").(string)
@@ -44,7 +46,7 @@ func resourceAlibabacloudStackImageSharePermissionCreate(d *schema.ResourceData,
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ImageId = imageId
 	accountSli := []string{accountId}
 	request.AddAccount = &accountSli
@@ -52,16 +54,16 @@ func resourceAlibabacloudStackImageSharePermissionCreate(d *schema.ResourceData,
 		return ecsClient.ModifyImageSharePermission(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_image_share_permission", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_image_share_permission", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	d.SetId(imageId + ":" + accountId)
-	return resourceAlibabacloudStackImageSharePermissionRead(d, meta)
+	return resourceAlibabacloudStackImageSharePermissionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageSharePermissionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageSharePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client: client}
 	object, err := ecsService.DescribeImageShareByImageId(d.Id())
 	if err != nil {
@@ -69,16 +71,16 @@ func resourceAlibabacloudStackImageSharePermissionRead(d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	d.Set("image_id", object.ImageId)
 	d.Set("account_id", parts[1])
-	return WrapError(err)
+	return DiagnosticsFromError(WrapError(err))
 }
 
-func resourceAlibabacloudStackImageSharePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackImageSharePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ecs.CreateModifyImageSharePermissionRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -87,7 +89,7 @@ func resourceAlibabacloudStackImageSharePermissionDelete(d *schema.ResourceData,
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	parts, err := ParseResourceId(d.Id(), 2)
 	request.ImageId = parts[0]
 	accountSli := []string{parts[1]}
@@ -96,7 +98,7 @@ func resourceAlibabacloudStackImageSharePermissionDelete(d *schema.ResourceData,
 		return ecsClient.ModifyImageSharePermission(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_image_share_permission", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_image_share_permission", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	return nil
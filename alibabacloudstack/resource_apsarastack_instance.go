@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"encoding/base64"
@@ -22,10 +24,10 @@ import (
 
 func resourceAlibabacloudStackInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackInstanceCreate,
-		Read:   resourceAlibabacloudStackInstanceRead,
-		Update: resourceAlibabacloudStackInstanceUpdate,
-		Delete: resourceAlibabacloudStackInstanceDelete,
+		CreateContext: resourceAlibabacloudStackInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackInstanceRead,
+		UpdateContext: resourceAlibabacloudStackInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -292,13 +294,13 @@ func resourceAlibabacloudStackInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request, err := buildAlibabacloudStackInstanceArgs(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.IoOptimized = string(IOOptimized)
 
@@ -311,7 +313,7 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 		request.Scheme = "http"
 	}
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.RunInstances(request)
 		})
@@ -331,37 +333,37 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 60*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	if v, ok := d.GetOk("security_groups"); ok {
 		sgs := expandStringList(v.(*schema.Set).List())
 		if len(sgs) > 1 {
 			err := ecsService.JoinSecurityGroups(d.Id(), sgs[1:])
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 	}
 	if d.Get("enable_ipv6").(bool) && d.Get("ipv6_address_count").(int) > 0 {
 		ipv6s, err := AssignIpv6AddressesFunc(d.Id(), d.Get("ipv6_address_count").(int), d.Get("ipv6_address_list").([]string), meta)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		} else {
 			d.Set("ipv6_address_list", ipv6s)
 		}
 	}
-	return resourceAlibabacloudStackInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	instance, err := ecsService.DescribeInstance(d.Id())
@@ -370,7 +372,7 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	log.Printf("[ECS Creation]: Getting Instance Details Successfully: %s", instance.Status)
 	disk, err := ecsService.DescribeInstanceSystemDisk(d.Id(), instance.ResourceGroupId)
@@ -379,7 +381,7 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("system_disk_category", disk.Category)
@@ -416,7 +418,7 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		sgs = append(sgs, sg)
 	}
 	if err := d.Set("security_groups", sgs); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if !d.IsNewResource() || d.HasChange("user_data") {
@@ -428,14 +430,14 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		}
 		dataRequest.RegionId = client.RegionId
 		dataRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		dataRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		dataRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		dataRequest.InstanceId = d.Id()
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeUserData(dataRequest)
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), dataRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), dataRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(dataRequest.GetActionName(), raw, dataRequest.RpcRequest, dataRequest)
 		response, _ := raw.(*ecs.DescribeUserDataResponse)
@@ -463,13 +465,13 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceIds = convertListToJsonString([]interface{}{d.Id()})
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstanceRamRole(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
@@ -489,13 +491,13 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstanceAutoRenewAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
@@ -503,8 +505,8 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	d.Partial(true)
@@ -512,7 +514,7 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 	if !d.IsNewResource() {
 		err := setTags(client, TagResourceInstance, d)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	if d.HasChange("system_disk_tags") {
@@ -521,13 +523,13 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 		if diskid == "" {
 			disk, err := ecsService.DescribeInstanceSystemDisk(d.Id(), client.ResourceGroup)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			diskid = disk.DiskId
 		}
 		err := updateTags(client, []string{diskid}, "disk", oraw, nraw)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -543,13 +545,13 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 			if len(al) > 0 {
 				err := ecsService.JoinSecurityGroups(d.Id(), al)
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 			if len(rl) > 0 {
 				err := ecsService.LeaveSecurityGroups(d.Id(), rl)
 				if err != nil {
-					return WrapError(err)
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 
@@ -558,30 +560,30 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 	}
 
 	run := false
-	imageUpdate, err := modifyInstanceImage(d, meta, run)
+	imageUpdate, err := modifyInstanceImage(ctx, d, meta, run)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	vpcUpdate, err := modifyVpcAttribute(d, meta, run)
+	vpcUpdate, err := modifyVpcAttribute(ctx, d, meta, run)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	passwordUpdate, err := modifyInstanceAttribute(d, meta)
+	passwordUpdate, err := modifyInstanceAttribute(ctx, d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	typeUpdate, err := modifyInstanceType(d, meta, run)
+	typeUpdate, err := modifyInstanceType(ctx, d, meta, run)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if imageUpdate || vpcUpdate || passwordUpdate || typeUpdate {
 		run = true
 		instance, errDesc := ecsService.DescribeInstance(d.Id())
 		if errDesc != nil {
-			return WrapError(errDesc)
+			return DiagnosticsFromError(WrapError(errDesc))
 		}
 		if instance.Status == string(Running) {
 			stopRequest := ecs.CreateStopInstanceRequest()
@@ -592,7 +594,7 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 			}
 			stopRequest.RegionId = client.RegionId
 			stopRequest.Headers = map[string]string{"RegionId": client.RegionId}
-			stopRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			stopRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 			stopRequest.InstanceId = d.Id()
 			stopRequest.ForceStop = requests.NewBoolean(false)
@@ -600,27 +602,27 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 				return ecsClient.StopInstance(stopRequest)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), stopRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), stopRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(stopRequest.GetActionName(), raw)
 		}
 
 		stateConf := BuildStateConf([]string{"Pending", "Running", "Stopping"}, []string{"Stopped"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
 
-		if _, err = stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 
-		if _, err := modifyInstanceImage(d, meta, run); err != nil {
-			return WrapError(err)
+		if _, err := modifyInstanceImage(ctx, d, meta, run); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
-		if _, err := modifyVpcAttribute(d, meta, run); err != nil {
-			return WrapError(err)
+		if _, err := modifyVpcAttribute(ctx, d, meta, run); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
-		if _, err := modifyInstanceType(d, meta, run); err != nil {
-			return WrapError(err)
+		if _, err := modifyInstanceType(ctx, d, meta, run); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		startRequest := ecs.CreateStartInstanceRequest()
@@ -630,10 +632,10 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 			startRequest.Scheme = "http"
 		}
 		startRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		startRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		startRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		startRequest.InstanceId = d.Id()
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.StartInstance(startRequest)
 			})
@@ -649,7 +651,7 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 		})
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), startRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), startRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		// Start instance sometimes costs more than 8 minutes when os type is centos.
@@ -662,13 +664,13 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 			MinTimeout: 3 * time.Second,
 		}
 
-		if _, err = stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
 
-	if err := modifyInstanceNetworkSpec(d, meta); err != nil {
-		return WrapError(err)
+	if err := modifyInstanceNetworkSpec(ctx, d, meta); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// if d.HasChange("ipv6_address_count") && d.Get("ipv6_address_count").(int) != len(d.Get("ipv6_address_list").([]interface{})) {
 	// 	ipv6s, err := AssignIpv6AddressesFunc(d.Id(), d.Get("ipv6_address_count").(int), meta)
@@ -680,11 +682,11 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 	// }
 
 	d.Partial(false)
-	return resourceAlibabacloudStackInstanceRead(d, meta)
+	return resourceAlibabacloudStackInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	stopRequest := ecs.CreateStopInstanceRequest()
@@ -694,7 +696,7 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 		stopRequest.Scheme = "http"
 	}
 	stopRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	stopRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	stopRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	stopRequest.InstanceId = d.Id()
 	stopRequest.ForceStop = requests.NewBoolean(true)
 
@@ -705,12 +707,12 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 		deleteRequest.Scheme = "http"
 	}
 	deleteRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	deleteRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	deleteRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	deleteRequest.InstanceId = d.Id()
 	deleteRequest.Force = requests.NewBoolean(true)
 
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteInstance(deleteRequest)
 		})
@@ -731,13 +733,13 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 		if IsExpectedErrors(err, EcsNotFound) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), deleteRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), deleteRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{"Pending", "Running", "Stopped", "Stopping"}, []string{}, d.Timeout(schema.TimeoutDelete), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
 
-	if _, err = stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
@@ -754,10 +756,9 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ecs",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
+		"Product":       "ecs",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
 	}
 	if v := d.Get("enable_ipv6").(bool); v {
 		request.QueryParams["Ipv6CidrBlock"] = d.Get("ipv6_cidr_block").(string)
@@ -920,7 +921,7 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 	return request, nil
 }
 
-func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyInstanceImage(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	if d.IsNewResource() {
 		d.Partial(false)
 		return false, nil
@@ -945,7 +946,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		request.ImageId = d.Get("image_id").(string)
 		request.SystemDiskSize = requests.NewInteger(d.Get("system_disk_size").(int))
@@ -965,7 +966,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 				return update, WrapError(errDesc)
 			}
 			var disk ecs.Disk
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				disk, err = ecsService.DescribeInstanceSystemDisk(d.Id(), instance.ResourceGroupId)
 				if err != nil {
 					if NotFoundError(err) {
@@ -1003,7 +1004,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 	return update, nil
 }
 
-func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, error) {
+func modifyInstanceAttribute(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -1020,7 +1021,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("instance_name") {
 		//d.SetPartial("instance_name")
@@ -1082,7 +1083,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	}
 
 	if update {
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceAttribute(request)
 			})
@@ -1103,7 +1104,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	return reboot, nil
 }
 
-func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyVpcAttribute(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -1120,7 +1121,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 	request.InstanceId = d.Id()
 	request.VSwitchId = d.Get("vswitch_id").(string)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("vswitch_id") {
 		update = true
@@ -1151,7 +1152,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 
 	if update {
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceVpcAttribute(request)
 			})
@@ -1177,7 +1178,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 	return update, nil
 }
 
-func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyInstanceType(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	if d.IsNewResource() {
 		d.Partial(false)
 		return false, nil
@@ -1200,11 +1201,11 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceType = d.Get("instance_type").(string)
 		request.ClientToken = buildClientToken(request.GetActionName())
 
-		err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			args := *request
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceSpec(&args)
@@ -1248,7 +1249,7 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 	return update, nil
 }
 
-func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
+func modifyInstanceNetworkSpec(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -1266,7 +1267,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	request.InstanceId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("internet_max_bandwidth_out") {
 		o, n := d.GetChange("internet_max_bandwidth_out")
@@ -1288,7 +1289,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	wait := incrementalWait(2*time.Second, 2*time.Second)
 
 	if update {
-		if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceNetworkSpec(request)
 			})
@@ -1341,7 +1342,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 			}
 			request.InstanceId = d.Id()
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.AllocatePublicIpAddress(request)
 			})
@@ -1369,7 +1370,7 @@ func AssignIpv6AddressesFunc(id string, ipv6_addresses_count int, ipv6_addresses
 	request.Ipv6Address = &ipv6_addresses
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	raw, ipv6_err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.AssignIpv6Addresses(request)
 	})
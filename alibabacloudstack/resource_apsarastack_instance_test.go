@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"log"
@@ -42,7 +43,7 @@ func testSweepInstances(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.RegionId = client.RegionId
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
@@ -813,3 +814,38 @@ var testAccInstanceCheckMap = map[string]string{
 	"internet_max_bandwidth_out": "0",
 	"force_delete":               NOSET,
 }
+
+// TestAlibabacloudStackInstance_cassette replays the creation, import, drift and deletion of an ECS
+// instance from its cassette, without any network access.
+func TestAlibabacloudStackInstance_cassette(t *testing.T) {
+	client := testCassetteClient(t, "instance")
+	r := resourceAlibabacloudStackInstance()
+
+	d := testCassetteCreate(t, r, client, map[string]interface{}{
+		"image_id":        "centos_7_9_x64_20G_alibase_20230718.vhd",
+		"instance_type":   "ecs.n4.large",
+		"security_groups": []interface{}{"sg-cassette01"},
+		"vswitch_id":      "vsw-cassette01",
+		"instance_name":   "tf-testacc-cassette-ecs",
+	})
+	expected := map[string]interface{}{
+		"image_id":             "centos_7_9_x64_20G_alibase_20230718.vhd",
+		"instance_type":        "ecs.n4.large",
+		"vswitch_id":           "vsw-cassette01",
+		"instance_name":        "tf-testacc-cassette-ecs",
+		"availability_zone":    "cn-cassette-env1-amtest1001-a",
+		"private_ip":           "172.16.0.20",
+		"system_disk_category": "cloud_efficiency",
+		"system_disk_size":     40,
+		"system_disk_id":       "d-cassette01",
+		"status":               "Running",
+	}
+	testCassetteCheckAttributes(t, "create", d, expected)
+	testCassetteCheckAttributes(t, "import", testCassetteImport(t, r, client, d.Id()), expected)
+
+	// The instance was renamed out of Terraform
+	testCassetteCheckDiags(t, "drift", r.ReadContext(context.Background(), d, client))
+	testCassetteCheckAttributes(t, "drift", d, map[string]interface{}{"instance_name": "changed-out-of-terraform"})
+
+	testCassetteCheckDiags(t, "delete", r.DeleteContext(context.Background(), d, client))
+}
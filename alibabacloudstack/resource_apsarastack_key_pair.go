@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"os"
@@ -19,14 +21,20 @@ import (
 
 func resourceAlibabacloudStackKeyPair() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKeyPairCreate,
-		Read:   resourceAlibabacloudStackKeyPairRead,
-		Update: resourceAlibabacloudStackKeyPairUpdate,
-		Delete: resourceAlibabacloudStackKeyPairDelete,
+		CreateContext: resourceAlibabacloudStackKeyPairCreate,
+		ReadContext:   resourceAlibabacloudStackKeyPairRead,
+		UpdateContext: resourceAlibabacloudStackKeyPairUpdate,
+		DeleteContext: resourceAlibabacloudStackKeyPairDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": {
 				Type:     schema.TypeString,
@@ -70,8 +78,8 @@ func resourceAlibabacloudStackKeyPair() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	var keyName string
 	if v, ok := d.GetOk("key_name"); ok {
@@ -84,59 +92,29 @@ func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interfa
 
 	if publicKey, ok := d.GetOk("public_key"); ok {
 		request := ecs.CreateImportKeyPairRequest()
-		request.RegionId = client.RegionId
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.Headers = map[string]string{
-			"RegionId":   client.RegionId,
-			"Department": client.Department,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeyId":     client.AccessKey,
-			"AccessKeySecret": client.SecretKey,
-			"SecurityToken":   client.Config.SecurityToken,
-			"Product":         "ecs",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup}
+		client.InitRpcRequest(request.RpcRequest)
+		request.Headers["Department"] = client.Department
 		request.KeyPairName = keyName
 		request.PublicKeyBody = publicKey.(string)
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ImportKeyPair(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_key_pair", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_key_pair", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		object, _ := raw.(*ecs.ImportKeyPairResponse)
 		d.SetId(object.KeyPairName)
 	} else {
 		request := ecs.CreateCreateKeyPairRequest()
-		request.RegionId = client.RegionId
-
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeyId":     client.AccessKey,
-			"AccessKeySecret": client.SecretKey,
-			"SecurityToken":   client.Config.SecurityToken,
-			"Product":         "ecs",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-		}
+		client.InitRpcRequest(request.RpcRequest)
 		request.KeyPairName = keyName
 		fmt.Println(request)
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.CreateKeyPair(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_key_pair", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "AlibabacloudStack_key_pair", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		keyPair, _ := raw.(*ecs.CreateKeyPairResponse)
@@ -147,20 +125,20 @@ func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	return resourceAlibabacloudStackKeyPairUpdate(d, meta)
+	return resourceAlibabacloudStackKeyPairUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackKeyPairUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKeyPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	err := setTags(client, TagResourceKeypair, d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackKeyPairRead(d, meta)
+	return resourceAlibabacloudStackKeyPairRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKeyPairRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	keyPair, err := ecsService.DescribeKeyPair(d.Id())
@@ -169,7 +147,7 @@ func resourceAlibabacloudStackKeyPairRead(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("key_name", keyPair.KeyPairName)
 	d.Set("finger_print", keyPair.KeyPairFingerPrint)
@@ -180,28 +158,15 @@ func resourceAlibabacloudStackKeyPairRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceAlibabacloudStackKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request := ecs.CreateDeleteKeyPairsRequest()
-	request.RegionId = client.RegionId
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"SecurityToken":   client.Config.SecurityToken,
-		"Product":         "ecs",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup}
+	client.InitRpcRequest(request.RpcRequest)
 	request.KeyPairNames = convertListToJsonString(append(make([]interface{}, 0, 1), d.Id()))
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteKeyPairs(request)
 		})
@@ -217,5 +182,5 @@ func resourceAlibabacloudStackKeyPairDelete(d *schema.ResourceData, meta interfa
 	if err != nil {
 		WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForKeyPair(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(ecsService.WaitForKeyPair(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,13 +18,18 @@ import (
 
 func resourceAlibabacloudStackKeyPairAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKeyPairAttachmentCreate,
-		Read:   resourceAlibabacloudStackKeyPairAttachmentRead,
-		Delete: resourceAlibabacloudStackKeyPairAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackKeyPairAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackKeyPairAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackKeyPairAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": {
 				Type:         schema.TypeString,
@@ -45,8 +52,8 @@ func resourceAlibabacloudStackKeyPairAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKeyPairAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	keyName := d.Get("key_name").(string)
 	instanceIds := d.Get("instance_ids").(*schema.Set).List()
@@ -56,7 +63,7 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 	if force {
 		ids, _, err := ecsService.QueryInstancesWithKeyPair("", keyName)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		for _, id := range ids {
@@ -70,7 +77,7 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 	}
 
 	if err := ecsService.AttachKeyPair(keyName, instanceIds); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if force {
@@ -82,7 +89,7 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 		} else {
 			request.Scheme = "http"
 		}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.ForceStop = requests.NewBoolean(true)
 		for _, id := range newIds {
 			request.InstanceId = id
@@ -90,25 +97,25 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 				return ecsClient.RebootInstance(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		}
 		for _, id := range newIds {
-			if err := ecsService.WaitForEcsInstance(id, Running, DefaultLongTimeout); err != nil {
-				return WrapError(err)
+			if err := ecsService.WaitForEcsInstance(id, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 	}
 
 	d.SetId(keyName + ":" + convertListToJsonString(instanceIds))
 
-	return resourceAlibabacloudStackKeyPairAttachmentRead(d, meta)
+	return resourceAlibabacloudStackKeyPairAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	keyName := strings.Split(d.Id(), ":")[0]
 	object, err := ecsService.DescribeKeyPairAttachment(d.Id())
@@ -118,7 +125,7 @@ func resourceAlibabacloudStackKeyPairAttachmentRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("key_name", object.KeyPairName)
@@ -127,15 +134,15 @@ func resourceAlibabacloudStackKeyPairAttachmentRead(d *schema.ResourceData, meta
 	} else {
 		ids, _, err := ecsService.QueryInstancesWithKeyPair("", keyName)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		d.Set("instance_ids", ids)
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKeyPairAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	keyName := strings.Split(d.Id(), ":")[0]
 	instanceIds := strings.Split(d.Id(), ":")[1]
@@ -148,10 +155,10 @@ func resourceAlibabacloudStackKeyPairAttachmentDelete(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.KeyPairName = keyName
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return DiagnosticsFromError(resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request.InstanceIds = instanceIds
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachKeyPair(request)
@@ -174,5 +181,5 @@ func resourceAlibabacloudStackKeyPairAttachmentDelete(d *schema.ResourceData, me
 		}
 
 		return nil
-	})
+	}))
 }
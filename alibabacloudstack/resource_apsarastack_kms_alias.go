@@ -1,17 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackKmsAlias() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsAliasCreate,
-		Read:   resourceAlibabacloudStackKmsAliasRead,
-		Update: resourceAlibabacloudStackKmsAliasUpdate,
-		Delete: resourceAlibabacloudStackKmsAliasDelete,
+		CreateContext: resourceAlibabacloudStackKmsAliasCreate,
+		ReadContext:   resourceAlibabacloudStackKmsAliasRead,
+		UpdateContext: resourceAlibabacloudStackKmsAliasUpdate,
+		DeleteContext: resourceAlibabacloudStackKmsAliasDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -29,12 +31,12 @@ func resourceAlibabacloudStackKmsAlias() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := kms.CreateCreateAliasRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.AliasName = d.Get("alias_name").(string)
 	request.KeyId = d.Get("key_id").(string)
@@ -42,16 +44,16 @@ func resourceAlibabacloudStackKmsAliasCreate(d *schema.ResourceData, meta interf
 		return kmsClient.CreateAlias(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_alias", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_alias", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw)
 	d.SetId(request.AliasName)
 
-	return resourceAlibabacloudStackKmsAliasRead(d, meta)
+	return resourceAlibabacloudStackKmsAliasRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kmsService := KmsService{client}
 	object, err := kmsService.DescribeKmsAlias(d.Id())
 	if err != nil {
@@ -59,19 +61,19 @@ func resourceAlibabacloudStackKmsAliasRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("alias_name", d.Id())
 	d.Set("key_id", object.KeyId)
 	return nil
 }
-func resourceAlibabacloudStackKmsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if d.HasChange("key_id") {
 		request := kms.CreateUpdateAliasRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.AliasName = d.Id()
 		request.KeyId = d.Get("key_id").(string)
@@ -80,16 +82,16 @@ func resourceAlibabacloudStackKmsAliasUpdate(d *schema.ResourceData, meta interf
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackKmsAliasRead(d, meta)
+	return resourceAlibabacloudStackKmsAliasRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := kms.CreateDeleteAliasRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.AliasName = d.Id()
 	raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
@@ -97,7 +99,7 @@ func resourceAlibabacloudStackKmsAliasDelete(d *schema.ResourceData, meta interf
 	})
 	addDebug(request.GetActionName(), raw)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,19 +1,21 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsCiphertextCreate,
-		Read:   schema.Noop,
-		Delete: resourceAlibabacloudStackKmsCiphertextDelete,
+		CreateContext: resourceAlibabacloudStackKmsCiphertextCreate,
+		ReadContext:   schema.NoopContext,
+		DeleteContext: resourceAlibabacloudStackKmsCiphertextDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -47,8 +49,8 @@ func resourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsCiphertextCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	// Since a ciphertext has no ID, we create an ID based on
 	// current unix time.
@@ -56,7 +58,7 @@ func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta i
 
 	request := kms.CreateEncryptRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.Plaintext = d.Get("plaintext").(string)
 	request.KeyId = d.Get("key_id").(string)
@@ -66,7 +68,7 @@ func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta i
 		cm := context.(map[string]interface{})
 		contextJson, err := convertMaptoJsonString(cm)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		request.EncryptionContext = string(contextJson)
 	}
@@ -75,7 +77,7 @@ func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta i
 		return kmsClient.Encrypt(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_ciphertext", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*kms.EncryptResponse)
@@ -84,6 +86,6 @@ func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackKmsCiphertextDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsCiphertextDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
@@ -1,21 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackKmsKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsKeyCreate,
-		Read:   resourceAlibabacloudStackKmsKeyRead,
-		Update: resourceAlibabacloudStackKmsKeyUpdate,
-		Delete: resourceAlibabacloudStackKmsKeyDelete,
+		CreateContext: resourceAlibabacloudStackKmsKeyCreate,
+		ReadContext:   resourceAlibabacloudStackKmsKeyRead,
+		UpdateContext: resourceAlibabacloudStackKmsKeyUpdate,
+		DeleteContext: resourceAlibabacloudStackKmsKeyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -64,6 +66,13 @@ func resourceAlibabacloudStackKmsKey() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"ENCRYPT/DECRYPT", "SIGN/VERIFY"}, false),
 				Default:      "ENCRYPT/DECRYPT",
 			},
+			"key_spec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Aliyun_AES_256", "Aliyun_SM4", "RSA_2048", "EC_P256", "EC_P256K", "EC_SM2"}, false),
+			},
 			"last_rotation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -114,12 +123,12 @@ func resourceAlibabacloudStackKmsKey() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := kms.CreateCreateKeyRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("automatic_rotation"); ok {
 		request.EnableAutomaticRotation = requests.NewBoolean(convertAutomaticRotationRequest(v.(string)))
@@ -127,6 +136,9 @@ func resourceAlibabacloudStackKmsKeyCreate(d *schema.ResourceData, meta interfac
 	if v, ok := d.GetOk("description"); ok {
 		request.Description = v.(string)
 	}
+	if v, ok := d.GetOk("key_spec"); ok {
+		request.KeySpec = v.(string)
+	}
 	if v, ok := d.GetOk("key_usage"); ok {
 		request.KeyUsage = v.(string)
 	}
@@ -143,20 +155,20 @@ func resourceAlibabacloudStackKmsKeyCreate(d *schema.ResourceData, meta interfac
 		return kmsClient.CreateKey(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_key", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_key", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*kms.CreateKeyResponse)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	d.SetId(fmt.Sprintf("%v", response.KeyMetadata.KeyId))
 
-	return resourceAlibabacloudStackKmsKeyRead(d, meta)
+	return resourceAlibabacloudStackKmsKeyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kmsService := KmsService{client}
 	object, err := kmsService.DescribeKmsKey(d.Id())
 	if err != nil {
@@ -164,7 +176,7 @@ func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("arn", object.Arn)
@@ -174,6 +186,7 @@ func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{
 	d.Set("delete_date", object.DeleteDate)
 	d.Set("description", object.Description)
 	d.Set("key_state", object.KeyState)
+	d.Set("key_spec", object.KeySpec)
 	d.Set("key_usage", object.KeyUsage)
 	d.Set("last_rotation_date", object.LastRotationDate)
 	d.Set("material_expire_time", object.MaterialExpireTime)
@@ -184,15 +197,15 @@ func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{
 	d.Set("rotation_interval", object.RotationInterval)
 	return nil
 }
-func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kmsService := KmsService{client}
 	d.Partial(true)
 
 	if d.HasChange("description") {
 		request := kms.CreateUpdateKeyDescriptionRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.KeyId = d.Id()
 		request.Description = d.Get("description").(string)
@@ -201,14 +214,14 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("description")
 	}
 	update := false
 	request := kms.CreateUpdateRotationPolicyRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.KeyId = d.Id()
 	if d.HasChange("automatic_rotation") {
@@ -225,7 +238,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("automatic_rotation")
 		//d.SetPartial("rotation_interval")
@@ -233,7 +246,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 	if d.HasChange("key_state") || d.HasChange("is_enabled") {
 		object, err := kmsService.DescribeKmsKey(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		var target = ""
 		if k, ok := d.GetOk("key_state"); ok {
@@ -252,7 +265,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 			if target == "Disabled" {
 				request := kms.CreateDisableKeyRequest()
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.KeyId = d.Id()
 				raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
@@ -260,7 +273,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 				})
 				addDebug(request.GetActionName(), raw)
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				//d.SetPartial("key_state")
 				//d.SetPartial("is_enabled")
@@ -268,7 +281,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 			if target == "Enabled" {
 				request := kms.CreateEnableKeyRequest()
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.KeyId = d.Id()
 				raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
@@ -276,7 +289,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 				})
 				addDebug(request.GetActionName(), raw)
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				//d.SetPartial("key_state")
 				//d.SetPartial("is_enabled")
@@ -284,13 +297,13 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackKmsKeyRead(d, meta)
+	return resourceAlibabacloudStackKmsKeyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := kms.CreateScheduleKeyDeletionRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.KeyId = d.Id()
 	if v, ok := d.GetOk("pending_window_in_days"); ok {
@@ -305,7 +318,7 @@ func resourceAlibabacloudStackKmsKeyDelete(d *schema.ResourceData, meta interfac
 	})
 	addDebug(request.GetActionName(), raw)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
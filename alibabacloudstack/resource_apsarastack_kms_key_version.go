@@ -0,0 +1,82 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackKmsKeyVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackKmsKeyVersionCreate,
+		ReadContext:   resourceAlibabacloudStackKmsKeyVersionRead,
+		DeleteContext: resourceAlibabacloudStackKmsKeyVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackKmsKeyVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := kms.CreateCreateKeyVersionRequest()
+	client.InitRpcRequest(request.RpcRequest)
+
+	request.KeyId = d.Get("key_id").(string)
+	raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
+		return kmsClient.CreateKeyVersion(request)
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_key_version", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*kms.CreateKeyVersionResponse)
+	d.SetId(fmt.Sprintf("%v%s%v", response.KeyVersion.KeyId, COLON_SEPARATED, response.KeyVersion.KeyVersionId))
+
+	return resourceAlibabacloudStackKmsKeyVersionRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackKmsKeyVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	kmsService := KmsService{client}
+	object, err := kmsService.DescribeKmsKeyVersion(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("key_id", object.KeyVersion.KeyId)
+	d.Set("key_version_id", object.KeyVersion.KeyVersionId)
+	d.Set("creation_date", object.KeyVersion.CreationDate)
+	return nil
+}
+
+func resourceAlibabacloudStackKmsKeyVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Cannot destroy the resource alibabacloudstack_kms_key_version %s. Terraform will remove this resource from the state file, however resources may remain.", d.Id()),
+	}}
+}
@@ -0,0 +1,61 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackKmsKeyVersion_basic(t *testing.T) {
+	var v kms.DescribeKeyVersionResponse
+	resourceId := "alibabacloudstack_kms_key_version.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeKmsKeyVersion")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccKmsKeyVersion%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, KmsKeyVersionBasicdependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"key_id": "${alibabacloudstack_kms_key.default.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"key_id":         CHECKSET,
+						"key_version_id": CHECKSET,
+						"creation_date":  CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func KmsKeyVersionBasicdependence(name string) string {
+	return fmt.Sprintf(`
+resource "alibabacloudstack_kms_key" "default" {
+  description            = "%s"
+  pending_window_in_days = 7
+}
+`, name)
+}
@@ -1,21 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackKmsSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsSecretCreate,
-		Read:   resourceAlibabacloudStackKmsSecretRead,
-		Update: resourceAlibabacloudStackKmsSecretUpdate,
-		Delete: resourceAlibabacloudStackKmsSecretDelete,
+		CreateContext: resourceAlibabacloudStackKmsSecretCreate,
+		ReadContext:   resourceAlibabacloudStackKmsSecretRead,
+		UpdateContext: resourceAlibabacloudStackKmsSecretUpdate,
+		DeleteContext: resourceAlibabacloudStackKmsSecretDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -83,13 +85,13 @@ func resourceAlibabacloudStackKmsSecret() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := kms.CreateCreateSecretRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("description"); ok {
 		request.Description = v.(string)
@@ -112,7 +114,7 @@ func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta inter
 		}
 		tags, err := json.Marshal(addTags)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.Tags = string(tags)
 	}
@@ -121,17 +123,17 @@ func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta inter
 		return kmsClient.CreateSecret(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_secret", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kms_secret", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*kms.CreateSecretResponse)
 	d.SetId(response.SecretName)
 
-	return resourceAlibabacloudStackKmsSecretRead(d, meta)
+	return resourceAlibabacloudStackKmsSecretRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kmsService := KmsService{client}
 	object, err := kmsService.DescribeKmsSecret(d.Id())
 	if err != nil {
@@ -139,7 +141,7 @@ func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("secret_name", d.Id())
@@ -156,7 +158,7 @@ func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interfa
 
 	getSecretValueObject, err := kmsService.GetSecretValue(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("secret_data", getSecretValueObject.SecretData)
 	d.Set("secret_data_type", getSecretValueObject.SecretDataType)
@@ -164,21 +166,21 @@ func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interfa
 	d.Set("version_stages", getSecretValueObject.VersionStages.VersionStage)
 	return nil
 }
-func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kmsService := KmsService{client}
 	d.Partial(true)
 
 	if d.HasChange("tags") {
 		if err := kmsService.setResourceTags(d, "secret"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		//d.SetPartial("tags")
 	}
 	if d.HasChange("description") {
 		request := kms.CreateUpdateSecretRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.SecretName = d.Id()
 		request.Description = d.Get("description").(string)
@@ -187,14 +189,14 @@ func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta inter
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("description")
 	}
 	update := false
 	request := kms.CreatePutSecretValueRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.SecretName = d.Id()
 	if d.HasChange("secret_data") {
@@ -219,7 +221,7 @@ func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta inter
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("secret_data")
 		//d.SetPartial("version_id")
@@ -227,13 +229,13 @@ func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta inter
 		//d.SetPartial("version_stages")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackKmsSecretRead(d, meta)
+	return resourceAlibabacloudStackKmsSecretRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsSecretDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKmsSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := kms.CreateDeleteSecretRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.SecretName = d.Id()
 	if v, ok := d.GetOkExists("force_delete_without_recovery"); ok {
@@ -250,7 +252,7 @@ func resourceAlibabacloudStackKmsSecretDelete(d *schema.ResourceData, meta inter
 		if IsExpectedErrors(err, []string{"Forbidden.ResourceNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,14 +16,20 @@ import (
 
 func resourceAlibabacloudStackKVstoreAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreAccountCreate,
-		Read:   resourceAlibabacloudStackKVStoreAccountRead,
-		Update: resourceAlibabacloudStackKVStoreAccountUpdate,
-		Delete: resourceAlibabacloudStackKVStoreAccountDelete,
+		CreateContext: resourceAlibabacloudStackKVStoreAccountCreate,
+		ReadContext:   resourceAlibabacloudStackKVStoreAccountRead,
+		UpdateContext: resourceAlibabacloudStackKVStoreAccountUpdate,
+		DeleteContext: resourceAlibabacloudStackKVStoreAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -74,20 +82,20 @@ func resourceAlibabacloudStackKVstoreAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKVStoreAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 	request := r_kvstore.CreateCreateAccountRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = d.Get("instance_id").(string)
 	request.AccountName = d.Get("account_name").(string)
 	request.AccountPrivilege = d.Get("account_privilege").(string)
 	password := d.Get("account_password").(string)
 	kmsPassword := d.Get("kms_encrypted_password").(string)
 	if password == "" && kmsPassword == "" {
-		return WrapError(Error("One of the 'account_password' and 'kms_encrypted_password' should be set."))
+		return DiagnosticsFromError(WrapError(Error("One of the 'account_password' and 'kms_encrypted_password' should be set.")))
 	}
 
 	if password != "" {
@@ -96,7 +104,7 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 		kmsService := KmsService{client}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.AccountPassword = decryptResp.Plaintext
 	}
@@ -106,10 +114,10 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 		request.AccountDescription = v.(string)
 	}
 	// wait instance running before modifying
-	if err := kvstoreService.WaitForKVstoreInstance(request.InstanceId, Normal, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := kvstoreService.WaitForKVstoreInstance(request.InstanceId, Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.CreateAccount(request)
 		})
@@ -124,21 +132,21 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kvstore_account", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kvstore_account", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.InstanceId, COLON_SEPARATED, request.AccountName))
 
-	if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackKVStoreAccountRead(d, meta)
+	return resourceAlibabacloudStackKVStoreAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 	object, err := kvstoreService.DescribeKVstoreAccount(d.Id())
 	if err != nil {
@@ -146,7 +154,7 @@ func resourceAlibabacloudStackKVStoreAccountRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.InstanceId)
@@ -158,8 +166,8 @@ func resourceAlibabacloudStackKVStoreAccountRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKVStoreAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 	d.Partial(true)
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
@@ -167,13 +175,13 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 	accountName := parts[1]
 
 	if d.HasChange("description") {
-		if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := r_kvstore.CreateModifyAccountDescriptionRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = instanceId
 		request.AccountName = accountName
 		request.AccountDescription = d.Get("description").(string)
@@ -182,20 +190,20 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 			return rkvClient.ModifyAccountDescription(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("description")
 	}
 
 	if d.HasChange("account_privilege") {
-		if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := r_kvstore.CreateGrantAccountPrivilegeRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = instanceId
 		request.AccountName = accountName
 		request.AccountPrivilege = d.Get("account_privilege").(string)
@@ -204,20 +212,20 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 			return rkvClient.GrantAccountPrivilege(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("account_privilege")
 	}
 
 	if d.HasChange("account_password") || d.HasChange("kms_encrypted_password") {
-		if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-			return WrapError(err)
+		if err := kvstoreService.WaitForKVstoreAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := r_kvstore.CreateResetAccountPasswordRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = instanceId
 		request.AccountName = accountName
 
@@ -225,16 +233,16 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 		kmsPassword := d.Get("kms_encrypted_password").(string)
 
 		if password == "" && kmsPassword == "" {
-			return WrapError(Error("One of the 'account_password' and 'kms_encrypted_password' should be set."))
+			return DiagnosticsFromError(WrapError(Error("One of the 'account_password' and 'kms_encrypted_password' should be set.")))
 		}
 
 		if password != "" {
 			request.AccountPassword = password
 		} else {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)}
 			decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request.AccountPassword = decryptResp.Plaintext
 
@@ -244,7 +252,7 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 			return rkvClient.ResetAccountPassword(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -254,20 +262,20 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackKVStoreAccountRead(d, meta)
+	return resourceAlibabacloudStackKVStoreAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKVStoreAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := r_kvstore.CreateDeleteAccountRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = parts[0]
 	request.AccountName = parts[1]
 
@@ -278,11 +286,11 @@ func resourceAlibabacloudStackKVStoreAccountDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"InvalidAccountName.NotFound"}) {
 			return nil
 		} else {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return kvstoreService.WaitForKVstoreAccount(d.Id(), Deleted, DefaultTimeoutMedium)
+	return DiagnosticsFromError(kvstoreService.WaitForKVstoreAccount(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
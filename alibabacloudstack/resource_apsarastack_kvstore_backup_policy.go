@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
@@ -12,10 +14,10 @@ import (
 
 func resourceAlibabacloudStackKVStoreBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreBackupPolicyCreate,
-		Read:   resourceAlibabacloudStackKVStoreBackupPolicyRead,
-		Update: resourceAlibabacloudStackKVStoreBackupPolicyUpdate,
-		Delete: resourceAlibabacloudStackKVStoreBackupPolicyDelete,
+		CreateContext: resourceAlibabacloudStackKVStoreBackupPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackKVStoreBackupPolicyRead,
+		UpdateContext: resourceAlibabacloudStackKVStoreBackupPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackKVStoreBackupPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -43,16 +45,16 @@ func resourceAlibabacloudStackKVStoreBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId(d.Get("instance_id").(string))
 
-	return resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackKVStoreBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 
 	object, err := kvstoreService.DescribeKVstoreBackupPolicy(d.Id())
@@ -61,7 +63,7 @@ func resourceAlibabacloudStackKVStoreBackupPolicyRead(d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", d.Id())
@@ -71,15 +73,15 @@ func resourceAlibabacloudStackKVStoreBackupPolicyRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("backup_time") || d.HasChange("backup_period") {
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 		kvstoreService := KvstoreService{client}
 
 		request := r_kvstore.CreateModifyBackupPolicyRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		request.PreferredBackupTime = d.Get("backup_time").(string)
 		periodList := expandStringList(d.Get("backup_period").(*schema.Set).List())
@@ -89,26 +91,26 @@ func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d *schema.ResourceData,
 			return rkvClient.ModifyBackupPolicy(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		// There is a random error and need waiting some seconds to ensure the update is success
 		_, err = kvstoreService.DescribeKVstoreBackupPolicy(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
-	return resourceAlibabacloudStackKVStoreBackupPolicyRead(d, meta)
+	return resourceAlibabacloudStackKVStoreBackupPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// In case of a delete we are resetting to default values which is Monday - Sunday each 3am-4am
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := r_kvstore.CreateModifyBackupPolicyRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = d.Id()
 
 	request.PreferredBackupTime = "01:00Z-02:00Z"
@@ -118,7 +120,7 @@ func resourceAlibabacloudStackKVStoreBackupPolicyDelete(d *schema.ResourceData,
 		return rkvClient.ModifyBackupPolicy(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	return nil
@@ -1,21 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackKvstoreConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKvstoreConnectionCreate,
-		Read:   resourceAlibabacloudStackKvstoreConnectionRead,
-		Update: resourceAlibabacloudStackKvstoreConnectionUpdate,
-		Delete: resourceAlibabacloudStackKvstoreConnectionDelete,
+		CreateContext: resourceAlibabacloudStackKvstoreConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackKvstoreConnectionRead,
+		UpdateContext: resourceAlibabacloudStackKvstoreConnectionUpdate,
+		DeleteContext: resourceAlibabacloudStackKvstoreConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -46,8 +48,8 @@ func resourceAlibabacloudStackKvstoreConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKvstoreConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKvstoreConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	r_kvstoreService := KvstoreService{client}
 
 	request := r_kvstore.CreateAllocateInstancePublicConnectionRequest()
@@ -55,36 +57,24 @@ func resourceAlibabacloudStackKvstoreConnectionCreate(d *schema.ResourceData, me
 	request.InstanceId = d.Get("instance_id").(string)
 	request.Port = d.Get("port").(string)
 	request.RegionId = client.RegionId
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "R-kvstore",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "AllocateInstancePublicConnection",
-		"Version":         "2015-01-01",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	raw, err := client.WithRkvClient(func(r_kvstoreClient *r_kvstore.Client) (interface{}, error) {
 		return r_kvstoreClient.AllocateInstancePublicConnection(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kvstore_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_kvstore_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw)
 	d.SetId(fmt.Sprintf("%v", request.InstanceId))
 	stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutCreate), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackKvstoreConnectionUpdate(d, meta)
+	return resourceAlibabacloudStackKvstoreConnectionUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackKvstoreConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKvstoreConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	r_kvstoreService := KvstoreService{client}
 	object, err := r_kvstoreService.DescribeKvstoreConnection(d.Id())
 	if err != nil {
@@ -93,7 +83,7 @@ func resourceAlibabacloudStackKvstoreConnectionRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", d.Id())
@@ -105,8 +95,8 @@ func resourceAlibabacloudStackKvstoreConnectionRead(d *schema.ResourceData, meta
 	}
 	return nil
 }
-func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKvstoreConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	r_kvstoreService := KvstoreService{client}
 	update := false
 	request := r_kvstore.CreateModifyDBInstanceConnectionStringRequest()
@@ -118,19 +108,7 @@ func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, me
 	}
 	request.NewConnectionString = d.Get("connection_string_prefix").(string)
 	request.IPType = "Public"
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "R-kvstore",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ModifyDBInstanceConnectionString",
-		"Version":         "2015-01-01",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	if !d.IsNewResource() && d.HasChange("port") {
 		update = true
 		request.Port = d.Get("port").(string)
@@ -141,33 +119,21 @@ func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, me
 		})
 		addDebug(request.GetActionName(), raw)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 	}
-	return resourceAlibabacloudStackKvstoreConnectionRead(d, meta)
+	return resourceAlibabacloudStackKvstoreConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKvstoreConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKvstoreConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	r_kvstoreService := KvstoreService{client}
 	request := r_kvstore.CreateReleaseInstancePublicConnectionRequest()
 	request.InstanceId = d.Id()
-	request.Headers = map[string]string{
-		"RegionId": client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "R-kvstore",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ReleaseInstancePublicConnection",
-		"Version":         "2015-01-01",
-	}
+	client.InitRpcRequest(request.RpcRequest)
 	request.CurrentConnectionString = d.Get("connection_string").(string)
 	raw, err := client.WithRkvClient(func(r_kvstoreClient *r_kvstore.Client) (interface{}, error) {
 		return r_kvstoreClient.ReleaseInstancePublicConnection(request)
@@ -177,11 +143,11 @@ func resourceAlibabacloudStackKvstoreConnectionDelete(d *schema.ResourceData, me
 		if IsExpectedErrors(err, []string{"InvalidInstanceId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutDelete), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
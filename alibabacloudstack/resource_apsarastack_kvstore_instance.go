@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/denverdino/aliyungo/common"
 
@@ -27,10 +29,10 @@ import (
 
 func resourceAlibabacloudStackKVStoreInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreInstanceCreate,
-		Read:   resourceAlibabacloudStackKVStoreInstanceRead,
-		Update: resourceAlibabacloudStackKVStoreInstanceUpdate,
-		Delete: resourceAlibabacloudStackKVStoreInstanceDelete,
+		CreateContext: resourceAlibabacloudStackKVStoreInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackKVStoreInstanceRead,
+		UpdateContext: resourceAlibabacloudStackKVStoreInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackKVStoreInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -197,14 +199,14 @@ func resourceAlibabacloudStackKVStoreInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKVStoreInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKVStoreInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	// vpcService := VpcService{client}
 	// kvstoreService := KvstoreService{client}
 	// request := r_kvstore.CreateCreateInstanceRequest()
 	// request.RegionId = client.RegionId
 	// request.Headers = map[string]string{"RegionId": client.RegionId}
-	// request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	// request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	// if v, ok := d.GetOk("instance_name"); ok && v.(string) != "" {
 	// 	request.InstanceName = v.(string)
 	// }
@@ -299,7 +301,7 @@ func resourceAlibabacloudStackKVStoreInstanceCreate(d *schema.ResourceData, meta
 	request := make(map[string]interface{})
 	conn, err := client.NewDataworkspublicClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["Product"] = "R-kvstore"
 	request["product"] = "R-kvstore"
@@ -343,7 +345,7 @@ func resourceAlibabacloudStackKVStoreInstanceCreate(d *schema.ResourceData, meta
 			kmsService := KmsService{client}
 			decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request["Password"] = decryptResp.Plaintext
 		}
@@ -369,7 +371,7 @@ func resourceAlibabacloudStackKVStoreInstanceCreate(d *schema.ResourceData, meta
 		// check vswitchId in zone
 		object, err := vpcService.DescribeVSwitch(vswitchId.(string))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		if request["ZoneId"] == "" {
@@ -384,28 +386,28 @@ func resourceAlibabacloudStackKVStoreInstanceCreate(d *schema.ResourceData, meta
 	addDebug(action, response, request, request)
 	if err != nil {
 		err = WrapErrorf(err, " create kvstroe instances Failed !!", action, AlibabacloudStackSdkGoERROR)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	if !response["asapiSuccess"].(bool) {
 		err = Error("create kvstroe instances Failed !!")
-		return WrapErrorf(err, " create kvstroe instances Failed !!", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, " create kvstroe instances Failed !!", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["InstanceId"]))
 	log.Printf("begin describe kvstroe instances !!")
 	// wait instance status change from Creating to Normal
 	stateConf := BuildStateConfByTimes([]string{"Creating"}, []string{"Normal"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}), 200)
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapError(err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	log.Printf("begin update kvstroe instances !!")
 
-	return resourceAlibabacloudStackKVStoreInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackKVStoreInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKVStoreInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging", "Changing"}, []string{"Normal"}, d.Timeout(schema.TimeoutUpdate), 1*time.Minute, kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
@@ -421,7 +423,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			}
 			cfg, _ := json.Marshal(config)
 			if err := kvstoreService.ModifyInstanceConfig(d.Id(), string(cfg)); err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 
@@ -430,31 +432,31 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 
 	if d.HasChange("security_ips") {
 		// wait instance status is Normal before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request := r_kvstore.CreateModifySecurityIpsRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.SecurityIpGroupName = "default"
 		request.InstanceId = d.Id()
 		if len(d.Get("security_ips").(*schema.Set).List()) > 0 {
 			request.SecurityIps = strings.Join(expandStringList(d.Get("security_ips").(*schema.Set).List())[:], COMMA_SEPARATED)
 		} else {
-			return WrapError(Error("Security ips cannot be empty"))
+			return DiagnosticsFromError(WrapError(Error("Security ips cannot be empty")))
 		}
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.ModifySecurityIps(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("security_ips")
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -464,14 +466,14 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			instanceType := d.Get("instance_type").(string)
 			if string(KVStoreRedis) == instanceType {
 				// wait instance status is Normal before modifying
-				if _, err := stateConf.WaitForState(); err != nil {
-					return WrapError(err)
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return DiagnosticsFromError(WrapError(err))
 				}
 
 				request := r_kvstore.CreateModifyInstanceVpcAuthModeRequest()
 				request.RegionId = client.RegionId
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				request.InstanceId = d.Id()
 				request.VpcAuthMode = d.Get("vpc_auth_mode").(string)
 
@@ -479,14 +481,14 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 					return rkvClient.ModifyInstanceVpcAuthMode(request)
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 				//d.SetPartial("vpc_auth_mode")
 
 				// The auth mode take some time to be effective, so wait to ensure the state !
-				if _, err := stateConf.WaitForState(); err != nil {
-					return WrapError(err)
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return DiagnosticsFromError(WrapError(err))
 				}
 			}
 		}
@@ -497,7 +499,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 		prePaidRequest := r_kvstore.CreateTransformToPrePaidRequest()
 		prePaidRequest.RegionId = client.RegionId
 		prePaidRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		prePaidRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		prePaidRequest.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		prePaidRequest.InstanceId = d.Id()
 		prePaidRequest.Period = requests.Integer(strconv.Itoa(d.Get("period").(int)))
 
@@ -506,12 +508,12 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			return rkvClient.TransformToPrePaid(prePaidRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), prePaidRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), prePaidRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(prePaidRequest.GetActionName(), raw, prePaidRequest.RpcRequest, prePaidRequest)
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		//d.SetPartial("instance_charge_type")
 		//d.SetPartial("period")
@@ -521,7 +523,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 		request := r_kvstore.CreateModifyInstanceMaintainTimeRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		request.MaintainStartTime = d.Get("maintain_start_time").(string)
 		request.MaintainEndTime = d.Get("maintain_end_time").(string)
@@ -530,7 +532,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			return client.ModifyInstanceMaintainTime(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("maintain_start_time")
@@ -539,24 +541,24 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackKVStoreInstanceRead(d, meta)
+		return resourceAlibabacloudStackKVStoreInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_class") {
 		// wait instance status is Normal before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		request := r_kvstore.CreateModifyInstanceSpecRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		request.InstanceClass = d.Get("instance_class").(string)
 		request.EffectiveTime = "Immediately"
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 				return rkvClient.ModifyInstanceSpec(request)
 			})
@@ -571,14 +573,14 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		// There needs more time to sync instance class update
-		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			object, err := kvstoreService.DescribeKVstoreInstance(d.Id())
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -590,7 +592,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			return nil
 		})
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("instance_class")
@@ -599,7 +601,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 	request := r_kvstore.CreateModifyInstanceAttributeRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = d.Id()
 	update := false
 	if d.HasChange("instance_name") {
@@ -614,10 +616,10 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			update = true
 		}
 		if v := d.Get("kms_encrypted_password").(string); v != "" {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)}
 			decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request.NewPassword = decryptResp.Plaintext
 			//d.SetPartial("kms_encrypted_password")
@@ -628,31 +630,31 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 
 	if update {
 		// wait instance status is Normal before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.ModifyInstanceAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapError(err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 		//d.SetPartial("instance_name")
 		//d.SetPartial("password")
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackKVStoreInstanceRead(d, meta)
+	return resourceAlibabacloudStackKVStoreInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 	object, err := kvstoreService.DescribeKVstoreInstance(d.Id())
 	if err != nil {
@@ -660,7 +662,7 @@ func resourceAlibabacloudStackKVStoreInstanceRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_name", object.InstanceName)
@@ -681,32 +683,32 @@ func resourceAlibabacloudStackKVStoreInstanceRead(d *schema.ResourceData, meta i
 		request := r_kvstore.CreateDescribeInstanceAutoRenewalAttributeRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 
 		raw, err := client.WithRkvClient(func(client *r_kvstore.Client) (interface{}, error) {
 			return client.DescribeInstanceAutoRenewalAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		period, err := computePeriodByUnit(object.CreateTime, object.EndTime, d.Get("period").(int), "Month")
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		d.Set("period", period)
 	}
 	//refresh parameters
 	if err = refreshParameters(d, meta); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackKVStoreInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	kvstoreService := KvstoreService{client}
 
 	object, err := kvstoreService.DescribeKVstoreInstance(d.Id())
@@ -714,15 +716,15 @@ func resourceAlibabacloudStackKVStoreInstanceDelete(d *schema.ResourceData, meta
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if PayType(object.ChargeType) == PrePaid {
-		return WrapError(Error("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically"))
+		return DiagnosticsFromError(WrapError(Error("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically")))
 	}
 	request := r_kvstore.CreateDeleteInstanceRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = d.Id()
 
 	raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
@@ -731,15 +733,15 @@ func resourceAlibabacloudStackKVStoreInstanceDelete(d *schema.ResourceData, meta
 
 	if err != nil {
 		if !IsExpectedErrors(err, []string{"InvalidInstanceId.NotFound"}) {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	stateConf := BuildStateConf([]string{"Creating", "Active", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	_, err = stateConf.WaitForState()
-	return WrapError(err)
+	_, err = stateConf.WaitForStateContext(ctx)
+	return DiagnosticsFromError(WrapError(err))
 }
 
 func buildKVStoreCreateRequest(d *schema.ResourceData, meta interface{}) (*r_kvstore.CreateInstanceRequest, error) {
@@ -753,7 +755,7 @@ func buildKVStoreCreateRequest(d *schema.ResourceData, meta interface{}) (*r_kvs
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceName = Trim(d.Get("instance_name").(string))
 
 	request.InstanceType = Trim(d.Get("instance_type").(string))
@@ -1,12 +1,15 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -18,14 +21,20 @@ import (
 
 func resourceAlibabacloudStackLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLaunchTemplateCreate,
-		Read:   resourceAlibabacloudStackLaunchTemplateRead,
-		Update: resourceAlibabacloudStackLaunchTemplateUpdate,
-		Delete: resourceAlibabacloudStackLaunchTemplateDelete,
+		CreateContext: resourceAlibabacloudStackLaunchTemplateCreate,
+		ReadContext:   resourceAlibabacloudStackLaunchTemplateRead,
+		UpdateContext: resourceAlibabacloudStackLaunchTemplateUpdate,
+		DeleteContext: resourceAlibabacloudStackLaunchTemplateDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -269,8 +278,8 @@ func resourceAlibabacloudStackLaunchTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := ecs.CreateCreateLaunchTemplateRequest()
 	request.RegionId = client.RegionId
@@ -280,7 +289,7 @@ func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateName = d.Get("name").(string)
 	request.Description = d.Get("description").(string)
 	request.HostName = d.Get("host_name").(string)
@@ -361,19 +370,19 @@ func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta
 		return ecsClient.CreateLaunchTemplate(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_launch_template", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_launch_template", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ecs.CreateLaunchTemplateResponse)
 
 	d.SetId(response.LaunchTemplateId)
 
-	return resourceAlibabacloudStackLaunchTemplateRead(d, meta)
+	return resourceAlibabacloudStackLaunchTemplateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeLaunchTemplate(d.Id())
 	if err != nil {
@@ -381,7 +390,7 @@ func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	latestVersion, err := ecsService.DescribeLaunchTemplateVersion(d.Id(), int(object.LatestVersionNumber))
 	if err != nil {
@@ -389,7 +398,7 @@ func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", latestVersion.LaunchTemplateName)
@@ -433,7 +442,7 @@ func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta in
 		interfaces = append(interfaces, ds)
 	}
 	if err := d.Set("network_interfaces", interfaces); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var disks []map[string]interface{}
@@ -449,7 +458,7 @@ func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta in
 		disks = append(disks, ds)
 	}
 	if err := d.Set("data_disks", disks); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	tags := make(map[string]interface{})
@@ -461,10 +470,10 @@ func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	versions, err := getLaunchTemplateVersions(d.Id(), meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// Remove one of the oldest and non-default version when the total number reach 30
 	if len(versions) > 29 {
@@ -477,20 +486,20 @@ func resourceAlibabacloudStackLaunchTemplateUpdate(d *schema.ResourceData, meta
 
 		err = deleteLaunchTemplateVersion(d.Id(), int(oldestVersion), meta)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
-	return WrapError(createLaunchTemplateVersion(d, meta))
+	return DiagnosticsFromError(WrapError(createLaunchTemplateVersion(d, meta)))
 
 }
 
-func resourceAlibabacloudStackLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := ecs.CreateDeleteLaunchTemplateRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -501,14 +510,14 @@ func resourceAlibabacloudStackLaunchTemplateDelete(d *schema.ResourceData, meta
 		return ecsClient.DeleteLaunchTemplate(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetAcceptFormat(), raw, request.RpcRequest, request)
 	ecsService := EcsService{client}
-	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackLaunchTemplateRead(d, meta)
+	return resourceAlibabacloudStackLaunchTemplateRead(ctx, d, meta)
 }
 
 func getLaunchTemplateVersions(id string, meta interface{}) ([]ecs.LaunchTemplateVersionSet, error) {
@@ -521,7 +530,7 @@ func getLaunchTemplateVersions(id string, meta interface{}) ([]ecs.LaunchTemplat
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(50)
 	request.LaunchTemplateId = id
 	raw, err := client.WithEcsClient(func(client *ecs.Client) (interface{}, error) {
@@ -549,7 +558,7 @@ func deleteLaunchTemplateVersion(id string, version int, meta interface{}) error
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateId = id
 	request.DeleteVersion = &[]string{strconv.FormatInt(int64(version), 10)}
 	raw, err := client.WithEcsClient(func(client *ecs.Client) (interface{}, error) {
@@ -572,7 +581,7 @@ func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateId = d.Id()
 	request.Description = d.Get("description").(string)
 	request.HostName = d.Get("host_name").(string)
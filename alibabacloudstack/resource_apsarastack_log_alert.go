@@ -0,0 +1,397 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackLogAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackLogAlertCreate,
+		ReadContext:   resourceAlibabacloudStackLogAlertRead,
+		UpdateContext: resourceAlibabacloudStackLogAlertUpdate,
+		DeleteContext: resourceAlibabacloudStackLogAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dashboard": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mute_until": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"throttling": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"notify_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"query_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"chart_title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"time_span_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Custom",
+							ValidateFunc: validation.StringInSlice([]string{"Custom", "Relative", "Truncated"}, false),
+						},
+					},
+				},
+			},
+			"notification_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{sls.NotificationTypeSMS, sls.NotificationTypeDingTalk, sls.NotificationTypeEmail, sls.NotificationTypeMessageCenter, sls.NotificationTypeWebhook}, false),
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mobile_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"email_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"schedule_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sls.ScheduleTypeFixedRate,
+				ValidateFunc: validation.StringInSlice([]string{sls.ScheduleTypeFixedRate, sls.ScheduleTypeHourly, sls.ScheduleTypeDaily, sls.ScheduleTypeWeekly}, false),
+			},
+			"schedule_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	project := d.Get("project").(string)
+
+	// The alert is stored in the dashboard, which is created when it does not exist yet.
+	if err := logService.CreateLogDashboard(project, d.Get("dashboard").(string)); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_alert", "CreateDashboard", AlibabacloudStackLogGoSdkERROR))
+	}
+
+	alert := buildLogAlert(d)
+	var requestInfo *sls.Client
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.CreateAlert(project, alert)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{LogClientTimeout, "InternalServerError"}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateAlert", raw, requestInfo, map[string]interface{}{
+				"project": project,
+				"alert":   alert,
+			})
+		}
+		return nil
+	}); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_alert", "CreateAlert", AlibabacloudStackLogGoSdkERROR))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, alert.Name))
+	if err := logService.WaitForLogstoreAlert(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackLogAlertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	object, err := logService.DescribeLogAlert(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("project", parts[0])
+	d.Set("name", object.Name)
+	d.Set("display_name", object.DisplayName)
+	d.Set("description", object.Description)
+	d.Set("enabled", object.State != "Disabled")
+	if object.Schedule != nil {
+		d.Set("schedule_type", object.Schedule.Type)
+		d.Set("schedule_interval", object.Schedule.Interval)
+	}
+	if config := object.Configuration; config != nil {
+		d.Set("condition", config.Condition)
+		d.Set("dashboard", config.Dashboard)
+		d.Set("mute_until", config.MuteUntil)
+		d.Set("throttling", config.Throttling)
+		d.Set("notify_threshold", config.NotifyThreshold)
+
+		queryList := make([]map[string]interface{}, 0, len(config.QueryList))
+		for _, query := range config.QueryList {
+			queryList = append(queryList, map[string]interface{}{
+				"chart_title":    query.ChartTitle,
+				"logstore":       query.LogStore,
+				"query":          query.Query,
+				"start":          query.Start,
+				"end":            query.End,
+				"time_span_type": query.TimeSpanType,
+			})
+		}
+		if err := d.Set("query_list", queryList); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+
+		notificationList := make([]map[string]interface{}, 0, len(config.NotificationList))
+		for _, notification := range config.NotificationList {
+			notificationList = append(notificationList, map[string]interface{}{
+				"type":        notification.Type,
+				"content":     notification.Content,
+				"service_uri": notification.ServiceUri,
+				"mobile_list": notification.MobileList,
+				"email_list":  notification.EmailList,
+			})
+		}
+		if err := d.Set("notification_list", notificationList); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackLogAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	if d.HasChange("dashboard") {
+		if err := logService.CreateLogDashboard(parts[0], d.Get("dashboard").(string)); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "CreateDashboard", AlibabacloudStackLogGoSdkERROR))
+		}
+	}
+
+	alert := buildLogAlert(d)
+	var requestInfo *sls.Client
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.UpdateAlert(parts[0], alert)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{LogClientTimeout, "InternalServerError"}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("UpdateAlert", raw, requestInfo, map[string]interface{}{
+				"project": parts[0],
+				"alert":   alert,
+			})
+		}
+		return nil
+	}); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateAlert", AlibabacloudStackLogGoSdkERROR))
+	}
+
+	return resourceAlibabacloudStackLogAlertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.DeleteAlert(parts[0], parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{LogClientTimeout, "InternalServerError"}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteAlert", raw, requestInfo, map[string]interface{}{
+				"project":    parts[0],
+				"alert_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "JobNotExist"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteAlert", AlibabacloudStackLogGoSdkERROR))
+	}
+	return DiagnosticsFromError(WrapError(logService.WaitForLogstoreAlert(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
+
+func buildLogAlert(d *schema.ResourceData) *sls.Alert {
+	queryList := make([]*sls.AlertQuery, 0)
+	for _, v := range d.Get("query_list").([]interface{}) {
+		query := v.(map[string]interface{})
+		queryList = append(queryList, &sls.AlertQuery{
+			ChartTitle:   query["chart_title"].(string),
+			LogStore:     query["logstore"].(string),
+			Query:        query["query"].(string),
+			Start:        query["start"].(string),
+			End:          query["end"].(string),
+			TimeSpanType: query["time_span_type"].(string),
+		})
+	}
+
+	notificationList := make([]*sls.Notification, 0)
+	for _, v := range d.Get("notification_list").([]interface{}) {
+		notification := v.(map[string]interface{})
+		notificationList = append(notificationList, &sls.Notification{
+			Type:       notification["type"].(string),
+			Content:    notification["content"].(string),
+			ServiceUri: notification["service_uri"].(string),
+			MobileList: expandStringList(notification["mobile_list"].([]interface{})),
+			EmailList:  expandStringList(notification["email_list"].([]interface{})),
+		})
+	}
+
+	state := "Enabled"
+	if !d.Get("enabled").(bool) {
+		state = "Disabled"
+	}
+	return &sls.Alert{
+		Name:        d.Get("name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		State:       state,
+		Configuration: &sls.AlertConfiguration{
+			Condition:        d.Get("condition").(string),
+			Dashboard:        d.Get("dashboard").(string),
+			QueryList:        queryList,
+			MuteUntil:        int64(d.Get("mute_until").(int)),
+			NotificationList: notificationList,
+			NotifyThreshold:  int32(d.Get("notify_threshold").(int)),
+			Throttling:       d.Get("throttling").(string),
+		},
+		Schedule: &sls.Schedule{
+			Type:     d.Get("schedule_type").(string),
+			Interval: d.Get("schedule_interval").(string),
+		},
+	}
+}
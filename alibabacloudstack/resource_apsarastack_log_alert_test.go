@@ -0,0 +1,125 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackLogAlert_basic(t *testing.T) {
+	var v *sls.Alert
+	resourceId := "alibabacloudstack_log_alert.default"
+	ra := resourceAttrInit(resourceId, logAlertMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogalert-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogAlertConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project":      "${alibabacloudstack_log_project.default.name}",
+					"name":         name,
+					"display_name": name,
+					"condition":    "count > 100",
+					"dashboard":    "terraform-dashboard",
+					"query_list": []map[string]interface{}{
+						{
+							"chart_title": "chart_title",
+							"logstore":    "${alibabacloudstack_log_store.default.name}",
+							"query":       "* AND aliyun | select count(1) as count",
+							"start":       "-60s",
+							"end":         "20s",
+						},
+					},
+					"notification_list": []map[string]interface{}{
+						{
+							"type":        "SMS",
+							"mobile_list": []string{"12345678"},
+							"content":     "alert content",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":                name,
+						"display_name":        name,
+						"condition":           "count > 100",
+						"query_list.#":        "1",
+						"notification_list.#": "1",
+						"schedule_type":       "FixedRate",
+						"schedule_interval":   "60s",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"condition":         "count > 200",
+					"schedule_interval": "300s",
+					"notify_threshold":  "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"condition":         "count > 200",
+						"schedule_interval": "300s",
+						"notify_threshold":  "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"enabled": "false",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"enabled": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogAlertConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	    retention_period = "3000"
+	    shard_count = 1
+	}
+	`, name)
+}
+
+var logAlertMap = map[string]string{
+	"project": CHECKSET,
+	"name":    CHECKSET,
+}
@@ -0,0 +1,206 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	slsPop "github.com/aliyun/alibaba-cloud-sdk-go/services/sls"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// logAuditReservedVariables are filled in by the provider and are not part of variable_map.
+var logAuditReservedVariables = map[string]bool{
+	"region":        true,
+	"aliuid":        true,
+	"project":       true,
+	"logstore":      true,
+	"multi_account": true,
+}
+
+func resourceAlibabacloudStackLogAudit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackLogAuditCreate,
+		ReadContext:   resourceAlibabacloudStackLogAuditRead,
+		UpdateContext: resourceAlibabacloudStackLogAuditUpdate,
+		DeleteContext: resourceAlibabacloudStackLogAuditDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"aliuid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"variable_map": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"multi_account": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogAuditCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := logAuditAnalyzeAppLog(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_audit", "AnalyzeAppLog", AlibabacloudStackSdkGoERROR))
+	}
+	d.SetId(d.Get("aliuid").(string))
+	return resourceAlibabacloudStackLogAuditRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAuditRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	object, err := logService.DescribeLogAudit(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("aliuid", d.Id())
+	d.Set("display_name", object.AppModel.DisplayName)
+
+	config := make(map[string]interface{})
+	if object.AppModel.Config != "" {
+		if err := json.Unmarshal([]byte(object.AppModel.Config), &config); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	variables, _ := config["variable_map"].(map[string]interface{})
+	if accounts, ok := variables["multi_account"].([]interface{}); ok {
+		d.Set("multi_account", accounts)
+	}
+	variableMap := make(map[string]interface{})
+	for k, v := range variables {
+		if logAuditReservedVariables[k] {
+			continue
+		}
+		variableMap[k] = fmt.Sprint(v)
+	}
+	if err := d.Set("variable_map", variableMap); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackLogAuditUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("display_name", "variable_map", "multi_account") {
+		if err := logAuditAnalyzeAppLog(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "AnalyzeAppLog", AlibabacloudStackSdkGoERROR))
+		}
+	}
+	return resourceAlibabacloudStackLogAuditRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAuditDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	request := slsPop.CreateDeleteAppRequest()
+	request.RegionId = client.RegionId
+	request.AppName = "audit"
+	request.Headers["x-ascm-product-name"] = "Sls"
+	request.Headers["x-acs-organizationId"] = client.Department
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithLogPopClient(func(slsClient *slsPop.Client) (interface{}, error) {
+			return slsClient.DeleteApp(request)
+		})
+		if err != nil {
+			if NeedRetry(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"AppNotExist"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	return nil
+}
+
+// logAuditAnalyzeAppLog applies the audit configuration. The same call serves both creation and update.
+func logAuditAnalyzeAppLog(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	aliuid := d.Get("aliuid").(string)
+
+	variableMap := map[string]interface{}{
+		"region":   client.RegionId,
+		"aliuid":   aliuid,
+		"project":  fmt.Sprintf("slsaudit-center-%s-%s", aliuid, client.RegionId),
+		"logstore": "slsaudit-center-logstore",
+	}
+	for k, v := range d.Get("variable_map").(map[string]interface{}) {
+		variableMap[k] = logAuditVariableValue(v.(string))
+	}
+	if v, ok := d.GetOk("multi_account"); ok {
+		variableMap["multi_account"] = expandStringList(v.(*schema.Set).List())
+	}
+	variables, err := json.Marshal(variableMap)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := slsPop.CreateAnalyzeAppLogRequest()
+	request.RegionId = client.RegionId
+	request.AppType = "audit"
+	request.DisplayName = d.Get("display_name").(string)
+	request.VariableMap = string(variables)
+	request.Headers["x-ascm-product-name"] = "Sls"
+	request.Headers["x-acs-organizationId"] = client.Department
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		raw, err := client.WithLogPopClient(func(slsClient *slsPop.Client) (interface{}, error) {
+			return slsClient.AnalyzeAppLog(request)
+		})
+		if err != nil {
+			if NeedRetry(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+}
+
+// logAuditVariableValue converts the switches and TTLs of variable_map, which are strings in the
+// configuration, to the booleans and numbers the audit app expects.
+func logAuditVariableValue(v string) interface{} {
+	if v == "true" || v == "false" {
+		return v == "true"
+	}
+	if i, err := strconv.Atoi(v); err == nil {
+		return i
+	}
+	return v
+}
@@ -0,0 +1,84 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	slsPop "github.com/aliyun/alibaba-cloud-sdk-go/services/sls"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackLogAudit_basic(t *testing.T) {
+	var v *slsPop.DescribeAppResponse
+	resourceId := "alibabacloudstack_log_audit.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogaudit-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogAuditConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"display_name": name,
+					"aliuid":       "${data.alibabacloudstack_account.default.id}",
+					"variable_map": map[string]string{
+						"actiontrail_enabled": "true",
+						"actiontrail_ttl":     "180",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"display_name":                     name,
+						"aliuid":                           CHECKSET,
+						"variable_map.actiontrail_enabled": "true",
+						"variable_map.actiontrail_ttl":     "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"variable_map": map[string]string{
+						"actiontrail_enabled": "true",
+						"actiontrail_ttl":     "90",
+						"oss_access_enabled":  "false",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"variable_map.actiontrail_ttl":    "90",
+						"variable_map.oss_access_enabled": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogAuditConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	data "alibabacloudstack_account" "default" {}
+	`, name)
+}
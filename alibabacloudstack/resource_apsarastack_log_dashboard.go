@@ -0,0 +1,313 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackLogDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackLogDashboardCreate,
+		ReadContext:   resourceAlibabacloudStackLogDashboardRead,
+		UpdateContext: resourceAlibabacloudStackLogDashboardUpdate,
+		DeleteContext: resourceAlibabacloudStackLogDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"charts": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: logDashboardChartsDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	project := d.Get("project").(string)
+	dashboard, err := buildLogDashboard(d)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	var requestInfo *sls.Client
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.CreateDashboard(project, dashboard)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{LogClientTimeout, "InternalServerError"}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateDashboard", raw, requestInfo, map[string]interface{}{
+				"project":   project,
+				"dashboard": dashboard,
+			})
+		}
+		return nil
+	}); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_dashboard", "CreateDashboard", AlibabacloudStackLogGoSdkERROR))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, dashboard.DashboardName))
+	if err := logService.WaitForLogDashboard(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackLogDashboardRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	object, err := logService.DescribeLogDashboard(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	charts := object.ChartList
+	if charts == nil {
+		charts = []sls.Chart{}
+	}
+	chartsJson, err := json.Marshal(charts)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("project", parts[0])
+	d.Set("name", object.DashboardName)
+	d.Set("display_name", object.DisplayName)
+	d.Set("description", object.Description)
+	d.Set("charts", string(chartsJson))
+	return nil
+}
+
+func resourceAlibabacloudStackLogDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("display_name", "description", "charts") {
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+		parts, err := ParseResourceId(d.Id(), 2)
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		dashboard, err := buildLogDashboard(d)
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+
+		var requestInfo *sls.Client
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+				requestInfo = slsClient
+				return nil, slsClient.UpdateDashboard(parts[0], dashboard)
+			})
+			if err != nil {
+				if IsExpectedErrors(err, []string{LogClientTimeout, "InternalServerError"}) {
+					time.Sleep(5 * time.Second)
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			if debugOn() {
+				addDebug("UpdateDashboard", raw, requestInfo, map[string]interface{}{
+					"project":   parts[0],
+					"dashboard": dashboard,
+				})
+			}
+			return nil
+		}); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateDashboard", AlibabacloudStackLogGoSdkERROR))
+		}
+	}
+
+	return resourceAlibabacloudStackLogDashboardRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.DeleteDashboard(parts[0], parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{LogClientTimeout, "InternalServerError"}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteDashboard", raw, requestInfo, map[string]interface{}{
+				"project":        parts[0],
+				"dashboard_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "DashboardNotExist"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteDashboard", AlibabacloudStackLogGoSdkERROR))
+	}
+	return DiagnosticsFromError(WrapError(logService.WaitForLogDashboard(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
+
+func buildLogDashboard(d *schema.ResourceData) (sls.Dashboard, error) {
+	dashboard := sls.Dashboard{
+		DashboardName: d.Get("name").(string),
+		DisplayName:   d.Get("display_name").(string),
+		Description:   d.Get("description").(string),
+		ChartList:     []sls.Chart{},
+	}
+	if err := json.Unmarshal([]byte(d.Get("charts").(string)), &dashboard.ChartList); err != nil {
+		return dashboard, WrapError(err)
+	}
+	return dashboard, nil
+}
+
+// logDashboardServerDefaults are the chart keys the API fills in when the configuration leaves them out.
+var logDashboardServerDefaults = map[string]bool{
+	"search.topic":        true,
+	"search.start":        true,
+	"search.end":          true,
+	"display.xPos":        true,
+	"display.yPos":        true,
+	"display.width":       true,
+	"display.height":      true,
+	"display.displayName": true,
+}
+
+// logDashboardChartsDiffSuppressFunc compares the charts as they are sent to the API, so that the
+// fields the API fills in for the charts do not show up as a change, while removed fields still do.
+func logDashboardChartsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	oldCharts, err := normalizeLogDashboardCharts(old)
+	if err != nil {
+		return false
+	}
+	newCharts, err := normalizeLogDashboardCharts(new)
+	if err != nil {
+		return false
+	}
+	return logDashboardJsonEqual(oldCharts, newCharts, "")
+}
+
+// normalizeLogDashboardCharts decodes the charts the way buildLogDashboard sends them.
+func normalizeLogDashboardCharts(charts string) (interface{}, error) {
+	var chartList []sls.Chart
+	if err := json.Unmarshal([]byte(charts), &chartList); err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(chartList)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(content, &normalized)
+	return normalized, err
+}
+
+// logDashboardJsonEqual compares the keys present on either side, except the server defaults which
+// are left empty in expected.
+func logDashboardJsonEqual(actual, expected interface{}, path string) bool {
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		keys := make(map[string]bool)
+		for key := range actual {
+			keys[key] = true
+		}
+		for key := range expected {
+			keys[key] = true
+		}
+		for key := range keys {
+			keyPath := strings.TrimPrefix(path+"."+key, ".")
+			if logDashboardServerDefaults[keyPath] && (expected[key] == nil || expected[key] == "" || expected[key] == float64(0)) {
+				continue
+			}
+			if !logDashboardJsonEqual(actual[key], expected[key], keyPath) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok || len(actual) != len(expected) {
+			return false
+		}
+		for i := range expected {
+			if !logDashboardJsonEqual(actual[i], expected[i], path) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(actual, expected)
+	}
+}
@@ -0,0 +1,100 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackLogDashboard_basic(t *testing.T) {
+	var v *sls.Dashboard
+	resourceId := "alibabacloudstack_log_dashboard.default"
+	ra := resourceAttrInit(resourceId, logDashboardMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogdashboard-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogAlertConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project":      "${alibabacloudstack_log_project.default.name}",
+					"name":         name,
+					"display_name": name,
+					"charts":       `[{\"title\":\"count\",\"type\":\"map\",\"search\":{\"logstore\":\"${alibabacloudstack_log_store.default.name}\",\"topic\":\"\",\"query\":\"* | select count(1) as count\",\"start\":\"-86400s\",\"end\":\"now\"},\"display\":{\"xAxis\":[\"count\"],\"yAxis\":[\"count\"],\"xPos\":0,\"yPos\":0,\"width\":10,\"height\":12,\"displayName\":\"count\"}}]`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":         name,
+						"display_name": name,
+						"charts":       CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"display_name": name + "-update",
+					"description":  "from terraform",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"display_name": name + "-update",
+						"description":  "from terraform",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var logDashboardMap = map[string]string{
+	"project": CHECKSET,
+	"name":    CHECKSET,
+}
+
+func TestLogDashboardChartsDiffSuppressFunc(t *testing.T) {
+	config := `[{"title":"chart","type":"linepro","search":{"logstore":"store","query":"* | select count(*)"}}]`
+	returned := `[{"title":"chart","type":"linepro","search":{"logstore":"store","query":"* | select count(*)","start":"-86400s","end":"now"},"display":{"xPos":0,"yPos":0}}]`
+	if !logDashboardChartsDiffSuppressFunc("charts", returned, config, nil) {
+		t.Errorf("expected the fields filled in by the API to be ignored")
+	}
+	changed := `[{"title":"chart","type":"linepro","search":{"logstore":"store","query":"* | select sum(size)"}}]`
+	if logDashboardChartsDiffSuppressFunc("charts", returned, changed, nil) {
+		t.Errorf("expected a changed query to show up as a change")
+	}
+	if logDashboardChartsDiffSuppressFunc("charts", returned, `[]`, nil) {
+		t.Errorf("expected a removed chart to show up as a change")
+	}
+	axis := `[{"title":"chart","type":"linepro","search":{"logstore":"store","query":"* | select count(*)"},"display":{"xAxis":["time"]}}]`
+	if logDashboardChartsDiffSuppressFunc("charts", axis, config, nil) {
+		t.Errorf("expected a removed field to show up as a change")
+	}
+	if logDashboardChartsDiffSuppressFunc("charts", returned, `[{"title":"chart","search":{"logstore":"store","query":"* | select count(*)"}}]`, nil) {
+		t.Errorf("expected a removed type to show up as a change")
+	}
+	moved := `[{"title":"chart","type":"linepro","search":{"logstore":"store","query":"* | select count(*)"},"display":{"xPos":5}}]`
+	if logDashboardChartsDiffSuppressFunc("charts", returned, moved, nil) {
+		t.Errorf("expected a configured position to show up as a change")
+	}
+}
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
@@ -14,14 +16,20 @@ import (
 
 func resourceAlibabacloudStackLogMachineGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogMachineGroupCreate,
-		Read:   resourceAlibabacloudStackLogMachineGroupRead,
-		Update: resourceAlibabacloudStackLogMachineGroupUpdate,
-		Delete: resourceAlibabacloudStackLogMachineGroupDelete,
+		CreateContext: resourceAlibabacloudStackLogMachineGroupCreate,
+		ReadContext:   resourceAlibabacloudStackLogMachineGroupRead,
+		UpdateContext: resourceAlibabacloudStackLogMachineGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackLogMachineGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -53,8 +61,8 @@ func resourceAlibabacloudStackLogMachineGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogMachineGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogMachineGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	params := &sls.MachineGroup{
 		Name:          d.Get("name").(string),
@@ -65,7 +73,7 @@ func resourceAlibabacloudStackLogMachineGroupCreate(d *schema.ResourceData, meta
 		},
 	}
 	var requestInfo *sls.Client
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.CreateMachineGroup(d.Get("project").(string), params)
@@ -85,21 +93,21 @@ func resourceAlibabacloudStackLogMachineGroupCreate(d *schema.ResourceData, meta
 		}
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_machine_group", "CreateMachineGroup", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_machine_group", "CreateMachineGroup", AlibabacloudStackLogGoSdkERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("name").(string)))
 
-	return resourceAlibabacloudStackLogMachineGroupRead(d, meta)
+	return resourceAlibabacloudStackLogMachineGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogMachineGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogMachineGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := logService.DescribeLogMachineGroup(d.Id())
 	if err != nil {
@@ -107,7 +115,7 @@ func resourceAlibabacloudStackLogMachineGroupRead(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("project", parts[0])
@@ -119,14 +127,14 @@ func resourceAlibabacloudStackLogMachineGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackLogMachineGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogMachineGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("identify_type") || d.HasChange("identify_list") || d.HasChange("topic") {
 		parts, err := ParseResourceId(d.Id(), 2)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 		var requestInfo *sls.Client
 		params := &sls.MachineGroup{
 			Name:          parts[1],
@@ -136,7 +144,7 @@ func resourceAlibabacloudStackLogMachineGroupUpdate(d *schema.ResourceData, meta
 				TopicName: d.Get("topic").(string),
 			},
 		}
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				requestInfo = slsClient
 				return nil, slsClient.UpdateMachineGroup(parts[0], params)
@@ -156,22 +164,22 @@ func resourceAlibabacloudStackLogMachineGroupUpdate(d *schema.ResourceData, meta
 			}
 			return nil
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateMachineGroup", AlibabacloudStackLogGoSdkERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateMachineGroup", AlibabacloudStackLogGoSdkERROR))
 		}
 	}
 
-	return resourceAlibabacloudStackLogMachineGroupRead(d, meta)
+	return resourceAlibabacloudStackLogMachineGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogMachineGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogMachineGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var requestInfo *sls.Client
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.DeleteMachineGroup(parts[0], parts[1])
@@ -192,7 +200,7 @@ func resourceAlibabacloudStackLogMachineGroupDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store", "ListShards", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store", "ListShards", AlibabacloudStackLogGoSdkERROR))
 	}
-	return WrapError(logService.WaitForLogMachineGroup(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(logService.WaitForLogMachineGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,11 +1,10 @@
 package alibabacloudstack
 
 import (
-	"strings"
-	"time"
+	"context"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -16,10 +15,10 @@ import (
 
 func resourceAlibabacloudStackLogProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogProjectCreate,
-		Read:   resourceAlibabacloudStackLogProjectRead,
-		Update: resourceAlibabacloudStackLogProjectUpdate,
-		Delete: resourceAlibabacloudStackLogProjectDelete,
+		CreateContext: resourceAlibabacloudStackLogProjectCreate,
+		ReadContext:   resourceAlibabacloudStackLogProjectRead,
+		UpdateContext: resourceAlibabacloudStackLogProjectUpdate,
+		DeleteContext: resourceAlibabacloudStackLogProjectDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -38,43 +37,23 @@ func resourceAlibabacloudStackLogProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	name := d.Get("name").(string)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "SLS"
+	request := client.NewCommonRequest("POST", "SLS", "2020-03-31", "CreateProject", "")
 	request.Domain = client.Domain
-	request.Version = "2020-03-31"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "CreateProject"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "SLS",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateProject",
-		"Version":         "2020-03-31",
-		"projectName":     name,
-		"Description":     d.Get("description").(string),
-	}
+	request.QueryParams["projectName"] = name
+	request.QueryParams["Description"] = d.Get("description").(string)
 	raw, err := client.WithEcsClient(func(alidnsClient *ecs.Client) (interface{}, error) {
 		return alidnsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_project", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_project", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("LogProject", raw)
 
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		object, err := logService.DescribeLogProject(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -85,12 +64,12 @@ func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta inte
 		return resource.RetryableError(Error("Failed to describe log project"))
 	})
 	d.SetId(name)
-	return resourceAlibabacloudStackLogProjectRead(d, meta)
+	return resourceAlibabacloudStackLogProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	object, err := logService.DescribeLogProject(d.Id())
 	if err != nil {
@@ -98,7 +77,7 @@ func resourceAlibabacloudStackLogProjectRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("name", object.ProjectName)
 	d.Set("description", object.Description)
@@ -106,81 +85,41 @@ func resourceAlibabacloudStackLogProjectRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackLogProjectUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *sls.Client
 
 	name := d.Id()
 	if d.HasChange("description") {
-		request := requests.NewCommonRequest()
-		request.Method = "POST"
-		request.Product = "SLS"
+		request := client.NewCommonRequest("POST", "SLS", "2020-03-31", "UpdateProject", "")
 		request.Domain = client.Domain
-		request.Version = "2020-03-31"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "UpdateProject"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "SLS",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "UpdateProject",
-			"Version":         "2020-03-31",
-			"organizationId":  client.Department,
-			"resourceGroupId": client.ResourceGroup,
-			"ProjectName":     name,
-			"description":     d.Get("description").(string),
-		}
+		request.QueryParams["organizationId"] = client.Department
+		request.QueryParams["resourceGroupId"] = client.ResourceGroup
+		request.QueryParams["ProjectName"] = name
+		request.QueryParams["description"] = d.Get("description").(string)
 
 		raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateProject", AlibabacloudStackLogGoSdkERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateProject", AlibabacloudStackLogGoSdkERROR))
 		}
 		addDebug("UpdateProject", raw, requestInfo, request)
 	}
 
-	return resourceAlibabacloudStackLogProjectRead(d, meta)
+	return resourceAlibabacloudStackLogProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogProjectDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *sls.Client
 	name := d.Get("name").(string)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "SLS"
+	request := client.NewCommonRequest("POST", "SLS", "2020-03-31", "DeleteProject", "")
 	request.Domain = client.Domain
-	request.Version = "2020-03-31"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DeleteProject"
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "SLS",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"organizationId":  client.Department,
-		"resourceGroupId": client.ResourceGroup,
-		"Action":          "DeleteProject",
-		"Version":         "2020-03-31",
-		"ProjectName":     name,
-	}
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	request.QueryParams["organizationId"] = client.Department
+	request.QueryParams["resourceGroupId"] = client.ResourceGroup
+	request.QueryParams["ProjectName"] = name
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
 		})
@@ -197,7 +136,7 @@ func resourceAlibabacloudStackLogProjectDelete(d *schema.ResourceData, meta inte
 		if IsExpectedErrors(err, []string{"ProjectNotExist"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteProject", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteProject", AlibabacloudStackLogGoSdkERROR))
 	}
 
 	return nil
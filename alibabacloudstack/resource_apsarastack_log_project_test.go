@@ -42,14 +42,13 @@ func testSweepLogProjects(region string) error {
 	request.ApiName = "ListProject"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "SLS",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "ListProject",
-		"Version":         "2020-03-31",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "SLS",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "ListProject",
+		"Version":       "2020-03-31",
 	}
 
 	raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
@@ -83,7 +82,6 @@ func testSweepLogProjects(region string) error {
 		request.ApiName = "DeleteProject"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
 			"AccessKeyId":     client.AccessKey,
 			"Product":         "SLS",
 			"Department":      client.Department,
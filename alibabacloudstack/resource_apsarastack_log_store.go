@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackLogStore() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogStoreCreate,
-		Read:   resourceAlibabacloudStackLogStoreRead,
-		Update: resourceAlibabacloudStackLogStoreUpdate,
-		Delete: resourceAlibabacloudStackLogStoreDelete,
+		CreateContext: resourceAlibabacloudStackLogStoreCreate,
+		ReadContext:   resourceAlibabacloudStackLogStoreRead,
+		UpdateContext: resourceAlibabacloudStackLogStoreUpdate,
+		DeleteContext: resourceAlibabacloudStackLogStoreDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -118,8 +120,8 @@ func resourceAlibabacloudStackLogStore() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	update := false
 	if v, ok := d.GetOk("encryption"); ok {
 		update = v.(bool)
@@ -155,7 +157,7 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 			AppendMeta:    d.Get("append_meta").(bool),
 		}
 	}
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return nil, slsClient.CreateLogStoreV2(d.Get("project").(string), logstore)
 		})
@@ -178,10 +180,10 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store", "CreateLogStoreV2", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store", "CreateLogStoreV2", AlibabacloudStackLogGoSdkERROR))
 	}
 	d.SetId(fmt.Sprintf("%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("name").(string)))
-	return resourceAlibabacloudStackLogStoreUpdate(d, meta)
+	return resourceAlibabacloudStackLogStoreUpdate(ctx, d, meta)
 }
 
 //err := resource.Retry(3*time.Minute, func() *resource.RetryError {
@@ -211,13 +213,13 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 //
 //return resourceAlibabacloudStackLogStoreUpdate(d, meta)
 
-func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := logService.DescribeLogStore(d.Id())
 	if err != nil {
@@ -225,7 +227,7 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	d.Set("project", parts[0])
@@ -233,7 +235,7 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 	d.Set("retention_period", object.TTL)
 	d.Set("shard_count", object.ShardCount)
 	var shards []*sls.Shard
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		shards, err = object.ListShards()
 		if err != nil {
 			if IsExpectedErrors(err, []string{"InternalServerError"}) {
@@ -245,7 +247,7 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store", "ListShards", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store", "ListShards", AlibabacloudStackLogGoSdkERROR))
 	}
 	var shardList []map[string]interface{}
 	for _, s := range shards {
@@ -266,17 +268,17 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 
 	if d.IsNewResource() {
-		return resourceAlibabacloudStackLogStoreRead(d, meta)
+		return resourceAlibabacloudStackLogStoreRead(ctx, d, meta)
 	}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Partial(true)
 
@@ -305,7 +307,7 @@ func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interf
 	if update {
 		store, err := logService.DescribeLogStore(d.Id())
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		store.MaxSplitShard = d.Get("max_split_shard_count").(int)
 		store.TTL = d.Get("retention_period").(int)
@@ -318,7 +320,7 @@ func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interf
 			return nil, slsClient.UpdateLogStoreV2(parts[0], store)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateLogStoreV2", AlibabacloudStackLogGoSdkERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateLogStoreV2", AlibabacloudStackLogGoSdkERROR))
 		}
 		if debugOn() {
 			addDebug("UpdateLogStoreV2", raw, requestInfo, map[string]interface{}{
@@ -329,11 +331,11 @@ func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interf
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackLogStoreRead(d, meta)
+	return resourceAlibabacloudStackLogStoreRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogStoreDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	name := d.Get("name").(string)
 	project := d.Get("project").(string)
 	var requestInfo *sls.Client
@@ -345,7 +347,7 @@ func resourceAlibabacloudStackLogStoreDelete(d *schema.ResourceData, meta interf
 		"logstore": name,
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteLogStore", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteLogStore", AlibabacloudStackLogGoSdkERROR))
 	}
 	return nil
 }
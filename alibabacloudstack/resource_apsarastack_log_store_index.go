@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackLogStoreIndex() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogStoreIndexCreate,
-		Read:   resourceAlibabacloudStackLogStoreIndexRead,
-		Update: resourceAlibabacloudStackLogStoreIndexUpdate,
-		Delete: resourceAlibabacloudStackLogStoreIndexDelete,
+		CreateContext: resourceAlibabacloudStackLogStoreIndexCreate,
+		ReadContext:   resourceAlibabacloudStackLogStoreIndexRead,
+		UpdateContext: resourceAlibabacloudStackLogStoreIndexUpdate,
+		DeleteContext: resourceAlibabacloudStackLogStoreIndexDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -131,23 +139,23 @@ func resourceAlibabacloudStackLogStoreIndex() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogStoreIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 
 	_, fullOk := d.GetOk("full_text")
 	_, fieldOk := d.GetOk("field_search")
 	if !fullOk && !fieldOk {
-		return WrapError(Error("At least one of the 'full_text' and 'field_search' should be specified."))
+		return DiagnosticsFromError(WrapError(Error("At least one of the 'full_text' and 'field_search' should be specified.")))
 	}
 
 	project := d.Get("project").(string)
 	store, err := logService.DescribeLogStore(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, d.Get("logstore").(string)))
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := store.GetIndex()
 		if err != nil {
 			if IsExpectedErrors(err, []string{LogClientTimeout}) {
@@ -165,7 +173,7 @@ func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta i
 		addDebug("GetIndex", raw)
 		return nil
 	}); err != nil {
-		return err
+		return DiagnosticsFromError(err)
 	}
 
 	var index sls.Index
@@ -176,7 +184,7 @@ func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta i
 		index.Keys = buildIndexKeys(d)
 	}
 
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 
 		if e := store.CreateIndex(index); e != nil {
 			if IsExpectedErrors(e, []string{"InternalServerError", LogClientTimeout}) {
@@ -187,21 +195,21 @@ func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta i
 		addDebug("CreateIndex", nil)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store_index", "CreateIndex", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store_index", "CreateIndex", AlibabacloudStackLogGoSdkERROR))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, store.Name))
 
-	return resourceAlibabacloudStackLogStoreIndexRead(d, meta)
+	return resourceAlibabacloudStackLogStoreIndexRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogStoreIndexRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	index, err := logService.DescribeLogStoreIndex(d.Id())
@@ -211,7 +219,7 @@ func resourceAlibabacloudStackLogStoreIndexRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if line := index.Line; line != nil {
 		mapping := map[string]interface{}{
@@ -220,7 +228,7 @@ func resourceAlibabacloudStackLogStoreIndexRead(d *schema.ResourceData, meta int
 			"token":           strings.Join(line.Token, ""),
 		}
 		if err := d.Set("full_text", []map[string]interface{}{mapping}); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	if keys := index.Keys; keys != nil {
@@ -251,7 +259,7 @@ func resourceAlibabacloudStackLogStoreIndexRead(d *schema.ResourceData, meta int
 			keySet = append(keySet, mapping)
 		}
 		if err := d.Set("field_search", keySet); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	d.Set("project", parts[0])
@@ -259,18 +267,18 @@ func resourceAlibabacloudStackLogStoreIndexRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackLogStoreIndexUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogStoreIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	logService := LogService{client}
 	index, err := logService.DescribeLogStoreIndex(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	update := false
 	if d.HasChange("full_text") {
@@ -284,7 +292,7 @@ func resourceAlibabacloudStackLogStoreIndexUpdate(d *schema.ResourceData, meta i
 
 	if update {
 		var requestInfo *sls.Client
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				requestInfo = slsClient
 				return nil, slsClient.UpdateIndex(parts[0], parts[1], *index)
@@ -305,30 +313,30 @@ func resourceAlibabacloudStackLogStoreIndexUpdate(d *schema.ResourceData, meta i
 			}
 			return nil
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateIndex", AlibabacloudStackLogGoSdkERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateIndex", AlibabacloudStackLogGoSdkERROR))
 		}
 	}
 
-	return resourceAlibabacloudStackLogStoreIndexRead(d, meta)
+	return resourceAlibabacloudStackLogStoreIndexRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogStoreIndexDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogStoreIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if _, err := logService.DescribeLogStoreIndex(d.Id()); err != nil {
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var requestInfo *sls.Client
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.DeleteIndex(parts[0], parts[1])
@@ -348,7 +356,7 @@ func resourceAlibabacloudStackLogStoreIndexDelete(d *schema.ResourceData, meta i
 		}
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteIndex", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteIndex", AlibabacloudStackLogGoSdkERROR))
 	}
 	return nil
 }
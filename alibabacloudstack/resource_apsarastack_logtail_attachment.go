@@ -1,21 +1,28 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackLogtailAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogtailAttachmentCreate,
-		Read:   resourceAlibabacloudStackLogtailAttachmentRead,
-		Delete: resourceAlibabacloudStackLogtailAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackLogtailAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackLogtailAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackLogtailAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -36,8 +43,8 @@ func resourceAlibabacloudStackLogtailAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogtailAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogtailAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	project := d.Get("project").(string)
 	config_name := d.Get("logtail_config_name").(string)
 	group_name := d.Get("machine_group_name").(string)
@@ -47,7 +54,7 @@ func resourceAlibabacloudStackLogtailAttachmentCreate(d *schema.ResourceData, me
 		return nil, slsClient.ApplyConfigToMachineGroup(project, config_name, group_name)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_logtail_attachment", "ApplyConfigToMachineGroup", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_logtail_attachment", "ApplyConfigToMachineGroup", AlibabacloudStackLogGoSdkERROR))
 	}
 	if debugOn() {
 		addDebug("ApplyConfigToMachineGroup", raw, requestInfo, map[string]string{
@@ -57,16 +64,16 @@ func resourceAlibabacloudStackLogtailAttachmentCreate(d *schema.ResourceData, me
 		})
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", project, COLON_SEPARATED, config_name, COLON_SEPARATED, group_name))
-	return resourceAlibabacloudStackLogtailAttachmentRead(d, meta)
+	return resourceAlibabacloudStackLogtailAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogtailAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := logService.DescribeLogtailAttachment(d.Id())
 	if err != nil {
@@ -74,7 +81,7 @@ func resourceAlibabacloudStackLogtailAttachmentRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("project", parts[0])
@@ -84,12 +91,12 @@ func resourceAlibabacloudStackLogtailAttachmentRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackLogtailAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogtailAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var requestInfo *sls.Client
 	raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
@@ -97,7 +104,7 @@ func resourceAlibabacloudStackLogtailAttachmentDelete(d *schema.ResourceData, me
 		return nil, slsClient.RemoveConfigFromMachineGroup(parts[0], parts[1], parts[2])
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveConfigFromMachineGroup", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveConfigFromMachineGroup", AlibabacloudStackLogGoSdkERROR))
 	}
 	if debugOn() {
 		addDebug("RemoveConfigFromMachineGroup", raw, requestInfo, map[string]string{
@@ -106,6 +113,6 @@ func resourceAlibabacloudStackLogtailAttachmentDelete(d *schema.ResourceData, me
 			"groupName": parts[2],
 		})
 	}
-	return WrapError(logService.WaitForLogtailAttachment(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(logService.WaitForLogtailAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 
 }
@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
@@ -16,13 +18,18 @@ import (
 
 func resourceAlibabacloudStackLogtailConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogtailConfigCreate,
-		Read:   resourceAlibabacloudStackLogtailConfigRead,
-		Update: resourceAlibabacloudStackLogtailConfiglUpdate,
-		Delete: resourceAlibabacloudStackLogtailConfigDelete,
+		CreateContext: resourceAlibabacloudStackLogtailConfigCreate,
+		ReadContext:   resourceAlibabacloudStackLogtailConfigRead,
+		UpdateContext: resourceAlibabacloudStackLogtailConfiglUpdate,
+		DeleteContext: resourceAlibabacloudStackLogtailConfigDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 
 			"name": {
@@ -63,12 +70,12 @@ func resourceAlibabacloudStackLogtailConfig() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogtailConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogtailConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var inputConfigInputDetail = make(map[string]interface{})
 	data := d.Get("input_detail").(string)
 	if jsonErr := json.Unmarshal([]byte(data), &inputConfigInputDetail); jsonErr != nil {
-		return WrapError(jsonErr)
+		return DiagnosticsFromError(WrapError(jsonErr))
 	}
 	var requestInfo *sls.Client
 	logconfig := &sls.LogConfig{
@@ -91,7 +98,7 @@ func resourceAlibabacloudStackLogtailConfigCreate(d *schema.ResourceData, meta i
 		return nil, slsClient.CreateConfig(d.Get("project").(string), logconfig)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_logtail_config", "CreateConfig", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_logtail_config", "CreateConfig", AlibabacloudStackLogGoSdkERROR))
 	}
 	if debugOn() {
 		addDebug("CreateConfig", raw, requestInfo, map[string]interface{}{
@@ -100,12 +107,12 @@ func resourceAlibabacloudStackLogtailConfigCreate(d *schema.ResourceData, meta i
 		})
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("logstore").(string), COLON_SEPARATED, d.Get("name").(string)))
-	return resourceAlibabacloudStackLogtailConfigRead(d, meta)
+	return resourceAlibabacloudStackLogtailConfigRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogtailConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	split := strings.Split(d.Id(), COLON_SEPARATED)
 	config, err := logService.DescribeLogtailConfig(d.Id())
@@ -114,7 +121,7 @@ func resourceAlibabacloudStackLogtailConfigRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	// Because the server will return redundant parameters, we filter here
@@ -131,7 +138,7 @@ func resourceAlibabacloudStackLogtailConfigRead(d *schema.ResourceData, meta int
 	}
 	nMapJson, err := json.Marshal(nMap)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("input_detail", string(nMapJson))
 	d.Set("project", split[0])
@@ -142,10 +149,10 @@ func resourceAlibabacloudStackLogtailConfigRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackLogtailConfiglUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailConfiglUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	update := false
@@ -161,16 +168,16 @@ func resourceAlibabacloudStackLogtailConfiglUpdate(d *schema.ResourceData, meta
 		data := d.Get("input_detail").(string)
 		conver_err := json.Unmarshal([]byte(data), &inputConfigInputDetail)
 		if conver_err != nil {
-			return WrapError(conver_err)
+			return DiagnosticsFromError(WrapError(conver_err))
 		}
 		sls.AddNecessaryInputConfigField(inputConfigInputDetail)
 		covertInput, covertErr := assertInputDetailType(inputConfigInputDetail, logconfig)
 		if covertErr != nil {
-			return WrapError(covertErr)
+			return DiagnosticsFromError(WrapError(covertErr))
 		}
 		logconfig.InputDetail = covertInput
 
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 		var requestInfo *sls.Client
 		params := &sls.LogConfig{
 			Name:        parts[2],
@@ -187,7 +194,7 @@ func resourceAlibabacloudStackLogtailConfiglUpdate(d *schema.ResourceData, meta
 			return nil, slsClient.UpdateConfig(parts[0], params)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateConfig", AlibabacloudStackLogGoSdkERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateConfig", AlibabacloudStackLogGoSdkERROR))
 		}
 		if debugOn() {
 			addDebug("UpdateConfig", raw, requestInfo, map[string]interface{}{
@@ -196,18 +203,18 @@ func resourceAlibabacloudStackLogtailConfiglUpdate(d *schema.ResourceData, meta
 			})
 		}
 	}
-	return resourceAlibabacloudStackLogtailConfigRead(d, meta)
+	return resourceAlibabacloudStackLogtailConfigRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogtailConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackLogtailConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var requestInfo *sls.Client
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.DeleteConfig(parts[0], parts[2])
@@ -231,9 +238,9 @@ func resourceAlibabacloudStackLogtailConfigDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"ProjectNotExist", "LogStoreNotExist", "ConfigNotExist"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteConfig", AlibabacloudStackLogGoSdkERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteConfig", AlibabacloudStackLogGoSdkERROR))
 	}
-	return WrapError(logService.WaitForLogtailConfig(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(logService.WaitForLogtailConfig(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 // This function is used to assert and convert the type to sls.LogConfig
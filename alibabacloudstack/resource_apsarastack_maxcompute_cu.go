@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -8,10 +9,10 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,13 +20,14 @@ import (
 
 func resourceAlibabacloudStackMaxcomputeCu() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMaxcomputeCuCreate,
-		Read:   resourceAlibabacloudStackMaxcomputeCuRead,
-		Delete: resourceAlibabacloudStackMaxcomputeCuDelete,
+		CreateContext: resourceAlibabacloudStackMaxcomputeCuCreate,
+		ReadContext:   resourceAlibabacloudStackMaxcomputeCuRead,
+		DeleteContext: resourceAlibabacloudStackMaxcomputeCuDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -55,35 +57,22 @@ func resourceAlibabacloudStackMaxcomputeCu() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackMaxcomputeCuCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "CreateUpdateOdpsCu"
 	product := "ascm"
 	response := make(map[string]interface{})
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = product
-	request.Domain = client.Domain
-	request.Version = "2019-05-10"
-	request.ApiName = action
-	request.RegionId = client.RegionId
-	request.QueryParams = map[string]string{
-		"CuName":          d.Get("cu_name").(string),
-		"CuNum":           fmt.Sprintf("%v", d.Get("cu_num").(int)),
-		"ClusterName":     d.Get("cluster_name").(string),
-		"Department":      fmt.Sprintf("%v", client.Department),
-		"OrganizationId":  fmt.Sprintf("%v", client.Department),
-		"RegionId":        client.RegionId,
-		"ResourceGroupId": fmt.Sprintf("%v", client.ResourceGroup),
-		"RegionName":      client.RegionId,
-		"Share":           "0",
-		"Product":         product,
-	}
-	request.Headers = map[string]string{
-		"RegionId":           client.RegionId,
-		"x-acs-content-type": "application/json",
-		"Content-Type":       "application/json",
-	}
+	request := client.NewCommonRequest("POST", product, "2019-05-10", action, "")
+	request.Headers["x-acs-content-type"] = "application/json"
+	request.Headers["Content-Type"] = "application/json"
+	request.QueryParams["CuName"] = d.Get("cu_name").(string)
+	request.QueryParams["CuNum"] = fmt.Sprintf("%v", d.Get("cu_num").(int))
+	request.QueryParams["ClusterName"] = d.Get("cluster_name").(string)
+	request.QueryParams["Department"] = fmt.Sprintf("%v", client.Department)
+	request.QueryParams["OrganizationId"] = fmt.Sprintf("%v", client.Department)
+	request.QueryParams["ResourceGroupId"] = fmt.Sprintf("%v", client.ResourceGroup)
+	request.QueryParams["RegionName"] = client.RegionId
+	request.QueryParams["Share"] = "0"
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -91,23 +80,23 @@ func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta in
 	bresponse, _ := raw.(*responses.CommonResponse)
 	addDebug(action, raw, request)
 	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_cu", "CreateUpdateOdpsCu", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_cu", "CreateUpdateOdpsCu", AlibabacloudStackSdkGoERROR))
 	}
 	err = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_cu", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_cu", action, AlibabacloudStackSdkGoERROR))
 	}
 	if fmt.Sprintf(`%v`, response["code"]) != "200" {
-		return WrapError(Error("CreateUpdateOdpsCu failed for " + response["asapiErrorMessage"].(string)))
+		return DiagnosticsFromError(WrapError(Error("CreateUpdateOdpsCu failed for " + response["asapiErrorMessage"].(string))))
 	}
 
 	d.Set("cu_name", d.Get("cu_name").(string))
 
-	return resourceAlibabacloudStackMaxcomputeCuRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeCuRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackMaxcomputeCuRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	maxcomputeService := MaxcomputeService{client}
 	object, err := maxcomputeService.DescribeMaxcomputeCu(d.Get("cu_name").(string))
 	if err != nil {
@@ -116,7 +105,7 @@ func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var data map[string]interface{}
@@ -134,7 +123,7 @@ func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta inte
 		d.SetId(data["id"].(string))
 		max_cu, err := data["max_cu"].(json.Number).Float64()
 		if err != nil {
-			return WrapError(Error("illegal max_cu value"))
+			return DiagnosticsFromError(WrapAttributeError(WrapError(Error("illegal max_cu value")), "max_cu"))
 		}
 		d.Set("cu_num", int64(max_cu))
 		d.Set("cluster_name", data["cluster"].(string))
@@ -142,13 +131,13 @@ func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta inte
 	}
 	return nil
 }
-func resourceAlibabacloudStackMaxcomputeCuDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackMaxcomputeCuDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteOdpsCu"
 	var response map[string]interface{}
 	conn, err := client.NewOdpsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"CuId":        d.Id(),
@@ -160,7 +149,7 @@ func resourceAlibabacloudStackMaxcomputeCuDelete(d *schema.ResourceData, meta in
 	}
 
 	wait := incrementalWait(3*time.Second, 10*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequestWithOrg(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"500"}) || NeedRetry(err) {
@@ -173,7 +162,7 @@ func resourceAlibabacloudStackMaxcomputeCuDelete(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	if IsExpectedErrorCodes(fmt.Sprintf("%v", response["code"]), []string{"102", "403"}) {
 		return nil
@@ -1,18 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
-	"strings"
-
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,14 +19,16 @@ import (
 
 func resourceAlibabacloudStackMaxcomputeUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMaxcomputeUserCreate,
-		Read:   resourceAlibabacloudStackMaxcomputeUserRead,
-		Update: resourceAlibabacloudStackMaxcomputeUserUpdate,
-		Delete: resourceAlibabacloudStackMaxcomputeUserDelete,
+		CreateContext: resourceAlibabacloudStackMaxcomputeUserCreate,
+		ReadContext:   resourceAlibabacloudStackMaxcomputeUserRead,
+		UpdateContext: resourceAlibabacloudStackMaxcomputeUserUpdate,
+		DeleteContext: resourceAlibabacloudStackMaxcomputeUserDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -72,15 +73,15 @@ func resourceAlibabacloudStackMaxcomputeUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackMaxcomputeUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackMaxcomputeUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateOdpsUser"
 	product := "ascm"
 	request := make(map[string]interface{})
 	conn, err := client.NewAscmClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["UserName"] = d.Get("user_name")
 	if v, ok := d.GetOk("organization_id"); ok {
@@ -94,7 +95,7 @@ func resourceAlibabacloudStackMaxcomputeUserCreate(d *schema.ResourceData, meta
 	request["RegionName"] = client.RegionId
 	request["Product"] = product
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequestWithOrg(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -107,17 +108,17 @@ func resourceAlibabacloudStackMaxcomputeUserCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_project", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_project", action, AlibabacloudStackSdkGoERROR))
 	}
 	if fmt.Sprintf(`%v`, response["code"]) != "200" {
-		return WrapError(Error("CreateUpdateOdpsUser failed for "))
+		return DiagnosticsFromError(WrapError(Error("CreateUpdateOdpsUser failed for ")))
 	}
 
-	return resourceAlibabacloudStackMaxcomputeUserRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackMaxcomputeUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	maxcomputeService := MaxcomputeService{client}
 	object, err := maxcomputeService.DescribeMaxcomputeUser(d.Get("user_name").(string))
 	if err != nil {
@@ -126,7 +127,7 @@ func resourceAlibabacloudStackMaxcomputeUserRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.SetId(strconv.Itoa(object.Data[0].ID))
@@ -139,8 +140,8 @@ func resourceAlibabacloudStackMaxcomputeUserRead(d *schema.ResourceData, meta in
 	d.Set("description", object.Data[0].Description)
 	return nil
 }
-func resourceAlibabacloudStackMaxcomputeUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackMaxcomputeUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	update := false
 	if d.HasChange("user_name") {
@@ -157,76 +158,52 @@ func resourceAlibabacloudStackMaxcomputeUserUpdate(d *schema.ResourceData, meta
 	}
 	if update {
 		var requestInfo *ecs.Client
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
 		roleId, err := client.RoleIds()
 		if err != nil {
 			err = WrapErrorf(Error(GetNotFoundMessage("ASCM User", "defaultRoleId")), NotFoundMsg, ProviderERROR)
-			return WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR))
 		}
 
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
 		OrganizationId := ""
 		if v, ok := d.GetOk("organization_id"); ok {
 			OrganizationId = strconv.Itoa(v.(int))
 		} else {
 			OrganizationId = client.Department
 		}
-		request.ApiName = "UpdateOdpsUser"
-		request.Headers = map[string]string{
-			"RegionId":              client.RegionId,
-			"x-acs-roleid":          strconv.Itoa(roleId),
-			"x-acs-resourcegroupid": client.ResourceGroup,
-			"x-acs-regionid":        client.RegionId,
-			"x-acs-organizationid":  client.Department,
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":         client.RegionId,
-			"Id":               d.Get("id").(string),
-			"UserId":           d.Get("user_id").(string),
-			"UserName":         d.Get("user_name").(string),
-			"UserType":         d.Get("user_type").(string),
-			"OrganizationId":   OrganizationId,
-			"OrganizationName": d.Get("organization_name").(string),
-			"Description":      d.Get("description").(string),
-			"Product":          "ascm",
-			"ResourceGroupId":  client.ResourceGroup,
-			"action":           "UpdateOdpsUser",
-			"Version":          "2019-05-10",
-		}
+		request := client.NewCommonRequest("POST", "ascm", "2019-05-10", "UpdateOdpsUser", "")
+		request.Headers["x-acs-roleid"] = strconv.Itoa(roleId)
+		request.QueryParams["Id"] = d.Get("id").(string)
+		request.QueryParams["UserId"] = d.Get("user_id").(string)
+		request.QueryParams["UserName"] = d.Get("user_name").(string)
+		request.QueryParams["UserType"] = d.Get("user_type").(string)
+		request.QueryParams["OrganizationId"] = OrganizationId
+		request.QueryParams["OrganizationName"] = d.Get("organization_name").(string)
+		request.QueryParams["Description"] = d.Get("description").(string)
+		request.QueryParams["ResourceGroupId"] = client.ResourceGroup
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"Error OdpsUser Not Found"}) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, d.Get("user_name").(string), "UpdateOdpsUser", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Get("user_name").(string), "UpdateOdpsUser", AlibabacloudStackSdkGoERROR))
 
 		}
 		addDebug("UpdateOdpsUser", raw, requestInfo, request)
 	}
-	return resourceAlibabacloudStackMaxcomputeUserRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//ASCM不支持删除
 	return nil
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteOdpsCu"
 	var response map[string]interface{}
 	conn, err := client.NewOdpsClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"CuId":        d.Id(),
@@ -238,7 +215,7 @@ func resourceAlibabacloudStackMaxcomputeUserDelete(d *schema.ResourceData, meta
 	}
 
 	wait := incrementalWait(3*time.Second, 10*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"500"}) || NeedRetry(err) {
@@ -251,13 +228,13 @@ func resourceAlibabacloudStackMaxcomputeUserDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	if IsExpectedErrorCodes(fmt.Sprintf("%v", response["code"]), []string{"102", "403"}) {
 		return nil
 	}
 	if fmt.Sprintf(`%v`, response["code"]) != "200" {
-		return WrapError(Error("DeleteOdpsCu failed for " + response["Message"].(string)))
+		return DiagnosticsFromError(WrapError(Error("DeleteOdpsCu failed for " + response["Message"].(string))))
 	}
 	return nil
 }
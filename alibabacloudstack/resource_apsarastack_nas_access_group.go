@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,15 +18,17 @@ import (
 
 func resourceAlibabacloudStackNasAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasAccessGroupCreate,
-		Read:   resourceAlibabacloudStackNasAccessGroupRead,
-		Update: resourceAlibabacloudStackNasAccessGroupUpdate,
-		Delete: resourceAlibabacloudStackNasAccessGroupDelete,
+		CreateContext: resourceAlibabacloudStackNasAccessGroupCreate,
+		ReadContext:   resourceAlibabacloudStackNasAccessGroupRead,
+		UpdateContext: resourceAlibabacloudStackNasAccessGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackNasAccessGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"access_group_name": {
@@ -72,21 +76,21 @@ func resourceAlibabacloudStackNasAccessGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasAccessGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateAccessGroup"
 	request := make(map[string]interface{})
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("access_group_name"); ok {
 		request["AccessGroupName"] = v
 	} else if v, ok := d.GetOk("name"); ok {
 		request["AccessGroupName"] = v
 	} else {
-		return WrapError(Error(`[ERROR] Argument "name" or "access_group_name" must be set one!`))
+		return DiagnosticsFromError(WrapError(Error(`[ERROR] Argument "name" or "access_group_name" must be set one!`)))
 	}
 
 	if v, ok := d.GetOk("access_group_type"); ok {
@@ -94,7 +98,7 @@ func resourceAlibabacloudStackNasAccessGroupCreate(d *schema.ResourceData, meta
 	} else if v, ok := d.GetOk("type"); ok {
 		request["AccessGroupType"] = v
 	} else {
-		return WrapError(Error(`[ERROR] Argument "type" or "access_group_type" must be set one!`))
+		return DiagnosticsFromError(WrapError(Error(`[ERROR] Argument "type" or "access_group_type" must be set one!`)))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -110,7 +114,7 @@ func resourceAlibabacloudStackNasAccessGroupCreate(d *schema.ResourceData, meta
 	request["Department"] = client.Department
 	request["ResourceGroup"] = client.ResourceGroup
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"InternalError", "ServiceTimeout", "ServiceUnavailable"}) || NeedRetry(err) {
@@ -123,15 +127,15 @@ func resourceAlibabacloudStackNasAccessGroupCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nas_access_group", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nas_access_group", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["AccessGroupName"], ":", request["FileSystemType"]))
 
-	return resourceAlibabacloudStackNasAccessGroupRead(d, meta)
+	return resourceAlibabacloudStackNasAccessGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	nasService := NasService{client}
 	if len(strings.Split(d.Id(), ":")) != 2 {
 		d.SetId(fmt.Sprintf("%v:%v", d.Id(), "standard"))
@@ -143,11 +147,11 @@ func resourceAlibabacloudStackNasAccessGroupRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("access_group_name", parts[0])
 	d.Set("name", parts[0])
@@ -157,15 +161,15 @@ func resourceAlibabacloudStackNasAccessGroupRead(d *schema.ResourceData, meta in
 	d.Set("description", object["Description"])
 	return nil
 }
-func resourceAlibabacloudStackNasAccessGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	if len(strings.Split(d.Id(), ":")) != 2 {
 		d.SetId(fmt.Sprintf("%v:%v", d.Id(), "standard"))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if d.HasChange("description") {
 		request := map[string]interface{}{
@@ -181,10 +185,10 @@ func resourceAlibabacloudStackNasAccessGroupUpdate(d *schema.ResourceData, meta
 		action := "ModifyAccessGroup"
 		conn, err := client.NewNasClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -197,25 +201,25 @@ func resourceAlibabacloudStackNasAccessGroupUpdate(d *schema.ResourceData, meta
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackNasAccessGroupRead(d, meta)
+	return resourceAlibabacloudStackNasAccessGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasAccessGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if len(strings.Split(d.Id(), ":")) != 2 {
 		d.SetId(fmt.Sprintf("%v:%v", d.Id(), "standard"))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	action := "DeleteAccessGroup"
 	var response map[string]interface{}
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"AccessGroupName": parts[0],
@@ -227,7 +231,7 @@ func resourceAlibabacloudStackNasAccessGroupDelete(d *schema.ResourceData, meta
 	request["Department"] = client.Department
 	request["ResourceGroup"] = client.ResourceGroup
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -243,7 +247,7 @@ func resourceAlibabacloudStackNasAccessGroupDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"Forbidden.NasNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,10 +19,10 @@ import (
 
 func resourceAlibabacloudStackNasAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasAccessRuleCreate,
-		Read:   resourceAlibabacloudStackNasAccessRuleRead,
-		Update: resourceAlibabacloudStackNasAccessRuleUpdate,
-		Delete: resourceAlibabacloudStackNasAccessRuleDelete,
+		CreateContext: resourceAlibabacloudStackNasAccessRuleCreate,
+		ReadContext:   resourceAlibabacloudStackNasAccessRuleRead,
+		UpdateContext: resourceAlibabacloudStackNasAccessRuleUpdate,
+		DeleteContext: resourceAlibabacloudStackNasAccessRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -61,14 +63,14 @@ func resourceAlibabacloudStackNasAccessRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateAccessRule"
 	request := make(map[string]interface{})
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["RegionId"] = client.Region
 	request["AccessGroupName"] = d.Get("access_group_name")
@@ -85,7 +87,7 @@ func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta i
 	}
 	request["Priority"] = d.Get("priority")
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -98,19 +100,19 @@ func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nas_access_rule", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nas_access_rule", action, AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(fmt.Sprint(request["AccessGroupName"], ":", response["AccessRuleId"]))
-	return resourceAlibabacloudStackNasAccessRuleRead(d, meta)
+	return resourceAlibabacloudStackNasAccessRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		err = WrapError(err)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	request := map[string]interface{}{
 		"RegionId":        client.RegionId,
@@ -146,10 +148,10 @@ func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta i
 		action := "ModifyAccessRule"
 		conn, err := client.NewNasClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -162,14 +164,14 @@ func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackNasAccessRuleRead(d, meta)
+	return resourceAlibabacloudStackNasAccessRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	nasService := NasService{client}
 	object, err := nasService.DescribeNasAccessRule(d.Id())
 	if err != nil {
@@ -178,11 +180,11 @@ func resourceAlibabacloudStackNasAccessRuleRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("access_rule_id", object["AccessRuleId"])
 	d.Set("source_cidr_ip", object["SourceCidrIp"])
@@ -194,18 +196,18 @@ func resourceAlibabacloudStackNasAccessRuleRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackNasAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNasAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteAccessRule"
 	var response map[string]interface{}
 	conn, err := client.NewNasClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		err = WrapError(err)
-		return err
+		return DiagnosticsFromError(err)
 	}
 	request := map[string]interface{}{
 		"RegionId":        client.RegionId,
@@ -217,7 +219,7 @@ func resourceAlibabacloudStackNasAccessRuleDelete(d *schema.ResourceData, meta i
 	request["Department"] = client.Department
 	request["ResourceGroup"] = client.ResourceGroup
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -233,7 +235,7 @@ func resourceAlibabacloudStackNasAccessRuleDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"Forbidden.NasNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"strconv"
@@ -16,14 +18,20 @@ import (
 
 func resourceAlibabacloudStackNatGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNatGatewayCreate,
-		Read:   resourceAlibabacloudStackNatGatewayRead,
-		Update: resourceAlibabacloudStackNatGatewayUpdate,
-		Delete: resourceAlibabacloudStackNatGatewayDelete,
+		CreateContext: resourceAlibabacloudStackNatGatewayCreate,
+		ReadContext:   resourceAlibabacloudStackNatGatewayRead,
+		UpdateContext: resourceAlibabacloudStackNatGatewayUpdate,
+		DeleteContext: resourceAlibabacloudStackNatGatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -92,8 +100,8 @@ func resourceAlibabacloudStackNatGateway() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNatGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateCreateNatGatewayRequest()
@@ -104,7 +112,7 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VpcId = string(d.Get("vpc_id").(string))
 	request.Spec = string(d.Get("specification").(string))
 	request.ClientToken = buildClientToken(request.GetActionName())
@@ -131,7 +139,7 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 		request.Description = v.(string)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateNatGateway(&args)
@@ -147,17 +155,17 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 		d.SetId(response.NatGatewayId)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nat_gateway", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nat_gateway", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForNatGateway(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackNatGatewayRead(d, meta)
+	return resourceAlibabacloudStackNatGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	object, err := vpcService.DescribeNatGateway(d.Id())
@@ -166,7 +174,7 @@ func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.Name)
@@ -178,7 +186,7 @@ func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interf
 	d.Set("vpc_id", object.VpcId)
 	bindWidthPackages, err := flattenBandWidthPackages(object.BandwidthPackageIds.BandwidthPackageId, meta, d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		d.Set("bandwidth_packages", bindWidthPackages)
 	}
@@ -190,20 +198,20 @@ func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	if d.HasChange("tags") {
 		if err := vpcService.SetResourceTags(d, "NATGATEWAY"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
 	natGateway, err := vpcService.DescribeNatGateway(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Partial(true)
@@ -217,7 +225,7 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 	modifyNatGatewayAttributeRequest.RegionId = natGateway.RegionId
 	modifyNatGatewayAttributeRequest.NatGatewayId = natGateway.NatGatewayId
 	modifyNatGatewayAttributeRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	modifyNatGatewayAttributeRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	modifyNatGatewayAttributeRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("name") {
 		//d.SetPartial("name")
@@ -225,7 +233,7 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 		if v, ok := d.GetOk("name"); ok {
 			name = v.(string)
 		} else {
-			return WrapError(Error("cann't change name to empty string"))
+			return DiagnosticsFromError(WrapError(Error("cann't change name to empty string")))
 		}
 		modifyNatGatewayAttributeRequest.Name = name
 
@@ -238,7 +246,7 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 		if v, ok := d.GetOk("description"); ok {
 			description = v.(string)
 		} else {
-			return WrapError(Error("can to change description to empty string"))
+			return DiagnosticsFromError(WrapError(Error("can to change description to empty string")))
 		}
 
 		modifyNatGatewayAttributeRequest.Description = description
@@ -251,7 +259,7 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 			return vpcClient.ModifyNatGatewayAttribute(modifyNatGatewayAttributeRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyNatGatewayAttributeRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyNatGatewayAttributeRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(modifyNatGatewayAttributeRequest.GetActionName(), raw, modifyNatGatewayAttributeRequest.RpcRequest, modifyNatGatewayAttributeRequest)
 	}
@@ -267,30 +275,30 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 		}
 		modifyNatGatewaySpecRequest.NatGatewayId = natGateway.NatGatewayId
 		modifyNatGatewaySpecRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		modifyNatGatewaySpecRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		modifyNatGatewaySpecRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		modifyNatGatewaySpecRequest.Spec = d.Get("specification").(string)
 
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ModifyNatGatewaySpec(modifyNatGatewaySpecRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyNatGatewaySpecRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyNatGatewaySpecRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(modifyNatGatewaySpecRequest.GetActionName(), raw, modifyNatGatewaySpecRequest.RpcRequest, modifyNatGatewaySpecRequest)
 	}
 	d.Partial(false)
-	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForNatGateway(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackNatGatewayRead(d, meta)
+	return resourceAlibabacloudStackNatGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNatGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
-	err := deleteBandwidthPackages(d, meta)
+	err := deleteBandwidthPackages(ctx, d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := vpc.CreateDeleteNatGatewayRequest()
 	request.RegionId = string(client.Region)
@@ -300,9 +308,9 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.NatGatewayId = d.Id()
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request := vpc.CreateDeleteNatGatewayRequest()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -311,7 +319,7 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 		}
 		request.RegionId = string(client.Region)
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.NatGatewayId = d.Id()
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteNatGateway(request)
@@ -329,12 +337,12 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
-func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
+func deleteBandwidthPackages(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	packRequest := vpc.CreateDescribeBandwidthPackagesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -344,9 +352,9 @@ func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
 	}
 	packRequest.RegionId = string(client.Region)
 	packRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	packRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	packRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	packRequest.NatGatewayId = d.Id()
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeBandwidthPackages(packRequest)
 		})
@@ -367,7 +375,7 @@ func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
 				}
 				request.BandwidthPackageId = pack.BandwidthPackageId
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				raw, e := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 					return vpcClient.DeleteBandwidthPackage(request)
 				})
@@ -434,7 +442,7 @@ func getPackage(packageId string, meta interface{}, d *schema.ResourceData) (pac
 	request.RegionId = client.RegionId
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.NatGatewayId = d.Id()
 	request.BandwidthPackageId = packageId
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackNetworkAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkAclCreate,
-		Read:   resourceAlibabacloudStackNetworkAclRead,
-		Update: resourceAlibabacloudStackNetworkAclUpdate,
-		Delete: resourceAlibabacloudStackNetworkAclDelete,
+		CreateContext: resourceAlibabacloudStackNetworkAclCreate,
+		ReadContext:   resourceAlibabacloudStackNetworkAclRead,
+		UpdateContext: resourceAlibabacloudStackNetworkAclUpdate,
+		DeleteContext: resourceAlibabacloudStackNetworkAclDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -147,8 +149,8 @@ func resourceAlibabacloudStackNetworkAcl() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkAclCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	var response map[string]interface{}
 	action := "CreateNetworkAcl"
@@ -157,7 +159,7 @@ func resourceAlibabacloudStackNetworkAclCreate(d *schema.ResourceData, meta inte
 	request["OrganizationId"] = client.Department
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("description"); ok {
 		request["Description"] = v
@@ -173,7 +175,7 @@ func resourceAlibabacloudStackNetworkAclCreate(d *schema.ResourceData, meta inte
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -186,19 +188,19 @@ func resourceAlibabacloudStackNetworkAclCreate(d *schema.ResourceData, meta inte
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_network_acl", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_network_acl", action, AlibabacloudStackSdkGoERROR))
 	}
 	responseNetworkAclAttribute := response["NetworkAclAttribute"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseNetworkAclAttribute["NetworkAclId"]))
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, vpcService.NetworkAclStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackNetworkAclUpdate(d, meta)
+	return resourceAlibabacloudStackNetworkAclUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeNetworkAcl(d.Id())
 	if err != nil {
@@ -207,7 +209,7 @@ func resourceAlibabacloudStackNetworkAclRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("description", object["Description"])
 
@@ -229,7 +231,7 @@ func resourceAlibabacloudStackNetworkAclRead(d *schema.ResourceData, meta interf
 		}
 	}
 	if err := d.Set("egress_acl_entries", egressAclEntry); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	ingressAclEntry := make([]map[string]interface{}, 0)
@@ -250,7 +252,7 @@ func resourceAlibabacloudStackNetworkAclRead(d *schema.ResourceData, meta interf
 		}
 	}
 	if err := d.Set("ingress_acl_entries", ingressAclEntry); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("network_acl_name", object["NetworkAclName"])
 	d.Set("name", object["NetworkAclName"])
@@ -269,14 +271,14 @@ func resourceAlibabacloudStackNetworkAclRead(d *schema.ResourceData, meta interf
 		}
 	}
 	if err := d.Set("resources", resourceMap); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("status", object["Status"])
 	d.Set("vpc_id", object["VpcId"])
 	return nil
 }
-func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	var response map[string]interface{}
 	d.Partial(true)
@@ -304,13 +306,13 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 		action := "ModifyNetworkAclAttributes"
 		conn, err := client.NewVpcClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request["ClientToken"] = buildClientToken("ModifyNetworkAclAttributes")
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -323,11 +325,11 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.NetworkAclStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("description")
 		//d.SetPartial("name")
@@ -378,13 +380,13 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 		action := "UpdateNetworkAclEntries"
 		conn, err := client.NewVpcClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request["ClientToken"] = buildClientToken("UpdateNetworkAclEntries")
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, updateNetworkAclEntriesReq, &runtime)
 			if err != nil {
 				if IsExpectedErrors(err, []string{"TaskConflict"}) || NeedRetry(err) {
@@ -397,11 +399,11 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 		})
 		addDebug(action, response, updateNetworkAclEntriesReq)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.NetworkAclStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("egress_acl_entries")
 		//d.SetPartial("ingress_acl_entries")
@@ -435,13 +437,13 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 			action := "AssociateNetworkAcl"
 			conn, err := client.NewVpcClient()
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request["ClientToken"] = buildClientToken("AssociateNetworkAcl")
 			runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 			runtime.SetAutoretry(true)
 			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, associatenetworkaclrequest, &runtime)
 				if err != nil {
 					if NeedRetry(err) {
@@ -454,11 +456,11 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 			})
 			addDebug(action, response, associatenetworkaclrequest)
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.NetworkAclStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapErrorf(err, IdMsg, d.Id())
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 			//d.SetPartial("resources")
 		}
@@ -482,13 +484,13 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 			action := "UnassociateNetworkAcl"
 			conn, err := client.NewVpcClient()
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request["ClientToken"] = buildClientToken("UnassociateNetworkAcl")
 			runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 			runtime.SetAutoretry(true)
 			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, unassociatenetworkaclrequest, &runtime)
 				if err != nil {
 					if NeedRetry(err) {
@@ -501,32 +503,32 @@ func resourceAlibabacloudStackNetworkAclUpdate(d *schema.ResourceData, meta inte
 			})
 			addDebug(action, response, unassociatenetworkaclrequest)
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.NetworkAclStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapErrorf(err, IdMsg, d.Id())
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 			//d.SetPartial("resources")
 		}
 	}
-	return resourceAlibabacloudStackNetworkAclRead(d, meta)
+	return resourceAlibabacloudStackNetworkAclRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackNetworkAclDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	// Delete binging resources before delete the ACL
 	_, err := vpcService.DeleteAclResources(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	action := "DeleteNetworkAcl"
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"NetworkAclId": d.Id(),
@@ -539,7 +541,7 @@ func resourceAlibabacloudStackNetworkAclDelete(d *schema.ResourceData, meta inte
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -552,11 +554,11 @@ func resourceAlibabacloudStackNetworkAclDelete(d *schema.ResourceData, meta inte
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, vpcService.NetworkAclStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
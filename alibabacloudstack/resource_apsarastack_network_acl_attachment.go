@@ -1,20 +1,33 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackNetworkAclAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkAclAttachmentCreate,
-		Read:   resourceAlibabacloudStackNetworkAclAttachmentRead,
-		Update: resourceAlibabacloudStackNetworkAclAttachmentUpdate,
-		Delete: resourceAlibabacloudStackNetworkAclAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackNetworkAclAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackNetworkAclAttachmentRead,
+		UpdateContext: resourceAlibabacloudStackNetworkAclAttachmentUpdate,
+		DeleteContext: resourceAlibabacloudStackNetworkAclAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlibabacloudStackNetworkAclAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -43,20 +56,20 @@ func resourceAlibabacloudStackNetworkAclAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId(d.Get("network_acl_id").(string) + COLON_SEPARATED + resource.UniqueId())
 
-	return resourceAlibabacloudStackNetworkAclAttachmentUpdate(d, meta)
+	return resourceAlibabacloudStackNetworkAclAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	networkAclId := parts[0]
 	vpcResource := []vpc.Resource{}
@@ -74,20 +87,50 @@ func resourceAlibabacloudStackNetworkAclAttachmentRead(d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("network_acl_id", networkAclId)
 	d.Set("resources", vpcResource)
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceAlibabacloudStackNetworkAclAttachmentImport imports all the resources bound to a network acl,
+// from the id <network_acl_id> or <network_acl_id>:<suffix>.
+func resourceAlibabacloudStackNetworkAclAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
+	networkAclId := strings.Split(d.Id(), COLON_SEPARATED)[0]
+	object, err := vpcService.DescribeNetworkAcl(networkAclId)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	var vpcResource []map[string]interface{}
+	resources, _ := object["Resources"].(map[string]interface{})["Resource"].([]interface{})
+	for _, res := range resources {
+		item := res.(map[string]interface{})
+		vpcResource = append(vpcResource, map[string]interface{}{
+			"resource_id":   fmt.Sprint(item["ResourceId"]),
+			"resource_type": fmt.Sprint(item["ResourceType"]),
+		})
+	}
+	if len(vpcResource) < 1 {
+		return nil, WrapError(Error("The network acl %s is not bound to any resource.", networkAclId))
+	}
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(networkAclId + COLON_SEPARATED + resource.UniqueId())
+	}
+	d.Set("network_acl_id", networkAclId)
+	d.Set("resources", vpcResource)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAlibabacloudStackNetworkAclAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpcService := VpcService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	networkAclId := parts[0]
 	if d.HasChange("resources") {
@@ -129,11 +172,11 @@ func resourceAlibabacloudStackNetworkAclAttachmentUpdate(d *schema.ResourceData,
 				return vpcClient.UnassociateNetworkAcl(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, Timeout5Minute); err != nil {
-				return WrapError(err)
+			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 
@@ -169,26 +212,26 @@ func resourceAlibabacloudStackNetworkAclAttachmentUpdate(d *schema.ResourceData,
 				return vpcClient.AssociateNetworkAcl(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, Timeout5Minute); err != nil {
-				return WrapError(err)
+			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		//d.SetPartial("resources")
 	}
 
-	return resourceAlibabacloudStackNetworkAclAttachmentRead(d, meta)
+	return resourceAlibabacloudStackNetworkAclAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	networkAclId := parts[0]
 
@@ -214,7 +257,7 @@ func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData,
 		})
 	}
 	request.Resource = &resources
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateNetworkAcl(request)
 		})
@@ -228,7 +271,7 @@ func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return vpcService.WaitForNetworkAclAttachment(networkAclId, vpcResource, Deleted, Timeout5Minute)
+	return DiagnosticsFromError(vpcService.WaitForNetworkAclAttachment(networkAclId, vpcResource, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
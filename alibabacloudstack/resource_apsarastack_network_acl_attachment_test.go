@@ -128,6 +128,11 @@ func SkipTestAccAlibabacloudStackVpcNetworkAclAttachment_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccNetworkAclAttachment_associate(rand),
 				Check: resource.ComposeTestCheckFunc(
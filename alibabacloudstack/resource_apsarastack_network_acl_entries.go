@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -13,13 +15,18 @@ import (
 
 func resourceAlibabacloudStackNetworkAclEntries() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkAclEntriesCreate,
-		Read:   resourceAlibabacloudStackNetworkAclEntriesRead,
-		Update: resourceAlibabacloudStackNetworkAclEntriesUpdate,
-		Delete: resourceAlibabacloudStackNetworkAclEntriesDelete,
+		CreateContext: resourceAlibabacloudStackNetworkAclEntriesCreate,
+		ReadContext:   resourceAlibabacloudStackNetworkAclEntriesRead,
+		UpdateContext: resourceAlibabacloudStackNetworkAclEntriesUpdate,
+		DeleteContext: resourceAlibabacloudStackNetworkAclEntriesDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 
 			"network_acl_id": {
@@ -117,20 +124,20 @@ func resourceAlibabacloudStackNetworkAclEntries() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclEntriesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId(d.Get("network_acl_id").(string) + COLON_SEPARATED + resource.UniqueId())
 
-	return resourceAlibabacloudStackNetworkAclEntriesUpdate(d, meta)
+	return resourceAlibabacloudStackNetworkAclEntriesUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	object, err := vpcService.DescribeNetworkAcl(parts[0])
@@ -139,7 +146,7 @@ func resourceAlibabacloudStackNetworkAclEntriesRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var ingress []map[string]interface{}
@@ -185,12 +192,12 @@ func resourceAlibabacloudStackNetworkAclEntriesRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclEntriesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	networkAclId := parts[0]
 	request := vpc.CreateUpdateNetworkAclEntriesRequest()
@@ -232,10 +239,10 @@ func resourceAlibabacloudStackNetworkAclEntriesUpdate(d *schema.ResourceData, me
 		request.UpdateEgressAclEntries = requests.NewBoolean(true)
 	}
 	// Check the network acl status.
-	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UpdateNetworkAclEntries(request)
 		})
@@ -249,17 +256,17 @@ func resourceAlibabacloudStackNetworkAclEntriesUpdate(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout)
+	return DiagnosticsFromError(vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())))
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkAclEntriesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	networkAclId := parts[0]
 
@@ -275,10 +282,10 @@ func resourceAlibabacloudStackNetworkAclEntriesDelete(d *schema.ResourceData, me
 	request.UpdateIngressAclEntries = requests.NewBoolean(true)
 	request.UpdateEgressAclEntries = requests.NewBoolean(true)
 	// Check the network acl status.
-	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UpdateNetworkAclEntries(request)
 		})
@@ -292,7 +299,7 @@ func resourceAlibabacloudStackNetworkAclEntriesDelete(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout)
+	return DiagnosticsFromError(vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,15 +15,21 @@ import (
 
 func resourceAlibabacloudStackNetworkInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetworkInterfaceCreate,
-		Read:   resourceNetworkInterfaceRead,
-		Update: resourceNetworkInterfaceUpdate,
-		Delete: resourceNetworkInterfaceDelete,
+		CreateContext: resourceNetworkInterfaceCreate,
+		ReadContext:   resourceNetworkInterfaceRead,
+		UpdateContext: resourceNetworkInterfaceUpdate,
+		DeleteContext: resourceNetworkInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -77,8 +85,8 @@ func resourceAlibabacloudStackNetworkInterface() *schema.Resource {
 	}
 }
 
-func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	request := ecs.CreateCreateNetworkInterfaceRequest()
 	request.RegionId = client.RegionId
@@ -88,7 +96,7 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VSwitchId = d.Get("vswitch_id").(string)
 	groups := d.Get("security_groups").(*schema.Set).List()
 
@@ -109,21 +117,21 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		return ecsClient.CreateNetworkInterface(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_network_interface", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_network_interface", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	object := raw.(*ecs.CreateNetworkInterfaceResponse)
 	d.SetId(object.NetworkInterfaceId)
 
-	if err := ecsService.WaitForNetworkInterface(d.Id(), Available, 600); err != nil {
-		return WrapError(err)
+	if err := ecsService.WaitForNetworkInterface(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceNetworkInterfaceUpdate(d, meta)
+	return resourceNetworkInterfaceUpdate(ctx, d, meta)
 }
 
-func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	object, err := ecsService.DescribeNetworkInterface(d.Id())
@@ -132,7 +140,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.NetworkInterfaceName)
@@ -152,7 +160,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 
 	tags, err := ecsService.DescribeTags(d.Id(), TagResourceEni)
 	if err != nil && !NotFoundError(err) {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if len(tags) > 0 {
@@ -162,8 +170,8 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	d.Partial(true)
@@ -178,7 +186,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if !d.IsNewResource() && d.HasChange("description") {
 		request.Description = d.Get("description").(string)
 		attributeUpdate = true
@@ -202,7 +210,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 			return ecsClient.ModifyNetworkInterfaceAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackGoClientFailure)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackGoClientFailure))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("security_groups")
@@ -226,10 +234,10 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				unAssignPrivateIpAddressesRequest.Scheme = "http"
 			}
 			unAssignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-			unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			unAssignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 			unAssignPrivateIpAddressesRequest.PrivateIpAddress = &unAssignIpList
-			err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.UnassignPrivateIpAddresses(unAssignPrivateIpAddressesRequest)
 				})
@@ -243,7 +251,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				return nil
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackGoClientFailure)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackGoClientFailure))
 			}
 		}
 
@@ -258,10 +266,10 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				assignPrivateIpAddressesRequest.Scheme = "http"
 			}
 			assignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-			assignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			assignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			assignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 			assignPrivateIpAddressesRequest.PrivateIpAddress = &assignIpList
-			err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.AssignPrivateIpAddresses(assignPrivateIpAddressesRequest)
 				})
@@ -275,12 +283,12 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				return nil
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), assignPrivateIpAddressesRequest.GetActionName(), AlibabacloudStackGoClientFailure)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), assignPrivateIpAddressesRequest.GetActionName(), AlibabacloudStackGoClientFailure))
 			}
 		}
 
 		if err := ecsService.WaitForPrivateIpsListChanged(d.Id(), expandStringList(newIpsSet.List())); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 
 		//d.SetPartial("private_ips")
@@ -300,10 +308,10 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 					assignPrivateIpAddressesRequest.Scheme = "http"
 				}
 				assignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-				assignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				assignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				assignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 				assignPrivateIpAddressesRequest.SecondaryPrivateIpAddressCount = requests.NewInteger(diff)
-				err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+				err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 						return ecsClient.AssignPrivateIpAddresses(assignPrivateIpAddressesRequest)
 					})
@@ -318,7 +326,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 					return nil
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), assignPrivateIpAddressesRequest.GetActionName(), AlibabacloudStackGoClientFailure)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), assignPrivateIpAddressesRequest.GetActionName(), AlibabacloudStackGoClientFailure))
 				}
 			}
 
@@ -333,8 +341,8 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 					unAssignPrivateIpAddressesRequest.Scheme = "http"
 				}
 				unAssignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-				unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-				err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+				unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					unAssignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 					unAssignPrivateIpAddressesRequest.PrivateIpAddress = &unAssignIps
 					raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -350,13 +358,13 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 					return nil
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), unAssignPrivateIpAddressesRequest.GetActionName(), AlibabacloudStackGoClientFailure)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), unAssignPrivateIpAddressesRequest.GetActionName(), AlibabacloudStackGoClientFailure))
 				}
 			}
 
 			err := ecsService.WaitForPrivateIpsCountChanged(d.Id(), newIpsCount.(int))
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 
 			//d.SetPartial("private_ips_count")
@@ -364,16 +372,16 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if err := setTags(client, TagResourceEni, d); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Partial(false)
 
-	return resourceNetworkInterfaceRead(d, meta)
+	return resourceNetworkInterfaceRead(ctx, d, meta)
 }
 
-func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request := ecs.CreateDeleteNetworkInterfaceRequest()
@@ -385,9 +393,9 @@ func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) er
 	}
 	request.NetworkInterfaceId = d.Id()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteNetworkInterface(request)
 		})
@@ -401,7 +409,7 @@ func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) er
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackGoClientFailure)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackGoClientFailure))
 	}
-	return WrapError(ecsService.WaitForNetworkInterface(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(ecsService.WaitForNetworkInterface(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
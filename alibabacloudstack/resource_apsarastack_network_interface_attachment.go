@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	//"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,14 +16,19 @@ import (
 
 func resourceNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkInterfaceAttachmentCreate,
-		Read:   resourceAlibabacloudStackNetworkInterfaceAttachmentRead,
-		Delete: resourceAlibabacloudStackNetworkInterfaceAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackNetworkInterfaceAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackNetworkInterfaceAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackNetworkInterfaceAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -37,8 +44,8 @@ func resourceNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	eniId := d.Get("network_interface_id").(string)
@@ -52,11 +59,11 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.Resourc
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = instanceId
 	request.NetworkInterfaceId = eniId
 
-	err := resource.Retry(DefaultTimeout*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachNetworkInterface(request)
 		})
@@ -70,18 +77,18 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_network_interface_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_network_interface_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(eniId + COLON_SEPARATED + instanceId)
-	if err = ecsService.WaitForNetworkInterface(eniId, InUse, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err = ecsService.WaitForNetworkInterface(eniId, InUse, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d, meta)
+	return resourceAlibabacloudStackNetworkInterfaceAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	object, err := ecsService.DescribeNetworkInterfaceAttachment(d.Id())
@@ -90,7 +97,7 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.InstanceId)
@@ -99,12 +106,12 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	eniId, instanceId := parts[0], parts[1]
 
@@ -113,7 +120,7 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.Resourc
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := ecs.CreateDetachNetworkInterfaceRequest()
@@ -126,9 +133,9 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.Resourc
 	request.InstanceId = instanceId
 	request.NetworkInterfaceId = eniId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err = resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachNetworkInterface(request)
 		})
@@ -142,7 +149,7 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(ecsService.WaitForNetworkInterface(eniId, Available, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(ecsService.WaitForNetworkInterface(eniId, Available, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
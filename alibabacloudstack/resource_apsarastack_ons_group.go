@@ -1,18 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
-	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,10 +19,10 @@ import (
 
 func resourceAlibabacloudStackOnsGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsGroupCreate,
-		Read:   resourceAlibabacloudStackOnsGroupRead,
-		Update: resourceAlibabacloudStackOnsGroupUpdate,
-		Delete: resourceAlibabacloudStackOnsGroupDelete,
+		CreateContext: resourceAlibabacloudStackOnsGroupCreate,
+		ReadContext:   resourceAlibabacloudStackOnsGroupRead,
+		UpdateContext: resourceAlibabacloudStackOnsGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackOnsGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -53,76 +52,53 @@ func resourceAlibabacloudStackOnsGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOnsGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 
 	instanceId := d.Get("instance_id").(string)
 	groupId := d.Get("group_id").(string)
 	remark := d.Get("remark").(string)
-	request := requests.NewCommonRequest()
-
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleGroupCreate",
-		"Version":         "2018-02-05",
-		"ProductName":     "Ons-inner",
-		"PreventCache":    "",
-		"GroupId":         groupId,
-		"Remark":          remark,
-		"OnsRegionId":     client.RegionId,
-		"InstanceId":      instanceId,
-	}
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.ServiceCode = "Ons-inner"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ConsoleGroupCreate"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleGroupCreate", "")
+	request.QueryParams["ProductName"] = "Ons-inner"
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["GroupId"] = groupId
+	request.QueryParams["Remark"] = remark
+	request.QueryParams["OnsRegionId"] = client.RegionId
+	request.QueryParams["InstanceId"] = instanceId
 	grp_resp := OGroup{}
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_group", "ConsoleGroupCreate", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_group", "ConsoleGroupCreate", raw))
 	}
 	addDebug("ConsoleGroupCreate", raw, requestInfo, request)
 
 	bresponse, _ := raw.(*responses.CommonResponse)
 	if bresponse.IsSuccess() != true {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_group", "ConsoleGroupCreate", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_group", "ConsoleGroupCreate", AlibabacloudStackSdkGoERROR))
 	}
 
 	err = json.Unmarshal(bresponse.GetHttpContentBytes(), &grp_resp)
 	if grp_resp.Success != true {
-		return WrapErrorf(errors.New(grp_resp.Message), DefaultErrorMsg, "alibabacloudstack_ons_group", "ConsoleGroupCreate", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(errors.New(grp_resp.Message), DefaultErrorMsg, "alibabacloudstack_ons_group", "ConsoleGroupCreate", AlibabacloudStackSdkGoERROR))
 	}
 
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	log.Printf("groupid and instanceid %s %s", groupId, instanceId)
 	d.SetId(groupId + COLON_SEPARATED + instanceId)
 
-	return resourceAlibabacloudStackOnsGroupRead(d, meta)
+	return resourceAlibabacloudStackOnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	onsService := OnsService{client}
 
 	object, err := onsService.DescribeOnsGroup(d.Id())
@@ -132,7 +108,7 @@ func resourceAlibabacloudStackOnsGroupRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.Data[0].NamespaceID)
@@ -142,52 +118,29 @@ func resourceAlibabacloudStackOnsGroupRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackOnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackOnsGroupRead(d, meta)
+func resourceAlibabacloudStackOnsGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceAlibabacloudStackOnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOnsGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	onsService := OnsService{client}
 	var requestInfo *ecs.Client
 	check, err := onsService.DescribeOnsGroup(d.Id())
 	parts, err := ParseResourceId(d.Id(), 2)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, parts[0], "IsGroupExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, parts[0], "IsGroupExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsGroupExist", check, requestInfo, map[string]string{"GroupId": parts[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ons-inner",
-			"Action":          "ConsoleGroupDelete",
-			"Version":         "2018-02-05",
-			"ProductName":     "Ons-inner",
-			"PreventCache":    "",
-			"GroupId":         parts[0],
-			"OnsRegionId":     client.RegionId,
-			"InstanceId":      parts[1],
-		}
-
-		request.Method = "POST"
-		request.Product = "Ons-inner"
-		request.Version = "2018-02-05"
-		request.ServiceCode = "Ons-inner"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "ConsoleGroupDelete"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+
+		request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleGroupDelete", "")
+		request.QueryParams["ProductName"] = "Ons-inner"
+		request.QueryParams["PreventCache"] = ""
+		request.QueryParams["GroupId"] = parts[0]
+		request.QueryParams["OnsRegionId"] = client.RegionId
+		request.QueryParams["InstanceId"] = parts[1]
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -1,18 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,10 +20,10 @@ import (
 
 func resourceAlibabacloudStackOnsInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsInstanceCreate,
-		Read:   resourceAlibabacloudStackOnsInstanceRead,
-		Update: resourceAlibabacloudStackOnsInstanceUpdate,
-		Delete: resourceAlibabacloudStackOnsInstanceDelete,
+		CreateContext: resourceAlibabacloudStackOnsInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackOnsInstanceRead,
+		UpdateContext: resourceAlibabacloudStackOnsInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackOnsInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -83,8 +83,8 @@ func resourceAlibabacloudStackOnsInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOnsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *ecs.Client
 	maxrtps := d.Get("tps_receive_max").(int)
 	maxstps := d.Get("tps_send_max").(int)
@@ -94,62 +94,40 @@ func resourceAlibabacloudStackOnsInstanceCreate(d *schema.ResourceData, meta int
 
 	cluster := d.Get("cluster").(string)
 	name := d.Get("name").(string)
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Product":           "Ons-inner",
-		"Action":            "ConsoleInstanceCreate",
-		"Version":           "2018-02-05",
-		"ProductName":       "Ons-inner",
-		"OnsRegionId":       client.RegionId,
-		"InstanceName":      name,
-		"MaxReceiveTps":     fmt.Sprint(maxrtps),
-		"MaxSendTps":        fmt.Sprint(maxstps),
-		"TopicCapacity":     fmt.Sprint(topiccapacity),
-		"Cluster":           cluster,
-		"IndependentNaming": independentname,
-	}
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.ServiceCode = "Ons-inner"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ConsoleInstanceCreate"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleInstanceCreate", "")
+	request.QueryParams["ProductName"] = "Ons-inner"
+	request.QueryParams["OnsRegionId"] = client.RegionId
+	request.QueryParams["InstanceName"] = name
+	request.QueryParams["MaxReceiveTps"] = fmt.Sprint(maxrtps)
+	request.QueryParams["MaxSendTps"] = fmt.Sprint(maxstps)
+	request.QueryParams["TopicCapacity"] = fmt.Sprint(topiccapacity)
+	request.QueryParams["Cluster"] = cluster
+	request.QueryParams["IndependentNaming"] = independentname
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", raw))
 	}
 	addDebug("ConsoleInstanceCreate", raw, requestInfo, request)
 	bresponse, _ := raw.(*responses.CommonResponse)
 	err = json.Unmarshal(bresponse.GetHttpContentBytes(), &ins_resp)
 	if ins_resp.Success != true {
-		return WrapErrorf(errors.New(ins_resp.Message), DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(errors.New(ins_resp.Message), DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", AlibabacloudStackSdkGoERROR))
 	}
 
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.SetId(ins_resp.Data.InstanceID)
 
-	return resourceAlibabacloudStackOnsInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackOnsInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	onsService := OnsService{client}
 
 	response, err := onsService.DescribeOnsInstance(d.Id())
@@ -160,7 +138,7 @@ func resourceAlibabacloudStackOnsInstanceRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", response.Data.InstanceName)
@@ -171,15 +149,15 @@ func resourceAlibabacloudStackOnsInstanceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOnsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	onsService := OnsService{client}
 	independentname := d.Get("independent_naming").(string)
 	cluster := d.Get("cluster").(string)
 	attributeUpdate := false
 	check, err := onsService.DescribeOnsInstance(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR))
 	}
 	var name, remark string
 
@@ -250,41 +228,19 @@ func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta int
 	topiccap := strconv.Itoa(topic)
 	Maxrtps := strconv.Itoa(maxrtps)
 	Maxstps := strconv.Itoa(maxstps)
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"Product":           "Ons-inner",
-		"Action":            "ConsoleInstanceUpdate",
-		"Version":           "2018-02-05",
-		"Remark":            remark,
-		"InstanceName":      name,
-		"OnsRegionId":       client.RegionId,
-		"PreventCache":      "",
-		"MaxReceiveTps":     Maxrtps,
-		"MaxSendTps":        Maxstps,
-		"Cluster":           cluster,
-		"IndependentNaming": independentname,
-		"InstanceId":        d.Id(),
-		"TopicCapacity":     topiccap,
-	}
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.ServiceCode = "Ons-inner"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.SetHTTPSInsecure(true)
-	request.ApiName = "ConsoleInstanceUpdate"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
 	check.Data.InstanceID = d.Id()
+	request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleInstanceUpdate", "")
+	request.SetHTTPSInsecure(true)
+	request.QueryParams["Remark"] = remark
+	request.QueryParams["InstanceName"] = name
+	request.QueryParams["OnsRegionId"] = client.RegionId
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["MaxReceiveTps"] = Maxrtps
+	request.QueryParams["MaxSendTps"] = Maxstps
+	request.QueryParams["Cluster"] = cluster
+	request.QueryParams["IndependentNaming"] = independentname
+	request.QueryParams["InstanceId"] = d.Id()
+	request.QueryParams["TopicCapacity"] = topiccap
 
 	if attributeUpdate {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -293,55 +249,32 @@ func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta int
 		log.Printf(" response of raw ConsoleInstanceUpdate : %s", raw)
 
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", raw)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ons_instance", "ConsoleInstanceCreate", raw))
 		}
 		addDebug(request.GetActionName(), raw, request)
 		log.Printf("total QueryParams and topic %v %v", request.GetQueryParams(), topic)
 
 	}
 
-	return resourceAlibabacloudStackOnsInstanceRead(d, meta)
+	return resourceAlibabacloudStackOnsInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOnsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	onsService := OnsService{client}
 	var requestInfo *ecs.Client
 	check, err := onsService.DescribeOnsInstance(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsInstanceExist", check, requestInfo, map[string]string{"InstanceId": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ons-inner",
-			"Action":          "ConsoleInstanceDelete",
-			"Version":         "2018-02-05",
-			"ProductName":     "Ons-inner",
-			"PreventCache":    "",
-			"OnsRegionId":     client.RegionId,
-			"InstanceId":      d.Id(),
-		}
-
-		request.Method = "POST"
-		request.Product = "Ons-inner"
-		request.Version = "2018-02-05"
-		request.ServiceCode = "Ons-inner"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "ConsoleInstanceDelete"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleInstanceDelete", "")
+		request.QueryParams["ProductName"] = "Ons-inner"
+		request.QueryParams["PreventCache"] = ""
+		request.QueryParams["OnsRegionId"] = client.RegionId
+		request.QueryParams["InstanceId"] = d.Id()
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
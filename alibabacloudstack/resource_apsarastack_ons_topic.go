@@ -1,18 +1,17 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"strconv"
-	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,10 +19,10 @@ import (
 
 func resourceAlibabacloudStackOnsTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsTopicCreate,
-		Read:   resourceAlibabacloudStackOnsTopicRead,
-		Update: resourceAlibabacloudStackOnsTopicUpdate,
-		Delete: resourceAlibabacloudStackOnsTopicDelete,
+		CreateContext: resourceAlibabacloudStackOnsTopicCreate,
+		ReadContext:   resourceAlibabacloudStackOnsTopicRead,
+		UpdateContext: resourceAlibabacloudStackOnsTopicUpdate,
+		DeleteContext: resourceAlibabacloudStackOnsTopicDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -57,67 +56,45 @@ func resourceAlibabacloudStackOnsTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOnsTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ordertype := d.Get("message_type").(string)
 	instanceId := d.Get("instance_id").(string)
 	remark := d.Get("remark").(string)
 	topic := d.Get("topic").(string)
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleTopicCreate",
-		"Version":         "2018-02-05",
-		"ProductName":     "Ons-inner",
-		"PreventCache":    "",
-		"OrderType":       ordertype,
-		"Topic":           topic,
-		"Remark":          remark,
-		"OnsRegionId":     client.RegionId,
-		"InstanceId":      instanceId,
-	}
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.ServiceCode = "Ons-inner"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ConsoleTopicCreate"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleTopicCreate", "")
+	request.QueryParams["ProductName"] = "Ons-inner"
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["OrderType"] = ordertype
+	request.QueryParams["Topic"] = topic
+	request.QueryParams["Remark"] = remark
+	request.QueryParams["OnsRegionId"] = client.RegionId
+	request.QueryParams["InstanceId"] = instanceId
 	response := TopicStruct{}
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	bresponse, _ := raw.(*responses.CommonResponse)
 	if bresponse.IsSuccess() != true {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", "ConsoleTopicCreate", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", "ConsoleTopicCreate", AlibabacloudStackSdkGoERROR))
 	}
 	_ = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
 	if response.Success != true {
-		return WrapErrorf(errors.New(response.Message), DefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", "ConsoleTopicCreate", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(errors.New(response.Message), DefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", "ConsoleTopicCreate", AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(topic + COLON_SEPARATED + instanceId)
 
-	return resourceAlibabacloudStackOnsTopicRead(d, meta)
+	return resourceAlibabacloudStackOnsTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	onsService := OnsService{client}
 
 	object, err := onsService.DescribeOnsTopic(d.Id())
@@ -127,7 +104,7 @@ func resourceAlibabacloudStackOnsTopicRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_id", object.Data[0].NamespaceID)
@@ -138,12 +115,12 @@ func resourceAlibabacloudStackOnsTopicRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackOnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackOnsTopicRead(d, meta)
+func resourceAlibabacloudStackOnsTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceAlibabacloudStackOnsTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOnsTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	onsService := OnsService{client}
 	var requestInfo *ecs.Client
 
@@ -151,40 +128,17 @@ func resourceAlibabacloudStackOnsTopicDelete(d *schema.ResourceData, meta interf
 	parts, err := ParseResourceId(d.Id(), 2)
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsTopicExist", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsTopicExist", AlibabacloudStackSdkGoERROR))
 	}
 	addDebug("IsTopicExist", check, requestInfo, map[string]string{"Topic": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-
-		request := requests.NewCommonRequest()
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ons-inner",
-			"Action":          "ConsoleTopicDelete",
-			"Version":         "2018-02-05",
-			"ProductName":     "Ons-inner",
-			"PreventCache":    "",
-			"Topic":           parts[0],
-			"OnsRegionId":     client.RegionId,
-			"InstanceId":      parts[1],
-		}
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		request.Method = "POST"
-		request.Product = "Ons-inner"
-		request.Version = "2018-02-05"
-		request.ServiceCode = "Ons-inner"
-		request.Domain = client.Domain
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "ConsoleTopicDelete"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+		request := client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleTopicDelete", "")
+		request.QueryParams["ProductName"] = "Ons-inner"
+		request.QueryParams["PreventCache"] = ""
+		request.QueryParams["Topic"] = parts[0]
+		request.QueryParams["OnsRegionId"] = client.RegionId
+		request.QueryParams["InstanceId"] = parts[1]
 
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
@@ -200,7 +154,7 @@ func resourceAlibabacloudStackOnsTopicDelete(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", "ConsoleTopicDelete", AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_ons_topic", "ConsoleTopicDelete", AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,14 +17,15 @@ import (
 
 func resourceAlibabacloudStackOosExecution() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOosExecutionCreate,
-		Read:   resourceAlibabacloudStackOosExecutionRead,
-		Delete: resourceAlibabacloudStackOosExecutionDelete,
+		CreateContext: resourceAlibabacloudStackOosExecutionCreate,
+		ReadContext:   resourceAlibabacloudStackOosExecutionRead,
+		DeleteContext: resourceAlibabacloudStackOosExecutionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(11 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"counters": {
@@ -131,15 +134,15 @@ func resourceAlibabacloudStackOosExecution() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOosExecutionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOosExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	oosService := OosService{client}
 	var response map[string]interface{}
 	action := "StartExecution"
 	request := make(map[string]interface{})
 	conn, err := client.NewOosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("description"); ok {
 		request["Description"] = v
@@ -179,7 +182,7 @@ func resourceAlibabacloudStackOosExecutionCreate(d *schema.ResourceData, meta in
 	request["OrganizationId"] = client.Department
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -192,19 +195,19 @@ func resourceAlibabacloudStackOosExecutionCreate(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_execution", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_execution", action, AlibabacloudStackSdkGoERROR))
 	}
 	responseExecution := response["Execution"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseExecution["ExecutionId"]))
 	stateConf := BuildStateConf([]string{}, []string{"Success"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, oosService.OosExecutionStateRefreshFunc(d.Id(), []string{"Failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackOosExecutionRead(d, meta)
+	return resourceAlibabacloudStackOosExecutionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackOosExecutionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOosExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	oosService := OosService{client}
 	object, err := oosService.DescribeOosExecution(d.Id())
 	if err != nil {
@@ -213,7 +216,7 @@ func resourceAlibabacloudStackOosExecutionRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("counters", object["Counters"])
 	d.Set("create_date", object["CreateDate"])
@@ -234,13 +237,13 @@ func resourceAlibabacloudStackOosExecutionRead(d *schema.ResourceData, meta inte
 	d.Set("update_date", object["UpdateDate"])
 	return nil
 }
-func resourceAlibabacloudStackOosExecutionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOosExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteExecutions"
 	var response map[string]interface{}
 	conn, err := client.NewOosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"ExecutionIds": convertListToJsonString(convertListStringToListInterface([]string{d.Id()})),
@@ -250,7 +253,7 @@ func resourceAlibabacloudStackOosExecutionDelete(d *schema.ResourceData, meta in
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -263,7 +266,7 @@ func resourceAlibabacloudStackOosExecutionDelete(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackOosTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOosTemplateCreate,
-		Read:   resourceAlibabacloudStackOosTemplateRead,
-		Update: resourceAlibabacloudStackOosTemplateUpdate,
-		Delete: resourceAlibabacloudStackOosTemplateDelete,
+		CreateContext: resourceAlibabacloudStackOosTemplateCreate,
+		ReadContext:   resourceAlibabacloudStackOosTemplateRead,
+		UpdateContext: resourceAlibabacloudStackOosTemplateUpdate,
+		DeleteContext: resourceAlibabacloudStackOosTemplateDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -95,21 +97,21 @@ func resourceAlibabacloudStackOosTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOosTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOosTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateTemplate"
 	request := make(map[string]interface{})
 	conn, err := client.NewOosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["Content"] = d.Get("content")
 	request["RegionId"] = client.RegionId
 	if v, ok := d.GetOk("tags"); ok {
 		respJson, err := convertMaptoJsonString(v.(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request["Tags"] = respJson
 	}
@@ -120,7 +122,7 @@ func resourceAlibabacloudStackOosTemplateCreate(d *schema.ResourceData, meta int
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -133,15 +135,15 @@ func resourceAlibabacloudStackOosTemplateCreate(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_template", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_template", action, AlibabacloudStackSdkGoERROR))
 	}
 	responseTemplate := response["Template"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseTemplate["TemplateName"]))
 
-	return resourceAlibabacloudStackOosTemplateRead(d, meta)
+	return resourceAlibabacloudStackOosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackOosTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOosTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	oosService := OosService{client}
 	object, err := oosService.DescribeOosTemplate(d.Id())
 	if err != nil {
@@ -150,7 +152,7 @@ func resourceAlibabacloudStackOosTemplateRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("template_name", d.Id())
@@ -170,8 +172,8 @@ func resourceAlibabacloudStackOosTemplateRead(d *schema.ResourceData, meta inter
 	d.Set("updated_date", object["UpdatedDate"])
 	return nil
 }
-func resourceAlibabacloudStackOosTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOosTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	update := false
 	request := map[string]interface{}{
@@ -188,7 +190,7 @@ func resourceAlibabacloudStackOosTemplateUpdate(d *schema.ResourceData, meta int
 		update = true
 		respJson, err := convertMaptoJsonString(d.Get("tags").(map[string]interface{}))
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_template", "UpdateTemplate", AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_template", "UpdateTemplate", AlibabacloudStackSdkGoERROR))
 		}
 		request["Tags"] = respJson
 	}
@@ -200,10 +202,10 @@ func resourceAlibabacloudStackOosTemplateUpdate(d *schema.ResourceData, meta int
 		action := "UpdateTemplate"
 		conn, err := client.NewOosClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -216,18 +218,18 @@ func resourceAlibabacloudStackOosTemplateUpdate(d *schema.ResourceData, meta int
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackOosTemplateRead(d, meta)
+	return resourceAlibabacloudStackOosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackOosTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOosTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteTemplate"
 	var response map[string]interface{}
 	conn, err := client.NewOosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"TemplateName": d.Id(),
@@ -240,7 +242,7 @@ func resourceAlibabacloudStackOosTemplateDelete(d *schema.ResourceData, meta int
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -256,7 +258,7 @@ func resourceAlibabacloudStackOosTemplateDelete(d *schema.ResourceData, meta int
 		if IsExpectedErrors(err, []string{"EntityNotExists.Template"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
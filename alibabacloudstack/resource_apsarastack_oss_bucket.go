@@ -2,15 +2,15 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,14 +23,20 @@ import (
 
 func resourceAlibabacloudStackOssBucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketCreate,
-		Read:   resourceAlibabacloudStackOssBucketRead,
-		Update: resourceAlibabacloudStackOssBucketUpdate,
-		Delete: resourceAlibabacloudStackOssBucketDelete,
+		CreateContext: resourceAlibabacloudStackOssBucketCreate,
+		ReadContext:   resourceAlibabacloudStackOssBucketRead,
+		UpdateContext: resourceAlibabacloudStackOssBucketUpdate,
+		DeleteContext: resourceAlibabacloudStackOssBucketDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -171,6 +177,7 @@ func resourceAlibabacloudStackOssBucket() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Set:      expirationHash,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"date": {
@@ -185,34 +192,88 @@ func resourceAlibabacloudStackOssBucket() *schema.Resource {
 								},
 							},
 						},
-						//"transitions": {
-						//	Type:     schema.TypeSet,
-						//	Optional: true,
-						//	Set:      transitionsHash,
-						//	Elem: &schema.Resource{
-						//		Schema: map[string]*schema.Schema{
-						//			"created_before_date": {
-						//				Type:         schema.TypeString,
-						//				Optional:     true,
-						//				ValidateFunc: validateOssBucketDateTimestamp,
-						//			},
-						//			"days": {
-						//				Type:     schema.TypeInt,
-						//				Optional: true,
-						//			},
-						//			"storage_class": {
-						//				Type:     schema.TypeString,
-						//				Default:  oss.StorageStandard,
-						//				Optional: true,
-						//				ValidateFunc: validation.StringInSlice([]string{
-						//					string(oss.StorageStandard),
-						//					string(oss.StorageIA),
-						//					string(oss.StorageArchive),
-						//				}, false),
-						//			},
-						//		},
-						//	},
-						//},
+						"transitions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      transitionsHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"created_before_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateOssBucketDateTimestamp,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(oss.StorageIA),
+											string(oss.StorageArchive),
+										}, false),
+									},
+								},
+							},
+						},
+						"abort_multipart_upload": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      abortMultipartUploadHash,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"created_before_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateOssBucketDateTimestamp,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      noncurrentVersionExpirationHash,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      noncurrentVersionTransitionHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(oss.StorageIA),
+											string(oss.StorageArchive),
+										}, false),
+									},
+								},
+							},
+						},
 					},
 				},
 				MaxItems: 1000,
@@ -306,8 +367,8 @@ func resourceAlibabacloudStackOssBucket() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	request := map[string]string{"bucketName": d.Get("bucket").(string), "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	var requestInfo *oss.Client
@@ -315,7 +376,7 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 	det, err := ossService.DescribeOssBucket(bucketName)
 	log.Printf("======================== det:%v", det)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk))
 	}
 	acl := d.Get("acl").(string)
 	storageClass := d.Get("storage_class")
@@ -329,36 +390,12 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 	sse_algo := d.Get("storage_class")
 	// If not present, Create Bucket
 	if det.BucketInfo.Name == "" {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "PutBucket",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}", "Department", client.Department, "ResourceGroup", client.ResourceGroup, "RegionId", client.RegionId, "asVersion", "enterprise", "asArchitechture", "x86", "haAlibabacloudStack", "true", "Language", "en", "BucketName", bucketName, "StorageClass", storageClass, "x-oss-acl", acl, "SSEAlgorithm", sse_algo), //,"x-one-console-endpoint","http://oss-cn-neimeng-env30-d01-a.intra.env30.shuguang.com"),
-		}
-		request.Method = "POST"        // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "PutBucket"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}", "Department", client.Department, "ResourceGroup", client.ResourceGroup, "RegionId", client.RegionId, "asVersion", "enterprise", "asArchitechture", "x86", "haAlibabacloudStack", "true", "Language", "en", "BucketName", bucketName, "StorageClass", storageClass, "x-oss-acl", acl, "SSEAlgorithm", sse_algo)
 
 		raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -368,9 +405,9 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 		log.Printf("Bresponse ossbucket before error")
 		if err != nil {
 			if ossNotFoundError(err) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, bucketName, "CreateBucketInfo", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, bucketName, "CreateBucketInfo", AlibabacloudStackOssGoSdk))
 		}
 		log.Printf("Bresponse ossbucket after error")
 		addDebug("CreateBucketInfo", raw, requestInfo, request)
@@ -379,7 +416,7 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 		log.Printf("Bresponse ossbucket %s", bresponse)
 
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "CreateBucket", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "CreateBucket", AlibabacloudStackOssGoSdk))
 		}
 		//logging:= make(map[string]interface{})
 		log.Printf("Enter for logging")
@@ -388,7 +425,7 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 
 	}
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		det, err := ossService.DescribeOssBucket(bucketName)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -400,7 +437,7 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk))
 	}
 
 	// Assign the bucket name as the resource ID
@@ -410,27 +447,37 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 
 		err = resourceAlibabacloudStackOssBucketLoggingCreate(client, d)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Logging Failed", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Logging Failed", AlibabacloudStackOssGoSdk))
 		}
 	}
 	newlist := d.Get("vpclist").([]interface{})
-	bvclient := meta.(*connectivity.AlibabacloudStackClient)
+	bvclient := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	bvserver := BucketVpcService{bvclient}
 	vpclist, binderr := bvserver.BucketVpcList(bucketName)
 	if binderr != nil {
-		return WrapError(binderr)
+		return DiagnosticsFromError(WrapError(binderr))
 	}
 	oldlist := vpclist.VpcList
 	vpc_err := checkVpcListChange(oldlist, newlist, d, meta)
 	if vpc_err != nil {
-		return WrapError(vpc_err)
+		return DiagnosticsFromError(WrapError(vpc_err))
 	}
-	return resourceAlibabacloudStackOssBucketRead(d, meta)
+	if len(d.Get("lifecycle_rule").([]interface{})) > 0 {
+		if err := resourceAlibabacloudStackOssBucketLifecycleRuleUpdate(client, d); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		if err := ossService.PutOssBucketTags(d.Id(), v.(map[string]interface{})); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	return resourceAlibabacloudStackOssBucketRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	object, err := ossService.DescribeOssBucket(d.Id())
 	acl := d.Get("acl").(string)
@@ -442,7 +489,7 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	logging, err := resourceAlibabacloudStackOssBucketLoggingDescribe(client, d)
 	log.Printf("read describe logging %v", logging)
@@ -463,13 +510,13 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 	list = append(list, map[string]interface{}{"target_bucket": desclog.TargetBucket, "target_prefix": desclog.TargetPrefix})
 	//d.Set("logging",list)
 	if err = d.Set("logging", list); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	bvclient := meta.(*connectivity.AlibabacloudStackClient)
+	bvclient := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	bvserver := BucketVpcService{bvclient}
 	vpclist, binderr := bvserver.BucketVpcList(d.Get("bucket").(string))
 	if binderr != nil {
-		return WrapError(binderr)
+		return DiagnosticsFromError(WrapError(binderr))
 	}
 	var vlist []string
 	if len(vpclist.VpcList) > 0 {
@@ -479,6 +526,29 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 		}
 	}
 	d.Set("vpclist", vlist)
+
+	// lifecycle_rule is left as configured when the OSS deployment does not provide GetBucketLifecycle.
+	lifecycleRules, err := ossService.DescribeOssBucketLifecycle(d.Id())
+	if err != nil && !IsExpectedErrors(err, OssUnsupportedErrors) {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err == nil {
+		lrules, err := flattenOssBucketLifecycleRules(lifecycleRules)
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		if err := d.Set("lifecycle_rule", lrules); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	tags, err := ossService.DescribeOssBucketTags(d.Id())
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
 	//request := map[string]string{"bucketName": d.Id()}
 	//var requestInfo *oss.Client
 	//
@@ -630,64 +700,6 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 	//	}
 	//}
 	//
-	//// Read the lifecycle rule configuration
-	//raw, err = client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
-	//	return ossClient.GetBucketLifecycle(d.Id())
-	//})
-	//if err != nil && !ossNotFoundError(err) {
-	//	return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketLifecycle", AlibabacloudStackOssGoSdk)
-	//}
-	//addDebug("GetBucketLifecycle", raw, requestInfo, request)
-	//lrules := make([]map[string]interface{}, 0)
-	//lifecycle, _ := raw.(oss.GetBucketLifecycleResult)
-	//for _, lifecycleRule := range lifecycle.Rules {
-	//	rule := make(map[string]interface{})
-	//	rule["id"] = lifecycleRule.ID
-	//	rule["prefix"] = lifecycleRule.Prefix
-	//	if LifecycleRuleStatus(lifecycleRule.Status) == ExpirationStatusEnabled {
-	//		rule["enabled"] = true
-	//	} else {
-	//		rule["enabled"] = false
-	//	}
-	//	// expiration
-	//	if lifecycleRule.Expiration != nil {
-	//		e := make(map[string]interface{})
-	//		if lifecycleRule.Expiration.Date != "" {
-	//			t, err := time.Parse("2006-01-02T15:04:05.000Z", lifecycleRule.Expiration.Date)
-	//			if err != nil {
-	//				return WrapError(err)
-	//			}
-	//			e["date"] = t.Format("2006-01-02")
-	//		}
-	//		e["days"] = int(lifecycleRule.Expiration.Days)
-	//		rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
-	//	}
-	//	// transitions
-	//	//if len(lifecycleRule.Transitions) != 0 {
-	//	//	var eSli []interface{}
-	//	//	for _, transition := range lifecycleRule.Transitions {
-	//	//		e := make(map[string]interface{})
-	//	//		if transition.CreatedBeforeDate != "" {
-	//	//			t, err := time.Parse("2006-01-02T15:04:05.000Z", transition.CreatedBeforeDate)
-	//	//			if err != nil {
-	//	//				return WrapError(err)
-	//	//			}
-	//	//			e["created_before_date"] = t.Format("2006-01-02")
-	//	//		}
-	//	//		e["days"] = transition.Days
-	//	//		e["storage_class"] = string(transition.StorageClass)
-	//	//		eSli = append(eSli, e)
-	//	//	}
-	//	//	rule["transitions"] = schema.NewSet(transitionsHash, eSli)
-	//	//}
-	//
-	//	lrules = append(lrules, rule)
-	//}
-	//
-	//if err := d.Set("lifecycle_rule", lrules); err != nil {
-	//	return WrapError(err)
-	//}
-	//
 	//// Read Policy
 	//raw, err = client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
 	//	params := map[string]interface{}{}
@@ -713,32 +725,12 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 	//if err := d.Set("policy", policy); err != nil {
 	//	return WrapError(err)
 	//}
-	//
-	//// Read tags
-	//raw, err = client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
-	//	return ossClient.GetBucketTagging(d.Id())
-	//})
-	//
-	//if err != nil {
-	//	return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketTagging", AlibabacloudStackOssGoSdk)
-	//}
-	//addDebug("GetBucketTagging", raw, requestInfo, request)
-	//tagging, _ := raw.(oss.GetBucketTaggingResult)
-	//tagsMap := make(map[string]string)
-	//if len(tagging.Tags) > 0 {
-	//	for _, t := range tagging.Tags {
-	//		tagsMap[t.Key] = t.Value
-	//	}
-	//}
-	//if err := d.Set("tags", tagsMap); err != nil {
-	//	return WrapError(err)
-	//}
 
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	d.Partial(true)
 	if d.HasChange("logging") {
@@ -749,7 +741,7 @@ func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta inter
 		log.Print("changes in logging")
 		err := resourceAlibabacloudStackOssBucketLoggingCreate(client, d)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	if d.HasChange("vpclist") {
@@ -758,77 +750,63 @@ func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta inter
 		newlist := n.([]interface{})
 		vpc_err := checkVpcListChange(oldlist, newlist, d, meta)
 		if vpc_err != nil {
-			return WrapError(vpc_err)
+			return DiagnosticsFromError(WrapError(vpc_err))
+		}
+	}
+	if d.HasChange("lifecycle_rule") {
+		if err := resourceAlibabacloudStackOssBucketLifecycleRuleUpdate(client, d); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	if d.HasChange("tags") {
+		ossService := OssService{client}
+		if err := ossService.PutOssBucketTags(d.Id(), d.Get("tags").(map[string]interface{})); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackOssBucketRead(d, meta)
+	return resourceAlibabacloudStackOssBucketRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta interface{}) error {
-	bvclient := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bvclient := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	bvserver := BucketVpcService{bvclient}
 	vpclist, binderr := bvserver.BucketVpcList(d.Id())
 	if binderr != nil {
-		return WrapError(binderr)
+		return DiagnosticsFromError(WrapError(binderr))
 	}
 	var vlist []string
 	if len(vpclist.VpcList) > 0 {
 		for _, v := range vpclist.VpcList {
 			vpc := v.(map[string]interface{})
-			client2 := meta.(*connectivity.AlibabacloudStackClient)
+			client2 := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 			bvserver := BucketVpcService{client2}
 			binderr := bvserver.UnBindBucket(vpc["vpcId"].(string), d.Id())
 			if binderr != nil {
-				return WrapError(binderr)
+				return DiagnosticsFromError(WrapError(binderr))
 			}
 		}
 	}
 	d.Set("vpclist", vlist)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
 	det, err := ossService.DescribeOssBucket(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBucketExist", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("IsBucketExist", det.BucketInfo, requestInfo, map[string]string{"bucketName": d.Id()})
 	if det.BucketInfo.Name == "" {
 		return nil
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "DeleteBucket",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}", "Department", client.Department, "ResourceGroup", client.ResourceGroup, "RegionId", client.RegionId, "asVersion", "enterprise", "asArchitechture", "x86", "haAlibabacloudStack", "true", "Language", "en", "BucketName", d.Id(), "StorageClass", "Standard"), //,"x-one-console-endpoint","http://oss-cn-neimeng-env30-d01-a.intra.env30.shuguang.com"),
-
-		}
-		request.Method = "POST"        // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "DeleteBucket"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}", "Department", client.Department, "ResourceGroup", client.ResourceGroup, "RegionId", client.RegionId, "asVersion", "enterprise", "asArchitechture", "x86", "haAlibabacloudStack", "true", "Language", "en", "BucketName", d.Id(), "StorageClass", "Standard")
 
 		_, err := client.WithOssNewClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -851,7 +829,7 @@ func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta inter
 		return nil
 	})
 	log.Print(err)
-	return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func checkVpcListChange(oldlist []interface{}, newlist []interface{}, d *schema.ResourceData, meta interface{}) error {
@@ -925,6 +903,206 @@ func transitionsHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+func abortMultipartUploadHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if v, ok := m["created_before_date"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["days"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func noncurrentVersionExpirationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if v, ok := m["days"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func noncurrentVersionTransitionHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if v, ok := m["days"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	if v, ok := m["storage_class"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func resourceAlibabacloudStackOssBucketLifecycleRuleUpdate(client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) error {
+	ossService := OssService{client}
+	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
+	if len(lifecycleRules) == 0 {
+		return ossService.DeleteOssBucketLifecycle(d.Id())
+	}
+
+	rules := make([]oss.LifecycleRule, 0, len(lifecycleRules))
+	for i, lifecycleRule := range lifecycleRules {
+		r := lifecycleRule.(map[string]interface{})
+		rule := oss.LifecycleRule{
+			ID:     r["id"].(string),
+			Prefix: r["prefix"].(string),
+			Status: string(ExpirationStatusDisabled),
+		}
+		if r["enabled"].(bool) {
+			rule.Status = string(ExpirationStatusEnabled)
+		}
+
+		for _, e := range r["expiration"].(*schema.Set).List() {
+			expiration := e.(map[string]interface{})
+			date, days := expiration["date"].(string), expiration["days"].(int)
+			if (date == "") == (days == 0) {
+				return WrapError(Error("lifecycle_rule.%d.expiration: exactly one of 'date' and 'days' must be set", i))
+			}
+			rule.Expiration = &oss.LifecycleExpiration{Days: days}
+			if date != "" {
+				rule.Expiration.Date = ossLifecycleDate(date)
+			}
+		}
+
+		for _, t := range r["transitions"].(*schema.Set).List() {
+			transition := t.(map[string]interface{})
+			date, days := transition["created_before_date"].(string), transition["days"].(int)
+			if (date == "") == (days == 0) {
+				return WrapError(Error("lifecycle_rule.%d.transitions: exactly one of 'created_before_date' and 'days' must be set", i))
+			}
+			item := oss.LifecycleTransition{
+				Days:         days,
+				StorageClass: oss.StorageClassType(transition["storage_class"].(string)),
+			}
+			if date != "" {
+				item.CreatedBeforeDate = ossLifecycleDate(date)
+			}
+			rule.Transitions = append(rule.Transitions, item)
+		}
+
+		for _, a := range r["abort_multipart_upload"].(*schema.Set).List() {
+			abort := a.(map[string]interface{})
+			date, days := abort["created_before_date"].(string), abort["days"].(int)
+			if (date == "") == (days == 0) {
+				return WrapError(Error("lifecycle_rule.%d.abort_multipart_upload: exactly one of 'created_before_date' and 'days' must be set", i))
+			}
+			rule.AbortMultipartUpload = &oss.LifecycleAbortMultipartUpload{Days: days}
+			if date != "" {
+				rule.AbortMultipartUpload.CreatedBeforeDate = ossLifecycleDate(date)
+			}
+		}
+
+		for _, e := range r["noncurrent_version_expiration"].(*schema.Set).List() {
+			expiration := e.(map[string]interface{})
+			rule.NonVersionExpiration = &oss.LifecycleVersionExpiration{NoncurrentDays: expiration["days"].(int)}
+		}
+
+		for _, t := range r["noncurrent_version_transition"].(*schema.Set).List() {
+			transition := t.(map[string]interface{})
+			rule.NonVersionTransitions = append(rule.NonVersionTransitions, oss.LifecycleVersionTransition{
+				NoncurrentDays: transition["days"].(int),
+				StorageClass:   oss.StorageClassType(transition["storage_class"].(string)),
+			})
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return ossService.PutOssBucketLifecycle(d.Id(), oss.LifecycleConfiguration{Rules: rules})
+}
+
+func flattenOssBucketLifecycleRules(lifecycleRules []map[string]interface{}) ([]map[string]interface{}, error) {
+	lrules := make([]map[string]interface{}, 0, len(lifecycleRules))
+	for _, lifecycleRule := range lifecycleRules {
+		rule := map[string]interface{}{
+			"id":      fmt.Sprint(lifecycleRule["ID"]),
+			"prefix":  "",
+			"enabled": LifecycleRuleStatus(fmt.Sprint(lifecycleRule["Status"])) == ExpirationStatusEnabled,
+		}
+		if v, ok := lifecycleRule["Prefix"].(string); ok {
+			rule["prefix"] = v
+		}
+
+		var eSli []interface{}
+		for _, expiration := range ossResponseElements(lifecycleRule["Expiration"]) {
+			e := make(map[string]interface{})
+			date, err := ossLifecycleDateValue(expiration["Date"])
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			e["date"] = date
+			e["days"] = ossIntValue(expiration["Days"])
+			eSli = append(eSli, e)
+		}
+		rule["expiration"] = schema.NewSet(expirationHash, eSli)
+
+		var tSli []interface{}
+		for _, transition := range ossResponseElements(lifecycleRule["Transition"]) {
+			e := make(map[string]interface{})
+			date, err := ossLifecycleDateValue(transition["CreatedBeforeDate"])
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			e["created_before_date"] = date
+			e["days"] = ossIntValue(transition["Days"])
+			e["storage_class"] = fmt.Sprint(transition["StorageClass"])
+			tSli = append(tSli, e)
+		}
+		rule["transitions"] = schema.NewSet(transitionsHash, tSli)
+
+		var aSli []interface{}
+		for _, abort := range ossResponseElements(lifecycleRule["AbortMultipartUpload"]) {
+			e := make(map[string]interface{})
+			date, err := ossLifecycleDateValue(abort["CreatedBeforeDate"])
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			e["created_before_date"] = date
+			e["days"] = ossIntValue(abort["Days"])
+			aSli = append(aSli, e)
+		}
+		rule["abort_multipart_upload"] = schema.NewSet(abortMultipartUploadHash, aSli)
+
+		var nSli []interface{}
+		for _, expiration := range ossResponseElements(lifecycleRule["NoncurrentVersionExpiration"]) {
+			nSli = append(nSli, map[string]interface{}{
+				"days": ossIntValue(expiration["NoncurrentDays"]),
+			})
+		}
+		rule["noncurrent_version_expiration"] = schema.NewSet(noncurrentVersionExpirationHash, nSli)
+
+		var ntSli []interface{}
+		for _, transition := range ossResponseElements(lifecycleRule["NoncurrentVersionTransition"]) {
+			ntSli = append(ntSli, map[string]interface{}{
+				"days":          ossIntValue(transition["NoncurrentDays"]),
+				"storage_class": fmt.Sprint(transition["StorageClass"]),
+			})
+		}
+		rule["noncurrent_version_transition"] = schema.NewSet(noncurrentVersionTransitionHash, ntSli)
+
+		lrules = append(lrules, rule)
+	}
+	return lrules, nil
+}
+
+func ossLifecycleDateValue(v interface{}) (string, error) {
+	if v == nil || fmt.Sprint(v) == "" {
+		return "", nil
+	}
+	t, err := time.Parse("2006-01-02T15:04:05.000Z", fmt.Sprint(v))
+	if err != nil {
+		return "", err
+	}
+	return t.Format("2006-01-02"), nil
+}
+
+func ossLifecycleDate(date string) string {
+	return fmt.Sprintf("%sT00:00:00.000Z", date)
+}
+
 func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) error {
 	describelogging, err := resourceAlibabacloudStackOssBucketLoggingDescribe(client, d)
 
@@ -936,42 +1114,13 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 		log.Printf("logging is not null %v", d.Get("logging"))
 		if _, v := d.GetOk("logging"); v == false {
 			log.Print("logging is being disabled")
-			logrequest := requests.NewCommonRequest()
-			if client.Config.Insecure {
-				logrequest.SetHTTPSInsecure(client.Config.Insecure)
-			}
-			logrequest.QueryParams = map[string]string{
-
-				"AccessKeySecret":  client.SecretKey,
-				"Product":          "OneRouter",
-				"Department":       client.Department,
-				"ResourceGroup":    client.ResourceGroup,
-				"RegionId":         client.RegionId,
-				"Action":           "DoOpenApi",
-				"AccountInfo":      "123456",
-				"Version":          "2018-12-12",
-				"SignatureVersion": "1.0",
-				"OpenApiAction":    "PutBucketLogging",
-				"ProductName":      "oss",
-				"Content":          fmt.Sprint("<BucketLoggingStatus></BucketLoggingStatus>"),
-				//"Content": oss-accesslog/",
-				"Params": fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id()),
-				//"Params": "{\"BucketName\":\"source-sample-bucket\"}",
-
-				//"Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}", "Department", client.Department, "ResourceGroup", client.ResourceGroup, "RegionId", client.RegionId, "asVersion", "enterprise", "asArchitechture", "x86", "haAlibabacloudStack", "true", "Language", "en", "BucketName", bucketName, "StorageClass", storageClass, "x-oss-acl", acl, "SSEAlgorithm", sse_algo), //,"x-one-console-endpoint","http://oss-cn-neimeng-env30-d01-a.intra.env30.shuguang.com"),
-
-			}
-			logrequest.Method = "POST"        // Set request method
-			logrequest.Product = "OneRouter"  // Specify product
-			logrequest.Version = "2018-12-12" // Specify product version
-			logrequest.ServiceCode = "OneRouter"
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				logrequest.Scheme = "https"
-			} else {
-				logrequest.Scheme = "http"
-			} // Set request scheme. Default: http
-			logrequest.ApiName = "DoOpenApi"
-			logrequest.Headers = map[string]string{"RegionId": client.RegionId}
+			logrequest := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+			logrequest.Domain = client.Domain
+			logrequest.QueryParams["AccountInfo"] = "123456"
+			logrequest.QueryParams["OpenApiAction"] = "PutBucketLogging"
+			logrequest.QueryParams["ProductName"] = "oss"
+			logrequest.QueryParams["Content"] = fmt.Sprint("<BucketLoggingStatus></BucketLoggingStatus>")
+			logrequest.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id())
 
 			raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -1012,42 +1161,13 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 					if err != nil {
 						return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "DescribeBucket")
 					}
-					logrequest := requests.NewCommonRequest()
-					if client.Config.Insecure {
-						logrequest.SetHTTPSInsecure(client.Config.Insecure)
-					}
-					logrequest.QueryParams = map[string]string{
-
-						"AccessKeySecret":  client.SecretKey,
-						"Product":          "OneRouter",
-						"Department":       client.Department,
-						"ResourceGroup":    client.ResourceGroup,
-						"RegionId":         client.RegionId,
-						"Action":           "DoOpenApi",
-						"AccountInfo":      "123456",
-						"Version":          "2018-12-12",
-						"SignatureVersion": "1.0",
-						"OpenApiAction":    "PutBucketLogging",
-						"ProductName":      "oss",
-						"Content":          fmt.Sprint("<BucketLoggingStatus><LoggingEnabled><TargetBucket>", logging["target_bucket"], "</TargetBucket><TargetPrefix>", logging["target_prefix"], "</TargetPrefix></LoggingEnabled></BucketLoggingStatus>"),
-						//"Content": oss-accesslog/",
-						"Params": fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id()),
-						//"Params": "{\"BucketName\":\"source-sample-bucket\"}",
-
-						//"Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}", "Department", client.Department, "ResourceGroup", client.ResourceGroup, "RegionId", client.RegionId, "asVersion", "enterprise", "asArchitechture", "x86", "haAlibabacloudStack", "true", "Language", "en", "BucketName", bucketName, "StorageClass", storageClass, "x-oss-acl", acl, "SSEAlgorithm", sse_algo), //,"x-one-console-endpoint","http://oss-cn-neimeng-env30-d01-a.intra.env30.shuguang.com"),
-
-					}
-					logrequest.Method = "POST"        // Set request method
-					logrequest.Product = "OneRouter"  // Specify product
-					logrequest.Version = "2018-12-12" // Specify product version
-					logrequest.ServiceCode = "OneRouter"
-					if strings.ToLower(client.Config.Protocol) == "https" {
-						logrequest.Scheme = "https"
-					} else {
-						logrequest.Scheme = "http"
-					} // Set request scheme. Default: http
-					logrequest.ApiName = "DoOpenApi"
-					logrequest.Headers = map[string]string{"RegionId": client.RegionId}
+					logrequest := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+					logrequest.Domain = client.Domain
+					logrequest.QueryParams["AccountInfo"] = "123456"
+					logrequest.QueryParams["OpenApiAction"] = "PutBucketLogging"
+					logrequest.QueryParams["ProductName"] = "oss"
+					logrequest.QueryParams["Content"] = fmt.Sprint("<BucketLoggingStatus><LoggingEnabled><TargetBucket>", logging["target_bucket"], "</TargetBucket><TargetPrefix>", logging["target_prefix"], "</TargetPrefix></LoggingEnabled></BucketLoggingStatus>")
+					logrequest.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id())
 
 					raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -1090,42 +1210,13 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 				if err != nil {
 					return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "DescribeBucket")
 				}
-				logrequest := requests.NewCommonRequest()
-				if client.Config.Insecure {
-					logrequest.SetHTTPSInsecure(client.Config.Insecure)
-				}
-				logrequest.QueryParams = map[string]string{
-
-					"AccessKeySecret":  client.SecretKey,
-					"Product":          "OneRouter",
-					"Department":       client.Department,
-					"ResourceGroup":    client.ResourceGroup,
-					"RegionId":         client.RegionId,
-					"Action":           "DoOpenApi",
-					"AccountInfo":      "123456",
-					"Version":          "2018-12-12",
-					"SignatureVersion": "1.0",
-					"OpenApiAction":    "PutBucketLogging",
-					"ProductName":      "oss",
-					"Content":          fmt.Sprint("<BucketLoggingStatus><LoggingEnabled><TargetBucket>", logging["target_bucket"], "</TargetBucket><TargetPrefix>", logging["target_prefix"], "</TargetPrefix></LoggingEnabled></BucketLoggingStatus>"),
-					//"Content": oss-accesslog/",
-					"Params": fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id()),
-					//"Params": "{\"BucketName\":\"source-sample-bucket\"}",
-
-					//"Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}", "Department", client.Department, "ResourceGroup", client.ResourceGroup, "RegionId", client.RegionId, "asVersion", "enterprise", "asArchitechture", "x86", "haAlibabacloudStack", "true", "Language", "en", "BucketName", bucketName, "StorageClass", storageClass, "x-oss-acl", acl, "SSEAlgorithm", sse_algo), //,"x-one-console-endpoint","http://oss-cn-neimeng-env30-d01-a.intra.env30.shuguang.com"),
-
-				}
-				logrequest.Method = "POST"        // Set request method
-				logrequest.Product = "OneRouter"  // Specify product
-				logrequest.Version = "2018-12-12" // Specify product version
-				logrequest.ServiceCode = "OneRouter"
-				if strings.ToLower(client.Config.Protocol) == "https" {
-					logrequest.Scheme = "https"
-				} else {
-					logrequest.Scheme = "http"
-				} // Set request scheme. Default: http
-				logrequest.ApiName = "DoOpenApi"
-				logrequest.Headers = map[string]string{"RegionId": client.RegionId}
+				logrequest := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+				logrequest.Domain = client.Domain
+				logrequest.QueryParams["AccountInfo"] = "123456"
+				logrequest.QueryParams["OpenApiAction"] = "PutBucketLogging"
+				logrequest.QueryParams["ProductName"] = "oss"
+				logrequest.QueryParams["Content"] = fmt.Sprint("<BucketLoggingStatus><LoggingEnabled><TargetBucket>", logging["target_bucket"], "</TargetBucket><TargetPrefix>", logging["target_prefix"], "</TargetPrefix></LoggingEnabled></BucketLoggingStatus>")
+				logrequest.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id())
 
 				raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -1148,38 +1239,14 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 }
 func resourceAlibabacloudStackOssBucketLoggingDescribe(client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) (*Logging, error) {
 
-	logdescribe := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		logdescribe.SetHTTPSInsecure(client.Config.Insecure)
-	}
 	describelogging := Logging{}
-	logdescribe.QueryParams = map[string]string{
-
-		"AccessKeySecret":   client.SecretKey,
-		"Product":           "OneRouter",
-		"Department":        client.Department,
-		"ResourceGroup":     client.ResourceGroup,
-		"RegionId":          client.RegionId,
-		"Action":            "DoOpenApi",
-		"AccountInfo":       "123456",
-		"Forwardedregionid": client.RegionId,
-		"Version":           "2018-12-12",
-		"SignatureVersion":  "1.0",
-		"OpenApiAction":     "GetBucketLogging",
-		"ProductName":       "oss",
-		"Params":            fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id()),
-	}
-	logdescribe.Method = "POST"        // Set request method
-	logdescribe.Product = "OneRouter"  // Specify product
-	logdescribe.Version = "2018-12-12" // Specify product version
-	logdescribe.ServiceCode = "OneRouter"
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		logdescribe.Scheme = "https"
-	} else {
-		logdescribe.Scheme = "http"
-	} // Set request scheme. Default: http
-	logdescribe.ApiName = "DoOpenApi"
-	logdescribe.Headers = map[string]string{"RegionId": client.RegionId}
+	logdescribe := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+	logdescribe.Domain = client.Domain
+	logdescribe.QueryParams["AccountInfo"] = "123456"
+	logdescribe.QueryParams["Forwardedregionid"] = client.RegionId
+	logdescribe.QueryParams["OpenApiAction"] = "GetBucketLogging"
+	logdescribe.QueryParams["ProductName"] = "oss"
+	logdescribe.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id())
 
 	lograw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -1,14 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -19,10 +21,19 @@ import (
 
 func resourceAlibabacloudStackOssBucketKms() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketKmsCreate,
-		Read:   resourceAlibabacloudStackOssBucketKmsRead,
-		Update: resourceAlibabacloudStackOssBucketKmsCreate,
-		Delete: resourceAlibabacloudStackOssBucketKmsDelete,
+		CreateContext: resourceAlibabacloudStackOssBucketKmsCreate,
+		ReadContext:   resourceAlibabacloudStackOssBucketKmsRead,
+		UpdateContext: resourceAlibabacloudStackOssBucketKmsCreate,
+		DeleteContext: resourceAlibabacloudStackOssBucketKmsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -68,14 +79,14 @@ func resourceAlibabacloudStackOssBucketKms() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketKmsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk))
 	}
 	sseAlgorithm := d.Get("sse_algorithm").(string)
 	kmsDateEncryption := ""
@@ -86,36 +97,13 @@ func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta in
 	}
 
 	if det.BucketInfo.Name == bucketName {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "PutBucketEncryption",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", bucketName),
-			"Content":          fmt.Sprintf("%s%s%s%s%s%s%s", "<ServerSideEncryptionRule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>", sseAlgorithm, "</SSEAlgorithm><KMSDataEncryption>", kmsDateEncryption, "</KMSDataEncryption><KMSMasterKeyID>", kmsMasterKeyID, "</KMSMasterKeyID></ApplyServerSideEncryptionByDefault></ServerSideEncryptionRule>"),
-		}
-		request.Method = "POST"        // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "PutBucketEncryption"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", bucketName)
+		request.QueryParams["Content"] = fmt.Sprintf("%s%s%s%s%s%s%s", "<ServerSideEncryptionRule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>", sseAlgorithm, "</SSEAlgorithm><KMSDataEncryption>", kmsDateEncryption, "</KMSDataEncryption><KMSMasterKeyID>", kmsMasterKeyID, "</KMSMasterKeyID></ApplyServerSideEncryptionByDefault></ServerSideEncryptionRule>")
 
 		raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 			return ossClient.ProcessCommonRequest(request)
@@ -124,9 +112,9 @@ func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta in
 		log.Printf("Bresponse ossbucket before error")
 		if err != nil {
 			if ossNotFoundError(err) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, bucketName, "PutBucketEncryption", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, bucketName, "PutBucketEncryption", AlibabacloudStackOssGoSdk))
 		}
 		log.Printf("Bresponse ossbucket after error")
 		addDebug("PutBucketEncryption", raw, requestInfo, request)
@@ -135,60 +123,42 @@ func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta in
 		log.Printf("Bresponse ossbucket %s", bresponse)
 
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "PutBucketEncryption", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "PutBucketEncryption", AlibabacloudStackOssGoSdk))
 		}
 		//logging:= make(map[string]interface{})
 		log.Printf("Enter for logging")
 	}
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk))
 	}
 	// Assign the bucket name as the resource ID
 	d.SetId(bucketName)
 
-	return resourceAlibabacloudStackOssBucketKmsRead(d, meta)
+	return resourceAlibabacloudStackOssBucketKmsRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketKmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
-	bucketName := d.Get("bucket").(string)
+	bucketName := d.Id()
 	det, err := ossService.DescribeOssBucket(bucketName)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk))
+	}
+	if det.BucketInfo.Name != bucketName {
+		d.SetId("")
+		return nil
 	}
+	d.Set("bucket", bucketName)
 	if det.BucketInfo.Name == bucketName {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "GetBucketEncryption",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", bucketName),
-		}
-		request.Method = "GET"         // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("GET", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "GetBucketEncryption"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", bucketName)
 
 		raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 			return ossClient.ProcessCommonRequest(request)
@@ -196,9 +166,9 @@ func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta inte
 		log.Printf("Response of GetBucketEncryption: %s", raw)
 		if err != nil {
 			if ossNotFoundError(err) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, bucketName, "GetBucketEncryption", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, bucketName, "GetBucketEncryption", AlibabacloudStackOssGoSdk))
 		}
 		addDebug("BucketEncryption", raw, requestInfo, request)
 		log.Printf("Bresponse ossbucket check")
@@ -206,63 +176,48 @@ func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta inte
 		log.Printf("Bresponse ossbucket %s", bresponse)
 
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "GetBucketEncryption", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "GetBucketEncryption", AlibabacloudStackOssGoSdk))
 		}
-		//logging:= make(map[string]interface{})
-		log.Printf("Enter for logging")
-		//d.Set("SSEAlgorithm",bresponse.GetHttpContentString())
+		var response map[string]interface{}
+		if err := json.Unmarshal(bresponse.GetHttpContentBytes(), &response); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		v, err := jsonpath.Get("$.Data.ServerSideEncryptionRule.ApplyServerSideEncryptionByDefault", response)
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, bucketName, "$.Data.ServerSideEncryptionRule.ApplyServerSideEncryptionByDefault", response))
+		}
+		rule, _ := v.(map[string]interface{})
+		d.Set("sse_algorithm", rule["SSEAlgorithm"])
+		d.Set("kms_data_encryption", rule["KMSDataEncryption"])
+		d.Set("kms_master_key_id", rule["KMSMasterKeyID"])
 	}
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketKmsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketKmsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
 	det, err := ossService.DescribeOssBucket(d.Id())
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBucketExist", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("IsBucketExist", det.BucketInfo, requestInfo, map[string]string{"bucketName": d.Id()})
 	if det.BucketInfo.Name == "" {
 		return nil
 	}
 
-	err = resource.Retry(3*time.Second, func() *resource.RetryError {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "DeleteBucketEncryption",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id()),
-		}
-		request.Method = "DELETE"      // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		request := client.NewCommonRequest("DELETE", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "DeleteBucketEncryption"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", d.Id())
 
 		_, err := client.WithOssNewClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -41,6 +41,12 @@ func TestAccAlibabacloudStackOssBucketKms_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content3", "acl3", "content_type3"},
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"bucket":              "${alibabacloudstack_oss_bucket.default.bucket}",
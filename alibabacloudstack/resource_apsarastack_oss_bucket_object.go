@@ -2,11 +2,19 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
@@ -17,10 +25,20 @@ import (
 
 func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketObjectPut,
-		Read:   resourceAlibabacloudStackOssBucketObjectRead,
-		Update: resourceAlibabacloudStackOssBucketObjectPut,
-		Delete: resourceAlibabacloudStackOssBucketObjectDelete,
+		CreateContext: resourceAlibabacloudStackOssBucketObjectPut,
+		ReadContext:   resourceAlibabacloudStackOssBucketObjectRead,
+		UpdateContext: resourceAlibabacloudStackOssBucketObjectUpdate,
+		DeleteContext: resourceAlibabacloudStackOssBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlibabacloudStackOssBucketObjectImport,
+		},
+		CustomizeDiff: resourceAlibabacloudStackOssBucketObjectCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -102,6 +120,39 @@ func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 				},
 			},
 
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateOssObjectMetadata,
+			},
+
+			"tags": tagsSchema(),
+
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 5120),
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -110,91 +161,139 @@ func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketObjectPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
 		requestInfo = ossClient
 		return ossClient.Bucket(d.Get("bucket").(string))
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket_object", "Bucket", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket_object", "Bucket", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("Bucket", raw, requestInfo, map[string]string{"bucketName": d.Get("bucket").(string)})
 	bucket, _ := raw.(*oss.Bucket)
-	var filePath string
-	var body io.Reader
 
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return WrapError(err)
+	if err := ossBucketObjectUpload(ctx, bucket, d); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		if err := bucket.PutObjectTagging(d.Get("key").(string), ossObjectTagging(v.(map[string]interface{}))); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Get("key").(string), "PutObjectTagging", AlibabacloudStackOssGoSdk))
 		}
-
-		filePath = path
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else {
-		return WrapError(Error("[ERROR] Must specify \"source\" or \"content\" field"))
 	}
 
-	key := d.Get("key").(string)
-	options, err := buildObjectHeaderOptions(d)
-
-	if v, ok := d.GetOk("server_side_encryption"); ok {
-		options = append(options, oss.ServerSideEncryption(v.(string)))
-	}
+	d.SetId(d.Get("key").(string))
+	return resourceAlibabacloudStackOssBucketObjectRead(ctx, d, meta)
+}
 
-	if v, ok := d.GetOk("kms_key_id"); ok {
-		options = append(options, oss.ServerSideEncryptionKeyID(v.(string)))
+func resourceAlibabacloudStackOssBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	var requestInfo *oss.Client
+	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
+		requestInfo = ossClient
+		return ossClient.Bucket(d.Get("bucket").(string))
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AlibabacloudStackOssGoSdk))
 	}
+	addDebug("Bucket", raw, requestInfo, map[string]string{"bucketName": d.Get("bucket").(string)})
+	bucket, _ := raw.(*oss.Bucket)
+	key := d.Get("key").(string)
 
-	if err != nil {
-		return WrapError(err)
+	// The object is only uploaded again when its data or encryption changes, header and metadata
+	// changes are applied by copying the object onto itself.
+	reupload := d.HasChanges("source", "content", "source_hash", "content_md5", "server_side_encryption", "kms_key_id")
+	if !reupload && d.HasChanges("content_type", "cache_control", "content_disposition", "content_encoding", "expires", "metadata") {
+		object, err := bucket.GetObjectDetailedMeta(key)
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectDetailedMeta", AlibabacloudStackOssGoSdk))
+		}
+		size, _ := strconv.ParseInt(object.Get("Content-Length"), 10, 64)
+		if size > ossObjectCopyMaxSize {
+			reupload = true
+		} else {
+			options, err := buildOssBucketObjectOptions(d)
+			if err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+			// The copy is encrypted again, as OSS encrypts it with the default of the bucket otherwise.
+			options = append(options, ossObjectEncryptionOptions(d)...)
+			options = append(options, oss.MetadataDirective(oss.MetaReplace))
+			if _, err := bucket.CopyObject(key, key, options...); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "CopyObject", AlibabacloudStackOssGoSdk))
+			}
+		}
 	}
-	if filePath != "" {
-		err = bucket.PutObjectFromFile(key, filePath, options...)
+	if reupload {
+		if err := ossBucketObjectUpload(ctx, bucket, d); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	} else if d.HasChange("acl") {
+		if err := bucket.SetObjectACL(key, oss.ACLType(d.Get("acl").(string))); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "SetObjectACL", AlibabacloudStackOssGoSdk))
+		}
 	}
 
-	if body != nil {
-		err = bucket.PutObject(key, body, options...)
+	// An upload replaces the tags of the object, so they are written again afterwards.
+	if d.HasChange("tags") || reupload {
+		tags := d.Get("tags").(map[string]interface{})
+		if len(tags) > 0 {
+			err = bucket.PutObjectTagging(key, ossObjectTagging(tags))
+		} else if !reupload {
+			err = bucket.DeleteObjectTagging(key)
+		}
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutObjectTagging", AlibabacloudStackOssGoSdk))
+		}
 	}
 
+	return resourceAlibabacloudStackOssBucketObjectRead(ctx, d, meta)
+}
+
+// resourceAlibabacloudStackOssBucketObjectCustomizeDiff plans source_hash from the current data of
+// source or content, so that changes to the file behind source trigger an upload.
+func resourceAlibabacloudStackOssBucketObjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("content") {
+		return d.SetNewComputed("source_hash")
+	}
+	hash, err := ossBucketObjectSourceHash(d.Get("source").(string), d.Get("content").(string))
 	if err != nil {
-		return WrapError(Error("Error putting object in Oss bucket (%#v): %s", bucket, err))
+		// The source file may only be created during the apply.
+		log.Printf("[WARN] Unable to compute the hash of the source of OSS object %s: %s", d.Get("key").(string), err)
+		return d.SetNewComputed("source_hash")
 	}
-
-	d.SetId(key)
-	return resourceAlibabacloudStackOssBucketObjectRead(d, meta)
+	if hash != d.Get("source_hash").(string) {
+		return d.SetNew("source_hash", hash)
+	}
+	return nil
 }
 
-func resourceAlibabacloudStackOssBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
 		requestInfo = ossClient
 		return ossClient.Bucket(d.Get("bucket").(string))
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("Bucket", raw, requestInfo, map[string]string{"bucketName": d.Get("bucket").(string)})
 	bucket, _ := raw.(*oss.Bucket)
 	options, err := buildObjectHeaderOptions(d)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	object, err := bucket.GetObjectDetailedMeta(d.Get("key").(string), options...)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"404 Not Found"}) {
 			d.SetId("")
-			return WrapError(Error("To get the Object: %#v but it is not exist in the specified bucket %s.", d.Get("key").(string), d.Get("bucket").(string)))
+			return DiagnosticsFromError(WrapError(Error("To get the Object: %#v but it is not exist in the specified bucket %s.", d.Get("key").(string), d.Get("bucket").(string))))
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectDetailedMeta", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectDetailedMeta", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("GetObjectDetailedMeta", object, requestInfo, map[string]interface{}{
 		"objectKey": d.Get("key").(string),
@@ -207,12 +306,52 @@ func resourceAlibabacloudStackOssBucketObjectRead(d *schema.ResourceData, meta i
 	d.Set("content_encoding", object.Get("Content-Encoding"))
 	d.Set("expires", object.Get("Expires"))
 	d.Set("version_id", object.Get("x-oss-version-id"))
+	d.Set("etag", strings.Trim(object.Get("ETag"), "\""))
+
+	metadata := make(map[string]interface{})
+	metaPrefix := strings.ToLower(oss.HTTPHeaderOssMetaPrefix)
+	for header := range object {
+		if name := strings.ToLower(header); strings.HasPrefix(name, metaPrefix) {
+			metadata[strings.TrimPrefix(name, metaPrefix)] = object.Get(header)
+		}
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	// tags are left as configured when the OSS deployment does not provide object tagging.
+	tagging, err := bucket.GetObjectTagging(d.Get("key").(string))
+	if err != nil && !ossNotFoundError(err) && !IsExpectedErrors(err, OssUnsupportedErrors) {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectTagging", AlibabacloudStackOssGoSdk))
+	}
+	if err == nil || ossNotFoundError(err) {
+		tags := make(map[string]interface{})
+		for _, tag := range tagging.Tags {
+			tags[tag.Key] = tag.Value
+		}
+		if err := d.Set("tags", tags); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+// resourceAlibabacloudStackOssBucketObjectImport imports an object from the id <bucket>:<key>. The key
+// may contain colons. The content and the source of the object are not read back.
+func resourceAlibabacloudStackOssBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, WrapError(Error("Invalid import id %s. Expected <bucket>:<key>.", d.Id()))
+	}
+	d.SetId(parts[1])
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAlibabacloudStackOssBucketObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
@@ -220,7 +359,7 @@ func resourceAlibabacloudStackOssBucketObjectDelete(d *schema.ResourceData, meta
 		return ossClient.Bucket(d.Get("bucket").(string))
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AlibabacloudStackOssGoSdk))
 	}
 	addDebug("Bucket", raw, requestInfo, map[string]string{"bucketName": d.Get("bucket").(string)})
 	bucket, _ := raw.(*oss.Bucket)
@@ -230,10 +369,10 @@ func resourceAlibabacloudStackOssBucketObjectDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"No Content", "Not Found"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObject", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObject", AlibabacloudStackOssGoSdk))
 	}
 
-	return WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 
 }
 
@@ -259,10 +398,6 @@ func buildObjectHeaderOptions(d *schema.ResourceData) (options []oss.Option, err
 		options = append(options, oss.ContentEncoding(v.(string)))
 	}
 
-	if v, ok := d.GetOk("content_md5"); ok {
-		options = append(options, oss.ContentMD5(v.(string)))
-	}
-
 	if v, ok := d.GetOk("expires"); ok {
 		expires := v.(string)
 		expiresTime, err := time.Parse(time.RFC1123, expires)
@@ -277,3 +412,174 @@ func buildObjectHeaderOptions(d *schema.ResourceData) (options []oss.Option, err
 	}
 	return options, nil
 }
+
+// ossObjectCopyMaxSize is the largest object that CopyObject can replace the metadata of.
+const ossObjectCopyMaxSize = 1024 * 1024 * 1024
+
+// ossBucketObjectUpload uploads source or content to the object. Source files larger than part_size
+// are uploaded in parts by parallelism goroutines, and no part is started once ctx is done.
+func ossBucketObjectUpload(ctx context.Context, bucket *oss.Bucket, d *schema.ResourceData) error {
+	key := d.Get("key").(string)
+	options, err := buildOssBucketObjectOptions(d)
+	if err != nil {
+		return WrapError(err)
+	}
+	options = append(options, ossObjectEncryptionOptions(d)...)
+
+	if v, ok := d.GetOk("source"); ok {
+		filePath, err := homedir.Expand(v.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return WrapError(err)
+		}
+		partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+		if info.Size() > partSize {
+			return ossBucketObjectUploadParts(ctx, bucket, d, key, filePath, partSize, options)
+		}
+		if v, ok := d.GetOk("content_md5"); ok {
+			options = append(options, oss.ContentMD5(v.(string)))
+		}
+		if err := bucket.PutObjectFromFile(key, filePath, options...); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, key, "PutObject", AlibabacloudStackOssGoSdk)
+		}
+	} else if v, ok := d.GetOk("content"); ok {
+		if v, ok := d.GetOk("content_md5"); ok {
+			options = append(options, oss.ContentMD5(v.(string)))
+		}
+		if err := bucket.PutObject(key, bytes.NewReader([]byte(v.(string))), options...); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, key, "PutObject", AlibabacloudStackOssGoSdk)
+		}
+	} else {
+		return WrapError(Error("[ERROR] Must specify \"source\" or \"content\" field"))
+	}
+
+	return ossBucketObjectSetSourceHash(d)
+}
+
+func ossBucketObjectSetSourceHash(d *schema.ResourceData) error {
+	if d.Get("source_hash").(string) == "" {
+		hash, err := ossBucketObjectSourceHash(d.Get("source").(string), d.Get("content").(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		d.Set("source_hash", hash)
+	}
+	return nil
+}
+
+// ossBucketObjectUploadParts uploads filePath in parts of partSize by parallelism goroutines. The
+// sdk UploadFile can not be cancelled, so the parts are uploaded here and the upload is aborted as
+// soon as a part fails or ctx is done, e.g. when the create or update timeout is reached.
+func ossBucketObjectUploadParts(ctx context.Context, bucket *oss.Bucket, d *schema.ResourceData, key, filePath string, partSize int64, options []oss.Option) error {
+	chunks, err := oss.SplitFileByPartSize(filePath, partSize)
+	if err != nil {
+		return WrapError(err)
+	}
+	imur, err := bucket.InitiateMultipartUpload(key, options...)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, key, "InitiateMultipartUpload", AlibabacloudStackOssGoSdk)
+	}
+
+	parts := make([]oss.UploadPart, len(chunks))
+	jobs := make(chan int)
+	var mutex sync.Mutex
+	var uploadErr error
+	var wg sync.WaitGroup
+	for i := 0; i < d.Get("parallelism").(int); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				mutex.Lock()
+				failed := uploadErr != nil
+				mutex.Unlock()
+				if failed {
+					continue
+				}
+				err := ctx.Err()
+				if err == nil {
+					parts[j], err = bucket.UploadPartFromFile(imur, filePath, chunks[j].Offset, chunks[j].Size, chunks[j].Number)
+				}
+				if err != nil {
+					mutex.Lock()
+					if uploadErr == nil {
+						uploadErr = err
+					}
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for j := range chunks {
+		jobs <- j
+	}
+	close(jobs)
+	wg.Wait()
+
+	if uploadErr != nil {
+		if err := bucket.AbortMultipartUpload(imur); err != nil {
+			log.Printf("[WARN] Unable to abort the upload %s of OSS object %s: %s", imur.UploadID, key, err)
+		}
+		return WrapErrorf(uploadErr, DefaultErrorMsg, key, "UploadPart", AlibabacloudStackOssGoSdk)
+	}
+	if _, err := bucket.CompleteMultipartUpload(imur, parts); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, key, "CompleteMultipartUpload", AlibabacloudStackOssGoSdk)
+	}
+	return ossBucketObjectSetSourceHash(d)
+}
+
+// ossObjectEncryptionOptions returns the server side encryption headers of the object.
+func ossObjectEncryptionOptions(d *schema.ResourceData) (options []oss.Option) {
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		options = append(options, oss.ServerSideEncryption(v.(string)))
+	}
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		options = append(options, oss.ServerSideEncryptionKeyID(v.(string)))
+	}
+	return options
+}
+
+// buildOssBucketObjectOptions adds the user metadata of the object to its header options.
+func buildOssBucketObjectOptions(d *schema.ResourceData) ([]oss.Option, error) {
+	options, err := buildObjectHeaderOptions(d)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		options = append(options, oss.Meta(k, v.(string)))
+	}
+	return options, nil
+}
+
+// ossBucketObjectSourceHash returns the hex encoded MD5 of the source file or of the content.
+func ossBucketObjectSourceHash(source, content string) (string, error) {
+	hash := md5.New()
+	if source != "" {
+		filePath, err := homedir.Expand(source)
+		if err != nil {
+			return "", err
+		}
+		file, err := os.Open(filePath)
+		if err != nil {
+			return "", err
+		}
+		defer file.Close()
+		if _, err := io.Copy(hash, file); err != nil {
+			return "", err
+		}
+	} else {
+		hash.Write([]byte(content))
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func ossObjectTagging(tags map[string]interface{}) oss.Tagging {
+	tagging := oss.Tagging{}
+	for k, v := range tags {
+		tagging.Tags = append(tagging.Tags, oss.Tag{Key: k, Value: v.(string)})
+	}
+	return tagging
+}
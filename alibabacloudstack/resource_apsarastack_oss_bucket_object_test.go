@@ -67,6 +67,13 @@ func TestAccAlibabacloudStackOssBucketObject_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       resourceImportStateIdFunc(resourceId, "bucket", "key"),
+				ImportStateVerifyIgnore: []string{"source", "content", "source_hash", "part_size", "parallelism", "acl", "server_side_encryption"},
+			},
 			/*
 				{
 					Config: testAccConfig(map[string]interface{}{
@@ -132,6 +139,159 @@ func TestAccAlibabacloudStackOssBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccAlibabacloudStackOssBucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-oss-object-test-acc-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	// 3MB of data is uploaded in three parts of 1MB.
+	err = ioutil.WriteFile(tmpFile.Name(), []byte(strings.Repeat("0123456789abcdef", 3*64*1024)), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v http.Header
+	resourceId := "alibabacloudstack_oss_bucket_object.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"bucket":      CHECKSET,
+		"key":         "test-object-multipart-key",
+		"source":      CHECKSET,
+		"part_size":   "1",
+		"parallelism": "2",
+		"etag":        CHECKSET,
+		"source_hash": CHECKSET,
+	})
+	testAccCheck := ra.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-object-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketObjectConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAlicloudOssBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":      "${alibabacloudstack_oss_bucket.default.bucket}",
+					"key":         "test-object-multipart-key",
+					"source":      strings.Replace(tmpFile.Name(), "\\", "\\\\", -1),
+					"part_size":   "1",
+					"parallelism": "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudOssBucketObjectExists(resourceId, name, v),
+					testAccCheck(nil),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"metadata": map[string]string{
+						"owner": "terraform",
+					},
+					"tags": map[string]string{
+						"Created": "TF",
+						"For":     "acceptance test",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"metadata.%":     "1",
+						"metadata.owner": "terraform",
+						"tags.%":         "2",
+						"tags.Created":   "TF",
+						"tags.For":       "acceptance test",
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					// Changing the file behind source has to be detected by source_hash.
+					if err := ioutil.WriteFile(tmpFile.Name(), []byte(strings.Repeat("fedcba9876543210", 3*64*1024)), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccConfig(map[string]interface{}{
+					"tags": map[string]string{
+						"Created": "TF",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":         "1",
+						"tags.Created":   "TF",
+						"tags.For":       REMOVEKEY,
+						"metadata.owner": "terraform",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlibabacloudStackOssBucketObject_kms(t *testing.T) {
+	var v http.Header
+	resourceId := "alibabacloudstack_oss_bucket_object.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"bucket":                 CHECKSET,
+		"key":                    "test-object-kms-key",
+		"content":                "some words for test oss object content",
+		"server_side_encryption": "KMS",
+		"kms_key_id":             CHECKSET,
+	})
+	testAccCheck := ra.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-object-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketObjectConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAlicloudOssBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":                 "${alibabacloudstack_oss_bucket.default.bucket}",
+					"key":                    "test-object-kms-key",
+					"content":                "some words for test oss object content",
+					"server_side_encryption": "KMS",
+					"kms_key_id":             "${data.alibabacloudstack_kms_keys.enabled.ids.0}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudOssBucketObjectExists(resourceId, name, v),
+					testAccCheck(nil),
+					testAccCheckOssBucketObjectEncryption(resourceId, name, "KMS"),
+				),
+			},
+			{
+				// The metadata changes are applied by copying the object, which has to stay encrypted.
+				Config: testAccConfig(map[string]interface{}{
+					"content_type": "text/plain",
+					"metadata": map[string]string{
+						"owner": "terraform",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"content_type":   "text/plain",
+						"metadata.%":     "1",
+						"metadata.owner": "terraform",
+					}),
+					testAccCheckOssBucketObjectEncryption(resourceId, name, "KMS"),
+				),
+			},
+		},
+	})
+}
+
 func resourceOssBucketObjectConfigDependence(name string) string {
 
 	return fmt.Sprintf(`
@@ -197,6 +357,30 @@ func testAccCheckOssBucketObjectExistsWithProviders(n string, bucket string, obj
 		return fmt.Errorf("Bucket not found")
 	}
 }
+func testAccCheckOssBucketObjectEncryption(n string, bucket string, encryption string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
+		raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+			return ossClient.Bucket(bucket)
+		})
+		if err != nil {
+			return fmt.Errorf("Error getting bucket: %#v", err)
+		}
+		object, err := raw.(*oss.Bucket).GetObjectDetailedMeta(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if actual := object.Get(oss.HTTPHeaderOssServerSideEncryption); actual != encryption {
+			return fmt.Errorf("expected the object %s to be encrypted with %s, got %q", rs.Primary.ID, encryption, actual)
+		}
+		return nil
+	}
+}
+
 func testAccCheckAlicloudOssBucketObjectDestroy(s *terraform.State) error {
 	return testAccCheckOssBucketObjectDestroyWithProvider(s, testAccProvider)
 }
@@ -247,3 +431,9 @@ func testAccCheckOssBucketObjectDestroyWithProvider(s *terraform.State, provider
 /*
 ALIBABACLOUDSTACK_OSSSERVICE_DOMAIN=oss-cn-qingdao-env66-d01-a.intra.env66.shuguang.com;
 */
+
+func TestResourceAlibabacloudStackOssBucketObjectImport(t *testing.T) {
+	testResourceImportState(t, resourceAlibabacloudStackOssBucketObject(), "tf-bucket:backup/2021-01-01T00:00:00.tar.gz",
+		"backup/2021-01-01T00:00:00.tar.gz", map[string]interface{}{"bucket": "tf-bucket", "key": "backup/2021-01-01T00:00:00.tar.gz"})
+	testResourceImportState(t, resourceAlibabacloudStackOssBucketObject(), "malformed", "", nil)
+}
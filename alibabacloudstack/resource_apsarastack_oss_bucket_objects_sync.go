@@ -0,0 +1,391 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceAlibabacloudStackOssBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackOssBucketObjectsSyncCreate,
+		ReadContext:   resourceAlibabacloudStackOssBucketObjectsSyncRead,
+		UpdateContext: resourceAlibabacloudStackOssBucketObjectsSyncUpdate,
+		DeleteContext: resourceAlibabacloudStackOssBucketObjectsSyncDelete,
+		CustomizeDiff: resourceAlibabacloudStackOssBucketObjectsSyncCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlibabacloudStackOssBucketObjectsSyncImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_patterns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateOssObjectsSyncPattern},
+			},
+			"exclude_patterns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateOssObjectsSyncPattern},
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      oss.ACLPrivate,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public-read", "public-read-write"}, false),
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackOssBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The id is set first, so the objects uploaded before a failure are kept in the state.
+	d.SetId(fmt.Sprintf("%s%s%s", d.Get("bucket").(string), COLON_SEPARATED, d.Get("key_prefix").(string)))
+	if err := ossBucketObjectsSync(ctx, d, meta, false); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutObject", AlibabacloudStackOssGoSdk))
+	}
+
+	return resourceAlibabacloudStackOssBucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	remote, err := ossService.ListOssBucketObjects(d.Get("bucket").(string), d.Get("key_prefix").(string))
+	if err != nil {
+		if IsExpectedErrors(err, []string{"NoSuchBucket"}) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	// Only the objects written by the resource are tracked, unless extraneous objects are deleted.
+	tracked := d.Get("objects").(map[string]interface{})
+	objects := make(map[string]interface{})
+	for _, object := range remote {
+		if _, ok := tracked[object.Key]; !ok {
+			if !d.Get("delete_extraneous").(bool) || !ossObjectsSyncIsManaged(d, object.Key) {
+				continue
+			}
+		}
+		objects[object.Key] = ossObjectsSyncEtag(object.ETag)
+	}
+	if err := d.Set("objects", objects); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackOssBucketObjectsSyncImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return nil, WrapError(Error("The objects sync %s can not be imported, as the files it uploaded are only known from its source_dir. "+
+		"Declare the resource with the same bucket, key_prefix and source_dir instead, the unchanged files are not uploaded again.", d.Id()))
+}
+
+func resourceAlibabacloudStackOssBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A new acl or cache_control is only applied by uploading every object again.
+	if err := ossBucketObjectsSync(ctx, d, meta, d.HasChanges("acl", "cache_control")); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutObject", AlibabacloudStackOssGoSdk))
+	}
+
+	return resourceAlibabacloudStackOssBucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	keys := make([]string, 0)
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := ossObjectsSyncDelete(client, d.Get("bucket").(string), keys); err != nil {
+		if IsExpectedErrors(err, []string{"NoSuchBucket"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObjects", AlibabacloudStackOssGoSdk))
+	}
+	return nil
+}
+
+// resourceAlibabacloudStackOssBucketObjectsSyncCustomizeDiff plans objects from the files in source_dir,
+// so that added, changed and removed files are detected.
+func resourceAlibabacloudStackOssBucketObjectsSyncCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("key_prefix") || !d.NewValueKnown("include_patterns") || !d.NewValueKnown("exclude_patterns") {
+		return d.SetNewComputed("objects")
+	}
+	files, err := ossObjectsSyncLocalFiles(d)
+	if err != nil {
+		// The directory may only be created during the apply.
+		return d.SetNewComputed("objects")
+	}
+	local := make(map[string]interface{})
+	for key, file := range files {
+		local[key] = file.hash
+	}
+	old := d.Get("objects").(map[string]interface{})
+	if len(old) != len(local) {
+		return d.SetNew("objects", local)
+	}
+	for key, hash := range local {
+		if old[key] != hash {
+			return d.SetNew("objects", local)
+		}
+	}
+	return nil
+}
+
+// ossBucketObjectsSync uploads the files of source_dir whose MD5 differs from the ETag of their object,
+// or all of them when force is set, and deletes the extraneous objects if delete_extraneous is set.
+func ossBucketObjectsSync(ctx context.Context, d *schema.ResourceData, meta interface{}, force bool) error {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+	bucketName := d.Get("bucket").(string)
+
+	files, err := ossObjectsSyncLocalFiles(d)
+	if err != nil {
+		return WrapError(err)
+	}
+	remote, err := ossService.ListOssBucketObjects(bucketName, d.Get("key_prefix").(string))
+	if err != nil {
+		return WrapError(err)
+	}
+	etags := make(map[string]string)
+	for _, object := range remote {
+		etags[object.Key] = ossObjectsSyncEtag(object.ETag)
+	}
+
+	// objects is recorded as the files are uploaded, so a failed sync can be resumed or destroyed. The
+	// files which are not uploaded yet are tracked with the ETag of their current object, if any.
+	objects := make(map[string]interface{})
+	for key := range files {
+		if etag, ok := etags[key]; ok {
+			objects[key] = etag
+		}
+	}
+	defer d.Set("objects", objects)
+	for key, file := range files {
+		if etag, ok := etags[key]; ok && etag == file.hash && !force {
+			continue
+		}
+		// the uploads stop once the create or update timeout is reached.
+		if err := ctx.Err(); err != nil {
+			return WrapError(err)
+		}
+		options := []oss.Option{
+			oss.ContentType(file.contentType),
+			oss.ObjectACL(oss.ACLType(d.Get("acl").(string))),
+		}
+		if v, ok := d.GetOk("cache_control"); ok {
+			options = append(options, oss.CacheControl(v.(string)))
+		}
+		var requestInfo *oss.Client
+		raw, err := client.WithOssBucketByName(bucketName, func(bucket *oss.Bucket) (interface{}, error) {
+			requestInfo = &bucket.Client
+			return nil, bucket.PutObjectFromFile(key, file.path, options...)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, key, "PutObjectFromFile", AlibabacloudStackOssGoSdk)
+		}
+		addDebug("PutObjectFromFile", raw, requestInfo, map[string]string{"objectKey": key, "filePath": file.path})
+		objects[key] = file.hash
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		extraneous := make([]string, 0)
+		for _, object := range remote {
+			if _, ok := files[object.Key]; !ok && ossObjectsSyncIsManaged(d, object.Key) {
+				extraneous = append(extraneous, object.Key)
+			}
+		}
+		if err := ossObjectsSyncDelete(client, bucketName, extraneous); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, bucketName, "DeleteObjects", AlibabacloudStackOssGoSdk)
+		}
+	}
+
+	return nil
+}
+
+func ossObjectsSyncDelete(client *connectivity.AlibabacloudStackClient, bucketName string, keys []string) error {
+	// DeleteObjects accepts at most 1000 keys per request.
+	for start := 0; start < len(keys); start += 1000 {
+		end := start + 1000
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batch := keys[start:end]
+		var requestInfo *oss.Client
+		raw, err := client.WithOssBucketByName(bucketName, func(bucket *oss.Bucket) (interface{}, error) {
+			requestInfo = &bucket.Client
+			return bucket.DeleteObjects(batch, oss.DeleteObjectsQuiet(true))
+		})
+		if err != nil {
+			return err
+		}
+		addDebug("DeleteObjects", raw, requestInfo, map[string]interface{}{"objectKeys": batch})
+	}
+	return nil
+}
+
+type ossObjectsSyncFile struct {
+	path        string
+	hash        string
+	contentType string
+}
+
+// ossObjectsSyncLocalFiles returns the files of source_dir that match the patterns, keyed by their object key.
+func ossObjectsSyncLocalFiles(d ossObjectsSyncConfig) (map[string]ossObjectsSyncFile, error) {
+	root, err := homedir.Expand(d.Get("source_dir").(string))
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]ossObjectsSyncFile)
+	err = filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		key := d.Get("key_prefix").(string) + filepath.ToSlash(rel)
+		if !ossObjectsSyncIsManaged(d, key) {
+			return nil
+		}
+		hash, contentType, err := ossObjectsSyncFileInfo(filePath)
+		if err != nil {
+			return err
+		}
+		files[key] = ossObjectsSyncFile{path: filePath, hash: hash, contentType: contentType}
+		return nil
+	})
+	return files, err
+}
+
+// ossObjectsSyncFileInfo returns the hex encoded MD5 and the content type of the file. The content type
+// is looked up by extension and detected from the first 512 bytes when the extension is unknown.
+func ossObjectsSyncFileInfo(filePath string) (string, string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(filePath))
+	if contentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(file, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return "", "", err
+		}
+		contentType = http.DetectContentType(head[:n])
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return "", "", err
+		}
+	}
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), contentType, nil
+}
+
+// ossObjectsSyncConfig is implemented by both schema.ResourceData and schema.ResourceDiff.
+type ossObjectsSyncConfig interface {
+	Get(string) interface{}
+}
+
+// ossObjectsSyncIsManaged reports whether the object key, relative to key_prefix, matches include_patterns
+// and none of exclude_patterns. Folder placeholder objects are never managed.
+func ossObjectsSyncIsManaged(d ossObjectsSyncConfig, key string) bool {
+	rel := strings.TrimPrefix(key, d.Get("key_prefix").(string))
+	if rel == "" || strings.HasSuffix(rel, "/") {
+		return false
+	}
+	include := expandStringList(d.Get("include_patterns").([]interface{}))
+	if len(include) > 0 && !ossObjectsSyncMatch(include, rel) {
+		return false
+	}
+	return !ossObjectsSyncMatch(expandStringList(d.Get("exclude_patterns").([]interface{})), rel)
+}
+
+// ossObjectsSyncMatch matches a slash separated relative path against path.Match patterns. A pattern
+// without a slash matches the base name of the path and a pattern ending with "/**" matches a directory.
+func ossObjectsSyncMatch(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "/**") && strings.HasPrefix(rel, strings.TrimSuffix(pattern, "**")) {
+			return true
+		}
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func ossObjectsSyncEtag(etag string) string {
+	return strings.ToLower(strings.Trim(etag, "\""))
+}
+
+func validateOssObjectsSyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(strings.TrimSuffix(v.(string), "/**"), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid pattern: %s", v.(string), err))
+	}
+	return
+}
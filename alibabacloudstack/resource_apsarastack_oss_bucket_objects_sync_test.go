@@ -0,0 +1,125 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackOssBucketObjectsSync_basic(t *testing.T) {
+	sourceDir, err := ioutil.TempDir("", "tf-oss-objects-sync-test-acc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(sourceDir)
+
+	files := map[string]string{
+		"index.html":       "<html><body>index</body></html>",
+		"css/site.css":     "body { margin: 0; }",
+		"js/app.js":        "console.log('app');",
+		"tmp/scratch.html": "<html></html>",
+	}
+	for name, content := range files {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resourceId := "alibabacloudstack_oss_bucket_objects_sync.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"bucket":            CHECKSET,
+		"key_prefix":        "site/",
+		"delete_extraneous": "false",
+		"acl":               "public-read",
+		"objects.%":         "3",
+	})
+	testAccCheck := ra.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-objects-sync-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketObjectsSyncConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAlicloudOssBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":           "${alibabacloudstack_oss_bucket.default.bucket}",
+					"key_prefix":       "site/",
+					"source_dir":       strings.Replace(sourceDir, "\\", "\\\\", -1),
+					"exclude_patterns": []string{"tmp/**"},
+					"acl":              "public-read",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("<html><body>changed</body></html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.Remove(filepath.Join(sourceDir, "js", "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccConfig(map[string]interface{}{
+					"delete_extraneous": "true",
+					"cache_control":     "max-age=300",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"delete_extraneous": "true",
+						"cache_control":     "max-age=300",
+						"objects.%":         "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceOssBucketObjectsSyncConfigDependence(name string) string {
+	return fmt.Sprintf(`
+resource "alibabacloudstack_oss_bucket" "default" {
+	bucket = "%s"
+	acl = "public-read"
+}
+`, name)
+}
+
+func TestOssObjectsSyncMatch(t *testing.T) {
+	cases := []struct {
+		patterns []string
+		rel      string
+		expected bool
+	}{
+		{[]string{"*.html"}, "index.html", true},
+		{[]string{"*.html"}, "docs/guide.html", true},
+		{[]string{"docs/*.html"}, "docs/guide.html", true},
+		{[]string{"docs/*.html"}, "docs/v1/guide.html", false},
+		{[]string{"tmp/**"}, "tmp/a/b.txt", true},
+		{[]string{"tmp/**"}, "tmpfile", false},
+		{[]string{"*.css", "*.js"}, "js/app.js", true},
+		{[]string{}, "index.html", false},
+	}
+	for _, c := range cases {
+		if got := ossObjectsSyncMatch(c.patterns, c.rel); got != c.expected {
+			t.Errorf("ossObjectsSyncMatch(%v, %q) = %v, expected %v", c.patterns, c.rel, got, c.expected)
+		}
+	}
+}
@@ -1,13 +1,16 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
+	"strconv"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -16,10 +19,13 @@ import (
 
 func resourceAlibabacloudStackOssBucketQuota() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketQuotaCreate,
-		Read:   resourceAlibabacloudStackOssBucketQuotaRead,
+		CreateContext: resourceAlibabacloudStackOssBucketQuotaCreate,
+		ReadContext:   resourceAlibabacloudStackOssBucketQuotaRead,
 		//Update: resourceAlibabacloudStackOssBucketQuotaCreate,
-		Delete: resourceAlibabacloudStackOssBucketQuotaDelete,
+		DeleteContext: resourceAlibabacloudStackOssBucketQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -36,48 +42,25 @@ func resourceAlibabacloudStackOssBucketQuota() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketQuotaCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk))
 	}
 	quota := d.Get("quota").(int)
 
 	if det.BucketInfo.Name == bucketName {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "SetBucketStorageCapacity",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":\"%s\",\"%s\":%d}", "BucketName", bucketName, "StorageCapacity", quota),
-			"Content":          fmt.Sprintf("%s%d%s", "<BucketUserQos><StorageCapacity>", quota, "</StorageCapacity></BucketUserQos>"),
-		}
-		request.Method = "POST"        // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "SetBucketStorageCapacity"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":%d}", "BucketName", bucketName, "StorageCapacity", quota)
+		request.QueryParams["Content"] = fmt.Sprintf("%s%d%s", "<BucketUserQos><StorageCapacity>", quota, "</StorageCapacity></BucketUserQos>")
 
 		raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 			return ossClient.ProcessCommonRequest(request)
@@ -86,9 +69,9 @@ func resourceAlibabacloudStackOssBucketQuotaCreate(d *schema.ResourceData, meta
 		log.Printf("Bresponse ossbucket before error")
 		if err != nil {
 			if ossNotFoundError(err) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, bucketName, "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, bucketName, "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk))
 		}
 		log.Printf("Bresponse ossbucket after error")
 		addDebug("SetBucketStorageCapacity", raw, requestInfo, request)
@@ -97,60 +80,42 @@ func resourceAlibabacloudStackOssBucketQuotaCreate(d *schema.ResourceData, meta
 		log.Printf("Bresponse ossbucket %s", bresponse)
 
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk))
 		}
 		//logging:= make(map[string]interface{})
 		log.Printf("Enter for logging")
 	}
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk))
 	}
 	// Assign the bucket name as the resource ID
 	d.SetId(bucketName)
 
-	return resourceAlibabacloudStackOssBucketKmsRead(d, meta)
+	return resourceAlibabacloudStackOssBucketKmsRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
-	bucketName := d.Get("bucket").(string)
+	bucketName := d.Id()
 	det, err := ossService.DescribeOssBucket(bucketName)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk))
 	}
+	if det.BucketInfo.Name != bucketName {
+		d.SetId("")
+		return nil
+	}
+	d.Set("bucket", bucketName)
 	if det.BucketInfo.Name == bucketName {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "GetBucketStorageCapacity",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", bucketName),
-		}
-		request.Method = "GET"         // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("GET", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "GetBucketStorageCapacity"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\"}", "BucketName", bucketName)
 
 		raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 			return ossClient.ProcessCommonRequest(request)
@@ -158,9 +123,9 @@ func resourceAlibabacloudStackOssBucketQuotaRead(d *schema.ResourceData, meta in
 		log.Printf("Response of GetBucketStorageCapacity: %s", raw)
 		if err != nil {
 			if ossNotFoundError(err) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, bucketName, "GetBucketStorageCapacity", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, bucketName, "GetBucketStorageCapacity", AlibabacloudStackOssGoSdk))
 		}
 		addDebug("GetBucketStorageCapacity", raw, requestInfo, request)
 		log.Printf("Bresponse ossbucket check")
@@ -168,61 +133,48 @@ func resourceAlibabacloudStackOssBucketQuotaRead(d *schema.ResourceData, meta in
 		log.Printf("Bresponse ossbucket %s", bresponse)
 
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "GetBucketStorageCapacity", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "GetBucketStorageCapacity", AlibabacloudStackOssGoSdk))
 		}
-		//logging:= make(map[string]interface{})
-		log.Printf("Enter for logging")
-		//d.Set("SSEAlgorithm",bresponse.GetHttpContentString())
+		var response map[string]interface{}
+		if err := json.Unmarshal(bresponse.GetHttpContentBytes(), &response); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		v, err := jsonpath.Get("$.Data.BucketUserQos.StorageCapacity", response)
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, FailedGetAttributeMsg, bucketName, "$.Data.BucketUserQos.StorageCapacity", response))
+		}
+		quota, err := strconv.Atoi(fmt.Sprint(v))
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		d.Set("quota", quota)
 	}
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketQuotaDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackOssBucketQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk))
 	}
 	//quota := d.Get("quota").(int)
 
 	if det.BucketInfo.Name == bucketName {
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
-			"Product":          "OneRouter",
-			"Department":       client.Department,
-			"ResourceGroup":    client.ResourceGroup,
-			"RegionId":         client.RegionId,
-			"Action":           "DoOpenApi",
-			"AccountInfo":      "123456",
-			"Version":          "2018-12-12",
-			"SignatureVersion": "1.0",
-			"OpenApiAction":    "SetBucketStorageCapacity",
-			"ProductName":      "oss",
-			"Params":           fmt.Sprintf("{\"%s\":\"%s\",\"%s\":%d}", "BucketName", bucketName, "StorageCapacity", -1),
-			"Content":          fmt.Sprintf("%s%d%s", "<BucketUserQos><StorageCapacity>", -1, "</StorageCapacity></BucketUserQos>"),
-		}
-		request.Method = "POST"        // Set request method
-		request.Product = "OneRouter"  // Specify product
-		request.Version = "2018-12-12" // Specify product version
-		request.ServiceCode = "OneRouter"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		} // Set request scheme. Default: http
-		request.ApiName = "DoOpenApi"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+		request.Domain = client.Domain
+		request.QueryParams["AccountInfo"] = "123456"
+		request.QueryParams["OpenApiAction"] = "SetBucketStorageCapacity"
+		request.QueryParams["ProductName"] = "oss"
+		request.QueryParams["Params"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":%d}", "BucketName", bucketName, "StorageCapacity", -1)
+		request.QueryParams["Content"] = fmt.Sprintf("%s%d%s", "<BucketUserQos><StorageCapacity>", -1, "</StorageCapacity></BucketUserQos>")
 
 		raw, err := client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 			return ossClient.ProcessCommonRequest(request)
@@ -231,9 +183,9 @@ func resourceAlibabacloudStackOssBucketQuotaDelete(d *schema.ResourceData, meta
 		log.Printf("Bresponse ossbucket before error")
 		if err != nil {
 			if ossNotFoundError(err) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+				return DiagnosticsFromError(WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk))
 			}
-			return WrapErrorf(err, DefaultErrorMsg, bucketName, "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, bucketName, "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk))
 		}
 		log.Printf("Bresponse ossbucket after error")
 		addDebug("SetBucketStorageCapacity", raw, requestInfo, request)
@@ -242,16 +194,16 @@ func resourceAlibabacloudStackOssBucketQuotaDelete(d *schema.ResourceData, meta
 		log.Printf("Bresponse ossbucket %s", bresponse)
 
 		if bresponse.GetHttpStatus() != 200 {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "SetBucketStorageCapacity", AlibabacloudStackOssGoSdk))
 		}
 		//logging:= make(map[string]interface{})
 		log.Printf("Enter for logging")
 	}
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk))
 	}
 	// Assign the bucket name as the resource ID
 	d.SetId(bucketName)
 
-	return resourceAlibabacloudStackOssBucketKmsRead(d, meta)
+	return resourceAlibabacloudStackOssBucketKmsRead(ctx, d, meta)
 }
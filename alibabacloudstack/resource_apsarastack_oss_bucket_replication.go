@@ -0,0 +1,209 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackOssBucketReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackOssBucketReplicationCreate,
+		ReadContext:   resourceAlibabacloudStackOssBucketReplicationRead,
+		DeleteContext: resourceAlibabacloudStackOssBucketReplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// OSS has no separate delete marker setting, the delete markers of versioned buckets are
+			// replicated together with the deletions when the action is ALL.
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ALL",
+				ValidateFunc: validation.StringInSlice([]string{"ALL", "PUT"}, false),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"historical_object_replication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "enabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackOssBucketReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	bucketName := d.Get("bucket").(string)
+	ruleId := d.Get("rule_id").(string)
+	if ruleId == "" {
+		ruleId = resource.UniqueId()
+	}
+	destination := d.Get("destination").([]interface{})[0].(map[string]interface{})
+	config := ossReplicationConfiguration{
+		Rule: ossReplicationRule{
+			ID:     ruleId,
+			Action: d.Get("action").(string),
+			Destination: ossReplicationDestination{
+				Bucket:   destination["bucket"].(string),
+				Location: destination["location"].(string),
+			},
+			HistoricalObjectReplication: d.Get("historical_object_replication").(string),
+		},
+	}
+	if v, ok := d.GetOk("prefixes"); ok {
+		config.Rule.PrefixSet = &ossReplicationPrefixSet{Prefix: expandStringList(v.([]interface{}))}
+	}
+	content, err := xml.Marshal(config)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	if _, err := ossService.doOssOneRouterRequest(bucketName, "PutBucketReplication", nil, string(content)); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket_replication", "PutBucketReplication", AlibabacloudStackOssGoSdk))
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", bucketName, COLON_SEPARATED, ruleId))
+
+	stateConf := BuildStateConf([]string{"starting"}, []string{"doing"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, ossService.OssBucketReplicationStateRefreshFunc(d.Id(), []string{"closing"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+
+	return resourceAlibabacloudStackOssBucketReplicationRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	object, err := ossService.DescribeOssBucketReplication(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	prefixes := make([]string, 0)
+	if prefixSet, ok := object["PrefixSet"].(map[string]interface{}); ok {
+		switch p := prefixSet["Prefix"].(type) {
+		case string:
+			prefixes = append(prefixes, p)
+		case []interface{}:
+			for _, prefix := range p {
+				prefixes = append(prefixes, fmt.Sprint(prefix))
+			}
+		}
+	}
+	destinations := make([]map[string]interface{}, 0)
+	for _, destination := range ossResponseElements(object["Destination"]) {
+		destinations = append(destinations, map[string]interface{}{
+			"bucket":   fmt.Sprint(destination["Bucket"]),
+			"location": fmt.Sprint(destination["Location"]),
+		})
+	}
+
+	d.Set("bucket", parts[0])
+	d.Set("rule_id", parts[1])
+	d.Set("prefixes", prefixes)
+	d.Set("action", fmt.Sprint(object["Action"]))
+	if err := d.Set("destination", destinations); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if v, ok := object["HistoricalObjectReplication"]; ok {
+		d.Set("historical_object_replication", fmt.Sprint(v))
+	}
+	d.Set("status", fmt.Sprint(object["Status"]))
+	return nil
+}
+
+func resourceAlibabacloudStackOssBucketReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	content, err := xml.Marshal(ossReplicationRules{ID: []string{parts[1]}})
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	if _, err := ossService.doOssOneRouterRequest(parts[0], "DeleteBucketReplication", nil, string(content)); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucketReplication", AlibabacloudStackOssGoSdk))
+	}
+
+	stateConf := BuildStateConf([]string{"starting", "doing", "closing"}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, ossService.OssBucketReplicationStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+	return nil
+}
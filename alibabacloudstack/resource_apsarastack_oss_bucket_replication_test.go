@@ -0,0 +1,80 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackOssBucketReplication_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_oss_bucket_replication.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackOssBucketReplicationMap)
+	serviceFunc := func() interface{} {
+		return &OssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-bucket-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackOssBucketReplicationBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":   "${alibabacloudstack_oss_bucket.source.bucket}",
+					"prefixes": []string{"audit/", "logs/"},
+					"destination": []map[string]interface{}{
+						{
+							"bucket":   "${alibabacloudstack_oss_bucket.destination.bucket}",
+							"location": "${alibabacloudstack_oss_bucket.destination.location}",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bucket":                 name + "-source",
+						"rule_id":                CHECKSET,
+						"prefixes.#":             "2",
+						"destination.#":          "1",
+						"destination.0.bucket":   name + "-destination",
+						"destination.0.location": CHECKSET,
+						"status":                 "doing",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var AlibabacloudStackOssBucketReplicationMap = map[string]string{
+	"action":                        "ALL",
+	"historical_object_replication": "enabled",
+}
+
+func AlibabacloudStackOssBucketReplicationBasicDependence(name string) string {
+	return fmt.Sprintf(`
+resource "alibabacloudstack_oss_bucket" "source" {
+	bucket = "%s-source"
+}
+
+resource "alibabacloudstack_oss_bucket" "destination" {
+	bucket = "%s-destination"
+}
+`, name, name)
+}
@@ -152,6 +152,36 @@ func TestAccAlibabacloudStackOssBucketBasic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: providerCommon + testAccConfig(map[string]interface{}{
+					"bucket":  name,
+					"vpclist": []string{"${alibabacloudstack_vpc.vpc.id}"},
+					"tags": map[string]string{
+						"Created": "TF",
+						"For":     "Test",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":       "2",
+						"tags.Created": "TF",
+						"tags.For":     "Test",
+					}),
+				),
+			},
+			{
+				Config: providerCommon + testAccConfig(map[string]interface{}{
+					"bucket":  name,
+					"vpclist": []string{"${alibabacloudstack_vpc.vpc.id}"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":       "0",
+						"tags.Created": REMOVEKEY,
+						"tags.For":     REMOVEKEY,
+					}),
+				),
+			},
 			//暂不支持修改acl
 			//			{
 			//				Config: testAccConfig(map[string]interface{}{
@@ -167,6 +197,135 @@ func TestAccAlibabacloudStackOssBucketBasic(t *testing.T) {
 	})
 }
 
+func TestAccAlibabacloudStackOssBucketLifecycle(t *testing.T) {
+	var v oss.GetBucketInfoResult
+
+	resourceId := "alibabacloudstack_oss_bucket.default"
+	ra := resourceAttrInit(resourceId, ossBucketBasicMap)
+
+	serviceFunc := func() interface{} {
+		return &OssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-bucket-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketConfigDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		// module name
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckOssBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerCommon + testAccConfig(map[string]interface{}{
+					"bucket": name,
+					"lifecycle_rule": []map[string]interface{}{
+						{
+							"id":      "logs",
+							"prefix":  "logs/",
+							"enabled": "true",
+							"expiration": []map[string]interface{}{
+								{
+									"days": "365",
+								},
+							},
+							"transitions": []map[string]interface{}{
+								{
+									"days":          "30",
+									"storage_class": "IA",
+								},
+								{
+									"days":          "180",
+									"storage_class": "Archive",
+								},
+							},
+							"abort_multipart_upload": []map[string]interface{}{
+								{
+									"days": "7",
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bucket":                                           name,
+						"lifecycle_rule.#":                                 "1",
+						"lifecycle_rule.0.id":                              "logs",
+						"lifecycle_rule.0.prefix":                          "logs/",
+						"lifecycle_rule.0.enabled":                         "true",
+						"lifecycle_rule.0.expiration.#":                    "1",
+						"lifecycle_rule.0.transitions.#":                   "2",
+						"lifecycle_rule.0.abort_multipart_upload.#":        "1",
+						"lifecycle_rule.0.noncurrent_version_expiration.#": "0",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: providerCommon + testAccConfig(map[string]interface{}{
+					"bucket": name,
+					"lifecycle_rule": []map[string]interface{}{
+						{
+							"id":      "logs",
+							"prefix":  "logs/",
+							"enabled": "true",
+							"expiration": []map[string]interface{}{
+								{
+									"date": "2030-01-01",
+								},
+							},
+							"noncurrent_version_expiration": []map[string]interface{}{
+								{
+									"days": "90",
+								},
+							},
+							"noncurrent_version_transition": []map[string]interface{}{
+								{
+									"days":          "30",
+									"storage_class": "IA",
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"lifecycle_rule.#":                                 "1",
+						"lifecycle_rule.0.expiration.#":                    "1",
+						"lifecycle_rule.0.transitions.#":                   "0",
+						"lifecycle_rule.0.abort_multipart_upload.#":        "0",
+						"lifecycle_rule.0.noncurrent_version_expiration.#": "1",
+						"lifecycle_rule.0.noncurrent_version_transition.#": "1",
+					}),
+				),
+			},
+			{
+				Config: providerCommon + testAccConfig(map[string]interface{}{
+					"bucket": name,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"lifecycle_rule.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOssBucketDestroy(s *terraform.State) error { //destroy function
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
@@ -0,0 +1,182 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackOssBucketWorm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackOssBucketWormCreate,
+		ReadContext:   resourceAlibabacloudStackOssBucketWormRead,
+		UpdateContext: resourceAlibabacloudStackOssBucketWormUpdate,
+		DeleteContext: resourceAlibabacloudStackOssBucketWormDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"retention_period_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 25550),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "InProgress",
+				ValidateFunc: validation.StringInSlice([]string{"InProgress", "Locked"}, false),
+			},
+			"worm_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackOssBucketWormCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	bucketName := d.Get("bucket").(string)
+	if err := ossBucketWormInitiate(ossService, bucketName, d.Get("retention_period_in_days").(int)); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket_worm", "InitiateBucketWorm", AlibabacloudStackOssGoSdk))
+	}
+	d.SetId(bucketName)
+
+	if d.Get("status").(string) == "Locked" {
+		object, err := ossService.DescribeOssBucketWorm(d.Id())
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		if err := ossBucketWormComplete(ossService, d.Id(), fmt.Sprint(object["WormId"])); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "CompleteBucketWorm", AlibabacloudStackOssGoSdk))
+		}
+	}
+
+	return resourceAlibabacloudStackOssBucketWormRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketWormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	object, err := ossService.DescribeOssBucketWorm(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("retention_period_in_days", ossIntValue(object["RetentionPeriodInDays"]))
+	d.Set("status", fmt.Sprint(object["State"]))
+	d.Set("worm_id", fmt.Sprint(object["WormId"]))
+	d.Set("creation_date", fmt.Sprint(object["CreationDate"]))
+	return nil
+}
+
+func resourceAlibabacloudStackOssBucketWormUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	oldStatus, newStatus := d.GetChange("status")
+	if oldStatus.(string) == "Locked" && newStatus.(string) != "Locked" {
+		return DiagnosticsFromError(WrapError(Error("The WORM configuration of bucket %s is locked and can not be unlocked.", d.Id())))
+	}
+
+	if d.HasChange("retention_period_in_days") {
+		oldDays, newDays := d.GetChange("retention_period_in_days")
+		if oldStatus.(string) == "Locked" {
+			// A locked retention period can only be extended.
+			if newDays.(int) < oldDays.(int) {
+				return DiagnosticsFromError(WrapError(Error("The retention period of the locked WORM configuration of bucket %s can not be shortened from %d to %d days.", d.Id(), oldDays.(int), newDays.(int))))
+			}
+			content, err := xml.Marshal(ossExtendWormConfiguration{RetentionPeriodInDays: newDays.(int)})
+			if err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+			if _, err := ossService.doOssOneRouterRequest(d.Id(), "ExtendBucketWorm", map[string]interface{}{"WormId": d.Get("worm_id").(string)}, string(content)); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "ExtendBucketWorm", AlibabacloudStackOssGoSdk))
+			}
+		} else {
+			// An unlocked configuration can not be extended, so it is replaced.
+			if _, err := ossService.doOssOneRouterRequest(d.Id(), "AbortBucketWorm", nil, ""); err != nil && !NotFoundError(err) {
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "AbortBucketWorm", AlibabacloudStackOssGoSdk))
+			}
+			if err := ossBucketWormInitiate(ossService, d.Id(), newDays.(int)); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "InitiateBucketWorm", AlibabacloudStackOssGoSdk))
+			}
+		}
+	}
+
+	if d.HasChange("status") && newStatus.(string) == "Locked" {
+		object, err := ossService.DescribeOssBucketWorm(d.Id())
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		if err := ossBucketWormComplete(ossService, d.Id(), fmt.Sprint(object["WormId"])); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "CompleteBucketWorm", AlibabacloudStackOssGoSdk))
+		}
+	}
+
+	return resourceAlibabacloudStackOssBucketWormRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketWormDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ossService := OssService{client}
+
+	object, err := ossService.DescribeOssBucketWorm(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if fmt.Sprint(object["State"]) == "Locked" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The WORM configuration of bucket %s is locked and can not be deleted. Terraform will remove this resource from the state file, however the configuration remains on the bucket.", d.Id()),
+		}}
+	}
+
+	if _, err := ossService.doOssOneRouterRequest(d.Id(), "AbortBucketWorm", nil, ""); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "AbortBucketWorm", AlibabacloudStackOssGoSdk))
+	}
+	return nil
+}
+
+func ossBucketWormInitiate(ossService OssService, bucketName string, days int) error {
+	content, err := xml.Marshal(ossInitiateWormConfiguration{RetentionPeriodInDays: days})
+	if err != nil {
+		return WrapError(err)
+	}
+	_, err = ossService.doOssOneRouterRequest(bucketName, "InitiateBucketWorm", nil, string(content))
+	return err
+}
+
+func ossBucketWormComplete(ossService OssService, bucketName, wormId string) error {
+	_, err := ossService.doOssOneRouterRequest(bucketName, "CompleteBucketWorm", map[string]interface{}{"WormId": wormId}, "")
+	return err
+}
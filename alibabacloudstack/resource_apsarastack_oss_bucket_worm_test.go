@@ -0,0 +1,78 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The WORM configuration is not locked here, a locked bucket could not be removed after the test.
+func TestAccAlibabacloudStackOssBucketWorm_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_oss_bucket_worm.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackOssBucketWormMap)
+	serviceFunc := func() interface{} {
+		return &OssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-bucket-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackOssBucketWormBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":                   "${alibabacloudstack_oss_bucket.default.bucket}",
+					"retention_period_in_days": "1",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bucket":                   name,
+						"retention_period_in_days": "1",
+						"worm_id":                  CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":                   "${alibabacloudstack_oss_bucket.default.bucket}",
+					"retention_period_in_days": "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"retention_period_in_days": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var AlibabacloudStackOssBucketWormMap = map[string]string{
+	"status":        "InProgress",
+	"creation_date": CHECKSET,
+}
+
+func AlibabacloudStackOssBucketWormBasicDependence(name string) string {
+	return fmt.Sprintf(`
+resource "alibabacloudstack_oss_bucket" "default" {
+	bucket = "%s"
+}
+`, name)
+}
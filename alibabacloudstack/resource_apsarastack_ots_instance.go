@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
@@ -13,14 +15,20 @@ import (
 
 func resourceAlibabacloudStackOtsInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsInstanceCreate,
-		Read:   resourceAliyunOtsInstanceRead,
-		Update: resourceAliyunOtsInstanceUpdate,
-		Delete: resourceAliyunOtsInstanceDelete,
+		CreateContext: resourceAliyunOtsInstanceCreate,
+		ReadContext:   resourceAliyunOtsInstanceRead,
+		UpdateContext: resourceAliyunOtsInstanceUpdate,
+		DeleteContext: resourceAliyunOtsInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -60,8 +68,8 @@ func resourceAlibabacloudStackOtsInstance() *schema.Resource {
 	}
 }
 
-func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunOtsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 
 	instanceType := d.Get("instance_type").(string)
@@ -70,11 +78,11 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 	request.ClusterType = convertInstanceType(OtsInstanceType(instanceType))
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	types, err := otsService.DescribeOtsInstanceTypes()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	valid := false
 	for _, t := range types {
@@ -84,7 +92,7 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 	if !valid {
-		return WrapError(Error("The instance type %s is not available in the region %s.", instanceType, client.RegionId))
+		return DiagnosticsFromError(WrapError(Error("The instance type %s is not available in the region %s.", instanceType, client.RegionId)))
 	}
 
 	request.InstanceName = d.Get("name").(string)
@@ -95,19 +103,19 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 		return otsClient.InsertInstance(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	d.SetId(request.InstanceName)
-	if err := otsService.WaitForOtsInstance(request.InstanceName, Running, DefaultTimeout/3); err != nil {
-		return WrapError(err)
+	if err := otsService.WaitForOtsInstance(request.InstanceName, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAliyunOtsInstanceUpdate(d, meta)
+	return resourceAliyunOtsInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunOtsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	object, err := otsService.DescribeOtsInstance(d.Id())
 	if err != nil {
@@ -115,7 +123,7 @@ func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.InstanceName)
@@ -126,8 +134,8 @@ func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunOtsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 
 	d.Partial(true)
@@ -137,14 +145,14 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceName = d.Id()
 		request.Network = convertInstanceAccessedBy(InstanceAccessedByType(d.Get("accessed_by").(string)))
 		raw, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 			return otsClient.UpdateInstance(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("accessed_by")
@@ -172,7 +180,7 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 				return otsClient.DeleteTags(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		}
@@ -182,7 +190,7 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 			request.RegionId = client.RegionId
 			request.Headers = map[string]string{"RegionId": client.RegionId}
 
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.InstanceName = d.Id()
 			var tags []ots.InsertTagsTagInfo
 			for _, t := range create {
@@ -196,30 +204,30 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 				return otsClient.InsertTags(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		}
 		//d.SetPartial("tags")
 	}
-	if err := otsService.WaitForOtsInstance(d.Id(), Running, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := otsService.WaitForOtsInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Partial(false)
-	return resourceAliyunOtsInstanceRead(d, meta)
+	return resourceAliyunOtsInstanceRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunOtsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	request := ots.CreateDeleteInstanceRequest()
 	request.RegionId = client.RegionId
 	//request.Headers["x-ascm-product-name"] = "Ots"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceName = d.Id()
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 			return otsClient.DeleteInstance(request)
 		})
@@ -236,7 +244,7 @@ func resourceAliyunOtsInstanceDelete(d *schema.ResourceData, meta interface{}) e
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(otsService.WaitForOtsInstance(d.Id(), Deleted, DefaultLongTimeout))
+	return DiagnosticsFromError(WrapError(otsService.WaitForOtsInstance(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
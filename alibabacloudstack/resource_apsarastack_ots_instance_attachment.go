@@ -1,16 +1,28 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackOtsInstanceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsInstanceAttachmentCreate,
-		Read:   resourceAliyunOtsInstanceAttachmentRead,
-		Delete: resourceAliyunOtsInstanceAttachmentDelete,
+		CreateContext: resourceAliyunOtsInstanceAttachmentCreate,
+		ReadContext:   resourceAliyunOtsInstanceAttachmentRead,
+		DeleteContext: resourceAliyunOtsInstanceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunOtsInstanceAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -37,8 +49,8 @@ func resourceAlibabacloudStackOtsInstanceAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunOtsInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := ots.CreateBindInstance2VpcRequest()
@@ -48,9 +60,9 @@ func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta inte
 	request.VirtualSwitchId = d.Get("vswitch_id").(string)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if vsw, err := vpcService.DescribeVSwitch(d.Get("vswitch_id").(string)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		request.VpcId = vsw.VpcId
 	}
@@ -59,16 +71,16 @@ func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta inte
 		return otsClient.BindInstance2Vpc(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ots_instance_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ots_instance_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	d.SetId(request.InstanceName)
-	return resourceAliyunOtsInstanceAttachmentRead(d, meta)
+	return resourceAliyunOtsInstanceAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunOtsInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	object, err := otsService.DescribeOtsInstanceAttachment(d.Id())
 	if err != nil {
@@ -76,24 +88,34 @@ func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	// There is a bug that inst does not contain instance name and vswitch ID, so this resource does not support import function.
-	//d.Set("instance_name", inst.InstanceName)
+	// The vpc info does not contain the vswitch ID, which is set from the import id.
+	d.Set("instance_name", d.Id())
 	d.Set("vpc_name", object.InstanceVpcName)
 	d.Set("vpc_id", object.VpcId)
 	return nil
 }
 
-func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunOtsInstanceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(Error("Invalid import id %s. Expected <instance_name>:<vswitch_id>, as the vswitch of an attachment can not be queried.", d.Id()))
+	}
+	d.SetId(parts[0])
+	d.Set("vswitch_id", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAliyunOtsInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	object, err := otsService.DescribeOtsInstanceAttachment(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := ots.CreateUnbindInstance2VpcRequest()
 	request.RegionId = client.RegionId
@@ -101,7 +123,7 @@ func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta inte
 	request.InstanceVpcName = object.InstanceVpcName
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	raw, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.UnbindInstance2Vpc(request)
 	})
@@ -109,8 +131,8 @@ func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta inte
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(otsService.WaitForOtsInstanceVpc(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(otsService.WaitForOtsInstanceVpc(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -51,6 +51,12 @@ func TestAccAlibabacloudStackOtsInstanceAttachmentBasic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: resourceImportStateIdFunc(resourceId, "id", "vswitch_id"),
+			},
 		},
 	})
 }
@@ -166,3 +172,9 @@ var otsInstanceAttachmentBasicMap = map[string]string{
 	"vswitch_id":    CHECKSET,
 	"vpc_id":        CHECKSET,
 }
+
+func TestResourceAlibabacloudStackOtsInstanceAttachmentImport(t *testing.T) {
+	testResourceImportState(t, resourceAlibabacloudStackOtsInstanceAttachment(), "tf-ots:vsw-123",
+		"tf-ots", map[string]interface{}{"vswitch_id": "vsw-123"})
+	testResourceImportState(t, resourceAlibabacloudStackOtsInstanceAttachment(), "malformed", "", nil)
+}
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"strconv"
@@ -19,14 +21,20 @@ import (
 
 func resourceAlibabacloudStackOtsTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsTableCreate,
-		Read:   resourceAliyunOtsTableRead,
-		Update: resourceAliyunOtsTableUpdate,
-		Delete: resourceAliyunOtsTableDelete,
+		CreateContext: resourceAliyunOtsTableCreate,
+		ReadContext:   resourceAliyunOtsTableRead,
+		UpdateContext: resourceAliyunOtsTableUpdate,
+		DeleteContext: resourceAliyunOtsTableDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
 				Type:     schema.TypeString,
@@ -81,14 +89,14 @@ func resourceAlibabacloudStackOtsTable() *schema.Resource {
 	}
 }
 
-func resourceAliyunOtsTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tableMeta := new(tablestore.TableMeta)
 	instanceName := d.Get("instance_name").(string)
 	tableName := d.Get("table_name").(string)
 	tableMeta.TableName = tableName
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
-	if err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, e := otsService.DescribeOtsInstance(instanceName)
 		if e != nil {
 			if NotFoundError(e) {
@@ -98,7 +106,7 @@ func resourceAliyunOtsTableCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 		return nil
 	}); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	for _, primaryKey := range d.Get("primary_key").([]interface{}) {
 		pk := primaryKey.(map[string]interface{})
@@ -118,7 +126,7 @@ func resourceAliyunOtsTableCreate(d *schema.ResourceData, meta interface{}) erro
 	request.TableOption = tableOption
 	request.ReservedThroughput = reservedThroughput
 	// var requestinfo *tablestore.TableStoreClient
-	if err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			// requestinfo = tableStoreClient
 			return tableStoreClient.CreateTable(request)
@@ -133,20 +141,20 @@ func resourceAliyunOtsTableCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ots_table", "CreateTable", AliyunTablestoreGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ots_table", "CreateTable", AliyunTablestoreGoSdk))
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", instanceName, COLON_SEPARATED, tableName))
-	return resourceAliyunOtsTableRead(d, meta)
+	return resourceAliyunOtsTableRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceName, _, err := parseId(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	object, err := otsService.DescribeOtsTable(d.Id())
 
@@ -155,7 +163,7 @@ func resourceAliyunOtsTableRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("instance_name", instanceName)
@@ -177,15 +185,15 @@ func resourceAliyunOtsTableRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAliyunOtsTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// As the issue of ots sdk, time_to_live and max_version need to be updated together at present.
 	// For the issue, please refer to https://github.com/aliyun/aliyun-tablestore-go-sdk/issues/18
 	if d.HasChange("time_to_live") || d.HasChange("max_version") || d.HasChange("deviation_cell_version_in_sec") {
 		instanceName, tableName, err := parseId(d, meta)
 		if err != nil {
-			return err
+			return DiagnosticsFromError(err)
 		}
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 		request := new(tablestore.UpdateTableRequest)
 		request.TableName = tableName
@@ -199,7 +207,7 @@ func resourceAliyunOtsTableUpdate(d *schema.ResourceData, meta interface{}) erro
 
 		request.TableOption = tableOption
 		var requestinfo *tablestore.TableStoreClient
-		if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 				requestinfo = tableStoreClient
 				return tableStoreClient.UpdateTable(request)
@@ -213,24 +221,24 @@ func resourceAliyunOtsTableUpdate(d *schema.ResourceData, meta interface{}) erro
 			addDebug("UpdateTable", raw, requestinfo, request)
 			return nil
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateTable", AliyunTablestoreGoSdk)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateTable", AliyunTablestoreGoSdk))
 		}
 	}
-	return resourceAliyunOtsTableRead(d, meta)
+	return resourceAliyunOtsTableRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceName, tableName, err := parseId(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	otsService := OtsService{client}
 	req := new(tablestore.DeleteTableRequest)
 	req.TableName = tableName
 	var requestinfo *tablestore.TableStoreClient
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestinfo = tableStoreClient
 			return tableStoreClient.DeleteTable(req)
@@ -248,9 +256,9 @@ func resourceAliyunOtsTableDelete(d *schema.ResourceData, meta interface{}) erro
 		if strings.HasPrefix(err.Error(), "OTSObjectNotExist") {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTable", AliyunTablestoreGoSdk)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTable", AliyunTablestoreGoSdk))
 	}
-	return WrapError(otsService.WaitForOtsTable(instanceName, tableName, Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(otsService.WaitForOtsTable(instanceName, tableName, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func parseId(d *schema.ResourceData, meta interface{}) (instanceName, tableName string, err error) {
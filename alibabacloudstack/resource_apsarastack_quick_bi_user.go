@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackQuickBiUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiUserCreate,
-		Read:   resourceAlibabacloudStackQuickBiUserRead,
-		Update: resourceAlibabacloudStackQuickBiUserUpdate,
-		Delete: resourceAlibabacloudStackQuickBiUserDelete,
+		CreateContext: resourceAlibabacloudStackQuickBiUserCreate,
+		ReadContext:   resourceAlibabacloudStackQuickBiUserRead,
+		UpdateContext: resourceAlibabacloudStackQuickBiUserUpdate,
+		DeleteContext: resourceAlibabacloudStackQuickBiUserDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -62,14 +64,14 @@ func resourceAlibabacloudStackQuickBiUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "AddUser"
 	request := make(map[string]interface{})
 	conn, err := client.NewQuickbiClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("account_id"); ok {
 		request["AccountId"] = v
@@ -80,7 +82,7 @@ func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta int
 	request["NickName"] = d.Get("nick_name")
 	request["UserType"] = convertQuickBiUserUserTypeRequest(d.Get("user_type").(string))
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -94,15 +96,15 @@ func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta int
 
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_quick_bi_user", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_quick_bi_user", action, AlibabacloudStackSdkGoERROR))
 	}
 	responseResult := response["Result"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseResult["UserId"]))
 
-	return resourceAlibabacloudStackQuickBiUserRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	quickbiPublicService := QuickbiPublicService{client}
 	object, err := quickbiPublicService.DescribeQuickBiUser(d.Id())
 	if err != nil {
@@ -111,7 +113,7 @@ func resourceAlibabacloudStackQuickBiUserRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("account_id", object["AccountId"])
@@ -122,8 +124,8 @@ func resourceAlibabacloudStackQuickBiUserRead(d *schema.ResourceData, meta inter
 	d.Set("user_type", convertQuickBiUserUserTypeResponse(formatInt(object["UserType"])))
 	return nil
 }
-func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	update := false
 	request := map[string]interface{}{
@@ -147,10 +149,10 @@ func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta int
 		action := "UpdateUser"
 		conn, err := client.NewQuickbiClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -163,25 +165,25 @@ func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta int
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return resourceAlibabacloudStackQuickBiUserRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteUser"
 	var response map[string]interface{}
 	conn, err := client.NewQuickbiClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"UserId": d.Id(),
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -197,7 +199,7 @@ func resourceAlibabacloudStackQuickBiUserDelete(d *schema.ResourceData, meta int
 		if IsExpectedErrors(err, []string{"User.Not.In.Organization"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,21 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackQuickBiUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiUserGroupCreate,
-		Read:   resourceAlibabacloudStackQuickBiUserGroupRead,
-		Update: resourceAlibabacloudStackQuickBiUserGroupUpdate,
-		Delete: resourceAlibabacloudStackQuickBiUserGroupDelete,
+		CreateContext: resourceAlibabacloudStackQuickBiUserGroupCreate,
+		ReadContext:   resourceAlibabacloudStackQuickBiUserGroupRead,
+		UpdateContext: resourceAlibabacloudStackQuickBiUserGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackQuickBiUserGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -47,14 +49,14 @@ var UserGroupDescription string
 var ParentUserGroupId string
 var UserGroupId string
 
-func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateUserGroup"
 	request := make(map[string]interface{})
 	conn, err := client.NewQuickbiClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("user_group_id"); ok {
 		request["UserGroupId"] = v
@@ -69,7 +71,7 @@ func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, met
 	request["ParentUserGroupId"] = ParentUserGroupId
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -83,15 +85,15 @@ func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, met
 
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_quick_bi_user", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_quick_bi_user", action, AlibabacloudStackSdkGoERROR))
 	}
 	responseResult := response["Result"].(string)
 	UserGroupId = responseResult
 	d.SetId(responseResult)
 
-	return resourceAlibabacloudStackQuickBiUserGroupRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Set("user_group_id", UserGroupId)
 	d.Set("user_group_name", UserGroupName)
@@ -100,8 +102,8 @@ func resourceAlibabacloudStackQuickBiUserGroupRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	update := false
 	request := map[string]interface{}{
@@ -122,10 +124,10 @@ func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, met
 		ParentUserGroupId = d.Get("parent_user_group_id").(string)
 		conn, err := client.NewQuickbiClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -138,26 +140,26 @@ func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, met
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 	}
 
-	return resourceAlibabacloudStackQuickBiUserGroupRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteUserGroup"
 	var response map[string]interface{}
 	conn, err := client.NewQuickbiClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"UserGroupId": d.Id(),
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -173,7 +175,7 @@ func resourceAlibabacloudStackQuickBiUserGroupDelete(d *schema.ResourceData, met
 		if IsExpectedErrors(err, []string{"User.Not.In.Organization"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,25 +1,26 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackQuickBiWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiWorkspaceCreate,
-		Read:   resourceAlibabacloudStackQuickBiWorkspaceRead,
-		Update: resourceAlibabacloudStackQuickBiWorkspaceUpdate,
-		Delete: resourceAlibabacloudStackQuickBiWorkspaceDelete,
+		CreateContext: resourceAlibabacloudStackQuickBiWorkspaceCreate,
+		ReadContext:   resourceAlibabacloudStackQuickBiWorkspaceRead,
+		UpdateContext: resourceAlibabacloudStackQuickBiWorkspaceUpdate,
+		DeleteContext: resourceAlibabacloudStackQuickBiWorkspaceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -59,24 +60,12 @@ var UseComment bool
 var AllowShare bool
 var AllowPublish bool
 
-func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateWorkSpace"
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "quickbi-public"
-	request.Domain = client.Domain
-	request.Version = "2022-03-01"
-	request.ApiName = "CreateWorkSpace"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"RegionId": client.RegionId,
-		"Product":  "quickbi-public",
-		"Version":  "2022-03-01",
-		"Action":   action,
-	}
+	request := client.NewCommonRequest("POST", "quickbi-public", "2022-03-01", "CreateWorkSpace", "")
+	request.QueryParams["Action"] = action
 	WorkspaceName = d.Get("workspace_name").(string)
 	WorkspaceDesc = d.Get("workspace_desc").(string)
 	UseComment = d.Get("use_comment").(bool)
@@ -99,7 +88,7 @@ func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, met
 	}
 	popClient.Domain = endpoint
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := popClient.ProcessCommonRequest(request)
 		addDebug(action, raw, request, request.QueryParams)
 		if err != nil {
@@ -115,14 +104,14 @@ func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, met
 
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_quick_bi_Workspace", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_quick_bi_Workspace", action, AlibabacloudStackSdkGoERROR))
 	}
 	WorkspaceId = response["Result"].(string)
 	d.SetId(fmt.Sprint(WorkspaceId))
 
-	return resourceAlibabacloudStackQuickBiWorkspaceRead(d, meta)
+	return resourceAlibabacloudStackQuickBiWorkspaceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Set("workspace_id", WorkspaceId)
 	d.Set("workspace_name", WorkspaceName)
@@ -133,23 +122,23 @@ func resourceAlibabacloudStackQuickBiWorkspaceRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackQuickBiWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackQuickBiWorkspaceRead(d, meta)
+func resourceAlibabacloudStackQuickBiWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceAlibabacloudStackQuickBiWorkspaceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackQuickBiWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteWorkSpace"
 	var response map[string]interface{}
 	conn, err := client.NewQuickbiClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"WorkspaceId": d.Id(),
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -165,7 +154,7 @@ func resourceAlibabacloudStackQuickBiWorkspaceDelete(d *schema.ResourceData, met
 		if IsExpectedErrors(err, []string{"Workspace.Not.In.Organization"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
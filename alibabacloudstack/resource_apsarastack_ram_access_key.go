@@ -1,33 +1,35 @@
 package alibabacloudstack
 
 import (
-	"encoding/json"
-	"fmt"
-	"strings"
+	"context"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
+func resourceAlibabacloudStackRamAccessKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackAscmAccessKeyCreate,
-		Read:   resourceAlibabacloudstackAscmAccessKeyRead,
-		Update: resourceAlibabacloudstackAscmAccessKeyUpdate,
-		Delete: resourceAlibabacloudstackAscmAccessKeyDelete,
+		CreateContext: resourceAlibabacloudStackRamAccessKeyCreate,
+		ReadContext:   resourceAlibabacloudStackRamAccessKeyRead,
+		UpdateContext: resourceAlibabacloudStackRamAccessKeyUpdate,
+		DeleteContext: resourceAlibabacloudStackRamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlibabacloudStackRamAccessKeyImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
 			"secret_file": {
 				Type:     schema.TypeString,
@@ -37,7 +39,7 @@ func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
 			"status": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      Active,
+				Default:      string(Active),
 				ValidateFunc: validation.StringInSlice([]string{"Active", "Inactive"}, false),
 			},
 			"secret": {
@@ -47,8 +49,8 @@ func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
 			},
 			"pgp_key": {
 				Type:     schema.TypeString,
-				ForceNew: true,
 				Optional: true,
+				ForceNew: true,
 			},
 			"key_fingerprint": {
 				Type:     schema.TypeString,
@@ -62,199 +64,113 @@ func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackAscmAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	var requestInfo *ecs.Client
-	//  userName:=d.Get("user_name")
+func resourceAlibabacloudStackRamAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2015-05-01"
-	request.ServiceCode = "ascm"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
+	request := ram.CreateCreateAccessKeyRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	if v, ok := d.GetOk("user_name"); ok {
+		request.UserName = v.(string)
 	}
-	request.ApiName = "RamCreateAccessKey"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "RamCreateAccessKey",
-		"Version":         "2015-05-01",
-		"ProductName":     "ascm",
-		//"": userName,
-	}
-	var response = AccessKeyInCreateAccessKey{}
-	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-		return ecsClient.ProcessCommonRequest(request)
-	})
 
+	raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.CreateAccessKey(request)
+	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_access_key", "", raw)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ram_access_key", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ram.CreateAccessKeyResponse)
 
-	addDebug("RamCreateAccessKey", raw, requestInfo, request)
-
-	bresponse, _ := raw.(*responses.CommonResponse)
-	if bresponse.GetHttpStatus() != 200 {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ascm_access_key", "", AlibabacloudStackSdkGoERROR)
+	if output, ok := d.GetOk("secret_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), response.AccessKey); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
 	}
-	addDebug("RamCreateAccessKey", raw, requestInfo, bresponse.GetHttpContentString())
-	_ = json.Unmarshal(bresponse.GetHttpContentBytes(), &response)
+	d.SetId(response.AccessKey.AccessKeyId)
 
-	d.SetId(fmt.Sprint(response.AccessKeyId))
+	// With a pgp_key the secret is only kept encrypted in the state.
+	if v, ok := d.GetOk("pgp_key"); ok {
+		fingerprint, encrypted, err := encryptWithPgpKey(v.(string), response.AccessKey.AccessKeySecret)
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		d.Set("key_fingerprint", fingerprint)
+		d.Set("encrypted_secret", encrypted)
+	} else {
+		d.Set("secret", response.AccessKey.AccessKeySecret)
+	}
 
-	return resourceAlibabacloudstackAscmAccessKeyUpdate(d, meta)
+	return resourceAlibabacloudStackRamAccessKeyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackAscmAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudstackAscmAccessKeyRead(d, meta)
+func resourceAlibabacloudStackRamAccessKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	// the access keys are created active, so only an inactive one needs to be updated on create.
+	if d.HasChange("status") && !(d.IsNewResource() && d.Get("status").(string) == string(Active)) {
+		request := ram.CreateUpdateAccessKeyRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.UserAccessKeyId = d.Id()
+		request.Status = d.Get("status").(string)
+		if v, ok := d.GetOk("user_name"); ok {
+			request.UserName = v.(string)
+		}
+
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.UpdateAccessKey(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	return resourceAlibabacloudStackRamAccessKeyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackAscmAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
-	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
-	object, err := ascmService.DescribeAscmKeypolicy(d.Id())
+func resourceAlibabacloudStackRamAccessKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	object, err := ramService.DescribeRamAccessKey(d.Id(), d.Get("user_name").(string))
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	d.Set("status", object) //add read data from the struct
+	d.Set("status", object.Status)
 	return nil
 }
 
-func resourceAlibabacloudstackAscmAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
-	var requestInfo *ecs.Client
-	check, err := ascmService.DescribeAscmKeypolicy(d.Id())
-
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
-	}
-	addDebug("IsKeyExist", check, requestInfo, map[string]string{"resourceGroupName": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-
-		request := requests.NewCommonRequest()
-		if client.Config.Insecure {
-			request.SetHTTPSInsecure(client.Config.Insecure)
-		}
-		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RamDeleteAccessKey",
-			"Version":         "2015-05-01",
-			"ProductName":     "ascm",
-			"id":              d.Id(),
-		}
+// resourceAlibabacloudStackRamAccessKeyImport refuses the import, as the secret of an access key is only
+// returned when it is created and the state could never hold it.
+func resourceAlibabacloudStackRamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return nil, WrapError(Error("The access key %s can not be imported, as its secret is only returned when it is created. "+
+		"Create a new access key with Terraform and delete %s once it is no longer used.", d.Id(), d.Id()))
+}
 
-		request.Method = "POST"
-		request.Product = "ascm"
-		request.Version = "2019-05-10"
-		request.ServiceCode = "ascm"
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			request.Scheme = "https"
-		} else {
-			request.Scheme = "http"
-		}
-		request.ApiName = "RamDeleteAccessKey"
-		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.RegionId = client.RegionId
+func resourceAlibabacloudStackRamAccessKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+	userName := d.Get("user_name").(string)
 
-		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
-			return csClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			return resource.RetryableError(err)
-		}
-		_, err = ascmService.DescribeAscmKeypolicy(d.Id())
+	request := ram.CreateDeleteAccessKeyRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.UserAccessKeyId = d.Id()
+	request.UserName = userName
 
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.DeleteAccessKey(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ram_access_key", "RamDeleteAccessKey", AlibabacloudStackSdkGoERROR)
-	}
-	return nil
-
-}
-
-func (s *AscmService) DescribeAscmKeypolicy(id string) (response *AccessKeyInCreateAccessKey, err error) {
-	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "RamListAccessKeys",
-		"Version":         "2015-05-01",
-		"id":              id,
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2015-05-01"
-	request.ServiceCode = "ascm"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "RamListAccessKeys"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
-	request.Domain = s.client.Domain
-	var value = &AccessKeyInCreateAccessKey{}
-	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-		return ecsClient.ProcessCommonRequest(request)
-	})
-
-	if err != nil {
-		if IsExpectedErrors(err, []string{"ErrorQuotaNotFound"}) {
-			return value, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		if IsExpectedErrors(err, []string{"EntityNotExist"}) {
+			return nil
 		}
-		return value, WrapErrorf(err, DefaultErrorMsg, "GetQuota", AlibabacloudStackSdkGoERROR)
-
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	addDebug("RamListAccessKeys", response, requestInfo, request)
-
-	bresponse, _ := raw.(*responses.CommonResponse)
-	err = json.Unmarshal(bresponse.GetHttpContentBytes(), value)
-	if err != nil {
-		return value, WrapError(err)
-	}
-	if value.AccessKeyId == "200" {
-		return value, WrapError(err)
-	}
-
-	return value, nil
-}
-
-type AccessKeyInCreateAccessKey struct {
-	AccessKeyId     string `json:"AccessKeyId" xml:"AccessKeyId"`
-	AccessKeySecret string `json:"AccessKeySecret" xml:"AccessKeySecret"`
-	Status          string `json:"Status" xml:"Status"`
-	CreateDate      string `json:"CreateDate" xml:"CreateDate"`
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamAccessKey(d.Id(), userName, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
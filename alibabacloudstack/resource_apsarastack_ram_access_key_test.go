@@ -0,0 +1,144 @@
+package alibabacloudstack
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+func TestAccAlibabacloudStackRamAccessKey_basic(t *testing.T) {
+	resourceId := "alibabacloudstack_ram_access_key.default"
+	ra := resourceAttrInit(resourceId, nil)
+	testAccCheck := ra.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccRamAccessKey%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceRamAccessKeyConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckRamAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"user_name": "${alibabacloudstack_ram_user.default.name}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"user_name": name,
+						"status":    "Active",
+						"secret":    CHECKSET,
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"status": "Inactive",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"status": "Inactive",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRamAccessKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
+	ramService := RamService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alibabacloudstack_ram_access_key" {
+			continue
+		}
+		_, err := ramService.DescribeRamAccessKey(rs.Primary.ID, rs.Primary.Attributes["user_name"])
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+		return WrapError(Error("the ram access key %s still exists", rs.Primary.ID))
+	}
+	return nil
+}
+
+func resourceRamAccessKeyConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ram_user" "default" {
+  name  = var.name
+  force = true
+}
+`, name)
+}
+
+func TestResourceAlibabacloudStackRamAccessKeyImport(t *testing.T) {
+	d := resourceAlibabacloudStackRamAccessKey().Data(nil)
+	d.SetId("LTAI123")
+	if _, err := resourceAlibabacloudStackRamAccessKey().Importer.State(d, nil); err == nil || !strings.Contains(err.Error(), "only returned when it is created") {
+		t.Errorf("import access key: expected an explanation, got %v", err)
+	}
+}
+
+func TestEncryptWithPgpKey(t *testing.T) {
+	config := &packet.Config{DefaultHash: crypto.SHA256}
+	entity, err := openpgp.NewEntity("terraform", "", "terraform@example.com", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// NewEntity sets the preferred hash after signing, so sign again to have it serialized.
+	for name, identity := range entity.Identities {
+		if err := identity.SelfSignature.SignUserId(name, entity.PrimaryKey, entity.PrivateKey, config); err != nil {
+			t.Fatal(err)
+		}
+	}
+	public := &bytes.Buffer{}
+	if err := entity.Serialize(public); err != nil {
+		t.Fatal(err)
+	}
+
+	fingerprint, encrypted, err := encryptWithPgpKey(base64.StdEncoding.EncodeToString(public.Bytes()), "secret")
+	if err != nil {
+		t.Fatalf("encryptWithPgpKey: %s", err)
+	}
+	if fingerprint != hex.EncodeToString(entity.PrimaryKey.Fingerprint[:]) {
+		t.Errorf("unexpected fingerprint %s", fingerprint)
+	}
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, err := openpgp.ReadMessage(bytes.NewReader(data), openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := ioutil.ReadAll(message.UnverifiedBody)
+	if err != nil || string(decrypted) != "secret" {
+		t.Errorf("expected the secret to be decrypted, got %q: %v", decrypted, err)
+	}
+
+	if _, _, err := encryptWithPgpKey("keybase:someone", "secret"); err == nil {
+		t.Errorf("expected the keybase keys to be refused")
+	}
+}
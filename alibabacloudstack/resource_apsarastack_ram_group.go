@@ -0,0 +1,154 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRamGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRamGroupCreate,
+		ReadContext:   resourceAlibabacloudStackRamGroupRead,
+		UpdateContext: resourceAlibabacloudStackRamGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackRamGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"comments": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRamGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := ram.CreateCreateGroupRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.GroupName = d.Get("name").(string)
+	if v, ok := d.GetOk("comments"); ok {
+		request.Comments = v.(string)
+	}
+
+	raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.CreateGroup(request)
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ram_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ram.CreateGroupResponse)
+	d.SetId(response.Group.GroupName)
+
+	return resourceAlibabacloudStackRamGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	if d.HasChanges("name", "comments") {
+		request := ram.CreateUpdateGroupRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.GroupName = d.Id()
+		if d.HasChange("name") {
+			request.NewGroupName = d.Get("name").(string)
+		}
+		if d.HasChange("comments") {
+			request.NewComments = d.Get("comments").(string)
+		}
+
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.UpdateGroup(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		d.SetId(d.Get("name").(string))
+	}
+
+	return resourceAlibabacloudStackRamGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	object, err := ramService.DescribeRamGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.Set("name", object.Group.GroupName)
+	d.Set("comments", object.Group.Comments)
+	return nil
+}
+
+func resourceAlibabacloudStackRamGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	if d.Get("force").(bool) {
+		if err := ramService.ClearRamGroup(d.Id()); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	request := ram.CreateDeleteGroupRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.GroupName = d.Id()
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.DeleteGroup(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"DeleteConflict.Group.User", "DeleteConflict.Group.Policy"}) {
+				return resource.NonRetryableError(WrapError(Error("The group %s can not be deleted as it still has users or policies. "+
+					"Set force to true to remove them with the group.", d.Id())))
+			}
+			if IsThrottling(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"EntityNotExist.Group"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
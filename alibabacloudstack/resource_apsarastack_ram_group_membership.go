@@ -0,0 +1,117 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRamGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRamGroupMembershipCreate,
+		ReadContext:   resourceAlibabacloudStackRamGroupMembershipRead,
+		UpdateContext: resourceAlibabacloudStackRamGroupMembershipUpdate,
+		DeleteContext: resourceAlibabacloudStackRamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"user_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRamGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+	groupName := d.Get("group_name").(string)
+
+	for _, userName := range expandStringList(d.Get("user_names").(*schema.Set).List()) {
+		if err := ramService.AddRamUserToGroup(userName, groupName); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	d.SetId(groupName)
+
+	if err := ramService.WaitForRamGroupMembership(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackRamGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	if d.HasChange("user_names") {
+		o, n := d.GetChange("user_names")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		for _, userName := range expandStringList(oldSet.Difference(newSet).List()) {
+			if err := ramService.RemoveRamUserFromGroup(userName, d.Id()); err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+		}
+		for _, userName := range expandStringList(newSet.Difference(oldSet).List()) {
+			if err := ramService.AddRamUserToGroup(userName, d.Id()); err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+		}
+	}
+
+	return resourceAlibabacloudStackRamGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	object, err := ramService.DescribeRamGroupMembership(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	var userNames []string
+	for _, user := range object.Users.User {
+		userNames = append(userNames, user.UserName)
+	}
+	d.Set("group_name", d.Id())
+	if err := d.Set("user_names", userNames); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackRamGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	for _, userName := range expandStringList(d.Get("user_names").(*schema.Set).List()) {
+		if err := ramService.RemoveRamUserFromGroup(userName, d.Id()); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	return nil
+}
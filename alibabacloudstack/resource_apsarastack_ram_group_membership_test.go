@@ -0,0 +1,93 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRamGroupMembership_basic(t *testing.T) {
+	var v *ram.ListUsersForGroupResponse
+	resourceId := "alibabacloudstack_ram_group_membership.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &RamService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccRamGroupMembership%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceRamGroupMembershipConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"group_name": "${alibabacloudstack_ram_group.default.name}",
+					"user_names": []string{"${alibabacloudstack_ram_user.default.0.name}"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"group_name":   name,
+						"user_names.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"user_names": []string{"${alibabacloudstack_ram_user.default.0.name}", "${alibabacloudstack_ram_user.default.1.name}"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"user_names.#": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"user_names": []string{"${alibabacloudstack_ram_user.default.1.name}"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"user_names.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceRamGroupMembershipConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ram_group" "default" {
+  name  = var.name
+  force = true
+}
+
+resource "alibabacloudstack_ram_user" "default" {
+  count = 2
+  name  = "${var.name}-${count.index}"
+  force = true
+}
+`, name)
+}
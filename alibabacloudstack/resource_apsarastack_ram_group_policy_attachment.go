@@ -0,0 +1,103 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRamGroupPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRamGroupPolicyAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackRamGroupPolicyAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackRamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"policy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"System", "Custom"}, false),
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRamGroupPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+	groupName := d.Get("group_name").(string)
+	policyName := d.Get("policy_name").(string)
+	policyType := d.Get("policy_type").(string)
+
+	if err := ramService.AttachRamPolicy("group", policyName, policyType, groupName); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	// the id is in the format of group:<policy_name>:<policy_type>:<group_name>
+	d.SetId(strings.Join([]string{"group", policyName, policyType, groupName}, COLON_SEPARATED))
+
+	if err := ramService.WaitForRamGroupPolicyAttachment(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackRamGroupPolicyAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamGroupPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	object, err := ramService.DescribeRamGroupPolicyAttachment(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.Set("group_name", parts[3])
+	d.Set("policy_name", object.PolicyName)
+	d.Set("policy_type", object.PolicyType)
+	return nil
+}
+
+func resourceAlibabacloudStackRamGroupPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := ramService.DetachRamPolicy("group", parts[1], parts[2], parts[3]); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamGroupPolicyAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
@@ -0,0 +1,79 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRamGroupPolicyAttachment_basic(t *testing.T) {
+	var v *ram.PolicyInListPoliciesForGroup
+	resourceId := "alibabacloudstack_ram_group_policy_attachment.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &RamService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccRamGroupPolicyAttachment%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceRamGroupPolicyAttachmentConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"group_name":  "${alibabacloudstack_ram_group.default.name}",
+					"policy_name": "${alibabacloudstack_ram_policy.default.name}",
+					"policy_type": "${alibabacloudstack_ram_policy.default.type}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"group_name":  name,
+						"policy_name": name,
+						"policy_type": "Custom",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceRamGroupPolicyAttachmentConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ram_group" "default" {
+  name  = var.name
+  force = true
+}
+
+resource "alibabacloudstack_ram_policy" "default" {
+  name  = var.name
+  force = true
+  statement {
+    effect   = "Allow"
+    action   = ["oss:ListObjects", "oss:GetObject"]
+    resource = ["acs:oss:*:*:mybucket", "acs:oss:*:*:mybucket/*"]
+  }
+}
+`, name)
+}
@@ -0,0 +1,145 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRamLoginProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRamLoginProfileCreate,
+		ReadContext:   resourceAlibabacloudStackRamLoginProfileRead,
+		UpdateContext: resourceAlibabacloudStackRamLoginProfileUpdate,
+		DeleteContext: resourceAlibabacloudStackRamLoginProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"password_reset_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"mfa_bind_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRamLoginProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	request := ram.CreateCreateLoginProfileRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.UserName = d.Get("user_name").(string)
+	request.Password = d.Get("password").(string)
+	request.PasswordResetRequired = requests.NewBoolean(d.Get("password_reset_required").(bool))
+	request.MFABindRequired = requests.NewBoolean(d.Get("mfa_bind_required").(bool))
+
+	raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.CreateLoginProfile(request)
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ram_login_profile", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ram.CreateLoginProfileResponse)
+	d.SetId(response.LoginProfile.UserName)
+
+	if err := ramService.WaitForRamLoginProfile(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackRamLoginProfileRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamLoginProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	if d.HasChanges("password", "password_reset_required", "mfa_bind_required") {
+		request := ram.CreateUpdateLoginProfileRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.UserName = d.Id()
+		request.PasswordResetRequired = requests.NewBoolean(d.Get("password_reset_required").(bool))
+		request.MFABindRequired = requests.NewBoolean(d.Get("mfa_bind_required").(bool))
+		if d.HasChange("password") {
+			request.Password = d.Get("password").(string)
+		}
+
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.UpdateLoginProfile(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	return resourceAlibabacloudStackRamLoginProfileRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamLoginProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	object, err := ramService.DescribeRamLoginProfile(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	// the password is never returned, so it keeps the value of the configuration.
+	d.Set("user_name", object.LoginProfile.UserName)
+	d.Set("password_reset_required", object.LoginProfile.PasswordResetRequired)
+	d.Set("mfa_bind_required", object.LoginProfile.MFABindRequired)
+	return nil
+}
+
+func resourceAlibabacloudStackRamLoginProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	request := ram.CreateDeleteLoginProfileRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.UserName = d.Id()
+
+	raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.DeleteLoginProfile(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"EntityNotExist.User.LoginProfile", "EntityNotExist.User"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamLoginProfile(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
@@ -0,0 +1,250 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRamPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRamPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackRamPolicyRead,
+		UpdateContext: resourceAlibabacloudStackRamPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackRamPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"statement": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"document"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(Allow), string(Deny)}, false),
+						},
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "1",
+				ConflictsWith: []string{"document"},
+				ValidateFunc:  validation.StringInSlice([]string{"1"}, false),
+			},
+			"document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"statement", "version"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: ramPolicyDocumentDiffSuppressFunc,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attachment_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRamPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	document, err := ramPolicyDocument(d, ramService)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	request := ram.CreateCreatePolicyRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.PolicyName = d.Get("name").(string)
+	request.PolicyDocument = document
+	if v, ok := d.GetOk("description"); ok {
+		request.Description = v.(string)
+	}
+
+	raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.CreatePolicy(request)
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ram_policy", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ram.CreatePolicyResponse)
+	d.SetId(response.Policy.PolicyName)
+
+	return resourceAlibabacloudStackRamPolicyRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	if d.HasChanges("document", "statement", "version") {
+		document, err := ramPolicyDocument(d, ramService)
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		object, err := ramService.DescribeRamPolicy(d.Id())
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+
+		request := ram.CreateCreatePolicyVersionRequest()
+		client.InitRpcRequest(request.RpcRequest)
+		request.PolicyName = d.Id()
+		request.PolicyDocument = document
+		request.SetAsDefault = requests.NewBoolean(true)
+
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.CreatePolicyVersion(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+		// a policy holds a few versions only, so the replaced default version is deleted.
+		if err := ramService.DeleteRamPolicyVersion(d.Id(), object.Policy.DefaultVersion); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	return resourceAlibabacloudStackRamPolicyRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	object, err := ramService.DescribeRamPolicy(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	document := object.DefaultPolicyVersion.PolicyDocument
+	if document == "" {
+		document = object.Policy.PolicyDocument
+	}
+	statement, version, err := ramService.ParsePolicyDocument(document)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("name", object.Policy.PolicyName)
+	d.Set("type", object.Policy.PolicyType)
+	d.Set("description", object.Policy.Description)
+	d.Set("attachment_count", object.Policy.AttachmentCount)
+	d.Set("document", document)
+	d.Set("version", version)
+	if err := d.Set("statement", statement); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackRamPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	if err := ramService.ClearRamPolicy(d.Id(), d.Get("force").(bool)); err != nil {
+		if NotFoundError(err) || IsExpectedErrors(err, []string{"EntityNotExist.Policy"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	request := ram.CreateDeletePolicyRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.PolicyName = d.Id()
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.DeletePolicy(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"DeleteConflict.Policy.Group", "DeleteConflict.Policy.User", "DeleteConflict.Policy.Role"}) {
+				return resource.NonRetryableError(WrapError(Error("The policy %s can not be deleted as it is still attached to users, groups or roles. "+
+					"Set force to true to detach it before it is deleted.", d.Id())))
+			}
+			if IsThrottling(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"EntityNotExist.Policy"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamPolicy(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
+
+// ramPolicyDocument returns the policy document configured as is, or assembled from the statement.
+func ramPolicyDocument(d *schema.ResourceData, ramService RamService) (string, error) {
+	if v, ok := d.GetOk("document"); ok && d.HasChange("document") {
+		return v.(string), nil
+	}
+	if v, ok := d.GetOk("statement"); ok {
+		return ramService.AssemblePolicyDocument(v.(*schema.Set).List(), d.Get("version").(string))
+	}
+	return "", WrapError(Error("one of document and statement must be set for the ram policy %s", d.Get("name").(string)))
+}
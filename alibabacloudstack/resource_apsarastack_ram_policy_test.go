@@ -0,0 +1,94 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRamPolicy_basic(t *testing.T) {
+	var v *ram.GetPolicyResponse
+	resourceId := "alibabacloudstack_ram_policy.default"
+	ra := resourceAttrInit(resourceId, ramPolicyBasicMap)
+	serviceFunc := func() interface{} {
+		return &RamService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccRamPolicy%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceRamPolicyConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":        "${var.name}",
+					"description": "tf test ram policy",
+					"statement": []map[string]interface{}{
+						{
+							"effect":   "Allow",
+							"action":   []string{"oss:ListObjects", "oss:GetObject"},
+							"resource": []string{"acs:oss:*:*:mybucket", "acs:oss:*:*:mybucket/*"},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":        name,
+						"description": "tf test ram policy",
+						"statement.#": "1",
+						"version":     "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"statement": REMOVEKEY,
+					"version":   REMOVEKEY,
+					"document":  `{\"Statement\": [{\"Action\": [\"oss:ListObjects\"], \"Effect\": \"Deny\", \"Resource\": [\"acs:oss:*:*:mybucket\"]}], \"Version\": \"1\"}`,
+					"force":     "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"statement.#": "1",
+						"document":    CHECKSET,
+						"force":       "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceRamPolicyConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+`, name)
+}
+
+var ramPolicyBasicMap = map[string]string{
+	"name":             CHECKSET,
+	"type":             "Custom",
+	"document":         CHECKSET,
+	"attachment_count": "0",
+}
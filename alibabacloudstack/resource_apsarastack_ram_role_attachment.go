@@ -1,21 +1,31 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackRamRoleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackInstanceRoleAttachmentCreate,
-		Read:   resourceAlibabacloudStackInstanceRoleAttachmentRead,
-		Delete: resourceAlibabacloudStackInstanceRoleAttachmentDelete,
+		CreateContext: resourceAlibabacloudStackInstanceRoleAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackInstanceRoleAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackInstanceRoleAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -33,8 +43,8 @@ func resourceAlibabacloudStackRamRoleAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackInstanceRoleAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var instanceId string
 	var instanceIds []string
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -56,13 +66,12 @@ func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup,
+		"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup,
 	}
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceId)
 	request.RamRoleName = d.Get("role_name").(string)
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachInstanceRamRole(request)
 		})
@@ -78,19 +87,19 @@ func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceDat
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "ram_role_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "ram_role_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return resourceAlibabacloudStackInstanceRoleAttachmentRead(d, meta)
+	return resourceAlibabacloudStackInstanceRoleAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRoleAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	roleName := parts[0]
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ramService := RamService{client}
 	object, err := ramService.DescribeRamRoleAttachment(d.Id())
 	if err != nil {
@@ -98,7 +107,7 @@ func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	instRoleSets := object.InstanceRamRoleSets.InstanceRamRoleSet
 	var instIds []string
@@ -113,12 +122,12 @@ func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData,
 
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackInstanceRoleAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ramService := RamService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	roleName := parts[0]
 	instanceIds := parts[1]
@@ -131,11 +140,11 @@ func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceDat
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RamRoleName = roleName
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceIds)
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachInstanceRamRole(request)
 		})
@@ -149,7 +158,7 @@ func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(ramService.WaitForRamRoleAttachment(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamRoleAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -36,6 +36,11 @@ func TestAccAlibabacloudStackRamRoleAttachment_basic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 
@@ -0,0 +1,103 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRamRolePolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRamRolePolicyAttachmentCreate,
+		ReadContext:   resourceAlibabacloudStackRamRolePolicyAttachmentRead,
+		DeleteContext: resourceAlibabacloudStackRamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"policy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"System", "Custom"}, false),
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRamRolePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+	roleName := d.Get("role_name").(string)
+	policyName := d.Get("policy_name").(string)
+	policyType := d.Get("policy_type").(string)
+
+	if err := ramService.AttachRamPolicy("role", policyName, policyType, roleName); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	// the id is in the format of role:<policy_name>:<policy_type>:<role_name>
+	d.SetId(strings.Join([]string{"role", policyName, policyType, roleName}, COLON_SEPARATED))
+
+	if err := ramService.WaitForRamRolePolicyAttachment(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackRamRolePolicyAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamRolePolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	object, err := ramService.DescribeRamRolePolicyAttachment(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	d.Set("role_name", parts[3])
+	d.Set("policy_name", object.PolicyName)
+	d.Set("policy_type", object.PolicyType)
+	return nil
+}
+
+func resourceAlibabacloudStackRamRolePolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := ramService.DetachRamPolicy("role", parts[1], parts[2], parts[3]); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamRolePolicyAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
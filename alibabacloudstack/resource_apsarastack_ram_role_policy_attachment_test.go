@@ -0,0 +1,78 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRamRolePolicyAttachment_basic(t *testing.T) {
+	var v *ram.PolicyInListPoliciesForRole
+	resourceId := "alibabacloudstack_ram_role_policy_attachment.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &RamService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccRamRolePolicyAttachment%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceRamRolePolicyAttachmentConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"role_name":   "${data.alibabacloudstack_ascm_ram_service_roles.default.roles.0.name}",
+					"policy_name": "${alibabacloudstack_ram_policy.default.name}",
+					"policy_type": "${alibabacloudstack_ram_policy.default.type}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"role_name":   CHECKSET,
+						"policy_name": name,
+						"policy_type": "Custom",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceRamRolePolicyAttachmentConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+data "alibabacloudstack_ascm_ram_service_roles" "default" {
+  product = "ecs"
+}
+
+resource "alibabacloudstack_ram_policy" "default" {
+  name  = var.name
+  force = true
+  statement {
+    effect   = "Allow"
+    action   = ["oss:ListObjects", "oss:GetObject"]
+    resource = ["acs:oss:*:*:mybucket", "acs:oss:*:*:mybucket/*"]
+  }
+}
+`, name)
+}
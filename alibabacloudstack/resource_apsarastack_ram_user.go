@@ -0,0 +1,200 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRamUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRamUserCreate,
+		ReadContext:   resourceAlibabacloudStackRamUserRead,
+		UpdateContext: resourceAlibabacloudStackRamUserUpdate,
+		DeleteContext: resourceAlibabacloudStackRamUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"mobile": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRamUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := ram.CreateCreateUserRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.UserName = d.Get("name").(string)
+	if v, ok := d.GetOk("display_name"); ok {
+		request.DisplayName = v.(string)
+	}
+	if v, ok := d.GetOk("mobile"); ok {
+		request.MobilePhone = v.(string)
+	}
+	if v, ok := d.GetOk("email"); ok {
+		request.Email = v.(string)
+	}
+	if v, ok := d.GetOk("comments"); ok {
+		request.Comments = v.(string)
+	}
+
+	raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.CreateUser(request)
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ram_user", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ram.CreateUserResponse)
+	d.SetId(response.User.UserId)
+
+	return resourceAlibabacloudStackRamUserRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	request := ram.CreateUpdateUserRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.UserName = d.Get("name").(string)
+	update := false
+
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		request.UserName = oldName.(string)
+		request.NewUserName = newName.(string)
+		update = true
+	}
+	if d.HasChange("display_name") {
+		request.NewDisplayName = d.Get("display_name").(string)
+		update = true
+	}
+	if d.HasChange("mobile") {
+		request.NewMobilePhone = d.Get("mobile").(string)
+		update = true
+	}
+	if d.HasChange("email") {
+		request.NewEmail = d.Get("email").(string)
+		update = true
+	}
+	if d.HasChange("comments") {
+		request.NewComments = d.Get("comments").(string)
+		update = true
+	}
+
+	if update {
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.UpdateUser(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	return resourceAlibabacloudStackRamUserRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRamUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+
+	object, err := ramService.DescribeRamUser(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	// a user can be imported with its name, and is tracked by its user id afterwards.
+	d.SetId(object.UserId)
+	d.Set("name", object.UserName)
+	d.Set("display_name", object.DisplayName)
+	d.Set("mobile", object.MobilePhone)
+	d.Set("email", object.Email)
+	d.Set("comments", object.Comments)
+	return nil
+}
+
+func resourceAlibabacloudStackRamUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	ramService := RamService{client}
+	userName := d.Get("name").(string)
+
+	if d.Get("force").(bool) {
+		if err := ramService.ClearRamUser(userName); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	request := ram.CreateDeleteUserRequest()
+	client.InitRpcRequest(request.RpcRequest)
+	request.UserName = userName
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.DeleteUser(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"DeleteConflict.User.AccessKey", "DeleteConflict.User.Group",
+				"DeleteConflict.User.Policy", "DeleteConflict.User.LoginProfile", "DeleteConflict.User.MFADevice"}) {
+				return resource.NonRetryableError(WrapError(Error("The user %s can not be deleted as it still has access keys, groups, policies, "+
+					"a login profile or a MFA device. Set force to true to remove them with the user.", userName)))
+			}
+			if IsThrottling(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"EntityNotExist.User"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	return DiagnosticsFromError(WrapError(ramService.WaitForRamUser(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
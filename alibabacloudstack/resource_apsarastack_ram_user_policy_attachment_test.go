@@ -0,0 +1,79 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRamUserPolicyAttachment_basic(t *testing.T) {
+	var v *ram.PolicyInListPoliciesForUser
+	resourceId := "alibabacloudstack_ram_user_policy_attachment.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &RamService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccRamUserPolicyAttachment%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceRamUserPolicyAttachmentConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"user_name":   "${alibabacloudstack_ram_user.default.name}",
+					"policy_name": "${alibabacloudstack_ram_policy.default.name}",
+					"policy_type": "${alibabacloudstack_ram_policy.default.type}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"user_name":   name,
+						"policy_name": name,
+						"policy_type": "Custom",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceRamUserPolicyAttachmentConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ram_user" "default" {
+  name  = var.name
+  force = true
+}
+
+resource "alibabacloudstack_ram_policy" "default" {
+  name  = var.name
+  force = true
+  statement {
+    effect   = "Allow"
+    action   = ["oss:ListObjects", "oss:GetObject"]
+    resource = ["acs:oss:*:*:mybucket", "acs:oss:*:*:mybucket/*"]
+  }
+}
+`, name)
+}
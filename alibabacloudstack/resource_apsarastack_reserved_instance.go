@@ -1,8 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -13,13 +16,18 @@ import (
 
 func resourceAlibabacloudStackReservedInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackReservedInstanceCreate,
-		Read:   resourceAlibabacloudStackReservedInstanceRead,
-		Update: resourceAlibabacloudStackReservedInstanceUpdate,
-		Delete: resourceAlibabacloudStackReservedInstanceDelete,
+		CreateContext: resourceAlibabacloudStackReservedInstanceCreate,
+		ReadContext:   resourceAlibabacloudStackReservedInstanceRead,
+		UpdateContext: resourceAlibabacloudStackReservedInstanceUpdate,
+		DeleteContext: resourceAlibabacloudStackReservedInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
 				Type:     schema.TypeString,
@@ -88,8 +96,8 @@ func resourceAlibabacloudStackReservedInstance() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackReservedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	request := ecs.CreatePurchaseReservedInstancesOfferingRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -98,7 +106,7 @@ func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if v, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = v.(string)
 	}
@@ -107,7 +115,7 @@ func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, met
 		request.Scope = scope.(string)
 		if v, ok := d.GetOk("zone_id"); ok {
 			if scope == "Zone" && v == "" {
-				return WrapError(Error("Required when Scope is Zone."))
+				return DiagnosticsFromError(WrapError(Error("Required when Scope is Zone.")))
 			}
 			request.ZoneId = v.(string)
 		}
@@ -140,23 +148,23 @@ func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, met
 		return ecsClient.PurchaseReservedInstancesOffering(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_reserved_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_reserved_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response := raw.(*ecs.PurchaseReservedInstancesOfferingResponse)
 	if len(response.ReservedInstanceIdSets.ReservedInstanceId) != 1 {
-		return WrapError(Error("API returned wrong number of collections"))
+		return DiagnosticsFromError(WrapError(Error("API returned wrong number of collections")))
 	}
 	d.SetId(response.ReservedInstanceIdSets.ReservedInstanceId[0])
 
-	if err := ecsService.WaitForReservedInstance(d.Id(), Active, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := ecsService.WaitForReservedInstance(d.Id(), Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackReservedInstanceRead(d, meta)
+	return resourceAlibabacloudStackReservedInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackReservedInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := ecs.CreateModifyReservedInstanceAttributeRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -164,7 +172,7 @@ func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ReservedInstanceId = d.Id()
 	request.RegionId = client.RegionId
 	if d.HasChange("name") || d.HasChange("description") {
@@ -178,20 +186,20 @@ func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, met
 			return ecsClient.ModifyReservedInstanceAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackReservedInstanceRead(d, meta)
+	return resourceAlibabacloudStackReservedInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackReservedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	reservedInstances, err := ecsService.DescribeReservedInstance(d.Id())
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("instance_type", reservedInstances.InstanceType)
 	d.Set("scope", reservedInstances.Scope)
@@ -203,9 +211,9 @@ func resourceAlibabacloudStackReservedInstanceRead(d *schema.ResourceData, meta
 	d.Set("description", reservedInstances.Description)
 	d.Set("resource_group_id", reservedInstances.ReservedInstanceId)
 
-	return WrapError(err)
+	return DiagnosticsFromError(WrapError(err))
 }
-func resourceAlibabacloudStackReservedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// PurchaseReservedInstancesOffering can not be release.
 	return nil
 }
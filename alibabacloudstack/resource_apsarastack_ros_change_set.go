@@ -0,0 +1,466 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRosChangeSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRosChangeSetCreate,
+		ReadContext:   resourceAlibabacloudStackRosChangeSetRead,
+		UpdateContext: resourceAlibabacloudStackRosChangeSetUpdate,
+		DeleteContext: resourceAlibabacloudStackRosChangeSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(11 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"change_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"change_set_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "UPDATE",
+				ValidateFunc: validation.StringInSlice([]string{"CREATE", "UPDATE"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disable_rollback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"notification_urls": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"parameter_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ram_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"replacement_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+			},
+			"stack_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"stack_id", "stack_name"},
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"stack_policy_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+			"stack_policy_during_update_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+			"stack_policy_during_update_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"stack_policy_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"template_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := compareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+			},
+			"template_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"template_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"timeout_in_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"use_previous_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"execute": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRosChangeSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	rosService := RosService{client}
+	var response map[string]interface{}
+	action := "CreateChangeSet"
+	request := make(map[string]interface{})
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	request["ChangeSetName"] = d.Get("change_set_name")
+	request["ChangeSetType"] = d.Get("change_set_type")
+	if v, ok := d.GetOk("description"); ok {
+		request["Description"] = v
+	}
+
+	if v, ok := d.GetOkExists("disable_rollback"); ok {
+		request["DisableRollback"] = v
+	}
+
+	if v, ok := d.GetOk("notification_urls"); ok {
+		request["NotificationURLs"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters := make([]map[string]interface{}, len(v.(*schema.Set).List()))
+		for i, j := range v.(*schema.Set).List() {
+			parameters[i] = make(map[string]interface{})
+			parameters[i]["ParameterKey"] = j.(map[string]interface{})["parameter_key"]
+			parameters[i]["ParameterValue"] = j.(map[string]interface{})["parameter_value"]
+		}
+		request["Parameters"] = parameters
+	}
+
+	if v, ok := d.GetOk("ram_role_name"); ok {
+		request["RamRoleName"] = v
+	}
+
+	if v, ok := d.GetOk("replacement_option"); ok {
+		request["ReplacementOption"] = v
+	}
+
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	if v, ok := d.GetOk("stack_id"); ok {
+		request["StackId"] = v
+	}
+
+	if v, ok := d.GetOk("stack_name"); ok {
+		request["StackName"] = v
+	}
+
+	if v, ok := d.GetOk("stack_policy_body"); ok {
+		request["StackPolicyBody"] = v
+	}
+
+	if v, ok := d.GetOk("stack_policy_during_update_body"); ok {
+		request["StackPolicyDuringUpdateBody"] = v
+	}
+
+	if v, ok := d.GetOk("stack_policy_during_update_url"); ok {
+		request["StackPolicyDuringUpdateURL"] = v
+	}
+
+	if v, ok := d.GetOk("stack_policy_url"); ok {
+		request["StackPolicyURL"] = v
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		request["TemplateBody"] = v
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		request["TemplateURL"] = v
+	}
+
+	if v, ok := d.GetOk("template_version"); ok {
+		request["TemplateVersion"] = v
+	}
+
+	if v, ok := d.GetOk("timeout_in_minutes"); ok {
+		request["TimeoutInMinutes"] = v
+	}
+
+	if v, ok := d.GetOkExists("use_previous_parameters"); ok {
+		request["UsePreviousParameters"] = v
+	}
+
+	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
+	request["ClientToken"] = buildClientToken("CreateChangeSet")
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_change_set", action, AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(action, response, request)
+
+	d.SetId(fmt.Sprint(response["ChangeSetId"]))
+	stateConf := BuildStateConf([]string{}, []string{"CREATE_COMPLETE"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, rosService.RosChangeSetStateRefreshFunc(d.Id(), []string{"CREATE_FAILED"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+
+	return resourceAlibabacloudStackRosChangeSetUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosChangeSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	rosService := RosService{client}
+	object, err := rosService.DescribeRosChangeSet(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("change_set_name", object["ChangeSetName"])
+	d.Set("change_set_type", object["ChangeSetType"])
+	d.Set("description", object["Description"])
+	d.Set("disable_rollback", object["DisableRollback"])
+	d.Set("stack_id", object["StackId"])
+	d.Set("stack_name", object["StackName"])
+	d.Set("template_body", object["TemplateBody"])
+	d.Set("timeout_in_minutes", formatInt(object["TimeoutInMinutes"]))
+	d.Set("status", object["Status"])
+	d.Set("execution_status", object["ExecutionStatus"])
+
+	parameters := make([]map[string]interface{}, 0)
+	if parametersList, ok := object["Parameters"].([]interface{}); ok {
+		for _, v := range parametersList {
+			if m1, ok := v.(map[string]interface{}); ok {
+				if strings.HasPrefix(fmt.Sprint(m1["ParameterKey"]), "ALIYUN::") {
+					continue
+				}
+				parameters = append(parameters, map[string]interface{}{
+					"parameter_key":   m1["ParameterKey"],
+					"parameter_value": m1["ParameterValue"],
+				})
+			}
+		}
+	}
+	if err := d.Set("parameters", parameters); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	changes := make([]map[string]interface{}, 0)
+	if changesList, ok := object["Changes"].([]interface{}); ok {
+		for _, v := range changesList {
+			change, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if m1, ok := change["ResourceChange"].(map[string]interface{}); ok {
+				changes = append(changes, map[string]interface{}{
+					"action":               m1["Action"],
+					"logical_resource_id":  m1["LogicalResourceId"],
+					"physical_resource_id": m1["PhysicalResourceId"],
+					"resource_type":        m1["ResourceType"],
+					"replacement":          m1["Replacement"],
+				})
+			}
+		}
+	}
+	if err := d.Set("changes", changes); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+// resourceAlibabacloudStackRosChangeSetUpdate executes the change set once execute is set. The other arguments
+// force a new change set, as a change set can not be modified after it is created.
+func resourceAlibabacloudStackRosChangeSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	rosService := RosService{client}
+
+	if d.HasChange("execute") && d.Get("execute").(bool) {
+		object, err := rosService.DescribeRosChangeSet(d.Id())
+		if err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+		if object["ExecutionStatus"] != "EXECUTE_COMPLETE" {
+			var response map[string]interface{}
+			action := "ExecuteChangeSet"
+			request := map[string]interface{}{
+				"ChangeSetId": d.Id(),
+			}
+			request["RegionId"] = client.RegionId
+			request["Product"] = "ROS"
+			request["product"] = "ROS"
+			request["OrganizationId"] = client.Department
+			conn, err := client.NewRosClient()
+			if err != nil {
+				return DiagnosticsFromError(WrapError(err))
+			}
+			runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+			addDebug(action, response, request)
+			if err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
+			}
+
+			// the change set is executed on its stack, so it is done once the stack is.
+			target, failStates := []string{"UPDATE_COMPLETE"}, []string{"UPDATE_FAILED", "ROLLBACK_COMPLETE", "ROLLBACK_FAILED"}
+			if object["ChangeSetType"] == "CREATE" {
+				target, failStates = []string{"CREATE_COMPLETE"}, []string{"CREATE_FAILED", "CREATE_ROLLBACK_COMPLETE", "CREATE_ROLLBACK_FAILED"}
+			}
+			timeout := d.Timeout(schema.TimeoutUpdate)
+			if d.IsNewResource() {
+				timeout = d.Timeout(schema.TimeoutCreate)
+			}
+			stateConf := BuildStateConf([]string{}, target, timeout, 10*time.Second, rosService.RosStackStateRefreshFunc(fmt.Sprint(object["StackId"]), failStates))
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+			}
+		}
+	}
+	return resourceAlibabacloudStackRosChangeSetRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosChangeSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	rosService := RosService{client}
+	action := "DeleteChangeSet"
+	var response map[string]interface{}
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	request := map[string]interface{}{
+		"ChangeSetId": d.Id(),
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, response, request)
+		return nil
+	})
+	if err != nil {
+		// an executed change set can not be deleted, and it goes along with its stack.
+		if IsExpectedErrors(err, []string{"ChangeSetNotFound", "InvalidChangeSetStatus"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
+	}
+	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, rosService.RosChangeSetStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRosChangeSet_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_ros_change_set.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackRosChangeSetMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeRosChangeSet")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAcc%sAlibabacloudStackRosChangeSet%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackRosChangeSetBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"change_set_name": name,
+					"change_set_type": "UPDATE",
+					"description":     "Test From Terraform",
+					"stack_id":        "${alibabacloudstack_ros_stack.default.id}",
+					"template_body":   `{\"ROSTemplateFormatVersion\":\"2015-09-01\", \"Parameters\": {\"VpcName\": {\"Type\": \"String\"},\"InstanceType\": {\"Type\": \"String\"}}, \"Outputs\": {\"VpcName\": {\"Value\": {\"Ref\": \"VpcName\"}}}}`,
+					"parameters": []map[string]interface{}{
+						{
+							"parameter_key":   "VpcName",
+							"parameter_value": "tf-testacc",
+						},
+						{
+							"parameter_key":   "InstanceType",
+							"parameter_value": "ECS",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"change_set_name":  name,
+						"change_set_type":  "UPDATE",
+						"description":      "Test From Terraform",
+						"stack_id":         CHECKSET,
+						"stack_name":       name,
+						"parameters.#":     "2",
+						"execution_status": "AVAILABLE",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"execute", "template_body", "use_previous_parameters"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"execute": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"execute":          "true",
+						"execution_status": "EXECUTE_COMPLETE",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var AlibabacloudStackRosChangeSetMap = map[string]string{
+	"status": "CREATE_COMPLETE",
+}
+
+func AlibabacloudStackRosChangeSetBasicDependence(name string) string {
+	return fmt.Sprintf(`
+resource "alibabacloudstack_ros_stack" "default" {
+  stack_name    = "%s"
+  template_body = "{\"ROSTemplateFormatVersion\":\"2015-09-01\", \"Parameters\": {\"VpcName\": {\"Type\": \"String\"},\"InstanceType\": {\"Type\": \"String\"}}}"
+  parameters {
+    parameter_key   = "VpcName"
+    parameter_value = "VpcName"
+  }
+  parameters {
+    parameter_key   = "InstanceType"
+    parameter_value = "InstanceType"
+  }
+}
+`, name)
+}
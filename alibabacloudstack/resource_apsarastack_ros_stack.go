@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackRosStack() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRosStackCreate,
-		Read:   resourceAlibabacloudStackRosStackRead,
-		Update: resourceAlibabacloudStackRosStackUpdate,
-		Delete: resourceAlibabacloudStackRosStackDelete,
+		CreateContext: resourceAlibabacloudStackRosStackCreate,
+		ReadContext:   resourceAlibabacloudStackRosStackRead,
+		UpdateContext: resourceAlibabacloudStackRosStackUpdate,
+		DeleteContext: resourceAlibabacloudStackRosStackDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -150,15 +152,15 @@ func resourceAlibabacloudStackRosStack() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRosStackCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosStackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rosService := RosService{client}
 	var response map[string]interface{}
 	action := "CreateStack"
 	request := make(map[string]interface{})
 	conn, err := client.NewRosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("create_option"); ok {
 		request["CreateOption"] = v
@@ -224,20 +226,20 @@ func resourceAlibabacloudStackRosStackCreate(d *schema.ResourceData, meta interf
 	request["ClientToken"] = buildClientToken("CreateStack")
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_stack", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_stack", action, AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(action, response, request)
 
 	d.SetId(fmt.Sprint(response["StackId"]))
 	stateConf := BuildStateConf([]string{}, []string{"CREATE_COMPLETE"}, d.Timeout(schema.TimeoutCreate), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"CREATE_FAILED", "CREATE_ROLLBACK_COMPLETE", "CREATE_ROLLBACK_FAILED"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackRosStackRead(d, meta)
+	return resourceAlibabacloudStackRosStackRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosStackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rosService := RosService{client}
 	object, err := rosService.DescribeRosStack(d.Id())
 	if err != nil {
@@ -246,7 +248,7 @@ func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("deletion_protection", object["DeletionProtection"])
 	d.Set("disable_rollback", object["DisableRollback"])
@@ -266,7 +268,7 @@ func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interfac
 		}
 	}
 	if err := d.Set("parameters", parameters); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("ram_role_name", object["RamRoleName"])
 	d.Set("stack_name", object["StackName"])
@@ -287,8 +289,8 @@ func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosStackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rosService := RosService{client}
 	var response map[string]interface{}
 	d.Partial(true)
@@ -365,7 +367,7 @@ func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interf
 		action := "UpdateStack"
 		conn, err := client.NewRosClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
@@ -373,11 +375,11 @@ func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interf
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"UPDATE_COMPLETE"}, d.Timeout(schema.TimeoutUpdate), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"UPDATE_FAILED", "ROLLBACK_FAILED"}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("disable_rollback")
 		//d.SetPartial("parameters")
@@ -386,16 +388,16 @@ func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interf
 		//d.SetPartial("timeout_in_minutes")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackRosStackRead(d, meta)
+	return resourceAlibabacloudStackRosStackRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosStackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rosService := RosService{client}
 	action := "DeleteStack"
 	var response map[string]interface{}
 	conn, err := client.NewRosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"StackId": d.Id(),
@@ -415,7 +417,7 @@ func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interf
 		request["RetainResources"] = v.(*schema.Set).List()
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -431,11 +433,11 @@ func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interf
 		if IsExpectedErrors(err, []string{"StackNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	stateConf := BuildStateConf([]string{}, []string{"DELETE_COMPLETE"}, d.Timeout(schema.TimeoutDelete), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"DELETE_FAILED"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
@@ -0,0 +1,485 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRosStackGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackRosStackGroupCreate,
+		ReadContext:   resourceAlibabacloudStackRosStackGroupRead,
+		UpdateContext: resourceAlibabacloudStackRosStackGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackRosStackGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"stack_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"administration_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"execution_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"parameter_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"template_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := compareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+			},
+			"template_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"operation_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operation_preferences": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+			"retain_stacks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRosStackGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	rosService := RosService{client}
+	var response map[string]interface{}
+	action := "CreateStackGroup"
+	request := make(map[string]interface{})
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if v, ok := d.GetOk("administration_role_name"); ok {
+		request["AdministrationRoleName"] = v
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		request["Description"] = v
+	}
+
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		request["ExecutionRoleName"] = v
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		request["Parameters"] = rosStackGroupParameters(v.(*schema.Set))
+	}
+
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	request["StackGroupName"] = d.Get("stack_group_name")
+	if v, ok := d.GetOk("template_body"); ok {
+		request["TemplateBody"] = v
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		request["TemplateURL"] = v
+	}
+
+	if v, ok := d.GetOk("template_version"); ok {
+		request["TemplateVersion"] = v
+	}
+
+	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
+	request["ClientToken"] = buildClientToken("CreateStackGroup")
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_stack_group", action, AlibabacloudStackSdkGoERROR))
+	}
+	addDebug(action, response, request)
+
+	d.SetId(fmt.Sprint(request["StackGroupName"]))
+	stateConf := BuildStateConf([]string{}, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, rosService.RosStackGroupStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
+	}
+
+	accountIds := d.Get("account_ids").(*schema.Set).List()
+	regionIds := d.Get("region_ids").(*schema.Set).List()
+	if len(accountIds) > 0 && len(regionIds) > 0 {
+		request := map[string]interface{}{
+			"AccountIds": convertListToJsonString(accountIds),
+			"RegionIds":  convertListToJsonString(regionIds),
+		}
+		if err := rosStackGroupOperation(ctx, d, client, "CreateStackInstances", request, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	return resourceAlibabacloudStackRosStackGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosStackGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	rosService := RosService{client}
+	object, err := rosService.DescribeRosStackGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("stack_group_name", object["StackGroupName"])
+	d.Set("administration_role_name", object["AdministrationRoleName"])
+	d.Set("execution_role_name", object["ExecutionRoleName"])
+	d.Set("description", object["Description"])
+	d.Set("template_body", object["TemplateBody"])
+	d.Set("stack_group_id", object["StackGroupId"])
+	d.Set("status", object["Status"])
+
+	parameters := make([]map[string]interface{}, 0)
+	if parametersList, ok := object["Parameters"].([]interface{}); ok {
+		for _, v := range parametersList {
+			if m1, ok := v.(map[string]interface{}); ok {
+				if strings.HasPrefix(fmt.Sprint(m1["ParameterKey"]), "ALIYUN::") {
+					continue
+				}
+				parameters = append(parameters, map[string]interface{}{
+					"parameter_key":   m1["ParameterKey"],
+					"parameter_value": m1["ParameterValue"],
+				})
+			}
+		}
+	}
+	if err := d.Set("parameters", parameters); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	instances, err := rosService.ListRosStackInstances(d.Id())
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	accountIds, regionIds := rosStackInstanceScopes(instances)
+	if err := d.Set("account_ids", accountIds); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if err := d.Set("region_ids", regionIds); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackRosStackGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	oldAccounts, newAccounts := d.GetChange("account_ids")
+	oldRegions, newRegions := d.GetChange("region_ids")
+	oldAccountSet, newAccountSet := oldAccounts.(*schema.Set), newAccounts.(*schema.Set)
+	oldRegionSet, newRegionSet := oldRegions.(*schema.Set), newRegions.(*schema.Set)
+
+	// the stack instances are the product of the accounts and the regions, so the removed accounts and regions
+	// are deleted with their instances first, and the added ones are created last.
+	if removed := oldAccountSet.Difference(newAccountSet).List(); len(removed) > 0 && oldRegionSet.Len() > 0 {
+		request := map[string]interface{}{
+			"AccountIds":   convertListToJsonString(removed),
+			"RegionIds":    convertListToJsonString(oldRegionSet.List()),
+			"RetainStacks": d.Get("retain_stacks"),
+		}
+		if err := rosStackGroupOperation(ctx, d, client, "DeleteStackInstances", request, timeout); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	remainedAccounts := oldAccountSet.Intersection(newAccountSet).List()
+	if removed := oldRegionSet.Difference(newRegionSet).List(); len(removed) > 0 && len(remainedAccounts) > 0 {
+		request := map[string]interface{}{
+			"AccountIds":   convertListToJsonString(remainedAccounts),
+			"RegionIds":    convertListToJsonString(removed),
+			"RetainStacks": d.Get("retain_stacks"),
+		}
+		if err := rosStackGroupOperation(ctx, d, client, "DeleteStackInstances", request, timeout); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	update := false
+	request := map[string]interface{}{
+		"StackGroupName": d.Id(),
+	}
+	if d.HasChange("administration_role_name") {
+		update = true
+	}
+	if v, ok := d.GetOk("administration_role_name"); ok {
+		request["AdministrationRoleName"] = v
+	}
+	if d.HasChange("execution_role_name") {
+		update = true
+	}
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		request["ExecutionRoleName"] = v
+	}
+	if d.HasChange("description") {
+		update = true
+		request["Description"] = d.Get("description")
+	}
+	if d.HasChange("parameters") {
+		update = true
+		request["Parameters"] = rosStackGroupParameters(d.Get("parameters").(*schema.Set))
+	}
+	if d.HasChanges("template_body", "template_url", "template_version") {
+		update = true
+	}
+	if update {
+		if v, ok := d.GetOk("template_url"); ok {
+			request["TemplateURL"] = v
+		} else {
+			request["TemplateBody"] = d.Get("template_body")
+		}
+		if v, ok := d.GetOk("template_version"); ok {
+			request["TemplateVersion"] = v
+		}
+		// the template is rolled out to the instances which are kept, the added ones get it on creation.
+		remainedRegions := oldRegionSet.Intersection(newRegionSet).List()
+		if len(remainedAccounts) > 0 && len(remainedRegions) > 0 {
+			request["AccountIds"] = convertListToJsonString(remainedAccounts)
+			request["RegionIds"] = convertListToJsonString(remainedRegions)
+		}
+		if err := rosStackGroupOperation(ctx, d, client, "UpdateStackGroup", request, timeout); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	if added := newAccountSet.Difference(oldAccountSet).List(); len(added) > 0 && newRegionSet.Len() > 0 {
+		request := map[string]interface{}{
+			"AccountIds": convertListToJsonString(added),
+			"RegionIds":  convertListToJsonString(newRegionSet.List()),
+		}
+		if err := rosStackGroupOperation(ctx, d, client, "CreateStackInstances", request, timeout); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+	if added := newRegionSet.Difference(oldRegionSet).List(); len(added) > 0 && len(remainedAccounts) > 0 {
+		request := map[string]interface{}{
+			"AccountIds": convertListToJsonString(remainedAccounts),
+			"RegionIds":  convertListToJsonString(added),
+		}
+		if err := rosStackGroupOperation(ctx, d, client, "CreateStackInstances", request, timeout); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	return resourceAlibabacloudStackRosStackGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosStackGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	rosService := RosService{client}
+
+	// a stack group can only be deleted once all of its stack instances are.
+	instances, err := rosService.ListRosStackInstances(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+	if len(instances) > 0 {
+		accountIds, regionIds := rosStackInstanceScopes(instances)
+		request := map[string]interface{}{
+			"AccountIds":   convertListToJsonString(accountIds),
+			"RegionIds":    convertListToJsonString(regionIds),
+			"RetainStacks": d.Get("retain_stacks"),
+		}
+		if err := rosStackGroupOperation(ctx, d, client, "DeleteStackInstances", request, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return DiagnosticsFromError(WrapError(err))
+		}
+	}
+
+	action := "DeleteStackGroup"
+	var response map[string]interface{}
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	request := map[string]interface{}{
+		"StackGroupName": d.Id(),
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, response, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"StackGroupNotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
+	}
+	return nil
+}
+
+// rosStackGroupOperation calls an action which starts an operation on the stack instances of the stack group,
+// and waits for the operation to succeed.
+func rosStackGroupOperation(ctx context.Context, d *schema.ResourceData, client *connectivity.AlibabacloudStackClient, action string, request map[string]interface{}, timeout time.Duration) error {
+	rosService := RosService{client}
+	var response map[string]interface{}
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	request["StackGroupName"] = d.Id()
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	if v, ok := d.GetOk("operation_description"); ok {
+		request["OperationDescription"] = v
+	}
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		request["OperationPreferences"] = v
+	}
+	request["ClientToken"] = buildClientToken(action)
+
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
+		if err != nil {
+			// only one operation runs on a stack group at a time.
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"StackGroupOperationInProgress"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, response, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	// operations that leave the stack instances untouched do not return an OperationId.
+	operationId, ok := response["OperationId"].(string)
+	if !ok || operationId == "" {
+		return nil
+	}
+	stateConf := BuildStateConf([]string{}, []string{"SUCCEEDED"}, timeout, 5*time.Second, rosService.RosStackGroupOperationStateRefreshFunc(operationId, []string{"FAILED", "STOPPED"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
+
+func rosStackGroupParameters(set *schema.Set) []map[string]interface{} {
+	parameters := make([]map[string]interface{}, len(set.List()))
+	for i, v := range set.List() {
+		parameters[i] = make(map[string]interface{})
+		parameters[i]["ParameterKey"] = v.(map[string]interface{})["parameter_key"]
+		parameters[i]["ParameterValue"] = v.(map[string]interface{})["parameter_value"]
+	}
+	return parameters
+}
+
+// rosStackInstanceScopes returns the distinct accounts and regions the stack instances are deployed to.
+func rosStackInstanceScopes(instances []interface{}) (accountIds, regionIds []interface{}) {
+	accounts := make(map[string]bool)
+	regions := make(map[string]bool)
+	for _, v := range instances {
+		instance := v.(map[string]interface{})
+		if accountId := fmt.Sprint(instance["AccountId"]); !accounts[accountId] {
+			accounts[accountId] = true
+			accountIds = append(accountIds, accountId)
+		}
+		if regionId := fmt.Sprint(instance["RegionId"]); !regions[regionId] {
+			regions[regionId] = true
+			regionIds = append(regionIds, regionId)
+		}
+	}
+	return accountIds, regionIds
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackRosTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRosTemplateCreate,
-		Read:   resourceAlibabacloudStackRosTemplateRead,
-		Update: resourceAlibabacloudStackRosTemplateUpdate,
-		Delete: resourceAlibabacloudStackRosTemplateDelete,
+		CreateContext: resourceAlibabacloudStackRosTemplateCreate,
+		ReadContext:   resourceAlibabacloudStackRosTemplateRead,
+		UpdateContext: resourceAlibabacloudStackRosTemplateUpdate,
+		DeleteContext: resourceAlibabacloudStackRosTemplateDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -49,14 +51,14 @@ func resourceAlibabacloudStackRosTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRosTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateTemplate"
 	request := make(map[string]interface{})
 	conn, err := client.NewRosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("description"); ok {
 		request["Description"] = v
@@ -88,15 +90,15 @@ func resourceAlibabacloudStackRosTemplateCreate(d *schema.ResourceData, meta int
 	//return nil
 	//})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_template", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_template", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["TemplateId"]))
 
-	return resourceAlibabacloudStackRosTemplateRead(d, meta)
+	return resourceAlibabacloudStackRosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	rosService := RosService{client}
 	object, err := rosService.DescribeRosTemplate(d.Id())
 	if err != nil {
@@ -105,7 +107,7 @@ func resourceAlibabacloudStackRosTemplateRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	//d.Set("description", object["Description"])
 	d.Set("template_body", object["TemplateBody"])
@@ -118,8 +120,8 @@ func resourceAlibabacloudStackRosTemplateRead(d *schema.ResourceData, meta inter
 	//d.Set("tags", tagsToMap(listTagResourcesObject))
 	return nil
 }
-func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	//rosService := RosService{client}
 	var response map[string]interface{}
 	d.Partial(true)
@@ -157,10 +159,10 @@ func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta int
 		request["OrganizationId"] = client.Department
 		conn, err := client.NewRosClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 			if err != nil {
 				if NeedRetry(err) {
@@ -173,22 +175,22 @@ func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta int
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("description")
 		//d.SetPartial("template_body")
 		//d.SetPartial("template_name")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackRosTemplateRead(d, meta)
+	return resourceAlibabacloudStackRosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRosTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteTemplate"
 	var response map[string]interface{}
 	conn, err := client.NewRosClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"TemplateId": d.Id(),
@@ -198,7 +200,7 @@ func resourceAlibabacloudStackRosTemplateDelete(d *schema.ResourceData, meta int
 	request["product"] = "ROS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -214,7 +216,7 @@ func resourceAlibabacloudStackRosTemplateDelete(d *schema.ResourceData, meta int
 		if IsExpectedErrors(err, []string{"ChangeSetNotFound", "StackNotFound", "TemplateNotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -14,13 +16,18 @@ import (
 
 func resourceAlibabacloudStackRouteEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouteEntryCreate,
-		Read:   resourceAlibabacloudStackRouteEntryRead,
-		Delete: resourceAlibabacloudStackRouteEntryDelete,
+		CreateContext: resourceAlibabacloudStackRouteEntryCreate,
+		ReadContext:   resourceAlibabacloudStackRouteEntryRead,
+		DeleteContext: resourceAlibabacloudStackRouteEntryDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"router_id": {
 				Type:       schema.TypeString,
@@ -58,8 +65,8 @@ func resourceAlibabacloudStackRouteEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	rtId := d.Get("route_table_id").(string)
@@ -70,7 +77,7 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 	table, err := vpcService.QueryRouteTableById(rtId)
 
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := vpc.CreateCreateRouteEntryRequest()
 	request.RegionId = client.RegionId
@@ -80,15 +87,15 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = rtId
 	request.DestinationCidrBlock = cidr
 	request.NextHopType = nt
 	request.NextHopId = ni
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.RouteEntryName = d.Get("name").(string)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
-		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 			return resource.NonRetryableError(err)
 		}
 		args := *request
@@ -108,30 +115,30 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 		if IsExpectedErrors(err, []string{"RouterEntryConflict.Duplicated"}) {
 			en, err := vpcService.DescribeRouteEntry(rtId + ":" + table.VRouterId + ":" + cidr + ":" + nt + ":" + ni)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
-			return WrapError(Error("The route entry %s has already existed. "+
+			return DiagnosticsFromError(WrapError(Error("The route entry %s has already existed. "+
 				"Please import it using ID '%s:%s:%s:%s:%s' or specify a new 'destination_cidrblock' and try again.",
-				en.DestinationCidrBlock, en.RouteTableId, table.VRouterId, en.DestinationCidrBlock, en.NextHopType, ni))
+				en.DestinationCidrBlock, en.RouteTableId, table.VRouterId, en.DestinationCidrBlock, en.NextHopType, ni)))
 		}
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_route_entry", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_route_entry", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(rtId + ":" + table.VRouterId + ":" + cidr + ":" + nt + ":" + ni)
 
-	if err := vpcService.WaitForRouteEntry(d.Id(), Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForRouteEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackRouteEntryRead(d, meta)
+	return resourceAlibabacloudStackRouteEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 5)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	object, err := vpcService.DescribeRouteEntry(d.Id())
 	if err != nil {
@@ -139,7 +146,7 @@ func resourceAlibabacloudStackRouteEntryRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("router_id", parts[1])
@@ -151,12 +158,12 @@ func resourceAlibabacloudStackRouteEntryRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	request, err := buildAlibabacloudStackRouteEntryDeleteArgs(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -164,15 +171,15 @@ func resourceAlibabacloudStackRouteEntryDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 5)
 	rtId := parts[0]
-	if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	retryTimes := 7
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteRouteEntry(request)
 		})
@@ -191,9 +198,9 @@ func resourceAlibabacloudStackRouteEntryDelete(d *schema.ResourceData, meta inte
 		if IsExpectedErrors(err, []string{"InvalidRouteEntry.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpcService.WaitForRouteEntry(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForRouteEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildAlibabacloudStackRouteEntryDeleteArgs(d *schema.ResourceData, meta interface{}) (*vpc.DeleteRouteEntryRequest, error) {
@@ -207,7 +214,7 @@ func buildAlibabacloudStackRouteEntryDeleteArgs(d *schema.ResourceData, meta int
 	}
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.RouteTableId = d.Get("route_table_id").(string)
 	request.DestinationCidrBlock = d.Get("destination_cidrblock").(string)
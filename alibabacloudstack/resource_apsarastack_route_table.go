@@ -1,24 +1,33 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunRouteTableCreate,
-		Read:   resourceAliyunRouteTableRead,
-		Update: resourceAliyunRouteTableUpdate,
-		Delete: resourceAliyunRouteTableDelete,
+		CreateContext: resourceAliyunRouteTableCreate,
+		ReadContext:   resourceAliyunRouteTableRead,
+		UpdateContext: resourceAliyunRouteTableUpdate,
+		DeleteContext: resourceAliyunRouteTableDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -41,8 +50,8 @@ func resourceAlibabacloudStackRouteTable() *schema.Resource {
 	}
 }
 
-func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateCreateRouteTableRequest()
@@ -53,7 +62,7 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.VpcId = d.Get("vpc_id").(string)
 	request.RouteTableName = d.Get("name").(string)
@@ -64,23 +73,23 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 		return vpcClient.CreateRouteTable(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_route_table", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_route_table", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*vpc.CreateRouteTableResponse)
 	d.SetId(response.RouteTableId)
 
-	if err := vpcService.WaitForRouteTable(d.Id(), Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForRouteTable(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAliyunRouteTableUpdate(d, meta)
+	return resourceAliyunRouteTableUpdate(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeRouteTable(d.Id())
 	if err != nil {
@@ -88,7 +97,7 @@ func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("vpc_id", object.VpcId)
 	d.Set("name", object.RouteTableName)
@@ -97,15 +106,15 @@ func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	if err := vpcService.setInstanceTags(d, TagResourceRouteTable); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAliyunRouteTableRead(d, meta)
+		return resourceAliyunRouteTableRead(ctx, d, meta)
 	}
 	request := vpc.CreateModifyRouteTableAttributesRequest()
 	request.RegionId = client.RegionId
@@ -115,7 +124,7 @@ func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = d.Id()
 
 	if d.HasChange("description") {
@@ -130,15 +139,15 @@ func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) er
 		return vpcClient.ModifyRouteTableAttributes(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAliyunRouteTableRead(d, meta)
+	return resourceAliyunRouteTableRead(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	routeTableService := VpcService{client}
 	request := vpc.CreateDeleteRouteTableRequest()
 	request.RegionId = client.RegionId
@@ -148,15 +157,15 @@ func resourceAliyunRouteTableDelete(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = d.Id()
 
 	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 		return vpcClient.DeleteRouteTable(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(routeTableService.WaitForRouteTable(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(routeTableService.WaitForRouteTable(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
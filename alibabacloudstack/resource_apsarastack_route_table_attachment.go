@@ -1,23 +1,29 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackRouteTableAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunRouteTableAttachmentCreate,
-		Read:   resourceAliyunRouteTableAttachmentRead,
-		Delete: resourceAliyunRouteTableAttachmentDelete,
+		CreateContext: resourceAliyunRouteTableAttachmentCreate,
+		ReadContext:   resourceAliyunRouteTableAttachmentRead,
+		DeleteContext: resourceAliyunRouteTableAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -34,8 +40,8 @@ func resourceAlibabacloudStackRouteTableAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunRouteTableAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunRouteTableAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateAssociateRouteTableRequest()
@@ -46,11 +52,11 @@ func resourceAliyunRouteTableAttachmentCreate(d *schema.ResourceData, meta inter
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = Trim(d.Get("route_table_id").(string))
 	request.VSwitchId = Trim(d.Get("vswitch_id").(string))
 	request.ClientToken = buildClientToken(request.GetActionName())
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.AssociateRouteTable(&args)
@@ -64,21 +70,21 @@ func resourceAliyunRouteTableAttachmentCreate(d *schema.ResourceData, meta inter
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_route_table_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_route_table_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	d.SetId(request.RouteTableId + COLON_SEPARATED + request.VSwitchId)
-	err := vpcService.WaitForRouteTableAttachment(d.Id(), Available, DefaultTimeout)
+	err := vpcService.WaitForRouteTableAttachment(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	if err := vpcService.WaitForVSwitch(request.VSwitchId, Available, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForVSwitch(request.VSwitchId, Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAliyunRouteTableAttachmentRead(d, meta)
+	return resourceAliyunRouteTableAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunRouteTableAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeRouteTableAttachment(d.Id())
 	if err != nil {
@@ -86,24 +92,24 @@ func resourceAliyunRouteTableAttachmentRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("route_table_id", object.RouteTableId)
 	d.Set("vswitch_id", parts[1])
 	return nil
 }
 
-func resourceAliyunRouteTableAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAliyunRouteTableAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := vpc.CreateUnassociateRouteTableRequest()
@@ -115,11 +121,11 @@ func resourceAliyunRouteTableAttachmentDelete(d *schema.ResourceData, meta inter
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = parts[0]
 	request.VSwitchId = parts[1]
 	request.ClientToken = buildClientToken(request.GetActionName())
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateRouteTable(&args)
@@ -135,7 +141,7 @@ func resourceAliyunRouteTableAttachmentDelete(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpcService.WaitForRouteTableAttachment(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForRouteTableAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
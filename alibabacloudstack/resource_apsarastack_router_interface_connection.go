@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,13 +17,18 @@ import (
 
 func resourceAlibabacloudStackRouterInterfaceConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouterInterfaceConnectionCreate,
-		Read:   resourceAlibabacloudStackRouterInterfaceConnectionRead,
-		Delete: resourceAlibabacloudStackRouterInterfaceConnectionDelete,
+		CreateContext: resourceAlibabacloudStackRouterInterfaceConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackRouterInterfaceConnectionRead,
+		DeleteContext: resourceAlibabacloudStackRouterInterfaceConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"interface_id": {
 				Type:     schema.TypeString,
@@ -63,32 +70,32 @@ func resourceAlibabacloudStackRouterInterfaceConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRouterInterfaceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	oppositeId := d.Get("opposite_interface_id").(string)
 	interfaceId := d.Get("interface_id").(string)
 	object, err := vpcService.DescribeRouterInterface(interfaceId, client.RegionId)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	// At present, the interface with "active/inactive" status can not be modify opposite connection information
 	// and it is RouterInterface product limitation.
 	if object.OppositeInterfaceId == oppositeId {
 		if object.Status == string(Active) {
-			return WrapError(Error("The specified router interface connection has existed, and please import it using id %s.", interfaceId))
+			return DiagnosticsFromError(WrapError(Error("The specified router interface connection has existed, and please import it using id %s.", interfaceId)))
 		}
 		if object.Status == string(Inactive) {
 			if err = vpcService.ActivateRouterInterface(interfaceId); err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			d.SetId(object.RouterInterfaceId)
-			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
-				return WrapError(err)
+			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+				return DiagnosticsFromError(WrapError(err))
 			}
-			return resourceAlibabacloudStackRouterInterfaceConnectionRead(d, meta)
+			return resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx, d, meta)
 		}
 	}
 
@@ -101,20 +108,20 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouterInterfaceId = interfaceId
 	request.OppositeInterfaceId = oppositeId
 
 	if owner_id, ok := d.GetOk("opposite_interface_owner_id"); ok && owner_id.(string) != "" {
 		request.OppositeInterfaceOwnerId = requests.Integer(owner_id.(string))
 		if v, o := d.GetOk("opposite_router_type"); !o || v.(string) == "" {
-			return WrapError(Error("'opposite_router_type' is required when 'opposite_interface_owner_id' is set."))
+			return DiagnosticsFromError(WrapError(Error("'opposite_router_type' is required when 'opposite_interface_owner_id' is set.")))
 		} else {
 			request.OppositeRouterType = v.(string)
 		}
 
 		if v, o := d.GetOk("opposite_router_id"); !o || v.(string) == "" {
-			return WrapError(Error("'opposite_router_id' is required when 'opposite_interface_owner_id' is set."))
+			return DiagnosticsFromError(WrapError(Error("'opposite_router_id' is required when 'opposite_interface_owner_id' is set.")))
 		} else {
 			request.OppositeRouterId = v.(string)
 		}
@@ -123,15 +130,15 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 		if owner == "" {
 			owner, err = client.AccountId()
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		if owner == "" {
-			return WrapError(Error("Opposite router interface owner id is empty. Please use field 'opposite_interface_owner_id' or globle field 'account_id' to set."))
+			return DiagnosticsFromError(WrapError(Error("Opposite router interface owner id is empty. Please use field 'opposite_interface_owner_id' or globle field 'account_id' to set.")))
 		}
 		oppositeRi, err := vpcService.DescribeRouterInterface(oppositeId, object.OppositeRegionId)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.OppositeRouterId = oppositeRi.RouterId
 		request.OppositeRouterType = oppositeRi.RouterType
@@ -142,13 +149,13 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 		return vpcClient.ModifyRouterInterfaceAttribute(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_router_interface_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_router_interface_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	d.SetId(interfaceId)
 
-	if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Idle, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Idle, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if object.Role == string(InitiatingSide) {
@@ -160,10 +167,10 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 			connectRequest.Scheme = "http"
 		}
 		connectRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		connectRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		connectRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		connectRequest.RouterInterfaceId = interfaceId
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.ConnectRouterInterface(connectRequest)
 			})
@@ -176,20 +183,20 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 			addDebug(connectRequest.GetActionName(), raw, connectRequest.RpcRequest, connectRequest)
 			return nil
 		}); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 
-		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
 
-	return resourceAlibabacloudStackRouterInterfaceConnectionRead(d, meta)
+	return resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeRouterInterfaceConnection(d.Id(), client.RegionId)
 
@@ -198,14 +205,14 @@ func resourceAlibabacloudStackRouterInterfaceConnectionRead(d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if object.Status == string(Inactive) {
 		if err := vpcService.ActivateRouterInterface(d.Id()); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
-		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
-			return WrapError(err)
+		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
@@ -219,8 +226,8 @@ func resourceAlibabacloudStackRouterInterfaceConnectionRead(d *schema.ResourceDa
 
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackRouterInterfaceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeRouterInterfaceConnection(d.Id(), client.RegionId)
 	if err != nil {
@@ -239,9 +246,9 @@ func resourceAlibabacloudStackRouterInterfaceConnectionDelete(d *schema.Resource
 	// and it is RouterInterface product limitation. So, the connection delete action is only modifying it to inactive.
 	if object.Status == string(Active) {
 		if err := vpcService.DeactivateRouterInterface(d.Id()); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
-	return WrapError(vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Inactive, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Inactive, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
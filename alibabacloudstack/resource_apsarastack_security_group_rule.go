@@ -1,11 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSecurityGroupRuleCreate,
-		Read:   resourceAlibabacloudStackSecurityGroupRuleRead,
-		Update: resourceAlibabacloudStackSecurityGroupRuleUpdate,
-		Delete: resourceAlibabacloudStackSecurityGroupRuleDelete,
+		CreateContext: resourceAlibabacloudStackSecurityGroupRuleCreate,
+		ReadContext:   resourceAlibabacloudStackSecurityGroupRuleRead,
+		UpdateContext: resourceAlibabacloudStackSecurityGroupRuleUpdate,
+		DeleteContext: resourceAlibabacloudStackSecurityGroupRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -113,15 +114,15 @@ func resourceAlibabacloudStackSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	direction := d.Get("type").(string)
 	sgId := d.Get("security_group_id").(string)
 	ptl := d.Get("ip_protocol").(string)
 	port := d.Get("port_range").(string)
 	if port == "" {
-		return WrapError(fmt.Errorf("'port_range': required field is not set or invalid."))
+		return DiagnosticsFromError(WrapError(fmt.Errorf("'port_range': required field is not set or invalid.")))
 	}
 	nicType := d.Get("nic_type").(string)
 	policy := d.Get("policy").(string)
@@ -129,17 +130,12 @@ func resourceAlibabacloudStackSecurityGroupRuleCreate(d *schema.ResourceData, me
 
 	if _, ok := d.GetOk("cidr_ip"); !ok {
 		if _, ok := d.GetOk("source_security_group_id"); !ok {
-			return WrapError(fmt.Errorf("Either 'cidr_ip' or 'source_security_group_id' must be specified."))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("Either 'cidr_ip' or 'source_security_group_id' must be specified.")))
 		}
 	}
 	request, err := buildAlibabacloudStackSGRuleRequest(d, meta)
 	if err != nil {
-		return WrapError(err)
-	}
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	var cidr_ip string
@@ -160,17 +156,17 @@ func resourceAlibabacloudStackSecurityGroupRuleCreate(d *schema.ResourceData, me
 		})
 	}
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_security_group_rule", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_security_group_rule", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(sgId + ":" + direction + ":" + ptl + ":" + port + ":" + nicType + ":" + cidr_ip + ":" + policy + ":" + strconv.Itoa(priority))
 
-	return resourceAlibabacloudStackSecurityGroupRuleRead(d, meta)
+	return resourceAlibabacloudStackSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	parts := strings.Split(d.Id(), ":")
 	policy := parseSecurityRuleId(d, meta, 6)
@@ -183,7 +179,7 @@ func resourceAlibabacloudStackSecurityGroupRuleRead(d *schema.ResourceData, meta
 	} else {
 		prior, err := strconv.Atoi(strPriority)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		priority = prior
 	}
@@ -196,7 +192,7 @@ func resourceAlibabacloudStackSecurityGroupRuleRead(d *schema.ResourceData, meta
 			d.SetId("")
 			//return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("type", object.Direction)
@@ -206,7 +202,7 @@ func resourceAlibabacloudStackSecurityGroupRuleRead(d *schema.ResourceData, meta
 	d.Set("port_range", object.PortRange)
 	d.Set("description", object.Description)
 	if pri, err := strconv.Atoi(object.Priority); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		d.Set("priority", pri)
 	}
@@ -224,8 +220,8 @@ func resourceAlibabacloudStackSecurityGroupRuleRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	policy := parseSecurityRuleId(d, meta, 6)
 	strPriority := parseSecurityRuleId(d, meta, 7)
@@ -237,19 +233,14 @@ func resourceAlibabacloudStackSecurityGroupRuleUpdate(d *schema.ResourceData, me
 	} else {
 		prior, err := strconv.Atoi(strPriority)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		priority = prior
 	}
 
 	request, err := buildAlibabacloudStackSGRuleRequest(d, meta)
 	if err != nil {
-		return WrapError(err)
-	}
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	direction := d.Get("type").(string)
@@ -271,11 +262,11 @@ func resourceAlibabacloudStackSecurityGroupRuleUpdate(d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	addDebug(request.GetActionName(), raw, request.Headers, request)
-	return resourceAlibabacloudStackSecurityGroupRuleRead(d, meta)
+	return resourceAlibabacloudStackSecurityGroupRuleRead(ctx, d, meta)
 }
 
 func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
@@ -304,7 +295,7 @@ func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policy := parseSecurityRuleId(d, meta, 6)
 	strPriority := parseSecurityRuleId(d, meta, 7)
 	var priority int
@@ -315,12 +306,12 @@ func resourceAlibabacloudStackSecurityGroupRuleDelete(d *schema.ResourceData, me
 	} else {
 		prior, err := strconv.Atoi(strPriority)
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		priority = prior
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := deleteSecurityGroupRule(d, meta)
 		if err != nil {
 			if NotFoundError(err) || IsExpectedErrors(err, []string{"InvalidSecurityGroupId.NotFound"}) {
@@ -331,7 +322,7 @@ func resourceAlibabacloudStackSecurityGroupRuleDelete(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	return nil
 }
@@ -339,20 +330,7 @@ func resourceAlibabacloudStackSecurityGroupRuleDelete(d *schema.ResourceData, me
 func buildAlibabacloudStackSGRuleRequest(d *schema.ResourceData, meta interface{}) (*requests.CommonRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
-	// Get product code from the built request
-	ruleReq := ecs.CreateModifySecurityGroupRuleRequest()
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		ruleReq.Scheme = "https"
-	} else {
-		ruleReq.Scheme = "http"
-	}
-
-	request, err := client.NewCommonRequest(ruleReq.GetProduct(), ruleReq.GetLocationServiceCode(), client.Config.Protocol, connectivity.ApiVersion20140526)
-	if err != nil {
-		return request, WrapError(err)
-	}
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request := client.NewCommonRequest("POST", "Ecs", string(connectivity.ApiVersion20140526), "", "")
 
 	direction := d.Get("type").(string)
 
@@ -1,9 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -14,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackSlb() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbCreate,
-		Read:   resourceAlibabacloudStackSlbRead,
-		Update: resourceAlibabacloudStackSlbUpdate,
-		Delete: resourceAlibabacloudStackSlbDelete,
+		CreateContext: resourceAlibabacloudStackSlbCreate,
+		ReadContext:   resourceAlibabacloudStackSlbRead,
+		UpdateContext: resourceAlibabacloudStackSlbUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -65,8 +74,8 @@ func resourceAlibabacloudStackSlb() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	request := slb.CreateCreateLoadBalancerRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -76,7 +85,7 @@ func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerName = d.Get("name").(string)
 	request.AddressType = strings.ToLower(string(Intranet))
 	request.ClientToken = buildClientToken(request.GetActionName())
@@ -109,24 +118,24 @@ func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}); err != nil {
 		if IsExpectedErrors(err, []string{"OrderFailed"}) {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.CreateLoadBalancerResponse)
 	d.SetId(response.LoadBalancerId)
 
-	if err := slbService.WaitForSlb(response.LoadBalancerId, Active, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := slbService.WaitForSlb(response.LoadBalancerId, Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackSlbUpdate(d, meta)
+	return resourceAlibabacloudStackSlbUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	object, err := slbService.DescribeSlb(d.Id())
 	if err != nil {
@@ -134,7 +143,7 @@ func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.LoadBalancerName)
@@ -146,27 +155,27 @@ func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{})
 	tags, _ := slbService.DescribeTags(d.Id(), nil, TagResourceInstance)
 	if len(tags) > 0 {
 		if err := d.Set("tags", slbService.tagsToMap(tags)); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	d.Partial(true)
 
 	// set instance tags
 
 	if err := slbService.setInstanceTags(d, TagResourceInstance); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSlbRead(d, meta)
+		return resourceAlibabacloudStackSlbRead(ctx, d, meta)
 	}
 
 	if d.HasChange("specification") {
@@ -178,7 +187,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.LoadBalancerId = d.Id()
 		request.LoadBalancerSpec = d.Get("specification").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -199,7 +208,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.LoadBalancerId = d.Id()
 		request.LoadBalancerName = d.Get("name").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -219,7 +228,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		modifyLoadBalancerInternetSpecRequest.Scheme = "http"
 	}
 	modifyLoadBalancerInternetSpecRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	modifyLoadBalancerInternetSpecRequest.RegionId = client.RegionId
 	modifyLoadBalancerInternetSpecRequest.LoadBalancerId = d.Id()
 	if update {
@@ -227,7 +236,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 			return slbClient.ModifyLoadBalancerInternetSpec(modifyLoadBalancerInternetSpecRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyLoadBalancerInternetSpecRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyLoadBalancerInternetSpecRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(modifyLoadBalancerInternetSpecRequest.GetActionName(), raw, modifyLoadBalancerInternetSpecRequest.RpcRequest, modifyLoadBalancerInternetSpecRequest)
 	}
@@ -241,7 +250,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		modifyLoadBalancerPayTypeRequest.Scheme = "http"
 	}
 	modifyLoadBalancerInternetSpecRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	modifyLoadBalancerPayTypeRequest.LoadBalancerId = d.Id()
 
 	if update {
@@ -249,17 +258,17 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 			return slbClient.ModifyLoadBalancerPayType(modifyLoadBalancerPayTypeRequest)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyLoadBalancerPayTypeRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), modifyLoadBalancerPayTypeRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(modifyLoadBalancerPayTypeRequest.GetActionName(), raw, modifyLoadBalancerPayTypeRequest.RpcRequest, modifyLoadBalancerPayTypeRequest)
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbRead(d, meta)
+	return resourceAlibabacloudStackSlbRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	request := slb.CreateDeleteLoadBalancerRequest()
@@ -270,7 +279,7 @@ func resourceAlibabacloudStackSlbDelete(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Id()
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -280,9 +289,9 @@ func resourceAlibabacloudStackSlbDelete(d *schema.ResourceData, meta interface{}
 		if IsExpectedErrors(err, []string{"InvalidLoadBalancerId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(slbService.WaitForSlb(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlb(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"time"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackSlbAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbAclCreate,
-		Read:   resourceAlibabacloudStackSlbAclRead,
-		Update: resourceAlibabacloudStackSlbAclUpdate,
-		Delete: resourceAlibabacloudStackSlbAclDelete,
+		CreateContext: resourceAlibabacloudStackSlbAclCreate,
+		ReadContext:   resourceAlibabacloudStackSlbAclRead,
+		UpdateContext: resourceAlibabacloudStackSlbAclUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbAclDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -58,8 +66,8 @@ func resourceAlibabacloudStackSlbAcl() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbAclCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateCreateAccessControlListRequest()
 	request.RegionId = client.RegionId
@@ -69,34 +77,33 @@ func resourceAlibabacloudStackSlbAclCreate(d *schema.ResourceData, meta interfac
 		request.Scheme = "http"
 	}
 	request.Headers["RegionId"] = client.RegionId
-	request.QueryParams["AccessKeySecret"] = client.SecretKey
 	request.QueryParams["Product"] = "Slb"
 	request.AclName = strings.TrimSpace(d.Get("name").(string))
 	request.AddressIPVersion = d.Get("ip_version").(string)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.CreateAccessControlList(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_acl", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_acl", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.CreateAccessControlListResponse)
 
 	d.SetId(response.AclId)
-	return resourceAlibabacloudStackSlbAclUpdate(d, meta)
+	return resourceAlibabacloudStackSlbAclUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbAclRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	tags, err := slbService.DescribeTags(d.Id(), nil, TagResourceAcl)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("tags", slbService.tagsToMap(tags))
 
@@ -106,19 +113,19 @@ func resourceAlibabacloudStackSlbAclRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("name", object.AclName)
 	d.Set("ip_version", object.AddressIPVersion)
 
 	if err := d.Set("entry_list", slbService.FlattenSlbAclEntryMappings(object.AclEntrys.AclEntry)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	d.Partial(true)
@@ -136,14 +143,14 @@ func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interfac
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.AclId = d.Id()
 		request.AclName = d.Get("name").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.SetAccessControlListAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("name")
@@ -158,13 +165,13 @@ func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interfac
 
 		if len(remove) > 0 {
 			if err := slbService.SlbRemoveAccessControlListEntry(remove, d.Id()); err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 
 		if len(add) > 0 {
 			if err := slbService.SlbAddAccessControlListEntry(add, d.Id()); err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 		}
 		//d.SetPartial("entry_list")
@@ -172,11 +179,11 @@ func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interfac
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbAclRead(d, meta)
+	return resourceAlibabacloudStackSlbAclRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbAclDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	request := slb.CreateDeleteAccessControlListRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -186,9 +193,9 @@ func resourceAlibabacloudStackSlbAclDelete(d *schema.ResourceData, meta interfac
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AclId = d.Id()
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteAccessControlList(request)
 		})
@@ -205,8 +212,8 @@ func resourceAlibabacloudStackSlbAclDelete(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		if !IsExpectedErrors(err, []string{"AclNotExist"}) {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 	}
-	return WrapError(slbService.WaitForSlbAcl(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbAcl(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,10 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -15,10 +16,10 @@ import (
 
 func resourceAlibabacloudStackSlbBackendServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbBackendServersCreate,
-		Read:   resourceAlibabacloudStackSlbBackendServersRead,
-		Update: resourceAlibabacloudStackSlbBackendServersUpdate,
-		Delete: resourceAlibabacloudStackSlbBackendServersDelete,
+		CreateContext: resourceAlibabacloudStackSlbBackendServersCreate,
+		ReadContext:   resourceAlibabacloudStackSlbBackendServersRead,
+		UpdateContext: resourceAlibabacloudStackSlbBackendServersUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbBackendServersDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -57,8 +58,8 @@ func resourceAlibabacloudStackSlbBackendServer() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbBackendServersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := slb.CreateAddBackendServersRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -67,7 +68,7 @@ func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	if v, ok := d.GetOk("backend_servers"); ok {
 		request.BackendServers = expandBackendServersInfoToString(v.(*schema.Set).List())
@@ -76,18 +77,18 @@ func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, me
 		return slbClient.AddBackendServers(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_backend_servers", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_backend_servers", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.AddBackendServersResponse)
 	d.SetId(response.LoadBalancerId)
 
-	return resourceAlibabacloudStackSlbBackendServersRead(d, meta)
+	return resourceAlibabacloudStackSlbBackendServersRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	resource_id := d.Id()
 	object, err := slbService.DescribeSlb(resource_id)
@@ -97,7 +98,7 @@ func resourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("load_balancer_id", object.LoadBalancerId)
@@ -113,14 +114,14 @@ func resourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta
 	}
 
 	if err := d.Set("backend_servers", servers); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbBackendServersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	d.Partial(true)
 	step := 20
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = d.Id()
 
 			segs := len(rmservers)/step + 1
@@ -174,7 +175,7 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 					return slbClient.RemoveBackendServers(request)
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 				//d.SetPartial("backend_servers")
@@ -202,7 +203,7 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = d.Id()
 
 			segs := len(addservers)/step + 1
@@ -217,7 +218,7 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 					return slbClient.AddBackendServers(request)
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 				//d.SetPartial("backend_servers")
@@ -253,14 +254,14 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 					request.Scheme = "http"
 				}
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				request.LoadBalancerId = d.Id()
 				request.BackendServers = expandBackendServersInfoToString(servers[start:end])
 				raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 					return slbClient.SetBackendServers(request)
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 				//d.SetPartial("backend_servers")
@@ -269,11 +270,11 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbBackendServersRead(d, meta)
+	return resourceAlibabacloudStackSlbBackendServersRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbBackendServersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	instanceSet := d.Get("backend_servers").(*schema.Set)
 	step := 20
 	if len(instanceSet.List()) > 0 {
@@ -285,10 +286,10 @@ func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, me
 				if NotFoundError(err) {
 					return nil
 				}
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			if lbInstance.DeleteProtection == "on" {
-				return WrapError(fmt.Errorf("Current backend servers' SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the resource.", d.Id()))
+				return DiagnosticsFromError(WrapError(fmt.Errorf("Current backend servers' SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the resource.", d.Id())))
 			}
 		}
 
@@ -310,7 +311,7 @@ func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, me
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.LoadBalancerId = d.Id()
 
 		segs := len(servers)/step + 1
@@ -322,7 +323,7 @@ func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, me
 			}
 
 			request.BackendServers = expandBackendServersWithoutTypeToString(servers[start:end])
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 				raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 					return slbClient.RemoveBackendServers(request)
 				})
@@ -336,7 +337,7 @@ func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, me
 				return nil
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 		}
 	}
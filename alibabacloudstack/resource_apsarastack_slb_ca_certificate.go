@@ -1,25 +1,33 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackSlbCACertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbCACertificateCreate,
-		Read:   resourceAlibabacloudStackSlbCACertificateRead,
-		Update: resourceAlibabacloudStackSlbCACertificateUpdate,
-		Delete: resourceAlibabacloudStackSlbCACertificateDelete,
+		CreateContext: resourceAlibabacloudStackSlbCACertificateCreate,
+		ReadContext:   resourceAlibabacloudStackSlbCACertificateRead,
+		UpdateContext: resourceAlibabacloudStackSlbCACertificateUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbCACertificateDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -34,8 +42,8 @@ func resourceAlibabacloudStackSlbCACertificate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	request := slb.CreateUploadCACertificateRequest()
@@ -46,7 +54,7 @@ func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if val, ok := d.GetOk("name"); ok && val.(string) != "" {
 		request.CACertificateName = val.(string)
@@ -55,26 +63,26 @@ func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, met
 	if val, ok := d.GetOk("ca_certificate"); ok && val.(string) != "" {
 		request.CACertificate = val.(string)
 	} else {
-		return WrapError(Error("UploadCACertificate got an error, ca_certificate should be not null"))
+		return DiagnosticsFromError(WrapError(Error("UploadCACertificate got an error, ca_certificate should be not null")))
 	}
 
 	raw, err := slbService.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.UploadCACertificate(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_ca_certificate", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_ca_certificate", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response := raw.(*slb.UploadCACertificateResponse)
 
 	d.SetId(response.CACertificateId)
 
-	return resourceAlibabacloudStackSlbCACertificateUpdate(d, meta)
+	return resourceAlibabacloudStackSlbCACertificateUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbCACertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	object, err := slbService.DescribeSlbCACertificate(d.Id())
@@ -83,19 +91,19 @@ func resourceAlibabacloudStackSlbCACertificateRead(d *schema.ResourceData, meta
 			d.SetId("")
 			//return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	err = d.Set("name", object.CACertificateName)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackSlbCACertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbCACertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if d.HasChange("name") {
 		request := slb.CreateSetCACertificateNameRequest()
 		request.RegionId = client.RegionId
@@ -105,23 +113,23 @@ func resourceAlibabacloudStackSlbCACertificateUpdate(d *schema.ResourceData, met
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.CACertificateId = d.Id()
 		request.CACertificateName = d.Get("name").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.SetCACertificateName(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackSlbCACertificateRead(d, meta)
+	return resourceAlibabacloudStackSlbCACertificateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbCACertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	request := slb.CreateDeleteCACertificateRequest()
@@ -132,10 +140,10 @@ func resourceAlibabacloudStackSlbCACertificateDelete(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.CACertificateId = d.Id()
 
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteCACertificate(request)
 		})
@@ -152,8 +160,8 @@ func resourceAlibabacloudStackSlbCACertificateDelete(d *schema.ResourceData, met
 		if IsExpectedErrors(err, []string{"CACertificateId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(slbService.WaitForSlbCACertificate(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbCACertificate(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
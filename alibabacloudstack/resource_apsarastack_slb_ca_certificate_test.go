@@ -38,7 +38,7 @@ func testSweepSlbCACertificate(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeCACertificates(req)
@@ -67,7 +67,7 @@ func testSweepSlbCACertificate(region string) error {
 		log.Printf("[INFO] Deleting Slb CA Certificate : %s (%s)", name, id)
 		req := slb.CreateDeleteCACertificateRequest()
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.CACertificateId = id
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			req.Scheme = "https"
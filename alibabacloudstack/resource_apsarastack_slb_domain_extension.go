@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,14 +18,19 @@ import (
 
 func resourceAlibabacloudStackSlbDomainExtension() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbDomainExtensionCreate,
-		Read:   resourceAlibabacloudStackSlbDomainExtensionRead,
-		Update: resourceAlibabacloudStackSlbDomainExtensionUpdate,
-		Delete: resourceAlibabacloudStackSlbDomainExtensionDelete,
+		CreateContext: resourceAlibabacloudStackSlbDomainExtensionCreate,
+		ReadContext:   resourceAlibabacloudStackSlbDomainExtensionRead,
+		UpdateContext: resourceAlibabacloudStackSlbDomainExtensionUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbDomainExtensionDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -54,8 +61,8 @@ func resourceAlibabacloudStackSlbDomainExtension() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbDomainExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateCreateDomainExtensionRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -64,14 +71,14 @@ func resourceAlibabacloudStackSlbDomainExtensionCreate(d *schema.ResourceData, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	request.ListenerPort = requests.NewInteger(d.Get("frontend_port").(int))
 	request.Domain = d.Get("domain").(string)
 	request.ServerCertificateId = d.Get("server_certificate_id").(string)
 
 	var response *slb.CreateDomainExtensionResponse
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.CreateDomainExtension(request)
 		})
@@ -86,16 +93,16 @@ func resourceAlibabacloudStackSlbDomainExtensionCreate(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_domain_extension", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_domain_extension", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(response.DomainExtensionId)
-	return resourceAlibabacloudStackSlbDomainExtensionRead(d, meta)
+	return resourceAlibabacloudStackSlbDomainExtensionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbDomainExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	domainExtension, err := slbService.DescribeDomainExtensionAttribute(d.Id())
@@ -104,7 +111,7 @@ func resourceAlibabacloudStackSlbDomainExtensionRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("id", domainExtension.DomainExtensionId)
@@ -116,12 +123,12 @@ func resourceAlibabacloudStackSlbDomainExtensionRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbDomainExtensionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if d.HasChange("server_certificate_id") {
 		request := slb.CreateSetDomainExtensionAttributeRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DomainExtensionId = d.Id()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -129,8 +136,8 @@ func resourceAlibabacloudStackSlbDomainExtensionUpdate(d *schema.ResourceData, m
 			request.Scheme = "http"
 		}
 		request.ServerCertificateId = d.Get("server_certificate_id").(string)
-		client := meta.(*connectivity.AlibabacloudStackClient)
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 				return slbClient.SetDomainExtensionAttribute(request)
 			})
@@ -144,15 +151,15 @@ func resourceAlibabacloudStackSlbDomainExtensionUpdate(d *schema.ResourceData, m
 			return nil
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_domain_extension", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_domain_extension", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("server_certificate_id")
 	}
-	return resourceAlibabacloudStackSlbDomainExtensionRead(d, meta)
+	return resourceAlibabacloudStackSlbDomainExtensionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbDomainExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	if d.Get("delete_protection_validation").(bool) {
@@ -162,10 +169,10 @@ func resourceAlibabacloudStackSlbDomainExtensionDelete(d *schema.ResourceData, m
 			if NotFoundError(err) {
 				return nil
 			}
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if lbInstance.DeleteProtection == "on" {
-			return WrapError(fmt.Errorf("Current domain extension's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the resource.", lbId))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("Current domain extension's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the resource.", lbId)))
 		}
 	}
 
@@ -177,9 +184,9 @@ func resourceAlibabacloudStackSlbDomainExtensionDelete(d *schema.ResourceData, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteDomainExtension(request)
 		})
@@ -196,8 +203,8 @@ func resourceAlibabacloudStackSlbDomainExtensionDelete(d *schema.ResourceData, m
 		if IsExpectedErrors(err, []string{"InvalidParameter.DomainExtensionId"}) {
 			return nil
 		}
-		return WrapErrorf(err, DataDefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DataDefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 
 	}
-	return WrapError(slbService.WaitForSlbDomainExtension(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbDomainExtension(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -18,14 +20,20 @@ import (
 
 func resourceAlibabacloudStackSlbListener() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbListenerCreate,
-		Read:   resourceAlibabacloudStackSlbListenerRead,
-		Update: resourceAlibabacloudStackSlbListenerUpdate,
-		Delete: resourceAlibabacloudStackSlbListenerDelete,
+		CreateContext: resourceAlibabacloudStackSlbListenerCreate,
+		ReadContext:   resourceAlibabacloudStackSlbListenerRead,
+		UpdateContext: resourceAlibabacloudStackSlbListenerUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbListenerDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -295,9 +303,9 @@ func resourceAlibabacloudStackSlbListener() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	httpForward := false
 	protocol := d.Get("protocol").(string)
@@ -313,7 +321,7 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request.ApiName = fmt.Sprintf("CreateLoadBalancer%sListener", strings.ToUpper(protocol))
 
@@ -321,13 +329,13 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		if httpForward {
 			reqHttp, err := buildHttpForwardArgs(d, request)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request = reqHttp
 		} else {
 			reqHttp, err := buildHttpListenerArgs(d, request)
 			if err != nil {
-				return WrapError(err)
+				return DiagnosticsFromError(WrapError(err))
 			}
 			request = reqHttp
 		}
@@ -339,7 +347,7 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		if Protocol(protocol) == Https {
 			scId := d.Get("server_certificate_id").(string)
 			if scId == "" {
-				return WrapError(Error(`'server_certificate_id': required field is not set when the protocol is 'https'.`))
+				return DiagnosticsFromError(WrapError(Error(`'server_certificate_id': required field is not set when the protocol is 'https'.`)))
 			}
 			request.QueryParams["ServerCertificateId"] = scId
 		}
@@ -348,13 +356,13 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		return slbClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_listener", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_listener", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request, request.QueryParams)
 	d.SetId(lb_id + ":" + protocol + ":" + strconv.Itoa(frontend))
 
-	if err := slbService.WaitForSlbListener(d.Id(), Stopped, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := slbService.WaitForSlbListener(d.Id(), Stopped, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	startLoadBalancerListenerRequest := slb.CreateStartLoadBalancerListenerRequest()
@@ -365,12 +373,12 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		startLoadBalancerListenerRequest.Scheme = "http"
 	}
 	startLoadBalancerListenerRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	startLoadBalancerListenerRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	startLoadBalancerListenerRequest.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	startLoadBalancerListenerRequest.LoadBalancerId = lb_id
 	startLoadBalancerListenerRequest.ListenerPort = requests.NewInteger(frontend)
 	startLoadBalancerListenerRequest.ListenerProtocol = protocol
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.StartLoadBalancerListener(startLoadBalancerListenerRequest)
 		})
@@ -385,21 +393,21 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_listener", startLoadBalancerListenerRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_listener", startLoadBalancerListenerRequest.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	if err = slbService.WaitForSlbListener(d.Id(), Running, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err = slbService.WaitForSlbListener(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if httpForward {
-		return resourceAlibabacloudStackSlbListenerRead(d, meta)
+		return resourceAlibabacloudStackSlbListenerRead(ctx, d, meta)
 	}
-	return resourceAlibabacloudStackSlbListenerUpdate(d, meta)
+	return resourceAlibabacloudStackSlbListenerUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	lb_id, protocol, port, err := parseListenerId(d, meta)
@@ -408,14 +416,14 @@ func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("protocol", protocol)
 	d.Set("load_balancer_id", lb_id)
 	d.Set("frontend_port", port)
 	d.SetId(lb_id + ":" + protocol + ":" + strconv.Itoa(port))
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return DiagnosticsFromError(resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		object, err := slbService.DescribeSlbListener(d.Id())
 		if err != nil {
 			if NotFoundError(err) {
@@ -434,20 +442,20 @@ func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 		}
 		return nil
-	})
+	}))
 }
 
-func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	proto := d.Get("protocol").(string)
 	lb_id := d.Get("load_balancer_id").(string)
 	frontend := d.Get("frontend_port").(int)
 	d.SetId(lb_id + ":" + proto + ":" + strconv.Itoa(frontend))
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	protocol := Protocol(d.Get("protocol").(string))
 	commonRequest, err := buildListenerCommonArgs(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	commonRequest.ApiName = fmt.Sprintf("SetLoadBalancer%sListenerAttribute", strings.ToUpper(string(protocol)))
 
@@ -504,7 +512,7 @@ func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta int
 
 	httpArgs, err := buildHttpListenerArgs(d, commonRequest)
 	if (protocol == Https || protocol == Http) && err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	// http https
 	if d.HasChange("sticky_session") {
@@ -626,7 +634,7 @@ func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta int
 	if protocol == Https {
 		scId := d.Get("server_certificate_id").(string)
 		if scId == "" {
-			return WrapError(Error("'server_certificate_id': required field is not set when the protocol is 'https'."))
+			return DiagnosticsFromError(WrapError(Error("'server_certificate_id': required field is not set when the protocol is 'https'.")))
 		}
 
 		httpsArgs.QueryParams["ServerCertificateId"] = scId
@@ -649,18 +657,18 @@ func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta int
 			return slbClient.ProcessCommonRequest(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request, request.QueryParams)
 	}
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbListenerRead(d, meta)
+	return resourceAlibabacloudStackSlbListenerRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	lbId, protocol, port, err := parseListenerId(d, meta)
@@ -669,7 +677,7 @@ func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if d.Get("delete_protection_validation").(bool) {
 		lbInstance, err := slbService.DescribeSlb(lbId)
@@ -677,10 +685,10 @@ func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta int
 			if NotFoundError(err) {
 				return nil
 			}
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if lbInstance.DeleteProtection == "on" {
-			return WrapError(fmt.Errorf("Current listener's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the listener resource.", lbId))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("Current listener's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the listener resource.", lbId)))
 		}
 	}
 	request := slb.CreateDeleteLoadBalancerListenerRequest()
@@ -691,11 +699,11 @@ func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = lbId
 	request.ListenerPort = requests.NewInteger(port)
 	request.ListenerProtocol = protocol
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteLoadBalancerListener(request)
 		})
@@ -710,9 +718,9 @@ func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(slbService.WaitForSlbListener(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbListener(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildListenerCommonArgs(d *schema.ResourceData, meta interface{}) (*requests.CommonRequest, error) {
@@ -723,10 +731,6 @@ func buildListenerCommonArgs(d *schema.ResourceData, meta interface{}) (*request
 	if err != nil {
 		return request, WrapError(err)
 	}
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-
 	request.QueryParams["LoadBalancerId"] = d.Get("load_balancer_id").(string)
 	request.QueryParams["ListenerPort"] = string(requests.NewInteger(d.Get("frontend_port").(int)))
 	if backendServerPort, ok := d.GetOk("backend_port"); ok {
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -15,14 +17,20 @@ import (
 
 func resourceAlibabacloudStackSlbMasterSlaveServerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate,
-		Read:   resourceAlibabacloudStackSlbMasterSlaveServerGroupRead,
-		Update: resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate,
-		Delete: resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete,
+		CreateContext: resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate,
+		ReadContext:   resourceAlibabacloudStackSlbMasterSlaveServerGroupRead,
+		UpdateContext: resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -75,8 +83,8 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := slb.CreateCreateMasterSlaveServerGroupRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -85,7 +93,7 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.Resource
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	if v, ok := d.GetOk("name"); ok {
 		request.MasterSlaveServerGroupName = v.(string)
@@ -97,18 +105,18 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.Resource
 		return slbClient.CreateMasterSlaveServerGroup(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_master_slave_server_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_master_slave_server_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.CreateMasterSlaveServerGroupResponse)
 	d.SetId(response.MasterSlaveServerGroupId)
 
-	return resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d, meta)
+	return resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	object, err := slbService.DescribeSlbMasterSlaveServerGroup(d.Id())
 
@@ -117,7 +125,7 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.MasterSlaveServerGroupName)
@@ -136,18 +144,18 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d *schema.ResourceDa
 	}
 
 	if err := d.Set("servers", servers); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	if d.Get("delete_protection_validation").(bool) {
@@ -157,10 +165,10 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.Resource
 			if NotFoundError(err) {
 				return nil
 			}
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if lbInstance.DeleteProtection == "on" {
-			return WrapError(fmt.Errorf("Current master-slave server group's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the resource.", lbId))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("Current master-slave server group's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the resource.", lbId)))
 		}
 	}
 
@@ -172,10 +180,10 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.Resource
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.MasterSlaveServerGroupId = d.Id()
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteMasterSlaveServerGroup(request)
 		})
@@ -192,8 +200,8 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.Resource
 		if IsExpectedErrors(err, []string{"The specified MasterSlaveGroupId does not exist", "InvalidParameter"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(slbService.WaitForSlbMasterSlaveServerGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbMasterSlaveServerGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"strconv"
@@ -18,14 +20,20 @@ import (
 
 func resourceAlibabacloudStackSlbRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbRuleCreate,
-		Read:   resourceAlibabacloudStackSlbRuleRead,
-		Update: resourceAlibabacloudStackSlbRuleUpdate,
-		Delete: resourceAlibabacloudStackSlbRuleDelete,
+		CreateContext: resourceAlibabacloudStackSlbRuleCreate,
+		ReadContext:   resourceAlibabacloudStackSlbRuleRead,
+		UpdateContext: resourceAlibabacloudStackSlbRuleUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -177,9 +185,9 @@ func resourceAlibabacloudStackSlbRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slb_id := d.Get("load_balancer_id").(string)
 	port := d.Get("frontend_port").(int)
 	name := strings.Trim(d.Get("name").(string), " ")
@@ -194,7 +202,7 @@ func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interfa
 	}
 
 	if domain == "" && url == "" {
-		return WrapError(Error("At least one 'domain' or 'url' must be set."))
+		return DiagnosticsFromError(WrapError(Error("At least one 'domain' or 'url' must be set.")))
 	} else if domain == "" {
 		rule = fmt.Sprintf("[{'RuleName':'%s','Url':'%s','VServerGroupId':'%s'}]", name, url, group_id)
 	} else if url == "" {
@@ -211,13 +219,13 @@ func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = slb_id
 	request.ListenerPort = requests.NewInteger(port)
 	request.RuleList = rule
 	var raw interface{}
 	var err error
-	if err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.CreateRules(request)
 		})
@@ -230,18 +238,18 @@ func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interfa
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_rule", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_rule", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	response, _ := raw.(*slb.CreateRulesResponse)
 	d.SetId(response.Rules.Rule[0].RuleId)
 
-	return resourceAlibabacloudStackSlbRuleUpdate(d, meta)
+	return resourceAlibabacloudStackSlbRuleUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	object, err := slbService.DescribeSlbRule(d.Id())
 
@@ -250,13 +258,13 @@ func resourceAlibabacloudStackSlbRuleRead(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.RuleName)
 	d.Set("load_balancer_id", object.LoadBalancerId)
 	if port, err := strconv.Atoi(object.ListenerPort); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	} else {
 		d.Set("frontend_port", port)
 	}
@@ -281,8 +289,8 @@ func resourceAlibabacloudStackSlbRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	update := false
 	fullUpdate := false
 	request := slb.CreateSetRuleRequest()
@@ -292,22 +300,22 @@ func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RuleId = d.Id()
 	if listenerSync, ok := d.GetOk("listener_sync"); ok && listenerSync == string(OffFlag) {
 		if stickySession := d.Get("sticky_session"); stickySession == string(OnFlag) {
 			if _, ok := d.GetOk("sticky_session_type"); !ok {
-				return WrapError(Error(`'sticky_session_type': required field is not set when the sticky_session is 'on'.`))
+				return DiagnosticsFromError(WrapError(Error(`'sticky_session_type': required field is not set when the sticky_session is 'on'.`)))
 			}
 		}
 		if stickySessionType := d.Get("sticky_session_type"); stickySessionType == string(InsertStickySessionType) {
 			if _, ok := d.GetOk("cookie_timeout"); !ok {
-				return WrapError(Error(`'cookie_timeout': required field is not set when the sticky_session_type is 'insert'.`))
+				return DiagnosticsFromError(WrapError(Error(`'cookie_timeout': required field is not set when the sticky_session_type is 'insert'.`)))
 			}
 		}
 		if stickySessionType := d.Get("sticky_session_type"); stickySessionType == string(ServerStickySessionType) {
 			if _, ok := d.GetOk("cookie"); !ok {
-				return WrapError(Error(`'cookie': required field is not set when the sticky_session_type is 'server'.`))
+				return DiagnosticsFromError(WrapError(Error(`'cookie': required field is not set when the sticky_session_type is 'server'.`)))
 			}
 		}
 	}
@@ -358,22 +366,22 @@ func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interfa
 
 	}
 	if update || fullUpdate {
-		client := meta.(*connectivity.AlibabacloudStackClient)
+		client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 		request.RegionId = client.RegionId
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.SetRule(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackSlbRuleRead(d, meta)
+	return resourceAlibabacloudStackSlbRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	if d.Get("delete_protection_validation").(bool) {
@@ -383,10 +391,10 @@ func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interfa
 			if NotFoundError(err) {
 				return nil
 			}
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if lbInstance.DeleteProtection == "on" {
-			return WrapError(fmt.Errorf("Current rule's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the rule.", lbId))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("Current rule's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the rule.", lbId)))
 		}
 	}
 
@@ -398,10 +406,10 @@ func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interfa
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RuleIds = fmt.Sprintf("['%s']", d.Id())
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteRules(request)
 		})
@@ -419,8 +427,8 @@ func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interfa
 		if IsExpectedErrors(err, []string{"InvalidRuleId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(slbService.WaitForSlbRule(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbRule(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 
 }
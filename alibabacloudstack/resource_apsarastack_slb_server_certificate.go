@@ -1,25 +1,33 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackSlbServerCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbServerCertificateCreate,
-		Read:   resourceAlibabacloudStackSlbServerCertificateRead,
-		Update: resourceAlibabacloudStackSlbServerCertificateUpdate,
-		Delete: resourceAlibabacloudStackSlbServerCertificateDelete,
+		CreateContext: resourceAlibabacloudStackSlbServerCertificateCreate,
+		ReadContext:   resourceAlibabacloudStackSlbServerCertificateRead,
+		UpdateContext: resourceAlibabacloudStackSlbServerCertificateUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbServerCertificateDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -39,8 +47,8 @@ func resourceAlibabacloudStackSlbServerCertificate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbServerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbServerCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := slb.CreateUploadServerCertificateRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -50,7 +58,7 @@ func resourceAlibabacloudStackSlbServerCertificateCreate(d *schema.ResourceData,
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if val, ok := d.GetOk("name"); ok && val != "" {
 		request.ServerCertificateName = val.(string)
@@ -66,32 +74,32 @@ func resourceAlibabacloudStackSlbServerCertificateCreate(d *schema.ResourceData,
 	//check server_certificate and private_key
 	if request.AliCloudCertificateId == "" {
 		if val := strings.Trim(request.ServerCertificate, " "); val == "" {
-			return WrapError(Error("UploadServerCertificate got an error, as server_certificate should be not null when alibabacloudstack_certificate_id is null."))
+			return DiagnosticsFromError(WrapError(Error("UploadServerCertificate got an error, as server_certificate should be not null when alibabacloudstack_certificate_id is null.")))
 		}
 
 		if val := strings.Trim(request.PrivateKey, " "); val == "" {
-			return WrapError(Error("UploadServerCertificate got an error, as either private_key or private_file  should be not null when alibabacloudstack_certificate_id is null."))
+			return DiagnosticsFromError(WrapError(Error("UploadServerCertificate got an error, as either private_key or private_file  should be not null when alibabacloudstack_certificate_id is null.")))
 		}
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.UploadServerCertificate(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.UploadServerCertificateResponse)
 	d.SetId(response.ServerCertificateId)
 
-	return resourceAlibabacloudStackSlbServerCertificateUpdate(d, meta)
+	return resourceAlibabacloudStackSlbServerCertificateUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	serverCertificate, err := slbService.DescribeSlbServerCertificate(d.Id())
 	if err != nil {
@@ -99,20 +107,20 @@ func resourceAlibabacloudStackSlbServerCertificateRead(d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("name", serverCertificate.ServerCertificateName); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackSlbServerCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbServerCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSlbServerCertificateRead(d, meta)
+		return resourceAlibabacloudStackSlbServerCertificateRead(ctx, d, meta)
 	}
 	if !d.IsNewResource() && d.HasChange("name") {
 		request := slb.CreateSetServerCertificateNameRequest()
@@ -123,22 +131,22 @@ func resourceAlibabacloudStackSlbServerCertificateUpdate(d *schema.ResourceData,
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.ServerCertificateId = d.Id()
 		request.ServerCertificateName = d.Get("name").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.SetServerCertificateName(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackSlbServerCertificateRead(d, meta)
+	return resourceAlibabacloudStackSlbServerCertificateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbServerCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	request := slb.CreateDeleteServerCertificateRequest()
@@ -149,9 +157,9 @@ func resourceAlibabacloudStackSlbServerCertificateDelete(d *schema.ResourceData,
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ServerCertificateId = d.Id()
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteServerCertificate(request)
 		})
@@ -170,9 +178,9 @@ func resourceAlibabacloudStackSlbServerCertificateDelete(d *schema.ResourceData,
 		if IsExpectedErrors(err, []string{"ServerCertificateId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(slbService.WaitForSlbServerCertificate(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbServerCertificate(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 
 }
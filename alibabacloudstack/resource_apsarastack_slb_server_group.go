@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -16,13 +18,18 @@ import (
 
 func resourceAlibabacloudStackSlbServerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbServerGroupCreate,
-		Read:   resourceAlibabacloudStackSlbServerGroupRead,
-		Update: resourceAlibabacloudStackSlbServerGroupUpdate,
-		Delete: resourceAlibabacloudStackSlbServerGroupDelete,
+		CreateContext: resourceAlibabacloudStackSlbServerGroupCreate,
+		ReadContext:   resourceAlibabacloudStackSlbServerGroupRead,
+		UpdateContext: resourceAlibabacloudStackSlbServerGroupUpdate,
+		DeleteContext: resourceAlibabacloudStackSlbServerGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -76,8 +83,8 @@ func resourceAlibabacloudStackSlbServerGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	request := slb.CreateCreateVServerGroupRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -86,7 +93,7 @@ func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	if v, ok := d.GetOk("name"); ok {
 		request.VServerGroupName = v.(string)
@@ -95,18 +102,18 @@ func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta
 		return slbClient.CreateVServerGroup(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_server_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_slb_server_group", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*slb.CreateVServerGroupResponse)
 	d.SetId(response.VServerGroupId)
 	d.Set("load_balancer_id", d.Get("load_balancer_id").(string))
-	return resourceAlibabacloudStackSlbServerGroupUpdate(d, meta)
+	return resourceAlibabacloudStackSlbServerGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 	object, err := slbService.DescribeSlbServerGroup(d.Id())
 
@@ -115,7 +122,7 @@ func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.VServerGroupName)
@@ -136,11 +143,11 @@ func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta in
 		k := strings.Split(key, COLON_SEPARATED)
 		p, e := strconv.Atoi(k[0])
 		if e != nil {
-			return WrapError(e)
+			return DiagnosticsFromError(WrapError(e))
 		}
 		w, e := strconv.Atoi(k[1])
 		if e != nil {
-			return WrapError(e)
+			return DiagnosticsFromError(WrapError(e))
 		}
 		t := k[2]
 		s := map[string]interface{}{
@@ -153,14 +160,14 @@ func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta in
 	}
 
 	if err := d.Set("servers", servers); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbServerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	d.Partial(true)
 	var removeserverSet, addServerSet, updateServerSet *schema.Set
@@ -205,7 +212,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.VServerGroupId = d.Id()
 			segs := len(rmservers)/step + 1
 			for i := 0; i < segs; i++ {
@@ -219,7 +226,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 					return slbClient.RemoveVServerGroupBackendServers(request)
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 				//d.SetPartial("servers")
@@ -253,7 +260,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.VServerGroupId = d.Id()
 			segs := len(addservers)/step + 1
 			for i := 0; i < segs; i++ {
@@ -268,7 +275,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 					return slbClient.AddVServerGroupBackendServers(request)
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 				//d.SetPartial("servers")
@@ -292,7 +299,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.VServerGroupId = d.Id()
 		request.VServerGroupName = name
 		if serverUpdate {
@@ -327,7 +334,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 					return slbClient.SetVServerGroupAttribute(request)
 				})
 				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+					return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 				//d.SetPartial("servers")
@@ -338,7 +345,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 				return slbClient.SetVServerGroupAttribute(request)
 			})
 			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+				return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			//d.SetPartial("name")
@@ -346,11 +353,11 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbServerGroupRead(d, meta)
+	return resourceAlibabacloudStackSlbServerGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSlbServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	slbService := SlbService{client}
 
 	if d.Get("delete_protection_validation").(bool) {
@@ -360,10 +367,10 @@ func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta
 			if NotFoundError(err) {
 				return nil
 			}
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		if lbInstance.DeleteProtection == "on" {
-			return WrapError(fmt.Errorf("Current VServerGroup's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the group.", lbId))
+			return DiagnosticsFromError(WrapError(fmt.Errorf("Current VServerGroup's SLB Instance %s has enabled DeleteProtection. Please set delete_protection_validation to false to delete the group.", lbId)))
 		}
 	}
 
@@ -375,9 +382,9 @@ func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VServerGroupId = d.Id()
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteVServerGroup(request)
 		})
@@ -394,7 +401,7 @@ func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta
 		if IsExpectedErrors(err, []string{"The specified VServerGroupId does not exist", "InvalidParameter"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(slbService.WaitForSlbServerGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(slbService.WaitForSlbServerGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
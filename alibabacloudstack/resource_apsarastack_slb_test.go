@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -48,7 +49,7 @@ func testSweepSLBs(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -345,3 +346,32 @@ provider "alibabacloudstack" {
 	}
 	`, name)
 }
+
+// TestAlibabacloudStackSlb_cassette replays the creation, import, drift and deletion of a load balancer
+// from its cassette, without any network access.
+func TestAlibabacloudStackSlb_cassette(t *testing.T) {
+	client := testCassetteClient(t, "slb")
+	r := resourceAlibabacloudStackSlb()
+
+	d := testCassetteCreate(t, r, client, map[string]interface{}{
+		"name":          "tf-testacc-cassette-slb",
+		"address_type":  "intranet",
+		"vswitch_id":    "vsw-cassette01",
+		"specification": "slb.s2.small",
+	})
+	expected := map[string]interface{}{
+		"name":          "tf-testacc-cassette-slb",
+		"address_type":  "intranet",
+		"vswitch_id":    "vsw-cassette01",
+		"specification": "slb.s2.small",
+		"address":       "172.16.0.10",
+	}
+	testCassetteCheckAttributes(t, "create", d, expected)
+	testCassetteCheckAttributes(t, "import", testCassetteImport(t, r, client, d.Id()), expected)
+
+	// The load balancer was renamed out of Terraform
+	testCassetteCheckDiags(t, "drift", r.ReadContext(context.Background(), d, client))
+	testCassetteCheckAttributes(t, "drift", d, map[string]interface{}{"name": "changed-out-of-terraform"})
+
+	testCassetteCheckDiags(t, "delete", r.DeleteContext(context.Background(), d, client))
+}
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -14,10 +16,10 @@ import (
 
 func resourceAlibabacloudStackSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnapshotCreate,
-		Read:   resourceAlibabacloudStackSnapshotRead,
-		Update: resourceAlibabacloudStackSnapshotUpdate,
-		Delete: resourceAlibabacloudStackSnapshotDelete,
+		CreateContext: resourceAlibabacloudStackSnapshotCreate,
+		ReadContext:   resourceAlibabacloudStackSnapshotRead,
+		UpdateContext: resourceAlibabacloudStackSnapshotUpdate,
+		DeleteContext: resourceAlibabacloudStackSnapshotDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -25,7 +27,8 @@ func resourceAlibabacloudStackSnapshot() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
-			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,13 +53,13 @@ func resourceAlibabacloudStackSnapshot() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := ecs.CreateCreateSnapshotRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DiskId = d.Get("disk_id").(string)
 	request.ClientToken = buildClientToken(request.GetActionName())
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -75,7 +78,7 @@ func resourceAlibabacloudStackSnapshotCreate(d *schema.ResourceData, meta interf
 		return ecsClient.CreateSnapshot(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultDebugMsg, "alibabacloudstack_snapshot", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultDebugMsg, "alibabacloudstack_snapshot", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response := raw.(*ecs.CreateSnapshotResponse)
@@ -86,16 +89,16 @@ func resourceAlibabacloudStackSnapshotCreate(d *schema.ResourceData, meta interf
 	stateConf := BuildStateConf([]string{}, []string{string(SnapshotCreatingAccomplished)}, d.Timeout(schema.TimeoutCreate), 2*time.Minute,
 		ecsService.SnapshotStateRefreshFunc(d.Id(), []string{string(SnapshotCreatingFailed)}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackSnapshotUpdate(d, meta)
+	return resourceAlibabacloudStackSnapshotUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	snapshot, err := ecsService.DescribeSnapshot(d.Id())
 	if err != nil {
@@ -103,7 +106,7 @@ func resourceAlibabacloudStackSnapshotRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("name", snapshot.SnapshotName)
 	d.Set("disk_id", snapshot.SourceDiskId)
@@ -111,7 +114,7 @@ func resourceAlibabacloudStackSnapshotRead(d *schema.ResourceData, meta interfac
 
 	tags, err := ecsService.DescribeTags(d.Id(), TagResourceSnapshot)
 	if err != nil && !NotFoundError(err) {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if len(tags) > 0 {
 		d.Set("tags", tagsToMap(tags))
@@ -120,23 +123,23 @@ func resourceAlibabacloudStackSnapshotRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	if err := setTags(client, TagResourceSnapshot, d); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackSnapshotRead(d, meta)
+	return resourceAlibabacloudStackSnapshotRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request := ecs.CreateDeleteSnapshotRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnapshotId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -145,7 +148,7 @@ func resourceAlibabacloudStackSnapshotDelete(d *schema.ResourceData, meta interf
 	}
 	var raw interface{}
 	var err error
-	err = resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteSnapshot(request)
 		})
@@ -162,15 +165,15 @@ func resourceAlibabacloudStackSnapshotDelete(d *schema.ResourceData, meta interf
 		if IsExpectedErrors(err, []string{"InvalidSnapshotId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 0,
 		ecsService.SnapshotStateRefreshFunc(d.Id(), []string{string(SnapshotCreatingFailed)}))
 
-	if _, err = stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,15 +17,21 @@ import (
 
 func resourceAlibabacloudStackSnapshotPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnapshotPolicyCreate,
-		Read:   resourceAlibabacloudStackSnapshotPolicyRead,
-		Update: resourceAlibabacloudStackSnapshotPolicyUpdate,
-		Delete: resourceAlibabacloudStackSnapshotPolicyDelete,
+		CreateContext: resourceAlibabacloudStackSnapshotPolicyCreate,
+		ReadContext:   resourceAlibabacloudStackSnapshotPolicyRead,
+		UpdateContext: resourceAlibabacloudStackSnapshotPolicyUpdate,
+		DeleteContext: resourceAlibabacloudStackSnapshotPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -49,8 +57,8 @@ func resourceAlibabacloudStackSnapshotPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnapshotPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := ecs.CreateCreateAutoSnapshotPolicyRequest()
 	request.RegionId = client.RegionId
@@ -60,7 +68,7 @@ func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AutoSnapshotPolicyName = d.Get("name").(string)
 	request.RepeatWeekdays = convertListToJsonString(d.Get("repeat_weekdays").(*schema.Set).List())
 	request.RetentionDays = requests.NewInteger(d.Get("retention_days").(int))
@@ -70,23 +78,23 @@ func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta
 		return ecsClient.CreateAutoSnapshotPolicy(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_snapshot_policy", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_snapshot_policy", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response := raw.(*ecs.CreateAutoSnapshotPolicyResponse)
 	d.SetId(response.AutoSnapshotPolicyId)
 
 	ecsService := EcsService{client}
-	if err := ecsService.WaitForSnapshotPolicy(d.Id(), SnapshotPolicyNormal, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := ecsService.WaitForSnapshotPolicy(d.Id(), SnapshotPolicyNormal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackSnapshotPolicyRead(d, meta)
+	return resourceAlibabacloudStackSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeSnapshotPolicy(d.Id())
 	if err != nil {
@@ -94,19 +102,19 @@ func resourceAlibabacloudStackSnapshotPolicyRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.AutoSnapshotPolicyName)
 	weekdays, err := convertJsonStringToList(object.RepeatWeekdays)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("repeat_weekdays", weekdays)
 	d.Set("retention_days", object.RetentionDays)
 	timePoints, err := convertJsonStringToList(object.TimePoints)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("tags", ecsService.tagsToMap(object.Tags.Tag))
 	d.Set("time_points", timePoints)
@@ -114,20 +122,20 @@ func resourceAlibabacloudStackSnapshotPolicyRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackSnapshotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnapshotPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	ecsService := EcsService{client}
 	if d.HasChange("tags") {
 		if err := ecsService.SetResourceTagsNew(d, "auto_snapshot_policy"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
 	request := ecs.CreateModifyAutoSnapshotPolicyExRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AutoSnapshotPolicyId = d.Id()
 	if d.HasChange("name") {
 		request.AutoSnapshotPolicyName = d.Get("name").(string)
@@ -150,14 +158,14 @@ func resourceAlibabacloudStackSnapshotPolicyUpdate(d *schema.ResourceData, meta
 		return ecsClient.ModifyAutoSnapshotPolicyEx(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackSnapshotPolicyRead(d, meta)
+	return resourceAlibabacloudStackSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnapshotPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	ecsService := EcsService{client}
 
 	request := ecs.CreateDeleteAutoSnapshotPolicyRequest()
@@ -168,9 +176,9 @@ func resourceAlibabacloudStackSnapshotPolicyDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AutoSnapshotPolicyId = d.Id()
-	err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteAutoSnapshotPolicy(request)
 		})
@@ -184,8 +192,8 @@ func resourceAlibabacloudStackSnapshotPolicyDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(ecsService.WaitForSnapshotPolicy(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(ecsService.WaitForSnapshotPolicy(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
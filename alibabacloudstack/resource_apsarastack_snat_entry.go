@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"time"
@@ -9,20 +10,27 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackSnatEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnatEntryCreate,
-		Read:   resourceAlibabacloudStackSnatEntryRead,
-		Update: resourceAlibabacloudStackSnatEntryUpdate,
-		Delete: resourceAlibabacloudStackSnatEntryDelete,
+		CreateContext: resourceAlibabacloudStackSnatEntryCreate,
+		ReadContext:   resourceAlibabacloudStackSnatEntryRead,
+		UpdateContext: resourceAlibabacloudStackSnatEntryUpdate,
+		DeleteContext: resourceAlibabacloudStackSnatEntryDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": {
 				Type:     schema.TypeString,
@@ -53,8 +61,8 @@ func resourceAlibabacloudStackSnatEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnatEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateCreateSnatEntryRequest()
@@ -65,7 +73,7 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnatTableId = d.Get("snat_table_id").(string)
 	request.SourceVSwitchId = d.Get("source_vswitch_id").(string)
 	request.SnatIp = d.Get("snat_ip").(string)
@@ -73,7 +81,7 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 		request.SourceCIDR = v.(string)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		ar := request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateSnatEntry(ar)
@@ -89,19 +97,19 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 		d.SetId(fmt.Sprintf("%s%s%s", request.SnatTableId, COLON_SEPARATED, response.SnatEntryId))
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_snat_entry", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_snat_entry", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	if err := vpcService.WaitForSnatEntry(d.Id(), Available, DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpcService.WaitForSnatEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackSnatEntryRead(d, meta)
+	return resourceAlibabacloudStackSnatEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnatEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	if strings.HasPrefix(d.Id(), "snat-") {
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("snat_table_id").(string), COLON_SEPARATED, d.Id()))
@@ -114,7 +122,7 @@ func resourceAlibabacloudStackSnatEntryRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("snat_table_id", object.SnatTableId)
@@ -129,17 +137,17 @@ func resourceAlibabacloudStackSnatEntryRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if strings.HasPrefix(d.Id(), "snat-") {
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("snat_table_id").(string), COLON_SEPARATED, d.Id()))
 	}
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	request := vpc.CreateModifySnatEntryRequest()
@@ -150,7 +158,7 @@ func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta inter
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnatTableId = parts[0]
 	request.SnatEntryId = parts[1]
 	update := false
@@ -164,18 +172,18 @@ func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta inter
 			return vpcClient.ModifySnatEntry(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		if err := vpcService.WaitForSnatEntry(d.Id(), Available, DefaultTimeout); err != nil {
-			return WrapError(err)
+		if err := vpcService.WaitForSnatEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
-	return resourceAlibabacloudStackSnatEntryRead(d, meta)
+	return resourceAlibabacloudStackSnatEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSnatEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	if strings.HasPrefix(d.Id(), "snat-") {
@@ -183,7 +191,7 @@ func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta inter
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := vpc.CreateDeleteSnatEntryRequest()
 	request.RegionId = client.RegionId
@@ -193,10 +201,10 @@ func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta inter
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnatTableId = parts[0]
 	request.SnatEntryId = parts[1]
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteSnatEntry(request)
 		})
@@ -213,7 +221,7 @@ func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta inter
 		if IsExpectedErrors(err, []string{"InvalidSnatTableId.NotFound", "InvalidSnatEntryId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpcService.WaitForSnatEntry(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(vpcService.WaitForSnatEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
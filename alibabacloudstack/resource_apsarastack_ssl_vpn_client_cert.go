@@ -0,0 +1,192 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackSslVpnClientCert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackSslVpnClientCertCreate,
+		ReadContext:   resourceAlibabacloudStackSslVpnClientCertRead,
+		UpdateContext: resourceAlibabacloudStackSslVpnClientCertUpdate,
+		DeleteContext: resourceAlibabacloudStackSslVpnClientCertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ssl_vpn_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ca_cert": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_cert": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackSslVpnClientCertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpnGatewayService := VpnGatewayService{client}
+
+	request := vpc.CreateCreateSslVpnClientCertRequest()
+	request.RegionId = client.RegionId
+	request.SslVpnServerId = d.Get("ssl_vpn_server_id").(string)
+	if v, ok := d.GetOk("name"); ok {
+		request.Name = v.(string)
+	}
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = client.Department
+
+	var raw interface{}
+	var err error
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		args := *request
+		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateSslVpnClientCert(&args)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"VpnGateway.Configuring"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ssl_vpn_client_cert", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	response, _ := raw.(*vpc.CreateSslVpnClientCertResponse)
+	d.SetId(response.SslVpnClientCertId)
+
+	if err := vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Ssl_Cert_Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackSslVpnClientCertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackSslVpnClientCertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpnGatewayService := VpnGatewayService{client}
+
+	object, err := vpnGatewayService.DescribeSslVpnClientCert(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("ssl_vpn_server_id", object.SslVpnServerId)
+	d.Set("name", object.Name)
+	d.Set("status", object.Status)
+	d.Set("ca_cert", object.CaCert)
+	d.Set("client_cert", object.ClientCert)
+	d.Set("client_key", object.ClientKey)
+	d.Set("client_config", object.ClientConfig)
+
+	return nil
+}
+
+func resourceAlibabacloudStackSslVpnClientCertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+
+	if d.HasChange("name") {
+		request := vpc.CreateModifySslVpnClientCertRequest()
+		request.RegionId = client.RegionId
+		request.SslVpnClientCertId = d.Id()
+		request.Name = d.Get("name").(string)
+		request.ClientToken = buildClientToken(request.GetActionName())
+		request.Headers["x-ascm-product-name"] = "Vpc"
+		request.Headers["x-acs-organizationId"] = client.Department
+
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.ModifySslVpnClientCert(request)
+		})
+		if err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	return resourceAlibabacloudStackSslVpnClientCertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackSslVpnClientCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpnGatewayService := VpnGatewayService{client}
+
+	request := vpc.CreateDeleteSslVpnClientCertRequest()
+	request.RegionId = client.RegionId
+	request.SslVpnClientCertId = d.Id()
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = client.Department
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		args := *request
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DeleteSslVpnClientCert(&args)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"VpnGateway.Configuring"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidSslVpnClientCertId.NotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	return DiagnosticsFromError(WrapError(vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
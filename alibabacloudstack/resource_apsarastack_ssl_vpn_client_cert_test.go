@@ -0,0 +1,124 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccCheckSslVpnClientCertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
+	vpnGatewayService := VpnGatewayService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alibabacloudstack_ssl_vpn_client_cert" {
+			continue
+		}
+
+		_, err := vpnGatewayService.DescribeSslVpnClientCert(rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+		return WrapError(Error("ssl vpn client cert %s still exists", rs.Primary.ID))
+	}
+
+	return nil
+}
+
+func TestAccAlibabacloudStackSslVpnClientCertBasic(t *testing.T) {
+	var v vpc.DescribeSslVpnClientCertResponse
+
+	resourceId := "alibabacloudstack_ssl_vpn_client_cert.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000, 9999)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSslVpnClientCertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSslVpnClientCertConfigBasic(rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"ssl_vpn_server_id": CHECKSET,
+						"name":              fmt.Sprintf("tf-testAccSslVpnClientCert%d", rand),
+						"status":            string(Ssl_Cert_Normal),
+						"ca_cert":           CHECKSET,
+						"client_cert":       CHECKSET,
+						"client_key":        CHECKSET,
+						"client_config":     CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSslVpnClientCertConfig_name(rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name": fmt.Sprintf("tf-testAccSslVpnClientCert%d_change", rand),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSslVpnClientCertConfigBasic(rand int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_ssl_vpn_server" "default" {
+	name = "${var.name}"
+	vpn_gateway_id = "${alibabacloudstack_vpn_gateway.default.id}"
+	client_ip_pool = "192.168.0.0/16"
+	local_subnet = "172.16.0.0/21"
+}
+
+resource "alibabacloudstack_ssl_vpn_client_cert" "default" {
+	ssl_vpn_server_id = "${alibabacloudstack_ssl_vpn_server.default.id}"
+	name = "${var.name}"
+}
+`, testAccSslVpnGatewayConfig(fmt.Sprintf("tf-testAccSslVpnClientCert%d", rand)))
+}
+
+func testAccSslVpnClientCertConfig_name(rand int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_ssl_vpn_server" "default" {
+	name = "${var.name}"
+	vpn_gateway_id = "${alibabacloudstack_vpn_gateway.default.id}"
+	client_ip_pool = "192.168.0.0/16"
+	local_subnet = "172.16.0.0/21"
+}
+
+resource "alibabacloudstack_ssl_vpn_client_cert" "default" {
+	ssl_vpn_server_id = "${alibabacloudstack_ssl_vpn_server.default.id}"
+	name = "${var.name}_change"
+}
+`, testAccSslVpnGatewayConfig(fmt.Sprintf("tf-testAccSslVpnClientCert%d", rand)))
+}
@@ -0,0 +1,243 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackSslVpnServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlibabacloudStackSslVpnServerCreate,
+		ReadContext:   resourceAlibabacloudStackSslVpnServerRead,
+		UpdateContext: resourceAlibabacloudStackSslVpnServerUpdate,
+		DeleteContext: resourceAlibabacloudStackSslVpnServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"client_ip_pool": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDRNetwork(16, 29),
+			},
+			"local_subnet": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      VPN_UDP_PROTO,
+				ValidateFunc: validation.StringInSlice([]string{VPN_UDP_PROTO, VPN_TCP_PROTO}, false),
+			},
+			"cipher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      SSL_VPN_ENC_AES_128,
+				ValidateFunc: validation.StringInSlice([]string{SSL_VPN_ENC_AES_128, SSL_VPN_ENC_AES_192, SSL_VPN_ENC_AES_256, SSL_VPN_ENC_NONE}, false),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1194,
+				ValidateFunc: validation.All(validation.IsPortNumber, validation.IntNotInSlice([]int{22, 2222, 22222, 9000, 9001, 9002, 7505, 80, 443, 53, 68, 123, 4510, 4560, 500, 4500})),
+			},
+			"compress": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"internet_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackSslVpnServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpnGatewayService := VpnGatewayService{client}
+
+	request := vpc.CreateCreateSslVpnServerRequest()
+	request.RegionId = client.RegionId
+	request.VpnGatewayId = d.Get("vpn_gateway_id").(string)
+	if v, ok := d.GetOk("name"); ok {
+		request.Name = v.(string)
+	}
+	request.ClientIpPool = d.Get("client_ip_pool").(string)
+	request.LocalSubnet = d.Get("local_subnet").(string)
+	request.Proto = d.Get("protocol").(string)
+	request.Cipher = d.Get("cipher").(string)
+	request.Port = requests.NewInteger(d.Get("port").(int))
+	request.Compress = requests.NewBoolean(d.Get("compress").(bool))
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = client.Department
+
+	var raw interface{}
+	var err error
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		args := *request
+		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateSslVpnServer(&args)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"VpnGateway.Configuring"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ssl_vpn_server", request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	response, _ := raw.(*vpc.CreateSslVpnServerResponse)
+	d.SetId(response.SslVpnServerId)
+
+	if err := vpnGatewayService.WaitForSslVpnServer(d.Id(), Null, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+	return resourceAlibabacloudStackSslVpnServerRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackSslVpnServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpnGatewayService := VpnGatewayService{client}
+
+	object, err := vpnGatewayService.DescribeSslVpnServer(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	d.Set("vpn_gateway_id", object.VpnGatewayId)
+	d.Set("name", object.Name)
+	d.Set("client_ip_pool", object.ClientIpPool)
+	d.Set("local_subnet", object.LocalSubnet)
+	d.Set("protocol", object.Proto)
+	d.Set("cipher", object.Cipher)
+	d.Set("port", object.Port)
+	d.Set("compress", object.Compress)
+	d.Set("internet_ip", object.InternetIp)
+	d.Set("connections", object.Connections)
+	d.Set("max_connections", object.MaxConnections)
+
+	return nil
+}
+
+func resourceAlibabacloudStackSslVpnServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpnGatewayService := VpnGatewayService{client}
+
+	request := vpc.CreateModifySslVpnServerRequest()
+	request.RegionId = client.RegionId
+	request.SslVpnServerId = d.Id()
+	request.Name = d.Get("name").(string)
+	request.ClientIpPool = d.Get("client_ip_pool").(string)
+	request.LocalSubnet = d.Get("local_subnet").(string)
+	request.Proto = d.Get("protocol").(string)
+	request.Cipher = d.Get("cipher").(string)
+	request.Port = requests.NewInteger(d.Get("port").(int))
+	request.Compress = requests.NewBoolean(d.Get("compress").(bool))
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = client.Department
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		args := *request
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.ModifySslVpnServer(&args)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"VpnGateway.Configuring"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	if err := vpnGatewayService.WaitForSslVpnServer(d.Id(), Null, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
+	}
+
+	return resourceAlibabacloudStackSslVpnServerRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackSslVpnServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
+	vpnGatewayService := VpnGatewayService{client}
+
+	request := vpc.CreateDeleteSslVpnServerRequest()
+	request.RegionId = client.RegionId
+	request.SslVpnServerId = d.Id()
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = client.Department
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		args := *request
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DeleteSslVpnServer(&args)
+		})
+		if err != nil {
+			// the client certificates issued by the server are deleted asynchronously.
+			if IsExpectedErrors(err, []string{"VpnGateway.Configuring", "DependencyViolation.SslVpnClientCert"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidSslVpnServerId.NotFound"}) {
+			return nil
+		}
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
+	}
+	return DiagnosticsFromError(WrapError(vpnGatewayService.WaitForSslVpnServer(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
+}
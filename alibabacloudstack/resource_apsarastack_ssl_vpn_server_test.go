@@ -0,0 +1,159 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccCheckSslVpnServerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
+	vpnGatewayService := VpnGatewayService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alibabacloudstack_ssl_vpn_server" {
+			continue
+		}
+
+		_, err := vpnGatewayService.DescribeSslVpnServer(rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+		return WrapError(Error("ssl vpn server %s still exists", rs.Primary.ID))
+	}
+
+	return nil
+}
+
+func TestAccAlibabacloudStackSslVpnServerBasic(t *testing.T) {
+	var v vpc.SslVpnServer
+
+	resourceId := "alibabacloudstack_ssl_vpn_server.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000, 9999)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSslVpnServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSslVpnServerConfigBasic(rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"vpn_gateway_id":  CHECKSET,
+						"name":            fmt.Sprintf("tf-testAccSslVpnServer%d", rand),
+						"client_ip_pool":  "192.168.0.0/16",
+						"local_subnet":    "172.16.0.0/21",
+						"protocol":        "UDP",
+						"cipher":          "AES-128-CBC",
+						"port":            "1194",
+						"compress":        "false",
+						"internet_ip":     CHECKSET,
+						"max_connections": CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSslVpnServerConfig_update(rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":     fmt.Sprintf("tf-testAccSslVpnServer%d_change", rand),
+						"protocol": "TCP",
+						"cipher":   "AES-256-CBC",
+						"port":     "1196",
+						"compress": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSslVpnServerConfigBasic(rand int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_ssl_vpn_server" "default" {
+	name = "${var.name}"
+	vpn_gateway_id = "${alibabacloudstack_vpn_gateway.default.id}"
+	client_ip_pool = "192.168.0.0/16"
+	local_subnet = "172.16.0.0/21"
+}
+`, testAccSslVpnGatewayConfig(fmt.Sprintf("tf-testAccSslVpnServer%d", rand)))
+}
+
+func testAccSslVpnServerConfig_update(rand int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_ssl_vpn_server" "default" {
+	name = "${var.name}_change"
+	vpn_gateway_id = "${alibabacloudstack_vpn_gateway.default.id}"
+	client_ip_pool = "192.168.0.0/16"
+	local_subnet = "172.16.0.0/21"
+	protocol = "TCP"
+	cipher = "AES-256-CBC"
+	port = 1196
+	compress = true
+}
+`, testAccSslVpnGatewayConfig(fmt.Sprintf("tf-testAccSslVpnServer%d", rand)))
+}
+
+// testAccSslVpnGatewayConfig returns a vpn gateway with the ssl vpn enabled, which the ssl vpn servers are created on.
+func testAccSslVpnGatewayConfig(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+	default = "%s"
+}
+resource "alibabacloudstack_vpc" "default" {
+	cidr_block = "172.16.0.0/12"
+	name = "${var.name}"
+}
+
+data "alibabacloudstack_zones" "default" {
+	available_resource_creation= "VSwitch"
+}
+
+resource "alibabacloudstack_vswitch" "default" {
+	vpc_id = "${alibabacloudstack_vpc.default.id}"
+	cidr_block = "172.16.0.0/21"
+	availability_zone = "${data.alibabacloudstack_zones.default.zones.0.id}"
+	name = "${var.name}"
+}
+
+resource "alibabacloudstack_vpn_gateway" "default" {
+	name = "${var.name}"
+	vpc_id = "${alibabacloudstack_vswitch.default.vpc_id}"
+	bandwidth = "10"
+	enable_ssl = true
+	ssl_connections = 5
+	instance_charge_type = "PostPaid"
+	vswitch_id = "${alibabacloudstack_vswitch.default.id}"
+}
+`, name)
+}
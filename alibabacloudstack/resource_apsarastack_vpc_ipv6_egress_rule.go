@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,9 +18,9 @@ import (
 
 func resourceAlibabacloudStackVpcIpv6EgressRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6EgressRuleCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6EgressRuleRead,
-		Delete: resourceAlibabacloudStackVpcIpv6EgressRuleDelete,
+		CreateContext: resourceAlibabacloudStackVpcIpv6EgressRuleCreate,
+		ReadContext:   resourceAlibabacloudStackVpcIpv6EgressRuleRead,
+		DeleteContext: resourceAlibabacloudStackVpcIpv6EgressRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -64,14 +66,14 @@ func resourceAlibabacloudStackVpcIpv6EgressRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateIpv6EgressOnlyRule"
 	request := make(map[string]interface{})
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("description"); ok {
 		request["Description"] = v
@@ -88,7 +90,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, me
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("CreateIpv6EgressOnlyRule")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -104,20 +106,20 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, me
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alicloud_vpc_ipv6_egress_rule", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alicloud_vpc_ipv6_egress_rule", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(request["Ipv6GatewayId"], ":", response["Ipv6EgressRuleId"]))
 	vpcService := VpcService{client}
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, vpcService.VpcIpv6EgressRuleStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackVpcIpv6EgressRuleRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6EgressRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6EgressRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6EgressRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeVpcIpv6EgressRule(d.Id())
 	if err != nil {
@@ -126,11 +128,11 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("ipv6_gateway_id", parts[0])
 	d.Set("description", object["Description"])
@@ -140,18 +142,18 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleRead(d *schema.ResourceData, meta
 	d.Set("status", object["Status"])
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	vpcService := VpcService{client}
 	action := "DeleteIpv6EgressOnlyRule"
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"Ipv6EgressOnlyRuleId": parts[1],
@@ -161,7 +163,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, me
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("DeleteIpv6EgressOnlyRule")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -177,11 +179,11 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, me
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, vpcService.VpcIpv6EgressRuleStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
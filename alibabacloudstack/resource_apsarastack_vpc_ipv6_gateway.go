@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,6 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,10 +18,10 @@ import (
 
 func resourceAlibabacloudStackVpcIpv6Gateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6GatewayCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6GatewayRead,
-		Update: resourceAlibabacloudStackVpcIpv6GatewayUpdate,
-		Delete: resourceAlibabacloudStackVpcIpv6GatewayDelete,
+		CreateContext: resourceAlibabacloudStackVpcIpv6GatewayCreate,
+		ReadContext:   resourceAlibabacloudStackVpcIpv6GatewayRead,
+		UpdateContext: resourceAlibabacloudStackVpcIpv6GatewayUpdate,
+		DeleteContext: resourceAlibabacloudStackVpcIpv6GatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -58,14 +60,14 @@ func resourceAlibabacloudStackVpcIpv6Gateway() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6GatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6GatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "CreateIpv6Gateway"
 	request := make(map[string]interface{})
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	if v, ok := d.GetOk("description"); ok {
 		request["Description"] = v
@@ -81,7 +83,7 @@ func resourceAlibabacloudStackVpcIpv6GatewayCreate(d *schema.ResourceData, meta
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
 		request["ClientToken"] = buildClientToken("CreateIpv6Gateway")
@@ -97,20 +99,20 @@ func resourceAlibabacloudStackVpcIpv6GatewayCreate(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpc_ipv6_gateway", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpc_ipv6_gateway", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["Ipv6GatewayId"]))
 	vpcService := VpcService{client}
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
-	return resourceAlibabacloudStackVpcIpv6GatewayRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6GatewayRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6GatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6GatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeVpcIpv6Gateway(d.Id())
 	if err != nil {
@@ -119,7 +121,7 @@ func resourceAlibabacloudStackVpcIpv6GatewayRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("description", object["Description"])
 	d.Set("ipv6_gateway_name", object["Name"])
@@ -128,8 +130,8 @@ func resourceAlibabacloudStackVpcIpv6GatewayRead(d *schema.ResourceData, meta in
 	d.Set("vpc_id", object["VpcId"])
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6GatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	var response map[string]interface{}
 	d.Partial(true)
@@ -151,12 +153,12 @@ func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta
 		action := "ModifyIpv6GatewaySpec"
 		conn, err := client.NewVpcClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			request["ClientToken"] = buildClientToken("ModifyIpv6GatewaySpec")
 			request["Product"] = "Vpc"
 			request["product"] = "Vpc"
@@ -173,11 +175,11 @@ func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta
 		})
 		addDebug(action, response, request)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("spec")
 	}
@@ -202,10 +204,10 @@ func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta
 		action := "ModifyIpv6GatewayAttribute"
 		conn, err := client.NewVpcClient()
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			modifyIpv6GatewayAttributeReq["Product"] = "Vpc"
 			modifyIpv6GatewayAttributeReq["OrganizationId"] = client.Department
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, modifyIpv6GatewayAttributeReq, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
@@ -220,26 +222,26 @@ func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta
 		})
 		addDebug(action, response, modifyIpv6GatewayAttributeReq)
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 		}
 		//d.SetPartial("description")
 		//d.SetPartial("ipv6_gateway_name")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackVpcIpv6GatewayRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6GatewayRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6GatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6GatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	action := "DeleteIpv6Gateway"
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"Ipv6GatewayId": d.Id(),
@@ -247,7 +249,7 @@ func resourceAlibabacloudStackVpcIpv6GatewayDelete(d *schema.ResourceData, meta
 
 	request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
@@ -262,11 +264,11 @@ func resourceAlibabacloudStackVpcIpv6GatewayDelete(d *schema.ResourceData, meta
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +17,10 @@ import (
 
 func resourceAlibabacloudStackVpcIpv6InternetBandwidth() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6InternetBandwidthRead,
-		Update: resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate,
-		Delete: resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete,
+		CreateContext: resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate,
+		ReadContext:   resourceAlibabacloudStackVpcIpv6InternetBandwidthRead,
+		UpdateContext: resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate,
+		DeleteContext: resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -53,14 +55,14 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidth() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	action := "AllocateIpv6InternetBandwidth"
 	request := make(map[string]interface{})
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request["Bandwidth"] = d.Get("bandwidth")
 	if v, ok := d.GetOk("internet_charge_type"); ok {
@@ -72,7 +74,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceD
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("AllocateIpv6InternetBandwidth")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -88,15 +90,15 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceD
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpc_ipv6_internet_bandwidth", action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpc_ipv6_internet_bandwidth", action, AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(fmt.Sprint(response["InternetBandwidthId"]))
 
-	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeVpcIpv6InternetBandwidth(d.Id())
 	if err != nil {
@@ -105,7 +107,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("ipv6_address_id", object["Ipv6AddressId"])
@@ -119,8 +121,8 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d *schema.ResourceDat
 	}
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	var response map[string]interface{}
 	request := map[string]interface{}{
 		"Ipv6InternetBandwidthId": d.Id(),
@@ -132,12 +134,12 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceD
 	action := "ModifyIpv6InternetBandwidth"
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("ModifyIpv6InternetBandwidth")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -153,17 +155,17 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceD
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
-	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	action := "DeleteIpv6InternetBandwidth"
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	request := map[string]interface{}{
 		"Ipv6InternetBandwidthId": d.Id(),
@@ -172,7 +174,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(d *schema.ResourceD
 	request["Ipv6AddressId"] = d.Get("ipv6_address_id")
 	request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(client.Config.Insecure)})
@@ -187,7 +189,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(d *schema.ResourceD
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR))
 	}
 	return nil
 }
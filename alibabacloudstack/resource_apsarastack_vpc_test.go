@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -55,7 +56,7 @@ func testSweepVpcs(region string) error {
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
@@ -469,3 +470,74 @@ provider "alibabacloudstack" {
 
 `)
 }
+
+// TestAccAlibabacloudStackVpc_recorded runs the basic VPC steps against the cassette vpc_acc, recorded
+// from a live stack with ALIBABACLOUDSTACK_RECORD_MODE=record. The names are fixed so the replayed
+// requests match the recorded ones.
+func TestAccAlibabacloudStackVpc_recorded(t *testing.T) {
+	resourceId := "alibabacloudstack_vpc.default"
+	providerFactories := testAccProviderFactoriesWithCassette(t, "vpc_acc")
+	resource.Test(t, resource.TestCase{
+		IDRefreshName:     resourceId,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigRecorded("tf-testacc-recorded-vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceId, "vpc_name", "tf-testacc-recorded-vpc"),
+					resource.TestCheckResourceAttr(resourceId, "cidr_block", "172.16.0.0/12"),
+					resource.TestCheckResourceAttrSet(resourceId, "router_id"),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcConfigRecorded("tf-testacc-recorded-vpc-changed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceId, "vpc_name", "tf-testacc-recorded-vpc-changed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcConfigRecorded(name string) string {
+	return fmt.Sprintf(`
+resource "alibabacloudstack_vpc" "default" {
+  vpc_name   = "%s"
+  cidr_block = "172.16.0.0/12"
+}
+`, name)
+}
+
+// TestAlibabacloudStackVpc_cassette replays the creation, import, drift and deletion of a VPC from its
+// cassette, without any network access.
+func TestAlibabacloudStackVpc_cassette(t *testing.T) {
+	client := testCassetteClient(t, "vpc")
+	r := resourceAlibabacloudStackVpc()
+
+	d := testCassetteCreate(t, r, client, map[string]interface{}{
+		"vpc_name":    "tf-testacc-cassette-vpc",
+		"cidr_block":  "172.16.0.0/12",
+		"description": "tf-testacc-cassette",
+	})
+	expected := map[string]interface{}{
+		"vpc_name":       "tf-testacc-cassette-vpc",
+		"cidr_block":     "172.16.0.0/12",
+		"description":    "tf-testacc-cassette",
+		"router_id":      "vrt-cassette01",
+		"route_table_id": "vtb-cassette01",
+		"status":         "Available",
+	}
+	testCassetteCheckAttributes(t, "create", d, expected)
+	testCassetteCheckAttributes(t, "import", testCassetteImport(t, r, client, d.Id()), expected)
+
+	// The description was changed out of Terraform
+	testCassetteCheckDiags(t, "drift", r.ReadContext(context.Background(), d, client))
+	testCassetteCheckAttributes(t, "drift", d, map[string]interface{}{"description": "changed-out-of-terraform"})
+
+	testCassetteCheckDiags(t, "delete", r.DeleteContext(context.Background(), d, client))
+}
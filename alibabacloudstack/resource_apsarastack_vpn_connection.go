@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"strings"
@@ -17,14 +19,20 @@ import (
 
 func resourceAlibabacloudStackVpnConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnConnectionCreate,
-		Read:   resourceAlibabacloudStackVpnConnectionRead,
-		Update: resourceAlibabacloudStackVpnConnectionUpdate,
-		Delete: resourceAlibabacloudStackVpnConnectionDelete,
+		CreateContext: resourceAlibabacloudStackVpnConnectionCreate,
+		ReadContext:   resourceAlibabacloudStackVpnConnectionRead,
+		UpdateContext: resourceAlibabacloudStackVpnConnectionUpdate,
+		DeleteContext: resourceAlibabacloudStackVpnConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"customer_gateway_id": {
 				Type:     schema.TypeString,
@@ -174,15 +182,15 @@ func resourceAlibabacloudStackVpnConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	request, err := buildAlibabacloudStackVpnConnectionArgs(d, meta)
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	var response *vpc.CreateVpnConnectionResponse
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpnConnection(&args)
@@ -199,21 +207,21 @@ func resourceAlibabacloudStackVpnConnectionCreate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpn_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpn_connection", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	d.SetId(response.VpnConnectionId)
 
-	if err := vpnGatewayService.WaitForVpnConnection(d.Id(), Null, DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := vpnGatewayService.WaitForVpnConnection(d.Id(), Null, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackVpnConnectionRead(d, meta)
+	return resourceAlibabacloudStackVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	response, err := vpnGatewayService.DescribeVpnConnection(d.Id())
 	if err != nil {
@@ -221,7 +229,7 @@ func resourceAlibabacloudStackVpnConnectionRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("customer_gateway_id", response.CustomerGatewayId)
 	d.Set("vpn_gateway_id", response.VpnGatewayId)
@@ -237,18 +245,18 @@ func resourceAlibabacloudStackVpnConnectionRead(d *schema.ResourceData, meta int
 	d.Set("status", response.Status)
 
 	if err := d.Set("ike_config", vpnGatewayService.ParseIkeConfig(response.IkeConfig)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if err := d.Set("ipsec_config", vpnGatewayService.ParseIpsecConfig(response.IpsecConfig)); err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateModifyVpnConnectionAttributeRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -273,7 +281,7 @@ func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("ike_config") {
 		ike_config, err := vpnGatewayService.AssembleIkeConfig(d.Get("ike_config").([]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.IkeConfig = ike_config
 	}
@@ -281,7 +289,7 @@ func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("ipsec_config") {
 		ipsec_config, err := vpnGatewayService.AssembleIpsecConfig(d.Get("ipsec_config").([]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		request.IpsecConfig = ipsec_config
 	}
@@ -290,15 +298,15 @@ func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta i
 		return vpcClient.ModifyVpnConnectionAttribute(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAlibabacloudStackVpnConnectionRead(d, meta)
+	return resourceAlibabacloudStackVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateDeleteVpnConnectionRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -308,7 +316,7 @@ func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta i
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.VpnConnectionId = d.Id()
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnConnection(&args)
@@ -329,9 +337,9 @@ func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta i
 		if IsExpectedErrors(err, []string{"InvalidVpnConnectionInstanceId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpnGatewayService.WaitForVpnConnection(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(vpnGatewayService.WaitForVpnConnection(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
 
 func buildAlibabacloudStackVpnConnectionArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateVpnConnectionRequest, error) {
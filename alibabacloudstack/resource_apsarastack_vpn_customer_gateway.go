@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -13,14 +15,20 @@ import (
 
 func resourceAlibabacloudStackVpnCustomerGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnCustomerGatewayCreate,
-		Read:   resourceAlibabacloudStackVpnCustomerGatewayRead,
-		Update: resourceAlibabacloudStackVpnCustomerGatewayUpdate,
-		Delete: resourceAlibabacloudStackVpnCustomerGatewayDelete,
+		CreateContext: resourceAlibabacloudStackVpnCustomerGatewayCreate,
+		ReadContext:   resourceAlibabacloudStackVpnCustomerGatewayRead,
+		UpdateContext: resourceAlibabacloudStackVpnCustomerGatewayUpdate,
+		DeleteContext: resourceAlibabacloudStackVpnCustomerGatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ip_address": {
 				Type:         schema.TypeString,
@@ -42,8 +50,8 @@ func resourceAlibabacloudStackVpnCustomerGateway() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnCustomerGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateCreateCustomerGatewayRequest()
 	request.RegionId = client.RegionId
@@ -62,7 +70,7 @@ func resourceAlibabacloudStackVpnCustomerGatewayCreate(d *schema.ResourceData, m
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 	var err error
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		args := *request
 		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateCustomerGateway(&args)
@@ -80,22 +88,22 @@ func resourceAlibabacloudStackVpnCustomerGatewayCreate(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpn_customer_gateway", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpn_customer_gateway", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	response, _ := raw.(*vpc.CreateCustomerGatewayResponse)
 
 	d.SetId(response.CustomerGatewayId)
 
-	err = vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Null, 60)
+	err = vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Null, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackVpnCustomerGatewayRead(d, meta)
+	return resourceAlibabacloudStackVpnCustomerGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnCustomerGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 
 	object, err := vpnGatewayService.DescribeVpnCustomerGateway(d.Id())
@@ -104,7 +112,7 @@ func resourceAlibabacloudStackVpnCustomerGatewayRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("ip_address", object.IpAddress)
@@ -114,8 +122,8 @@ func resourceAlibabacloudStackVpnCustomerGatewayRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnCustomerGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 
 	request := vpc.CreateModifyCustomerGatewayAttributeRequest()
 	request.RegionId = client.RegionId
@@ -134,15 +142,15 @@ func resourceAlibabacloudStackVpnCustomerGatewayUpdate(d *schema.ResourceData, m
 		return vpcClient.ModifyCustomerGatewayAttribute(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAlibabacloudStackVpnCustomerGatewayRead(d, meta)
+	return resourceAlibabacloudStackVpnCustomerGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnCustomerGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateDeleteCustomerGatewayRequest()
 	request.RegionId = client.RegionId
@@ -150,7 +158,7 @@ func resourceAlibabacloudStackVpnCustomerGatewayDelete(d *schema.ResourceData, m
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteCustomerGateway(&args)
@@ -172,7 +180,7 @@ func resourceAlibabacloudStackVpnCustomerGatewayDelete(d *schema.ResourceData, m
 		if IsExpectedErrors(err, []string{"InvalidCustomerGatewayInstanceId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Deleted, DefaultTimeout))
+	return DiagnosticsFromError(WrapError(vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -19,14 +21,20 @@ import (
 
 func resourceAlibabacloudStackVpnGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnGatewayCreate,
-		Read:   resourceAlibabacloudStackVpnGatewayRead,
-		Update: resourceAlibabacloudStackVpnGatewayUpdate,
-		Delete: resourceAlibabacloudStackVpnGatewayDelete,
+		CreateContext: resourceAlibabacloudStackVpnGatewayCreate,
+		ReadContext:   resourceAlibabacloudStackVpnGatewayRead,
+		UpdateContext: resourceAlibabacloudStackVpnGatewayUpdate,
+		DeleteContext: resourceAlibabacloudStackVpnGatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -113,8 +121,8 @@ func resourceAlibabacloudStackVpnGateway() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateCreateVpnGatewayRequest()
 	request.RegionId = client.RegionId
@@ -166,23 +174,23 @@ func resourceAlibabacloudStackVpnGatewayCreate(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpn_gateway", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpn_gateway", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*vpc.CreateVpnGatewayResponse)
 	d.SetId(response.VpnGatewayId)
 
 	time.Sleep(10 * time.Second)
-	if err := vpnGatewayService.WaitForVpnGateway(d.Id(), Active, 2*DefaultTimeout); err != nil {
-		return WrapError(err)
+	if err := vpnGatewayService.WaitForVpnGateway(d.Id(), Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
 
-	return resourceAlibabacloudStackVpnGatewayUpdate(d, meta)
+	return resourceAlibabacloudStackVpnGatewayUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 	vpcService := VpcService{client}
 
@@ -192,7 +200,7 @@ func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("name", object.Name)
@@ -212,7 +220,7 @@ func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interf
 	if err == nil {
 		d.Set("bandwidth", bandwidth)
 	} else {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	if string("PostpayByFlow") == object.ChargeType {
@@ -222,15 +230,15 @@ func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interf
 	}
 	listTagResourcesObject, err := vpcService.ListTagResources(d.Id(), "VpnGateWay")
 	if err != nil {
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 	d.Set("tags", tagsToMap(listTagResourcesObject))
 
 	return nil
 }
 
-func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateModifyVpnGatewayAttributeRequest()
@@ -241,7 +249,7 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 	update := false
 	if d.HasChange("tags") {
 		if err := vpcService.SetResourceTags(d, "VpnGateWay"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 		d.SetPartial("tags")
 	}
@@ -261,7 +269,7 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 			return vpcClient.ModifyVpnGatewayAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("name")
@@ -270,23 +278,23 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackVpnGatewayRead(d, meta)
+		return resourceAlibabacloudStackVpnGatewayRead(ctx, d, meta)
 	}
 
 	if d.HasChange("bandwidth") {
-		return fmt.Errorf("Now Cann't Support modify vpn gateway bandwidth, try to modify on the web console")
+		return DiagnosticsFromError(fmt.Errorf("Now Cann't Support modify vpn gateway bandwidth, try to modify on the web console"))
 	}
 
 	if d.HasChange("enable_ipsec") || d.HasChange("enable_ssl") {
-		return fmt.Errorf("Now Cann't Support modify ipsec/ssl switch, try to modify on the web console")
+		return DiagnosticsFromError(fmt.Errorf("Now Cann't Support modify ipsec/ssl switch, try to modify on the web console"))
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackVpnGatewayRead(d, meta)
+	return resourceAlibabacloudStackVpnGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnGatewayService := VpnGatewayService{client}
 
 	request := vpc.CreateDeleteVpnGatewayRequest()
@@ -295,7 +303,7 @@ func resourceAlibabacloudStackVpnGatewayDelete(d *schema.ResourceData, meta inte
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnGateway(&args)
@@ -319,8 +327,8 @@ func resourceAlibabacloudStackVpnGatewayDelete(d *schema.ResourceData, meta inte
 		if IsExpectedErrors(err, []string{"InvalidVpnGatewayInstanceId.NotFound"}) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
-	return WrapError(vpnGatewayService.WaitForVpnGateway(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpnGatewayService.WaitForVpnGateway(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -14,14 +16,20 @@ import (
 
 func resourceAlibabacloudStackVpnRouteEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnRouteEntryCreate,
-		Read:   resourceAlibabacloudStackVpnRouteEntryRead,
-		Update: resourceAlibabacloudStackVpnRouteEntryUpdate,
-		Delete: resourceAlibabacloudStackVpnRouteEntryDelete,
+		CreateContext: resourceAlibabacloudStackVpnRouteEntryCreate,
+		ReadContext:   resourceAlibabacloudStackVpnRouteEntryRead,
+		UpdateContext: resourceAlibabacloudStackVpnRouteEntryUpdate,
+		DeleteContext: resourceAlibabacloudStackVpnRouteEntryDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Type:     schema.TypeString,
@@ -55,8 +63,8 @@ func resourceAlibabacloudStackVpnRouteEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnRouteEntryService := VpnGatewayService{client}
 	request := vpc.CreateCreateVpnRouteEntryRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -72,7 +80,7 @@ func resourceAlibabacloudStackVpnRouteEntryCreate(d *schema.ResourceData, meta i
 
 	var raw interface{}
 	wait := incrementalWait(5*time.Second, 5*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw1, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpnRouteEntry(request)
 		})
@@ -89,21 +97,21 @@ func resourceAlibabacloudStackVpnRouteEntryCreate(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	response, _ := raw.(*vpc.CreateVpnRouteEntryResponse)
 	id := response.VpnInstanceId + ":" + response.NextHop + ":" + response.RouteDest
 	d.SetId(id)
 
-	if err := vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Active, 2*DefaultTimeoutMedium); err != nil {
-		return WrapError(err)
+	if err := vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return DiagnosticsFromError(WrapError(err))
 	}
-	return resourceAlibabacloudStackVpnRouteEntryRead(d, meta)
+	return resourceAlibabacloudStackVpnRouteEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnRouteEntryService := VpnGatewayService{client}
 
 	object, err := vpnRouteEntryService.DescribeVpnRouteEntry(d.Id())
@@ -112,7 +120,7 @@ func resourceAlibabacloudStackVpnRouteEntryRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("weight", object.Weight)
@@ -130,8 +138,8 @@ func resourceAlibabacloudStackVpnRouteEntryRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackVpnRouteEntryUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnRouteEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	d.Partial(true)
 
 	if d.HasChange("publish_vpc") {
@@ -149,7 +157,7 @@ func resourceAlibabacloudStackVpnRouteEntryUpdate(d *schema.ResourceData, meta i
 			return vpcClient.PublishVpnRouteEntry(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("public_vpc")
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -171,18 +179,18 @@ func resourceAlibabacloudStackVpnRouteEntryUpdate(d *schema.ResourceData, meta i
 			return vpcClient.ModifyVpnRouteEntryWeight(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		//d.SetPartial("weight")
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackVpnRouteEntryRead(d, meta)
+	return resourceAlibabacloudStackVpnRouteEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackVpnRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpnRouteEntryService := VpnGatewayService{client}
 
 	request := vpc.CreateDeleteVpnRouteEntryRequest()
@@ -196,7 +204,7 @@ func resourceAlibabacloudStackVpnRouteEntryDelete(d *schema.ResourceData, meta i
 	request.ClientToken = buildClientToken(request.GetActionName())
 
 	wait := incrementalWait(5*time.Second, 5*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnRouteEntry(request)
 		})
@@ -212,7 +220,7 @@ func resourceAlibabacloudStackVpnRouteEntryDelete(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
-	return WrapError(vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Deleted, DefaultTimeoutMedium))
+	return DiagnosticsFromError(WrapError(vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))))
 }
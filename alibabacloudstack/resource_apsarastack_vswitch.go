@@ -1,27 +1,30 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSwitchCreate,
-		Read:   resourceAlibabacloudStackSwitchRead,
-		Update: resourceAlibabacloudStackSwitchUpdate,
-		Delete: resourceAlibabacloudStackSwitchDelete,
+		CreateContext: resourceAlibabacloudStackSwitchCreate,
+		ReadContext:   resourceAlibabacloudStackSwitchRead,
+		UpdateContext: resourceAlibabacloudStackSwitchUpdate,
+		DeleteContext: resourceAlibabacloudStackSwitchDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -59,8 +62,8 @@ func resourceAlibabacloudStackSwitch() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSwitchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	request := vpc.CreateCreateVSwitchRequest()
@@ -76,7 +79,7 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 	if d.Get("ipv6_cidr_block").(string) != "" {
 		ipv6_cidr_block = "0"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ipv6CidrBlock": ipv6_cidr_block, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ipv6CidrBlock": ipv6_cidr_block, "ResourceGroup": client.ResourceGroup}
 
 	request.VpcId = Trim(d.Get("vpc_id").(string))
 	request.ZoneId = d.Get("availability_zone").(string)
@@ -92,7 +95,7 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVSwitch(&args)
@@ -111,19 +114,19 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 		d.SetId(response.VSwitchId)
 		return nil
 	}); err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vswitch", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vswitch", request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, vpcService.VSwitchStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
-	return resourceAlibabacloudStackSwitchUpdate(d, meta)
+	return resourceAlibabacloudStackSwitchUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSwitchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	waitSecondsIfWithTest(1)
-	client := meta.(*connectivity.AlibabacloudStackClient)
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	vswitch, err := vpcService.DescribeVSwitch(d.Id())
 	if err != nil {
@@ -131,7 +134,7 @@ func resourceAlibabacloudStackSwitchRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return WrapError(err)
+		return DiagnosticsFromError(WrapError(err))
 	}
 
 	d.Set("availability_zone", vswitch.ZoneId)
@@ -148,19 +151,19 @@ func resourceAlibabacloudStackSwitchRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSwitchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 
 	if d.HasChange("tags") {
 		if err := vpcService.SetResourceTags(d, "VSWITCH"); err != nil {
-			return WrapError(err)
+			return DiagnosticsFromError(WrapError(err))
 		}
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSwitchRead(d, meta)
+		return resourceAlibabacloudStackSwitchRead(ctx, d, meta)
 	}
 	// object, err := vpcService.DescribeVpc(d.Get("vpc_id").(string))
 	// if err != nil {
@@ -182,7 +185,7 @@ func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interfac
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Ipv6CidrBlock": d.Get("ipv6_cidr_block").(string), "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Ipv6CidrBlock": d.Get("ipv6_cidr_block").(string), "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	// if ipv6_cidr_block != "" {
 	// 	update = true
@@ -205,15 +208,15 @@ func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interfac
 			return vpcClient.ModifyVSwitchAttribute(request)
 		})
 		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackSwitchRead(d, meta)
+	return resourceAlibabacloudStackSwitchRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*connectivity.AlibabacloudStackClient)
+func resourceAlibabacloudStackSwitchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*connectivity.AlibabacloudStackClient).WithContext(ctx)
 	vpcService := VpcService{client}
 	request := vpc.CreateDeleteVSwitchRequest()
 	request.RegionId = client.RegionId
@@ -224,9 +227,9 @@ func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interfac
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VSwitchId = d.Id()
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVSwitch(request)
 		})
@@ -244,12 +247,12 @@ func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return DiagnosticsFromError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR))
 	}
 
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, vpcService.VSwitchStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return DiagnosticsFromError(WrapErrorf(err, IdMsg, d.Id()))
 	}
 
 	return nil
@@ -718,7 +718,7 @@ func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string
 			runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 			runtime.SetIgnoreSSL(s.client.Config.Insecure)
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 				if err != nil {
 					if IsThrottling(err) {
@@ -752,7 +752,7 @@ func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string
 			wait := incrementalWait(2*time.Second, 1*time.Second)
 			runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 			runtime.SetIgnoreSSL(s.client.Config.Insecure)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 				if err != nil {
 					if IsThrottling(err) {
@@ -24,7 +24,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaInstance(instanceId stri
 	wait := incrementalWait(2*time.Second, 1*time.Second)
 	var raw interface{}
 	var err error
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.Context(), 10*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.GetInstanceList(instanceListReq)
 		})
@@ -65,7 +65,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaNodeStatus(instanceId st
 	wait := incrementalWait(2*time.Second, 1*time.Second)
 	var raw interface{}
 	var err error
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.Context(), 10*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.DescribeNodeStatus(describeNodeStatusReq)
 		})
@@ -102,7 +102,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaInstanceByOrderId(orderI
 		wait := incrementalWait(2*time.Second, 1*time.Second)
 		var raw interface{}
 		var err error
-		err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(alikafkaService.client.Context(), 10*time.Minute, func() *resource.RetryError {
 			raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 				return client.GetInstanceList(instanceListReq)
 			})
@@ -142,15 +142,29 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaConsumerGroup(id string)
 		return alikafkaConsumerGroup, WrapError(err)
 	}
 	instanceId := parts[0]
-	//consumerId := parts[1]
+	consumerId := parts[1]
 
+	consumerGroups, err := alikafkaService.ListAlikafkaConsumerGroups(instanceId)
+	if err != nil {
+		return alikafkaConsumerGroup, WrapError(err)
+	}
+	for _, v := range consumerGroups {
+		if v.ConsumerId == consumerId {
+			return &v, nil
+		}
+	}
+	return alikafkaConsumerGroup, WrapErrorf(Error(GetNotFoundMessage("AlikafkaConsumerGroup", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (alikafkaService *AlikafkaService) ListAlikafkaConsumerGroups(instanceId string) ([]alikafka.ConsumerVO, error) {
 	request := alikafka.CreateGetConsumerListRequest()
 	request.InstanceId = instanceId
-	request.RegionId = alikafkaService.client.RegionId
-        request.QueryParams["Product"] = "alikafka"
+	alikafkaService.client.InitRpcRequest(request.RpcRequest)
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 	wait := incrementalWait(2*time.Second, 1*time.Second)
 	var raw interface{}
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(alikafkaService.client.Context(), 10*time.Minute, func() *resource.RetryError {
+		var err error
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.GetConsumerList(request)
 		})
@@ -164,20 +178,79 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaConsumerGroup(id string)
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	consumerListResp, _ := raw.(*alikafka.GetConsumerListResponse)
+	return consumerListResp.ConsumerList.ConsumerVO, nil
+}
 
+// ListAlikafkaInstances returns the instances of the region, leaving out the released ones.
+func (alikafkaService *AlikafkaService) ListAlikafkaInstances() ([]alikafka.InstanceVO, error) {
+	request := alikafka.CreateGetInstanceListRequest()
+	alikafkaService.client.InitRpcRequest(request.RpcRequest)
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	wait := incrementalWait(2*time.Second, 1*time.Second)
+	var raw interface{}
+	err := resource.RetryContext(alikafkaService.client.Context(), 10*time.Minute, func() *resource.RetryError {
+		var err error
+		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
+			return client.GetInstanceList(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
 	if err != nil {
-		return alikafkaConsumerGroup, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return nil, WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	//consumerListResp, _ := raw.(*alikafka.GetConsumerListResponse)
-	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	instanceListResp, _ := raw.(*alikafka.GetInstanceListResponse)
+	instances := make([]alikafka.InstanceVO, 0)
+	for _, v := range instanceListResp.InstanceList.InstanceVO {
+		// ServiceStatus equals 10 means the instance is released.
+		if v.ServiceStatus != 10 {
+			instances = append(instances, v)
+		}
+	}
+	return instances, nil
+}
 
-	//for _, v := range consumerListResp.ConsumerList {
-	//	if v.ConsumerId == consumerId {
-	//		return &v, nil
-	//	}
-	//}
-	return alikafkaConsumerGroup, WrapErrorf(Error(GetNotFoundMessage("AlikafkaConsumerGroup", id)), NotFoundMsg, ProviderERROR)
+func (alikafkaService *AlikafkaService) ListAlikafkaTopics(instanceId string) ([]alikafka.TopicList, error) {
+	request := alikafka.CreateGetTopicListRequest()
+	request.InstanceId = instanceId
+	alikafkaService.client.InitRpcRequest(request.RpcRequest)
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	var raw interface{}
+	err := resource.RetryContext(alikafkaService.client.Context(), 5*time.Minute, func() *resource.RetryError {
+		var err error
+		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.GetTopicList(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	topicListResp, _ := raw.(*alikafka.GetTopicListResponse)
+	return topicListResp.TopicList, nil
 }
 
 func (alikafkaService *AlikafkaService) DescribeAlikafkaTopicStatus(id string) (*alikafka.TopicStatus, error) {
@@ -199,7 +272,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopicStatus(id string) (
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.GetTopicStatus(request)
 		})
@@ -246,21 +319,12 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopic(id string) (*alika
 	
 
 
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": alikafkaService.client.SecretKey,
-		"AccessKeyId":     alikafkaService.client.AccessKey,
-		"Product":         "alikafka",
-		"RegionId":        alikafkaService.client.RegionId,
-		"Department":      alikafkaService.client.Department,
-		"ResourceGroup":   alikafkaService.client.ResourceGroup,
-		"Action":          "GetTopicList",
-		"Version":         "2019-09-16",
-	}
+	alikafkaService.client.InitRpcRequest(request.RpcRequest)
 
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.GetTopicList(request)
 		})
@@ -307,7 +371,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslUser(id string) (*al
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DescribeSaslUsers(request)
 		})
@@ -362,7 +426,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslAcl(id string) (*ali
 	request.QueryParams["Product"] = "alikafka"
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DescribeAcls(request)
 		})
@@ -650,7 +714,7 @@ func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[strin
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.ListTagResources(request)
 		})
@@ -693,7 +757,7 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 			request.QueryParams["Product"] = "alikafka"
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 					return client.UntagResources(request)
 				})
@@ -722,7 +786,7 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 			request.QueryParams["Product"] = "alikafka"
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 					return client.TagResources(request)
 				})
@@ -21,24 +21,11 @@ type CloudApiService struct {
 func (s *CloudApiService) DescribeApiGatewayGroup(id string) (*cloudapi.DescribeApiGroupResponse, error) {
 	apiGroup := &cloudapi.DescribeApiGroupResponse{}
 	request := cloudapi.CreateDescribeApiGroupRequest()
-	request.RegionId = s.client.RegionId
+	s.client.InitRpcRequest(request.RpcRequest)
 	request.GroupId = id
-	request.Headers = map[string]string{
-		"RegionId":              s.client.RegionId,
-		"x-acs-resourcegroupid": s.client.ResourceGroup,
-		"x-acs-regionid":        s.client.RegionId,
-		"x-acs-organizationid":  s.client.Department,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "DescribeApiGroup",
-		"Version":         "2016-07-14",
-	}
+	request.Headers["x-acs-resourcegroupid"] = s.client.ResourceGroup
+	request.Headers["x-acs-regionid"] = s.client.RegionId
+	request.Headers["x-acs-organizationid"] = s.client.Department
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DescribeApiGroup(request)
@@ -84,19 +71,7 @@ func (s *CloudApiService) DescribeApiGatewayApp(id string) (*cloudapi.DescribeAp
 	request := cloudapi.CreateDescribeAppRequest()
 	request.RegionId = s.client.RegionId
 	request.AppId = requests.Integer(id)
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeApp",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DescribeApp(request)
@@ -145,19 +120,7 @@ func (s *CloudApiService) DescribeApiGatewayApi(id string) (*cloudapi.DescribeAp
 	request.RegionId = s.client.RegionId
 	request.ApiId = parts[1]
 	request.GroupId = parts[0]
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeApi",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DescribeApi(request)
@@ -209,19 +172,7 @@ func (s *CloudApiService) DescribeApiGatewayAppAttachment(id string) (*cloudapi.
 	request.GroupId = parts[0]
 	request.ApiId = parts[1]
 	request.StageName = parts[3]
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeAuthorizedApps",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 	appId, _ := strconv.ParseInt(parts[2], 10, 64)
 
 	var allApps []cloudapi.AuthorizedApp
@@ -270,19 +221,7 @@ func (s *CloudApiService) DescribeApiGatewayVpcAccess(id string) (*cloudapi.VpcA
 	vpc := &cloudapi.VpcAccessAttribute{}
 	request := cloudapi.CreateDescribeVpcAccessesRequest()
 	request.RegionId = s.client.RegionId
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeVpcAccesses",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 	parts, err := ParseResourceId(id, 4)
 	if err != nil {
 		return vpc, WrapError(err)
@@ -365,19 +304,7 @@ func (s *CloudApiService) DescribeDeployedApi(id string, stageName string) (*clo
 	request.ApiId = parts[1]
 	request.GroupId = parts[0]
 	request.StageName = stageName
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeDeployedApi",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DescribeDeployedApi(request)
@@ -404,19 +331,7 @@ func (s *CloudApiService) DeployedApi(id string, stageName string) (err error) {
 	request.GroupId = parts[0]
 	request.StageName = stageName
 	request.Description = DeployCommonDescription
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DeployApi",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DeployApi(request)
 	})
@@ -437,19 +352,7 @@ func (s *CloudApiService) AbolishApi(id string, stageName string) (err error) {
 	request.ApiId = parts[1]
 	request.GroupId = parts[0]
 	request.StageName = stageName
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "AbolishApi",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.AbolishApi(request)
@@ -470,19 +373,7 @@ func (s *CloudApiService) DescribeTags(resourceId string, resourceTags map[strin
 	request.RegionId = s.client.RegionId
 	request.ResourceType = string(resourceType)
 	request.ResourceId = &[]string{resourceId}
-	request.Headers = map[string]string{
-		"RegionId": s.client.RegionId,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "ListTagResources",
-		"Version":         "2016-07-14",
-	}
+	s.client.InitRpcRequest(request.RpcRequest)
 	if resourceTags != nil && len(resourceTags) > 0 {
 		var reqTags []cloudapi.ListTagResourcesTag
 		for key, value := range resourceTags {
@@ -522,19 +413,7 @@ func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType T
 		request.ResourceType = string(resourceType)
 		request.TagKey = &tagKey
 		request.RegionId = s.client.RegionId
-		request.Headers = map[string]string{
-			"RegionId": s.client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": s.client.SecretKey,
-			"AccessKeyId":     s.client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        s.client.RegionId,
-			"Department":      s.client.Department,
-			"ResourceGroup":   s.client.ResourceGroup,
-			"Action":          "UntagResources",
-			"Version":         "2016-07-14",
-		}
+		s.client.InitRpcRequest(request.RpcRequest)
 		raw, err := s.client.WithCloudApiClient(func(client *cloudapi.Client) (interface{}, error) {
 			return client.UntagResources(request)
 		})
@@ -550,19 +429,7 @@ func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType T
 		request.Tag = &create
 		request.ResourceType = string(resourceType)
 		request.RegionId = s.client.RegionId
-		request.Headers = map[string]string{
-			"RegionId": s.client.RegionId,
-		}
-		request.QueryParams = map[string]string{
-			"AccessKeySecret": s.client.SecretKey,
-			"AccessKeyId":     s.client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        s.client.RegionId,
-			"Department":      s.client.Department,
-			"ResourceGroup":   s.client.ResourceGroup,
-			"Action":          "TagResources",
-			"Version":         "2016-07-14",
-		}
+		s.client.InitRpcRequest(request.RpcRequest)
 		raw, err := s.client.WithCloudApiClient(func(client *cloudapi.Client) (interface{}, error) {
 			return client.TagResources(request)
 		})
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -18,30 +17,8 @@ type AscmService struct {
 
 func (s *AscmService) DescribeAscmLogonPolicy(id string) (response *LoginPolicy, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListLoginPolicies"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "ListLoginPolicies",
-		"Version":         "2019-05-10",
-		"Name":            id,
-	}
+	request := s.client.NewCommonRequest("POST", "ascm", "2019-05-10", "ListLoginPolicies", "")
+	request.QueryParams["Name"] = id
 	var resp = &LoginPolicy{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -70,32 +47,8 @@ func (s *AscmService) DescribeAscmResourceGroup(id string) (response *ResourceGr
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
 
-	request := requests.NewCommonRequest()
-
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":          s.client.RegionId,
-		"AccessKeySecret":   s.client.SecretKey,
-		"Product":           "ascm",
-		"Action":            "ListResourceGroup",
-		"Version":           "2019-05-10",
-		"resourceGroupName": did[0],
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListResourceGroup"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListResourceGroup", "")
+	request.QueryParams["resourceGroupName"] = did[0]
 	var resp = &ResourceGroup{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -124,33 +77,9 @@ func (s *AscmService) DescribeAscmCustomRole(id string) (response *AscmCustomRol
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
 
-	request := requests.NewCommonRequest()
-
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListRoles",
-		"Version":         "2019-05-10",
-		"roleName":        did[0],
-		"roleType":        "ROLETYPE_ASCM",
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListRoles"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListRoles", "")
+	request.QueryParams["roleName"] = did[0]
+	request.QueryParams["roleType"] = "ROLETYPE_ASCM"
 	var resp = &AscmCustomRole{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -180,35 +109,9 @@ func (s *AscmService) DescribeAscmRamRole(id string) (response *AscmRoles, err e
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
 
-	request := requests.NewCommonRequest()
-
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "ListRoles",
-		"Version":         "2019-05-10",
-		"roleName":        did[0],
-		"roleType":        "ROLETYPE_RAM",
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListRoles"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListRoles", "")
+	request.QueryParams["roleName"] = did[0]
+	request.QueryParams["roleType"] = "ROLETYPE_RAM"
 	var resp = &AscmRoles{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -238,35 +141,10 @@ func (s *AscmService) DescribeAscmRamRole(id string) (response *AscmRoles, err e
 func (s *AscmService) DescribeAscmRamServiceRole(id string) (response *RamRole, err error) {
 	var requestInfo *ecs.Client
 
-	request := requests.NewCommonRequest()
-
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"id":              id,
-		"Action":          "GetRAMServiceRole",
-		"Version":         "2019-05-10",
-		"roleType":        "ROLETYPE_RAM",
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListRAMServiceRoles"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListRAMServiceRoles", "")
+	request.QueryParams["id"] = id
+	request.QueryParams["Action"] = "GetRAMServiceRole"
+	request.QueryParams["roleType"] = "ROLETYPE_RAM"
 	var resp = &RamRole{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -307,32 +185,8 @@ type BindResourceAndUsers struct {
 
 func (s *AscmService) DescribeAscmResourceGroupUserAttachment(id string) (response *AscmResourceGroupUser, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListAscmUsersInsideResourceGroup",
-		"Version":         "2019-05-10",
-		"resourceGroupId": id,
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListAscmUsersInsideResourceGroup"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListAscmUsersInsideResourceGroup", "")
+	request.QueryParams["resourceGroupId"] = id
 	var resp = &AscmResourceGroupUser{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -363,44 +217,12 @@ func (s *AscmService) DescribeAscmUserGroupResourceSet(id string) (response *Lis
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
 
-	request := requests.NewCommonRequest()
-
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListResourceGroup", "")
 	if id == "" {
-		request.QueryParams = map[string]string{
-			"RegionId":        s.client.RegionId,
-			"AccessKeySecret": s.client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "ListResourceGroup",
-			"Version":         "2019-05-10",
-			"pageSize":        "1000",
-		}
+		request.QueryParams["pageSize"] = "1000"
 	} else {
-		request.QueryParams = map[string]string{
-			"RegionId":          s.client.RegionId,
-			"AccessKeySecret":   s.client.SecretKey,
-			"Product":           "ascm",
-			"Action":            "ListResourceGroup",
-			"Version":           "2019-05-10",
-			"resourceGroupName": did[0],
-		}
+		request.QueryParams["resourceGroupName"] = did[0]
 	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListResourceGroup"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
 	var resp = &ListResourceGroup{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -428,32 +250,8 @@ func (s *AscmService) DescribeAscmUserGroupResourceSet(id string) (response *Lis
 
 func (s *AscmService) DescribeAscmUserGroupResourceSetBinding(id string) (response *ListResourceGroup, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListResourceGroup",
-		"Version":         "2019-05-10",
-		"pageSize":        "1000",
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListResourceGroup"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListResourceGroup", "")
+	request.QueryParams["pageSize"] = "1000"
 	var resp = &ListResourceGroup{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -491,31 +289,8 @@ func (s *AscmService) DescribeAscmUserGroupResourceSetBinding(id string) (respon
 
 func (s *AscmService) DescribeAscmUser(id string) (response *User, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListUsers",
-		"Version":         "2019-05-10",
-		"loginName":       id,
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListUsers"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListUsers", "")
+	request.QueryParams["loginName"] = id
 	var resp = &User{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -544,48 +319,10 @@ func (s *AscmService) DescribeAscmUser(id string) (response *User, err error) {
 
 func (s *AscmService) DescribeAscmUserGroup(id string) (response *UserGroup, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	if id == "" {
-		request.QueryParams = map[string]string{
-			"RegionId":         s.client.RegionId,
-			"AccessKeySecret":  s.client.SecretKey,
-			"Product":          "ascm",
-			"Action":           "ListUserGroups",
-			"Version":          "2019-05-10",
-			"SecurityToken":    s.client.Config.SecurityToken,
-			"SignatureVersion": "1.0",
-			"SignatureMethod":  "HMAC-SHA1",
-		}
-	} else {
-		request.QueryParams = map[string]string{
-			"RegionId":         s.client.RegionId,
-			"AccessKeySecret":  s.client.SecretKey,
-			"Product":          "ascm",
-			"Action":           "ListUserGroups",
-			"Version":          "2019-05-10",
-			"userGroupName":    id,
-			"SecurityToken":    s.client.Config.SecurityToken,
-			"SignatureVersion": "1.0",
-			"SignatureMethod":  "HMAC-SHA1",
-		}
-	}
-
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListUserGroups", "")
+	if id != "" {
+		request.QueryParams["userGroupName"] = id
 	}
-	request.ApiName = "ListUserGroups"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
 	var resp = &UserGroup{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -614,34 +351,8 @@ func (s *AscmService) DescribeAscmUserGroup(id string) (response *UserGroup, err
 
 func (s *AscmService) DescribeAscmUserGroupRoleBinding(id string) (response *UserGroup, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":         s.client.RegionId,
-		"AccessKeySecret":  s.client.SecretKey,
-		"Product":          "ascm",
-		"Action":           "ListUserGroups",
-		"Version":          "2019-05-10",
-		"pageSize":         "1000",
-		"SecurityToken":    s.client.Config.SecurityToken,
-		"SignatureVersion": "1.0",
-		"SignatureMethod":  "HMAC-SHA1",
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListUserGroups"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListUserGroups", "")
+	request.QueryParams["pageSize"] = "1000"
 	var resp = &UserGroup{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -678,31 +389,8 @@ func (s *AscmService) DescribeAscmUserGroupRoleBinding(id string) (response *Use
 
 func (s *AscmService) DescribeAscmUserRoleBinding(id string) (response *User, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListUsers",
-		"Version":         "2019-05-10",
-		"loginName":       id,
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListUsers"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListUsers", "")
+	request.QueryParams["loginName"] = id
 	var resp = &User{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -730,31 +418,8 @@ func (s *AscmService) DescribeAscmUserRoleBinding(id string) (response *User, er
 }
 func (s *AscmService) DescribeAscmDeletedUser(id string) (response *DeletedUser, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListDeletedUsers",
-		"Version":         "2019-05-10",
-		"loginName":       id,
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListDeletedUsers"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListDeletedUsers", "")
+	request.QueryParams["loginName"] = id
 	var resp = &DeletedUser{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -783,33 +448,8 @@ func (s *AscmService) DescribeAscmDeletedUser(id string) (response *DeletedUser,
 func (s *AscmService) DescribeAscmOrganization(id string) (response *Organization, err error) {
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "GetOrganizationList",
-		"Version":         "2019-05-10",
-		"name":            did[0],
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetOrganizationList"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "ascm", "2019-05-10", "GetOrganizationList", "")
+	request.QueryParams["name"] = did[0]
 	var resp = &Organization{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -838,34 +478,8 @@ func (s *AscmService) DescribeAscmOrganization(id string) (response *Organizatio
 func (s *AscmService) DescribeAscmRamPolicy(id string) (response *RamPolicies, err error) {
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeyId":     s.client.AccessKey,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "ListRAMPolicies",
-		"Version":         "2019-05-10",
-		"policyName":      did[0],
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListRAMPolicies"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "ascm", "2019-05-10", "ListRAMPolicies", "")
+	request.QueryParams["policyName"] = did[0]
 	var resp = &RamPolicies{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -895,35 +509,9 @@ func (s *AscmService) DescribeAscmRamPolicyForRole(id string) (response *RamPoli
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
 
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeyId":     s.client.AccessKey,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "ListRAMPolicies",
-		"Version":         "2019-05-10",
-		"RamPolicyId":     did[0],
-		//"roleId":     did[1],
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListRAMPolicies"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "ascm", "2019-05-10", "ListRAMPolicies", "")
+	//"roleId":     did[1],
+	request.QueryParams["RamPolicyId"] = did[0]
 	var resp = &RamPolicies{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -962,37 +550,12 @@ func (s *AscmService) DescribeAscmQuota(id string) (response *AscmQuota, err err
 	} else {
 		targetType = ""
 	}
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"regionName ":     s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "GetQuota",
-		"Version":         "2019-05-10",
-		"productName":     did[0],
-		"quotaType":       did[1],
-		"quotaTypeId":     did[2],
-		"targetType":      targetType,
-	}
-	request.Method = "GET"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.ApiName = "GetQuota"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("GET", "Ascm", "2019-05-10", "GetQuota", "")
+	request.QueryParams["regionName "] = s.client.RegionId
+	request.QueryParams["productName"] = did[0]
+	request.QueryParams["quotaType"] = did[1]
+	request.QueryParams["quotaTypeId"] = did[2]
+	request.QueryParams["targetType"] = targetType
 	var resp = &AscmQuota{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -1022,33 +585,8 @@ func (s *AscmService) DescribeAscmQuota(id string) (response *AscmQuota, err err
 func (s *AscmService) DescribeAscmPasswordPolicy(id string) (response *PasswordPolicy, err error) {
 	var requestInfo *ecs.Client
 	//	did := strings.Split(id, COLON_SEPARATED)
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "GetPasswordPolicy",
-		"Version":         "2019-05-10",
-		"id":              id,
-	}
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetPasswordPolicy"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
-	request.Domain = s.client.Domain
+	request := s.client.NewCommonRequest("POST", "ascm", "2019-05-10", "GetPasswordPolicy", "")
+	request.QueryParams["id"] = id
 	var resp = &PasswordPolicy{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -1077,37 +615,8 @@ func (s *AscmService) DescribeAscmPasswordPolicy(id string) (response *PasswordP
 
 func (s *AscmService) DescribeAscmUsergroupUser(id string) (response *User, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":  s.client.SecretKey,
-		"AccessKeyId":      s.client.AccessKey,
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"RegionId":         s.client.RegionId,
-		"Product":          "ascm",
-		"Action":           "ListUsersInUserGroup",
-		"Version":          "2019-05-10",
-		"userGroupId":      id,
-		"SecurityToken":    s.client.Config.SecurityToken,
-		"SignatureVersion": "1.0",
-		"SignatureMethod":  "HMAC-SHA1",
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListUsersInUserGroup"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListUsersInUserGroup", "")
+	request.QueryParams["userGroupId"] = id
 	var resp = &User{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -1135,48 +644,24 @@ func (s *AscmService) DescribeAscmUsergroupUser(id string) (response *User, err
 }
 
 func (s *AscmService) ExportInitPasswordByLoginName(loginname string) (initPassword string, err error) {
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
 	var loginnamelist []string
 	loginnamelist = append(loginnamelist, loginname)
 	QueryParams := map[string]interface{}{
-		"AccessKeySecret":  s.client.SecretKey,
-		"AccessKeyId":      s.client.AccessKey,
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"RegionId":         s.client.RegionId,
-		"Product":          "ascm",
-		"Action":           "ExportInitPasswordByLoginNameList",
-		"Version":          "2019-05-10",
-		"SecurityToken":    s.client.Config.SecurityToken,
-		"SignatureVersion": "1.0",
-		"SignatureMethod":  "HMAC-SHA1",
-		"LoginNameList":    loginnamelist,
-	}
-	request.Method = "POST"
-	request.Product = "Ascm"
-	request.Version = "2019-05-10"
-	request.ServiceCode = "ascm"
-	request.Domain = s.client.Domain
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Product":       "ascm",
+		"Action":        "ExportInitPasswordByLoginNameList",
+		"Version":       "2019-05-10",
+		"LoginNameList": loginnamelist,
+	}
 	requeststring, jsonerr := json.Marshal(QueryParams)
 	log.Printf("=========================  ExportInitPasswordByLoginNameList jsonerr:%v", jsonerr)
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ExportInitPasswordByLoginNameList", "/roa/ascm/auth/user/exportInitPasswordByLoginNameList")
 	request.SetContent(requeststring)
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ExportInitPasswordByLoginNameList"
-	request.Headers = map[string]string{
-		"RegionId":               s.client.RegionId,
-		"x-ascm-product-name":    "ascm",
-		"x-ascm-product-version": "2019-05-10",
-	}
-	request.RegionId = s.client.RegionId
 	request.SetContentType("application/json")
-	request.PathPattern = "/roa/ascm/auth/user/exportInitPasswordByLoginNameList"
+	request.Headers["x-ascm-product-name"] = "ascm"
+	request.Headers["x-ascm-product-version"] = "2019-05-10"
 	log.Printf("ExportInitPasswordByLoginNameList loginname:%v", loginname)
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -3,9 +3,7 @@ package alibabacloudstack
 import (
 	"encoding/json"
 	"log"
-	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
@@ -33,33 +31,11 @@ type VpcListResult struct {
 
 func (s *BucketVpcService) BucketVpcList(bucketName string) (vpclist *VpcListResult, err error) {
 	var requestInfo *oss.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"RegionId":         s.client.RegionId,
-		"Action":           "ListBucketVpc",
-		"AccountInfo":      "123456",
-		"Version":          "2019-05-10",
-		"SignatureVersion": "2.1",
-		"OpenApiAction":    "ListBucketVpc",
-		"Product":          "Ascm",
-		"BucketName":       bucketName,
-		// "Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",}", "action", "ListBucketVpc", "product", "Ascm", "region", s.client.RegionId, "params", "{\"BucketName\":\""+bucketName+"\"}"),
-	}
-	request.Method = "POST"  // Set request method
-	request.Product = "Ascm" // Specify product
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "ListBucketVpc"
-	request.Version = "2019-05-10" // Specify product version
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "ListBucketVpc", "")
+	// "Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",}", "action", "ListBucketVpc", "product", "Ascm", "region", s.client.RegionId, "params", "{\"BucketName\":\""+bucketName+"\"}"),
+	request.QueryParams["AccountInfo"] = "123456"
+	request.QueryParams["OpenApiAction"] = "ListBucketVpc"
+	request.QueryParams["BucketName"] = bucketName
 	raw, err := s.client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
 		return ossClient.ProcessCommonRequest(request)
@@ -87,36 +63,12 @@ func (s *BucketVpcService) BucketVpcList(bucketName string) (vpclist *VpcListRes
 
 func (s *BucketVpcService) BindBucket(vpcId string, vpcName string, vLan string, bucket string) error {
 	var requestInfo *oss.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":  s.client.SecretKey,
-		"AccessKeyId":      s.client.AccessKey,
-		"Product":          "ascm",
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"RegionId":         s.client.RegionId,
-		"Version":          "2019-05-10",
-		"SignatureVersion": "2.1",
-		"Action":           "BindBucketPolicy",
-		"BucketName":       bucket,
-		"VpcName":          vpcName,
-		"VLan":             vLan,
-		"VpcId":            vpcId,
-		// "Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",}", "BucketName", bucket, "VpcName", vpcName, "VLan", vLan, "VpcId", vpcId, "AccessKeyId", s.client.AccessKey, "AccessKeySecret", s.client.SecretKey, "RegionId", s.client.RegionId),
-	}
-	request.Method = "POST"        // Set request method
-	request.Product = "ascm"       // Specify product
-	request.Version = "2019-05-10" // Specify product version
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "BindBucketPolicy"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request := s.client.NewCommonRequest("POST", "ascm", "2019-05-10", "BindBucketPolicy", "")
+	// "Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",}", "BucketName", bucket, "VpcName", vpcName, "VLan", vLan, "VpcId", vpcId, "AccessKeyId", s.client.AccessKey, "AccessKeySecret", s.client.SecretKey, "RegionId", s.client.RegionId),
+	request.QueryParams["BucketName"] = bucket
+	request.QueryParams["VpcName"] = vpcName
+	request.QueryParams["VLan"] = vLan
+	request.QueryParams["VpcId"] = vpcId
 
 	raw, err := s.client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -144,33 +96,10 @@ func (s *BucketVpcService) BindBucket(vpcId string, vpcName string, vLan string,
 
 func (s *BucketVpcService) UnBindBucket(vpcId string, bucket string) error {
 	var requestInfo *oss.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":  s.client.SecretKey,
-		"Product":          "Ascm",
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"RegionId":         s.client.RegionId,
-		"Action":           "UnBindBucketPolicy",
-		"Version":          "2019-05-10",
-		"SignatureVersion": "2.1",
-		"BucketName":       bucket,
-		"vpcId":            vpcId,
-		// "Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",}", "action", "UnBindBucketPolicy", "product", "Ascm", "region", s.client.Region, "params", "{\"BucketName\":"+bucket+",\"endpoint\":"+""+",\"vpcId\":"+vpcId+"\",}"),
-	}
-	request.Method = "POST"                // Set request method
-	request.Product = "Ascm"               // Specify product
-	request.Version = "2019-05-10"         // Specify product version
-	request.ApiName = "UnBindBucketPolicy" // Specify ApiName
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request := s.client.NewCommonRequest("POST", "Ascm", "2019-05-10", "UnBindBucketPolicy", "")
+	// "Params":           fmt.Sprintf("{\"%s\":%s,\"%s\":%s,\"%s\":\"%s\",\"%s\":\"%s\",}", "action", "UnBindBucketPolicy", "product", "Ascm", "region", s.client.Region, "params", "{\"BucketName\":"+bucket+",\"endpoint\":"+""+",\"vpcId\":"+vpcId+"\",}"),
+	request.QueryParams["BucketName"] = bucket
+	request.QueryParams["vpcId"] = vpcId
 
 	raw, err := s.client.WithEcsClient(func(ossClient *ecs.Client) (interface{}, error) {
 
@@ -1,5 +1,374 @@
 package alibabacloudstack
 
+import (
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
 const ChildInstanceTypeVpc = "VPC"
 const ChildInstanceTypeVbr = "VBR"
 const ChildInstanceTypeCcn = "CCN"
+
+type CenService struct {
+	client *connectivity.AlibabacloudStackClient
+}
+
+func (s *CenService) DescribeCenInstance(id string) (object cbn.Cen, err error) {
+	request := cbn.CreateDescribeCensRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.Filter = &[]cbn.DescribeCensFilter{
+		{
+			Key:   "CenId",
+			Value: &[]string{id},
+		},
+	}
+
+	raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+		return cbnClient.DescribeCens(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterCenInstanceId"}) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("CenInstance", id)), NotFoundMsg, ProviderERROR)
+		}
+		return object, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*cbn.DescribeCensResponse)
+	if len(response.Cens.Cen) < 1 || response.Cens.Cen[0].CenId != id {
+		return object, WrapErrorf(Error(GetNotFoundMessage("CenInstance", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.Cens.Cen[0], nil
+}
+
+func (s *CenService) CenInstanceStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenInstance(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.Status == failState {
+				return object, object.Status, WrapError(Error(FailedToReachTargetStatus, object.Status))
+			}
+		}
+		return object, object.Status, nil
+	}
+}
+
+func (s *CenService) ListCenInstances() (object []cbn.Cen, err error) {
+	request := cbn.CreateDescribeCensRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.DescribeCens(request)
+		})
+		if err != nil {
+			return object, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*cbn.DescribeCensResponse)
+		object = append(object, response.Cens.Cen...)
+		if len(response.Cens.Cen) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return object, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return object, nil
+}
+
+// DescribeCenInstanceAttachment looks up a child instance attached to a CEN. The id is
+// formatted as <cen id>:<child instance id>:<child instance region id>.
+func (s *CenService) DescribeCenInstanceAttachment(id string) (object cbn.DescribeCenAttachedChildInstanceAttributeResponse, err error) {
+	parts, err := ParseResourceId(id, 3)
+	if err != nil {
+		return object, WrapError(err)
+	}
+	instanceType, err := GetCenChildInstanceType(parts[1])
+	if err != nil {
+		return object, WrapError(err)
+	}
+
+	request := cbn.CreateDescribeCenAttachedChildInstanceAttributeRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.CenId = parts[0]
+	request.ChildInstanceId = parts[1]
+	request.ChildInstanceRegionId = parts[2]
+	request.ChildInstanceType = instanceType
+
+	raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+		return cbnClient.DescribeCenAttachedChildInstanceAttribute(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterInstanceId", "ParameterCenInstanceId", "InvalidCenInstanceStatus"}) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("CenInstanceAttachment", id)), NotFoundMsg, ProviderERROR)
+		}
+		return object, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*cbn.DescribeCenAttachedChildInstanceAttributeResponse)
+	if response.ChildInstanceId != parts[1] {
+		return object, WrapErrorf(Error(GetNotFoundMessage("CenInstanceAttachment", id)), NotFoundMsg, ProviderERROR)
+	}
+	return *response, nil
+}
+
+func (s *CenService) WaitForCenInstanceAttachment(id string, status Status, timeout int) error {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		object, err := s.DescribeCenInstanceAttachment(id)
+		if err != nil {
+			if NotFoundError(err) {
+				if status == Deleted {
+					return nil
+				}
+			} else {
+				return WrapError(err)
+			}
+		}
+		if object.Status == string(status) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+	}
+}
+
+func (s *CenService) ListCenInstanceAttachments(cenId, childInstanceType, childInstanceRegionId string) (object []cbn.ChildInstance, err error) {
+	request := cbn.CreateDescribeCenAttachedChildInstancesRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.CenId = cenId
+	request.ChildInstanceType = childInstanceType
+	request.ChildInstanceRegionId = childInstanceRegionId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.DescribeCenAttachedChildInstances(request)
+		})
+		if err != nil {
+			return object, WrapErrorf(err, DefaultErrorMsg, cenId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*cbn.DescribeCenAttachedChildInstancesResponse)
+		object = append(object, response.ChildInstances.ChildInstance...)
+		if len(response.ChildInstances.ChildInstance) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return object, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return object, nil
+}
+
+func (s *CenService) DescribeCenBandwidthPackage(id string) (object cbn.CenBandwidthPackage, err error) {
+	request := cbn.CreateDescribeCenBandwidthPackagesRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.Filter = &[]cbn.DescribeCenBandwidthPackagesFilter{
+		{
+			Key:   "CenBandwidthPackageId",
+			Value: &[]string{id},
+		},
+	}
+
+	raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+		return cbnClient.DescribeCenBandwidthPackages(request)
+	})
+	if err != nil {
+		return object, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*cbn.DescribeCenBandwidthPackagesResponse)
+	packages := response.CenBandwidthPackages.CenBandwidthPackage
+	if len(packages) < 1 || packages[0].CenBandwidthPackageId != id {
+		return object, WrapErrorf(Error(GetNotFoundMessage("CenBandwidthPackage", id)), NotFoundMsg, ProviderERROR)
+	}
+	return packages[0], nil
+}
+
+func (s *CenService) WaitForCenBandwidthPackage(id string, status Status, timeout int) error {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		object, err := s.DescribeCenBandwidthPackage(id)
+		if err != nil {
+			if NotFoundError(err) {
+				if status == Deleted {
+					return nil
+				}
+			} else {
+				return WrapError(err)
+			}
+		}
+		if object.Status == string(status) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+	}
+}
+
+func (s *CenService) UnassociateCenBandwidthPackage(id, cenId string, timeout int) error {
+	request := cbn.CreateUnassociateCenBandwidthPackageRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.CenBandwidthPackageId = id
+	request.CenId = cenId
+
+	err := resource.RetryContext(s.client.Context(), time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.UnassociateCenBandwidthPackage(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", Throttling}) {
+				time.Sleep(5 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return s.WaitForCenBandwidthPackage(id, Idle, timeout)
+}
+
+func (s *CenService) ListCenBandwidthPackages(cenId string) (object []cbn.CenBandwidthPackage, err error) {
+	request := cbn.CreateDescribeCenBandwidthPackagesRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	if cenId != "" {
+		request.Filter = &[]cbn.DescribeCenBandwidthPackagesFilter{
+			{
+				Key:   "CenId",
+				Value: &[]string{cenId},
+			},
+		}
+	}
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.DescribeCenBandwidthPackages(request)
+		})
+		if err != nil {
+			return object, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_bandwidth_packages", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*cbn.DescribeCenBandwidthPackagesResponse)
+		object = append(object, response.CenBandwidthPackages.CenBandwidthPackage...)
+		if len(response.CenBandwidthPackages.CenBandwidthPackage) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return object, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return object, nil
+}
+
+func (s *CenService) ListCenPublishedRouteEntries(cenId, childInstanceId, routeTableId, cidrBlock string) (object []cbn.PublishedRouteEntry, err error) {
+	instanceType, err := GetCenChildInstanceType(childInstanceId)
+	if err != nil {
+		return object, WrapError(err)
+	}
+
+	request := cbn.CreateDescribePublishedRouteEntriesRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.CenId = cenId
+	request.ChildInstanceId = childInstanceId
+	request.ChildInstanceType = instanceType
+	request.ChildInstanceRegionId = s.client.RegionId
+	request.ChildInstanceRouteTableId = routeTableId
+	request.DestinationCidrBlock = cidrBlock
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
+			return cbnClient.DescribePublishedRouteEntries(request)
+		})
+		if err != nil {
+			return object, WrapErrorf(err, DefaultErrorMsg, cenId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*cbn.DescribePublishedRouteEntriesResponse)
+		object = append(object, response.PublishedRouteEntries.PublishedRouteEntry...)
+		if len(response.PublishedRouteEntries.PublishedRouteEntry) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return object, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return object, nil
+}
+
+// DescribeCenRouteEntry looks up a VPC route entry published to a CEN. The id is
+// formatted as <cen id>:<child instance id>:<route table id>:<destination cidr block>.
+func (s *CenService) DescribeCenRouteEntry(id string) (object cbn.PublishedRouteEntry, err error) {
+	parts, err := ParseResourceId(id, 4)
+	if err != nil {
+		return object, WrapError(err)
+	}
+
+	entries, err := s.ListCenPublishedRouteEntries(parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterIllegal", "ParameterIllegalCenInstanceId"}) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("CenRouteEntry", id)), NotFoundMsg, ProviderERROR)
+		}
+		return object, WrapError(err)
+	}
+	for _, entry := range entries {
+		if entry.DestinationCidrBlock == parts[3] && entry.ChildInstanceRouteTableId == parts[2] {
+			return entry, nil
+		}
+	}
+	return object, WrapErrorf(Error(GetNotFoundMessage("CenRouteEntry", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) WaitForCenRouteEntry(id string, status Status, timeout int) error {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		object, err := s.DescribeCenRouteEntry(id)
+		if err != nil {
+			if NotFoundError(err) {
+				if status == Deleted {
+					return nil
+				}
+			} else {
+				return WrapError(err)
+			}
+		}
+		if object.PublishStatus == string(status) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.PublishStatus, string(status), ProviderERROR)
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+	}
+}
@@ -19,22 +19,6 @@ type CmsService struct {
 
 type IspCities []map[string]string
 
-func (s *CmsService) BuildCmsCommonRequest(region string) *requests.CommonRequest {
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	return request
-}
-
-func (s *CmsService) BuildCmsAlarmRequest(id string) *requests.CommonRequest {
-
-	request := s.BuildCmsCommonRequest(s.client.RegionId)
-	request.QueryParams["Id"] = id
-
-	return request
-}
-
 func (s *CmsService) DescribeCmsAlarm(id string) (alarm cms.Alarm, err error) {
 	request := cms.CreateDescribeMetricRuleListRequest()
 	parts, err := ParseResourceId(id, 2)
@@ -47,11 +31,11 @@ func (s *CmsService) DescribeCmsAlarm(id string) (alarm cms.Alarm, err error) {
 		request.RuleName = parts[1]
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms"}
+	request.QueryParams = map[string]string{"Product": "cms"}
 
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var response *cms.DescribeMetricRuleListResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DescribeMetricRuleList(request)
 		})
@@ -121,7 +105,7 @@ func (s *CmsService) ExtractWebhookFromJson(webhookJson string) (string, error)
 func (s *CmsService) DescribeSiteMonitor(id, keyword string) (siteMonitor cms.SiteMonitor, err error) {
 	listRequest := cms.CreateDescribeSiteMonitorListRequest()
 	listRequest.Headers = map[string]string{"RegionId": s.client.RegionId}
-	listRequest.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	listRequest.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	listRequest.Keyword = keyword
 	listRequest.TaskId = id
@@ -147,7 +131,7 @@ func (s *CmsService) DescribeSiteMonitor(id, keyword string) (siteMonitor cms.Si
 func (s *CmsService) GetIspCities(id string) (ispCities IspCities, err error) {
 	request := cms.CreateDescribeSiteMonitorAttributeRequest()
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.TaskId = id
 
@@ -174,7 +158,7 @@ func (s *CmsService) DescribeCmsAlarmContact(id string) (object cms.Contact, err
 	request := cms.CreateDescribeContactListRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.ContactName = id
 
@@ -207,7 +191,7 @@ func (s *CmsService) DescribeCmsAlarmContactGroup(id string) (object cms.Contact
 	request := cms.CreateDescribeContactGroupListRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
@@ -259,14 +243,13 @@ func (s *CmsService) DescribeCmsMetricRuleTemplateList() (templates []cms.Templa
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	PageNumber := 1
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "cms",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"IsDefault":       "false",
-		"History":         "ture",
-		"PageNumber":      fmt.Sprintf("%d", PageNumber),
-		"PageSize":        "20",
+		"Product":       "cms",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"IsDefault":     "false",
+		"History":       "ture",
+		"PageNumber":    fmt.Sprintf("%d", PageNumber),
+		"PageSize":      "20",
 	}
 	for {
 		raw, err := s.client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
@@ -311,10 +294,9 @@ func (s *CmsService) DescribeMetricRuleTemplateAttribute(id string) (object *cms
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "cms",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
+		"Product":       "cms",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
 	}
 	request.TemplateId = id
 	raw, err := s.client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
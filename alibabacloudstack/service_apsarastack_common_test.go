@@ -17,6 +17,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -351,6 +352,50 @@ func resourceTestAccConfigFunc(resourceId string,
 	return basicInfo.configBuild(false)
 }
 
+// get the id to import the resource with, joined from the attributes of its state, for the resources
+// whose id does not hold everything needed to import them
+func resourceImportStateIdFunc(resourceId string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceId]
+		if !ok {
+			return "", fmt.Errorf("resource %s is not found in the state", resourceId)
+		}
+		parts := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			if value := rs.Primary.Attributes[attribute]; value != "" {
+				parts = append(parts, value)
+			}
+		}
+		return strings.Join(parts, COLON_SEPARATED), nil
+	}
+}
+
+// check the resource imported with importId gets the id and the attributes expected, or an error
+// when expected is nil
+func testResourceImportState(t *testing.T, r *schema.Resource, importId, id string, expected map[string]interface{}) {
+	d := r.Data(nil)
+	d.SetId(importId)
+	imported, err := r.Importer.State(d, nil)
+	if expected == nil {
+		if err == nil {
+			t.Errorf("import %s: expected an error", importId)
+		}
+		return
+	}
+	if err != nil || len(imported) != 1 {
+		t.Errorf("import %s: %v", importId, err)
+		return
+	}
+	if imported[0].Id() != id {
+		t.Errorf("import %s: expected the id %s, got %s", importId, id, imported[0].Id())
+	}
+	for k, v := range expected {
+		if actual := imported[0].Get(k); actual != v {
+			t.Errorf("import %s: expected %s to be %v, got %v", importId, k, v, actual)
+		}
+	}
+}
+
 func dataSourceTestAccConfigFunc(resourceId string,
 	name string,
 	configDependence func(name string) string) ResourceTestAccConfigFunc {
@@ -635,7 +680,7 @@ func (s *VpcService) sweepVSwitch(id string) error {
 	log.Printf("[DEBUG] Deleting Vswitch %s ...", id)
 	request := vpc.CreateDeleteVSwitchRequest()
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -703,7 +748,7 @@ func (s *SlbService) sweepSlb(id string) error {
 	request := slb.CreateSetLoadBalancerDeleteProtectionRequest()
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LoadBalancerId = id
 	request.DeleteProtection = "off"
 	_, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -716,7 +761,7 @@ func (s *SlbService) sweepSlb(id string) error {
 	delRequest := slb.CreateDeleteLoadBalancerRequest()
 
 	delRequest.Headers = map[string]string{"RegionId": s.client.RegionId}
-	delRequest.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	delRequest.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	delRequest.LoadBalancerId = id
 	_, err = s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DeleteLoadBalancer(delRequest)
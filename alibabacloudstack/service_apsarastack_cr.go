@@ -2,7 +2,6 @@ package alibabacloudstack
 
 import (
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -244,25 +243,8 @@ func (c *CrService) DescribeCrNamespace(id string) (*crDescribeNamespaceResponse
 
 	var err error
 	response := crDescribeNamespaceResponse{}
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = c.client.Domain
-	request.Version = "2016-06-07"
-	request.Scheme = "http"
-	request.ApiName = "GetNamespace"
-	request.Headers = map[string]string{"RegionId": c.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": c.client.SecretKey,
-		"AccessKeyId":     c.client.AccessKey,
-		"Product":         "cr",
-		"Department":      c.client.Department,
-		"ResourceGroup":   c.client.ResourceGroup,
-		"RegionId":        c.client.RegionId,
-		"Action":          "GetNamespace",
-		"Version":         "2016-06-07",
-		"Namespace":       id,
-	}
+	request := c.client.NewCommonRequest("POST", "cr", "2016-06-07", "GetNamespace", "")
+	request.QueryParams["Namespace"] = id
 	raw, err := c.client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
 	})
@@ -287,26 +269,9 @@ func (c *CrService) DescribeCrRepo(id string) (GetRepoResponse, error) {
 	sli := strings.Split(id, SLASH_SEPARATED)
 	repoNamespace := sli[0]
 	repoName := sli[1]
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "cr"
-	request.Domain = c.client.Domain
-	request.Version = "2016-06-07"
-	request.Scheme = "http"
-	request.ApiName = "GetRepo"
-	request.Headers = map[string]string{"RegionId": c.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": c.client.SecretKey,
-		"AccessKeyId":     c.client.AccessKey,
-		"Product":         "cr",
-		"Department":      c.client.Department,
-		"ResourceGroup":   c.client.ResourceGroup,
-		"RegionId":        c.client.RegionId,
-		"Action":          "GetRepo",
-		"Version":         "2016-06-07",
-		"RepoName":        repoName,
-		"RepoNamespace":   repoNamespace,
-	}
+	request := c.client.NewCommonRequest("POST", "cr", "2016-06-07", "GetRepo", "")
+	request.QueryParams["RepoName"] = repoName
+	request.QueryParams["RepoNamespace"] = repoNamespace
 	raw, err := c.client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
 	})
@@ -4,10 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -46,34 +44,12 @@ func (s *CsService) DescribeCsKubernetes(id string) (cl *cs.KubernetesClusterDet
 	cluster.ClusterId = ""
 	var requestInfo *cs.Client
 	var response interface{}
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "CS",
-		//"Department":       s.client.Department,
-		//"ResourceGroup":    s.client.ResourceGroup,
-		"Action": "DescribeClustersV1",
-		//"AccountInfo":      "123456",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-	}
-	request.Method = "POST" // Set request method
-	request.Product = "Cs"  // Specify product
+	request := s.client.NewCommonRequest("POST", "Cs", "2015-12-15", "DescribeClustersV1", "")
+	//"Department":       s.client.Department,
+	//"ResourceGroup":    s.client.ResourceGroup,
+	//"AccountInfo":      "123456",
 	// request.Domain =       // Location Service will not be enabled if the host is specified. For example, service with a Certification type-Bearer Token should be specified
-	request.Version = "2015-12-15" // Specify product version
-	request.ServiceCode = "cs"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "DescribeClustersV1"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams["ProductName"] = "cs"
 	if err := invoker.Run(func() error {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
@@ -137,35 +113,11 @@ func (s *CsService) DescribeClusterNodes(id, nodepoolid string) (pools *NodePool
 	invoker := NewInvoker()
 	//var requestInfo *cs.Client
 	var response interface{}
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":         s.client.RegionId,
-		"AccessKeySecret":  s.client.SecretKey,
-		"Product":          "CS",
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"Action":           "DescribeClusterNodes",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-		"nodepool_id":      nodepoolid,
-		"ClusterId":        id,
-	}
-	request.Method = "POST" // Set request method
-	request.Product = "Cs"  // Specify product
+	request := s.client.NewCommonRequest("POST", "Cs", "2015-12-15", "DescribeClusterNodes", "")
 	// request.Domain =       // Location Service will not be enabled if the host is specified. For example, service with a Certification type-Bearer Token should be specified
-	request.Version = "2015-12-15" // Specify product version
-	request.ServiceCode = "cs"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "DescribeClusterNodes"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams["ProductName"] = "cs"
+	request.QueryParams["nodepool_id"] = nodepoolid
+	request.QueryParams["ClusterId"] = id
 	var clusternodepools *NodePools
 	if err := invoker.Run(func() error {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -188,32 +140,9 @@ func (s *CsService) DescribeClusterNodes(id, nodepoolid string) (pools *NodePool
 }
 func (s *CsService) DescribeClusterNodePools(id string) (*NodePool, error) {
 	//invoker := NewInvoker()
-	req := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		req.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	req.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "CS",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeClusterNodePools",
-		"Version":         "2015-12-15",
-		"ProductName":     "cs",
-		"ClusterId":       id,
-	}
-	req.Method = "POST"        // Set request method
-	req.Product = "CS"         // Specify product
-	req.Version = "2015-12-15" // Specify product version
-	req.ServiceCode = "cs"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		req.Scheme = "https"
-	} else {
-		req.Scheme = "http"
-	} // Set request scheme. Default: http
-	req.ApiName = "DescribeClusterNodePools"
-	req.Headers = map[string]string{"RegionId": s.client.RegionId}
+	req := s.client.NewCommonRequest("POST", "CS", "2015-12-15", "DescribeClusterNodePools", "")
+	req.QueryParams["ProductName"] = "cs"
+	req.QueryParams["ClusterId"] = id
 
 	//if err = invoker.Run(func() error {
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -279,39 +208,14 @@ func (s *CsService) CsKubernetesNodePoolStateRefreshFunc(id, clusterid string, f
 	}
 }
 func (s *CsService) DescribeCsKubernetesNodePool(id, clusterid string) (*NodePoolAlone, error) {
-	req := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		req.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	req.QueryParams = map[string]string{
-		"RegionId":         s.client.RegionId,
-		"AccessKeySecret":  s.client.SecretKey,
-		"Product":          "CS",
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"Action":           "DescribeClusterNodePoolDetail",
-		"AccountInfo":      "123456",
-		"Version":          "2015-12-15",
-		"SignatureVersion": "1.0",
-		"ProductName":      "cs",
-		"X-acs-body": fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\"}",
-
-			"ClusterId", clusterid,
-			"NodepoolId", id,
-		),
-	}
-	req.Method = "POST"        // Set request method
-	req.Product = "CS"         // Specify product
-	req.Version = "2015-12-15" // Specify product version
-	req.ServiceCode = "cs"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		req.Scheme = "https"
-	} else {
-		req.Scheme = "http"
-	} // Set request scheme. Default: http
-	req.ApiName = "DescribeClusterNodePoolDetail"
-	req.Headers = map[string]string{"RegionId": s.client.RegionId}
-	req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
+	req := s.client.NewCommonRequest("POST", "CS", "2015-12-15", "DescribeClusterNodePoolDetail", "")
+	req.QueryParams["AccountInfo"] = "123456"
+	req.QueryParams["ProductName"] = "cs"
+	req.QueryParams["X-acs-body"] = fmt.Sprintf("{\"%s\":\"%s\",\"%s\":\"%s\"}",
+		"ClusterId", clusterid,
+		"NodepoolId", id,
+	)
+	req.Headers["x-acs-asapi-gateway-version"] = "3.0"
 	//if err = invoker.Run(func() error {
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(req)
@@ -377,7 +281,7 @@ func (s *CsService) UpgradeCluster(clusterId string, args *cs.UpgradeClusterArgs
 }
 
 func (s *CsService) WaitForUpgradeCluster(clusterId string, action string) (string, error) {
-	err := resource.Retry(UpgradeClusterTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(s.client.Context(), UpgradeClusterTimeout, func() *resource.RetryError {
 		resp, err := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.QueryUpgradeClusterResult(clusterId)
 		})
@@ -1,7 +1,6 @@
 package alibabacloudstack
 
 import (
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strings"
 	"time"
@@ -20,35 +19,10 @@ type DatahubService struct {
 
 func (s *DatahubService) DescribeDatahubProject(id string) (*datahub.GetProjectResult, error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
 	resp := &datahub.GetProjectResult{}
-
-	request.Method = "GET"
-	request.Product = "datahub"
-	request.Version = "2019-11-20"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetProject"
-	request.Headers = map[string]string{
-		"RegionId":              s.client.RegionId,
-		"x-acs-resourcegroupid": s.client.ResourceGroup,
-		"x-acs-regionid":        s.client.RegionId,
-		"x-acs-organizationid":  s.client.Department,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "datahub",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "GetProject",
-		"Version":         "2019-11-20",
-		"ProjectName":     id,
-	}
+	request := s.client.NewCommonRequest("GET", "datahub", "2019-11-20", "GetProject", "")
+	request.Domain = s.client.Domain
+	request.QueryParams["ProjectName"] = id
 
 	raw, err := s.client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 		return dataHubClient.ProcessCommonRequest(request)
@@ -99,44 +73,16 @@ func (s *DatahubService) WaitForDatahubProject(id string, status Status, timeout
 func (s *DatahubService) DescribeDatahubSubscription(id string) (*datahub.GetSubscriptionResult, error) {
 	subscription := &datahub.GetSubscriptionResult{}
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
 	parts, err := ParseResourceId(id, 3)
 	if err != nil {
 		return subscription, WrapError(err)
 	}
 	projectName, topicName, subId := parts[0], parts[1], parts[2]
-
-	request.Method = "GET"
-	request.Product = "datahub"
-	request.Version = "2019-11-20"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetSubscriptionOffset"
-	request.Headers = map[string]string{
-		"RegionId":              s.client.RegionId,
-		"x-acs-resourcegroupid": s.client.ResourceGroup,
-		"x-acs-regionid":        s.client.RegionId,
-		"x-acs-organizationid":  s.client.Department,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret":  s.client.SecretKey,
-		"AccessKeyId":      s.client.AccessKey,
-		"Product":          "datahub",
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"RegionId":         s.client.RegionId,
-		"Action":           "GetSubscriptionOffset",
-		"Version":          "2019-11-20",
-		"ProjectName":      projectName,
-		"TopicName":        topicName,
-		"SubscriptionId":   subId,
-		"SignatureMethod":  "HMAC-SHA256",
-		"Format":           "JSON",
-		"SignatureVersion": "2.1",
-	}
+	request := s.client.NewCommonRequest("GET", "datahub", "2019-11-20", "GetSubscriptionOffset", "")
+	request.Domain = s.client.Domain
+	request.QueryParams["ProjectName"] = projectName
+	request.QueryParams["TopicName"] = topicName
+	request.QueryParams["SubscriptionId"] = subId
 
 	raw, err := s.client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 		return dataHubClient.ProcessCommonRequest(request)
@@ -194,39 +140,15 @@ func (s *DatahubService) WaitForDatahubSubscription(id string, status Status, ti
 func (s *DatahubService) DescribeDatahubTopic(id string) (*GetTopicResult, error) {
 	topic := &GetTopicResult{}
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
 	parts, err := ParseResourceId(id, 2)
 	if err != nil {
 		return topic, WrapError(err)
 	}
 	projectName, topicName := parts[0], parts[1]
-	request.Method = "GET"
-	request.Product = "datahub"
-	request.Version = "2019-11-20"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetTopic"
-	request.Headers = map[string]string{
-		"RegionId":              s.client.RegionId,
-		"x-acs-resourcegroupid": s.client.ResourceGroup,
-		"x-acs-regionid":        s.client.RegionId,
-		"x-acs-organizationid":  s.client.Department,
-	}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "datahub",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "GetTopic",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
-	}
+	request := s.client.NewCommonRequest("GET", "datahub", "2019-11-20", "GetTopic", "")
+	request.Domain = s.client.Domain
+	request.QueryParams["ProjectName"] = projectName
+	request.QueryParams["TopicName"] = topicName
 
 	raw, err := s.client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 		return dataHubClient.ProcessCommonRequest(request)
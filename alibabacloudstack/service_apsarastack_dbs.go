@@ -32,7 +32,7 @@ func (s *DbsService) DescribeDbsBackupPlan(id string) (object map[string]interfa
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
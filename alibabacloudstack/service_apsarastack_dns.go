@@ -26,33 +26,10 @@ func (s *DnsService) DescribeDnsRecord(id string) (response *DnsRecord, err erro
 		return response, WrapError(err)
 	}
 	ZoneId := id
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "CloudDns",
-		"Action":          "DescribeGlobalZoneRecords",
-		"Version":         "2021-06-24",
-		"ZoneId":          ZoneId,
-	}
-	request.Method = "POST"
-	request.Product = "CloudDns"
-	request.Version = "2021-06-24"
-	request.ServiceCode = "CloudDns"
-	request.Domain = s.client.Domain
+	request := s.client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "DescribeGlobalZoneRecords", "")
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(2)
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DescribeGlobalZoneRecords"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request.QueryParams["ZoneId"] = ZoneId
 	var resp = &DnsRecord{}
 	raw, err := s.client.WithEcsClient(func(cmsClient *ecs.Client) (interface{}, error) {
 		return cmsClient.ProcessCommonRequest(request)
@@ -83,7 +60,7 @@ func (s *DnsService) DescribeDnsGroup(id string) (alidns.DomainGroup, error) {
 	var group alidns.DomainGroup
 	request := alidns.CreateDescribeDomainGroupsRequest()
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = s.client.Department
 	request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 	request.RegionId = s.client.RegionId
@@ -121,7 +98,7 @@ func (s *DnsService) ListTagResources(id string) (object alidns.ListTagResources
 	request := alidns.CreateListTagResourcesRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = s.client.Department
 	request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -143,7 +120,7 @@ func (s *DnsService) DescribeDnsDomainAttachment(id string) (object alidns.Descr
 	request := alidns.CreateDescribeInstanceDomainsRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = s.client.Department
 	request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -221,7 +198,7 @@ func (s *DnsService) SetResourceTags(d *schema.ResourceData, resourceType string
 		request := alidns.CreateUntagResourcesRequest()
 		request.RegionId = s.client.RegionId
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = s.client.Department
 		request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -240,7 +217,7 @@ func (s *DnsService) SetResourceTags(d *schema.ResourceData, resourceType string
 		request := alidns.CreateTagResourcesRequest()
 		request.RegionId = s.client.RegionId
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = s.client.Department
 		request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -262,34 +239,14 @@ func (s *DnsService) DescribeDnsDomain(id string) (response *DnsDomains, err err
 	var requestInfo *ecs.Client
 	did := strings.Split(id, COLON_SEPARATED)
 
-	request := requests.NewCommonRequest()
-	request.Method = "POST"          // Set request method
-	request.Product = "CloudDns"     // Specify product
-	request.Domain = s.client.Domain // Location Service will not be enabled if the host is specified. For example, service with a Certification type-Bearer Token should be specified
-	request.Version = "2021-06-24"   // Specify product version
+	request := s.client.NewCommonRequest("POST", "CloudDns", "2021-06-24", "DescribeGlobalZones", "")
+	//"Id":              did[1],
 	request.PageNumber = requests.NewInteger(2)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DescribeGlobalZones"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudDns",
-		"RegionId":        s.client.RegionId,
-		"Action":          "DescribeGlobalZones",
-		"Version":         "2021-06-24",
-		//"Id":              did[1],
-		"Name":              did[0],
-		"Forwardedregionid": s.client.RegionId,
-		"SignatureVersion":  "2.1",
-		"PageNumber":        fmt.Sprint(1),
-		"PageSize":          fmt.Sprint(PageSizeLarge),
-	}
+	request.QueryParams["Name"] = did[0]
+	request.QueryParams["Forwardedregionid"] = s.client.RegionId
+	request.QueryParams["PageNumber"] = fmt.Sprint(1)
+	request.QueryParams["PageSize"] = fmt.Sprint(PageSizeLarge)
 	resp := &DnsDomains{}
 	raw, err := s.client.WithEcsClient(func(cmsClient *ecs.Client) (interface{}, error) {
 		return cmsClient.ProcessCommonRequest(request)
@@ -27,10 +27,13 @@ func (s *DtsService) DescribeDtsJobMonitorRule(id string) (object map[string]int
 		"RegionId": s.client.RegionId,
 		"DtsJobId": id,
 	}
+	request["product"] = "Dts"
+	request["OrganizationId"] = s.client.Department
+	request["ResourceId"] = s.client.ResourceGroup
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -43,6 +46,9 @@ func (s *DtsService) DescribeDtsJobMonitorRule(id string) (object map[string]int
 	})
 	addDebug(action, response, request)
 	if err != nil {
+		if IsExpectedErrors(err, []string{"Forbidden.InstanceNotFound"}) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("DTS:JobMonitorRule", id)), NotFoundMsg, ProviderERROR, fmt.Sprint(response["RequestId"]))
+		}
 		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
 	}
 	if fmt.Sprint(response["Success"]) == "false" {
@@ -56,6 +62,26 @@ func (s *DtsService) DescribeDtsJobMonitorRule(id string) (object map[string]int
 	return object, nil
 }
 
+// DescribeDtsJobMonitorRuleByType returns the monitor rule of a type, with an id like <dts_job_id>:<type>.
+func (s *DtsService) DescribeDtsJobMonitorRuleByType(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return object, WrapError(err)
+	}
+	response, err := s.DescribeDtsJobMonitorRule(parts[0])
+	if err != nil {
+		return object, WrapError(err)
+	}
+	rules, _ := response["MonitorRules"].([]interface{})
+	for _, v := range rules {
+		rule, ok := v.(map[string]interface{})
+		if ok && fmt.Sprint(rule["Type"]) == parts[1] {
+			return rule, nil
+		}
+	}
+	return object, WrapErrorf(Error(GetNotFoundMessage("DTS:JobMonitorRule", id)), NotFoundMsg, ProviderERROR)
+}
+
 func (s *DtsService) DescribeDtsSubscriptionJob(id string) (object map[string]interface{}, err error) {
 	var response map[string]interface{}
 	conn, err := s.client.NewDtsClient()
@@ -74,7 +100,7 @@ func (s *DtsService) DescribeDtsSubscriptionJob(id string) (object map[string]in
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequestWithOrg(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -147,7 +173,7 @@ func (s *DtsService) DescribeDtsSynchronizationInstance(id string) (object map[s
 		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -208,7 +234,7 @@ func (s *DtsService) ListTagResources(id string, resourceType string) (object in
 
 	for {
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 			response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
 			if err != nil {
 				if IsExpectedErrors(err, []string{Throttling}) {
@@ -266,7 +292,7 @@ func (s *DtsService) SetResourceTags(d *schema.ResourceData, resourceType string
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
 				if err != nil {
 					if IsThrottling(err) {
@@ -298,7 +324,7 @@ func (s *DtsService) SetResourceTags(d *schema.ResourceData, resourceType string
 			}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
 				if err != nil {
 					if IsThrottling(err) {
@@ -338,7 +364,7 @@ func (s *DtsService) DescribeDtsSynchronizationJob(id string) (object map[string
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -389,7 +415,7 @@ func (s *DtsService) DescribeDtsJobDetail(id string) (object map[string]interfac
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
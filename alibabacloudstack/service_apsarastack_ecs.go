@@ -38,7 +38,7 @@ func (s *EcsService) JudgeRegionValidation(key, region string) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeRegions(request)
 	})
@@ -71,7 +71,7 @@ func (s *EcsService) DescribeZone(id string) (zone ecs.Zone, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeZones(request)
 	})
@@ -104,7 +104,7 @@ func (s *EcsService) DescribeZones(d *schema.ResourceData) (zones []ecs.Zone, er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	//request.SpotStrategy = d.Get("spot_strategy").(string)
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeZones(request)
@@ -141,12 +141,12 @@ func (s *EcsService) DescribeInstance(id string) (instance ecs.Instance, err err
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.InstanceIds = convertListToJsonString([]interface{}{id})
 
 	var response *ecs.DescribeInstancesResponse
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstances(request)
 		})
@@ -184,7 +184,7 @@ func (s *EcsService) DescribeInstanceAttribute(id string) (instance ecs.Describe
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeInstanceAttribute(request)
 	})
@@ -213,10 +213,10 @@ func (s *EcsService) DescribeInstanceSystemDisk(id, rg string) (disk ecs.Disk, e
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	var response *ecs.DescribeDisksResponse
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeDisks(request)
 		})
@@ -280,7 +280,7 @@ func (s *EcsService) JoinSecurityGroups(instanceId string, securityGroupIds []st
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	for _, sid := range securityGroupIds {
 		request.SecurityGroupId = sid
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -305,7 +305,7 @@ func (s *EcsService) LeaveSecurityGroups(instanceId string, securityGroupIds []s
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	for _, sid := range securityGroupIds {
 		request.SecurityGroupId = sid
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -330,7 +330,7 @@ func (s *EcsService) DescribeSecurityGroup(id string) (group ecs.DescribeSecurit
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeSecurityGroupAttribute(request)
 	})
@@ -371,7 +371,7 @@ func (s *EcsService) DescribeSecurityGroupRule(id string) (rule ecs.Permission,
 	request.NicType = nicType
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeSecurityGroupAttribute(request)
 	})
@@ -420,7 +420,7 @@ func (s *EcsService) DescribeAvailableResources(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DestinationResource = string(destination)
 	request.IoOptimized = string(IOOptimized)
 
@@ -529,7 +529,7 @@ func (s *EcsService) QueryInstancesWithKeyPair(instanceIdsStr, keyPair string) (
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	request.InstanceIds = instanceIdsStr
@@ -566,20 +566,7 @@ func (s *EcsService) QueryInstancesWithKeyPair(instanceIdsStr, keyPair string) (
 
 func (s *EcsService) DescribeKeyPair(id string) (keyPair ecs.KeyPair, err error) {
 	request := ecs.CreateDescribeKeyPairsRequest()
-	request.RegionId = s.client.RegionId
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     s.client.AccessKey,
-		"AccessKeySecret": s.client.SecretKey,
-		"SecurityToken":   s.client.Config.SecurityToken,
-		"Product":         "ecs",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup}
+	s.client.InitRpcRequest(request.RpcRequest)
 	request.KeyPairName = id
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeKeyPairs(request)
@@ -626,7 +613,7 @@ func (s *EcsService) DescribeDisk(id string) (disk ecs.Disk, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeDisks(request)
 	})
@@ -667,7 +654,7 @@ func (s *EcsService) DescribeDisksByType(instanceId string, diskType DiskType) (
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	if instanceId != "" {
 		request.InstanceId = instanceId
 	}
@@ -696,7 +683,7 @@ func (s *EcsService) DescribeTags(resourceId string, resourceType TagResourceTyp
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ResourceType = string(resourceType)
 	request.ResourceId = resourceId
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -725,7 +712,7 @@ func (s *EcsService) DescribeImageById(id string) (image ecs.Image, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ImageId = id
 	request.ImageOwnerAlias = "self"
 	request.Status = fmt.Sprintf("%s,%s,%s,%s,%s", "Creating", "Waiting", "Available", "UnAvailable", "CreateFailed")
@@ -765,7 +752,7 @@ func (s *EcsService) deleteImage(d *schema.ResourceData) error {
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ImageId = object.ImageId
 
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -777,7 +764,7 @@ func (s *EcsService) deleteImage(d *schema.ResourceData) error {
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutCreate), 3*time.Second, s.ImageStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.client.Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -803,7 +790,7 @@ func (s *EcsService) updateImage(d *schema.ResourceData) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ImageId = d.Id()
 
 	if d.HasChange("description") || d.HasChange("name") || d.HasChange("image_name") {
@@ -842,7 +829,7 @@ func (s *EcsService) DescribeNetworkInterface(id string) (networkInterface ecs.N
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	eniIds := []string{id}
 	request.NetworkInterfaceId = &eniIds
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -917,7 +904,7 @@ func (s *EcsService) DescribeNetworkInterfaceAttachment(id string) (networkInter
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.InstanceId = instanceId
 	eniIds := []string{eniId}
 	request.NetworkInterfaceId = &eniIds
@@ -1036,7 +1023,7 @@ func (s *EcsService) deleteImageforDest(d *schema.ResourceData, region string) e
 	}
 	request := ecs.CreateDeleteImageRequest()
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	if force, ok := d.GetOk("force"); ok {
 		request.Force = requests.NewBoolean(force.(bool))
 	}
@@ -1057,7 +1044,7 @@ func (s *EcsService) deleteImageforDest(d *schema.ResourceData, region string) e
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutCreate), 3*time.Second, s.ImageStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.client.Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1073,7 +1060,7 @@ func (s *EcsService) DescribeImage(id, region string) (image ecs.Image, err erro
 	}
 	request.ImageId = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs"}
+	request.QueryParams = map[string]string{"Product": "ecs"}
 	request.Status = fmt.Sprintf("%s,%s,%s,%s,%s", "Creating", "Waiting", "Available", "UnAvailable", "CreateFailed")
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeImages(request)
@@ -1344,10 +1331,10 @@ func (s *EcsService) AttachKeyPair(keyName string, instanceIds []interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.KeyPairName = keyName
 	request.InstanceIds = convertListToJsonString(instanceIds)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachKeyPair(request)
 		})
@@ -1434,7 +1421,7 @@ func (s *EcsService) DescribeTaskById(id string) (task *ecs.DescribeTaskAttribut
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeTaskAttribute(request)
 	})
@@ -1460,7 +1447,7 @@ func (s *EcsService) DescribeSnapshot(id string) (*ecs.Snapshot, error) {
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.SnapshotIds = fmt.Sprintf("[\"%s\"]", id)
 	request.QueryParams["SnapshotId"] = id
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1493,7 +1480,7 @@ func (s *EcsService) DescribeSnapshotPolicy(id string) (*ecs.AutoSnapshotPolicy,
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeAutoSnapshotPolicyEx(request)
 	})
@@ -1522,7 +1509,7 @@ func (s *EcsService) DescribeReservedInstance(id string) (reservedInstance ecs.R
 	request.ReservedInstanceId = balance
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeReservedInstances(request)
 	})
@@ -1598,7 +1585,7 @@ func (s *EcsService) DescribeLaunchTemplate(id string) (set ecs.LaunchTemplateSe
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LaunchTemplateId = &[]string{id}
 
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1630,7 +1617,7 @@ func (s *EcsService) DescribeLaunchTemplateVersion(id string, version int) (set
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LaunchTemplateId = id
 	request.LaunchTemplateVersion = &[]string{strconv.FormatInt(int64(version), 10)}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1689,7 +1676,7 @@ func (s *EcsService) DescribeImageShareByImageId(id string) (imageShare *ecs.Des
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	parts, err := ParseResourceId(id, 2)
 	if err != nil {
 		return imageShare, WrapError(err)
@@ -1747,7 +1734,7 @@ func (s *EcsService) DescribeAutoProvisioningGroup(id string) (group ecs.AutoPro
 	request.AutoProvisioningGroupId = &ids
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, e := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeAutoProvisioningGroups(request)
 	})
@@ -1812,7 +1799,7 @@ func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		request.ResourceId = &[]string{d.Id()}
 		request.ResourceType = resourceType
 		request.TagKey = &removed
@@ -1836,7 +1823,7 @@ func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		request.ResourceId = &[]string{d.Id()}
 		request.ResourceType = resourceType
 		request.Tag = &added
@@ -1880,7 +1867,7 @@ func (s *EcsService) SetResourceTagsNew(d *schema.ResourceData, resourceType str
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "ascm"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
@@ -1914,7 +1901,7 @@ func (s *EcsService) SetResourceTagsNew(d *schema.ResourceData, resourceType str
 			}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "ascm"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
@@ -1971,7 +1958,7 @@ func (s *EcsService) SetSystemDiskTags(d *schema.ResourceData) error {
 		request[fmt.Sprintf("TagKey.%d", i+1)] = key
 	}
 	wait := incrementalWait(2*time.Second, 1*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 		request["Product"] = "ascm"
 		request["OrganizationId"] = s.client.Department
 		response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
@@ -2000,7 +1987,7 @@ func (s *EcsService) SetSystemDiskTags(d *schema.ResourceData) error {
 		add_request[fmt.Sprintf("Tag.%d.Value", count)] = value
 		count++
 	}
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 		add_request["Product"] = "ascm"
 		add_request["OrganizationId"] = s.client.Department
 		response, err := conn.DoRequest(StringPointer("TagResources"), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, add_request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
@@ -2062,7 +2049,7 @@ func (s *EcsService) UpdateSystemDiskTags(d *schema.ResourceData) error {
 			request[fmt.Sprintf("TagKey.%d", i+1)] = key
 		}
 		wait := incrementalWait(2*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 			request["Product"] = "ascm"
 			request["OrganizationId"] = s.client.Department
 			response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
@@ -2096,7 +2083,7 @@ func (s *EcsService) UpdateSystemDiskTags(d *schema.ResourceData) error {
 		}
 
 		wait := incrementalWait(2*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 			request["Product"] = "ascm"
 			request["OrganizationId"] = s.client.Department
 			response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
@@ -2127,7 +2114,7 @@ func (s *EcsService) DescribeEcsDedicatedHost(id string) (object ecs.DedicatedHo
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
@@ -2194,33 +2181,8 @@ func (s *EcsService) DescribeEcsEbsStorageSet(id string) (result *datahub.EcsDes
 
 	resp := &datahub.EcsDescribeEcsEbsStorageSetsResult{}
 	action := "DescribeStorageSets"
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "Ecs"
-	request.Domain = s.client.Domain
-	request.Version = "2014-05-26"
-	request.RegionId = s.client.RegionId
-	request.ApiName = action
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["x-ascm-product-name"] = "Ecs"
-	request.Headers["x-acs-organizationId"] = s.client.Department
-
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"RegionId":        s.client.RegionId,
-		"Product":         "Ecs",
-		"Version":         "2014-05-26",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          action,
-		"PageNumber":      "1",
-	}
+	request := s.client.NewCommonRequest("GET", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["PageNumber"] = "1"
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
@@ -2260,33 +2222,8 @@ func (s *EcsService) DescribeEcsCommand(id string) (result *datahub.EcsDescribeE
 
 	resp := &datahub.EcsDescribeEcsCommandResult{}
 	action := "DescribeCommands"
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "Ecs"
-	request.Domain = s.client.Domain
-	request.Version = "2014-05-26"
-	request.RegionId = s.client.RegionId
-	request.ApiName = action
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.Headers["x-ascm-product-name"] = "Ecs"
-	request.Headers["x-acs-organizationId"] = s.client.Department
-
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"RegionId":        s.client.RegionId,
-		"Product":         "Ecs",
-		"Version":         "2014-05-26",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          action,
-		"CommandId":       id,
-	}
+	request := s.client.NewCommonRequest("GET", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["CommandId"] = id
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
@@ -2321,6 +2258,85 @@ func (s *EcsService) DescribeEcsCommand(id string) (result *datahub.EcsDescribeE
 	//object = v.([]interface{})[0].(map[string]interface{})
 	return resp, nil
 }
+func (s *EcsService) DescribeEcsInvocation(id string) (object ecs.Invocation, err error) {
+	request := ecs.CreateDescribeInvocationsRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.InvokeId = id
+	request.IncludeOutput = requests.NewBoolean(true)
+	request.ContentEncoding = "PlainText"
+
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeInvocations(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidInvokeId.NotFound"}) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR)
+		}
+		return object, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.DescribeInvocationsResponse)
+	for _, invocation := range response.Invocations.Invocation {
+		if invocation.InvokeId == id {
+			return invocation, nil
+		}
+	}
+	return object, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR, response.RequestId)
+}
+
+func (s *EcsService) EcsInvocationStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeEcsInvocation(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.InvocationStatus == failState {
+				return object, object.InvocationStatus, WrapError(Error(FailedToReachTargetStatus, object.InvocationStatus))
+			}
+		}
+		return object, object.InvocationStatus, nil
+	}
+}
+
+func (s *EcsService) ListEcsInvocations(commandId, instanceId, invokeStatus string) (invocations []ecs.Invocation, err error) {
+	request := ecs.CreateDescribeInvocationsRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.CommandId = commandId
+	request.InstanceId = instanceId
+	request.InvokeStatus = invokeStatus
+	request.IncludeOutput = requests.NewBoolean(true)
+	request.ContentEncoding = "PlainText"
+	request.PageNumber = requests.NewInteger(1)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+
+	for {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeInvocations(request)
+		})
+		if err != nil {
+			return invocations, WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_invocations", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ecs.DescribeInvocationsResponse)
+		invocations = append(invocations, response.Invocations.Invocation...)
+		if len(response.Invocations.Invocation) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return invocations, WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+	return invocations, nil
+}
+
 func (s *EcsService) DescribeEcsHpcCluster(id string) (result *datahub.EcsDescribeEcsHpcClusterResult, err error) {
 	//var response map[string]interface{}
 
@@ -2337,30 +2353,9 @@ func (s *EcsService) DescribeEcsHpcCluster(id string) (result *datahub.EcsDescri
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	ClientToken := buildClientToken("DescribeHpcClusters")
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Product = "Ecs"
-	request.Domain = s.client.Domain
-	request.Version = "2014-05-26"
-	request.RegionId = s.client.RegionId
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = action
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"RegionId":        s.client.RegionId,
-		"Product":         "Ecs",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          action,
-		"HpcClusterIds":   string(ids),
-		"ClientToken":     ClientToken,
-	}
+	request := s.client.NewCommonRequest("POST", "Ecs", "2014-05-26", action, "")
+	request.QueryParams["HpcClusterIds"] = string(ids)
+	request.QueryParams["ClientToken"] = ClientToken
 
 	raw, err := s.client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 		return EcsClient.ProcessCommonRequest(request)
@@ -2411,7 +2406,7 @@ func (s *EcsService) DescribeEcsDeploymentSet(id string) (object map[string]inte
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -12,7 +13,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/elasticsearch"
@@ -64,34 +64,12 @@ func (s *ElasticsearchService) DescribeElasticsearchInstance(id string) (object
 }
 
 func (s *ElasticsearchService) DescribeElasticsearchOnk8sInstance(id string) (object map[string]interface{}, err error) {
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "elasticsearch-k8s",
-		"product":         "elasticsearch-k8s",
-		"Action":          "DescribeInstance",
-		"Version":         "2017-06-13",
-		"InstanceId":      id,
-		"ClientToken":     buildClientToken("DescribeInstance"),
-		"OrganizationId":  s.client.Department,
-	}
-	request.Method = "POST"
-	request.Product = "elasticsearch-k8s"
-	request.Version = "2017-06-13"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DescribeInstance"
-	request.RegionId = s.client.RegionId
-	request.Headers = map[string]string{"RegionId": s.client.RegionId, "Content-Type": "application/json"}
+	request := s.client.NewCommonRequest("POST", "elasticsearch-k8s", "2017-06-13", "DescribeInstance", "")
+	request.Headers["Content-Type"] = "application/json"
+	request.QueryParams["product"] = "elasticsearch-k8s"
+	request.QueryParams["InstanceId"] = id
+	request.QueryParams["ClientToken"] = buildClientToken("DescribeInstance")
+	request.QueryParams["OrganizationId"] = s.client.Department
 
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -148,7 +126,7 @@ func (s *ElasticsearchService) ElasticsearchRetryFunc(wait func(), errorCodeList
 	var raw interface{}
 	var err error
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithElasticsearchClient(do)
 
 		if err != nil {
@@ -193,7 +171,7 @@ func (s *ElasticsearchService) TriggerNetwork(d *schema.ResourceData, content ma
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, s.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.client.Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -218,7 +196,7 @@ func (s *ElasticsearchService) ModifyWhiteIps(d *schema.ResourceData, content ma
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction", "InternalServerError"}) || NeedRetry(err) {
@@ -237,7 +215,7 @@ func (s *ElasticsearchService) ModifyWhiteIps(d *schema.ResourceData, content ma
 
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, s.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.client.Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -258,7 +236,7 @@ func (s *ElasticsearchService) DescribeElasticsearchTags(id string) (tags map[st
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "elasticsearch", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ResourceIds = string(resourceIds)
 	request.ResourceType = strings.ToUpper(string(TagResourceInstance))
 	raw, err := s.client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
@@ -345,35 +323,13 @@ func updateDescription(d *schema.ResourceData, meta interface{}) error {
 	// }
 	// return nil
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "elasticsearch-k8s",
-		"product":         "elasticsearch-k8s",
-		"Action":          "UpdateDescription",
-		"Version":         "2017-06-13",
-		"InstanceId":      d.Id(),
-		"description":     d.Get("description").(string),
-		"ClientToken":     buildClientToken("UpdateDescription"),
-		"OrganizationId":  client.Department,
-	}
-	request.Method = "POST"
-	request.Product = "elasticsearch-k8s"
-	request.Version = "2017-06-13"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "UpdateDescription"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId, "Content-Type": "application/json"}
+	request := client.NewCommonRequest("POST", "elasticsearch-k8s", "2017-06-13", "UpdateDescription", "")
+	request.Headers["Content-Type"] = "application/json"
+	request.QueryParams["product"] = "elasticsearch-k8s"
+	request.QueryParams["InstanceId"] = d.Id()
+	request.QueryParams["description"] = d.Get("description").(string)
+	request.QueryParams["ClientToken"] = buildClientToken("UpdateDescription")
+	request.QueryParams["OrganizationId"] = client.Department
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -442,7 +398,7 @@ func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.ResourceIds = string(resourceIds)
 		raw, err := client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			return elasticsearchClient.UntagResources(request)
@@ -475,7 +431,7 @@ func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		raw, err := client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			return elasticsearchClient.TagResources(request)
@@ -556,7 +512,7 @@ func renewInstance(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updateDataNodeAmount(d *schema.ResourceData, meta interface{}) error {
+func updateDataNodeAmount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	conn, err := client.NewElasticsearchClient()
@@ -577,7 +533,7 @@ func updateDataNodeAmount(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
@@ -598,14 +554,14 @@ func updateDataNodeAmount(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(meta.(*connectivity.AlibabacloudStackClient).Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func updateDataNodeSpec(d *schema.ResourceData, meta interface{}) error {
+func updateDataNodeSpec(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	conn, err := client.NewElasticsearchClient()
@@ -631,7 +587,7 @@ func updateDataNodeSpec(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
@@ -652,14 +608,14 @@ func updateDataNodeSpec(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(meta.(*connectivity.AlibabacloudStackClient).Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func updateMasterNode(d *schema.ResourceData, meta interface{}) error {
+func updateMasterNode(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	conn, err := client.NewElasticsearchClient()
@@ -691,7 +647,7 @@ func updateMasterNode(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
@@ -712,13 +668,13 @@ func updateMasterNode(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(meta.(*connectivity.AlibabacloudStackClient).Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
 }
 
-func updatePassword(d *schema.ResourceData, meta interface{}) error {
+func updatePassword(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	conn, err := client.NewElasticsearchClient()
@@ -757,7 +713,7 @@ func updatePassword(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
@@ -778,7 +734,7 @@ func updatePassword(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(meta.(*connectivity.AlibabacloudStackClient).Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -805,7 +761,7 @@ func filterWhitelist(destIPs []string, localIPs *schema.Set) []string {
 	return whitelist
 }
 
-func updateClientNode(d *schema.ResourceData, meta interface{}) error {
+func updateClientNode(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	conn, err := client.NewElasticsearchClient()
@@ -839,7 +795,7 @@ func updateClientNode(d *schema.ResourceData, meta interface{}) error {
 
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
@@ -860,14 +816,14 @@ func updateClientNode(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(meta.(*connectivity.AlibabacloudStackClient).Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func openHttps(d *schema.ResourceData, meta interface{}) error {
+func openHttps(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	conn, err := client.NewElasticsearchClient()
@@ -889,7 +845,7 @@ func openHttps(d *schema.ResourceData, meta interface{}) error {
 
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
@@ -910,13 +866,13 @@ func openHttps(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(meta.(*connectivity.AlibabacloudStackClient).Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
 }
 
-func closeHttps(d *schema.ResourceData, meta interface{}) error {
+func closeHttps(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	conn, err := client.NewElasticsearchClient()
@@ -937,7 +893,7 @@ func closeHttps(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
@@ -958,7 +914,7 @@ func closeHttps(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(meta.(*connectivity.AlibabacloudStackClient).Context()); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
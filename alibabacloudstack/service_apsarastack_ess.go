@@ -20,7 +20,7 @@ func (s *EssService) DescribeEssAlarm(id string) (alarm ess.Alarm, err error) {
 	request := ess.CreateDescribeAlarmsRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.AlarmTaskId = id
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -45,7 +45,7 @@ func (s *EssService) DescribeEssAlarm(id string) (alarm ess.Alarm, err error) {
 	AlarmsRequest := ess.CreateDescribeAlarmsRequest()
 	AlarmsRequest.RegionId = s.client.RegionId
 	AlarmsRequest.Headers = map[string]string{"RegionId": s.client.RegionId}
-	AlarmsRequest.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	AlarmsRequest.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
 		AlarmsRequest.Scheme = "https"
 	} else {
@@ -79,7 +79,7 @@ func (s *EssService) DescribeEssLifecycleHook(id string) (hook ess.LifecycleHook
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeLifecycleHooks(request)
 	})
@@ -132,7 +132,7 @@ func (s *EssService) DescribeEssNotification(id string) (notification ess.Notifi
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ScalingGroupId = scalingGroupId
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeNotificationConfigurations(request)
@@ -188,7 +188,7 @@ func (s *EssService) DescribeEssScalingGroup(id string) (group ess.ScalingGroup,
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, e := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScalingGroups(request)
 	})
@@ -217,7 +217,7 @@ func (s *EssService) DescribeEssScalingConfiguration(id string) (config ess.Scal
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScalingConfigurations(request)
 	})
@@ -248,7 +248,7 @@ func (s *EssService) ActiveEssScalingConfiguration(sgId, id string) error {
 	request.ActiveScalingConfigurationId = id
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.ModifyScalingGroup(request)
 	})
@@ -337,7 +337,7 @@ func (s *EssService) DescribeEssScalingRule(id string) (rule ess.ScalingRule, er
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScalingRules(request)
 	})
@@ -391,7 +391,7 @@ func (s *EssService) DescribeEssScheduledTask(id string) (task ess.ScheduledTask
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScheduledTasks(request)
 	})
@@ -445,7 +445,7 @@ func (srv *EssService) DescribeEssAttachment(id string, instanceIds []string) (i
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": srv.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": srv.client.SecretKey, "Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
 
 	request.ScalingGroupId = id
 	s := reflect.ValueOf(request).Elem()
@@ -456,24 +456,38 @@ func (srv *EssService) DescribeEssAttachment(id string, instanceIds []string) (i
 		}
 	}
 
-	raw, err := srv.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
-		return essClient.DescribeScalingInstances(request)
-	})
-	if err != nil {
-		if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
-			err = WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+	// The instances are listed page by page, as all the instances of the group are returned without instance ids.
+	request.PageNumber = requests.NewInteger(1)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for {
+		raw, err := srv.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			return essClient.DescribeScalingInstances(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
+				err = WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+			} else {
+				err = WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			}
+			return instances, err
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ess.DescribeScalingInstancesResponse)
+		instances = append(instances, response.ScalingInstances.ScalingInstance...)
+		if len(response.ScalingInstances.ScalingInstance) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return instances, WrapError(err)
 		} else {
-			err = WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			request.PageNumber = page
 		}
-		return
 	}
-	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	response, _ := raw.(*ess.DescribeScalingInstancesResponse)
-	if len(response.ScalingInstances.ScalingInstance) < 1 {
+	if len(instances) < 1 {
 		err = WrapErrorf(Error(GetNotFoundMessage("EssAttachment", id)), NotFoundMsg, ProviderERROR)
 		return
 	}
-	return response.ScalingInstances.ScalingInstance, nil
+	return instances, nil
 }
 
 func (s *EssService) DescribeEssScalingConfifurations(id string) (configs []ess.ScalingConfiguration, err error) {
@@ -488,7 +502,7 @@ func (s *EssService) DescribeEssScalingConfifurations(id string) (configs []ess.
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	for {
 		raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.DescribeScalingConfigurations(request)
@@ -543,7 +557,7 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 	}
 
 	removed := instanceIds
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(srv.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		request := ess.CreateRemoveInstancesRequest()
 		request.ScalingGroupId = id
 		request.RegionId = srv.client.RegionId
@@ -553,7 +567,7 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": srv.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": srv.client.SecretKey, "Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
 
 		if len(removed) > 0 {
 			request.InstanceId = &removed
@@ -631,6 +645,69 @@ func (s *EssService) WaitForEssScalingGroup(id string, status Status, timeout in
 	}
 }
 
+// EssScalingInstancesStateRefreshFunc returns the lifecycle state shared by the instances, or "Mixed"
+// while they are in different states.
+func (s *EssService) EssScalingInstancesStateRefreshFunc(id string, instanceIds []string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instances, err := s.DescribeEssAttachment(id, instanceIds)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		state := instances[0].LifecycleState
+		for _, instance := range instances {
+			for _, failState := range failStates {
+				if instance.LifecycleState == failState {
+					return instances, instance.LifecycleState, WrapError(Error(FailedToReachTargetStatus, instance.LifecycleState))
+				}
+			}
+			if instance.LifecycleState != state {
+				state = "Mixed"
+			}
+		}
+		return instances, state, nil
+	}
+}
+
+// CheckEssInstancesNotIn returns an error if any of the instances is in the given lifecycle state. An
+// instance is either protected or in standby, so the protection and standby resources must not share it.
+func (s *EssService) CheckEssInstancesNotIn(id string, instanceIds []string, lifecycleState string) error {
+	instances, err := s.DescribeEssAttachment(id, instanceIds)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	for _, instance := range instances {
+		if instance.LifecycleState == lifecycleState {
+			return WrapError(Error("The instance %s of the scaling group %s is %s, remove it from the %s instances first.", instance.InstanceId, id, lifecycleState, strings.ToLower(lifecycleState)))
+		}
+	}
+	return nil
+}
+
+func (s *EssService) DescribeEssSuspendProcess(id string) (group ess.ScalingGroup, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return group, WrapError(err)
+	}
+	group, err = s.DescribeEssScalingGroup(parts[0])
+	if err != nil {
+		return group, WrapError(err)
+	}
+	for _, process := range group.SuspendedProcesses.SuspendedProcess {
+		if process == parts[1] {
+			return group, nil
+		}
+	}
+	return group, WrapErrorf(Error(GetNotFoundMessage("EssSuspendProcess", id)), NotFoundMsg, ProviderERROR)
+}
+
 // ess dimensions to map
 func (s *EssService) flattenDimensionsToMap(dimensions []ess.Dimension) map[string]string {
 	result := make(map[string]string)
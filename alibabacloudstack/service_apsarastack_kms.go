@@ -11,6 +11,7 @@ import (
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,7 +25,7 @@ func (s *KmsService) DescribeKmsKey(id string) (object kms.KeyMetadata, err erro
 	request := kms.CreateDescribeKeyRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.KeyId = id
 
@@ -57,7 +58,7 @@ func (s *KmsService) Decrypt(ciphertextBlob string, encryptionContext map[string
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.CiphertextBlob = ciphertextBlob
 	request.EncryptionContext = string(context[:])
@@ -90,7 +91,7 @@ func (s *KmsService) Decrypt2(ciphertextBlob string, encryptionContext map[strin
 	request["Product"] = "Kms"
 	request["OrganizationId"] = s.client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-01-20"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
 		if err != nil {
 			if NeedRetry(err) {
@@ -117,7 +118,7 @@ func (s *KmsService) DescribeKmsSecret(id string) (object kms.DescribeSecretResp
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.SecretName = id
 	request.FetchTags = "true"
@@ -143,7 +144,7 @@ func (s *KmsService) GetSecretValue(id string) (object kms.GetSecretValueRespons
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.SecretName = id
 
@@ -181,7 +182,7 @@ func (s *KmsService) setResourceTags(d *schema.ResourceData, resourceType string
 		request.RegionId = s.client.RegionId
 
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 		if resourceType == "key" {
 			request.KeyId = d.Id()
@@ -207,7 +208,7 @@ func (s *KmsService) setResourceTags(d *schema.ResourceData, resourceType string
 		request.RegionId = s.client.RegionId
 
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 		if resourceType == "key" {
 			request.KeyId = d.Id()
@@ -236,7 +237,7 @@ func (s *KmsService) DescribeKmsAlias(id string) (object kms.KeyMetadata, err er
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.KeyId = id
 
@@ -266,7 +267,7 @@ func (s *KmsService) DescribeKmsKeyVersion(id string) (object kms.DescribeKeyVer
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.KeyId = parts[0]
 	request.KeyVersionId = parts[1]
@@ -275,6 +276,10 @@ func (s *KmsService) DescribeKmsKeyVersion(id string) (object kms.DescribeKeyVer
 		return kmsClient.DescribeKeyVersion(request)
 	})
 	if err != nil {
+		if IsExpectedErrors(err, []string{"Forbidden.KeyNotFound", "Forbidden.ResourceNotFound"}) {
+			err = WrapErrorf(Error(GetNotFoundMessage("KmsKeyVersion", id)), NotFoundMsg, ProviderERROR)
+			return
+		}
 		err = WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		return
 	}
@@ -282,3 +287,32 @@ func (s *KmsService) DescribeKmsKeyVersion(id string) (object kms.DescribeKeyVer
 	response, _ := raw.(*kms.DescribeKeyVersionResponse)
 	return *response, nil
 }
+
+func (s *KmsService) ListKmsKeyVersions(keyId string) (object []kms.KeyVersion, err error) {
+	request := kms.CreateListKeyVersionsRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+
+	request.KeyId = keyId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
+			return kmsClient.ListKeyVersions(request)
+		})
+		if err != nil {
+			return object, WrapErrorf(err, DefaultErrorMsg, keyId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*kms.ListKeyVersionsResponse)
+		object = append(object, response.KeyVersions.KeyVersion...)
+		if len(response.KeyVersions.KeyVersion) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return object, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return object, nil
+}
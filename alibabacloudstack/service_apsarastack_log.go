@@ -1,11 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
-	"strings"
 	"time"
 
 	slsPop "github.com/aliyun/alibaba-cloud-sdk-go/services/sls"
@@ -45,31 +44,11 @@ func (s *LogService) DescribeLogProject(id string) (*LogProject, error) {
 	//project := &sls.LogProject{}
 	var project = &responses.CommonResponse{}
 	var requestInfo *sls.Client
-	request := requests.NewCommonRequest()
-	request.Method = "POST"          // Set request method
-	request.Product = "SLS"          // Specify product
-	request.Domain = s.client.Domain // Location Service will not be enabled if the host is specified. For example, service with a Certification type-Bearer Token should be specified
-	request.Version = "2020-03-31"   // Specify product version
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "GetProject"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "SLS",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "GetProject",
-		"Version":         "2020-03-31",
-		"projectName":     id,
-	}
+	request := s.client.NewCommonRequest("POST", "SLS", "2020-03-31", "GetProject", "")
+	request.Domain = s.client.Domain
+	request.QueryParams["projectName"] = id
 	var logProject *LogProject
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
 		})
@@ -139,7 +118,7 @@ func (s *LogService) DescribeLogStore(id string) (*sls.LogStore, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetLogStore(projectName, name)
@@ -206,7 +185,7 @@ func (s *LogService) DescribeLogStoreIndex(id string) (*sls.Index, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetIndex(projectName, name)
@@ -248,7 +227,7 @@ func (s *LogService) DescribeLogMachineGroup(id string) (*sls.MachineGroup, erro
 	}
 	projectName, groupName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetMachineGroup(projectName, groupName)
@@ -317,7 +296,7 @@ func (s *LogService) DescribeLogtailConfig(id string) (*sls.LogConfig, error) {
 	}
 	projectName, configName := parts[0], parts[2]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetConfig(projectName, configName)
@@ -384,7 +363,7 @@ func (s *LogService) DescribeLogtailAttachment(id string) (groupName string, err
 	projectName, configName, name := parts[0], parts[1], parts[2]
 	var groupNames []string
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
@@ -457,7 +436,7 @@ func (s *LogService) DescribeLogAlert(id string) (*sls.Alert, error) {
 	}
 	projectName, alertName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetAlert(projectName, alertName)
@@ -523,7 +502,7 @@ func (s *LogService) CreateLogDashboard(project, name string) error {
 		DashboardName: name,
 		ChartList:     []sls.Chart{},
 	}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return nil, slsClient.CreateDashboard(project, dashboard)
 		})
@@ -531,7 +510,7 @@ func (s *LogService) CreateLogDashboard(project, name string) error {
 			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
 				return resource.RetryableError(err)
 			}
-			if err.(*sls.Error).Message == "specified dashboard already exists" {
+			if e, ok := err.(*sls.Error); ok && e.Message == "specified dashboard already exists" {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -551,12 +530,12 @@ func (s *LogService) CreateLogDashboard(project, name string) error {
 	return nil
 }
 
-func CreateDashboard(project, name string, client *sls.Client) error {
+func CreateDashboard(ctx context.Context, project, name string, client *sls.Client) error {
 	dashboard := sls.Dashboard{
 		DashboardName: name,
 		ChartList:     []sls.Chart{},
 	}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		err := client.CreateDashboard(project, dashboard)
 		if err != nil {
 			if err.(*sls.Error).Message == "specified dashboard already exists" {
@@ -581,7 +560,10 @@ func CreateDashboard(project, name string, client *sls.Client) error {
 func (s *LogService) DescribeLogAudit(id string) (*slsPop.DescribeAppResponse, error) {
 	request := slsPop.CreateDescribeAppRequest()
 	response := &slsPop.DescribeAppResponse{}
+	request.RegionId = s.client.RegionId
 	request.AppName = "audit"
+	request.Headers["x-ascm-product-name"] = "Sls"
+	request.Headers["x-acs-organizationId"] = s.client.Department
 	raw, err := s.client.WithLogPopClient(func(client *slsPop.Client) (interface{}, error) {
 		return client.DescribeApp(request)
 	})
@@ -590,6 +572,7 @@ func (s *LogService) DescribeLogAudit(id string) (*slsPop.DescribeAppResponse, e
 		if IsExpectedErrors(err, []string{"AppNotExist"}) {
 			return response, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
 		}
+		return response, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ = raw.(*slsPop.DescribeAppResponse)
@@ -621,7 +604,7 @@ func (s *LogService) DescribeLogDashboard(id string) (*sls.Dashboard, error) {
 	}
 	projectName, dashboardName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetDashboard(projectName, dashboardName)
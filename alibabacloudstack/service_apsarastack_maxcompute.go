@@ -12,7 +12,6 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -26,47 +25,21 @@ func (s *MaxcomputeService) DescribeMaxcomputeProject(name string) (object *MaxC
 	client := s.client
 	var requestInfo *ecs.Client
 
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-
 	roleId, err := client.RoleIds()
 	if err != nil {
 		err = WrapErrorf(Error(GetNotFoundMessage("ASCM User", "defaultRoleId")), NotFoundMsg, ProviderERROR)
 		return nil, err
 	}
 
-	request.QueryParams = map[string]string{
-		"Action":          "ListCalcEnginesForAscm",
-		"ResourceGroupId": client.ResourceGroup,
-		"Product":         "dataworks-private-cloud",
-		"CalcEngineType":  "ODPS", // 固定值
-		"OrganizationId":  client.Department,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"CurrentRoleId":   strconv.Itoa(roleId),
-	}
-
+	request := client.NewCommonRequest("POST", "dataworks-private-cloud", "2019-01-17", "ListCalcEnginesForAscm", "")
+	request.QueryParams["ResourceGroupId"] = client.ResourceGroup
+	request.QueryParams["CalcEngineType"] = "ODPS" // 固定值
+	request.QueryParams["OrganizationId"] = client.Department
+	request.QueryParams["CurrentRoleId"] = strconv.Itoa(roleId)
 	if strings.Trim(name, " ") != "" {
 		request.QueryParams["Name"] = name
 	}
 
-	request.Method = "POST"
-	request.Product = "dataworks-private-cloud"
-	request.Version = "2019-01-17"
-	request.ServiceCode = "dataworks-private-cloud"
-	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ListCalcEnginesForAscm"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId}
-
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
@@ -138,40 +111,17 @@ func (s *MaxcomputeService) DescribeMaxcomputeCu(name string) (object map[string
 
 func (s *MaxcomputeService) DescribeMaxcomputeUser(name string) (response *OdpsUser, err error) {
 	var requestInfo *ecs.Client
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-
 	roleId, err := s.client.RoleIds()
 	if err != nil {
 		err = WrapErrorf(Error(GetNotFoundMessage("ASCM User", "defaultRoleId")), NotFoundMsg, ProviderERROR)
 		return nil, err
 	}
 
-	request.Method = "POST"
-	request.Product = "ascm"
-	request.Version = "2019-05-10"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetOdpsUserList"
-	request.Headers = map[string]string{
-		"RegionId":              s.client.RegionId,
-		"x-acs-roleid":          strconv.Itoa(roleId),
-		"x-acs-resourcegroupid": s.client.ResourceGroup,
-		"x-acs-regionid":        s.client.RegionId,
-		"x-acs-organizationid":  s.client.Department,
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"UserName":        name,
-		"Product":         "ascm",
-		"OrganizationId":  s.client.Department,
-		"ResourceGroupId": s.client.ResourceGroup,
-	}
+	request := s.client.NewCommonRequest("POST", "ascm", "2019-05-10", "GetOdpsUserList", "")
+	request.Headers["x-acs-roleid"] = strconv.Itoa(roleId)
+	request.QueryParams["UserName"] = name
+	request.QueryParams["OrganizationId"] = s.client.Department
+	request.QueryParams["ResourceGroupId"] = s.client.ResourceGroup
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
@@ -2,11 +2,9 @@ package alibabacloudstack
 
 import (
 	"encoding/json"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"strings"
 )
 
 type OnsService struct {
@@ -16,32 +14,10 @@ type OnsService struct {
 func (s *OnsService) DescribeOnsInstance(instanceid string) (response *OnsInstance, err error) {
 	var requestInfo *ecs.Client
 
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleInstanceBaseInfo",
-		"Version":         "2018-02-05",
-		"OnsRegionId":     s.client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      instanceid,
-	}
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.ServiceCode = "Ons-inner"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ConsoleInstanceBaseInfo"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleInstanceBaseInfo", "")
+	request.QueryParams["OnsRegionId"] = s.client.RegionId
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["InstanceId"] = instanceid
 	var resp = &OnsInstance{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -83,33 +59,11 @@ func (s *OnsService) DescribeOnsTopic(id string) (response *Topic, err error) {
 	}
 	TopicId := did[0]
 	InstanceId := did[1]
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleTopicList",
-		"Version":         "2018-02-05",
-		"topic":           TopicId,
-		"OnsRegionId":     s.client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      InstanceId,
-	}
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.ServiceCode = "Ons-inner"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ConsoleTopicList"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleTopicList", "")
+	request.QueryParams["topic"] = TopicId
+	request.QueryParams["OnsRegionId"] = s.client.RegionId
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["InstanceId"] = InstanceId
 	var resp = &Topic{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -142,33 +96,11 @@ func (s *OnsService) DescribeOnsGroup(id string) (response *OnsGroup, err error)
 	}
 	GroupId := did[0]
 	InstanceId := did[1]
-	request := requests.NewCommonRequest()
-	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleGroupList",
-		"Version":         "2018-02-05",
-		"GroupId":         GroupId,
-		"OnsRegionId":     s.client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      InstanceId,
-	}
-	request.Method = "POST"
-	request.Product = "Ons-inner"
-	request.Version = "2018-02-05"
-	request.ServiceCode = "Ons-inner"
-	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "ConsoleGroupList"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.RegionId = s.client.RegionId
+	request := s.client.NewCommonRequest("POST", "Ons-inner", "2018-02-05", "ConsoleGroupList", "")
+	request.QueryParams["GroupId"] = GroupId
+	request.QueryParams["OnsRegionId"] = s.client.RegionId
+	request.QueryParams["PreventCache"] = ""
+	request.QueryParams["InstanceId"] = InstanceId
 	var resp = &OnsGroup{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
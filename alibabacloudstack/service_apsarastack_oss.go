@@ -2,16 +2,18 @@ package alibabacloudstack
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 // OssService *connectivity.AlibabacloudStackClient
@@ -23,35 +25,11 @@ func (s *OssService) DescribeOssBucket(id string) (response oss.GetBucketInfoRes
 	//request := map[string]string{"bucketName": id, "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	var requestInfo *oss.Client
 
-	request := requests.NewCommonRequest()
-	if s.client.Config.Insecure {
-		request.SetHTTPSInsecure(s.client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-
-		"AccessKeySecret":  s.client.SecretKey,
-		"Product":          "OneRouter",
-		"Department":       s.client.Department,
-		"ResourceGroup":    s.client.ResourceGroup,
-		"RegionId":         s.client.RegionId,
-		"Action":           "DoOpenApi",
-		"AccountInfo":      "123456",
-		"Version":          "2018-12-12",
-		"SignatureVersion": "1.0",
-		"OpenApiAction":    "GetService",
-		"ProductName":      "oss",
-	}
-	request.Method = "POST"        // Set request method
-	request.Product = "OneRouter"  // Specify product
-	request.Version = "2018-12-12" // Specify product version
-	request.ServiceCode = "OneRouter"
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	} // Set request scheme. Default: http
-	request.ApiName = "DoOpenApi"
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request := s.client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+	request.Domain = s.client.Domain
+	request.QueryParams["AccountInfo"] = "123456"
+	request.QueryParams["OpenApiAction"] = "GetService"
+	request.QueryParams["ProductName"] = "oss"
 
 	var bucketList = &BucketList{}
 	raw, err := s.client.WithOssNewClient(func(ossClient *ecs.Client) (interface{}, error) {
@@ -151,37 +129,231 @@ func (s *OssService) WaitForOssBucket(id string, status Status, timeout int) err
 	}
 }
 
+// doOssOneRouterRequest sends an OSS bucket API call through the OneRouter DoOpenApi gateway.
+// params holds the API parameters besides BucketName and content the XML request body, if any.
+func (s *OssService) doOssOneRouterRequest(bucketName, action string, params map[string]interface{}, content string) (response map[string]interface{}, err error) {
+	var requestInfo *oss.Client
+	apiParams := map[string]interface{}{"BucketName": bucketName}
+	for k, v := range params {
+		apiParams[k] = v
+	}
+	paramsJson, err := json.Marshal(apiParams)
+	if err != nil {
+		return response, WrapError(err)
+	}
+	request := s.client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoOpenApi", "")
+	request.Domain = s.client.Domain
+	request.QueryParams["OpenApiAction"] = action
+	request.QueryParams["ProductName"] = "oss"
+	request.QueryParams["Params"] = string(paramsJson)
+	if content != "" {
+		request.QueryParams["Content"] = content
+	}
+
+	raw, err := s.client.WithOssNewClient(func(ossClient *ecs.Client) (interface{}, error) {
+		return ossClient.ProcessCommonRequest(request)
+	})
+	if err != nil {
+		if ossNotFoundError(err) {
+			return response, WrapErrorf(err, NotFoundMsg, AlibabacloudStackOssGoSdk)
+		}
+		return response, WrapErrorf(err, DefaultErrorMsg, bucketName, action, AlibabacloudStackOssGoSdk)
+	}
+	addDebug(action, raw, requestInfo, request)
+	bresponse, _ := raw.(*responses.CommonResponse)
+	if err := json.Unmarshal(bresponse.GetHttpContentBytes(), &response); err != nil {
+		return response, WrapError(err)
+	}
+	if code := fmt.Sprint(response["code"]); code != "200" {
+		message := fmt.Sprint(response["message"])
+		if strings.Contains(message, "NoSuch") {
+			return response, WrapErrorf(Error(GetNotFoundMessage("OssBucket", bucketName)), NotFoundMsg, ProviderERROR)
+		}
+		return response, WrapErrorf(Error("%s: %s", code, message), DefaultErrorMsg, bucketName, action, AlibabacloudStackOssGoSdk)
+	}
+	return response, nil
+}
+
+// DescribeOssBucketLifecycle returns the raw lifecycle rules of a bucket, or an empty list if it has none.
+func (s *OssService) DescribeOssBucketLifecycle(bucketName string) (rules []map[string]interface{}, err error) {
+	response, err := s.doOssOneRouterRequest(bucketName, "GetBucketLifecycle", nil, "")
+	if err != nil {
+		if NotFoundError(err) || IsExpectedErrors(err, []string{"NoSuchLifecycle"}) {
+			return rules, nil
+		}
+		return rules, WrapError(err)
+	}
+	v, err := jsonpath.Get("$.Data.LifecycleConfiguration.Rule", response)
+	if err != nil {
+		return rules, nil
+	}
+	return ossResponseElements(v), nil
+}
+
+func (s *OssService) PutOssBucketLifecycle(bucketName string, config oss.LifecycleConfiguration) error {
+	content, err := xml.Marshal(config)
+	if err != nil {
+		return WrapError(err)
+	}
+	_, err = s.doOssOneRouterRequest(bucketName, "PutBucketLifecycle", nil, string(content))
+	return err
+}
+
+func (s *OssService) DeleteOssBucketLifecycle(bucketName string) error {
+	_, err := s.doOssOneRouterRequest(bucketName, "DeleteBucketLifecycle", nil, "")
+	if err != nil && !NotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *OssService) DescribeOssBucketTags(bucketName string) (tags map[string]string, err error) {
+	tags = make(map[string]string)
+	response, err := s.doOssOneRouterRequest(bucketName, "GetBucketTags", nil, "")
+	if err != nil {
+		// buckets without tags and deployments without bucket tagging are read as having no tags.
+		if NotFoundError(err) || IsExpectedErrors(err, append([]string{"NoSuchTagSet"}, OssUnsupportedErrors...)) {
+			return tags, nil
+		}
+		return tags, WrapError(err)
+	}
+	v, err := jsonpath.Get("$.Data.Tagging.TagSet.Tag", response)
+	if err != nil {
+		return tags, nil
+	}
+	for _, tag := range ossResponseElements(v) {
+		tags[fmt.Sprint(tag["Key"])] = fmt.Sprint(tag["Value"])
+	}
+	return tags, nil
+}
+
+func (s *OssService) PutOssBucketTags(bucketName string, tags map[string]interface{}) error {
+	if len(tags) == 0 {
+		_, err := s.doOssOneRouterRequest(bucketName, "DeleteBucketTags", nil, "")
+		if err != nil && !NotFoundError(err) {
+			return err
+		}
+		return nil
+	}
+	tagging := oss.Tagging{}
+	for k, v := range tags {
+		tagging.Tags = append(tagging.Tags, oss.Tag{Key: k, Value: v.(string)})
+	}
+	content, err := xml.Marshal(tagging)
+	if err != nil {
+		return WrapError(err)
+	}
+	_, err = s.doOssOneRouterRequest(bucketName, "PutBucketTags", nil, string(content))
+	return err
+}
+
+func (s *OssService) DescribeOssBucketReplication(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return object, WrapError(err)
+	}
+	response, err := s.doOssOneRouterRequest(parts[0], "GetBucketReplication", nil, "")
+	if err != nil {
+		if NotFoundError(err) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("OssBucketReplication", id)), NotFoundMsg, ProviderERROR)
+		}
+		return object, WrapError(err)
+	}
+	v, err := jsonpath.Get("$.Data.ReplicationConfiguration.Rule", response)
+	if err != nil {
+		return object, WrapErrorf(Error(GetNotFoundMessage("OssBucketReplication", id)), NotFoundWithResponse, response)
+	}
+	for _, rule := range ossResponseElements(v) {
+		if fmt.Sprint(rule["ID"]) == parts[1] {
+			return rule, nil
+		}
+	}
+	return object, WrapErrorf(Error(GetNotFoundMessage("OssBucketReplication", id)), NotFoundWithResponse, response)
+}
+
+func (s *OssService) OssBucketReplicationStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeOssBucketReplication(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		status := fmt.Sprint(object["Status"])
+		for _, failState := range failStates {
+			if status == failState {
+				return object, status, WrapError(Error(FailedToReachTargetStatus, status))
+			}
+		}
+		return object, status, nil
+	}
+}
+
+func (s *OssService) DescribeOssBucketWorm(id string) (object map[string]interface{}, err error) {
+	response, err := s.doOssOneRouterRequest(id, "GetBucketWorm", nil, "")
+	if err != nil {
+		if NotFoundError(err) || IsExpectedErrors(err, []string{"NoSuchWORMConfiguration"}) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("OssBucketWorm", id)), NotFoundMsg, ProviderERROR)
+		}
+		return object, WrapError(err)
+	}
+	v, err := jsonpath.Get("$.Data.WormConfiguration", response)
+	if err != nil {
+		return object, WrapErrorf(Error(GetNotFoundMessage("OssBucketWorm", id)), NotFoundWithResponse, response)
+	}
+	object, ok := v.(map[string]interface{})
+	if !ok || fmt.Sprint(object["WormId"]) == "" {
+		return object, WrapErrorf(Error(GetNotFoundMessage("OssBucketWorm", id)), NotFoundWithResponse, response)
+	}
+	return object, nil
+}
+
+// ListOssBucketObjects returns all objects of the bucket whose key starts with prefix.
+func (s *OssService) ListOssBucketObjects(bucketName, prefix string) (objects []oss.ObjectProperties, err error) {
+	var requestInfo *oss.Client
+	marker := ""
+	for {
+		options := []oss.Option{oss.MaxKeys(1000)}
+		if prefix != "" {
+			options = append(options, oss.Prefix(prefix))
+		}
+		if marker != "" {
+			options = append(options, oss.Marker(marker))
+		}
+		raw, err := s.client.WithOssBucketByName(bucketName, func(bucket *oss.Bucket) (interface{}, error) {
+			requestInfo = &bucket.Client
+			return bucket.ListObjects(options...)
+		})
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, bucketName, "ListObjects", AlibabacloudStackOssGoSdk)
+		}
+		if debugOn() {
+			addDebug("ListObjects", raw, requestInfo, map[string]interface{}{"options": options})
+		}
+		response, _ := raw.(oss.ListObjectsResult)
+		objects = append(objects, response.Objects...)
+
+		marker = response.NextMarker
+		if len(response.Objects) < 1 || marker == "" {
+			break
+		}
+	}
+	return objects, nil
+}
+
 func (s *OssService) HeadOssBucketObject(bucketName string, objectName string) error {
 	client := s.client
 	var requestInfo *oss.Client
-	request := requests.NewCommonRequest()
-	if client.Config.Insecure {
-		request.SetHTTPSInsecure(client.Config.Insecure)
-	}
-	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "OneRouter",
-		"Action":          "DoApi",
-		"AppAction":       "HeadObject",
-		"AppName":         "one-console-app-oss",
-		"Version":         "2018-12-12",
-		"Params":          "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + bucketName + "\",\"objectName\":\"" + objectName + "\"}}",
-		"AccountInfo":     "",
-	}
-	request.Method = "POST"
-	request.Product = "OneRouter"
-	request.Version = "2018-12-12"
+	request := client.NewCommonRequest("POST", "OneRouter", "2018-12-12", "DoApi", "")
 	request.Domain = client.Domain
-	if strings.ToLower(client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "DoApi"
-	request.RegionId = client.RegionId
-	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceid": bucketName}
+	request.Headers["x-acs-instanceid"] = bucketName
+	request.QueryParams["AppAction"] = "HeadObject"
+	request.QueryParams["AppName"] = "one-console-app-oss"
+	request.QueryParams["Params"] = "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + bucketName + "\",\"objectName\":\"" + objectName + "\"}}"
+	request.QueryParams["AccountInfo"] = ""
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
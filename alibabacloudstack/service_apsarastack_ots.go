@@ -41,7 +41,7 @@ func (s *OtsService) ListOtsTable(instanceName string) (table *tablestore.ListTa
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.ListTable()
@@ -83,7 +83,7 @@ func (s *OtsService) DescribeOtsTable(id string) (*tablestore.DescribeTableRespo
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.DescribeTable(request)
@@ -158,7 +158,7 @@ func (s *OtsService) ListOtsInstance(pageSize int, pageNum int) ([]string, error
 	req.Headers = map[string]string{"RegionId": s.client.RegionId}
 	req.Domain = s.client.Domain
 
-	req.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	var allInstanceNames []string
 
 	for {
@@ -193,32 +193,12 @@ func (s *OtsService) ListOtsInstance(pageSize int, pageNum int) ([]string, error
 }
 
 func (s *OtsService) DescribeOtsInstance(id string) (inst InstanceInfo, err error) {
-	request := requests.NewCommonRequest()
-	request.Method = "GET"
-	request.Product = "Ots"
-	request.Version = "2016-06-20"
+	request := s.client.NewCommonRequest("GET", "Ots", "2016-06-20", "GetInstance", "")
 	request.Domain = s.client.Domain
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		request.Scheme = "https"
-	} else {
-		request.Scheme = "http"
-	}
-	request.ApiName = "GetInstance"
-	request.RegionId = s.client.RegionId
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{
-		"AccessKeyId":     s.client.AccessKey,
-		"InstanceName":    id,
-		"RegionId":        s.client.RegionId,
-		"apiName":         "GetInstance",
-		"Action":          "GetInstance",
-		"version":         "2016-06-20",
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "Ots",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"ResourceOwnerId": s.client.ResourceGroup,
-	}
+	request.QueryParams["InstanceName"] = id
+	request.QueryParams["apiName"] = "GetInstance"
+	request.QueryParams["version"] = "2016-06-20"
+	request.QueryParams["ResourceOwnerId"] = s.client.ResourceGroup
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
 	})
@@ -254,7 +234,7 @@ func (s *OtsService) DescribeOtsInstanceAttachment(id string) (inst ots.VpcInfo,
 	request.InstanceName = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.ListVpcInfoByInstance(request)
 	})
@@ -303,7 +283,7 @@ func (s *OtsService) ListOtsInstanceVpc(id string) (inst []ots.VpcInfo, err erro
 	request.InstanceName = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.ListVpcInfoByInstance(request)
 	})
@@ -354,7 +334,7 @@ func (s *OtsService) DescribeOtsInstanceTypes() (types []string, err error) {
 	request.Method = requests.GET
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.ListClusterType(request)
 	})
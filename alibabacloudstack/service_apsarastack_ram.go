@@ -533,12 +533,11 @@ func (s *RamService) DescribeRamRoleAttachment(id string) (*ecs.DescribeInstance
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup,
+		"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup,
 	}
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", parts[1])
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstanceRamRole(request)
 		})
@@ -815,3 +814,268 @@ func (s *RamService) DescribeRamAccountPasswordPolicy(id string) (*ram.GetPasswo
 
 	return response, nil
 }
+
+// AttachRamPolicy attaches the policy to the principal, which is one of "user", "group" and "role".
+func (s *RamService) AttachRamPolicy(principal, policyName, policyType, principalName string) error {
+	var request *requests.RpcRequest
+	var do func(ramClient *ram.Client) (interface{}, error)
+	switch principal {
+	case "user":
+		attach := ram.CreateAttachPolicyToUserRequest()
+		attach.PolicyName, attach.PolicyType, attach.UserName = policyName, policyType, principalName
+		request, do = attach.RpcRequest, func(ramClient *ram.Client) (interface{}, error) { return ramClient.AttachPolicyToUser(attach) }
+	case "group":
+		attach := ram.CreateAttachPolicyToGroupRequest()
+		attach.PolicyName, attach.PolicyType, attach.GroupName = policyName, policyType, principalName
+		request, do = attach.RpcRequest, func(ramClient *ram.Client) (interface{}, error) { return ramClient.AttachPolicyToGroup(attach) }
+	case "role":
+		attach := ram.CreateAttachPolicyToRoleRequest()
+		attach.PolicyName, attach.PolicyType, attach.RoleName = policyName, policyType, principalName
+		request, do = attach.RpcRequest, func(ramClient *ram.Client) (interface{}, error) { return ramClient.AttachPolicyToRole(attach) }
+	default:
+		return WrapError(Error("the ram policy principal %s is not supported", principal))
+	}
+	s.client.InitRpcRequest(request)
+	raw, err := s.client.WithRamClient(do)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, principalName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request, request.QueryParams)
+	return nil
+}
+
+// DetachRamPolicy detaches the policy from the principal, which is one of "user", "group" and "role".
+// A policy or a principal which no longer exists is considered detached.
+func (s *RamService) DetachRamPolicy(principal, policyName, policyType, principalName string) error {
+	var request *requests.RpcRequest
+	var do func(ramClient *ram.Client) (interface{}, error)
+	switch principal {
+	case "user":
+		detach := ram.CreateDetachPolicyFromUserRequest()
+		detach.PolicyName, detach.PolicyType, detach.UserName = policyName, policyType, principalName
+		request, do = detach.RpcRequest, func(ramClient *ram.Client) (interface{}, error) { return ramClient.DetachPolicyFromUser(detach) }
+	case "group":
+		detach := ram.CreateDetachPolicyFromGroupRequest()
+		detach.PolicyName, detach.PolicyType, detach.GroupName = policyName, policyType, principalName
+		request, do = detach.RpcRequest, func(ramClient *ram.Client) (interface{}, error) { return ramClient.DetachPolicyFromGroup(detach) }
+	case "role":
+		detach := ram.CreateDetachPolicyFromRoleRequest()
+		detach.PolicyName, detach.PolicyType, detach.RoleName = policyName, policyType, principalName
+		request, do = detach.RpcRequest, func(ramClient *ram.Client) (interface{}, error) { return ramClient.DetachPolicyFromRole(detach) }
+	default:
+		return WrapError(Error("the ram policy principal %s is not supported", principal))
+	}
+	s.client.InitRpcRequest(request)
+	raw, err := s.client.WithRamClient(do)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"EntityNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, principalName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request, request.QueryParams)
+	return nil
+}
+
+// ClearRamUser removes the access keys, the login profile, the group memberships and the policies of
+// the user, which must all be gone before the user can be deleted.
+func (s *RamService) ClearRamUser(userName string) error {
+	listAccessKeysRequest := ram.CreateListAccessKeysRequest()
+	s.client.InitRpcRequest(listAccessKeysRequest.RpcRequest)
+	listAccessKeysRequest.UserName = userName
+	raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.ListAccessKeys(listAccessKeysRequest)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, userName, listAccessKeysRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(listAccessKeysRequest.GetActionName(), raw, listAccessKeysRequest.RpcRequest, listAccessKeysRequest)
+	for _, accessKey := range raw.(*ram.ListAccessKeysResponse).AccessKeys.AccessKey {
+		request := ram.CreateDeleteAccessKeyRequest()
+		s.client.InitRpcRequest(request.RpcRequest)
+		request.UserName = userName
+		request.UserAccessKeyId = accessKey.AccessKeyId
+		raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.DeleteAccessKey(request)
+		})
+		if err != nil && !IsExpectedErrors(err, []string{"EntityNotExist"}) {
+			return WrapErrorf(err, DefaultErrorMsg, userName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	deleteLoginProfileRequest := ram.CreateDeleteLoginProfileRequest()
+	s.client.InitRpcRequest(deleteLoginProfileRequest.RpcRequest)
+	deleteLoginProfileRequest.UserName = userName
+	raw, err = s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.DeleteLoginProfile(deleteLoginProfileRequest)
+	})
+	if err != nil && !IsExpectedErrors(err, []string{"EntityNotExist"}) {
+		return WrapErrorf(err, DefaultErrorMsg, userName, deleteLoginProfileRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(deleteLoginProfileRequest.GetActionName(), raw, deleteLoginProfileRequest.RpcRequest, deleteLoginProfileRequest)
+
+	listGroupsRequest := ram.CreateListGroupsForUserRequest()
+	s.client.InitRpcRequest(listGroupsRequest.RpcRequest)
+	listGroupsRequest.UserName = userName
+	raw, err = s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.ListGroupsForUser(listGroupsRequest)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, userName, listGroupsRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(listGroupsRequest.GetActionName(), raw, listGroupsRequest.RpcRequest, listGroupsRequest)
+	for _, group := range raw.(*ram.ListGroupsForUserResponse).Groups.Group {
+		if err := s.RemoveRamUserFromGroup(userName, group.GroupName); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	listPoliciesRequest := ram.CreateListPoliciesForUserRequest()
+	s.client.InitRpcRequest(listPoliciesRequest.RpcRequest)
+	listPoliciesRequest.UserName = userName
+	raw, err = s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.ListPoliciesForUser(listPoliciesRequest)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, userName, listPoliciesRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(listPoliciesRequest.GetActionName(), raw, listPoliciesRequest.RpcRequest, listPoliciesRequest)
+	for _, policy := range raw.(*ram.ListPoliciesForUserResponse).Policies.Policy {
+		if err := s.DetachRamPolicy("user", policy.PolicyName, policy.PolicyType, userName); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
+
+// ClearRamGroup removes the users and the policies of the group, which must all be gone before the
+// group can be deleted.
+func (s *RamService) ClearRamGroup(groupName string) error {
+	object, err := s.DescribeRamGroupMembership(groupName)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	for _, user := range object.Users.User {
+		if err := s.RemoveRamUserFromGroup(user.UserName, groupName); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	request := ram.CreateListPoliciesForGroupRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.GroupName = groupName
+	raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.ListPoliciesForGroup(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, groupName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	for _, policy := range raw.(*ram.ListPoliciesForGroupResponse).Policies.Policy {
+		if err := s.DetachRamPolicy("group", policy.PolicyName, policy.PolicyType, groupName); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
+
+// ClearRamPolicy deletes the versions of the custom policy but the default one, and detaches it from
+// all of its users, groups and roles when detach is set, so that the policy can be deleted.
+func (s *RamService) ClearRamPolicy(policyName string, detach bool) error {
+	if detach {
+		request := ram.CreateListEntitiesForPolicyRequest()
+		s.client.InitRpcRequest(request.RpcRequest)
+		request.PolicyName = policyName
+		request.PolicyType = "Custom"
+		raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+			return ramClient.ListEntitiesForPolicy(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, policyName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ram.ListEntitiesForPolicyResponse)
+		for _, user := range response.Users.User {
+			if err := s.DetachRamPolicy("user", policyName, "Custom", user.UserName); err != nil {
+				return WrapError(err)
+			}
+		}
+		for _, group := range response.Groups.Group {
+			if err := s.DetachRamPolicy("group", policyName, "Custom", group.GroupName); err != nil {
+				return WrapError(err)
+			}
+		}
+		for _, role := range response.Roles.Role {
+			if err := s.DetachRamPolicy("role", policyName, "Custom", role.RoleName); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+
+	request := ram.CreateListPolicyVersionsRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.PolicyName = policyName
+	request.PolicyType = "Custom"
+	raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.ListPolicyVersions(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, policyName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	for _, version := range raw.(*ram.ListPolicyVersionsResponse).PolicyVersions.PolicyVersion {
+		if version.IsDefaultVersion {
+			continue
+		}
+		if err := s.DeleteRamPolicyVersion(policyName, version.VersionId); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
+
+func (s *RamService) DeleteRamPolicyVersion(policyName, versionId string) error {
+	request := ram.CreateDeletePolicyVersionRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.PolicyName = policyName
+	request.VersionId = versionId
+	raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.DeletePolicyVersion(request)
+	})
+	if err != nil && !IsExpectedErrors(err, []string{"EntityNotExist"}) {
+		return WrapErrorf(err, DefaultErrorMsg, policyName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
+
+func (s *RamService) AddRamUserToGroup(userName, groupName string) error {
+	request := ram.CreateAddUserToGroupRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.UserName = userName
+	request.GroupName = groupName
+	raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.AddUserToGroup(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, groupName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
+
+func (s *RamService) RemoveRamUserFromGroup(userName, groupName string) error {
+	request := ram.CreateRemoveUserFromGroupRequest()
+	s.client.InitRpcRequest(request.RpcRequest)
+	request.UserName = userName
+	request.GroupName = groupName
+	raw, err := s.client.WithRamClient(func(ramClient *ram.Client) (interface{}, error) {
+		return ramClient.RemoveUserFromGroup(request)
+	})
+	if err != nil && !IsExpectedErrors(err, []string{"EntityNotExist"}) {
+		return WrapErrorf(err, DefaultErrorMsg, groupName, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
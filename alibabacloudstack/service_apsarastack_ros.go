@@ -28,6 +28,9 @@ func (s *RosService) DescribeRosChangeSet(id string) (object map[string]interfac
 		"ChangeSetId":  id,
 		"ShowTemplate": true,
 	}
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = s.client.Department
 	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
 	runtime.SetAutoretry(true)
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
@@ -176,7 +179,7 @@ func (s *RosService) ListTagResources(id string, resourceType string) (object in
 
 	for {
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 			response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
 			if err != nil {
 				if IsExpectedErrors(err, []string{Throttling}) {
@@ -237,7 +240,7 @@ func (s *RosService) SetResourceTags(d *schema.ResourceData, resourceType string
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
 				if err != nil {
 					if IsThrottling(err) {
@@ -272,7 +275,7 @@ func (s *RosService) SetResourceTags(d *schema.ResourceData, resourceType string
 			}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)})
 				if err != nil {
 					if IsThrottling(err) {
@@ -381,3 +384,96 @@ func (s *RosService) DescribeRosTemplate(id string) (object map[string]interface
 	object = v.(map[string]interface{})
 	return object, nil
 }
+
+func (s *RosService) DescribeRosStackGroupOperation(id string) (object map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewRosClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "GetStackGroupOperation"
+	request := map[string]interface{}{
+		"RegionId":    s.client.RegionId,
+		"OperationId": id,
+	}
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = s.client.Department
+	runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"StackGroupOperationNotFound"}) {
+			err = WrapErrorf(Error(GetNotFoundMessage("RosStackGroupOperation", id)), NotFoundMsg, ProviderERROR)
+			return object, err
+		}
+		err = WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+		return object, err
+	}
+	addDebug(action, response, request)
+	v, err := jsonpath.Get("$.StackGroupOperation", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.StackGroupOperation", response)
+	}
+	object = v.(map[string]interface{})
+	return object, nil
+}
+
+func (s *RosService) RosStackGroupOperationStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeRosStackGroupOperation(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object["Status"].(string) == failState {
+				return object, object["Status"].(string), WrapError(Error(FailedToReachTargetStatus, object["Status"].(string)))
+			}
+		}
+		return object, object["Status"].(string), nil
+	}
+}
+
+// ListRosStackInstances returns all of the stack instances of the stack group.
+func (s *RosService) ListRosStackInstances(stackGroupName string) (objects []interface{}, err error) {
+	conn, err := s.client.NewRosClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "ListStackInstances"
+	request := map[string]interface{}{
+		"RegionId":       s.client.RegionId,
+		"StackGroupName": stackGroupName,
+		"PageSize":       PageSizeLarge,
+		"PageNumber":     1,
+	}
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = s.client.Department
+	for {
+		runtime := util.RuntimeOptions{IgnoreSSL: tea.Bool(s.client.Config.Insecure)}
+		response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if IsExpectedErrors(err, []string{"StackGroupNotFound"}) {
+				return objects, WrapErrorf(Error(GetNotFoundMessage("RosStackGroup", stackGroupName)), NotFoundMsg, ProviderERROR)
+			}
+			return objects, WrapErrorf(err, DefaultErrorMsg, stackGroupName, action, AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(action, response, request)
+		v, err := jsonpath.Get("$.StackInstances", response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, stackGroupName, "$.StackInstances", response)
+		}
+		result, _ := v.([]interface{})
+		objects = append(objects, result...)
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return objects, nil
+}
@@ -32,21 +32,7 @@ const tags_max_num_per_time = 5
 const tags_max_page_size = 50
 
 func (s *SlbService) BuildSlbCommonRequest() (*requests.CommonRequest, error) {
-	// Get product code from the built request
-	slbReq := slb.CreateCreateLoadBalancerRequest()
-	if strings.ToLower(s.client.Config.Protocol) == "https" {
-		slbReq.Scheme = "https"
-	} else {
-		slbReq.Scheme = "http"
-	}
-	slbReq.Headers = map[string]string{"RegionId": s.client.RegionId}
-	slbReq.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
-	req, err := s.client.NewCommonRequest(slbReq.GetProduct(), slbReq.GetLocationServiceCode(), s.client.Config.Protocol, connectivity.ApiVersion20140515)
-	if err != nil {
-		err = WrapError(err)
-	}
-	req.RegionId = s.client.RegionId
-	return req, err
+	return s.client.NewCommonRequest("POST", "Slb", string(connectivity.ApiVersion20140515), "", ""), nil
 }
 
 func (s *SlbService) DescribeSlb(id string) (*slb.DescribeLoadBalancerAttributeResponse, error) {
@@ -59,7 +45,7 @@ func (s *SlbService) DescribeSlb(id string) (*slb.DescribeLoadBalancerAttributeR
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LoadBalancerId = id
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeLoadBalancerAttribute(request)
@@ -90,7 +76,7 @@ func (s *SlbService) DescribeSlbRule(id string) (*slb.DescribeRuleAttributeRespo
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RuleId = id
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeRuleAttribute(request)
@@ -116,7 +102,7 @@ func (s *SlbService) DescribeSlbServerGroup(id string) (*slb.DescribeVServerGrou
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.VServerGroupId = id
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeVServerGroupAttribute(request)
@@ -145,7 +131,7 @@ func (s *SlbService) DescribeSlbMasterSlaveServerGroup(id string) (*slb.Describe
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.MasterSlaveServerGroupId = id
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeMasterSlaveServerGroupAttribute(request)
@@ -174,7 +160,7 @@ func (s *SlbService) DescribeSlbBackendServer(id string) (*slb.DescribeLoadBalan
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LoadBalancerId = id
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeLoadBalancerAttribute(request)
@@ -202,10 +188,6 @@ func (s *SlbService) DescribeSlbListener(id string) (listener map[string]interfa
 	}
 	protocol := parts[1]
 	request, err := s.BuildSlbCommonRequest()
-	request.RegionId = s.client.RegionId
-	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
-
 	if err != nil {
 		err = WrapError(err)
 		return
@@ -214,7 +196,7 @@ func (s *SlbService) DescribeSlbListener(id string) (listener map[string]interfa
 	request.QueryParams["LoadBalancerId"] = parts[0]
 	port, _ := strconv.Atoi(parts[2])
 	request.QueryParams["ListenerPort"] = string(requests.NewInteger(port))
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.ProcessCommonRequest(request)
 		})
@@ -252,7 +234,7 @@ func (s *SlbService) DescribeSlbAcl(id string) (*slb.DescribeAccessControlListAt
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.AclId = id
 
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -456,7 +438,7 @@ func (s *SlbService) slbRemoveAccessControlListEntryPerTime(list []interface{},
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.AclId = id
 	b, err := json.Marshal(list)
 	if err != nil {
@@ -509,7 +491,7 @@ func (s *SlbService) slbAddAccessControlListEntryPerTime(list []interface{}, id
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.AclId = id
 	b, err := json.Marshal(list)
 	if err != nil {
@@ -592,7 +574,7 @@ func (s *SlbService) DescribeSlbCACertificate(id string) (*slb.CACertificate, er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.CACertificateId = id
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeCACertificates(request)
@@ -641,7 +623,7 @@ func (s *SlbService) DescribeSlbServerCertificate(id string) (*slb.ServerCertifi
 	}
 	request.ServerCertificateId = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeServerCertificates(request)
@@ -707,11 +689,11 @@ func (s *SlbService) DescribeDomainExtensionAttribute(domainExtensionId string)
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DomainExtensionId = domainExtensionId
 	var raw interface{}
 	var err error
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeDomainExtensionAttribute(request)
 		})
@@ -771,7 +753,7 @@ func (s *SlbService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 		}
 		request := slb.CreateRemoveTagsRequest()
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		request.LoadBalancerId = d.Id()
 		if strings.ToLower(s.client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -784,7 +766,7 @@ func (s *SlbService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 		request.RegionId = s.client.RegionId
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 			raw, err := s.client.WithSlbClient(func(client *slb.Client) (interface{}, error) {
 				return client.RemoveTags(request)
 			})
@@ -813,14 +795,14 @@ func (s *SlbService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		bytes, _ := json.Marshal(create)
 		s2 := string(bytes)
 		request.Tags = fmt.Sprint(s2)
 		request.RegionId = s.client.RegionId
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(s.client.Context(), 10*time.Minute, func() *resource.RetryError {
 			raw, err := s.client.WithSlbClient(func(client *slb.Client) (interface{}, error) {
 				return client.AddTags(request)
 			})
@@ -907,7 +889,7 @@ func (s *SlbService) DescribeTags(resourceId string, resourceTags map[string]int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LoadBalancerId = resourceId
 	if resourceTags != nil && len(resourceTags) > 0 {
 		var reqTags []slb.TagSet
@@ -921,7 +903,7 @@ func (s *SlbService) DescribeTags(resourceId string, resourceTags map[string]int
 		s2 := string(bytes)
 		request.Tags = fmt.Sprint(s2)
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.Context(), 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithSlbClient(func(Client *slb.Client) (interface{}, error) {
 			return Client.DescribeTags(request)
 		})
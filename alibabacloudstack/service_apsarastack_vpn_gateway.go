@@ -93,6 +93,8 @@ func (s *VpnGatewayService) DescribeSslVpnServer(id string) (v vpc.SslVpnServer,
 	request.RegionId = s.client.RegionId
 	request.SslVpnServerId = id
 
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = s.client.Department
 	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 		return vpcClient.DescribeSslVpnServers(request)
 	})
@@ -116,6 +118,8 @@ func (s *VpnGatewayService) DescribeSslVpnClientCert(id string) (v vpc.DescribeS
 	request.RegionId = s.client.RegionId
 	request.SslVpnClientCertId = id
 
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = s.client.Department
 	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 		return vpcClient.DescribeSslVpnClientCert(request)
 	})
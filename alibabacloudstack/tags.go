@@ -90,11 +90,11 @@ func setVolumeTags(client *connectivity.AlibabacloudStackClient, resourceType Ta
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		var response *ecs.DescribeDisksResponse
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(client.Context(), 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.DescribeDisks(request)
 			})
@@ -157,7 +157,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		}
 		request.ResourceId = &ids
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		var tagsKey []string
 		for _, t := range remove {
@@ -166,7 +166,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		request.TagKey = &tagsKey
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(client.Context(), 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.UntagResources(request)
 			})
@@ -197,7 +197,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		request.ResourceType = string(resourceType)
 		request.ResourceId = &ids
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		var tags []ecs.TagResourcesTag
 		for _, t := range create {
@@ -209,7 +209,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		request.Tag = &tags
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(client.Context(), 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.TagResources(request)
 			})
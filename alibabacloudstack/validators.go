@@ -135,6 +135,16 @@ func validateOssBucketDateTimestamp(v interface{}, k string) (ws []string, error
 	return
 }
 
+func validateOssObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf(
+				"%q: the key %q must be lower case, OSS stores the user metadata keys in lower case", k, key))
+		}
+	}
+	return
+}
+
 func validateDBConnectionPort(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
 		port, err := strconv.Atoi(value)
@@ -25,12 +25,15 @@ require (
 	github.com/denverdino/aliyungo v0.0.0-20200831100606-661b4d73f397
 	github.com/go-yaml/yaml v2.1.0+incompatible
 	github.com/google/uuid v1.1.2
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/go-uuid v1.0.1
 	github.com/hashicorp/terraform v0.13.5
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.7.0
 	github.com/jmespath/go-jmespath v0.4.0
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/shopspring/decimal v1.3.1 // indirect
+	golang.org/x/crypto v0.0.0-20210421170649-83a5a9bb288b
 	golang.org/x/tools v0.1.8-0.20211014194737-fc98fb2abd48 // indirect
 	gopkg.in/ini.v1 v1.62.0 // indirect
+	gopkg.in/resty.v1 v1.12.0
 )